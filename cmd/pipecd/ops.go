@@ -35,6 +35,7 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/ops/orphancommandcleaner"
 	"github.com/pipe-cd/pipecd/pkg/app/ops/pipedstatsbuilder"
 	"github.com/pipe-cd/pipecd/pkg/app/ops/planpreviewoutputcleaner"
+	"github.com/pipe-cd/pipecd/pkg/app/ops/stagelogarchiver"
 	"github.com/pipe-cd/pipecd/pkg/app/ops/staledpipedstatcleaner"
 	"github.com/pipe-cd/pipecd/pkg/cache/rediscache"
 	"github.com/pipe-cd/pipecd/pkg/cli"
@@ -171,6 +172,18 @@ func (s *ops) run(ctx context.Context, input cli.Input) error {
 		})
 	}
 
+	// Start running stage log archiver.
+	if cfg.StageLogArchiver.Enabled {
+		archiver, err := stagelogarchiver.NewArchiver(ds, fs, cfg.StageLogArchiver, input.Logger)
+		if err != nil {
+			input.Logger.Error("failed to create stage log archiver", zap.Error(err))
+			return err
+		}
+		group.Go(func() error {
+			return archiver.Run(ctx)
+		})
+	}
+
 	// Start runnning apiKeyLastUsedTime updater.
 	{
 		updater := apikeylastusedtimeupdater.NewAPIKeyLastUsedTimeUpdater(ds, rd, input.Logger)
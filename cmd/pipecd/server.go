@@ -250,13 +250,14 @@ func (s *server) run(ctx context.Context, input cli.Input) error {
 				input.Logger,
 			)
 
-			service = grpcapi.NewAPI(ctx, ds, fs, cache, cmdOutputStore, statCache, cfg.Address, input.Logger)
+			service = grpcapi.NewAPI(ctx, ds, fs, cache, cmdOutputStore, statCache, cfg.Address, cfg.SecretEncryption.EmbedKeyID, input.Logger)
 			opts    = []rpc.Option{
 				rpc.WithPort(s.apiPort),
 				rpc.WithGracePeriod(s.gracePeriod),
 				rpc.WithLogger(input.Logger),
 				rpc.WithLogUnaryInterceptor(input.Logger),
 				rpc.WithAPIKeyAuthUnaryInterceptor(verifier, input.Logger),
+				rpc.WithAPIKeyAuthStreamInterceptor(verifier, input.Logger),
 				rpc.WithRequestValidationUnaryInterceptor(),
 			}
 		)
@@ -299,6 +300,7 @@ func (s *server) run(ctx context.Context, input cli.Input) error {
 			statCache,
 			cfg.ProjectMap(),
 			encryptDecrypter,
+			cfg.SecretEncryption.EmbedKeyID,
 			input.Logger,
 		)
 		opts := []rpc.Option{
@@ -344,6 +346,9 @@ func (s *server) run(ctx context.Context, input cli.Input) error {
 			cfg.ProjectMap(),
 			cfg.SharedSSOConfigMap(),
 			datastore.NewProjectStore(ds),
+			cfg.RegistryWebhooks,
+			datastore.NewAPIKeyStore(ds),
+			datastore.NewEventStore(ds),
 			!s.insecureCookie,
 			input.Logger,
 		)
@@ -42,14 +42,20 @@ type deploymentStore interface {
 }
 
 type deploymentChainStore interface {
+	Get(ctx context.Context, id string) (*model.DeploymentChain, error)
 	List(ctx context.Context, opts datastore.ListOptions) ([]*model.DeploymentChain, string, error)
 	AddNodeDeployment(ctx context.Context, chainID string, deployment *model.Deployment) error
 	UpdateNodeDeploymentStatus(ctx context.Context, chainID string, blockIndex uint32, deploymentID string, status model.DeploymentStatus, reason string) error
 }
 
+type commandStore interface {
+	Add(ctx context.Context, cmd *model.Command) error
+}
+
 type DeploymentChainController struct {
 	deploymentStore      deploymentStore
 	deploymentChainStore deploymentChainStore
+	commandStore         commandStore
 	// Map from deployment chain ID to the updater
 	// who in charge for the deployment chain updating.
 	updaters map[string]*updater
@@ -64,6 +70,7 @@ func NewDeploymentChainController(
 	return &DeploymentChainController{
 		deploymentStore:      datastore.NewDeploymentStore(ds),
 		deploymentChainStore: datastore.NewDeploymentChainStore(ds),
+		commandStore:         datastore.NewCommandStore(ds),
 		updaters:             make(map[string]*updater),
 		logger:               logger.Named("deployment-chain-controller"),
 	}
@@ -120,6 +127,7 @@ func (d *DeploymentChainController) syncUpdaters(ctx context.Context) error {
 			c,
 			d.deploymentStore,
 			d.deploymentChainStore,
+			d.commandStore,
 			d.logger,
 		)
 	}
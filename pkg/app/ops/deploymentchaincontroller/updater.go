@@ -18,6 +18,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	"github.com/pipe-cd/pipecd/pkg/datastore"
@@ -37,8 +38,16 @@ type updater struct {
 	// of that in chain application.
 	deploymentRefs map[string]*model.ChainDeploymentRef
 
+	// commandedDeployments contains IDs of the deployments which were already
+	// commanded to be cancelled or rolled back due to the chain failure.
+	commandedDeployments map[string]struct{}
+	// chainFailureHandled is true once all deployments of the failed chain
+	// were cancelled or rolled back as configured by their blocks.
+	chainFailureHandled bool
+
 	deploymentStore      deploymentStore
 	deploymentChainStore deploymentChainStore
+	commandStore         commandStore
 
 	done          bool
 	doneTimestamp time.Time
@@ -51,14 +60,17 @@ func newUpdater(
 	dc *model.DeploymentChain,
 	ds deploymentStore,
 	dcs deploymentChainStore,
+	cs commandStore,
 	lg *zap.Logger,
 ) *updater {
 	return &updater{
 		deploymentChainID:    dc.Id,
 		applicationRefs:      dc.ListAllInChainApplications(),
 		deploymentRefs:       dc.ListAllInChainApplicationDeploymentsMap(),
+		commandedDeployments: make(map[string]struct{}),
 		deploymentStore:      ds,
 		deploymentChainStore: dcs,
+		commandStore:         cs,
 		logger:               lg,
 		nowFunc:              time.Now,
 	}
@@ -71,7 +83,15 @@ func (u *updater) IsDone() bool {
 func (u *updater) Run(ctx context.Context) error {
 	// In case all in chain applications' deployments are completed
 	// mark the updater as done and return immediately.
+	// The updater of a failed chain is kept until all of its deployments
+	// were cancelled or rolled back as configured.
 	if u.isAllInChainDeploymentsCompleted() {
+		if err := u.handleChainFailure(ctx); err != nil {
+			return err
+		}
+		if !u.chainFailureHandled && u.hasUnsuccessfulDeployment() {
+			return nil
+		}
 		if !u.done {
 			u.done = true
 			u.doneTimestamp = u.nowFunc()
@@ -120,6 +140,12 @@ func (u *updater) Run(ctx context.Context) error {
 		}
 	}
 
+	// Cancel or roll back the deployments of the blocks which require it
+	// in case the chain was failed or cancelled.
+	if err := u.handleChainFailure(ctx); err != nil {
+		return err
+	}
+
 	// If not all applications in chain has its deployment ref
 	// fetch all missing deployments and link the deployment ref to them.
 	if len(u.deploymentRefs) != len(u.applicationRefs) {
@@ -156,6 +182,141 @@ func (u *updater) Run(ctx context.Context) error {
 	return nil
 }
 
+// handleChainFailure sends commands to cancel or roll back the deployments of the blocks
+// configured to do so once the chain finished with FAILURE or CANCELLED status.
+// The running deployments of the blocks configured with CancelOnChainFailure are cancelled
+// with rollback, and the succeeded deployments of the blocks configured with RollbackOnChainFailure
+// are rolled back by deploying their previously running commits.
+// It is called at every interval until all required commands have been accepted.
+func (u *updater) handleChainFailure(ctx context.Context) error {
+	if u.chainFailureHandled || !u.hasUnsuccessfulDeployment() {
+		return nil
+	}
+
+	dc, err := u.deploymentChainStore.Get(ctx, u.deploymentChainID)
+	if err != nil {
+		u.logger.Error("failed to get deployment chain",
+			zap.String("deploymentChainId", u.deploymentChainID),
+			zap.Error(err),
+		)
+		return err
+	}
+	if dc.Status != model.ChainStatus_DEPLOYMENT_CHAIN_FAILURE && dc.Status != model.ChainStatus_DEPLOYMENT_CHAIN_CANCELLED {
+		return nil
+	}
+
+	var (
+		pending bool
+		lastErr error
+	)
+	for _, block := range dc.Blocks {
+		if !block.CancelOnChainFailure && !block.RollbackOnChainFailure {
+			continue
+		}
+		for _, node := range block.Nodes {
+			ref := node.DeploymentRef
+			if ref == nil {
+				continue
+			}
+			if _, ok := u.commandedDeployments[ref.DeploymentId]; ok {
+				continue
+			}
+			done, err := u.handleChainFailureOfDeployment(ctx, dc, block, ref.DeploymentId)
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			if !done {
+				pending = true
+			}
+		}
+	}
+	if lastErr != nil {
+		return lastErr
+	}
+	u.chainFailureHandled = !pending
+	return nil
+}
+
+// handleChainFailureOfDeployment sends the command to cancel or roll back the given deployment if required.
+// It returns false when the deployment is still running and has to be checked again later.
+func (u *updater) handleChainFailureOfDeployment(ctx context.Context, dc *model.DeploymentChain, block *model.ChainBlock, deploymentID string) (bool, error) {
+	deployment, err := u.deploymentStore.Get(ctx, deploymentID)
+	if err != nil {
+		u.logger.Error("failed to get deployment to cancel or roll back",
+			zap.String("deploymentChainId", u.deploymentChainID),
+			zap.String("deploymentId", deploymentID),
+			zap.Error(err),
+		)
+		return false, err
+	}
+
+	var cmd *model.Command
+	switch {
+	case !deployment.Status.IsCompleted():
+		// The running deployments of a cancelled chain were already commanded
+		// to be cancelled while cancelling the chain.
+		if !block.CancelOnChainFailure || dc.Status != model.ChainStatus_DEPLOYMENT_CHAIN_FAILURE {
+			// Wait for the deployment to finish to decide whether it has to be rolled back.
+			return !block.RollbackOnChainFailure, nil
+		}
+		cmd = &model.Command{
+			Id:            uuid.New().String(),
+			PipedId:       deployment.PipedId,
+			ApplicationId: deployment.ApplicationId,
+			ProjectId:     deployment.ProjectId,
+			DeploymentId:  deployment.Id,
+			Type:          model.Command_CANCEL_DEPLOYMENT,
+			CancelDeployment: &model.Command_CancelDeployment{
+				DeploymentId:  deployment.Id,
+				ForceRollback: true,
+			},
+		}
+
+	case deployment.Status == model.DeploymentStatus_DEPLOYMENT_SUCCESS && block.RollbackOnChainFailure:
+		if deployment.RunningCommitHash == "" {
+			u.logger.Warn("unable to roll back deployment in chain since there is no previously running commit",
+				zap.String("deploymentChainId", u.deploymentChainID),
+				zap.String("deploymentId", deployment.Id),
+			)
+			u.commandedDeployments[deployment.Id] = struct{}{}
+			return true, nil
+		}
+		cmd = &model.Command{
+			Id:            uuid.New().String(),
+			PipedId:       deployment.PipedId,
+			ApplicationId: deployment.ApplicationId,
+			ProjectId:     deployment.ProjectId,
+			Type:          model.Command_SYNC_APPLICATION,
+			SyncApplication: &model.Command_SyncApplication{
+				ApplicationId: deployment.ApplicationId,
+				SyncStrategy:  model.SyncStrategy_QUICK_SYNC,
+				TargetCommit:  deployment.RunningCommitHash,
+			},
+		}
+
+	default:
+		return true, nil
+	}
+
+	if err := u.commandStore.Add(ctx, cmd); err != nil {
+		u.logger.Error("failed to add command to cancel or roll back deployment in chain",
+			zap.String("deploymentChainId", u.deploymentChainID),
+			zap.String("deploymentId", deployment.Id),
+			zap.String("command", cmd.Type.String()),
+			zap.Error(err),
+		)
+		return false, err
+	}
+	u.commandedDeployments[deployment.Id] = struct{}{}
+	u.logger.Info("commanded to cancel or roll back deployment due to the deployment chain failure",
+		zap.String("deploymentChainId", u.deploymentChainID),
+		zap.String("deploymentId", deployment.Id),
+		zap.String("command", cmd.Type.String()),
+	)
+	return true, nil
+}
+
 func (u *updater) listAllMissingDeployments(ctx context.Context) ([]*model.Deployment, error) {
 	noDeploymentApps := make(map[string]interface{}, len(u.applicationRefs))
 	for _, appRef := range u.applicationRefs {
@@ -203,3 +364,15 @@ func (u *updater) isAllInChainDeploymentsCompleted() bool {
 	}
 	return true
 }
+
+// hasUnsuccessfulDeployment returns true when one of the in chain deployments
+// has finished with FAILURE or CANCELLED status.
+func (u *updater) hasUnsuccessfulDeployment() bool {
+	for _, dr := range u.deploymentRefs {
+		switch dr.Status {
+		case model.DeploymentStatus_DEPLOYMENT_FAILURE, model.DeploymentStatus_DEPLOYMENT_CANCELLED:
+			return true
+		}
+	}
+	return false
+}
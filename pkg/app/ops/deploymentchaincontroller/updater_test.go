@@ -0,0 +1,189 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploymentchaincontroller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeDeploymentStore struct {
+	deploymentStore
+	deployments map[string]*model.Deployment
+}
+
+func (s *fakeDeploymentStore) Get(_ context.Context, id string) (*model.Deployment, error) {
+	d, ok := s.deployments[id]
+	if !ok {
+		return nil, datastore.ErrNotFound
+	}
+	return d, nil
+}
+
+type fakeDeploymentChainStore struct {
+	deploymentChainStore
+	chain *model.DeploymentChain
+}
+
+func (s *fakeDeploymentChainStore) Get(_ context.Context, _ string) (*model.DeploymentChain, error) {
+	return s.chain, nil
+}
+
+type fakeCommandStore struct {
+	commands []*model.Command
+	// failures is the number of the next Add calls which fail.
+	failures int
+}
+
+func (s *fakeCommandStore) Add(_ context.Context, cmd *model.Command) error {
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("unavailable")
+	}
+	s.commands = append(s.commands, cmd)
+	return nil
+}
+
+func newChainNode(appID, deploymentID string, status model.DeploymentStatus) *model.ChainNode {
+	return &model.ChainNode{
+		ApplicationRef: &model.ChainApplicationRef{ApplicationId: appID},
+		DeploymentRef:  &model.ChainDeploymentRef{DeploymentId: deploymentID, Status: status},
+	}
+}
+
+func newTestUpdater(dc *model.DeploymentChain, deployments []*model.Deployment, cs *fakeCommandStore) *updater {
+	ds := &fakeDeploymentStore{deployments: make(map[string]*model.Deployment, len(deployments))}
+	for _, d := range deployments {
+		ds.deployments[d.Id] = d
+	}
+	return newUpdater(dc, ds, &fakeDeploymentChainStore{chain: dc}, cs, zap.NewNop())
+}
+
+func TestUpdater_handleChainFailure(t *testing.T) {
+	t.Parallel()
+
+	newChain := func() *model.DeploymentChain {
+		return &model.DeploymentChain{
+			Id:     "chain",
+			Status: model.ChainStatus_DEPLOYMENT_CHAIN_FAILURE,
+			Blocks: []*model.ChainBlock{
+				{
+					Nodes:                  []*model.ChainNode{newChainNode("app-1", "deployment-1", model.DeploymentStatus_DEPLOYMENT_SUCCESS)},
+					RollbackOnChainFailure: true,
+				},
+				{
+					Nodes: []*model.ChainNode{newChainNode("app-2", "deployment-2", model.DeploymentStatus_DEPLOYMENT_SUCCESS)},
+				},
+				{
+					Nodes: []*model.ChainNode{
+						newChainNode("app-3", "deployment-3", model.DeploymentStatus_DEPLOYMENT_FAILURE),
+						newChainNode("app-4", "deployment-4", model.DeploymentStatus_DEPLOYMENT_RUNNING),
+					},
+					CancelOnChainFailure: true,
+				},
+			},
+		}
+	}
+	deployments := []*model.Deployment{
+		{Id: "deployment-1", ApplicationId: "app-1", PipedId: "piped", Status: model.DeploymentStatus_DEPLOYMENT_SUCCESS, RunningCommitHash: "previous"},
+		{Id: "deployment-2", ApplicationId: "app-2", PipedId: "piped", Status: model.DeploymentStatus_DEPLOYMENT_SUCCESS, RunningCommitHash: "previous"},
+		{Id: "deployment-3", ApplicationId: "app-3", PipedId: "piped", Status: model.DeploymentStatus_DEPLOYMENT_FAILURE},
+		{Id: "deployment-4", ApplicationId: "app-4", PipedId: "piped", Status: model.DeploymentStatus_DEPLOYMENT_RUNNING},
+	}
+
+	t.Run("cancel running and roll back succeeded deployments", func(t *testing.T) {
+		t.Parallel()
+
+		cs := &fakeCommandStore{}
+		u := newTestUpdater(newChain(), deployments, cs)
+
+		require.NoError(t, u.handleChainFailure(context.Background()))
+		assert.True(t, u.chainFailureHandled)
+		require.Len(t, cs.commands, 2)
+
+		byApp := make(map[string]*model.Command, len(cs.commands))
+		for _, cmd := range cs.commands {
+			byApp[cmd.ApplicationId] = cmd
+		}
+		assert.Equal(t, model.Command_SYNC_APPLICATION, byApp["app-1"].Type)
+		assert.Equal(t, "previous", byApp["app-1"].SyncApplication.TargetCommit)
+		assert.Equal(t, model.SyncStrategy_QUICK_SYNC, byApp["app-1"].SyncApplication.SyncStrategy)
+		assert.Equal(t, model.Command_CANCEL_DEPLOYMENT, byApp["app-4"].Type)
+		assert.True(t, byApp["app-4"].CancelDeployment.ForceRollback)
+
+		// Nothing is commanded again at the next interval.
+		require.NoError(t, u.handleChainFailure(context.Background()))
+		assert.Len(t, cs.commands, 2)
+	})
+
+	t.Run("retry until all commands are accepted", func(t *testing.T) {
+		t.Parallel()
+
+		cs := &fakeCommandStore{failures: 1}
+		u := newTestUpdater(newChain(), deployments, cs)
+
+		require.Error(t, u.handleChainFailure(context.Background()))
+		assert.False(t, u.chainFailureHandled)
+		assert.Len(t, cs.commands, 1)
+
+		require.NoError(t, u.handleChainFailure(context.Background()))
+		assert.True(t, u.chainFailureHandled)
+		assert.Len(t, cs.commands, 2)
+	})
+
+	t.Run("wait for running deployments to be rolled back", func(t *testing.T) {
+		t.Parallel()
+
+		dc := newChain()
+		dc.Blocks[0].Nodes[0].DeploymentRef.Status = model.DeploymentStatus_DEPLOYMENT_RUNNING
+		running := &model.Deployment{Id: "deployment-1", ApplicationId: "app-1", PipedId: "piped", Status: model.DeploymentStatus_DEPLOYMENT_RUNNING, RunningCommitHash: "previous"}
+
+		cs := &fakeCommandStore{}
+		u := newTestUpdater(dc, []*model.Deployment{running, deployments[1], deployments[2], deployments[3]}, cs)
+
+		require.NoError(t, u.handleChainFailure(context.Background()))
+		assert.False(t, u.chainFailureHandled)
+		require.Len(t, cs.commands, 1)
+		assert.Equal(t, model.Command_CANCEL_DEPLOYMENT, cs.commands[0].Type)
+
+		running.Status = model.DeploymentStatus_DEPLOYMENT_SUCCESS
+		require.NoError(t, u.handleChainFailure(context.Background()))
+		assert.True(t, u.chainFailureHandled)
+		require.Len(t, cs.commands, 2)
+		assert.Equal(t, model.Command_SYNC_APPLICATION, cs.commands[1].Type)
+	})
+
+	t.Run("do nothing while the chain is running", func(t *testing.T) {
+		t.Parallel()
+
+		dc := newChain()
+		dc.Status = model.ChainStatus_DEPLOYMENT_CHAIN_RUNNING
+
+		cs := &fakeCommandStore{}
+		u := newTestUpdater(dc, deployments, cs)
+
+		require.NoError(t, u.handleChainFailure(context.Background()))
+		assert.False(t, u.chainFailureHandled)
+		assert.Empty(t, cs.commands)
+	})
+}
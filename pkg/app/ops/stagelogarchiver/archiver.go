@@ -0,0 +1,285 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stagelogarchiver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/stagelogstore"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/filestore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	// completedGracePeriod is how long to wait after the completion of a deployment
+	// before compressing its stage logs, so that piped can flush its remaining logs.
+	completedGracePeriod = time.Hour
+	// milestoneFilePath is the path of the file recording how far the archiver has processed.
+	milestoneFilePath = "stage-log-archiver/milestone.json"
+	// listLimit is the number of deployments fetched from the datastore at once.
+	listLimit = 50
+)
+
+type deploymentLister interface {
+	List(ctx context.Context, opts datastore.ListOptions) ([]*model.Deployment, string, error)
+}
+
+// milestone records how far the archiver has processed the completed deployments.
+// The stage logs of the deployments completed at or before CompressedAt were already compressed,
+// and the ones completed at or before ArchivedAt were already moved to the archive prefix.
+type milestone struct {
+	CompressedAt int64 `json:"compressedAt"`
+	ArchivedAt   int64 `json:"archivedAt"`
+}
+
+// Archiver compresses the stage logs of completed deployments
+// and moves them to the archive prefix after the configured period.
+// The stage logs are exported to the configured sink right before being compressed.
+// Instead of listing all stage logs in the filestore, it walks the completed deployments
+// in the order of their completion from the milestone saved at the last run.
+type Archiver struct {
+	filestore       filestore.Store
+	deploymentStore deploymentLister
+	exporter        Exporter
+	config          config.ControlPlaneStageLogArchiver
+	nowFunc         func() time.Time
+	logger          *zap.Logger
+}
+
+func NewArchiver(ds datastore.DataStore, fs filestore.Store, cfg config.ControlPlaneStageLogArchiver, logger *zap.Logger) (*Archiver, error) {
+	a := &Archiver{
+		filestore:       fs,
+		deploymentStore: datastore.NewDeploymentStore(ds),
+		config:          cfg,
+		nowFunc:         time.Now,
+		logger:          logger.Named("stage-log-archiver"),
+	}
+	if cfg.Exporter != nil {
+		e, err := NewExporter(cfg.Exporter)
+		if err != nil {
+			return nil, err
+		}
+		a.exporter = e
+	}
+	return a, nil
+}
+
+func (a *Archiver) Run(ctx context.Context) error {
+	a.logger.Info("start running stage log archiver")
+
+	cr := cron.New()
+	if _, err := cr.AddFunc(a.config.Schedule, func() { a.archive(ctx) }); err != nil {
+		return err
+	}
+
+	cr.Start()
+	<-ctx.Done()
+	cr.Stop()
+
+	a.logger.Info("stage log archiver has been stopped")
+	return nil
+}
+
+func (a *Archiver) archive(ctx context.Context) error {
+	a.logger.Info("will find stage logs to compress and archive")
+
+	m, err := a.getMilestone(ctx)
+	if err != nil {
+		a.logger.Error("failed to load milestone from filestore", zap.Error(err))
+		return err
+	}
+
+	now := a.nowFunc()
+	compressedAt, compresses := a.processCompletedDeployments(ctx, m.CompressedAt, now.Add(-completedGracePeriod).Unix(), a.compressLogs)
+	// Only the compressed stage logs can be archived.
+	archiveTo := min(now.Add(-a.config.ArchiveAfter.Duration()).Unix(), compressedAt)
+	archivedAt, archives := a.processCompletedDeployments(ctx, m.ArchivedAt, archiveTo, a.archiveLogs)
+
+	a.logger.Info(fmt.Sprintf("compressed %d and archived %d stage logs", compresses, archives))
+
+	if compressedAt == m.CompressedAt && archivedAt == m.ArchivedAt {
+		return nil
+	}
+	m.CompressedAt, m.ArchivedAt = compressedAt, archivedAt
+	if err := a.putMilestone(ctx, m); err != nil {
+		a.logger.Error("failed to store milestone", zap.Error(err))
+		return err
+	}
+	a.logger.Info("successfully stored a new milestone",
+		zap.Int64("compressed-at", m.CompressedAt),
+		zap.Int64("archived-at", m.ArchivedAt),
+	)
+	return nil
+}
+
+// processCompletedDeployments calls the given function for each deployment completed in the range (from, to]
+// in the order of their completion, and returns the new milestone and the total number of processed stage logs.
+// When it fails in the middle, the returned milestone stays before the failed deployment
+// so that the rest will be retried at the next run.
+func (a *Archiver) processCompletedDeployments(ctx context.Context, from, to int64, fn func(context.Context, *model.Deployment) (int, error)) (int64, int) {
+	if to <= from {
+		return from, 0
+	}
+
+	var (
+		opts = datastore.ListOptions{
+			Filters: []datastore.ListFilter{
+				{
+					Field:    "CompletedAt",
+					Operator: datastore.OperatorGreaterThan,
+					Value:    from,
+				},
+				{
+					Field:    "CompletedAt",
+					Operator: datastore.OperatorLessThanOrEqual,
+					Value:    to,
+				},
+			},
+			Orders: []datastore.Order{
+				{
+					Field:     "CompletedAt",
+					Direction: datastore.Asc,
+				},
+				{
+					Field:     "Id",
+					Direction: datastore.Asc,
+				},
+			},
+			Limit: listLimit,
+		}
+		// Deployments completed at the same time as the failed one may have been processed already,
+		// so they are processed again at the next run. It is safe since fn is idempotent.
+		failedAt = func(d *model.Deployment) int64 { return max(from, d.CompletedAt-1) }
+		last     *model.Deployment
+		count    int
+	)
+	for {
+		ds, next, err := a.deploymentStore.List(ctx, opts)
+		if err != nil {
+			a.logger.Error("failed to list completed deployments", zap.Error(err))
+			if last == nil {
+				return from, count
+			}
+			return failedAt(last), count
+		}
+		for _, d := range ds {
+			n, err := fn(ctx, d)
+			count += n
+			if err != nil {
+				a.logger.Error("failed to process stage logs of deployment", zap.String("deployment-id", d.Id), zap.Error(err))
+				return failedAt(d), count
+			}
+			last = d
+		}
+		if next == "" {
+			return to, count
+		}
+		opts.Cursor = next
+	}
+}
+
+// compressLogs exports and compresses the uncompressed stage logs of the given deployment.
+func (a *Archiver) compressLogs(ctx context.Context, d *model.Deployment) (int, error) {
+	count := 0
+	for _, obj := range logObjects(d, false) {
+		blocks, _, err := stagelogstore.ReadLogObject(ctx, a.filestore, obj)
+		// The stage log was not written or was already compressed.
+		if errors.Is(err, filestore.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return count, fmt.Errorf("failed to read stage log of stage %s: %w", obj.StageID, err)
+		}
+		// The stage log is kept uncompressed when the export failed,
+		// so that it will be retried at the next run.
+		if a.exporter != nil {
+			if err := a.exporter.Export(ctx, d, obj.StageID, obj.RetriedCount, blocks); err != nil {
+				return count, fmt.Errorf("failed to export stage log of stage %s: %w", obj.StageID, err)
+			}
+		}
+		if err := stagelogstore.CompressLogObject(ctx, a.filestore, obj); err != nil {
+			return count, fmt.Errorf("failed to compress stage log of stage %s: %w", obj.StageID, err)
+		}
+		count++
+	}
+	return count, nil
+}
+
+// archiveLogs moves the compressed stage logs of the given deployment to the archive prefix.
+func (a *Archiver) archiveLogs(ctx context.Context, d *model.Deployment) (int, error) {
+	count := 0
+	for _, obj := range logObjects(d, true) {
+		err := stagelogstore.ArchiveLogObject(ctx, a.filestore, obj)
+		// The stage log was not written or was already archived.
+		if errors.Is(err, filestore.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return count, fmt.Errorf("failed to archive stage log of stage %s: %w", obj.StageID, err)
+		}
+		count++
+	}
+	return count, nil
+}
+
+// logObjects returns the stage log objects of all stages and their retries of the given deployment.
+func logObjects(d *model.Deployment, compressed bool) []stagelogstore.LogObject {
+	objects := make([]stagelogstore.LogObject, 0, len(d.Stages))
+	for _, s := range d.Stages {
+		for retriedCount := int32(0); retriedCount <= s.RetriedCount; retriedCount++ {
+			objects = append(objects, stagelogstore.LogObject{
+				DeploymentID: d.Id,
+				StageID:      s.Id,
+				RetriedCount: retriedCount,
+				Compressed:   compressed,
+			})
+		}
+	}
+	return objects
+}
+
+// getMilestone returns the milestone saved at the last run.
+// At the first run, all completed deployments are processed.
+func (a *Archiver) getMilestone(ctx context.Context) (*milestone, error) {
+	m := &milestone{}
+	data, err := a.filestore.Get(ctx, milestoneFilePath)
+	if errors.Is(err, filestore.ErrNotFound) {
+		return m, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (a *Archiver) putMilestone(ctx context.Context, m *milestone) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return a.filestore.Put(ctx, milestoneFilePath, data)
+}
@@ -0,0 +1,349 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stagelogarchiver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/filestore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeFileStore struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (f *fakeFileStore) Get(_ context.Context, path string) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	data, ok := f.objects[path]
+	if !ok {
+		return nil, filestore.ErrNotFound
+	}
+	return data, nil
+}
+
+func (f *fakeFileStore) GetReader(ctx context.Context, path string) (io.ReadCloser, error) {
+	data, err := f.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (f *fakeFileStore) Put(_ context.Context, path string, content []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.objects[path] = content
+	return nil
+}
+
+func (f *fakeFileStore) Delete(_ context.Context, path string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.objects, path)
+	return nil
+}
+
+func (f *fakeFileStore) List(_ context.Context, prefix string) ([]filestore.ObjectAttrs, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var objects []filestore.ObjectAttrs
+	for path := range f.objects {
+		if strings.HasPrefix(path, prefix) {
+			objects = append(objects, filestore.ObjectAttrs{Path: path})
+		}
+	}
+	return objects, nil
+}
+
+func (f *fakeFileStore) Close() error {
+	return nil
+}
+
+func (f *fakeFileStore) paths() []string {
+	paths := make([]string, 0, len(f.objects))
+	for p := range f.objects {
+		if p != milestoneFilePath {
+			paths = append(paths, p)
+		}
+	}
+	return paths
+}
+
+// fakeDeploymentStore supports only the filters and orders used by the archiver.
+// The cursor is the offset of the next page.
+type fakeDeploymentStore struct {
+	deployments []*model.Deployment
+}
+
+func (s *fakeDeploymentStore) List(_ context.Context, opts datastore.ListOptions) ([]*model.Deployment, string, error) {
+	var from, to int64
+	for _, f := range opts.Filters {
+		switch f.Operator {
+		case datastore.OperatorGreaterThan:
+			from = f.Value.(int64)
+		case datastore.OperatorLessThanOrEqual:
+			to = f.Value.(int64)
+		}
+	}
+	var matched []*model.Deployment
+	for _, d := range s.deployments {
+		if d.CompletedAt > from && d.CompletedAt <= to {
+			matched = append(matched, d)
+		}
+	}
+	slices.SortFunc(matched, func(a, b *model.Deployment) int {
+		if a.CompletedAt != b.CompletedAt {
+			return int(a.CompletedAt - b.CompletedAt)
+		}
+		return strings.Compare(a.Id, b.Id)
+	})
+
+	offset := 0
+	if opts.Cursor != "" {
+		offset, _ = strconv.Atoi(opts.Cursor)
+	}
+	end := min(offset+opts.Limit, len(matched))
+	next := ""
+	if end < len(matched) {
+		next = strconv.Itoa(end)
+	}
+	return matched[offset:end], next, nil
+}
+
+type fakeExporter struct {
+	exported []string
+	// The deployment whose stage logs fail to be exported.
+	failedDeploymentID string
+}
+
+func (e *fakeExporter) Export(_ context.Context, d *model.Deployment, stageID string, retriedCount int32, blocks []*model.LogBlock) error {
+	if d.Id == e.failedDeploymentID {
+		return errors.New("sink is unavailable")
+	}
+	for _, b := range blocks {
+		e.exported = append(e.exported, fmt.Sprintf("%s/%s/%d: %s", d.Id, stageID, retriedCount, b.Log))
+	}
+	return nil
+}
+
+const (
+	completedLog = `{"index":1,"log":"applied","severity":1,"created_at":1590499431}` + "\nEOL"
+	runningLog   = `{"index":1,"log":"applying","severity":0,"created_at":1590499431}` + "\n"
+)
+
+func TestArchiverArchive(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	fs := &fakeFileStore{
+		objects: map[string][]byte{
+			"log/deployment-1/stage-1/0.txt": []byte(completedLog),
+			"log/deployment-2/stage-1/0.txt": []byte(runningLog),
+			"log/deployment-3/stage-1/0.txt": []byte(completedLog),
+			"log/deployment-4/stage-1/0.txt": []byte(completedLog),
+			"log/deployment-4/stage-1/1.txt": []byte(completedLog),
+			// The stage log of the stage which was not executed is never written.
+		},
+	}
+	ds := &fakeDeploymentStore{
+		deployments: []*model.Deployment{
+			// Completed long enough ago to be archived.
+			{
+				Id:          "deployment-1",
+				Status:      model.DeploymentStatus_DEPLOYMENT_SUCCESS,
+				Stages:      []*model.PipelineStage{{Id: "stage-1"}},
+				CompletedAt: now.Add(-48 * time.Hour).Unix(),
+			},
+			// Still running.
+			{
+				Id:     "deployment-2",
+				Status: model.DeploymentStatus_DEPLOYMENT_RUNNING,
+				Stages: []*model.PipelineStage{{Id: "stage-1"}},
+			},
+			// Completed just now.
+			{
+				Id:          "deployment-3",
+				Status:      model.DeploymentStatus_DEPLOYMENT_FAILURE,
+				Stages:      []*model.PipelineStage{{Id: "stage-1"}},
+				CompletedAt: now.Unix(),
+			},
+			// Completed long enough ago to be compressed.
+			{
+				Id:          "deployment-4",
+				Status:      model.DeploymentStatus_DEPLOYMENT_SUCCESS,
+				Stages:      []*model.PipelineStage{{Id: "stage-1", RetriedCount: 1}, {Id: "stage-2"}},
+				CompletedAt: now.Add(-2 * time.Hour).Unix(),
+			},
+		},
+	}
+	exporter := &fakeExporter{}
+	a := &Archiver{
+		filestore:       fs,
+		deploymentStore: ds,
+		exporter:        exporter,
+		config:          config.ControlPlaneStageLogArchiver{ArchiveAfter: config.Duration(24 * time.Hour)},
+		nowFunc:         func() time.Time { return now },
+		logger:          zap.NewNop(),
+	}
+	require.NoError(t, a.archive(ctx))
+
+	assert.ElementsMatch(t, []string{
+		"log-archive/deployment-1/stage-1/0.txt.gz",
+		"log/deployment-2/stage-1/0.txt",
+		"log/deployment-3/stage-1/0.txt",
+		"log/deployment-4/stage-1/0.txt.gz",
+		"log/deployment-4/stage-1/1.txt.gz",
+	}, fs.paths())
+	assert.Equal(t, []string{
+		"deployment-1/stage-1/0: applied",
+		"deployment-4/stage-1/0: applied",
+		"deployment-4/stage-1/1: applied",
+	}, exporter.exported)
+	assert.Equal(t, milestone{
+		CompressedAt: now.Add(-completedGracePeriod).Unix(),
+		ArchivedAt:   now.Add(-24 * time.Hour).Unix(),
+	}, readMilestone(t, fs))
+
+	// The deployments before the milestone are not processed again.
+	fs.objects["log/deployment-4/stage-1/0.txt"] = []byte(completedLog)
+	require.NoError(t, a.archive(ctx))
+	assert.Contains(t, fs.paths(), "log/deployment-4/stage-1/0.txt")
+	assert.Len(t, exporter.exported, 3)
+}
+
+func TestArchiverArchive_ExportFailed(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	fs := &fakeFileStore{
+		objects: map[string][]byte{
+			"log/deployment-1/stage-1/0.txt": []byte(completedLog),
+			"log/deployment-2/stage-1/0.txt": []byte(completedLog),
+			"log/deployment-3/stage-1/0.txt": []byte(completedLog),
+		},
+	}
+	ds := &fakeDeploymentStore{
+		deployments: []*model.Deployment{
+			{
+				Id:          "deployment-1",
+				Status:      model.DeploymentStatus_DEPLOYMENT_SUCCESS,
+				Stages:      []*model.PipelineStage{{Id: "stage-1"}},
+				CompletedAt: now.Add(-4 * time.Hour).Unix(),
+			},
+			{
+				Id:          "deployment-2",
+				Status:      model.DeploymentStatus_DEPLOYMENT_SUCCESS,
+				Stages:      []*model.PipelineStage{{Id: "stage-1"}},
+				CompletedAt: now.Add(-3 * time.Hour).Unix(),
+			},
+			{
+				Id:          "deployment-3",
+				Status:      model.DeploymentStatus_DEPLOYMENT_SUCCESS,
+				Stages:      []*model.PipelineStage{{Id: "stage-1"}},
+				CompletedAt: now.Add(-2 * time.Hour).Unix(),
+			},
+		},
+	}
+	exporter := &fakeExporter{failedDeploymentID: "deployment-2"}
+	a := &Archiver{
+		filestore:       fs,
+		deploymentStore: ds,
+		exporter:        exporter,
+		config:          config.ControlPlaneStageLogArchiver{ArchiveAfter: config.Duration(24 * time.Hour)},
+		nowFunc:         func() time.Time { return now },
+		logger:          zap.NewNop(),
+	}
+	require.NoError(t, a.archive(ctx))
+
+	// The stage logs are kept uncompressed from the failed deployment to retry exporting at the next run.
+	assert.ElementsMatch(t, []string{
+		"log/deployment-1/stage-1/0.txt.gz",
+		"log/deployment-2/stage-1/0.txt",
+		"log/deployment-3/stage-1/0.txt",
+	}, fs.paths())
+	assert.Equal(t, ds.deployments[1].CompletedAt-1, readMilestone(t, fs).CompressedAt)
+
+	exporter.failedDeploymentID = ""
+	require.NoError(t, a.archive(ctx))
+	assert.ElementsMatch(t, []string{
+		"log/deployment-1/stage-1/0.txt.gz",
+		"log/deployment-2/stage-1/0.txt.gz",
+		"log/deployment-3/stage-1/0.txt.gz",
+	}, fs.paths())
+	assert.Equal(t, []string{
+		"deployment-1/stage-1/0: applied",
+		"deployment-2/stage-1/0: applied",
+		"deployment-3/stage-1/0: applied",
+	}, exporter.exported)
+}
+
+func TestArchiverArchive_Pagination(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	fs := &fakeFileStore{objects: map[string][]byte{}}
+	ds := &fakeDeploymentStore{}
+	expected := make([]string, 0, 2*listLimit+1)
+	for i := 0; i < 2*listLimit+1; i++ {
+		id := fmt.Sprintf("deployment-%d", i)
+		fs.objects[fmt.Sprintf("log/%s/stage-1/0.txt", id)] = []byte(completedLog)
+		ds.deployments = append(ds.deployments, &model.Deployment{
+			Id:     id,
+			Status: model.DeploymentStatus_DEPLOYMENT_SUCCESS,
+			Stages: []*model.PipelineStage{{Id: "stage-1"}},
+			// Some deployments are completed at the same time.
+			CompletedAt: now.Add(-2*time.Hour).Unix() + int64(i/2),
+		})
+		expected = append(expected, fmt.Sprintf("log/%s/stage-1/0.txt.gz", id))
+	}
+	a := &Archiver{
+		filestore:       fs,
+		deploymentStore: ds,
+		config:          config.ControlPlaneStageLogArchiver{ArchiveAfter: config.Duration(24 * time.Hour)},
+		nowFunc:         func() time.Time { return now },
+		logger:          zap.NewNop(),
+	}
+	require.NoError(t, a.archive(ctx))
+
+	assert.ElementsMatch(t, expected, fs.paths())
+}
+
+func readMilestone(t *testing.T, fs *fakeFileStore) milestone {
+	data, ok := fs.objects[milestoneFilePath]
+	require.True(t, ok)
+	var m milestone
+	require.NoError(t, json.Unmarshal(data, &m))
+	return m
+}
@@ -0,0 +1,279 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stagelogarchiver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// Exporter sends the stage logs to an external sink.
+type Exporter interface {
+	Export(ctx context.Context, d *model.Deployment, stageID string, retriedCount int32, blocks []*model.LogBlock) error
+}
+
+// NewExporter returns the exporter for the given configuration.
+func NewExporter(cfg *config.StageLogExporter) (Exporter, error) {
+	s := &sender{
+		url:     cfg.URL,
+		headers: cfg.Headers,
+		client:  &http.Client{Timeout: cfg.Timeout.Duration()},
+	}
+	switch cfg.Type {
+	case config.StageLogExporterOTLP:
+		return &otlpExporter{sender: s}, nil
+	case config.StageLogExporterLoki:
+		return &lokiExporter{sender: s}, nil
+	default:
+		return nil, fmt.Errorf("unsupported stage log exporter type %q", cfg.Type)
+	}
+}
+
+// sender posts JSON payloads to the sink.
+type sender struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func (s *sender) send(ctx context.Context, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(msg))
+	}
+	return nil
+}
+
+// otlpExporter sends the stage logs to an OTLP/HTTP logs endpoint in the JSON encoding.
+type otlpExporter struct {
+	sender *sender
+}
+
+type otlpLogsPayload struct {
+	ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+}
+
+type otlpResourceLogs struct {
+	Resource  otlpResource    `json:"resource"`
+	ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeLogs struct {
+	Scope      otlpScope       `json:"scope"`
+	LogRecords []otlpLogRecord `json:"logRecords"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano   string         `json:"timeUnixNano"`
+	SeverityNumber int            `json:"severityNumber"`
+	SeverityText   string         `json:"severityText"`
+	Body           otlpAnyValue   `json:"body"`
+	Attributes     []otlpKeyValue `json:"attributes"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+func (e *otlpExporter) Export(ctx context.Context, d *model.Deployment, stageID string, retriedCount int32, blocks []*model.LogBlock) error {
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	records := make([]otlpLogRecord, 0, len(blocks))
+	for _, b := range blocks {
+		attrs := []otlpKeyValue{
+			otlpAttribute("pipecd.stage.id", stageID),
+			otlpAttribute("pipecd.stage.name", stageName(d, stageID)),
+			otlpAttribute("pipecd.stage.retried_count", strconv.Itoa(int(retriedCount))),
+		}
+		for _, k := range slices.Sorted(maps.Keys(b.Fields)) {
+			attrs = append(attrs, otlpAttribute(k, b.Fields[k]))
+		}
+		records = append(records, otlpLogRecord{
+			TimeUnixNano:   strconv.FormatInt(time.Unix(b.CreatedAt, 0).UnixNano(), 10),
+			SeverityNumber: otlpSeverityNumber(b.Severity),
+			SeverityText:   b.Severity.String(),
+			Body:           otlpAnyValue{StringValue: b.Log},
+			Attributes:     attrs,
+		})
+	}
+
+	payload := otlpLogsPayload{
+		ResourceLogs: []otlpResourceLogs{
+			{
+				Resource: otlpResource{
+					Attributes: []otlpKeyValue{
+						otlpAttribute("service.name", "pipecd"),
+						otlpAttribute("pipecd.project.id", d.ProjectId),
+						otlpAttribute("pipecd.application.id", d.ApplicationId),
+						otlpAttribute("pipecd.application.name", d.ApplicationName),
+						otlpAttribute("pipecd.deployment.id", d.Id),
+						otlpAttribute("pipecd.piped.id", d.PipedId),
+					},
+				},
+				ScopeLogs: []otlpScopeLogs{
+					{
+						Scope:      otlpScope{Name: "pipecd.stagelog"},
+						LogRecords: records,
+					},
+				},
+			},
+		},
+	}
+	return e.sender.send(ctx, payload)
+}
+
+func otlpAttribute(key, value string) otlpKeyValue {
+	return otlpKeyValue{Key: key, Value: otlpAnyValue{StringValue: value}}
+}
+
+// otlpSeverityNumber maps the log severity to the OpenTelemetry severity number.
+// https://opentelemetry.io/docs/specs/otel/logs/data-model/#field-severitynumber
+func otlpSeverityNumber(s model.LogSeverity) int {
+	switch s {
+	case model.LogSeverity_DEBUG:
+		return 5
+	case model.LogSeverity_WARN:
+		return 13
+	case model.LogSeverity_ERROR:
+		return 17
+	default:
+		return 9
+	}
+}
+
+// lokiExporter sends the stage logs to the Loki push API.
+// Only the values with bounded cardinality, such as the application and the severity, are used as stream labels.
+// The IDs of the deployment and the stage and the fields are appended to the log line in logfmt,
+// so that they can be extracted by the logfmt parser of LogQL without creating a stream per deployment.
+type lokiExporter struct {
+	sender *sender
+}
+
+type lokiPushPayload struct {
+	Streams []lokiStream `json:"streams"`
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+func (e *lokiExporter) Export(ctx context.Context, d *model.Deployment, stageID string, retriedCount int32, blocks []*model.LogBlock) error {
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	streams := make(map[model.LogSeverity]*lokiStream)
+	for _, b := range blocks {
+		s, ok := streams[b.Severity]
+		if !ok {
+			s = &lokiStream{
+				Stream: map[string]string{
+					"service_name":     "pipecd",
+					"project_id":       d.ProjectId,
+					"application_id":   d.ApplicationId,
+					"application_name": d.ApplicationName,
+					"stage_name":       stageName(d, stageID),
+					"severity":         b.Severity.String(),
+				},
+			}
+			streams[b.Severity] = s
+		}
+		var line strings.Builder
+		line.WriteString(b.Log)
+		writeLogfmtPair(&line, "deployment_id", d.Id)
+		writeLogfmtPair(&line, "stage_id", stageID)
+		writeLogfmtPair(&line, "retried_count", strconv.Itoa(int(retriedCount)))
+		for _, k := range slices.Sorted(maps.Keys(b.Fields)) {
+			writeLogfmtPair(&line, k, b.Fields[k])
+		}
+		s.Values = append(s.Values, [2]string{
+			strconv.FormatInt(time.Unix(b.CreatedAt, 0).UnixNano(), 10),
+			line.String(),
+		})
+	}
+
+	payload := lokiPushPayload{
+		Streams: make([]lokiStream, 0, len(streams)),
+	}
+	for _, severity := range slices.Sorted(maps.Keys(streams)) {
+		payload.Streams = append(payload.Streams, *streams[severity])
+	}
+	return e.sender.send(ctx, payload)
+}
+
+// writeLogfmtPair appends the given key and value in logfmt.
+// The value is quoted when it is empty or contains spaces, quotes or equal signs.
+func writeLogfmtPair(b *strings.Builder, key, value string) {
+	if value == "" || strings.ContainsAny(value, " \t\n\"=") {
+		value = strconv.Quote(value)
+	}
+	fmt.Fprintf(b, " %s=%s", key, value)
+}
+
+func stageName(d *model.Deployment, stageID string) string {
+	for _, s := range d.Stages {
+		if s.Id == stageID {
+			return s.Name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,140 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stagelogarchiver
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestExporters(t *testing.T) {
+	d := &model.Deployment{
+		Id:              "deployment-1",
+		ApplicationId:   "app-1",
+		ApplicationName: "simple",
+		ProjectId:       "project-1",
+		PipedId:         "piped-1",
+		Stages: []*model.PipelineStage{
+			{Id: "stage-1", Name: "K8S_SYNC"},
+		},
+	}
+	blocks := []*model.LogBlock{
+		{Index: 1, Log: "applying", Severity: model.LogSeverity_INFO, CreatedAt: 100},
+		{Index: 2, Log: "connection refused", Severity: model.LogSeverity_ERROR, CreatedAt: 101, Fields: map[string]string{"host": "db", "attempt": "1", "reason": "dial tcp"}},
+	}
+
+	testcases := []struct {
+		name         string
+		exporterType config.StageLogExporterType
+		expected     string
+	}{
+		{
+			name:         "otlp",
+			exporterType: config.StageLogExporterOTLP,
+			expected: `{"resourceLogs":[{
+				"resource":{"attributes":[
+					{"key":"service.name","value":{"stringValue":"pipecd"}},
+					{"key":"pipecd.project.id","value":{"stringValue":"project-1"}},
+					{"key":"pipecd.application.id","value":{"stringValue":"app-1"}},
+					{"key":"pipecd.application.name","value":{"stringValue":"simple"}},
+					{"key":"pipecd.deployment.id","value":{"stringValue":"deployment-1"}},
+					{"key":"pipecd.piped.id","value":{"stringValue":"piped-1"}}
+				]},
+				"scopeLogs":[{"scope":{"name":"pipecd.stagelog"},"logRecords":[
+					{"timeUnixNano":"100000000000","severityNumber":9,"severityText":"INFO","body":{"stringValue":"applying"},"attributes":[
+						{"key":"pipecd.stage.id","value":{"stringValue":"stage-1"}},
+						{"key":"pipecd.stage.name","value":{"stringValue":"K8S_SYNC"}},
+						{"key":"pipecd.stage.retried_count","value":{"stringValue":"1"}}
+					]},
+					{"timeUnixNano":"101000000000","severityNumber":17,"severityText":"ERROR","body":{"stringValue":"connection refused"},"attributes":[
+						{"key":"pipecd.stage.id","value":{"stringValue":"stage-1"}},
+						{"key":"pipecd.stage.name","value":{"stringValue":"K8S_SYNC"}},
+						{"key":"pipecd.stage.retried_count","value":{"stringValue":"1"}},
+						{"key":"attempt","value":{"stringValue":"1"}},
+						{"key":"host","value":{"stringValue":"db"}},
+						{"key":"reason","value":{"stringValue":"dial tcp"}}
+					]}
+				]}]
+			}]}`,
+		},
+		{
+			name:         "loki",
+			exporterType: config.StageLogExporterLoki,
+			expected: `{"streams":[
+				{"stream":{"service_name":"pipecd","project_id":"project-1","application_id":"app-1","application_name":"simple","stage_name":"K8S_SYNC","severity":"INFO"},
+				 "values":[["100000000000","applying deployment_id=deployment-1 stage_id=stage-1 retried_count=1"]]},
+				{"stream":{"service_name":"pipecd","project_id":"project-1","application_id":"app-1","application_name":"simple","stage_name":"K8S_SYNC","severity":"ERROR"},
+				 "values":[["101000000000","connection refused deployment_id=deployment-1 stage_id=stage-1 retried_count=1 attempt=1 host=db reason=\"dial tcp\""]]}
+			]}`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				body   []byte
+				header http.Header
+			)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				header = r.Header
+				body, _ = io.ReadAll(r.Body)
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			e, err := NewExporter(&config.StageLogExporter{
+				Type:    tc.exporterType,
+				URL:     server.URL,
+				Headers: map[string]string{"X-Scope-OrgID": "pipecd"},
+			})
+			require.NoError(t, err)
+			require.NoError(t, e.Export(context.Background(), d, "stage-1", 1, blocks))
+
+			assert.Equal(t, "application/json", header.Get("Content-Type"))
+			assert.Equal(t, "pipecd", header.Get("X-Scope-OrgID"))
+			assert.JSONEq(t, tc.expected, string(body))
+		})
+	}
+}
+
+func TestExporter_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	e, err := NewExporter(&config.StageLogExporter{Type: config.StageLogExporterLoki, URL: server.URL})
+	require.NoError(t, err)
+
+	err = e.Export(context.Background(), &model.Deployment{Id: "deployment-1"}, "stage-1", 0, []*model.LogBlock{{Index: 1, Log: "log"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid payload")
+
+	// Nothing is sent when there is no log block.
+	require.NoError(t, e.Export(context.Background(), &model.Deployment{Id: "deployment-1"}, "stage-1", 0, nil))
+}
+
+func TestNewExporter_Unsupported(t *testing.T) {
+	_, err := NewExporter(&config.StageLogExporter{Type: "SPLUNK", URL: "http://splunk"})
+	assert.Error(t, err)
+}
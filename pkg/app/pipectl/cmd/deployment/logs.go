@@ -17,20 +17,35 @@ package deployment
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"os"
+	"slices"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
 	"github.com/pipe-cd/pipecd/pkg/cli"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	colorReset  = "\033[0m"
+	colorRed    = "\033[31m"
+	colorGreen  = "\033[32m"
+	colorYellow = "\033[33m"
 )
 
 type logs struct {
 	root *command
 
 	deploymentID string
+	stageID      string
+	follow       bool
+	noColor      bool
 	stdout       io.Writer
 }
 
@@ -46,6 +61,9 @@ func newLogsCommand(root *command) *cobra.Command {
 	}
 
 	cmd.Flags().StringVar(&c.deploymentID, "deployment-id", c.deploymentID, "The deployment ID.")
+	cmd.Flags().StringVar(&c.stageID, "stage", c.stageID, "The stage ID to get logs. Logs of all stages are returned if not specified.")
+	cmd.Flags().BoolVar(&c.follow, "follow", c.follow, "Whether to stream logs in a human readable format until the deployment completes.")
+	cmd.Flags().BoolVar(&c.noColor, "no-color", c.noColor, "Whether to disable coloring logs by severity while following.")
 
 	cmd.MarkFlagRequired("deployment-id")
 
@@ -59,6 +77,10 @@ func (c *logs) run(ctx context.Context, input cli.Input) error {
 	}
 	defer cli.Close()
 
+	if c.follow {
+		return c.followLogs(ctx, cli)
+	}
+
 	req := &apiservice.ListStageLogsRequest{
 		DeploymentId: c.deploymentID,
 	}
@@ -68,6 +90,14 @@ func (c *logs) run(ctx context.Context, input cli.Input) error {
 		return fmt.Errorf("failed to get stage log: %w", err)
 	}
 
+	if c.stageID != "" {
+		stageLogs := map[string]*apiservice.StageLog{}
+		if sl, ok := resp.StageLogs[c.stageID]; ok {
+			stageLogs[c.stageID] = sl
+		}
+		resp.StageLogs = stageLogs
+	}
+
 	bytes, err := json.Marshal(resp)
 	if err != nil {
 		return fmt.Errorf("failed to marshal stage log: %w", err)
@@ -76,3 +106,63 @@ func (c *logs) run(ctx context.Context, input cli.Input) error {
 	fmt.Fprintln(c.stdout, string(bytes))
 	return nil
 }
+
+func (c *logs) followLogs(ctx context.Context, cli apiservice.Client) error {
+	stream, err := cli.StreamStageLogs(ctx, &apiservice.StreamStageLogsRequest{
+		DeploymentId: c.deploymentID,
+		StageId:      c.stageID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stream stage logs: %w", err)
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to stream stage logs: %w", err)
+		}
+		for _, b := range resp.Blocks {
+			fmt.Fprintln(c.stdout, formatLogBlock(resp.StageName, b, !c.noColor))
+		}
+	}
+
+	// The stream is closed once the deployment completed.
+	resp, err := cli.GetDeployment(ctx, &apiservice.GetDeploymentRequest{
+		DeploymentId: c.deploymentID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get deployment: %w", err)
+	}
+
+	status := resp.Deployment.Status
+	fmt.Fprintf(c.stdout, "Deployment %s completed with status %s\n", c.deploymentID, status)
+	if status != model.DeploymentStatus_DEPLOYMENT_SUCCESS {
+		return fmt.Errorf("deployment %s was not successful: %s", c.deploymentID, status)
+	}
+	return nil
+}
+
+// formatLogBlock returns a human readable line of the given log block.
+func formatLogBlock(stageName string, b *model.LogBlock, colored bool) string {
+	line := fmt.Sprintf("[%s] %s %s", stageName, time.Unix(b.CreatedAt, 0).UTC().Format(time.RFC3339), b.Log)
+	for _, k := range slices.Sorted(maps.Keys(b.Fields)) {
+		line += fmt.Sprintf(" %s=%s", k, b.Fields[k])
+	}
+	if !colored {
+		return line
+	}
+
+	switch b.Severity {
+	case model.LogSeverity_ERROR:
+		return colorRed + line + colorReset
+	case model.LogSeverity_SUCCESS:
+		return colorGreen + line + colorReset
+	case model.LogSeverity_WARN:
+		return colorYellow + line + colorReset
+	default:
+		return line
+	}
+}
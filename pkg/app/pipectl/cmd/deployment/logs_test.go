@@ -0,0 +1,79 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestFormatLogBlock(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		block    *model.LogBlock
+		colored  bool
+		expected string
+	}{
+		{
+			name:     "info log is not colored",
+			block:    &model.LogBlock{Log: "applying", Severity: model.LogSeverity_INFO, CreatedAt: 1700000000},
+			colored:  true,
+			expected: "[K8S_SYNC] 2023-11-14T22:13:20Z applying",
+		},
+		{
+			name:     "error log is colored red",
+			block:    &model.LogBlock{Log: "failed", Severity: model.LogSeverity_ERROR, CreatedAt: 1700000000},
+			colored:  true,
+			expected: "\033[31m[K8S_SYNC] 2023-11-14T22:13:20Z failed\033[0m",
+		},
+		{
+			name:     "success log is colored green",
+			block:    &model.LogBlock{Log: "done", Severity: model.LogSeverity_SUCCESS, CreatedAt: 1700000000},
+			colored:  true,
+			expected: "\033[32m[K8S_SYNC] 2023-11-14T22:13:20Z done\033[0m",
+		},
+		{
+			name:     "warn log is colored yellow",
+			block:    &model.LogBlock{Log: "retrying", Severity: model.LogSeverity_WARN, CreatedAt: 1700000000},
+			colored:  true,
+			expected: "\033[33m[K8S_SYNC] 2023-11-14T22:13:20Z retrying\033[0m",
+		},
+		{
+			name:     "fields are appended in key order",
+			block:    &model.LogBlock{Log: "applied", Severity: model.LogSeverity_INFO, CreatedAt: 1700000000, Fields: map[string]string{"namespace": "default", "kind": "Deployment"}},
+			colored:  true,
+			expected: "[K8S_SYNC] 2023-11-14T22:13:20Z applied kind=Deployment namespace=default",
+		},
+		{
+			name:     "coloring disabled",
+			block:    &model.LogBlock{Log: "failed", Severity: model.LogSeverity_ERROR, CreatedAt: 1700000000},
+			expected: "[K8S_SYNC] 2023-11-14T22:13:20Z failed",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := formatLogBlock("K8S_SYNC", tc.block, tc.colored)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -38,6 +38,10 @@ type command struct {
 	inputFile      string
 	base64Encoding bool
 
+	reencrypt       bool
+	appConfigFile   string
+	privateKeyFiles []string
+
 	stdout io.Writer
 }
 
@@ -51,14 +55,20 @@ func NewCommand() *cobra.Command {
 		Short: "Encrypt the plaintext entered in either stdin or the --input-file flag.",
 		Example: `  pipectl encrypt --piped-id=xxx --api-key=yyy --address=foo.xz <secret.txt
   cat secret.txt | pipectl encrypt --piped-id=xxxxt --api-key=yyy --address=foo.xz
-  pipectl encrypt --input-file=secret.txt --piped-id=xxxxt --api-key=yyy --address=foo.xz`,
+  pipectl encrypt --input-file=secret.txt --piped-id=xxxxt --api-key=yyy --address=foo.xz
+  pipectl encrypt --reencrypt --app-config=app.pipecd.yaml --private-key-file=old-private-key --piped-id=xxx --api-key=yyy --address=foo.xz`,
 		RunE: cli.WithContext(c.run),
 	}
 
 	cmd.Flags().StringVar(&c.pipedID, "piped-id", c.pipedID, "The id of Piped to which the application using the ciphertext belongs.")
 	cmd.Flags().StringVar(&c.inputFile, "input-file", c.inputFile, "The path to the file to be encrypted.")
 	cmd.Flags().BoolVar(&c.base64Encoding, "use-base64-encoding", c.base64Encoding, "Whether the plaintext should be base64 encoded before encrypting or not. (default false)")
+	cmd.Flags().BoolVar(&c.reencrypt, "reencrypt", c.reencrypt, "Whether to re-encrypt all encrypted secrets in the application config specified by --app-config with the current public key of the piped.")
+	cmd.Flags().StringVar(&c.appConfigFile, "app-config", c.appConfigFile, "The path to the application config file whose encrypted secrets should be re-encrypted. Used with --reencrypt.")
+	cmd.Flags().StringSliceVar(&c.privateKeyFiles, "private-key-file", c.privateKeyFiles, "The path to the private key file used to decrypt the current encrypted secrets. Can be specified multiple times. Used with --reencrypt.")
 	cmd.MarkFlagRequired("piped-id")
+	cmd.MarkFlagsRequiredTogether("reencrypt", "app-config", "private-key-file")
+	cmd.MarkFlagsMutuallyExclusive("reencrypt", "input-file")
 
 	c.clientOptions.RegisterPersistentFlags(cmd)
 
@@ -72,6 +82,19 @@ func (c *command) run(ctx context.Context, input cli.Input) error {
 	}
 	defer cli.Close()
 
+	if c.reencrypt {
+		return c.reencryptAppConfig(ctx, func(ctx context.Context, plaintext string) (string, error) {
+			resp, err := cli.Encrypt(ctx, &apiservice.EncryptRequest{
+				PipedId:   c.pipedID,
+				Plaintext: plaintext,
+			})
+			if err != nil {
+				return "", err
+			}
+			return resp.Ciphertext, nil
+		})
+	}
+
 	// Prioritize the file passed via the "--input-file" flag.
 	var source io.Reader
 	if c.inputFile != "" {
@@ -0,0 +1,121 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encrypt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/pipe-cd/pipecd/pkg/crypto"
+	"github.com/pipe-cd/pipecd/pkg/yamlprocessor"
+)
+
+const encryptedSecretsPath = "$.spec.encryption.encryptedSecrets"
+
+// reencryptAppConfig rewrites all encrypted secrets in the given application config file
+// so that they are sealed by the current public key of the piped.
+func (c *command) reencryptAppConfig(ctx context.Context, encrypt func(ctx context.Context, plaintext string) (string, error)) error {
+	keys := make([][]byte, 0, len(c.privateKeyFiles))
+	for _, f := range c.privateKeyFiles {
+		key, err := os.ReadFile(f)
+		if err != nil {
+			return fmt.Errorf("failed to read private key file %q: %w", f, err)
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return errors.New("at least one private key file must be specified to re-encrypt")
+	}
+	decrypter, err := crypto.NewHybridDecrypter(keys[0], keys[1:]...)
+	if err != nil {
+		return fmt.Errorf("failed to initialize decrypter: %w", err)
+	}
+
+	data, err := os.ReadFile(c.appConfigFile)
+	if err != nil {
+		return fmt.Errorf("failed to read application config file %q: %w", c.appConfigFile, err)
+	}
+
+	out, names, err := reencryptSecrets(ctx, data, decrypter, encrypt)
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		fmt.Fprintf(c.stdout, "no encrypted secret was found in %s\n", c.appConfigFile)
+		return nil
+	}
+
+	info, err := os.Stat(c.appConfigFile)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(c.appConfigFile, out, info.Mode()); err != nil {
+		return fmt.Errorf("failed to write application config file %q: %w", c.appConfigFile, err)
+	}
+
+	for _, name := range names {
+		fmt.Fprintf(c.stdout, "re-encrypted %s\n", name)
+	}
+	return nil
+}
+
+// reencryptSecrets decrypts every value placed under spec.encryption.encryptedSecrets
+// and replaces it with the ciphertext returned by the given encrypt function.
+// It returns the rewritten data and the sorted names of the re-encrypted secrets.
+func reencryptSecrets(ctx context.Context, data []byte, decrypter crypto.Decrypter, encrypt func(ctx context.Context, plaintext string) (string, error)) ([]byte, []string, error) {
+	p, err := yamlprocessor.NewProcessor(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse application config: %w", err)
+	}
+
+	v, err := p.GetValue(encryptedSecretsPath)
+	if err != nil {
+		// The application config does not contain any encrypted secret.
+		return data, nil, nil
+	}
+	secrets, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, nil, fmt.Errorf("unexpected type of spec.encryption.encryptedSecrets: %T", v)
+	}
+
+	names := make([]string, 0, len(secrets))
+	for name := range secrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		ciphertext, ok := secrets[name].(string)
+		if !ok {
+			return nil, nil, fmt.Errorf("encrypted secret %s must be a string", name)
+		}
+		plaintext, err := decrypter.Decrypt(ciphertext)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decrypt secret %s: %w", name, err)
+		}
+		newCiphertext, err := encrypt(ctx, plaintext)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to encrypt secret %s: %w", name, err)
+		}
+		if err := p.ReplaceString(encryptedSecretsPath+"."+name, newCiphertext); err != nil {
+			return nil, nil, fmt.Errorf("failed to replace secret %s: %w", name, err)
+		}
+	}
+
+	return p.Bytes(), names, nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encrypt
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDecrypter map[string]string
+
+func (d fakeDecrypter) Decrypt(text string) (string, error) {
+	if v, ok := d[text]; ok {
+		return v, nil
+	}
+	return "", errors.New("unable to decrypt")
+}
+
+func TestReencryptSecrets(t *testing.T) {
+	t.Parallel()
+
+	decrypter := fakeDecrypter{
+		"old-password": "password",
+		"old-token":    "token",
+	}
+	encrypt := func(_ context.Context, plaintext string) (string, error) {
+		return "new-" + plaintext, nil
+	}
+
+	testcases := []struct {
+		name          string
+		data          string
+		expected      string
+		expectedNames []string
+		wantErr       bool
+	}{
+		{
+			name: "re-encrypt all secrets",
+			data: `apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  encryption:
+    encryptedSecrets:
+      # The database password.
+      password: old-password
+      token: old-token
+    decryptionTargets:
+      - secret.yaml
+`,
+			expected: `apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  encryption:
+    encryptedSecrets:
+      # The database password.
+      password: new-password
+      token: new-token
+    decryptionTargets:
+      - secret.yaml
+`,
+			expectedNames: []string{"password", "token"},
+		},
+		{
+			name: "no encrypted secrets",
+			data: `apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  name: foo
+`,
+			expected: `apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  name: foo
+`,
+			expectedNames: nil,
+		},
+		{
+			name: "unable to decrypt",
+			data: `apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  encryption:
+    encryptedSecrets:
+      password: unknown
+`,
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			out, names, err := reencryptSecrets(context.Background(), []byte(tc.data), decrypter, encrypt)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedNames, names)
+			assert.Equal(t, tc.expected, string(out))
+		})
+	}
+}
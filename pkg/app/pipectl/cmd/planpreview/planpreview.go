@@ -240,6 +240,7 @@ func convert(results []*model.PlanPreviewCommandResult) ReadableResult {
 				PlanDetails:       string(a.PlanDetails),
 				NoChange:          a.NoChange,
 				PluginPlanResults: a.PluginPlanResults,
+				PolicyViolations:  toPolicyViolations(a.PolicyViolations),
 			})
 		}
 	}
@@ -263,6 +264,13 @@ type ApplicationResult struct {
 	NoChange    bool
 
 	PluginPlanResults []*model.PluginPlanPreviewResult
+	PolicyViolations  []PolicyViolation
+}
+
+type PolicyViolation struct {
+	Rule    string
+	Message string
+	Action  string // DENY, REQUIRE_APPROVAL
 }
 
 type FailurePiped struct {
@@ -310,6 +318,31 @@ func toPlannedPluginNames(pluginPlanResults []*model.PluginPlanPreviewResult) st
 	return strings.Join(keys, ", ")
 }
 
+func toPolicyViolations(violations []*model.PolicyViolation) []PolicyViolation {
+	if len(violations) == 0 {
+		return nil
+	}
+	out := make([]PolicyViolation, 0, len(violations))
+	for _, v := range violations {
+		out = append(out, PolicyViolation{
+			Rule:    v.Rule,
+			Message: v.Message,
+			Action:  v.Action.String(),
+		})
+	}
+	return out
+}
+
+func writePolicyViolations(b *strings.Builder, violations []PolicyViolation) {
+	if len(violations) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "  policy violations:\n")
+	for _, v := range violations {
+		fmt.Fprintf(b, "  - %s(%s): %s\n", v.Rule, v.Action, v.Message)
+	}
+}
+
 func (r ReadableResult) String() string {
 	var b strings.Builder
 	if len(r.Applications)+len(r.FailureApplications)+len(r.FailurePipeds) == 0 {
@@ -333,6 +366,7 @@ func (r ReadableResult) String() string {
 				b.WriteString(title)
 				fmt.Fprintf(&b, "  sync strategy: %s\n", app.SyncStrategy)
 				fmt.Fprintf(&b, "  summary: %s\n", app.PlanSummary)
+				writePolicyViolations(&b, app.PolicyViolations)
 				fmt.Fprintf(&b, "  details:\n\n  ---DETAILS_BEGIN---\n%s\n  ---DETAILS_END---\n", app.PlanDetails)
 			} else {
 				title := fmt.Sprintf("\n%d. app: %s, env: %s, plan plugin(s): %s\n", i+1, app.ApplicationName, app.Env, app.PlannedPluginNames)
@@ -347,6 +381,7 @@ func (r ReadableResult) String() string {
 				for _, ppr := range app.PluginPlanResults {
 					fmt.Fprintf(&b, "  - %s(%s): %s\n", ppr.PluginName, ppr.DeployTarget, ppr.PlanSummary)
 				}
+				writePolicyViolations(&b, app.PolicyViolations)
 				fmt.Fprint(&b, "  details:\n\n")
 				for _, ppr := range app.PluginPlanResults {
 					fmt.Fprintf(&b, "  - %s(%s):\n", ppr.PluginName, ppr.DeployTarget)
@@ -66,6 +66,48 @@ Here are plan-preview for 1 application:
   summary: 2 manifests will be added, 1 manifest will be deleted and 5 manifests will be changed
   details:
 
+  ---DETAILS_BEGIN---
+changes-1
+  ---DETAILS_END---
+`,
+		},
+		{
+			name: "there is an application violating policies",
+			results: []*model.PlanPreviewCommandResult{
+				{
+					CommandId: "command-2",
+					PipedId:   "piped-2",
+					PipedUrl:  "https://pipecd.dev/piped-2",
+					Results: []*model.ApplicationPlanPreviewResult{
+						{
+							ApplicationId:   "app-1",
+							ApplicationName: "app-1",
+							ApplicationUrl:  "https://pipecd.dev/app-1",
+							ApplicationKind: model.ApplicationKind_TERRAFORM,
+							SyncStrategy:    model.SyncStrategy_QUICK_SYNC,
+							PlanSummary:     []byte("0 to import, 0 to add, 0 to change, 1 to destroy"),
+							PlanDetails:     []byte("changes-1"),
+							PolicyViolations: []*model.PolicyViolation{
+								{
+									Rule:    "no-rds-destroy",
+									Message: "RDS instances must not be destroyed",
+									Action:  model.PolicyViolation_DENY,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: `
+Here are plan-preview for 1 application:
+
+1. app: app-1, kind: TERRAFORM
+  sync strategy: QUICK_SYNC
+  summary: 0 to import, 0 to add, 0 to change, 1 to destroy
+  policy violations:
+  - no-rds-destroy(DENY): RDS instances must not be destroyed
+  details:
+
   ---DETAILS_BEGIN---
 changes-1
   ---DETAILS_END---
@@ -0,0 +1,84 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/cli"
+)
+
+type add struct {
+	root *command
+
+	username     string
+	password     string
+	passwordFile string
+	role         string
+	stdout       io.Writer
+}
+
+func newAddCommand(root *command) *cobra.Command {
+	c := &add{
+		root:   root,
+		stdout: os.Stdout,
+	}
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Add a new static user.",
+		RunE:  cli.WithContext(c.run),
+	}
+
+	cmd.Flags().StringVar(&c.username, "username", c.username, "The name of the user.")
+	cmd.Flags().StringVar(&c.password, "password", c.password, "The initial password of the user.")
+	cmd.Flags().StringVar(&c.passwordFile, "password-file", c.passwordFile, "Path to the file containing the initial password of the user.")
+	cmd.Flags().StringVar(&c.role, "role", c.role, "The RBAC role assigned to the user. e.g. Admin, Editor, Viewer or a custom role.")
+
+	cmd.MarkFlagRequired("username")
+	cmd.MarkFlagRequired("role")
+
+	return cmd
+}
+
+func (c *add) run(ctx context.Context, _ cli.Input) error {
+	password, err := readPassword(c.password, c.passwordFile)
+	if err != nil {
+		return err
+	}
+
+	cli, err := c.root.clientOptions.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to initialize client: %w", err)
+	}
+	defer cli.Close()
+
+	req := &apiservice.AddStaticUserRequest{
+		Username: c.username,
+		Password: password,
+		Role:     c.role,
+	}
+	if _, err := cli.AddStaticUser(ctx, req); err != nil {
+		return fmt.Errorf("failed to add static user: %w", err)
+	}
+
+	fmt.Fprintf(c.stdout, "Successfully added static user %s\n", c.username)
+	return nil
+}
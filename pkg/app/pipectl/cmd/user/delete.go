@@ -0,0 +1,69 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/cli"
+)
+
+type delete struct {
+	root *command
+
+	username string
+	stdout   io.Writer
+}
+
+func newDeleteCommand(root *command) *cobra.Command {
+	c := &delete{
+		root:   root,
+		stdout: os.Stdout,
+	}
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a static user.",
+		RunE:  cli.WithContext(c.run),
+	}
+
+	cmd.Flags().StringVar(&c.username, "username", c.username, "The name of the user.")
+	cmd.MarkFlagRequired("username")
+
+	return cmd
+}
+
+func (c *delete) run(ctx context.Context, _ cli.Input) error {
+	cli, err := c.root.clientOptions.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to initialize client: %w", err)
+	}
+	defer cli.Close()
+
+	req := &apiservice.DeleteStaticUserRequest{
+		Username: c.username,
+	}
+	if _, err := cli.DeleteStaticUser(ctx, req); err != nil {
+		return fmt.Errorf("failed to delete static user: %w", err)
+	}
+
+	fmt.Fprintf(c.stdout, "Successfully deleted static user %s\n", c.username)
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/cli"
+)
+
+type list struct {
+	root *command
+
+	stdout io.Writer
+}
+
+func newListCommand(root *command) *cobra.Command {
+	c := &list{
+		root:   root,
+		stdout: os.Stdout,
+	}
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Show the list of static users.",
+		RunE:  cli.WithContext(c.run),
+	}
+
+	return cmd
+}
+
+func (c *list) run(ctx context.Context, _ cli.Input) error {
+	cli, err := c.root.clientOptions.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to initialize client: %w", err)
+	}
+	defer cli.Close()
+
+	resp, err := cli.ListStaticUsers(ctx, &apiservice.ListStaticUsersRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to list static users: %w", err)
+	}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		return fmt.Errorf("failed to marshal static users: %w", err)
+	}
+
+	fmt.Fprintln(c.stdout, string(bytes))
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/cli"
+)
+
+type resetPassword struct {
+	root *command
+
+	username     string
+	password     string
+	passwordFile string
+	resetTOTP    bool
+	stdout       io.Writer
+}
+
+func newResetPasswordCommand(root *command) *cobra.Command {
+	c := &resetPassword{
+		root:   root,
+		stdout: os.Stdout,
+	}
+	cmd := &cobra.Command{
+		Use:   "reset-password",
+		Short: "Set a new password for a static user.",
+		RunE:  cli.WithContext(c.run),
+	}
+
+	cmd.Flags().StringVar(&c.username, "username", c.username, "The name of the user.")
+	cmd.Flags().StringVar(&c.password, "password", c.password, "The new password of the user.")
+	cmd.Flags().StringVar(&c.passwordFile, "password-file", c.passwordFile, "Path to the file containing the new password of the user.")
+	cmd.Flags().BoolVar(&c.resetTOTP, "reset-totp", c.resetTOTP, "Whether to disable the two-factor authentication of the user as well.")
+
+	cmd.MarkFlagRequired("username")
+
+	return cmd
+}
+
+func (c *resetPassword) run(ctx context.Context, _ cli.Input) error {
+	password, err := readPassword(c.password, c.passwordFile)
+	if err != nil {
+		return err
+	}
+
+	cli, err := c.root.clientOptions.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to initialize client: %w", err)
+	}
+	defer cli.Close()
+
+	req := &apiservice.ResetStaticUserPasswordRequest{
+		Username:  c.username,
+		Password:  password,
+		ResetTotp: c.resetTOTP,
+	}
+	if _, err := cli.ResetStaticUserPassword(ctx, req); err != nil {
+		return fmt.Errorf("failed to reset password of static user: %w", err)
+	}
+
+	fmt.Fprintf(c.stdout, "Successfully reset the password of static user %s\n", c.username)
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/cli"
+)
+
+type updateRole struct {
+	root *command
+
+	username string
+	role     string
+	stdout   io.Writer
+}
+
+func newUpdateRoleCommand(root *command) *cobra.Command {
+	c := &updateRole{
+		root:   root,
+		stdout: os.Stdout,
+	}
+	cmd := &cobra.Command{
+		Use:   "update-role",
+		Short: "Change the RBAC role of a static user.",
+		RunE:  cli.WithContext(c.run),
+	}
+
+	cmd.Flags().StringVar(&c.username, "username", c.username, "The name of the user.")
+	cmd.Flags().StringVar(&c.role, "role", c.role, "The new RBAC role assigned to the user.")
+
+	cmd.MarkFlagRequired("username")
+	cmd.MarkFlagRequired("role")
+
+	return cmd
+}
+
+func (c *updateRole) run(ctx context.Context, _ cli.Input) error {
+	cli, err := c.root.clientOptions.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to initialize client: %w", err)
+	}
+	defer cli.Close()
+
+	req := &apiservice.UpdateStaticUserRoleRequest{
+		Username: c.username,
+		Role:     c.role,
+	}
+	if _, err := cli.UpdateStaticUserRole(ctx, req); err != nil {
+		return fmt.Errorf("failed to update role of static user: %w", err)
+	}
+
+	fmt.Fprintf(c.stdout, "Successfully updated the role of static user %s to %s\n", c.username, c.role)
+	return nil
+}
@@ -754,7 +754,11 @@ func (p *piped) initializeSecretDecrypter(cfg *config.PipedSpec) (crypto.Decrypt
 		if err != nil {
 			return nil, err
 		}
-		decrypter, err := crypto.NewHybridDecrypter(key)
+		previousKeys, err := sm.KeyPair.LoadPreviousPrivateKeys()
+		if err != nil {
+			return nil, err
+		}
+		decrypter, err := crypto.NewHybridDecrypter(key, previousKeys...)
 		if err != nil {
 			return nil, fmt.Errorf("failed to initialize decrypter (%w)", err)
 		}
@@ -36,6 +36,8 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/piped/controller/controllermetrics"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/logpersister"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/policy"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/sourceprocesser"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
 	"github.com/pipe-cd/pipecd/pkg/cache"
 	"github.com/pipe-cd/pipecd/pkg/config"
@@ -118,6 +120,8 @@ type controller struct {
 	pipedConfig         *config.PipedSpec
 	appManifestsCache   cache.Cache
 	logPersister        logpersister.Persister
+	policyLoader        *policy.Loader
+	secretProvider      sourceprocesser.SecretProvider
 
 	// Map from application ID to the planner
 	// of a pending deployment of that application.
@@ -136,6 +140,11 @@ type controller struct {
 	// Map from application ID to its most recently successful commit hash.
 	mostRecentlySuccessfulCommits         map[string]string
 	mostRecentlySuccessfulConfigFilenames map[string]string
+	// Map from application ID to the digest of its most recently deployed OCI artifact.
+	mostRecentlySuccessfulArtifactDigests map[string]string
+	// Map from deployment ID to the secret provider caching the secrets resolved for that deployment.
+	// It is shared by the planner and the scheduler so that both see the same values.
+	deploymentSecretProviders map[string]sourceprocesser.SecretProvider
 	// WaitGroup for waiting the completions of all planners, schedulers.
 	wg sync.WaitGroup
 
@@ -184,13 +193,17 @@ func NewController(
 		appManifestsCache:   appManifestsCache,
 		pipedConfig:         pipedConfig,
 		logPersister:        lp,
+		policyLoader:        policy.NewLoader(gitClient, pipedConfig, lg),
+		secretProvider:      sourceprocesser.NewSecretProvider(pipedConfig.SecretProviders),
 
 		planners:                              make(map[string]*planner),
 		donePlanners:                          make(map[string]time.Time),
 		schedulers:                            make(map[string]*scheduler),
 		doneSchedulers:                        make(map[string]time.Time),
 		mostRecentlySuccessfulCommits:         make(map[string]string),
+		mostRecentlySuccessfulArtifactDigests: make(map[string]string),
 		mostRecentlySuccessfulConfigFilenames: make(map[string]string),
+		deploymentSecretProviders:             make(map[string]sourceprocesser.SecretProvider),
 
 		workingDirRemovalCh: make(chan string),
 
@@ -238,6 +251,9 @@ func (c *controller) Run(ctx context.Context) error {
 		}
 	}()
 
+	// Start refreshing the policies enforced by planners in the background.
+	go c.policyLoader.Run(ctx)
+
 	ticker := time.NewTicker(c.syncInternal)
 	defer ticker.Stop()
 	c.logger.Info("start syncing planners and schedulers")
@@ -336,6 +352,9 @@ func (c *controller) syncPlanners(ctx context.Context) {
 		)
 		c.donePlanners[p.ID()] = p.DoneTimestamp()
 		delete(c.planners, id)
+		if p.DoneDeploymentStatus().IsCompleted() {
+			delete(c.deploymentSecretProviders, p.ID())
+		}
 
 		// Application will be marked as NOT deploying when planner's deployment was completed.
 		if p.DoneDeploymentStatus().IsCompleted() {
@@ -478,6 +497,7 @@ func (c *controller) startNewPlanner(ctx context.Context, d *model.Deployment) (
 	var (
 		commitHash     = c.mostRecentlySuccessfulCommits[d.ApplicationId]
 		configFilename = c.mostRecentlySuccessfulConfigFilenames[d.ApplicationId]
+		artifactDigest = c.mostRecentlySuccessfulArtifactDigests[d.ApplicationId]
 	)
 	if commitHash == "" {
 		dref, err := c.getMostRecentlySuccessfulDeployment(ctx, d.ApplicationId)
@@ -485,8 +505,10 @@ func (c *controller) startNewPlanner(ctx context.Context, d *model.Deployment) (
 		case err == nil:
 			commitHash = dref.Trigger.Commit.Hash
 			configFilename = dref.ConfigFilename
+			artifactDigest = dref.Trigger.ArtifactDigest
 			c.mostRecentlySuccessfulCommits[d.ApplicationId] = commitHash
 			c.mostRecentlySuccessfulConfigFilenames[d.ApplicationId] = configFilename
+			c.mostRecentlySuccessfulArtifactDigests[d.ApplicationId] = artifactDigest
 
 		case status.Code(err) == codes.NotFound:
 			logger.Info("there is no previous successful commit for this application")
@@ -500,6 +522,7 @@ func (c *controller) startNewPlanner(ctx context.Context, d *model.Deployment) (
 		d,
 		commitHash,
 		configFilename,
+		artifactDigest,
 		workingDir,
 		c.apiClient,
 		c.gitClient,
@@ -507,6 +530,8 @@ func (c *controller) startNewPlanner(ctx context.Context, d *model.Deployment) (
 		c.secretDecrypter,
 		c.pipedConfig,
 		c.appManifestsCache,
+		c.policyLoader,
+		c.getDeploymentSecretProvider(d.Id),
 		c.logger,
 		c.tracerProvider,
 	)
@@ -542,6 +567,7 @@ func (c *controller) syncSchedulers(ctx context.Context) {
 		}
 		c.mostRecentlySuccessfulCommits[id] = s.CommitHash()
 		c.mostRecentlySuccessfulConfigFilenames[id] = s.ConfigFilename()
+		c.mostRecentlySuccessfulArtifactDigests[id] = s.ArtifactDigest()
 	}
 
 	// Remove done schedulers.
@@ -562,6 +588,7 @@ func (c *controller) syncSchedulers(ctx context.Context) {
 		)
 		c.doneSchedulers[s.ID()] = s.DoneTimestamp()
 		delete(c.schedulers, id)
+		delete(c.deploymentSecretProviders, s.ID())
 
 		// Application will be marked as NOT deploying when scheduler's deployment was completed.
 		if s.DoneDeploymentStatus().IsCompleted() {
@@ -648,6 +675,7 @@ func (c *controller) startNewScheduler(ctx context.Context, d *model.Deployment)
 		c.logPersister,
 		c.notifier,
 		c.secretDecrypter,
+		c.getDeploymentSecretProvider(d.Id),
 		c.pipedConfig,
 		c.appManifestsCache,
 		c.logger,
@@ -672,6 +700,19 @@ func (c *controller) startNewScheduler(ctx context.Context, d *model.Deployment)
 	return scheduler, nil
 }
 
+// getDeploymentSecretProvider returns the secret provider caching the secrets resolved for the given deployment.
+// It returns nil when no secret provider was configured.
+func (c *controller) getDeploymentSecretProvider(deploymentID string) sourceprocesser.SecretProvider {
+	if sp, ok := c.deploymentSecretProviders[deploymentID]; ok {
+		return sp
+	}
+	sp := sourceprocesser.NewCachingSecretProvider(c.secretProvider)
+	if sp != nil {
+		c.deploymentSecretProviders[deploymentID] = sp
+	}
+	return sp
+}
+
 func (c *controller) getMostRecentlySuccessfulDeployment(ctx context.Context, applicationID string) (*model.ApplicationDeploymentReference, error) {
 	var (
 		err   error
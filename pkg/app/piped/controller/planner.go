@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"path/filepath"
 	"time"
 
@@ -32,6 +33,8 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/piped/metadatastore"
 	pln "github.com/pipe-cd/pipecd/pkg/app/piped/planner"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/planner/registry"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/policy"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/sourceprocesser"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
 	"github.com/pipe-cd/pipecd/pkg/cache"
 	"github.com/pipe-cd/pipecd/pkg/config"
@@ -50,6 +53,7 @@ type planner struct {
 	deployment                   *model.Deployment
 	lastSuccessfulCommitHash     string
 	lastSuccessfulConfigFilename string
+	lastSuccessfulArtifactDigest string
 	workingDir                   string
 	apiClient                    apiClient
 	gitClient                    gitClient
@@ -59,6 +63,8 @@ type planner struct {
 	plannerRegistry              registry.Registry
 	pipedConfig                  *config.PipedSpec
 	appManifestsCache            cache.Cache
+	policyLoader                 *policy.Loader
+	secretProvider               sourceprocesser.SecretProvider
 	logger                       *zap.Logger
 	tracer                       trace.Tracer
 
@@ -75,6 +81,7 @@ func newPlanner(
 	d *model.Deployment,
 	lastSuccessfulCommitHash string,
 	lastSuccessfulConfigFilename string,
+	lastSuccessfulArtifactDigest string,
 	workingDir string,
 	apiClient apiClient,
 	gitClient gitClient,
@@ -82,6 +89,8 @@ func newPlanner(
 	sd secretDecrypter,
 	pipedConfig *config.PipedSpec,
 	appManifestsCache cache.Cache,
+	policyLoader *policy.Loader,
+	secretProvider sourceprocesser.SecretProvider,
 	logger *zap.Logger,
 	tracerProvider trace.TracerProvider,
 ) *planner {
@@ -98,6 +107,7 @@ func newPlanner(
 		deployment:                   d,
 		lastSuccessfulCommitHash:     lastSuccessfulCommitHash,
 		lastSuccessfulConfigFilename: lastSuccessfulConfigFilename,
+		lastSuccessfulArtifactDigest: lastSuccessfulArtifactDigest,
 		workingDir:                   workingDir,
 		apiClient:                    apiClient,
 		gitClient:                    gitClient,
@@ -107,6 +117,8 @@ func newPlanner(
 		pipedConfig:                  pipedConfig,
 		plannerRegistry:              registry.DefaultRegistry(),
 		appManifestsCache:            appManifestsCache,
+		policyLoader:                 policyLoader,
+		secretProvider:               secretProvider,
 		doneDeploymentStatus:         d.Status,
 		cancelledCh:                  make(chan *model.ReportableCommand, 1),
 		nowFunc:                      time.Now,
@@ -185,11 +197,15 @@ func (p *planner) Run(ctx context.Context) error {
 		Logger:                         p.logger,
 	}
 
+	ociClient := deploysource.NewOCIClient(p.pipedConfig.OCIRegistries)
+
 	in.TargetDSP = deploysource.NewProvider(
 		filepath.Join(p.workingDir, "target-deploysource"),
 		deploysource.NewGitSourceCloner(p.gitClient, repoCfg, "target", p.deployment.Trigger.Commit.Hash),
 		*p.deployment.GitPath,
 		p.secretDecrypter,
+		deploysource.WithOCIArtifact(ociClient, p.deployment.Trigger.ArtifactDigest),
+		deploysource.WithSecretProvider(p.secretProvider),
 	)
 
 	if p.lastSuccessfulCommitHash != "" {
@@ -201,6 +217,8 @@ func (p *planner) Run(ctx context.Context) error {
 			deploysource.NewGitSourceCloner(p.gitClient, repoCfg, "running", p.lastSuccessfulCommitHash),
 			gp,
 			p.secretDecrypter,
+			deploysource.WithOCIArtifact(ociClient, p.lastSuccessfulArtifactDigest),
+			deploysource.WithSecretProvider(p.secretProvider),
 		)
 	}
 
@@ -215,6 +233,13 @@ func (p *planner) Run(ctx context.Context) error {
 		return fmt.Errorf("unable to find the planner for application %v", p.deployment.Kind)
 	}
 
+	// The deployment may have been waiting in the queue until the application entered a deployment freeze window.
+	if reason, frozen := p.checkDeploymentFreeze(ctx, in.TargetDSP); frozen {
+		p.doneDeploymentStatus = model.DeploymentStatus_DEPLOYMENT_FAILURE
+		span.SetStatus(codes.Error, reason)
+		return p.reportDeploymentFailed(ctx, reason)
+	}
+
 	out, err := planner.Plan(ctx, in)
 
 	// If the deployment was already cancelled, we ignore the plan result.
@@ -236,11 +261,72 @@ func (p *planner) Run(ctx context.Context) error {
 		return p.reportDeploymentFailed(ctx, fmt.Sprintf("Unable to plan the deployment (%v)", err))
 	}
 
+	if reason, denied := p.enforcePolicies(ctx, &out); denied {
+		p.doneDeploymentStatus = model.DeploymentStatus_DEPLOYMENT_FAILURE
+		span.SetStatus(codes.Error, reason)
+		return p.reportDeploymentFailed(ctx, reason)
+	}
+
 	span.SetStatus(codes.Ok, "The deployment has been planned")
 	p.doneDeploymentStatus = model.DeploymentStatus_DEPLOYMENT_PLANNED
 	return p.reportDeploymentPlanned(ctx, out)
 }
 
+// checkDeploymentFreeze reports whether the deployment is inside one of the deployment freeze windows
+// configured in the application configuration and was not bypassed by a privileged user.
+func (p *planner) checkDeploymentFreeze(ctx context.Context, dsp deploysource.Provider) (string, bool) {
+	if p.deployment.DeploymentFreezeBypassJustification() != "" {
+		return "", false
+	}
+	// Failures while preparing the deploy source are reported by the planner of each application kind.
+	ds, err := dsp.Get(ctx, io.Discard)
+	if err != nil {
+		return "", false
+	}
+	w, err := ds.GenericApplicationConfig.FindActiveDeploymentFreezeWindow(p.nowFunc())
+	if err != nil {
+		return fmt.Sprintf("Unable to check deployment freeze windows (%v)", err), true
+	}
+	if w == nil {
+		return "", false
+	}
+	return fmt.Sprintf("Deployment was rejected because the application is frozen. %s", w.Reason()), true
+}
+
+// enforcePolicies evaluates the policies configured in piped against the planned deployment.
+// The deployment is rejected when any rule with DENY action was violated,
+// otherwise a stage waiting for an approval is added at the beginning of the pipeline
+// when any rule with REQUIRE_APPROVAL action was violated.
+func (p *planner) enforcePolicies(ctx context.Context, out *pln.Output) (string, bool) {
+	evaluator, err := p.policyLoader.Load(ctx)
+	if err != nil {
+		return fmt.Sprintf("Unable to load the policies (%v)", err), true
+	}
+
+	violations := evaluator.Evaluate(policy.Input{
+		ApplicationName: p.deployment.ApplicationName,
+		ApplicationKind: p.deployment.Kind,
+		Labels:          p.deployment.Labels,
+		Manifests:       out.Manifests,
+		SyncStrategy:    out.SyncStrategy,
+		Stages:          out.Stages,
+	})
+	if len(violations) == 0 {
+		return "", false
+	}
+	if policy.Denied(violations) {
+		return fmt.Sprintf("Deployment was rejected because of the policy violations. %s", policy.Describe(violations)), true
+	}
+
+	rules := make([]string, 0, len(violations))
+	for _, v := range violations {
+		rules = append(rules, v.Rule)
+	}
+	p.logger.Info("the deployment requires an approval because of the policy violations", zap.Strings("rules", rules))
+	out.Stages = pln.AddPolicyApprovalStage(out.Stages, rules, p.nowFunc())
+	return "", false
+}
+
 func (p *planner) reportDeploymentPlanned(ctx context.Context, out pln.Output) error {
 	var (
 		err   error
@@ -251,6 +337,7 @@ func (p *planner) reportDeploymentPlanned(ctx context.Context, out pln.Output) e
 			StatusReason:              "The deployment has been planned",
 			RunningCommitHash:         p.lastSuccessfulCommitHash,
 			RunningConfigFilename:     p.lastSuccessfulConfigFilename,
+			RunningArtifactDigest:     p.lastSuccessfulArtifactDigest,
 			Version:                   out.Version,
 			Versions:                  out.Versions,
 			Stages:                    out.Stages,
@@ -35,12 +35,16 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/piped/logpersister"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/metadatastore"
 	pln "github.com/pipe-cd/pipecd/pkg/app/piped/planner"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/sourceprocesser"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
 	"github.com/pipe-cd/pipecd/pkg/cache"
 	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
+// retryStageCommandCheckInterval is the interval to check whether a RETRY_STAGE command was issued.
+const retryStageCommandCheckInterval = 5 * time.Second
+
 // scheduler is a dedicated object for a specific deployment of a single application.
 type scheduler struct {
 	// Readonly deployment model.
@@ -57,6 +61,7 @@ type scheduler struct {
 	metadataStore       metadatastore.MetadataStore
 	notifier            notifier
 	secretDecrypter     secretDecrypter
+	secretProvider      sourceprocesser.SecretProvider
 	pipedConfig         *config.PipedSpec
 	appManifestsCache   cache.Cache
 	logger              *zap.Logger
@@ -72,6 +77,8 @@ type scheduler struct {
 	// when the stages can be executed concurrently.
 	stageStatuses            map[string]model.StageStatus
 	genericApplicationConfig config.GenericApplicationSpec
+	// The number of times each stage has been retried.
+	stageRetriedCounts map[string]int32
 
 	done                 atomic.Bool
 	doneTimestamp        time.Time
@@ -94,6 +101,7 @@ func newScheduler(
 	lp logpersister.Persister,
 	notifier notifier,
 	sd secretDecrypter,
+	sp sourceprocesser.SecretProvider,
 	pipedConfig *config.PipedSpec,
 	appManifestsCache cache.Cache,
 	logger *zap.Logger,
@@ -121,6 +129,7 @@ func newScheduler(
 		metadataStore:        metadatastore.NewMetadataStore(apiClient, d),
 		notifier:             notifier,
 		secretDecrypter:      sd,
+		secretProvider:       sp,
 		pipedConfig:          pipedConfig,
 		appManifestsCache:    appManifestsCache,
 		doneDeploymentStatus: d.Status,
@@ -132,8 +141,10 @@ func newScheduler(
 
 	// Initialize the map of current status of all stages.
 	s.stageStatuses = make(map[string]model.StageStatus, len(d.Stages))
+	s.stageRetriedCounts = make(map[string]int32, len(d.Stages))
 	for _, stage := range d.Stages {
 		s.stageStatuses[stage.Id] = stage.Status
+		s.stageRetriedCounts[stage.Id] = stage.RetriedCount
 	}
 
 	return s
@@ -150,6 +161,11 @@ func (s *scheduler) CommitHash() string {
 	return s.deployment.CommitHash()
 }
 
+// ArtifactDigest returns the digest of the OCI artifact deployed by the deployment.
+func (s *scheduler) ArtifactDigest() string {
+	return s.deployment.Trigger.ArtifactDigest
+}
+
 // ConfigFilename returns the config filename of the deployment.
 func (s *scheduler) ConfigFilename() string {
 	return s.deployment.GitPath.GetApplicationConfigFilename()
@@ -247,11 +263,15 @@ func (s *scheduler) Run(ctx context.Context) error {
 		Branch: s.deployment.GitPath.Repo.Branch,
 	}
 
+	ociClient := deploysource.NewOCIClient(s.pipedConfig.OCIRegistries)
+
 	s.targetDSP = deploysource.NewProvider(
 		filepath.Join(s.workingDir, "target-deploysource"),
 		deploysource.NewGitSourceCloner(s.gitClient, repoCfg, "target", s.deployment.Trigger.Commit.Hash),
 		*s.deployment.GitPath,
 		s.secretDecrypter,
+		deploysource.WithOCIArtifact(ociClient, s.deployment.Trigger.ArtifactDigest),
+		deploysource.WithSecretProvider(s.secretProvider),
 	)
 
 	if s.deployment.RunningCommitHash != "" {
@@ -263,6 +283,8 @@ func (s *scheduler) Run(ctx context.Context) error {
 			deploysource.NewGitSourceCloner(s.gitClient, repoCfg, "running", s.deployment.RunningCommitHash),
 			gp,
 			s.secretDecrypter,
+			deploysource.WithOCIArtifact(ociClient, s.deployment.RunningArtifactDigest),
+			deploysource.WithSecretProvider(s.secretProvider),
 		)
 	}
 
@@ -275,6 +297,7 @@ func (s *scheduler) Run(ctx context.Context) error {
 		deploysource.NewGitSourceCloner(s.gitClient, repoCfg, "target", s.deployment.Trigger.Commit.Hash),
 		*s.deployment.GitPath,
 		nil,
+		deploysource.WithOCIArtifact(ociClient, s.deployment.Trigger.ArtifactDigest),
 	)
 	ds, err := configDSP.GetReadOnly(ctx, io.Discard)
 	if err != nil {
@@ -331,56 +354,86 @@ func (s *scheduler) Run(ctx context.Context) error {
 		}
 
 		var (
-			result       model.StageStatus
-			sig, handler = executor.NewStopSignal()
-			doneCh       = make(chan struct{})
+			result model.StageStatus
+			sig    executor.StopSignal
 		)
 
-		go func() {
-			_, span := s.tracer.Start(ctx, ps.Name, trace.WithAttributes(
-				attribute.String("application-id", s.deployment.ApplicationId),
-				attribute.String("kind", s.deployment.Kind.String()),
-				attribute.String("deployment-id", s.deployment.Id),
-				attribute.String("stage-id", ps.Id),
-			))
-			defer span.End()
+		// Execute the stage and retry it in place while its retry options allow.
+		for {
+			var (
+				handler executor.StopSignalHandler
+				doneCh  = make(chan struct{})
+			)
+			sig, handler = executor.NewStopSignal()
 
-			s.notifyStageStartEvent(ps)
+			go func() {
+				_, span := s.tracer.Start(ctx, ps.Name, trace.WithAttributes(
+					attribute.String("application-id", s.deployment.ApplicationId),
+					attribute.String("kind", s.deployment.Kind.String()),
+					attribute.String("deployment-id", s.deployment.Id),
+					attribute.String("stage-id", ps.Id),
+				))
+				defer span.End()
 
-			result = s.executeStage(sig, *ps, func(in executor.Input) (executor.Executor, bool) {
-				return s.executorRegistry.Executor(model.Stage(ps.Name), in)
-			})
+				s.notifyStageStartEvent(ps)
 
-			s.notifyStageEndEvent(ps, result)
+				result = s.executeStage(sig, *ps, func(in executor.Input) (executor.Executor, bool) {
+					return s.executorRegistry.Executor(model.Stage(ps.Name), in)
+				})
 
-			switch result {
-			case model.StageStatus_STAGE_SUCCESS:
-				span.SetStatus(codes.Ok, statusReason)
-			case model.StageStatus_STAGE_FAILURE, model.StageStatus_STAGE_CANCELLED:
-				span.SetStatus(codes.Error, statusReason)
-			}
+				s.notifyStageEndEvent(ps, result)
 
-			close(doneCh)
-		}()
+				switch result {
+				case model.StageStatus_STAGE_SUCCESS:
+					span.SetStatus(codes.Ok, statusReason)
+				case model.StageStatus_STAGE_FAILURE, model.StageStatus_STAGE_CANCELLED:
+					span.SetStatus(codes.Error, statusReason)
+				}
 
-		select {
-		case <-ctx.Done():
-			handler.Terminate()
-			<-doneCh
+				close(doneCh)
+			}()
 
-		case <-timer.C:
-			handler.Timeout()
-			<-doneCh
+			select {
+			case <-ctx.Done():
+				handler.Terminate()
+				<-doneCh
+
+			case <-timer.C:
+				handler.Timeout()
+				<-doneCh
 
-		case cmd := <-s.cancelledCh:
+			case cmd := <-s.cancelledCh:
+				if cmd != nil {
+					cancelCommand = cmd
+					cancelCommander = cmd.Commander
+					handler.Cancel()
+					<-doneCh
+				}
+
+			case <-doneCh:
+				break
+			}
+
+			// Only the stages failed by themselves are retried.
+			if result != model.StageStatus_STAGE_FAILURE || sig.Terminated() || sig.Signal() == executor.StopSignalTimeout {
+				break
+			}
+
+			retry, cmd := s.waitStageRetry(ctx, timer, ps)
 			if cmd != nil {
 				cancelCommand = cmd
 				cancelCommander = cmd.Commander
-				handler.Cancel()
-				<-doneCh
+				result = model.StageStatus_STAGE_CANCELLED
+			}
+			if retry {
+				s.stageRetriedCounts[ps.Id]++
+				continue
+			}
+			// The piped was terminated while waiting to retry the failed stage.
+			if cmd == nil && ctx.Err() != nil {
+				s.logger.Info("stop scheduler because of temination signal", zap.String("stage-id", ps.Id))
+				return nil
 			}
-
-		case <-doneCh:
 			break
 		}
 
@@ -506,8 +559,9 @@ func (s *scheduler) executeStage(sig executor.StopSignal, ps model.PipelineStage
 	var (
 		ctx            = sig.Context()
 		originalStatus = ps.Status
-		lp             = s.logPersister.StageLogPersister(s.deployment.Id, ps.Id)
+		lp             = s.logPersister.StageLogPersister(s.deployment.Id, ps.Id, s.stageRetriedCounts[ps.Id])
 	)
+	ps.RetriedCount = s.stageRetriedCounts[ps.Id]
 	defer func() {
 		// When the piped has been terminated (PS kill) while the stage is still running
 		// we should not mark the log persister as completed.
@@ -598,6 +652,7 @@ func (s *scheduler) executeStage(sig executor.StopSignal, ps model.PipelineStage
 		PipedConfig:           s.pipedConfig,
 		TargetDSP:             s.targetDSP,
 		RunningDSP:            s.runningDSP,
+		WorkingDir:            s.workingDir,
 		GitClient:             s.gitClient,
 		CommandLister:         cmdLister,
 		LogPersister:          lp,
@@ -660,6 +715,87 @@ func (s *scheduler) executeStage(sig executor.StopSignal, ps model.PipelineStage
 	return originalStatus
 }
 
+// waitStageRetry waits until the given failed stage should be executed again.
+// The stage is retried automatically after a backoff while its retry limit is not reached,
+// and then it waits for a RETRY_STAGE command from users if a manual retry timeout was configured.
+// The returned command is not nil when the deployment was cancelled during the wait.
+func (s *scheduler) waitStageRetry(ctx context.Context, timer *time.Timer, ps *model.PipelineStage) (bool, *model.ReportableCommand) {
+	// The predefined stages are not configurable so they are never retried.
+	if ps.Predefined {
+		return false, nil
+	}
+	stageConfig, ok := s.genericApplicationConfig.GetStage(ps.Index)
+	if !ok {
+		return false, nil
+	}
+
+	var (
+		opts         = stageConfig.Retries
+		retriedCount = s.stageRetriedCounts[ps.Id]
+		automatic    = int(retriedCount) < opts.Limit
+		wait         time.Duration
+	)
+	switch {
+	case automatic:
+		wait = opts.BackoffFor(retriedCount)
+		s.logger.Info(fmt.Sprintf("stage %s failed, it will be retried in %v", ps.Id, wait),
+			zap.Int32("retried-count", retriedCount),
+		)
+	case opts.ManualRetryTimeout > 0:
+		wait = opts.ManualRetryTimeout.Duration()
+		s.logger.Info(fmt.Sprintf("stage %s failed, waiting for a retry command up to %v", ps.Id, wait),
+			zap.Int32("retried-count", retriedCount),
+		)
+	default:
+		return false, nil
+	}
+
+	waitTimer := time.NewTimer(wait)
+	defer waitTimer.Stop()
+
+	ticker := time.NewTicker(retryStageCommandCheckInterval)
+	defer ticker.Stop()
+
+	cancelledCh := s.cancelledCh
+	for {
+		select {
+		case <-ctx.Done():
+			return false, nil
+
+		case <-timer.C:
+			return false, nil
+
+		case cmd := <-cancelledCh:
+			if cmd != nil {
+				return false, cmd
+			}
+			cancelledCh = nil
+
+		case <-waitTimer.C:
+			return automatic, nil
+
+		case <-ticker.C:
+			// A RETRY_STAGE command triggers the retry right away without waiting for the rest of backoff.
+			if cmd, ok := s.findRetryStageCommand(ps.Id); ok {
+				if err := cmd.Report(ctx, model.CommandStatus_COMMAND_SUCCEEDED, nil, nil); err != nil {
+					s.logger.Error("failed to report command status", zap.Error(err))
+				}
+				s.logger.Info(fmt.Sprintf("stage %s will be retried by %s", ps.Id, cmd.Commander))
+				return true, nil
+			}
+		}
+	}
+}
+
+func (s *scheduler) findRetryStageCommand(stageID string) (model.ReportableCommand, bool) {
+	for _, cmd := range s.commandLister.ListStageCommands(s.deployment.Id, stageID) {
+		if cmd.GetRetryStage() != nil {
+			return cmd, true
+		}
+	}
+	return model.ReportableCommand{}, false
+}
+
 func (s *scheduler) reportStageStatus(ctx context.Context, stageID string, status model.StageStatus, requires []string) error {
 	var (
 		err error
@@ -670,6 +806,7 @@ func (s *scheduler) reportStageStatus(ctx context.Context, stageID string, statu
 			Status:       status,
 			Requires:     requires,
 			Visible:      true,
+			RetriedCount: s.stageRetriedCounts[stageID],
 			CompletedAt:  now.Unix(),
 		}
 		retry = pipedservice.NewRetry(10)
@@ -32,6 +32,7 @@ type DeploySource struct {
 	RepoDir                  string
 	AppDir                   string
 	Revision                 string
+	ArtifactDigest           string
 	ApplicationConfig        *config.Config
 	GenericApplicationConfig config.GenericApplicationSpec
 }
@@ -53,6 +54,9 @@ type provider struct {
 	revision        string
 	appGitPath      model.ApplicationGitPath
 	secretDecrypter secretDecrypter
+	ociClient       OCIClient
+	artifactDigest  string
+	secretProvider  sourceprocesser.SecretProvider
 
 	done    bool
 	source  *DeploySource
@@ -61,14 +65,35 @@ type provider struct {
 	mu      sync.Mutex
 }
 
+type Option func(*provider)
+
+// WithOCIArtifact configures the provider to pull the application manifests
+// from the OCI artifact specified in the application configuration.
+// An empty digest means the artifact currently referenced by the configured URL.
+func WithOCIArtifact(client OCIClient, digest string) Option {
+	return func(p *provider) {
+		p.ociClient = client
+		p.artifactDigest = digest
+	}
+}
+
+// WithSecretProvider configures the provider to resolve the external secrets
+// referred in the application manifests by using the given secret provider.
+func WithSecretProvider(sp sourceprocesser.SecretProvider) Option {
+	return func(p *provider) {
+		p.secretProvider = sp
+	}
+}
+
 func NewProvider(
 	workingDir string,
 	cloner SourceCloner,
 	appGitPath model.ApplicationGitPath,
 	sd secretDecrypter,
+	opts ...Option,
 ) Provider {
 
-	return &provider{
+	p := &provider{
 		workingDir:      workingDir,
 		cloner:          cloner,
 		revisionName:    cloner.RevisionName(),
@@ -76,6 +101,10 @@ func NewProvider(
 		appGitPath:      appGitPath,
 		secretDecrypter: sd,
 	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
 func (p *provider) Revision() string {
@@ -171,7 +200,23 @@ func (p *provider) prepare(ctx context.Context, lw io.Writer) (*DeploySource, er
 	}
 	fmt.Fprintln(lw, "Successfully loaded the application configuration file")
 
+	// Pull the manifests from the OCI artifact if configured.
+	var artifactDigest string
+	if src := gac.OCISource(); src != nil {
+		digest, err := PullOCISource(ctx, p.ociClient, src, p.artifactDigest, appDir)
+		if err != nil {
+			fmt.Fprintf(lw, "Unable to pull the OCI artifact %s (%v)\n", src.URL, err)
+			return nil, err
+		}
+		artifactDigest = digest
+		fmt.Fprintf(lw, "Successfully pulled the OCI artifact %s (%s)\n", src.URL, digest)
+	}
+
 	var templProcessors []sourceprocesser.SourceTemplateProcessor
+	// Resolve the external secrets first so that the following processors see the rendered files.
+	if gac.ExternalSecrets != nil && p.secretProvider != nil {
+		templProcessors = append(templProcessors, sourceprocesser.NewSecretProviderProcessor(ctx, gac.ExternalSecrets, p.secretProvider))
+	}
 	// Decrypt the sealed secrets if needed.
 	if gac.Encryption != nil && p.secretDecrypter != nil {
 		templProcessors = append(templProcessors, sourceprocesser.NewSecretDecrypterProcessor(gac.Encryption, p.secretDecrypter))
@@ -195,6 +240,7 @@ func (p *provider) prepare(ctx context.Context, lw io.Writer) (*DeploySource, er
 		RepoDir:                  repoDir,
 		AppDir:                   appDir,
 		Revision:                 p.revision,
+		ArtifactDigest:           artifactDigest,
 		ApplicationConfig:        cfg,
 		GenericApplicationConfig: gac,
 	}, nil
@@ -215,6 +261,7 @@ func (p *provider) copy(lw io.Writer) (*DeploySource, error) {
 		RepoDir:                  dest,
 		AppDir:                   filepath.Join(dest, p.appGitPath.Path),
 		Revision:                 p.revision,
+		ArtifactDigest:           p.source.ArtifactDigest,
 		ApplicationConfig:        p.source.ApplicationConfig,
 		GenericApplicationConfig: p.source.GenericApplicationConfig,
 	}, nil
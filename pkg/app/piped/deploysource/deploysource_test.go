@@ -0,0 +1,138 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploysource
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeSourceCloner struct {
+	files map[string]string
+}
+
+func (c *fakeSourceCloner) Clone(_ context.Context, dest string) error {
+	for name, data := range c.files {
+		path := filepath.Join(dest, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *fakeSourceCloner) Revision() string     { return "commit-hash" }
+func (c *fakeSourceCloner) RevisionName() string { return "target" }
+
+type fakeOCIClient struct {
+	latest string
+	files  map[string]map[string]string
+}
+
+func (c *fakeOCIClient) Resolve(_ context.Context, _ string) (string, error) {
+	return c.latest, nil
+}
+
+func (c *fakeOCIClient) Pull(_ context.Context, _, digest, dest string) error {
+	files, ok := c.files[digest]
+	if !ok {
+		return errors.New("not found")
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dest, name), []byte(data), 0600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestProviderWithOCIArtifact(t *testing.T) {
+	t.Parallel()
+
+	const appConfig = `apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  source:
+    oci:
+      url: oci://localhost:5000/manifests/app:latest
+`
+	ociClient := &fakeOCIClient{
+		latest: "sha256:new",
+		files: map[string]map[string]string{
+			"sha256:old": {"deployment.yaml": "old"},
+			"sha256:new": {"deployment.yaml": "new"},
+		},
+	}
+
+	testcases := []struct {
+		name           string
+		digest         string
+		expectedDigest string
+		expectedData   string
+		wantErr        bool
+	}{
+		{
+			name:           "resolve the latest digest",
+			digest:         "",
+			expectedDigest: "sha256:new",
+			expectedData:   "new",
+		},
+		{
+			name:           "pull the given digest",
+			digest:         "sha256:old",
+			expectedDigest: "sha256:old",
+			expectedData:   "old",
+		},
+		{
+			name:    "unknown digest",
+			digest:  "sha256:unknown",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cloner := &fakeSourceCloner{
+				files: map[string]string{"app/app.pipecd.yaml": appConfig},
+			}
+			p := NewProvider(t.TempDir(), cloner, model.ApplicationGitPath{Path: "app", ConfigFilename: "app.pipecd.yaml"}, nil, WithOCIArtifact(ociClient, tc.digest))
+
+			ds, err := p.Get(context.Background(), &bytes.Buffer{})
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedDigest, ds.ArtifactDigest)
+
+			data, err := os.ReadFile(filepath.Join(ds.AppDir, "deployment.yaml"))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedData, string(data))
+		})
+	}
+}
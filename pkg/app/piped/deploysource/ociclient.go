@@ -0,0 +1,109 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploysource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/oci"
+)
+
+// OCIClient resolves and pulls OCI artifacts containing application manifests.
+type OCIClient interface {
+	// Resolve returns the digest of the artifact currently referenced by the given URL.
+	Resolve(ctx context.Context, url string) (string, error)
+	// Pull pulls the artifact with the given digest into the destination directory.
+	Pull(ctx context.Context, url, digest, dest string) error
+}
+
+type ociClient struct {
+	registries []config.PipedOCIRegistry
+}
+
+// NewOCIClient returns an OCIClient that authenticates to the registries
+// by using the given piped configuration.
+func NewOCIClient(registries []config.PipedOCIRegistry) OCIClient {
+	return &ociClient{
+		registries: registries,
+	}
+}
+
+func (c *ociClient) Resolve(ctx context.Context, url string) (string, error) {
+	return oci.ResolveDigest(ctx, url, c.options(url)...)
+}
+
+func (c *ociClient) Pull(ctx context.Context, url, digest, dest string) error {
+	if digest != "" {
+		u, err := oci.DigestURL(url, digest)
+		if err != nil {
+			return err
+		}
+		url = u
+	}
+	return oci.PullArtifactToDirectory(ctx, dest, url, c.options(url)...)
+}
+
+func (c *ociClient) options(url string) []oci.PullOption {
+	host := registryHost(url)
+	for _, r := range c.registries {
+		if r.Address != host {
+			continue
+		}
+		var opts []oci.PullOption
+		if r.Username != "" {
+			opts = append(opts, oci.WithUsername(r.Username))
+		}
+		if r.Password != "" {
+			opts = append(opts, oci.WithPassword(r.Password))
+		}
+		if r.Insecure {
+			opts = append(opts, oci.WithInsecure())
+		}
+		return opts
+	}
+	return nil
+}
+
+// registryHost returns the registry part of the given oci:// URL.
+func registryHost(url string) string {
+	host := strings.TrimPrefix(url, "oci://")
+	if i := strings.Index(host, "/"); i >= 0 {
+		host = host[:i]
+	}
+	return host
+}
+
+// PullOCISource pulls the OCI artifact of the given source into appDir.
+// If the digest is empty, the artifact currently referenced by the URL is used.
+// It returns the digest of the pulled artifact.
+func PullOCISource(ctx context.Context, client OCIClient, src *config.OCISource, digest, appDir string) (string, error) {
+	if client == nil {
+		return "", fmt.Errorf("no OCI client was configured to pull %s", src.URL)
+	}
+	if digest == "" {
+		d, err := client.Resolve(ctx, src.URL)
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve the digest of %s: %w", src.URL, err)
+		}
+		digest = d
+	}
+	if err := client.Pull(ctx, src.URL, digest, appDir); err != nil {
+		return "", fmt.Errorf("failed to pull %s@%s: %w", src.URL, digest, err)
+	}
+	return digest, nil
+}
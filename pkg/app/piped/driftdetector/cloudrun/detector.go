@@ -24,6 +24,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatestore/cloudrun"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/cloudrun"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/sourceprocesser"
@@ -65,6 +66,8 @@ type detector struct {
 	interval          time.Duration
 	config            *config.PipedSpec
 	secretDecrypter   secretDecrypter
+	ociClient         deploysource.OCIClient
+	secretProvider    sourceprocesser.SecretProvider
 	logger            *zap.Logger
 
 	gitRepos map[string]git.Repo
@@ -95,6 +98,8 @@ func NewDetector(
 		interval:          time.Minute,
 		config:            cfg,
 		secretDecrypter:   sd,
+		ociClient:         deploysource.NewOCIClient(cfg.OCIRegistries),
+		secretProvider:    sourceprocesser.NewSecretProvider(cfg.SecretProviders),
 		gitRepos:          make(map[string]git.Repo),
 		logger:            logger,
 	}
@@ -193,7 +198,7 @@ func (d *detector) listGroupedApplication() map[string][]*model.Application {
 }
 
 func (d *detector) checkApplication(ctx context.Context, app *model.Application, repo git.Repo, headCommit git.Commit) error {
-	headManifest, err := d.loadHeadServiceManifest(app, repo, headCommit)
+	headManifest, err := d.loadHeadServiceManifest(ctx, app, repo, headCommit)
 	if err != nil {
 		return err
 	}
@@ -222,7 +227,7 @@ func (d *detector) checkApplication(ctx context.Context, app *model.Application,
 	return d.reporter.ReportApplicationSyncState(ctx, app.Id, state)
 }
 
-func (d *detector) loadHeadServiceManifest(app *model.Application, repo git.Worktree, headCommit git.Commit) (provider.ServiceManifest, error) {
+func (d *detector) loadHeadServiceManifest(ctx context.Context, app *model.Application, repo git.Worktree, headCommit git.Commit) (provider.ServiceManifest, error) {
 	var (
 		manifestCache = provider.ServiceManifestCache{
 			AppID:  app.Id,
@@ -231,9 +236,11 @@ func (d *detector) loadHeadServiceManifest(app *model.Application, repo git.Work
 		}
 		repoDir = repo.GetPath()
 		appDir  = filepath.Join(repoDir, app.GitPath.Path)
+		// The manifests loaded from an OCI artifact also depend on its digest.
+		cacheKey = headCommit.Hash + app.MostRecentlySuccessfulDeployment.GetTrigger().GetArtifactDigest()
 	)
 
-	manifest, ok := manifestCache.Get(headCommit.Hash)
+	manifest, ok := manifestCache.Get(cacheKey)
 	if !ok {
 		// When the manifests were not in the cache we have to load them.
 		cfg, err := d.loadApplicationConfiguration(repoDir, app)
@@ -247,13 +254,15 @@ func (d *detector) loadHeadServiceManifest(app *model.Application, repo git.Work
 		}
 
 		var (
-			encryptionUsed = d.secretDecrypter != nil && gds.Encryption != nil
-			attachmentUsed = gds.Attachment != nil
+			encryptionUsed      = d.secretDecrypter != nil && gds.Encryption != nil
+			externalSecretsUsed = d.secretProvider != nil && gds.ExternalSecrets != nil
+			attachmentUsed      = gds.Attachment != nil
+			ociSource           = gds.OCISource()
 		)
 
 		// We have to copy repository into another directory because
-		// decrypting the sealed secrets or attaching files might change the git repository.
-		if attachmentUsed || encryptionUsed {
+		// resolving secrets, attaching files or pulling OCI artifacts might change the git repository.
+		if attachmentUsed || encryptionUsed || externalSecretsUsed || ociSource != nil {
 			dir, err := os.MkdirTemp("", "detector-git-processing")
 			if err != nil {
 				return provider.ServiceManifest{}, fmt.Errorf("failed to prepare a temporary directory for git repository (%w)", err)
@@ -270,7 +279,19 @@ func (d *detector) loadHeadServiceManifest(app *model.Application, repo git.Work
 			appDir = filepath.Join(repoDir, app.GitPath.Path)
 		}
 
+		// Pull the manifests from the OCI artifact deployed by the last successful deployment.
+		if ociSource != nil {
+			digest := app.MostRecentlySuccessfulDeployment.GetTrigger().GetArtifactDigest()
+			if _, err := deploysource.PullOCISource(ctx, d.ociClient, ociSource, digest, appDir); err != nil {
+				return provider.ServiceManifest{}, err
+			}
+		}
+
 		var templProcessors []sourceprocesser.SourceTemplateProcessor
+		// Resolving the external secrets first so that the following processors see the rendered files.
+		if externalSecretsUsed {
+			templProcessors = append(templProcessors, sourceprocesser.NewSecretProviderProcessor(ctx, gds.ExternalSecrets, sourceprocesser.NewCachingSecretProvider(d.secretProvider)))
+		}
 		// Decrypting secrets to manifests.
 		if encryptionUsed {
 			templProcessors = append(templProcessors, sourceprocesser.NewSecretDecrypterProcessor(gds.Encryption, d.secretDecrypter))
@@ -295,7 +316,7 @@ func (d *detector) loadHeadServiceManifest(app *model.Application, repo git.Work
 		if err != nil {
 			return provider.ServiceManifest{}, fmt.Errorf("failed to load new service manifest: %w", err)
 		}
-		manifestCache.Put(headCommit.Hash, manifest)
+		manifestCache.Put(cacheKey, manifest)
 	}
 	return manifest, nil
 }
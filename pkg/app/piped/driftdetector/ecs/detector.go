@@ -28,6 +28,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	"go.uber.org/zap"
 
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatestore/ecs"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/sourceprocesser"
@@ -69,6 +70,8 @@ type detector struct {
 	interval          time.Duration
 	config            *config.PipedSpec
 	secretDecrypter   secretDecrypter
+	ociClient         deploysource.OCIClient
+	secretProvider    sourceprocesser.SecretProvider
 	logger            *zap.Logger
 
 	gitRepos map[string]git.Repo
@@ -99,6 +102,8 @@ func NewDetector(
 		interval:          time.Minute,
 		config:            cfg,
 		secretDecrypter:   sd,
+		ociClient:         deploysource.NewOCIClient(cfg.OCIRegistries),
+		secretProvider:    sourceprocesser.NewSecretProvider(cfg.SecretProviders),
 		gitRepos:          make(map[string]git.Repo),
 		logger:            logger,
 	}
@@ -197,7 +202,7 @@ func (d *detector) listGroupedApplication() map[string][]*model.Application {
 }
 
 func (d *detector) checkApplication(ctx context.Context, app *model.Application, repo git.Repo, headCommit git.Commit) error {
-	headManifests, err := d.loadConfigs(app, repo, headCommit)
+	headManifests, err := d.loadConfigs(ctx, app, repo, headCommit)
 	if err != nil {
 		return err
 	}
@@ -243,6 +248,7 @@ func ignoreParameters(liveManifests provider.ECSManifests, headManifests provide
 	liveService := *liveManifests.ServiceDefinition
 	liveService.CreatedAt = nil
 	liveService.CreatedBy = nil
+	liveService.Deployments = nil // ECS does not report deployments of services using EXTERNAL or CODE_DEPLOY controller.
 	liveService.Events = nil
 	liveService.LoadBalancers = nil // TODO: We should set values in headService from the head manifests .
 	liveService.PendingCount = 0
@@ -273,6 +279,7 @@ func ignoreParameters(liveManifests provider.ECSManifests, headManifests provide
 	}
 
 	headService := *headManifests.ServiceDefinition
+	headService.Deployments = nil // Deployments of the head service only carry Service Connect configuration.
 	if headService.PlatformVersion == nil {
 		// The LATEST platform version is used by default if PlatformVersion is not specified.
 		// See https://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_CreateService.html#ECS-CreateService-request-platformVersion.
@@ -343,7 +350,7 @@ func ignoreParameters(liveManifests provider.ECSManifests, headManifests provide
 	return live, head
 }
 
-func (d *detector) loadConfigs(app *model.Application, repo git.Worktree, headCommit git.Commit) (provider.ECSManifests, error) {
+func (d *detector) loadConfigs(ctx context.Context, app *model.Application, repo git.Worktree, headCommit git.Commit) (provider.ECSManifests, error) {
 	var (
 		manifestCache = provider.ECSManifestsCache{
 			AppID:  app.Id,
@@ -352,9 +359,11 @@ func (d *detector) loadConfigs(app *model.Application, repo git.Worktree, headCo
 		}
 		repoDir = repo.GetPath()
 		appDir  = filepath.Join(repoDir, app.GitPath.Path)
+		// The manifests loaded from an OCI artifact also depend on its digest.
+		cacheKey = headCommit.Hash + app.MostRecentlySuccessfulDeployment.GetTrigger().GetArtifactDigest()
 	)
 
-	manifests, ok := manifestCache.Get(headCommit.Hash)
+	manifests, ok := manifestCache.Get(cacheKey)
 	if ok {
 		return manifests, nil
 	}
@@ -370,13 +379,15 @@ func (d *detector) loadConfigs(app *model.Application, repo git.Worktree, headCo
 	}
 
 	var (
-		encryptionUsed = d.secretDecrypter != nil && gds.Encryption != nil
-		attachmentUsed = gds.Attachment != nil
+		encryptionUsed      = d.secretDecrypter != nil && gds.Encryption != nil
+		externalSecretsUsed = d.secretProvider != nil && gds.ExternalSecrets != nil
+		attachmentUsed      = gds.Attachment != nil
+		ociSource           = gds.OCISource()
 	)
 
 	// We have to copy repository into another directory because
-	// decrypting the sealed secrets or attaching files might change the git repository.
-	if attachmentUsed || encryptionUsed {
+	// resolving secrets, attaching files or pulling OCI artifacts might change the git repository.
+	if attachmentUsed || encryptionUsed || externalSecretsUsed || ociSource != nil {
 		dir, err := os.MkdirTemp("", "detector-git-processing")
 		if err != nil {
 			return provider.ECSManifests{}, fmt.Errorf("failed to prepare a temporary directory for git repository (%w)", err)
@@ -393,7 +404,19 @@ func (d *detector) loadConfigs(app *model.Application, repo git.Worktree, headCo
 		appDir = filepath.Join(repoDir, app.GitPath.Path)
 	}
 
+	// Pull the manifests from the OCI artifact deployed by the last successful deployment.
+	if ociSource != nil {
+		digest := app.MostRecentlySuccessfulDeployment.GetTrigger().GetArtifactDigest()
+		if _, err := deploysource.PullOCISource(ctx, d.ociClient, ociSource, digest, appDir); err != nil {
+			return provider.ECSManifests{}, err
+		}
+	}
+
 	var templProcessors []sourceprocesser.SourceTemplateProcessor
+	// Resolving the external secrets first so that the following processors see the rendered files.
+	if externalSecretsUsed {
+		templProcessors = append(templProcessors, sourceprocesser.NewSecretProviderProcessor(ctx, gds.ExternalSecrets, sourceprocesser.NewCachingSecretProvider(d.secretProvider)))
+	}
 	// Decrypting secrets to manifests.
 	if encryptionUsed {
 		templProcessors = append(templProcessors, sourceprocesser.NewSecretDecrypterProcessor(gds.Encryption, d.secretDecrypter))
@@ -428,7 +451,7 @@ func (d *detector) loadConfigs(app *model.Application, repo git.Worktree, headCo
 		ServiceDefinition: &serviceDef,
 		TaskDefinition:    &taskDef,
 	}
-	manifestCache.Put(headCommit.Hash, manifests)
+	manifestCache.Put(cacheKey, manifests)
 
 	return manifests, nil
 }
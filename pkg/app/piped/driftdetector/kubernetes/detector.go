@@ -24,6 +24,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatestore/kubernetes"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/sourceprocesser"
@@ -65,6 +66,8 @@ type detector struct {
 	interval          time.Duration
 	config            *config.PipedSpec
 	secretDecrypter   secretDecrypter
+	ociClient         deploysource.OCIClient
+	secretProvider    sourceprocesser.SecretProvider
 	logger            *zap.Logger
 
 	gitRepos   map[string]git.Repo
@@ -96,6 +99,8 @@ func NewDetector(
 		interval:          time.Minute,
 		config:            cfg,
 		secretDecrypter:   sd,
+		ociClient:         deploysource.NewOCIClient(cfg.OCIRegistries),
+		secretProvider:    sourceprocesser.NewSecretProvider(cfg.SecretProviders),
 		gitRepos:          make(map[string]git.Repo),
 		syncStates:        make(map[string]model.ApplicationSyncState),
 		logger:            logger,
@@ -245,9 +250,11 @@ func (d *detector) loadHeadManifests(ctx context.Context, app *model.Application
 		}
 		repoDir = repo.GetPath()
 		appDir  = filepath.Join(repoDir, app.GitPath.Path)
+		// The manifests loaded from an OCI artifact also depend on its digest.
+		cacheKey = headCommit.Hash + app.MostRecentlySuccessfulDeployment.GetTrigger().GetArtifactDigest()
 	)
 
-	manifests, ok := manifestCache.Get(headCommit.Hash)
+	manifests, ok := manifestCache.Get(cacheKey)
 	if !ok {
 		// When the manifests were not in the cache we have to load them.
 		cfg, err := d.loadApplicationConfiguration(repoDir, app)
@@ -261,13 +268,15 @@ func (d *detector) loadHeadManifests(ctx context.Context, app *model.Application
 		}
 
 		var (
-			encryptionUsed = d.secretDecrypter != nil && gds.Encryption != nil
-			attachmentUsed = gds.Attachment != nil
+			encryptionUsed      = d.secretDecrypter != nil && gds.Encryption != nil
+			externalSecretsUsed = d.secretProvider != nil && gds.ExternalSecrets != nil
+			attachmentUsed      = gds.Attachment != nil
+			ociSource           = gds.OCISource()
 		)
 
 		// We have to copy repository into another directory because
-		// decrypting the sealed secrets or attaching files might change the git repository.
-		if attachmentUsed || encryptionUsed {
+		// resolving secrets, attaching files or pulling OCI artifacts might change the git repository.
+		if attachmentUsed || encryptionUsed || externalSecretsUsed || ociSource != nil {
 			dir, err := os.MkdirTemp("", "detector-git-processing")
 			if err != nil {
 				return nil, fmt.Errorf("failed to prepare a temporary directory for git repository (%w)", err)
@@ -284,7 +293,19 @@ func (d *detector) loadHeadManifests(ctx context.Context, app *model.Application
 			appDir = filepath.Join(repoDir, app.GitPath.Path)
 		}
 
+		// Pull the manifests from the OCI artifact deployed by the last successful deployment.
+		if ociSource != nil {
+			digest := app.MostRecentlySuccessfulDeployment.GetTrigger().GetArtifactDigest()
+			if _, err := deploysource.PullOCISource(ctx, d.ociClient, ociSource, digest, appDir); err != nil {
+				return nil, err
+			}
+		}
+
 		var templProcessors []sourceprocesser.SourceTemplateProcessor
+		// Resolving the external secrets first so that the following processors see the rendered files.
+		if externalSecretsUsed {
+			templProcessors = append(templProcessors, sourceprocesser.NewSecretProviderProcessor(ctx, gds.ExternalSecrets, sourceprocesser.NewCachingSecretProvider(d.secretProvider)))
+		}
 		// Decrypting secrets to manifests.
 		if encryptionUsed {
 			templProcessors = append(templProcessors, sourceprocesser.NewSecretDecrypterProcessor(gds.Encryption, d.secretDecrypter))
@@ -306,7 +327,7 @@ func (d *detector) loadHeadManifests(ctx context.Context, app *model.Application
 			err = fmt.Errorf("failed to load new manifests: %w", err)
 			return nil, err
 		}
-		manifestCache.Put(headCommit.Hash, manifests)
+		manifestCache.Put(cacheKey, manifests)
 	}
 
 	watchingMap := make(map[provider.APIVersionKind]struct{}, len(watchingResourceKinds))
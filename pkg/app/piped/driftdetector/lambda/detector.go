@@ -26,6 +26,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatestore/lambda"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/lambda"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/sourceprocesser"
@@ -67,6 +68,8 @@ type detector struct {
 	interval          time.Duration
 	config            *config.PipedSpec
 	secretDecrypter   secretDecrypter
+	ociClient         deploysource.OCIClient
+	secretProvider    sourceprocesser.SecretProvider
 	logger            *zap.Logger
 
 	gitRepos map[string]git.Repo
@@ -97,6 +100,8 @@ func NewDetector(
 		interval:          time.Minute,
 		config:            cfg,
 		secretDecrypter:   sd,
+		ociClient:         deploysource.NewOCIClient(cfg.OCIRegistries),
+		secretProvider:    sourceprocesser.NewSecretProvider(cfg.SecretProviders),
 		gitRepos:          make(map[string]git.Repo),
 		logger:            logger,
 	}
@@ -195,7 +200,7 @@ func (d *detector) listGroupedApplication() map[string][]*model.Application {
 }
 
 func (d *detector) checkApplication(ctx context.Context, app *model.Application, repo git.Repo, headCommit git.Commit) error {
-	headManifest, err := d.loadHeadFunctionManifest(app, repo, headCommit)
+	headManifest, err := d.loadHeadFunctionManifest(ctx, app, repo, headCommit)
 	if err != nil {
 		return err
 	}
@@ -208,6 +213,7 @@ func (d *detector) checkApplication(ctx context.Context, app *model.Application,
 	d.logger.Info(fmt.Sprintf("application %s has a live function manifest", app.Id))
 
 	clonedSpec := ignoreAndSortParameters(headManifest.Spec)
+	clonedSpec = ignoreUnavailableFields(clonedSpec, d.stateGetter.GetUnavailableFields(app.Id))
 	head := provider.FunctionManifest{
 		Kind:       headManifest.Kind,
 		APIVersion: headManifest.APIVersion,
@@ -239,10 +245,12 @@ func (d *detector) checkApplication(ctx context.Context, app *model.Application,
 // ignores:
 //   - SourceCode in headSpec
 //   - S3Bucket, S3Key, and S3ObjectVersion in headSpec
+//   - ProvisionedConcurrentExecutions of 0 in headSpec, which means removing it
 //
 // sorts: (Lambda sorts them in liveSpec)
 //   - Architectures in headSpec
 //   - SubnetIDs in headSpec
+//   - EventSourceMappings in headSpec
 func ignoreAndSortParameters(headSpec provider.FunctionManifestSpec) provider.FunctionManifestSpec {
 	cloneSpec := headSpec
 	// We cannot compare SourceCode and S3 packaging because live states do not have them.
@@ -266,11 +274,39 @@ func ignoreAndSortParameters(headSpec provider.FunctionManifestSpec) provider.Fu
 			SubnetIDs:        cloneSubnets,
 		}
 	}
+	if headSpec.ProvisionedConcurrentExecutions != nil && *headSpec.ProvisionedConcurrentExecutions == 0 {
+		cloneSpec.ProvisionedConcurrentExecutions = nil
+	}
+	// EventSourceMappings are sorted by the event source ARN in live states.
+	if len(headSpec.EventSourceMappings) > 1 {
+		cloneSpec.EventSourceMappings = slices.Clone(headSpec.EventSourceMappings)
+		sort.Slice(cloneSpec.EventSourceMappings, func(i, j int) bool {
+			return strings.Compare(cloneSpec.EventSourceMappings[i].EventSourceARN, cloneSpec.EventSourceMappings[j].EventSourceARN) < 0
+		})
+	}
 
 	return cloneSpec
 }
 
-func (d *detector) loadHeadFunctionManifest(app *model.Application, repo git.Worktree, headCommit git.Commit) (provider.FunctionManifest, error) {
+// ignoreUnavailableFields removes the fields which could not be read from live states
+// so that they are not reported as drift.
+func ignoreUnavailableFields(headSpec provider.FunctionManifestSpec, fields []string) provider.FunctionManifestSpec {
+	for _, f := range fields {
+		switch f {
+		case lambda.FieldEventSourceMappings:
+			headSpec.EventSourceMappings = nil
+		case lambda.FieldFunctionURL:
+			headSpec.FunctionURL = nil
+		case lambda.FieldDestinations:
+			headSpec.Destinations = nil
+		case lambda.FieldProvisionedConcurrentExecutions:
+			headSpec.ProvisionedConcurrentExecutions = nil
+		}
+	}
+	return headSpec
+}
+
+func (d *detector) loadHeadFunctionManifest(ctx context.Context, app *model.Application, repo git.Worktree, headCommit git.Commit) (provider.FunctionManifest, error) {
 	var (
 		manifestCache = provider.FunctionManifestCache{
 			AppID:  app.Id,
@@ -279,9 +315,11 @@ func (d *detector) loadHeadFunctionManifest(app *model.Application, repo git.Wor
 		}
 		repoDir = repo.GetPath()
 		appDir  = filepath.Join(repoDir, app.GitPath.Path)
+		// The manifests loaded from an OCI artifact also depend on its digest.
+		cacheKey = headCommit.Hash + app.MostRecentlySuccessfulDeployment.GetTrigger().GetArtifactDigest()
 	)
 
-	manifest, ok := manifestCache.Get(headCommit.Hash)
+	manifest, ok := manifestCache.Get(cacheKey)
 	if !ok {
 		// When the manifests were not in the cache, we have to load them.
 		cfg, err := d.loadApplicationConfiguration(repoDir, app)
@@ -295,13 +333,15 @@ func (d *detector) loadHeadFunctionManifest(app *model.Application, repo git.Wor
 		}
 
 		var (
-			encryptionUsed = d.secretDecrypter != nil && gds.Encryption != nil
-			attachmentUsed = gds.Attachment != nil
+			encryptionUsed      = d.secretDecrypter != nil && gds.Encryption != nil
+			externalSecretsUsed = d.secretProvider != nil && gds.ExternalSecrets != nil
+			attachmentUsed      = gds.Attachment != nil
+			ociSource           = gds.OCISource()
 		)
 
 		// We have to copy repository into another directory because
-		// decrypting the sealed secrets or attaching files might change the git repository.
-		if attachmentUsed || encryptionUsed {
+		// resolving secrets, attaching files or pulling OCI artifacts might change the git repository.
+		if attachmentUsed || encryptionUsed || externalSecretsUsed || ociSource != nil {
 			dir, err := os.MkdirTemp("", "detector-git-processing")
 			if err != nil {
 				return provider.FunctionManifest{}, fmt.Errorf("failed to prepare a temporary directory for git repository (%w)", err)
@@ -318,7 +358,19 @@ func (d *detector) loadHeadFunctionManifest(app *model.Application, repo git.Wor
 			appDir = filepath.Join(repoDir, app.GitPath.Path)
 		}
 
+		// Pull the manifests from the OCI artifact deployed by the last successful deployment.
+		if ociSource != nil {
+			digest := app.MostRecentlySuccessfulDeployment.GetTrigger().GetArtifactDigest()
+			if _, err := deploysource.PullOCISource(ctx, d.ociClient, ociSource, digest, appDir); err != nil {
+				return provider.FunctionManifest{}, err
+			}
+		}
+
 		var templProcessors []sourceprocesser.SourceTemplateProcessor
+		// Resolving the external secrets first so that the following processors see the rendered files.
+		if externalSecretsUsed {
+			templProcessors = append(templProcessors, sourceprocesser.NewSecretProviderProcessor(ctx, gds.ExternalSecrets, sourceprocesser.NewCachingSecretProvider(d.secretProvider)))
+		}
 		// Decrypting secrets to manifests.
 		if encryptionUsed {
 			templProcessors = append(templProcessors, sourceprocesser.NewSecretDecrypterProcessor(gds.Encryption, d.secretDecrypter))
@@ -343,7 +395,7 @@ func (d *detector) loadHeadFunctionManifest(app *model.Application, repo git.Wor
 		if err != nil {
 			return provider.FunctionManifest{}, fmt.Errorf("failed to load new function manifest: %w", err)
 		}
-		manifestCache.Put(headCommit.Hash, manifest)
+		manifestCache.Put(cacheKey, manifest)
 	}
 	return manifest, nil
 }
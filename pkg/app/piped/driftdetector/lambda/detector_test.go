@@ -17,9 +17,11 @@ package lambda
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatestore/lambda"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/lambda"
 	"github.com/pipe-cd/pipecd/pkg/diff"
 )
@@ -142,6 +144,58 @@ func TestIgnoreAndSortParameters(t *testing.T) {
 			},
 			expectDiff: true,
 		},
+		{
+			name: "Ignore not sorted event source mappings and added fields in livestate",
+			liveSpec: provider.FunctionManifestSpec{
+				ReservedConcurrentExecutions: aws.Int32(10),
+				EventSourceMappings: []provider.EventSourceMapping{
+					{EventSourceARN: "arn-1", BatchSize: 10, Enabled: aws.Bool(true)},
+					{EventSourceARN: "arn-2", BatchSize: 100, Enabled: aws.Bool(true), StartingPosition: "LATEST"},
+				},
+				TracingMode: "PassThrough",
+				LoggingConfig: &provider.LoggingConfig{
+					LogFormat: "Text",
+					LogGroup:  "/aws/lambda/test-function",
+				},
+			},
+			headSpec: provider.FunctionManifestSpec{
+				ReservedConcurrentExecutions:    aws.Int32(10),
+				ProvisionedConcurrentExecutions: aws.Int32(0),
+				EventSourceMappings: []provider.EventSourceMapping{
+					{EventSourceARN: "arn-2", BatchSize: 100, StartingPosition: "LATEST"},
+					{EventSourceARN: "arn-1"},
+				},
+				LoggingConfig: &provider.LoggingConfig{
+					LogFormat: "Text",
+				},
+			},
+			expectDiff: false,
+		},
+		{
+			name: "Detect changed event source mappings",
+			liveSpec: provider.FunctionManifestSpec{
+				EventSourceMappings: []provider.EventSourceMapping{
+					{EventSourceARN: "arn-1", BatchSize: 10},
+				},
+			},
+			headSpec: provider.FunctionManifestSpec{
+				EventSourceMappings: []provider.EventSourceMapping{
+					{EventSourceARN: "arn-1", BatchSize: 10},
+					{EventSourceARN: "arn-2"},
+				},
+			},
+			expectDiff: true,
+		},
+		{
+			name: "Detect changed provisioned concurrency",
+			liveSpec: provider.FunctionManifestSpec{
+				ProvisionedConcurrentExecutions: aws.Int32(5),
+			},
+			headSpec: provider.FunctionManifestSpec{
+				ProvisionedConcurrentExecutions: aws.Int32(10),
+			},
+			expectDiff: true,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -195,3 +249,31 @@ func TestIgnoreAndSortParametersNotChangeOriginal(t *testing.T) {
 		headSpec.Architectures)
 	assert.Equal(t, []string{"subnet-2", "subnet-1"}, headSpec.VPCConfig.SubnetIDs)
 }
+
+func TestIgnoreUnavailableFields(t *testing.T) {
+	t.Parallel()
+
+	headSpec := provider.FunctionManifestSpec{
+		Name: "test-function",
+		EventSourceMappings: []provider.EventSourceMapping{
+			{EventSourceARN: "arn:aws:sqs:ap-northeast-1:123456789012:queue"},
+		},
+		FunctionURL:                     &provider.FunctionURL{AuthType: "NONE"},
+		Destinations:                    &provider.Destinations{OnFailure: "arn:aws:sqs:ap-northeast-1:123456789012:dlq"},
+		ProvisionedConcurrentExecutions: aws.Int32(1),
+	}
+
+	got := ignoreUnavailableFields(headSpec, []string{
+		lambda.FieldEventSourceMappings,
+		lambda.FieldProvisionedConcurrentExecutions,
+	})
+
+	assert.Equal(t, "test-function", got.Name)
+	assert.Nil(t, got.EventSourceMappings)
+	assert.Nil(t, got.ProvisionedConcurrentExecutions)
+	assert.Equal(t, headSpec.FunctionURL, got.FunctionURL)
+	assert.Equal(t, headSpec.Destinations, got.Destinations)
+	// The original spec must not be changed.
+	assert.Len(t, headSpec.EventSourceMappings, 1)
+	assert.NotNil(t, headSpec.ProvisionedConcurrentExecutions)
+}
@@ -25,10 +25,10 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatestore/terraform"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/terraform"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/sourceprocesser"
-	"github.com/pipe-cd/pipecd/pkg/app/piped/toolregistry"
 	"github.com/pipe-cd/pipecd/pkg/cache"
 	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/git"
@@ -66,6 +66,8 @@ type detector struct {
 	interval          time.Duration
 	config            *config.PipedSpec
 	secretDecrypter   secretDecrypter
+	ociClient         deploysource.OCIClient
+	secretProvider    sourceprocesser.SecretProvider
 	logger            *zap.Logger
 
 	gitRepos   map[string]git.Repo
@@ -97,6 +99,8 @@ func NewDetector(
 		interval:          10 * time.Minute,
 		config:            cfg,
 		secretDecrypter:   sd,
+		ociClient:         deploysource.NewOCIClient(cfg.OCIRegistries),
+		secretProvider:    sourceprocesser.NewSecretProvider(cfg.SecretProviders),
 		gitRepos:          make(map[string]git.Repo),
 		syncStates:        make(map[string]model.ApplicationSyncState),
 		logger:            logger,
@@ -189,13 +193,15 @@ func (d *detector) checkApplication(ctx context.Context, app *model.Application,
 	}
 
 	var (
-		encryptionUsed = d.secretDecrypter != nil && gds.Encryption != nil
-		attachmentUsed = gds.Attachment != nil
+		encryptionUsed      = d.secretDecrypter != nil && gds.Encryption != nil
+		externalSecretsUsed = d.secretProvider != nil && gds.ExternalSecrets != nil
+		attachmentUsed      = gds.Attachment != nil
+		ociSource           = gds.OCISource()
 	)
 
 	// We have to copy repository into another directory because
-	// decrypting the sealed secrets or attaching files might change the git repository.
-	if attachmentUsed || encryptionUsed {
+	// resolving secrets, attaching files or pulling OCI artifacts might change the git repository.
+	if attachmentUsed || encryptionUsed || externalSecretsUsed || ociSource != nil {
 		dir, err := os.MkdirTemp("", "detector-git-processing")
 		if err != nil {
 			return fmt.Errorf("failed to prepare a temporary directory for git repository (%w)", err)
@@ -212,7 +218,19 @@ func (d *detector) checkApplication(ctx context.Context, app *model.Application,
 		appDir = filepath.Join(repoDir, app.GitPath.Path)
 	}
 
+	// Pull the manifests from the OCI artifact deployed by the last successful deployment.
+	if ociSource != nil {
+		digest := app.MostRecentlySuccessfulDeployment.GetTrigger().GetArtifactDigest()
+		if _, err := deploysource.PullOCISource(ctx, d.ociClient, ociSource, digest, appDir); err != nil {
+			return err
+		}
+	}
+
 	var templProcessors []sourceprocesser.SourceTemplateProcessor
+	// Resolving the external secrets first so that the following processors see the rendered files.
+	if externalSecretsUsed {
+		templProcessors = append(templProcessors, sourceprocesser.NewSecretProviderProcessor(ctx, gds.ExternalSecrets, sourceprocesser.NewCachingSecretProvider(d.secretProvider)))
+	}
 	// Decrypting secrets to manifests.
 	if encryptionUsed {
 		templProcessors = append(templProcessors, sourceprocesser.NewSecretDecrypterProcessor(gds.Encryption, d.secretDecrypter))
@@ -229,8 +247,7 @@ func (d *detector) checkApplication(ctx context.Context, app *model.Application,
 	}
 
 	// Set up terraform
-	version := appCfg.Input.TerraformVersion
-	terraformPath, _, err := toolregistry.DefaultRegistry().Terraform(ctx, version)
+	terraformPath, _, err := provider.FindBinary(ctx, appCfg.Input.Binary, appCfg.Input.TerraformVersion)
 	if err != nil {
 		return err
 	}
@@ -97,6 +97,13 @@ type watcher struct {
 	executionMilestoneMap sync.Map
 	// Cache for the last scanned commit and event watcher configs for each application.
 	lastScannedConfig sync.Map
+
+	// Poller of the image repositories configured in the piped config.
+	// This is nil if no image policy is configured.
+	imagePoller *imagePoller
+	// The latest data pushed by the polled images.
+	// A map from repo-id, git path and event key to the pushed data.
+	polledImageMilestoneMap sync.Map
 }
 
 type eventWatcherCache struct {
@@ -111,13 +118,17 @@ type eventWatcherConfig struct {
 }
 
 func NewWatcher(cfg *config.PipedSpec, eventLister eventLister, gitClient gitClient, apiClient apiClient, logger *zap.Logger) Watcher {
-	return &watcher{
+	w := &watcher{
 		config:      cfg,
 		eventLister: eventLister,
 		gitClient:   gitClient,
 		apiClient:   apiClient,
 		logger:      logger.Named("event-watcher"),
 	}
+	if len(cfg.EventWatcher.ImagePolicies) > 0 {
+		w.imagePoller = newImagePoller(cfg.EventWatcher.ImagePolicies, &ociImageRegistry{config: cfg}, w.logger)
+	}
+	return w
 }
 
 // Run spawns goroutines for each git repository. They periodically fetch the latest Event
@@ -133,6 +144,14 @@ func (w *watcher) Run(ctx context.Context) error {
 	defer os.RemoveAll(workingDir)
 	w.workingDir = workingDir
 
+	if w.imagePoller != nil {
+		w.wg.Add(1)
+		go func() {
+			defer w.wg.Done()
+			w.imagePoller.Run(ctx)
+		}()
+	}
+
 	for _, r := range w.config.Repositories {
 		repo, err := w.cloneRepo(ctx, r)
 		if err != nil {
@@ -300,6 +319,16 @@ func (w *watcher) run(ctx context.Context, repo git.Repo, repoCfg config.PipedRe
 					zap.Error(err),
 				)
 			}
+			if w.imagePoller == nil {
+				continue
+			}
+			if err := w.executePolledImages(ctx, repo, repoCfg.RepoID, cfgs); err != nil {
+				w.logger.Error("failed to update the values with the polled images",
+					zap.String("repo-id", repoCfg.RepoID),
+					zap.String("branch", repo.GetClonedBranch()),
+					zap.Error(err),
+				)
+			}
 		}
 	}
 }
@@ -513,6 +542,112 @@ func (w *watcher) execute(ctx context.Context, repo git.Repo, repoID string, eve
 	return nil
 }
 
+// executePolledImages updates the files with the images chosen by the image policies in the piped config.
+// They are handled by the same handlers as the events registered in the control-plane,
+// but their statuses are not reported since they are not stored in the control-plane.
+func (w *watcher) executePolledImages(ctx context.Context, repo git.Repo, repoID string, eventCfgs []eventWatcherConfig) error {
+	type polledImageUpdate struct {
+		gitPath      string
+		cfg          config.EventWatcherConfig
+		event        *model.Event
+		milestoneKey string
+	}
+
+	// Find the images updated since the last push before copying the repository
+	// because they are rarely updated compared to how often this runs.
+	updates := make([]polledImageUpdate, 0)
+	for _, e := range eventCfgs {
+		for _, cfg := range e.Configs {
+			event, ok := w.imagePoller.LatestEvent(cfg.Matcher.Name, cfg.Matcher.Labels)
+			if !ok {
+				continue
+			}
+			milestoneKey := fmt.Sprintf("%s/%s/%s", repoID, e.GitPath, event.EventKey)
+			if v, ok := w.polledImageMilestoneMap.Load(milestoneKey); ok && v.(string) == event.Data {
+				continue
+			}
+			if cfg.Handler.Type != config.EventWatcherHandlerTypeGitUpdate {
+				w.logger.Error(fmt.Sprintf("event watcher handler type %s is not supported yet", cfg.Handler.Type),
+					zap.String("event-name", event.Name),
+				)
+				continue
+			}
+			updates = append(updates, polledImageUpdate{
+				gitPath:      e.GitPath,
+				cfg:          cfg,
+				event:        event,
+				milestoneKey: milestoneKey,
+			})
+		}
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+
+	// Copy the repo to another directory to modify local file to avoid reverting previous changes.
+	tmpDir, err := os.MkdirTemp(w.workingDir, "repo")
+	if err != nil {
+		w.logger.Error("failed to create a new temporary directory", zap.Error(err))
+		return err
+	}
+	tmpRepo, err := repo.CopyToModify(filepath.Join(tmpDir, "tmp-repo"))
+	if err != nil {
+		w.logger.Error("failed to copy the repository to the temporary directory", zap.Error(err))
+		return err
+	}
+	// nolint: errcheck
+	defer tmpRepo.Clean()
+
+	// A map from branch to the milestones to be stored once it's pushed.
+	branchMilestones := make(map[string]map[string]string)
+	for _, u := range updates {
+		cfg, event := u.cfg, u.event
+		branch, err := w.commitFiles(ctx, event, cfg.Matcher.Name, cfg.Handler.Config.CommitMessage, u.gitPath, cfg.Handler.Config.Replacements, tmpRepo, cfg.Handler.Config.MakePullRequest)
+		if errors.Is(err, errNoChanges) {
+			w.polledImageMilestoneMap.Store(u.milestoneKey, event.Data)
+			continue
+		}
+		if err != nil {
+			w.logger.Error("failed to commit outdated files", zap.String("event-name", event.Name), zap.Error(err))
+			continue
+		}
+		if branchMilestones[branch] == nil {
+			branchMilestones[branch] = make(map[string]string)
+		}
+		branchMilestones[branch][u.milestoneKey] = event.Data
+	}
+
+	var responseError error
+	retry := backoff.NewRetry(retryPushNum, backoff.NewConstant(retryPushInterval))
+	for branch, milestones := range branchMilestones {
+		zlogger := w.logger.With(
+			zap.String("repo-id", repoID),
+			zap.String("branch", branch),
+		)
+		_, err = retry.Do(ctx, func() (interface{}, error) {
+			if err := tmpRepo.Push(ctx, branch); err != nil {
+				zlogger.Warn(fmt.Sprintf("failed to push commits. retry attempt %d/%d", retry.Calls(), retryPushNum), zap.Error(err))
+				return nil, err
+			}
+			return nil, nil
+		})
+		if err == git.ErrBranchNotFresh {
+			zlogger.Warn("failed to push commits. local branch was not up-to-date. will retry in the next loop", zap.Error(err))
+			continue
+		}
+		if err != nil {
+			zlogger.Error("failed to push commits", zap.Error(err))
+			responseError = errors.Join(responseError, err)
+			continue
+		}
+		for k, v := range milestones {
+			w.polledImageMilestoneMap.Store(k, v)
+		}
+		zlogger.Info(fmt.Sprintf("successfully pushed %d updates of polled images", len(milestones)))
+	}
+	return responseError
+}
+
 // updateValues inspects all Event-definition and pushes the changes to git repo if there is.
 // NOTE: This will be removed.
 func (w *watcher) updateValues(ctx context.Context, repo git.Repo, repoID string, eventCfgs []config.EventWatcherEvent, commitMsg string) error {
@@ -0,0 +1,315 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventwatcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+	"github.com/pipe-cd/pipecd/pkg/oci"
+)
+
+const (
+	defaultImagePollInterval = 5 * time.Minute
+
+	defaultImageTagDataFormat    = "{{ .Image }}:{{ .Tag }}"
+	defaultImageDigestDataFormat = "{{ .Image }}@{{ .Digest }}"
+)
+
+var errNoMatchingTag = errors.New("no tag matches the policy")
+
+// imageRegistry lists the tags of image repositories and resolves their digests.
+type imageRegistry interface {
+	ListTags(ctx context.Context, policy *config.PipedEventWatcherImagePolicy) ([]string, error)
+	ResolveDigest(ctx context.Context, policy *config.PipedEventWatcherImagePolicy, tag string) (string, error)
+}
+
+// ociImageRegistry accesses the registries with the credentials of ociRegistries in the piped config.
+type ociImageRegistry struct {
+	config *config.PipedSpec
+}
+
+func (r *ociImageRegistry) options(policy *config.PipedEventWatcherImagePolicy) []oci.PullOption {
+	reg, ok := r.config.FindOCIRegistry(policy.RegistryAddress())
+	if !ok {
+		return nil
+	}
+	opts := make([]oci.PullOption, 0, 3)
+	if reg.Username != "" {
+		opts = append(opts, oci.WithUsername(reg.Username))
+	}
+	if reg.Password != "" {
+		opts = append(opts, oci.WithPassword(reg.Password))
+	}
+	if reg.Insecure {
+		opts = append(opts, oci.WithInsecure())
+	}
+	return opts
+}
+
+func (r *ociImageRegistry) ListTags(ctx context.Context, policy *config.PipedEventWatcherImagePolicy) ([]string, error) {
+	return oci.ListTags(ctx, "oci://"+policy.Image, r.options(policy)...)
+}
+
+func (r *ociImageRegistry) ResolveDigest(ctx context.Context, policy *config.PipedEventWatcherImagePolicy, tag string) (string, error) {
+	return oci.ResolveDigest(ctx, fmt.Sprintf("oci://%s:%s", policy.Image, tag), r.options(policy)...)
+}
+
+// polledImage represents the image chosen by an image policy.
+// Its fields are available in the data template of the policy.
+type polledImage struct {
+	Image  string
+	Tag    string
+	Digest string
+}
+
+// imagePoller periodically polls the image repositories configured in the piped config
+// and keeps the image chosen by each policy as the latest event.
+type imagePoller struct {
+	policies []config.PipedEventWatcherImagePolicy
+	registry imageRegistry
+	nowFunc  func() time.Time
+	logger   *zap.Logger
+
+	// A map from event key to the latest event made from the polled image.
+	events sync.Map
+}
+
+func newImagePoller(policies []config.PipedEventWatcherImagePolicy, registry imageRegistry, logger *zap.Logger) *imagePoller {
+	return &imagePoller{
+		policies: policies,
+		registry: registry,
+		nowFunc:  time.Now,
+		logger:   logger.Named("image-poller"),
+	}
+}
+
+// Run spawns goroutines for each image policy and blocks until the context is done.
+func (p *imagePoller) Run(ctx context.Context) {
+	p.logger.Info("start polling image repositories", zap.Int("policies", len(p.policies)))
+
+	var wg sync.WaitGroup
+	for i := range p.policies {
+		wg.Add(1)
+		go func(policy *config.PipedEventWatcherImagePolicy) {
+			defer wg.Done()
+			p.run(ctx, policy)
+		}(&p.policies[i])
+	}
+	wg.Wait()
+}
+
+func (p *imagePoller) run(ctx context.Context, policy *config.PipedEventWatcherImagePolicy) {
+	interval := time.Duration(policy.Interval)
+	if interval == 0 {
+		interval = defaultImagePollInterval
+	}
+	logger := p.logger.With(
+		zap.String("image", policy.Image),
+		zap.String("event-name", policy.EventName),
+	)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := p.poll(ctx, policy); err != nil {
+			logger.Error("failed to poll image repository. will retry in the next loop", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll chooses the image by the given policy and updates the latest event if the data was changed.
+func (p *imagePoller) poll(ctx context.Context, policy *config.PipedEventWatcherImagePolicy) error {
+	image, err := chooseImage(ctx, p.registry, policy)
+	if err != nil {
+		return err
+	}
+	data, err := renderImageData(policy, image)
+	if err != nil {
+		return err
+	}
+
+	key := model.MakeEventKey(policy.EventName, policy.Labels)
+	if v, ok := p.events.Load(key); ok && v.(*model.Event).Data == data {
+		return nil
+	}
+	p.events.Store(key, &model.Event{
+		Name:      policy.EventName,
+		Labels:    policy.Labels,
+		Data:      data,
+		EventKey:  key,
+		CreatedAt: p.nowFunc().Unix(),
+	})
+	p.logger.Info("found a new image by polling",
+		zap.String("event-name", policy.EventName),
+		zap.String("data", data),
+	)
+	return nil
+}
+
+// LatestEvent returns the event made from the latest image chosen by the policy
+// having the given event name and labels.
+func (p *imagePoller) LatestEvent(name string, labels map[string]string) (*model.Event, bool) {
+	v, ok := p.events.Load(model.MakeEventKey(name, labels))
+	if !ok {
+		return nil, false
+	}
+	return v.(*model.Event), true
+}
+
+// chooseImage lists the tags of the image repository and chooses one according to the given policy.
+func chooseImage(ctx context.Context, registry imageRegistry, policy *config.PipedEventWatcherImagePolicy) (polledImage, error) {
+	if policy.Policy.Tag != "" {
+		digest, err := registry.ResolveDigest(ctx, policy, policy.Policy.Tag)
+		if err != nil {
+			return polledImage{}, err
+		}
+		return polledImage{Image: policy.Image, Tag: policy.Policy.Tag, Digest: digest}, nil
+	}
+
+	tags, err := registry.ListTags(ctx, policy)
+	if err != nil {
+		return polledImage{}, err
+	}
+	tag, err := selectTag(tags, policy.Policy)
+	if err != nil {
+		return polledImage{}, fmt.Errorf("failed to select tag of %s: %w", policy.Image, err)
+	}
+	return polledImage{Image: policy.Image, Tag: tag}, nil
+}
+
+// selectTag selects a tag from the given list by the semver or regex policy.
+func selectTag(tags []string, policy config.ImagePolicy) (string, error) {
+	switch {
+	case policy.Semver != "":
+		return selectSemverTag(tags, policy.Semver)
+	case policy.Regex != "":
+		return selectRegexTag(tags, policy.Regex, policy.Sort, policy.Order)
+	default:
+		return "", errors.New("either semver or regex must be set to select a tag")
+	}
+}
+
+func selectSemverTag(tags []string, constraint string) (string, error) {
+	c, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		latest    *semver.Version
+		latestTag string
+	)
+	for _, tag := range tags {
+		v, err := semver.NewVersion(tag)
+		if err != nil {
+			continue
+		}
+		if !c.Check(v) {
+			continue
+		}
+		if latest == nil || v.GreaterThan(latest) {
+			latest, latestTag = v, tag
+		}
+	}
+	if latest == nil {
+		return "", errNoMatchingTag
+	}
+	return latestTag, nil
+}
+
+func selectRegexTag(tags []string, expr string, sortType config.ImagePolicySortType, order config.ImagePolicyOrder) (string, error) {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return "", err
+	}
+
+	type candidate struct {
+		tag    string
+		key    string
+		number float64
+	}
+	candidates := make([]candidate, 0, len(tags))
+	for _, tag := range tags {
+		m := re.FindStringSubmatch(tag)
+		if m == nil {
+			continue
+		}
+		c := candidate{tag: tag, key: tag}
+		if len(m) > 1 {
+			c.key = m[1]
+		}
+		if sortType == config.ImagePolicySortNumerical {
+			n, err := strconv.ParseFloat(c.key, 64)
+			if err != nil {
+				continue
+			}
+			c.number = n
+		}
+		candidates = append(candidates, c)
+	}
+	if len(candidates) == 0 {
+		return "", errNoMatchingTag
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if sortType == config.ImagePolicySortNumerical {
+			return candidates[i].number < candidates[j].number
+		}
+		return candidates[i].key < candidates[j].key
+	})
+	if order == config.ImagePolicyOrderDesc {
+		return candidates[0].tag, nil
+	}
+	return candidates[len(candidates)-1].tag, nil
+}
+
+// renderImageData renders the event data of the chosen image.
+func renderImageData(policy *config.PipedEventWatcherImagePolicy, image polledImage) (string, error) {
+	format := policy.Data
+	if format == "" {
+		format = defaultImageTagDataFormat
+		if policy.Policy.Tag != "" {
+			format = defaultImageDigestDataFormat
+		}
+	}
+	t, err := template.New("data").Option("missingkey=error").Parse(format)
+	if err != nil {
+		return "", fmt.Errorf("invalid data template: %w", err)
+	}
+	var b strings.Builder
+	if err := t.Execute(&b, image); err != nil {
+		return "", fmt.Errorf("failed to render data: %w", err)
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,237 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventwatcher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/git/gittest"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeImageRegistry struct {
+	tags    []string
+	digests map[string]string
+}
+
+func (r *fakeImageRegistry) ListTags(_ context.Context, _ *config.PipedEventWatcherImagePolicy) ([]string, error) {
+	return r.tags, nil
+}
+
+func (r *fakeImageRegistry) ResolveDigest(_ context.Context, _ *config.PipedEventWatcherImagePolicy, tag string) (string, error) {
+	return r.digests[tag], nil
+}
+
+func TestSelectTag(t *testing.T) {
+	t.Parallel()
+
+	tags := []string{"latest", "v0.9.0", "v1.0.0", "v1.2.0", "v1.10.1", "v2.0.0-rc.1", "v2.0.0", "main-abc-9", "main-def-10", "main-ghi-100"}
+	testcases := []struct {
+		name     string
+		policy   config.ImagePolicy
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "highest version in semver range",
+			policy:   config.ImagePolicy{Semver: ">=1.0.0 <2.0.0"},
+			expected: "v1.10.1",
+		},
+		{
+			name:     "highest version without prerelease",
+			policy:   config.ImagePolicy{Semver: ">=1.0.0"},
+			expected: "v2.0.0",
+		},
+		{
+			name:    "no version in semver range",
+			policy:  config.ImagePolicy{Semver: ">=3.0.0"},
+			wantErr: true,
+		},
+		{
+			name:     "alphabetical ascending order",
+			policy:   config.ImagePolicy{Regex: `^main-[a-z]+-\d+$`, Sort: config.ImagePolicySortAlphabetical, Order: config.ImagePolicyOrderAsc},
+			expected: "main-ghi-100",
+		},
+		{
+			name:     "alphabetical descending order",
+			policy:   config.ImagePolicy{Regex: `^main-[a-z]+-\d+$`, Sort: config.ImagePolicySortAlphabetical, Order: config.ImagePolicyOrderDesc},
+			expected: "main-abc-9",
+		},
+		{
+			name:     "numerical order by capturing group",
+			policy:   config.ImagePolicy{Regex: `^main-[a-z]+-(\d+)$`, Sort: config.ImagePolicySortNumerical, Order: config.ImagePolicyOrderAsc},
+			expected: "main-ghi-100",
+		},
+		{
+			name:     "alphabetical order by capturing group",
+			policy:   config.ImagePolicy{Regex: `^main-[a-z]+-(\d+)$`, Sort: config.ImagePolicySortAlphabetical, Order: config.ImagePolicyOrderAsc},
+			expected: "main-abc-9",
+		},
+		{
+			name:    "no tag matching regex",
+			policy:  config.ImagePolicy{Regex: `^release-.*$`},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := selectTag(tags, tc.policy)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestRenderImageData(t *testing.T) {
+	t.Parallel()
+
+	image := polledImage{Image: "ghcr.io/pipe-cd/helloworld", Tag: "v1.0.0", Digest: "sha256:abc"}
+	testcases := []struct {
+		name     string
+		policy   config.PipedEventWatcherImagePolicy
+		expected string
+	}{
+		{
+			name:     "default for tag selection",
+			policy:   config.PipedEventWatcherImagePolicy{Policy: config.ImagePolicy{Semver: "^1.0.0"}},
+			expected: "ghcr.io/pipe-cd/helloworld:v1.0.0",
+		},
+		{
+			name:     "default for moving tag",
+			policy:   config.PipedEventWatcherImagePolicy{Policy: config.ImagePolicy{Tag: "latest"}},
+			expected: "ghcr.io/pipe-cd/helloworld@sha256:abc",
+		},
+		{
+			name:     "custom template",
+			policy:   config.PipedEventWatcherImagePolicy{Policy: config.ImagePolicy{Semver: "^1.0.0"}, Data: "{{ .Tag }}"},
+			expected: "v1.0.0",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := renderImageData(&tc.policy, image)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestImagePollerPoll(t *testing.T) {
+	t.Parallel()
+
+	registry := &fakeImageRegistry{
+		tags:    []string{"v1.0.0", "v1.1.0"},
+		digests: map[string]string{"latest": "sha256:abc"},
+	}
+	policies := []config.PipedEventWatcherImagePolicy{
+		{
+			EventName: "semver-update",
+			Labels:    map[string]string{"env": "dev"},
+			Image:     "ghcr.io/pipe-cd/helloworld",
+			Policy:    config.ImagePolicy{Semver: "^1.0.0"},
+		},
+		{
+			EventName: "digest-update",
+			Image:     "ghcr.io/pipe-cd/helloworld",
+			Policy:    config.ImagePolicy{Tag: "latest"},
+		},
+	}
+	p := newImagePoller(policies, registry, zap.NewNop())
+	now := time.Unix(100, 0)
+	p.nowFunc = func() time.Time { return now }
+
+	_, ok := p.LatestEvent("semver-update", map[string]string{"env": "dev"})
+	assert.False(t, ok)
+
+	for i := range policies {
+		require.NoError(t, p.poll(context.Background(), &policies[i]))
+	}
+
+	e, ok := p.LatestEvent("semver-update", map[string]string{"env": "dev"})
+	require.True(t, ok)
+	assert.Equal(t, "ghcr.io/pipe-cd/helloworld:v1.1.0", e.Data)
+	assert.Equal(t, int64(100), e.CreatedAt)
+
+	_, ok = p.LatestEvent("semver-update", nil)
+	assert.False(t, ok)
+
+	e, ok = p.LatestEvent("digest-update", nil)
+	require.True(t, ok)
+	assert.Equal(t, "ghcr.io/pipe-cd/helloworld@sha256:abc", e.Data)
+
+	// The event is kept as is if the chosen image was not changed.
+	now = time.Unix(200, 0)
+	require.NoError(t, p.poll(context.Background(), &policies[0]))
+	e, _ = p.LatestEvent("semver-update", map[string]string{"env": "dev"})
+	assert.Equal(t, int64(100), e.CreatedAt)
+
+	registry.tags = append(registry.tags, "v1.2.0")
+	require.NoError(t, p.poll(context.Background(), &policies[0]))
+	e, _ = p.LatestEvent("semver-update", map[string]string{"env": "dev"})
+	assert.Equal(t, "ghcr.io/pipe-cd/helloworld:v1.2.0", e.Data)
+	assert.Equal(t, int64(200), e.CreatedAt)
+}
+
+func TestExecutePolledImagesWithoutUpdates(t *testing.T) {
+	t.Parallel()
+
+	policies := []config.PipedEventWatcherImagePolicy{
+		{
+			EventName: "image-update",
+			Image:     "ghcr.io/pipe-cd/helloworld",
+			Policy:    config.ImagePolicy{Semver: "^1.0.0"},
+		},
+	}
+	p := newImagePoller(policies, &fakeImageRegistry{tags: []string{"v1.0.0"}}, zap.NewNop())
+	require.NoError(t, p.poll(context.Background(), &policies[0]))
+
+	w := &watcher{
+		imagePoller: p,
+		logger:      zap.NewNop(),
+	}
+	eventKey := model.MakeEventKey("image-update", nil)
+	w.polledImageMilestoneMap.Store("repo/app/"+eventKey, "ghcr.io/pipe-cd/helloworld:v1.0.0")
+
+	cfgs := []eventWatcherConfig{
+		{
+			GitPath: "app",
+			Configs: []config.EventWatcherConfig{
+				{
+					Matcher: config.EventWatcherMatcher{Name: "image-update"},
+					Handler: config.EventWatcherHandler{Type: config.EventWatcherHandlerTypeGitUpdate},
+				},
+				{
+					Matcher: config.EventWatcherMatcher{Name: "not-polled"},
+					Handler: config.EventWatcherHandler{Type: config.EventWatcherHandlerTypeGitUpdate},
+				},
+			},
+		},
+	}
+
+	// The repository must not be copied since no image was updated since the last push.
+	ctrl := gomock.NewController(t)
+	repo := gittest.NewMockRepo(ctrl)
+	assert.NoError(t, w.executePolledImages(context.Background(), repo, "repo", cfgs))
+}
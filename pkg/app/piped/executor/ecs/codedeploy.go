@@ -0,0 +1,391 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cdtypes "github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	// The ID of the CodeDeploy deployment created by this PipeCD deployment.
+	codeDeployDeploymentIDKey = "codedeploy-deployment-id"
+	// The percentage of traffic shifted first by the canary deployment configuration of the CodeDeploy deployment.
+	codeDeployCanaryPercentageKey = "codedeploy-canary-percentage"
+)
+
+// The interval to check the status of CodeDeploy deployments.
+var codeDeployStatusCheckInterval = 15 * time.Second
+
+func (e *deployExecutor) ensureCodeDeploy(ctx context.Context) model.StageStatus {
+	// The replacement task set is created and terminated by CodeDeploy,
+	// so there is nothing to clean here.
+	if model.Stage(e.Stage.Name) == model.StageECSCanaryClean {
+		e.LogPersister.Info("Nothing to clean since the original task set is terminated by CodeDeploy")
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	client, err := provider.DefaultRegistry().Client(e.platformProviderName, e.platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", e.platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if model.Stage(e.Stage.Name) == model.StageECSTrafficRouting {
+		if !codeDeployRouting(ctx, &e.Input, client) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	taskDefinition, ok := loadTaskDefinition(&e.Input, e.appCfg.Input.TaskDefinitionFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	serviceDefinition, ok := loadServiceDefinition(&e.Input, e.appCfg.Input.ServiceDefinitionFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	primary, _, ok := loadTargetGroups(&e.Input, e.appCfg, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if primary == nil {
+		e.LogPersister.Error("Primary target group is required to deploy via CodeDeploy")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	cdCfg := e.appCfg.Input.CodeDeploy
+	switch model.Stage(e.Stage.Name) {
+	case model.StageECSSync:
+		ok = codeDeploySync(ctx, &e.Input, client, cdCfg, taskDefinition, serviceDefinition, *primary)
+	case model.StageECSCanaryRollout:
+		ok = codeDeployCanaryRollout(ctx, &e.Input, client, cdCfg, e.appCfg.CodeDeployCanaryPercentage(), taskDefinition, serviceDefinition, *primary)
+	case model.StageECSPrimaryRollout:
+		ok = codeDeployPrimaryRollout(ctx, &e.Input, client, cdCfg, taskDefinition, serviceDefinition, *primary)
+	default:
+		e.LogPersister.Errorf("Unsupported stage %s for ECS application deployed via CodeDeploy", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// createCodeDeployDeployment applies the task definition and the service definition,
+// then creates a CodeDeploy deployment to replace the running task set by the new task definition.
+// The returned deployment ID is empty when the service has just been created with the new task definition.
+func createCodeDeployDeployment(ctx context.Context, in *executor.Input, client provider.Client, cdCfg *config.ECSCodeDeployConfig, deploymentConfigName string, taskDefinition types.TaskDefinition, serviceDefinition types.Service, targetGroup types.LoadBalancer) (string, bool) {
+	if !provider.IsCodeDeployService(serviceDefinition) {
+		in.LogPersister.Errorf("ECS service %s must use the CODE_DEPLOY deployment controller to be deployed via CodeDeploy", *serviceDefinition.ServiceName)
+		return "", false
+	}
+
+	in.LogPersister.Infof("Start applying the ECS task definition")
+	td, err := applyTaskDefinition(ctx, client, taskDefinition)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to apply ECS task definition: %v", err)
+		return "", false
+	}
+
+	// These are only used when the service is newly created,
+	// otherwise they are changed through the CodeDeploy deployment.
+	serviceDefinition.TaskDefinition = td.TaskDefinitionArn
+	serviceDefinition.LoadBalancers = []types.LoadBalancer{targetGroup}
+
+	in.LogPersister.Infof("Start applying the ECS service definition")
+	service, err := applyServiceDefinition(ctx, client, serviceDefinition)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to apply service %s: %v", *serviceDefinition.ServiceName, err)
+		return "", false
+	}
+
+	if aws.ToString(service.TaskDefinition) == *td.TaskDefinitionArn {
+		in.LogPersister.Infof("ECS service %s is already running task definition %s", *serviceDefinition.ServiceName, *td.TaskDefinitionArn)
+		return "", true
+	}
+
+	id, err := client.CreateCodeDeployDeployment(ctx, provider.CodeDeployDeployment{
+		ApplicationName:      cdCfg.ApplicationName,
+		DeploymentGroupName:  cdCfg.DeploymentGroupName,
+		DeploymentConfigName: deploymentConfigName,
+		TaskDefinitionArn:    *td.TaskDefinitionArn,
+		ContainerName:        aws.ToString(targetGroup.ContainerName),
+		ContainerPort:        aws.ToInt32(targetGroup.ContainerPort),
+		Description:          fmt.Sprintf("Triggered by PipeCD deployment %s", in.Deployment.Id),
+	})
+	if err != nil {
+		in.LogPersister.Errorf("Failed to create CodeDeploy deployment for service %s: %v", *serviceDefinition.ServiceName, err)
+		return "", false
+	}
+	in.LogPersister.Infof("Created CodeDeploy deployment %s", id)
+
+	// Store the deployment ID to continue or stop it in the later stages.
+	if err := in.MetadataStore.Shared().Put(ctx, codeDeployDeploymentIDKey, id); err != nil {
+		in.LogPersister.Errorf("Unable to store CodeDeploy deployment ID to metadata store: %v", err)
+		return "", false
+	}
+	return id, true
+}
+
+// waitCodeDeployDeployment blocks until the deployment reaches one of the given statuses.
+// It returns an error when the deployment fails or is stopped before reaching them.
+func waitCodeDeployDeployment(ctx context.Context, client provider.Client, deploymentID string, statuses ...cdtypes.DeploymentStatus) (cdtypes.DeploymentStatus, error) {
+	ticker := time.NewTicker(codeDeployStatusCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		status, err := client.GetCodeDeployDeploymentStatus(ctx, deploymentID)
+		if err != nil {
+			return "", err
+		}
+		if slices.Contains(statuses, status) {
+			return status, nil
+		}
+		if status == cdtypes.DeploymentStatusFailed || status == cdtypes.DeploymentStatusStopped {
+			return status, fmt.Errorf("CodeDeploy deployment %s was %s", deploymentID, status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// completeCodeDeployDeployment waits until the deployment succeeds.
+// Deployments waiting in the Ready state are continued to reroute all traffic to the replacement task set.
+func completeCodeDeployDeployment(ctx context.Context, in *executor.Input, client provider.Client, deploymentID string) bool {
+	in.LogPersister.Infof("Waiting for CodeDeploy deployment %s", deploymentID)
+	status, err := waitCodeDeployDeployment(ctx, client, deploymentID, cdtypes.DeploymentStatusReady, cdtypes.DeploymentStatusSucceeded)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to wait for CodeDeploy deployment %s: %v", deploymentID, err)
+		return false
+	}
+
+	if status == cdtypes.DeploymentStatusReady {
+		in.LogPersister.Infof("Rerouting all traffic to the replacement task set of CodeDeploy deployment %s", deploymentID)
+		if err := client.ContinueCodeDeployDeployment(ctx, deploymentID); err != nil {
+			in.LogPersister.Errorf("Failed to continue CodeDeploy deployment %s: %v", deploymentID, err)
+			return false
+		}
+		if _, err := waitCodeDeployDeployment(ctx, client, deploymentID, cdtypes.DeploymentStatusSucceeded); err != nil {
+			in.LogPersister.Errorf("Failed to wait for CodeDeploy deployment %s: %v", deploymentID, err)
+			return false
+		}
+	}
+
+	in.LogPersister.Infof("CodeDeploy deployment %s succeeded", deploymentID)
+	return true
+}
+
+func codeDeploySync(ctx context.Context, in *executor.Input, client provider.Client, cdCfg *config.ECSCodeDeployConfig, taskDefinition types.TaskDefinition, serviceDefinition types.Service, targetGroup types.LoadBalancer) bool {
+	id, ok := createCodeDeployDeployment(ctx, in, client, cdCfg, cdCfg.DeploymentConfigName, taskDefinition, serviceDefinition, targetGroup)
+	if !ok {
+		return false
+	}
+	if id == "" {
+		return true
+	}
+	return completeCodeDeployDeployment(ctx, in, client, id)
+}
+
+// codeDeployCanaryRollout creates a CodeDeploy deployment which waits in the Ready state
+// until the following ECS_TRAFFIC_ROUTING stages reroute traffic.
+// When one of them routes a part of traffic, the deployment uses the canary deployment configuration
+// which shifts that percentage of traffic first, otherwise the default config of the deployment group is used.
+func codeDeployCanaryRollout(ctx context.Context, in *executor.Input, client provider.Client, cdCfg *config.ECSCodeDeployConfig, canaryPercentage int, taskDefinition types.TaskDefinition, serviceDefinition types.Service, targetGroup types.LoadBalancer) bool {
+	wait, err := client.CodeDeployWaitsBeforeRerouting(ctx, cdCfg.ApplicationName, cdCfg.DeploymentGroupName)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to get CodeDeploy deployment group %s: %v", cdCfg.DeploymentGroupName, err)
+		return false
+	}
+	if !wait {
+		in.LogPersister.Errorf("CodeDeploy deployment group %s must be configured to wait before rerouting traffic so that the following stages can reroute it", cdCfg.DeploymentGroupName)
+		return false
+	}
+
+	var deploymentConfigName string
+	if canaryPercentage > 0 {
+		deploymentConfigName, err = client.EnsureCodeDeployCanaryConfig(ctx, int32(canaryPercentage), cdCfg.CanaryInterval)
+		if err != nil {
+			in.LogPersister.Errorf("Failed to prepare CodeDeploy deployment config to shift %d%% of traffic: %v", canaryPercentage, err)
+			return false
+		}
+		in.LogPersister.Infof("Using CodeDeploy deployment config %s which shifts %d%% of traffic first and the rest after %d minutes", deploymentConfigName, canaryPercentage, cdCfg.CanaryInterval)
+	}
+
+	id, ok := createCodeDeployDeployment(ctx, in, client, cdCfg, deploymentConfigName, taskDefinition, serviceDefinition, targetGroup)
+	if !ok {
+		return false
+	}
+	if id == "" {
+		return true
+	}
+
+	if err := in.MetadataStore.Shared().Put(ctx, codeDeployCanaryPercentageKey, strconv.Itoa(canaryPercentage)); err != nil {
+		in.LogPersister.Errorf("Unable to store CodeDeploy canary percentage to metadata store: %v", err)
+		return false
+	}
+
+	in.LogPersister.Infof("Waiting for the replacement task set of CodeDeploy deployment %s to be ready", id)
+	status, err := waitCodeDeployDeployment(ctx, client, id, cdtypes.DeploymentStatusReady, cdtypes.DeploymentStatusSucceeded)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to wait for CodeDeploy deployment %s: %v", id, err)
+		return false
+	}
+	if status == cdtypes.DeploymentStatusSucceeded {
+		in.LogPersister.Errorf("CodeDeploy deployment %s has rerouted all traffic at once. Configure the deployment group to wait before rerouting traffic", id)
+		return false
+	}
+
+	in.LogPersister.Infof("The replacement task set of CodeDeploy deployment %s is ready", id)
+	return true
+}
+
+func codeDeployPrimaryRollout(ctx context.Context, in *executor.Input, client provider.Client, cdCfg *config.ECSCodeDeployConfig, taskDefinition types.TaskDefinition, serviceDefinition types.Service, targetGroup types.LoadBalancer) bool {
+	// Complete the deployment created at the ECS_CANARY_ROLLOUT stage if exists.
+	if id, ok := in.MetadataStore.Shared().Get(codeDeployDeploymentIDKey); ok {
+		return completeCodeDeployDeployment(ctx, in, client, id)
+	}
+	return codeDeploySync(ctx, in, client, cdCfg, taskDefinition, serviceDefinition, targetGroup)
+}
+
+// codeDeployRouting reroutes traffic of the in-progress CodeDeploy deployment.
+// The listeners of the deployment group are controlled by CodeDeploy, so they are never modified here.
+// Instead, traffic is rerouted to the replacement task set by continuing the deployment,
+// which shifts traffic as configured in the deployment configuration of CodeDeploy.
+func codeDeployRouting(ctx context.Context, in *executor.Input, client provider.Client) bool {
+	options := in.StageConfig.ECSTrafficRoutingStageOptions
+	if options == nil {
+		in.LogPersister.Errorf("Malformed configuration for stage %s", in.Stage.Name)
+		return false
+	}
+	primary, canary := options.Percentage()
+
+	id, ok := in.MetadataStore.Shared().Get(codeDeployDeploymentIDKey)
+	if !ok {
+		in.LogPersister.Error("No CodeDeploy deployment to route traffic. ECS_CANARY_ROLLOUT stage must be run before")
+		return false
+	}
+
+	// A part of traffic can be routed only by the canary deployment configuration
+	// which was chosen for the percentage when the deployment was created.
+	if canary != 0 && canary != 100 {
+		if v, _ := in.MetadataStore.Shared().Get(codeDeployCanaryPercentageKey); v != strconv.Itoa(canary) {
+			in.LogPersister.Errorf("Unable to route %d%% of traffic to the replacement task set since CodeDeploy deployment %s was not created to shift that percentage of traffic", canary, id)
+			return false
+		}
+	}
+
+	metadataPercentage := map[string]string{
+		trafficRoutePrimaryMetadataKey: strconv.FormatInt(int64(primary), 10),
+		trafficRouteCanaryMetadataKey:  strconv.FormatInt(int64(canary), 10),
+	}
+	if err := in.MetadataStore.Stage(in.Stage.Id).PutMulti(ctx, metadataPercentage); err != nil {
+		in.Logger.Error("Failed to store traffic routing config to metadata store", zap.Error(err))
+	}
+
+	switch canary {
+	case 0:
+		in.LogPersister.Infof("All traffic is kept on the original task set of CodeDeploy deployment %s", id)
+		return true
+	case 100:
+		return completeCodeDeployDeployment(ctx, in, client, id)
+	default:
+		return startCodeDeployCanaryShift(ctx, in, client, id, canary)
+	}
+}
+
+// startCodeDeployCanaryShift continues the deployment waiting in the Ready state,
+// then CodeDeploy shifts the given percentage of traffic first and the rest after the canary interval.
+func startCodeDeployCanaryShift(ctx context.Context, in *executor.Input, client provider.Client, deploymentID string, canary int) bool {
+	status, err := client.GetCodeDeployDeploymentStatus(ctx, deploymentID)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to get CodeDeploy deployment %s: %v", deploymentID, err)
+		return false
+	}
+	switch status {
+	case cdtypes.DeploymentStatusReady:
+		in.LogPersister.Infof("Rerouting %d%% of traffic to the replacement task set of CodeDeploy deployment %s", canary, deploymentID)
+		if err := client.ContinueCodeDeployDeployment(ctx, deploymentID); err != nil {
+			in.LogPersister.Errorf("Failed to continue CodeDeploy deployment %s: %v", deploymentID, err)
+			return false
+		}
+	case cdtypes.DeploymentStatusInProgress:
+		// For example, this stage is retried after the deployment was continued.
+		in.LogPersister.Infof("CodeDeploy deployment %s is already rerouting traffic", deploymentID)
+	default:
+		in.LogPersister.Errorf("Unable to reroute traffic of CodeDeploy deployment %s in %s status", deploymentID, status)
+		return false
+	}
+	in.LogPersister.Infof("CodeDeploy deployment %s shifts %d%% of traffic to the replacement task set, then the rest after the canary interval", deploymentID, canary)
+	return true
+}
+
+// codeDeployRollback stops the in-progress CodeDeploy deployment to let CodeDeploy reroute traffic back
+// to the original task set. When there is no deployment to stop, it redeploys the running task definition.
+func codeDeployRollback(ctx context.Context, in *executor.Input, client provider.Client, cdCfg *config.ECSCodeDeployConfig, taskDefinition types.TaskDefinition, serviceDefinition types.Service, targetGroup types.LoadBalancer) bool {
+	if id, ok := in.MetadataStore.Shared().Get(codeDeployDeploymentIDKey); ok {
+		status, err := client.GetCodeDeployDeploymentStatus(ctx, id)
+		if err != nil && !errors.Is(err, platformprovider.ErrNotFound) {
+			in.LogPersister.Errorf("Failed to get CodeDeploy deployment %s: %v", id, err)
+			return false
+		}
+
+		switch status {
+		case cdtypes.DeploymentStatusCreated,
+			cdtypes.DeploymentStatusQueued,
+			cdtypes.DeploymentStatusInProgress,
+			cdtypes.DeploymentStatusReady:
+			in.LogPersister.Infof("Stopping CodeDeploy deployment %s and rolling it back", id)
+			if err := client.StopCodeDeployDeployment(ctx, id, true); err != nil {
+				in.LogPersister.Errorf("Failed to stop CodeDeploy deployment %s: %v", id, err)
+				return false
+			}
+			if _, err := waitCodeDeployDeployment(ctx, client, id, cdtypes.DeploymentStatusStopped); err != nil {
+				in.LogPersister.Errorf("Failed to wait for CodeDeploy deployment %s to be stopped: %v", id, err)
+				return false
+			}
+			in.LogPersister.Infof("Stopped CodeDeploy deployment %s and rolled back the ECS service %s", id, *serviceDefinition.ServiceName)
+			return true
+		}
+	}
+
+	in.LogPersister.Infof("Start rolling back the ECS service %s by a new CodeDeploy deployment", *serviceDefinition.ServiceName)
+	if !codeDeploySync(ctx, in, client, cdCfg, taskDefinition, serviceDefinition, targetGroup) {
+		return false
+	}
+	in.LogPersister.Infof("Rolled back the ECS service %s and task definition %s configuration to original stage", *serviceDefinition.ServiceName, *taskDefinition.Family)
+	return true
+}
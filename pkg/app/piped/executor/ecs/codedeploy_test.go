@@ -0,0 +1,481 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cdtypes "github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/metadatastore"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeLogPersister struct{}
+
+func (l *fakeLogPersister) Write(_ []byte) (int, error)         { return 0, nil }
+func (l *fakeLogPersister) Info(_ string)                       {}
+func (l *fakeLogPersister) Infof(_ string, _ ...interface{})    {}
+func (l *fakeLogPersister) Success(_ string)                    {}
+func (l *fakeLogPersister) Successf(_ string, _ ...interface{}) {}
+func (l *fakeLogPersister) Error(_ string)                      {}
+func (l *fakeLogPersister) Errorf(_ string, _ ...interface{})   {}
+
+type fakeMetadataStore struct {
+	shared *fakeStore
+	stages map[string]*fakeStore
+}
+
+func newFakeMetadataStore() *fakeMetadataStore {
+	return &fakeMetadataStore{
+		shared: &fakeStore{data: map[string]string{}},
+		stages: map[string]*fakeStore{},
+	}
+}
+
+func (m *fakeMetadataStore) Shared() metadatastore.Store {
+	return m.shared
+}
+
+func (m *fakeMetadataStore) Stage(stageID string) metadatastore.Store {
+	if _, ok := m.stages[stageID]; !ok {
+		m.stages[stageID] = &fakeStore{data: map[string]string{}}
+	}
+	return m.stages[stageID]
+}
+
+type fakeStore struct {
+	data map[string]string
+}
+
+func (s *fakeStore) Get(key string) (string, bool) {
+	v, ok := s.data[key]
+	return v, ok
+}
+
+func (s *fakeStore) Put(_ context.Context, key, value string) error {
+	s.data[key] = value
+	return nil
+}
+
+func (s *fakeStore) PutMulti(ctx context.Context, md map[string]string) error {
+	for k, v := range md {
+		s.Put(ctx, k, v)
+	}
+	return nil
+}
+
+// fakeClient implements the methods of provider.Client used by CodeDeploy deployments.
+// Calling other methods panics.
+type fakeClient struct {
+	provider.Client
+
+	serviceExists bool
+	statuses      []cdtypes.DeploymentStatus
+	doesNotWait   bool
+	canaryConfigs []string
+
+	createdService     *types.Service
+	updatedService     *types.Service
+	createdDeployments []provider.CodeDeployDeployment
+	continued          []string
+	stopped            []string
+}
+
+func (c *fakeClient) RegisterTaskDefinition(_ context.Context, td types.TaskDefinition) (*types.TaskDefinition, error) {
+	td.TaskDefinitionArn = aws.String("arn:aws:ecs:task-definition/" + *td.Family + ":2")
+	return &td, nil
+}
+
+func (c *fakeClient) ServiceExists(_ context.Context, _ string, _ string) (bool, error) {
+	return c.serviceExists, nil
+}
+
+func (c *fakeClient) CreateService(_ context.Context, service types.Service) (*types.Service, error) {
+	c.createdService = &service
+	return &service, nil
+}
+
+func (c *fakeClient) UpdateService(_ context.Context, service types.Service) (*types.Service, error) {
+	c.updatedService = &service
+	return &types.Service{
+		ServiceArn:     aws.String("arn:aws:ecs:service/" + *service.ServiceName),
+		ServiceName:    service.ServiceName,
+		TaskDefinition: aws.String("arn:aws:ecs:task-definition/web:1"),
+	}, nil
+}
+
+func (c *fakeClient) ListTags(_ context.Context, _ string) ([]types.Tag, error) {
+	return nil, nil
+}
+
+func (c *fakeClient) TagResource(_ context.Context, _ string, _ []types.Tag) error {
+	return nil
+}
+
+func (c *fakeClient) CreateCodeDeployDeployment(_ context.Context, d provider.CodeDeployDeployment) (string, error) {
+	c.createdDeployments = append(c.createdDeployments, d)
+	return "d-XXXXXXXXX", nil
+}
+
+func (c *fakeClient) GetCodeDeployDeploymentStatus(_ context.Context, _ string) (cdtypes.DeploymentStatus, error) {
+	status := c.statuses[0]
+	if len(c.statuses) > 1 {
+		c.statuses = c.statuses[1:]
+	}
+	return status, nil
+}
+
+func (c *fakeClient) ContinueCodeDeployDeployment(_ context.Context, id string) error {
+	c.continued = append(c.continued, id)
+	return nil
+}
+
+func (c *fakeClient) CodeDeployWaitsBeforeRerouting(_ context.Context, _, _ string) (bool, error) {
+	return !c.doesNotWait, nil
+}
+
+func (c *fakeClient) EnsureCodeDeployCanaryConfig(_ context.Context, percentage, intervalMinutes int32) (string, error) {
+	name := fmt.Sprintf("canary-%d-%d", percentage, intervalMinutes)
+	c.canaryConfigs = append(c.canaryConfigs, name)
+	return name, nil
+}
+
+func (c *fakeClient) StopCodeDeployDeployment(_ context.Context, id string, rollback bool) error {
+	if rollback {
+		c.stopped = append(c.stopped, id)
+	}
+	return nil
+}
+
+func newTestInput(store *fakeMetadataStore, stageConfig config.PipelineStage) *executor.Input {
+	return &executor.Input{
+		Deployment:    &model.Deployment{Id: "deployment-id"},
+		Stage:         &model.PipelineStage{Id: "stage-id", Name: stageConfig.Name.String()},
+		StageConfig:   stageConfig,
+		LogPersister:  &fakeLogPersister{},
+		MetadataStore: store,
+		Logger:        zap.NewNop(),
+	}
+}
+
+func codeDeployServiceDefinition() types.Service {
+	return types.Service{
+		ClusterArn:  aws.String("arn:aws:ecs:cluster/default"),
+		ServiceName: aws.String("web"),
+		DeploymentController: &types.DeploymentController{
+			Type: types.DeploymentControllerTypeCodeDeploy,
+		},
+	}
+}
+
+var (
+	testCodeDeployConfig = &config.ECSCodeDeployConfig{
+		ApplicationName:      "app",
+		DeploymentGroupName:  "group",
+		DeploymentConfigName: "CodeDeployDefault.ECSAllAtOnce",
+		CanaryInterval:       60,
+	}
+	testTaskDefinition = types.TaskDefinition{Family: aws.String("web")}
+	testTargetGroup    = types.LoadBalancer{
+		TargetGroupArn: aws.String("arn:aws:elasticloadbalancing:targetgroup/blue"),
+		ContainerName:  aws.String("web"),
+		ContainerPort:  aws.Int32(80),
+	}
+)
+
+func TestCodeDeploySync(t *testing.T) {
+	codeDeployStatusCheckInterval = time.Millisecond
+
+	testcases := []struct {
+		name                string
+		serviceExists       bool
+		serviceDefinition   types.Service
+		statuses            []cdtypes.DeploymentStatus
+		expected            bool
+		expectedDeployments int
+		expectedContinued   []string
+	}{
+		{
+			name:                "newly created service does not need a deployment",
+			serviceDefinition:   codeDeployServiceDefinition(),
+			expected:            true,
+			expectedDeployments: 0,
+		},
+		{
+			name:                "deployment waiting in ready state is continued",
+			serviceExists:       true,
+			serviceDefinition:   codeDeployServiceDefinition(),
+			statuses:            []cdtypes.DeploymentStatus{cdtypes.DeploymentStatusInProgress, cdtypes.DeploymentStatusReady, cdtypes.DeploymentStatusSucceeded},
+			expected:            true,
+			expectedDeployments: 1,
+			expectedContinued:   []string{"d-XXXXXXXXX"},
+		},
+		{
+			name:                "failed deployment",
+			serviceExists:       true,
+			serviceDefinition:   codeDeployServiceDefinition(),
+			statuses:            []cdtypes.DeploymentStatus{cdtypes.DeploymentStatusInProgress, cdtypes.DeploymentStatusFailed},
+			expected:            false,
+			expectedDeployments: 1,
+		},
+		{
+			name:          "service not using CODE_DEPLOY controller",
+			serviceExists: true,
+			serviceDefinition: types.Service{
+				ClusterArn:  aws.String("arn:aws:ecs:cluster/default"),
+				ServiceName: aws.String("web"),
+			},
+			expected:            false,
+			expectedDeployments: 0,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &fakeClient{serviceExists: tc.serviceExists, statuses: tc.statuses}
+			store := newFakeMetadataStore()
+			in := newTestInput(store, config.PipelineStage{Name: model.StageECSSync})
+
+			got := codeDeploySync(context.Background(), in, client, testCodeDeployConfig, testTaskDefinition, tc.serviceDefinition, testTargetGroup)
+			assert.Equal(t, tc.expected, got)
+			require.Len(t, client.createdDeployments, tc.expectedDeployments)
+			assert.Equal(t, tc.expectedContinued, client.continued)
+
+			if tc.expectedDeployments > 0 {
+				d := client.createdDeployments[0]
+				assert.Equal(t, "CodeDeployDefault.ECSAllAtOnce", d.DeploymentConfigName)
+				assert.Equal(t, "arn:aws:ecs:task-definition/web:2", d.TaskDefinitionArn)
+				assert.Equal(t, "web", d.ContainerName)
+				assert.Equal(t, int32(80), d.ContainerPort)
+
+				id, ok := store.Shared().Get(codeDeployDeploymentIDKey)
+				assert.True(t, ok)
+				assert.Equal(t, "d-XXXXXXXXX", id)
+			}
+		})
+	}
+}
+
+func TestCodeDeployCanaryRollout(t *testing.T) {
+	codeDeployStatusCheckInterval = time.Millisecond
+
+	testcases := []struct {
+		name                 string
+		canaryPercentage     int
+		doesNotWait          bool
+		statuses             []cdtypes.DeploymentStatus
+		expected             bool
+		expectedDeployments  int
+		expectedConfigName   string
+		expectedCanaryConfig []string
+	}{
+		{
+			name:                "use the default config of the deployment group",
+			statuses:            []cdtypes.DeploymentStatus{cdtypes.DeploymentStatusInProgress, cdtypes.DeploymentStatusReady},
+			expected:            true,
+			expectedDeployments: 1,
+		},
+		{
+			name:                 "use the canary config to shift a part of traffic",
+			canaryPercentage:     20,
+			statuses:             []cdtypes.DeploymentStatus{cdtypes.DeploymentStatusInProgress, cdtypes.DeploymentStatusReady},
+			expected:             true,
+			expectedDeployments:  1,
+			expectedConfigName:   "canary-20-60",
+			expectedCanaryConfig: []string{"canary-20-60"},
+		},
+		{
+			name:                "deployment group not waiting before rerouting traffic",
+			doesNotWait:         true,
+			expected:            false,
+			expectedDeployments: 0,
+		},
+		{
+			name:                "all traffic was rerouted at once",
+			statuses:            []cdtypes.DeploymentStatus{cdtypes.DeploymentStatusInProgress, cdtypes.DeploymentStatusSucceeded},
+			expected:            false,
+			expectedDeployments: 1,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &fakeClient{
+				serviceExists: true,
+				statuses:      tc.statuses,
+				doesNotWait:   tc.doesNotWait,
+			}
+			store := newFakeMetadataStore()
+			in := newTestInput(store, config.PipelineStage{Name: model.StageECSCanaryRollout})
+
+			got := codeDeployCanaryRollout(context.Background(), in, client, testCodeDeployConfig, tc.canaryPercentage, testTaskDefinition, codeDeployServiceDefinition(), testTargetGroup)
+			assert.Equal(t, tc.expected, got)
+			require.Len(t, client.createdDeployments, tc.expectedDeployments)
+			assert.Equal(t, tc.expectedCanaryConfig, client.canaryConfigs)
+			// Traffic must not be rerouted until the following stages.
+			assert.Empty(t, client.continued)
+
+			if tc.expectedDeployments > 0 {
+				assert.Equal(t, tc.expectedConfigName, client.createdDeployments[0].DeploymentConfigName)
+				percentage, ok := store.Shared().Get(codeDeployCanaryPercentageKey)
+				assert.True(t, ok)
+				assert.Equal(t, strconv.Itoa(tc.canaryPercentage), percentage)
+			}
+		})
+	}
+}
+
+func TestCodeDeployRouting(t *testing.T) {
+	codeDeployStatusCheckInterval = time.Millisecond
+
+	testcases := []struct {
+		name              string
+		deploymentID      string
+		canaryPercentage  string
+		options           *config.ECSTrafficRoutingStageOptions
+		statuses          []cdtypes.DeploymentStatus
+		expected          bool
+		expectedContinued []string
+	}{
+		{
+			name:              "reroute all traffic to the replacement task set",
+			deploymentID:      "d-XXXXXXXXX",
+			options:           &config.ECSTrafficRoutingStageOptions{Canary: config.Percentage{Number: 100}},
+			statuses:          []cdtypes.DeploymentStatus{cdtypes.DeploymentStatusReady, cdtypes.DeploymentStatusSucceeded},
+			expected:          true,
+			expectedContinued: []string{"d-XXXXXXXXX"},
+		},
+		{
+			name:         "keep all traffic on the original task set",
+			deploymentID: "d-XXXXXXXXX",
+			options:      &config.ECSTrafficRoutingStageOptions{Primary: config.Percentage{Number: 100}},
+			expected:     true,
+		},
+		{
+			name:              "start shifting a part of traffic",
+			deploymentID:      "d-XXXXXXXXX",
+			canaryPercentage:  "20",
+			options:           &config.ECSTrafficRoutingStageOptions{Canary: config.Percentage{Number: 20}},
+			statuses:          []cdtypes.DeploymentStatus{cdtypes.DeploymentStatusReady},
+			expected:          true,
+			expectedContinued: []string{"d-XXXXXXXXX"},
+		},
+		{
+			name:             "a part of traffic is already being shifted",
+			deploymentID:     "d-XXXXXXXXX",
+			canaryPercentage: "20",
+			options:          &config.ECSTrafficRoutingStageOptions{Canary: config.Percentage{Number: 20}},
+			statuses:         []cdtypes.DeploymentStatus{cdtypes.DeploymentStatusInProgress},
+			expected:         true,
+		},
+		{
+			name:             "deployment not created to shift the percentage of traffic",
+			deploymentID:     "d-XXXXXXXXX",
+			canaryPercentage: "0",
+			options:          &config.ECSTrafficRoutingStageOptions{Canary: config.Percentage{Number: 20}},
+			expected:         false,
+		},
+		{
+			name:     "no in-progress deployment",
+			options:  &config.ECSTrafficRoutingStageOptions{Canary: config.Percentage{Number: 100}},
+			expected: false,
+		},
+		{
+			name:         "malformed stage options",
+			deploymentID: "d-XXXXXXXXX",
+			expected:     false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &fakeClient{statuses: tc.statuses}
+			store := newFakeMetadataStore()
+			if tc.deploymentID != "" {
+				store.Shared().Put(context.Background(), codeDeployDeploymentIDKey, tc.deploymentID)
+			}
+			if tc.canaryPercentage != "" {
+				store.Shared().Put(context.Background(), codeDeployCanaryPercentageKey, tc.canaryPercentage)
+			}
+			in := newTestInput(store, config.PipelineStage{
+				Name:                          model.StageECSTrafficRouting,
+				ECSTrafficRoutingStageOptions: tc.options,
+			})
+
+			got := codeDeployRouting(context.Background(), in, client)
+			assert.Equal(t, tc.expected, got)
+			assert.Equal(t, tc.expectedContinued, client.continued)
+		})
+	}
+}
+
+func TestCodeDeployRollback(t *testing.T) {
+	codeDeployStatusCheckInterval = time.Millisecond
+
+	testcases := []struct {
+		name                string
+		deploymentID        string
+		statuses            []cdtypes.DeploymentStatus
+		expectedStopped     []string
+		expectedDeployments int
+	}{
+		{
+			name:            "stop the in-progress deployment",
+			deploymentID:    "d-XXXXXXXXX",
+			statuses:        []cdtypes.DeploymentStatus{cdtypes.DeploymentStatusReady, cdtypes.DeploymentStatusStopped},
+			expectedStopped: []string{"d-XXXXXXXXX"},
+		},
+		{
+			name:                "redeploy the running task definition after the deployment succeeded",
+			deploymentID:        "d-XXXXXXXXX",
+			statuses:            []cdtypes.DeploymentStatus{cdtypes.DeploymentStatusSucceeded},
+			expectedDeployments: 1,
+		},
+		{
+			name:                "redeploy the running task definition when no deployment was created",
+			statuses:            []cdtypes.DeploymentStatus{cdtypes.DeploymentStatusSucceeded},
+			expectedDeployments: 1,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &fakeClient{serviceExists: true, statuses: tc.statuses}
+			store := newFakeMetadataStore()
+			if tc.deploymentID != "" {
+				store.Shared().Put(context.Background(), codeDeployDeploymentIDKey, tc.deploymentID)
+			}
+			in := newTestInput(store, config.PipelineStage{Name: model.StageRollback})
+
+			got := codeDeployRollback(context.Background(), in, client, testCodeDeployConfig, testTaskDefinition, codeDeployServiceDefinition(), testTargetGroup)
+			assert.True(t, got)
+			assert.Equal(t, tc.expectedStopped, client.stopped)
+			assert.Len(t, client.createdDeployments, tc.expectedDeployments)
+		})
+	}
+}
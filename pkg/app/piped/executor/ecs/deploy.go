@@ -60,6 +60,11 @@ func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
 		status         model.StageStatus
 	)
 
+	if e.appCfg.Input.IsCodeDeploy() {
+		status = e.ensureCodeDeploy(ctx)
+		return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+	}
+
 	switch model.Stage(e.Stage.Name) {
 	case model.StageECSSync:
 		status = e.ensureSync(ctx)
@@ -87,6 +87,22 @@ func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	if appCfg.Input.IsCodeDeploy() {
+		if primary == nil {
+			e.LogPersister.Error("Primary target group is required to roll back via CodeDeploy")
+			return model.StageStatus_STAGE_FAILURE
+		}
+		client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, e.Logger)
+		if err != nil {
+			e.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", platformProviderName, err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+		if !codeDeployRollback(ctx, &e.Input, client, appCfg.Input.CodeDeploy, taskDefinition, serviceDefinition, *primary) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
 	if !rollback(ctx, &e.Input, platformProviderName, platformProviderCfg, taskDefinition, serviceDefinition, primary, canary) {
 		return model.StageStatus_STAGE_FAILURE
 	}
@@ -77,7 +77,10 @@ type Input struct {
 	// Deploy source at target commit
 	TargetDSP deploysource.Provider
 	// Deploy source at running commit
-	RunningDSP            deploysource.Provider
+	RunningDSP deploysource.Provider
+	// The directory dedicated to the deployment.
+	// It is kept until the deployment completes, so it can be used to pass files between stages.
+	WorkingDir            string
 	GitClient             GitClient
 	CommandLister         CommandLister
 	LogPersister          LogPersister
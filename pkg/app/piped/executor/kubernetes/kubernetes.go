@@ -720,25 +720,14 @@ func patchManifest(m provider.Manifest, patch config.K8sResourcePatch) (*provide
 		return nil, err
 	}
 
-	process := func(bytes []byte) ([]byte, error) {
-		p, err := yamlprocessor.NewProcessor(bytes)
-		if err != nil {
-			return nil, err
-		}
-
+	process := func(bytes []byte, dataStruct interface{}) ([]byte, error) {
 		for _, o := range patch.Ops {
-			switch o.Op {
-			case config.K8sResourcePatchOpYAMLReplace:
-				if err := p.ReplaceString(o.Path, o.Value); err != nil {
-					return nil, fmt.Errorf("failed to replace value at path: %s, error: %w", o.Path, err)
-				}
-			default:
-				// TODO: Support more patch operation for K8sCanaryRolloutStageOptions.
-				return nil, fmt.Errorf("%s operation is not supported currently", o.Op)
+			var err error
+			if bytes, err = applyPatchOp(bytes, o, dataStruct); err != nil {
+				return nil, err
 			}
 		}
-
-		return p.Bytes(), nil
+		return bytes, nil
 	}
 
 	buildManifest := func(bytes []byte) (*provider.Manifest, error) {
@@ -756,7 +745,7 @@ func patchManifest(m provider.Manifest, patch config.K8sResourcePatch) (*provide
 	// just pass full bytes to process and build a new manifest based on the returned data.
 	root := patch.Target.DocumentRoot
 	if root == "" {
-		out, err := process(fullBytes)
+		out, err := process(fullBytes, strategicMergeDataStruct(m))
 		if err != nil {
 			return nil, err
 		}
@@ -780,7 +769,8 @@ func patchManifest(m provider.Manifest, patch config.K8sResourcePatch) (*provide
 	}
 
 	// And process that field data.
-	out, err := process([]byte(sv))
+	// Its type is unknown so strategic-merge falls back to the JSON merge patch.
+	out, err := process([]byte(sv), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -969,6 +969,86 @@ func TestPatchManifest(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:      "json patch ops",
+			manifests: "testdata/patch_deployment_json_ops.yaml",
+			patch: config.K8sResourcePatch{
+				Ops: []config.K8sResourcePatchOp{
+					{
+						Op:    config.K8sResourcePatchOpJSONTest,
+						Path:  "/spec/replicas",
+						Value: "3",
+					},
+					{
+						Op:    config.K8sResourcePatchOpJSONReplace,
+						Path:  "/spec/replicas",
+						Value: "1",
+					},
+					{
+						Op:   config.K8sResourcePatchOpJSONRemove,
+						Path: "/metadata/annotations",
+					},
+					{
+						Op:   config.K8sResourcePatchOpJSONCopy,
+						From: "/metadata/labels/app",
+						Path: "/metadata/labels/component",
+					},
+					{
+						Op:   config.K8sResourcePatchOpJSONMove,
+						From: "/spec/template/metadata/labels/team",
+						Path: "/spec/template/metadata/labels/owner",
+					},
+					{
+						Op:   config.K8sResourcePatchOpJSONAdd,
+						Path: "/spec/template/spec/containers/-",
+						Value: `name: envoy
+image: envoyproxy/envoy:v1.30.0
+args: [--config-path, /etc/envoy/envoy.yaml]`,
+					},
+				},
+			},
+		},
+		{
+			name:      "strategic merge",
+			manifests: "testdata/patch_deployment_strategic_merge.yaml",
+			patch: config.K8sResourcePatch{
+				Ops: []config.K8sResourcePatchOp{
+					{
+						Op: config.K8sResourcePatchOpStrategicMerge,
+						Value: `spec:
+  template:
+    spec:
+      containers:
+      - name: helloworld
+        env:
+        - name: LOG_LEVEL
+          value: debug
+        - name: CANARY
+          value: "true"`,
+					},
+				},
+			},
+		},
+		{
+			name:      "json patch ops with a given field",
+			manifests: "testdata/patch_configmap_field_json_ops.yaml",
+			patch: config.K8sResourcePatch{
+				Target: config.K8sResourcePatchTarget{
+					DocumentRoot: "$.data.envoy-config",
+				},
+				Ops: []config.K8sResourcePatchOp{
+					{
+						Op:    config.K8sResourcePatchOpJSONReplace,
+						Path:  "/admin/address/socket_address/port_value",
+						Value: "9096",
+					},
+					{
+						Op:   config.K8sResourcePatchOpJSONRemove,
+						Path: "/static_resources/clusters/1",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testcases {
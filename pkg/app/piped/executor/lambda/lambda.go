@@ -116,6 +116,9 @@ func sync(ctx context.Context, in *executor.Input, platformProviderName string,
 			in.LogPersister.Errorf("Failed to create traffic routing for Lambda function %s (version: %s): %v", fm.Spec.Name, version, err)
 			return false
 		}
+		if !updateAliasConfig(ctx, in, client, fm) {
+			return false
+		}
 		in.LogPersister.Infof("Successfully applied the lambda function manifest")
 		return true
 	}
@@ -148,6 +151,10 @@ func sync(ctx context.Context, in *executor.Input, platformProviderName string,
 		return false
 	}
 
+	if !updateAliasConfig(ctx, in, client, fm) {
+		return false
+	}
+
 	in.LogPersister.Infof("Successfully applied the manifest for Lambda function %s version (v%s)", fm.Spec.Name, version)
 	return true
 }
@@ -224,6 +231,9 @@ func promote(ctx context.Context, in *executor.Input, platformProviderName strin
 			in.LogPersister.Errorf("Failed to create traffic routing for Lambda function %s (version: %s): %v", fm.Spec.Name, version, err)
 			return false
 		}
+		if !updateAliasConfig(ctx, in, client, fm) {
+			return false
+		}
 		in.LogPersister.Infof("Successfully route all traffic to the lambda function %s (version %s)", fm.Spec.Name, version)
 		return true
 	}
@@ -255,10 +265,29 @@ func promote(ctx context.Context, in *executor.Input, platformProviderName strin
 		return false
 	}
 
+	if !updateAliasConfig(ctx, in, client, fm) {
+		return false
+	}
+
 	in.LogPersister.Infof("Successfully promote new version (v%s) of Lambda function %s, it will handle %v percent of traffic", version, fm.Spec.Name, options.Percent)
 	return true
 }
 
+// updateAliasConfig applies the configurations which are attached to the alias,
+// such as the provisioned concurrency, the event source mappings and the function URL,
+// since they follow the versions the alias routes the traffic to.
+func updateAliasConfig(ctx context.Context, in *executor.Input, client provider.Client, fm provider.FunctionManifest) bool {
+	if fm.Spec.ProvisionedConcurrentExecutions == nil && fm.Spec.EventSourceMappings == nil && fm.Spec.FunctionURL == nil {
+		return true
+	}
+	if err := client.UpdateAliasConfig(ctx, fm); err != nil {
+		in.LogPersister.Errorf("Failed to update the configuration of the alias of Lambda function %s: %v", fm.Spec.Name, err)
+		return false
+	}
+	in.LogPersister.Infof("Successfully updated the configuration of the alias of Lambda function %s", fm.Spec.Name)
+	return true
+}
+
 func configureTrafficRouting(trafficCfg provider.RoutingTrafficConfig, version string, percent int) bool {
 	// The primary version has to be set on trafficCfg.
 	primary, ok := trafficCfg[provider.TrafficPrimaryVersionKeyName]
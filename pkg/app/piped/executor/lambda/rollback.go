@@ -134,7 +134,7 @@ func rollback(ctx context.Context, in *executor.Input, platformProviderName stri
 			in.LogPersister.Errorf("Failed to rollback original traffic config for Lambda function %s: %v", fm.Spec.Name, err)
 			return false
 		}
-		return true
+		return updateAliasConfig(ctx, in, client, fm)
 	// Original traffic config is PRIMARY ONLY config,
 	// we need to reset any others SECONDARY created by previous (until failed) PROMOTE stages.
 	case 1:
@@ -155,7 +155,7 @@ func rollback(ctx context.Context, in *executor.Input, platformProviderName stri
 			in.LogPersister.Errorf("Failed to rollback original traffic config for Lambda function %s: %v", fm.Spec.Name, err)
 			return false
 		}
-		return true
+		return updateAliasConfig(ctx, in, client, fm)
 	default:
 		in.LogPersister.Errorf("Unable to prepare original traffic config: invalid original traffic config stored")
 		return false
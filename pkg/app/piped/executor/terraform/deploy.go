@@ -16,6 +16,10 @@ package terraform
 
 import (
 	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/terraform"
@@ -65,7 +69,7 @@ func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
 	)
 
 	var ok bool
-	e.terraformPath, ok = findTerraform(ctx, e.appCfg.Input.TerraformVersion, e.LogPersister)
+	e.terraformPath, ok = findTerraform(ctx, e.appCfg.Input, e.LogPersister)
 	if !ok {
 		return model.StageStatus_STAGE_FAILURE
 	}
@@ -115,7 +119,14 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
-	planResult, err := cmd.Plan(ctx, e.LogPersister)
+	planFile, err := e.preparePlanFile()
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare the plan file (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	defer os.Remove(planFile)
+
+	planResult, err := cmd.SavePlan(ctx, e.LogPersister, planFile)
 	if err != nil {
 		e.LogPersister.Errorf("Failed to plan (%v)", err)
 		return model.StageStatus_STAGE_FAILURE
@@ -127,8 +138,9 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 	}
 
 	e.LogPersister.Infof("Detected %d import, %d add, %d change, %d destroy. Those changes will be applied automatically.", planResult.Imports, planResult.Adds, planResult.Changes, planResult.Destroys)
+	e.LogPersister.Info(planResult.RenderChanges())
 
-	if err := cmd.Apply(ctx, e.LogPersister); err != nil {
+	if err := cmd.ApplyPlan(ctx, e.LogPersister, planFile); err != nil {
 		e.LogPersister.Errorf("Failed to apply changes (%v)", err)
 		return model.StageStatus_STAGE_FAILURE
 	}
@@ -164,13 +176,24 @@ func (e *deployExecutor) ensurePlan(ctx context.Context) model.StageStatus {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
-	planResult, err := cmd.Plan(ctx, e.LogPersister)
+	planFile, err := e.preparePlanFile()
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare the plan file (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	planResult, err := cmd.SavePlan(ctx, e.LogPersister, planFile)
 	if err != nil {
 		e.LogPersister.Errorf("Failed to plan (%v)", err)
 		return model.StageStatus_STAGE_FAILURE
 	}
 
 	if planResult.NoChanges() {
+		// Leave an empty plan file to tell the following apply stage that nothing should be applied.
+		if err := os.WriteFile(planFile, nil, 0600); err != nil {
+			e.LogPersister.Errorf("Failed to save the plan (%v)", err)
+			return model.StageStatus_STAGE_FAILURE
+		}
 		e.LogPersister.Success("No changes to apply")
 		if e.StageConfig.TerraformPlanStageOptions.ExitOnNoChanges {
 			return model.StageStatus_STAGE_EXITED
@@ -178,7 +201,8 @@ func (e *deployExecutor) ensurePlan(ctx context.Context) model.StageStatus {
 		return model.StageStatus_STAGE_SUCCESS
 	}
 
-	e.LogPersister.Successf("Detected %d import, %d add, %d change, %d destroy.", planResult.Imports, planResult.Adds, planResult.Changes, planResult.Destroys)
+	e.LogPersister.Info(planResult.RenderChanges())
+	e.LogPersister.Successf("Detected %d import, %d add, %d change, %d destroy. The plan was saved to be applied by the %s stage.", planResult.Imports, planResult.Adds, planResult.Changes, planResult.Destroys, model.StageTerraformApply)
 	return model.StageStatus_STAGE_SUCCESS
 }
 
@@ -209,7 +233,36 @@ func (e *deployExecutor) ensureApply(ctx context.Context) model.StageStatus {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
-	if err := cmd.Apply(ctx, e.LogPersister); err != nil {
+	planFile := e.planFilePath()
+	info, err := os.Stat(planFile)
+	switch {
+	case err == nil && info.Size() == 0:
+		e.LogPersister.Success("No changes to apply since the plan stage detected no changes")
+		return model.StageStatus_STAGE_SUCCESS
+
+	case err == nil:
+		e.LogPersister.Info("Applying the changes saved by the plan stage")
+		if err = cmd.ApplyPlan(ctx, e.LogPersister, planFile); err == nil {
+			// A saved plan can be applied only once.
+			// It is kept on failure so that the stage can be retried with the same plan.
+			os.Remove(planFile)
+		}
+
+	case errors.Is(err, fs.ErrNotExist) && e.hasPrecedingPlanStage():
+		// For example, piped was restarted after the plan stage.
+		e.LogPersister.Errorf("The plan saved by the %s stage was not found. Please trigger a new deployment to plan again", model.StageTerraformPlan)
+		return model.StageStatus_STAGE_FAILURE
+
+	case errors.Is(err, fs.ErrNotExist):
+		e.LogPersister.Infof("Applying the changes planned right now since no %s stage was executed before", model.StageTerraformPlan)
+		err = cmd.Apply(ctx, e.LogPersister)
+
+	default:
+		e.LogPersister.Errorf("Failed to find the saved plan (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if err != nil {
 		e.LogPersister.Errorf("Failed to apply changes (%v)", err)
 		return model.StageStatus_STAGE_FAILURE
 	}
@@ -217,3 +270,31 @@ func (e *deployExecutor) ensureApply(ctx context.Context) model.StageStatus {
 	e.LogPersister.Success("Successfully applied changes")
 	return model.StageStatus_STAGE_SUCCESS
 }
+
+// planFilePath returns the path to the plan file saved for the current deployment.
+// The plan file is placed in the working directory of the deployment,
+// so it is removed together once the deployment completes.
+func (e *deployExecutor) planFilePath() string {
+	return filepath.Join(e.WorkingDir, "terraform", "plan.tfplan")
+}
+
+func (e *deployExecutor) preparePlanFile() (string, error) {
+	path := e.planFilePath()
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// hasPrecedingPlanStage reports whether the pipeline contains a TERRAFORM_PLAN stage before the current stage.
+func (e *deployExecutor) hasPrecedingPlanStage() bool {
+	for _, s := range e.Deployment.Stages {
+		if s.Id == e.Stage.Id {
+			return false
+		}
+		if s.Name == model.StageTerraformPlan.String() {
+			return true
+		}
+	}
+	return false
+}
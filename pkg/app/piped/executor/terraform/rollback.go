@@ -69,7 +69,7 @@ func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus
 		return model.StageStatus_STAGE_FAILURE
 	}
 
-	terraformPath, ok := findTerraform(ctx, appCfg.Input.TerraformVersion, e.LogPersister)
+	terraformPath, ok := findTerraform(ctx, appCfg.Input, e.LogPersister)
 	if !ok {
 		return model.StageStatus_STAGE_FAILURE
 	}
@@ -15,11 +15,11 @@
 package terraform
 
 import (
+	"cmp"
 	"context"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/terraform"
-	"github.com/pipe-cd/pipecd/pkg/app/piped/toolregistry"
 	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
@@ -68,14 +68,18 @@ func selectWorkspace(ctx context.Context, cmd *provider.Terraform, workspace str
 	return true
 }
 
-func findTerraform(ctx context.Context, version string, lp executor.LogPersister) (string, bool) {
-	path, installed, err := toolregistry.DefaultRegistry().Terraform(ctx, version)
+func findTerraform(ctx context.Context, input config.TerraformDeploymentInput, lp executor.LogPersister) (string, bool) {
+	var (
+		binary  = cmp.Or(input.Binary, config.TerraformBinaryTerraform)
+		version = input.TerraformVersion
+	)
+	path, installed, err := provider.FindBinary(ctx, binary, version)
 	if err != nil {
-		lp.Errorf("Unable to find required terraform %q (%v)", version, err)
+		lp.Errorf("Unable to find required %s %q (%v)", binary, version, err)
 		return "", false
 	}
 	if installed {
-		lp.Infof("Terraform %q has just been installed to %q because of no pre-installed binary for that version", version, path)
+		lp.Infof("%s %q has just been installed to %q because of no pre-installed binary for that version", binary, version, path)
 	}
 	return path, true
 }
@@ -35,6 +35,9 @@ type Store struct {
 type Getter interface {
 	GetFunctionManifest(appID string) (provider.FunctionManifest, bool)
 	GetState(appID string) (State, bool)
+	// GetUnavailableFields returns the fields of the live function manifest
+	// which could not be retrieved because of missing permissions.
+	GetUnavailableFields(appID string) []string
 
 	WaitForReady(ctx context.Context, timeout time.Duration) error
 }
@@ -105,6 +108,10 @@ func (s *Store) GetState(appID string) (State, bool) {
 	return s.store.getState(appID)
 }
 
+func (s *Store) GetUnavailableFields(appID string) []string {
+	return s.store.getUnavailableFields(appID)
+}
+
 func (s *Store) WaitForReady(ctx context.Context, timeout time.Duration) error {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
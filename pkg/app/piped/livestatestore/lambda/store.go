@@ -17,9 +17,12 @@ package lambda
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
@@ -27,14 +30,29 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
+// The fields of a function manifest which are retrieved by additional API calls.
+// They might be unavailable when piped is not allowed to call those APIs.
+const (
+	FieldEventSourceMappings             = "eventSourceMappings"
+	FieldFunctionURL                     = "functionUrl"
+	FieldDestinations                    = "destinations"
+	FieldProvisionedConcurrentExecutions = "provisionedConcurrentExecutions"
+)
+
 type store struct {
 	apps   atomic.Value
 	logger *zap.Logger
 	client provider.Client
+
+	// The pairs of function and field which have been reported as unavailable.
+	// They are used to avoid logging the same warning on every sync.
+	reportedUnavailableFields map[string]struct{}
 }
 
 type app struct {
 	functionManifest provider.FunctionManifest
+	// Fields which could not be retrieved because of missing permissions.
+	unavailableFields []string
 
 	// States of functions
 	states  []*model.LambdaResourceState
@@ -55,6 +73,10 @@ func (s *store) run(ctx context.Context) error {
 
 	for _, funcCfg := range funcCfgs {
 		f, err := s.client.GetFunction(ctx, *funcCfg.FunctionName)
+		if provider.IsNotFoundError(err) {
+			// The function was deleted after listing.
+			continue
+		}
 		if err != nil {
 			return fmt.Errorf("failed to get Lambda function %s: %w", *funcCfg.FunctionName, err)
 		}
@@ -65,8 +87,14 @@ func (s *store) run(ctx context.Context) error {
 			continue
 		}
 
+		extra, err := s.getAdditionalConfig(ctx, *funcCfg.FunctionName)
+		if err != nil {
+			return fmt.Errorf("failed to get the configuration of Lambda function %s: %w", *funcCfg.FunctionName, err)
+		}
+
 		apps[appID] = app{
-			functionManifest: convertToManifest(f),
+			functionManifest:  convertToManifest(f, extra),
+			unavailableFields: extra.unavailableFields,
 			states: []*model.LambdaResourceState{
 				provider.MakeFunctionResourceState(f.Configuration),
 			},
@@ -80,7 +108,75 @@ func (s *store) run(ctx context.Context) error {
 	return nil
 }
 
-func convertToManifest(f *lambda.GetFunctionOutput) provider.FunctionManifest {
+// additionalConfig holds the configurations of a function which are not included in GetFunction's response.
+type additionalConfig struct {
+	eventSourceMappings    []types.EventSourceMappingConfiguration
+	functionURL            *lambda.GetFunctionUrlConfigOutput
+	eventInvokeConfig      *lambda.GetFunctionEventInvokeConfigOutput
+	provisionedConcurrency *lambda.GetProvisionedConcurrencyConfigOutput
+
+	// Fields which could not be retrieved because of missing permissions.
+	unavailableFields []string
+}
+
+// getAdditionalConfig retrieves the additional configurations of the given function.
+// Each of them is retrieved independently: a missing resource is treated as not configured,
+// and a field which piped is not allowed to read is recorded as unavailable instead of failing the whole sync.
+func (s *store) getAdditionalConfig(ctx context.Context, functionName string) (additionalConfig, error) {
+	var (
+		extra additionalConfig
+		err   error
+	)
+
+	handle := func(field string, err error) error {
+		switch {
+		case err == nil, provider.IsNotFoundError(err):
+			return nil
+		case provider.IsAccessDeniedError(err):
+			extra.unavailableFields = append(extra.unavailableFields, field)
+			s.reportUnavailableField(functionName, field, err)
+			return nil
+		default:
+			return err
+		}
+	}
+
+	extra.eventSourceMappings, err = s.client.ListEventSourceMappings(ctx, functionName)
+	if err := handle(FieldEventSourceMappings, err); err != nil {
+		return extra, err
+	}
+	extra.functionURL, err = s.client.GetFunctionURLConfig(ctx, functionName)
+	if err := handle(FieldFunctionURL, err); err != nil {
+		return extra, err
+	}
+	extra.eventInvokeConfig, err = s.client.GetFunctionEventInvokeConfig(ctx, functionName)
+	if err := handle(FieldDestinations, err); err != nil {
+		return extra, err
+	}
+	extra.provisionedConcurrency, err = s.client.GetProvisionedConcurrency(ctx, functionName)
+	if err := handle(FieldProvisionedConcurrentExecutions, err); err != nil {
+		return extra, err
+	}
+	return extra, nil
+}
+
+func (s *store) reportUnavailableField(functionName, field string, err error) {
+	if s.reportedUnavailableFields == nil {
+		s.reportedUnavailableFields = make(map[string]struct{})
+	}
+	key := functionName + "/" + field
+	if _, ok := s.reportedUnavailableFields[key]; ok {
+		return
+	}
+	s.reportedUnavailableFields[key] = struct{}{}
+	s.logger.Warn("unable to read a field of Lambda function due to missing permissions, it will be ignored in drift detection",
+		zap.String("function", functionName),
+		zap.String("field", field),
+		zap.Error(err),
+	)
+}
+
+func convertToManifest(f *lambda.GetFunctionOutput, extra additionalConfig) provider.FunctionManifest {
 	fc := f.Configuration
 
 	architectures := make([]provider.Architecture, 0, len(fc.Architectures))
@@ -132,6 +228,79 @@ func convertToManifest(f *lambda.GetFunctionOutput) provider.FunctionManifest {
 			SubnetIDs:        fc.VpcConfig.SubnetIds,
 		}
 	}
+	if f.Concurrency != nil {
+		m.Spec.ReservedConcurrentExecutions = f.Concurrency.ReservedConcurrentExecutions
+	}
+	if fc.DeadLetterConfig != nil {
+		m.Spec.DeadLetterTargetARN = aws.ToString(fc.DeadLetterConfig.TargetArn)
+	}
+	if fc.TracingConfig != nil {
+		m.Spec.TracingMode = string(fc.TracingConfig.Mode)
+	}
+	if fc.LoggingConfig != nil {
+		m.Spec.LoggingConfig = &provider.LoggingConfig{
+			LogFormat:           string(fc.LoggingConfig.LogFormat),
+			ApplicationLogLevel: string(fc.LoggingConfig.ApplicationLogLevel),
+			SystemLogLevel:      string(fc.LoggingConfig.SystemLogLevel),
+			LogGroup:            aws.ToString(fc.LoggingConfig.LogGroup),
+		}
+	}
+
+	if len(extra.eventSourceMappings) > 0 {
+		mappings := make([]provider.EventSourceMapping, 0, len(extra.eventSourceMappings))
+		for _, esm := range extra.eventSourceMappings {
+			responseTypes := make([]string, 0, len(esm.FunctionResponseTypes))
+			for _, t := range esm.FunctionResponseTypes {
+				responseTypes = append(responseTypes, string(t))
+			}
+			mappings = append(mappings, provider.EventSourceMapping{
+				EventSourceARN:                 aws.ToString(esm.EventSourceArn),
+				BatchSize:                      aws.ToInt32(esm.BatchSize),
+				Enabled:                        aws.Bool(aws.ToString(esm.State) != "Disabled" && aws.ToString(esm.State) != "Disabling"),
+				StartingPosition:               string(esm.StartingPosition),
+				MaximumBatchingWindowInSeconds: aws.ToInt32(esm.MaximumBatchingWindowInSeconds),
+				FunctionResponseTypes:          responseTypes,
+			})
+		}
+		// Sort to compare with the mappings in the manifest regardless of the order.
+		sort.Slice(mappings, func(i, j int) bool {
+			return mappings[i].EventSourceARN < mappings[j].EventSourceARN
+		})
+		m.Spec.EventSourceMappings = mappings
+	}
+	if url := extra.functionURL; url != nil {
+		m.Spec.FunctionURL = &provider.FunctionURL{
+			AuthType:   string(url.AuthType),
+			InvokeMode: string(url.InvokeMode),
+		}
+		if url.Cors != nil {
+			m.Spec.FunctionURL.Cors = &provider.FunctionURLCors{
+				AllowCredentials: aws.ToBool(url.Cors.AllowCredentials),
+				AllowHeaders:     url.Cors.AllowHeaders,
+				AllowMethods:     url.Cors.AllowMethods,
+				AllowOrigins:     url.Cors.AllowOrigins,
+				ExposeHeaders:    url.Cors.ExposeHeaders,
+				MaxAge:           aws.ToInt32(url.Cors.MaxAge),
+			}
+		}
+	}
+	if cfg := extra.eventInvokeConfig; cfg != nil {
+		m.Spec.Destinations = &provider.Destinations{
+			MaximumRetryAttempts:     cfg.MaximumRetryAttempts,
+			MaximumEventAgeInSeconds: aws.ToInt32(cfg.MaximumEventAgeInSeconds),
+		}
+		if cfg.DestinationConfig != nil {
+			if cfg.DestinationConfig.OnSuccess != nil {
+				m.Spec.Destinations.OnSuccess = aws.ToString(cfg.DestinationConfig.OnSuccess.Destination)
+			}
+			if cfg.DestinationConfig.OnFailure != nil {
+				m.Spec.Destinations.OnFailure = aws.ToString(cfg.DestinationConfig.OnFailure.Destination)
+			}
+		}
+	}
+	if pc := extra.provisionedConcurrency; pc != nil {
+		m.Spec.ProvisionedConcurrentExecutions = pc.RequestedProvisionedConcurrentExecutions
+	}
 
 	return m
 }
@@ -174,3 +343,11 @@ func (s *store) getState(appID string) (State, bool) {
 		Version:   app.version,
 	}, true
 }
+
+func (s *store) getUnavailableFields(appID string) []string {
+	apps := s.loadApps()
+	if apps == nil {
+		return nil
+	}
+	return apps[appID].unavailableFields
+}
@@ -15,12 +15,17 @@
 package lambda
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/lambda"
 )
@@ -31,6 +36,7 @@ func TestConvertToManifest(t *testing.T) {
 	testcases := []struct {
 		title    string
 		f        *lambda.GetFunctionOutput
+		extra    additionalConfig
 		expected provider.FunctionManifest
 	}{
 		{
@@ -128,14 +134,209 @@ func TestConvertToManifest(t *testing.T) {
 				},
 			},
 		},
+		{
+			title: "convert additional configurations",
+			f: &lambda.GetFunctionOutput{
+				Configuration: &types.FunctionConfiguration{
+					FunctionName:     aws.String("test-function"),
+					MemorySize:       aws.Int32(128),
+					Timeout:          aws.Int32(60),
+					DeadLetterConfig: &types.DeadLetterConfig{TargetArn: aws.String("dlq-arn")},
+					TracingConfig:    &types.TracingConfigResponse{Mode: types.TracingModeActive},
+					LoggingConfig: &types.LoggingConfig{
+						LogFormat: types.LogFormatJson,
+						LogGroup:  aws.String("/aws/lambda/test-function"),
+					},
+				},
+				Code:        &types.FunctionCodeLocation{},
+				Concurrency: &types.Concurrency{ReservedConcurrentExecutions: aws.Int32(10)},
+			},
+			extra: additionalConfig{
+				eventSourceMappings: []types.EventSourceMappingConfiguration{
+					{
+						EventSourceArn: aws.String("sqs-arn-2"),
+						BatchSize:      aws.Int32(10),
+						State:          aws.String("Enabled"),
+					},
+					{
+						EventSourceArn:   aws.String("kinesis-arn-1"),
+						BatchSize:        aws.Int32(100),
+						State:            aws.String("Disabled"),
+						StartingPosition: types.EventSourcePositionLatest,
+					},
+				},
+				functionURL: &lambda.GetFunctionUrlConfigOutput{
+					AuthType: types.FunctionUrlAuthTypeAwsIam,
+					Cors: &types.Cors{
+						AllowOrigins: []string{"*"},
+					},
+				},
+				eventInvokeConfig: &lambda.GetFunctionEventInvokeConfigOutput{
+					MaximumRetryAttempts: aws.Int32(0),
+					DestinationConfig: &types.DestinationConfig{
+						OnFailure: &types.OnFailure{Destination: aws.String("on-failure-arn")},
+					},
+				},
+				provisionedConcurrency: &lambda.GetProvisionedConcurrencyConfigOutput{
+					RequestedProvisionedConcurrentExecutions: aws.Int32(5),
+				},
+			},
+			expected: provider.FunctionManifest{
+				Kind:       "LambdaFunction",
+				APIVersion: "pipecd.dev/v1beta1",
+				Spec: provider.FunctionManifestSpec{
+					Name:                            "test-function",
+					Memory:                          128,
+					Timeout:                         60,
+					Architectures:                   []provider.Architecture{},
+					Layers:                          []string{},
+					ReservedConcurrentExecutions:    aws.Int32(10),
+					ProvisionedConcurrentExecutions: aws.Int32(5),
+					EventSourceMappings: []provider.EventSourceMapping{
+						{
+							EventSourceARN:        "kinesis-arn-1",
+							BatchSize:             100,
+							Enabled:               aws.Bool(false),
+							StartingPosition:      "LATEST",
+							FunctionResponseTypes: []string{},
+						},
+						{
+							EventSourceARN:        "sqs-arn-2",
+							BatchSize:             10,
+							Enabled:               aws.Bool(true),
+							FunctionResponseTypes: []string{},
+						},
+					},
+					FunctionURL: &provider.FunctionURL{
+						AuthType: "AWS_IAM",
+						Cors: &provider.FunctionURLCors{
+							AllowOrigins: []string{"*"},
+						},
+					},
+					DeadLetterTargetARN: "dlq-arn",
+					Destinations: &provider.Destinations{
+						OnFailure:            "on-failure-arn",
+						MaximumRetryAttempts: aws.Int32(0),
+					},
+					TracingMode: "Active",
+					LoggingConfig: &provider.LoggingConfig{
+						LogFormat: "JSON",
+						LogGroup:  "/aws/lambda/test-function",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testcases {
 		tc := tc
 		t.Run("convert successfully", func(t *testing.T) {
 			t.Parallel()
-			fm := convertToManifest(tc.f)
+			fm := convertToManifest(tc.f, tc.extra)
 			assert.Equal(t, tc.expected, fm)
 		})
 	}
 }
+
+type fakeAPIError struct {
+	code string
+}
+
+func (e fakeAPIError) Error() string     { return e.code }
+func (e fakeAPIError) ErrorCode() string { return e.code }
+
+type fakeClient struct {
+	provider.Client
+
+	eventSourceMappingsErr    error
+	functionURLErr            error
+	eventInvokeConfigErr      error
+	provisionedConcurrencyErr error
+}
+
+func (c *fakeClient) ListEventSourceMappings(_ context.Context, _ string) ([]types.EventSourceMappingConfiguration, error) {
+	if c.eventSourceMappingsErr != nil {
+		return nil, c.eventSourceMappingsErr
+	}
+	return []types.EventSourceMappingConfiguration{{EventSourceArn: aws.String("arn")}}, nil
+}
+
+func (c *fakeClient) GetFunctionURLConfig(_ context.Context, _ string) (*lambda.GetFunctionUrlConfigOutput, error) {
+	if c.functionURLErr != nil {
+		return nil, c.functionURLErr
+	}
+	return &lambda.GetFunctionUrlConfigOutput{}, nil
+}
+
+func (c *fakeClient) GetFunctionEventInvokeConfig(_ context.Context, _ string) (*lambda.GetFunctionEventInvokeConfigOutput, error) {
+	if c.eventInvokeConfigErr != nil {
+		return nil, c.eventInvokeConfigErr
+	}
+	return &lambda.GetFunctionEventInvokeConfigOutput{}, nil
+}
+
+func (c *fakeClient) GetProvisionedConcurrency(_ context.Context, _ string) (*lambda.GetProvisionedConcurrencyConfigOutput, error) {
+	if c.provisionedConcurrencyErr != nil {
+		return nil, c.provisionedConcurrencyErr
+	}
+	return &lambda.GetProvisionedConcurrencyConfigOutput{}, nil
+}
+
+func TestGetAdditionalConfig(t *testing.T) {
+	t.Parallel()
+
+	accessDenied := fakeAPIError{code: "AccessDeniedException"}
+
+	testcases := []struct {
+		name                      string
+		client                    *fakeClient
+		expectedUnavailableFields []string
+		expectedErr               bool
+	}{
+		{
+			name:   "all fields are available",
+			client: &fakeClient{},
+		},
+		{
+			name: "access denied fields are recorded",
+			client: &fakeClient{
+				eventSourceMappingsErr:    fmt.Errorf("failed to list: %w", accessDenied),
+				provisionedConcurrencyErr: accessDenied,
+			},
+			expectedUnavailableFields: []string{FieldEventSourceMappings, FieldProvisionedConcurrentExecutions},
+		},
+		{
+			name: "missing resources are ignored",
+			client: &fakeClient{
+				eventSourceMappingsErr: fmt.Errorf("failed to list: %w", &types.ResourceNotFoundException{}),
+				functionURLErr:         provider.ErrNotFound,
+			},
+		},
+		{
+			name: "other errors fail",
+			client: &fakeClient{
+				eventInvokeConfigErr: errors.New("throttled"),
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &store{
+				client: tc.client,
+				logger: zap.NewNop(),
+			}
+			extra, err := s.getAdditionalConfig(context.Background(), "test-function")
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedUnavailableFields, extra.unavailableFields)
+		})
+	}
+}
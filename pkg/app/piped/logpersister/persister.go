@@ -38,7 +38,7 @@ type apiClient interface {
 
 type Persister interface {
 	Run(ctx context.Context) error
-	StageLogPersister(deploymentID, stageID string) StageLogPersister
+	StageLogPersister(deploymentID, stageID string, retriedCount int32) StageLogPersister
 }
 
 type StageLogPersister interface {
@@ -49,12 +49,18 @@ type StageLogPersister interface {
 	Successf(format string, a ...interface{})
 	Error(log string)
 	Errorf(format string, a ...interface{})
+	Warn(log string)
+	Warnf(format string, a ...interface{})
+	Debug(log string)
+	Debugf(format string, a ...interface{})
+	Append(log string, s model.LogSeverity, fields map[string]string)
 	Complete(timeout time.Duration) error
 }
 
 type key struct {
 	DeploymentID string
 	StageID      string
+	RetriedCount int32
 }
 
 type persister struct {
@@ -109,14 +115,16 @@ func (p *persister) shutdown() {
 }
 
 // StageLogPersister creates a child persister instance for a specific stage.
-func (p *persister) StageLogPersister(deploymentID, stageID string) StageLogPersister {
+func (p *persister) StageLogPersister(deploymentID, stageID string, retriedCount int32) StageLogPersister {
 	k := key{
 		DeploymentID: deploymentID,
 		StageID:      stageID,
+		RetriedCount: retriedCount,
 	}
 	logger := p.logger.With(
 		zap.String("deployment-id", deploymentID),
 		zap.String("stage-id", stageID),
+		zap.Int32("retried-count", retriedCount),
 	)
 	sp := &stageLogPersister{
 		key:                     k,
@@ -186,6 +194,7 @@ func (p *persister) reportStageLogs(ctx context.Context, k key, blocks []*model.
 	req := &pipedservice.ReportStageLogsRequest{
 		DeploymentId: k.DeploymentID,
 		StageId:      k.StageID,
+		RetriedCount: k.RetriedCount,
 		Blocks:       blocks,
 	}
 	if _, err := p.apiClient.ReportStageLogs(ctx, req); err != nil {
@@ -202,6 +211,7 @@ func (p *persister) reportStageLogsFromLastCheckpoint(ctx context.Context, k key
 	req := &pipedservice.ReportStageLogsFromLastCheckpointRequest{
 		DeploymentId: k.DeploymentID,
 		StageId:      k.StageID,
+		RetriedCount: k.RetriedCount,
 		Blocks:       blocks,
 		Completed:    completed,
 	}
@@ -46,8 +46,13 @@ type stageLogPersister struct {
 	logger                  *zap.Logger
 }
 
-// append appends a new log block.
+// append appends a new log block without any field.
 func (sp *stageLogPersister) append(log string, s model.LogSeverity) {
+	sp.Append(log, s, nil)
+}
+
+// Append appends a new log block with the given severity and key/value fields.
+func (sp *stageLogPersister) Append(log string, s model.LogSeverity, fields map[string]string) {
 	now := time.Now()
 
 	// We also send the error logs to the local logger.
@@ -63,6 +68,7 @@ func (sp *stageLogPersister) append(log string, s model.LogSeverity) {
 		Index:     sp.curLogIndex,
 		Log:       log,
 		Severity:  s,
+		Fields:    fields,
 		CreatedAt: now.Unix(),
 	})
 }
@@ -103,6 +109,27 @@ func (sp *stageLogPersister) Errorf(format string, a ...interface{}) {
 	sp.append(fmt.Sprintf(format, a...), model.LogSeverity_ERROR)
 }
 
+// Warn appends a new WARN log block.
+func (sp *stageLogPersister) Warn(log string) {
+	sp.append(log, model.LogSeverity_WARN)
+}
+
+// Warnf formats and appends a new WARN log block.
+func (sp *stageLogPersister) Warnf(format string, a ...interface{}) {
+	sp.append(fmt.Sprintf(format, a...), model.LogSeverity_WARN)
+}
+
+// Debug appends a new DEBUG log block.
+// DEBUG log blocks are hidden on the web UI unless the verbose mode is enabled.
+func (sp *stageLogPersister) Debug(log string) {
+	sp.append(log, model.LogSeverity_DEBUG)
+}
+
+// Debugf formats and appends a new DEBUG log block.
+func (sp *stageLogPersister) Debugf(format string, a ...interface{}) {
+	sp.append(fmt.Sprintf(format, a...), model.LogSeverity_DEBUG)
+}
+
 // Complete marks the completion of logging for this stage.
 // This means no more log for this stage will be added into this persister.
 func (sp *stageLogPersister) Complete(timeout time.Duration) error {
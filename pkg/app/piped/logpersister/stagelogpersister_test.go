@@ -0,0 +1,55 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logpersister
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestStageLogPersisterSeverities(t *testing.T) {
+	t.Parallel()
+
+	sp := &stageLogPersister{logger: zap.NewNop()}
+	sp.Info("info")
+	sp.Warnf("warn %d", 1)
+	sp.Debug("debug")
+	sp.Errorf("error %s", "msg")
+	sp.Append("applied", model.LogSeverity_SUCCESS, map[string]string{"kind": "Deployment"})
+
+	expected := []struct {
+		index    int64
+		log      string
+		severity model.LogSeverity
+		fields   map[string]string
+	}{
+		{index: 1, log: "info", severity: model.LogSeverity_INFO},
+		{index: 2, log: "warn 1", severity: model.LogSeverity_WARN},
+		{index: 3, log: "debug", severity: model.LogSeverity_DEBUG},
+		{index: 4, log: "error msg", severity: model.LogSeverity_ERROR},
+		{index: 5, log: "applied", severity: model.LogSeverity_SUCCESS, fields: map[string]string{"kind": "Deployment"}},
+	}
+	assert.Len(t, sp.blocks, len(expected))
+	for i, e := range expected {
+		assert.Equal(t, e.index, sp.blocks[i].Index)
+		assert.Equal(t, e.log, sp.blocks[i].Log)
+		assert.Equal(t, e.severity, sp.blocks[i].Severity)
+		assert.Equal(t, e.fields, sp.blocks[i].Fields)
+	}
+}
@@ -89,6 +89,11 @@ func (p *Planner) Plan(ctx context.Context, in planner.Input) (out planner.Outpu
 		manifestCache.Put(in.Trigger.Commit.Hash, newManifests)
 	}
 
+	out.Manifests = make([]map[string]interface{}, 0, len(newManifests))
+	for _, m := range newManifests {
+		out.Manifests = append(out.Manifests, m.Object())
+	}
+
 	// Determine application version from the manifests.
 	if version, e := determineVersion(newManifests); e != nil {
 		in.Logger.Warn("unable to determine version", zap.Error(e))
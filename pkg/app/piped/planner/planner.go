@@ -21,7 +21,9 @@ package planner
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -62,6 +64,10 @@ type Output struct {
 	SyncStrategy model.SyncStrategy
 	Summary      string
 	Stages       []*model.PipelineStage
+	// The rendered manifests of the target commit.
+	// This is only set by the planners of the application kinds
+	// whose manifests are rendered while planning.
+	Manifests []map[string]interface{}
 }
 
 // MakeInitialStageMetadata makes the initial metadata for the given state configuration.
@@ -75,3 +81,24 @@ func MakeInitialStageMetadata(cfg config.PipelineStage) map[string]string {
 		return nil
 	}
 }
+
+// AddPolicyApprovalStage adds a stage waiting for an approval at the beginning of the given pipeline.
+// The given rules are the names of the violated policy rules requiring the approval.
+func AddPolicyApprovalStage(stages []*model.PipelineStage, rules []string, now time.Time) []*model.PipelineStage {
+	s, _ := GetPredefinedStage(PredefinedStagePolicyApproval)
+	stage := &model.PipelineStage{
+		Id:         s.ID,
+		Name:       s.Name.String(),
+		Desc:       fmt.Sprintf("%s: %s", s.Desc, strings.Join(rules, ", ")),
+		Predefined: true,
+		Visible:    true,
+		Status:     model.StageStatus_STAGE_NOT_STARTED_YET,
+		Metadata:   MakeInitialStageMetadata(s),
+		CreatedAt:  now.Unix(),
+		UpdatedAt:  now.Unix(),
+	}
+	if len(stages) > 0 {
+		stages[0].Requires = []string{stage.Id}
+	}
+	return append([]*model.PipelineStage{stage}, stages...)
+}
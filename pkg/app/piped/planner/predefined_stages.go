@@ -15,6 +15,8 @@
 package planner
 
 import (
+	"time"
+
 	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
@@ -28,6 +30,7 @@ const (
 	PredefinedStageRollback           = "Rollback"
 	PredefinedStageCustomSyncRollback = "CustomSyncRollback"
 	PredefinedStageScriptRunRollback  = "ScriptRunRollback"
+	PredefinedStagePolicyApproval     = "PolicyApproval"
 )
 
 var predefinedStages = map[string]config.PipelineStage{
@@ -71,6 +74,15 @@ var predefinedStages = map[string]config.PipelineStage{
 		Name: model.StageScriptRunRollback,
 		Desc: "Rollback the script run stage",
 	},
+	PredefinedStagePolicyApproval: {
+		ID:   PredefinedStagePolicyApproval,
+		Name: model.StageWaitApproval,
+		Desc: "Wait for an approval because of the policy violations",
+		WaitApprovalStageOptions: &config.WaitApprovalStageOptions{
+			Timeout:        config.Duration(6 * time.Hour),
+			MinApproverNum: 1,
+		},
+	},
 }
 
 // GetPredefinedStage finds and returns the predefined stage for the given id.
@@ -27,6 +27,8 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/planner"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/planner/registry"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/policy"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/sourceprocesser"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/trigger"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
 	"github.com/pipe-cd/pipecd/pkg/backoff"
@@ -64,10 +66,15 @@ type builder struct {
 	appManifestsCache cache.Cache
 	regexPool         *regexpool.Pool
 	pipedCfg          *config.PipedSpec
+	ociClient         deploysource.OCIClient
+	secretProvider    sourceprocesser.SecretProvider
 	logger            *zap.Logger
 
+	policyLoader *policy.Loader
+
 	workingDir string
 	repoCfg    config.PipedRepository
+	policies   *policy.Evaluator
 }
 
 func newBuilder(
@@ -79,6 +86,7 @@ func newBuilder(
 	amc cache.Cache,
 	rp *regexpool.Pool,
 	cfg *config.PipedSpec,
+	pl *policy.Loader,
 	logger *zap.Logger,
 ) *builder {
 
@@ -91,6 +99,9 @@ func newBuilder(
 		appManifestsCache: amc,
 		regexPool:         rp,
 		pipedCfg:          cfg,
+		ociClient:         deploysource.NewOCIClient(cfg.OCIRegistries),
+		secretProvider:    sourceprocesser.NewSecretProvider(cfg.SecretProviders),
+		policyLoader:      pl,
 		logger:            logger.Named("plan-preview-builder"),
 	}
 }
@@ -128,6 +139,13 @@ func (b *builder) build(ctx context.Context, id string, cmd model.Command_BuildP
 	}
 	b.repoCfg = repoCfg
 
+	// Load the policies from their configured branch to not be affected by the changes being previewed.
+	policies, err := b.policyLoader.Load(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load policies (%w)", err)
+	}
+	b.policies = policies
+
 	// List all applications that belong to this Piped
 	// and are placed in the given repository.
 	apps := b.listApplications(repoCfg)
@@ -214,10 +232,11 @@ func (b *builder) buildApp(ctx context.Context, worker int, command string, app
 
 	r := model.MakeApplicationPlanPreviewResult(*app)
 
-	var preCommit string
-	// Find the commit of the last successful deployment.
+	var preCommit, preArtifactDigest string
+	// Find the commit and the OCI artifact of the last successful deployment.
 	if deploy, err := b.getMostRecentlySuccessfulDeployment(ctx, app.Id); err == nil {
 		preCommit = deploy.Trigger.Commit.Hash
+		preArtifactDigest = deploy.Trigger.ArtifactDigest
 	} else if status.Code(err) != codes.NotFound {
 		r.Error = fmt.Sprintf("failed while finding the last successful deployment (%v)", err)
 		return r
@@ -228,31 +247,34 @@ func (b *builder) buildApp(ctx context.Context, worker int, command string, app
 		deploysource.NewLocalSourceCloner(repo, "target", mergedCommit),
 		*app.GitPath,
 		b.secretDecrypter,
+		// Plan against the artifact currently referenced by the configured OCI URL.
+		deploysource.WithOCIArtifact(b.ociClient, ""),
+		deploysource.WithSecretProvider(sourceprocesser.NewCachingSecretProvider(b.secretProvider)),
 	)
 
-	strategy, err := b.plan(ctx, app, targetDSP, preCommit)
+	out, err := b.plan(ctx, app, targetDSP, preCommit, preArtifactDigest)
 	if err != nil {
 		r.Error = fmt.Sprintf("failed while planning, %v", err)
 		return r
 	}
-	r.SyncStrategy = strategy
+	r.SyncStrategy = out.SyncStrategy
 
-	logger.Info("successfully decided sync strategy for a application", zap.String("strategy", strategy.String()))
+	logger.Info("successfully decided sync strategy for a application", zap.String("strategy", out.SyncStrategy.String()))
 
 	var buf bytes.Buffer
 	var dr *diffResult
 
 	switch app.Kind {
 	case model.ApplicationKind_KUBERNETES:
-		dr, err = b.kubernetesDiff(ctx, app, targetDSP, preCommit, &buf)
+		dr, err = b.kubernetesDiff(ctx, app, targetDSP, preCommit, preArtifactDigest, &buf)
 	case model.ApplicationKind_TERRAFORM:
 		dr, err = b.terraformDiff(ctx, app, targetDSP, &buf)
 	case model.ApplicationKind_CLOUDRUN:
-		dr, err = b.cloudrundiff(ctx, app, targetDSP, preCommit, &buf)
+		dr, err = b.cloudrundiff(ctx, app, targetDSP, preCommit, preArtifactDigest, &buf)
 	case model.ApplicationKind_ECS:
-		dr, err = b.ecsdiff(ctx, app, targetDSP, preCommit, &buf)
+		dr, err = b.ecsdiff(ctx, app, targetDSP, preCommit, preArtifactDigest, &buf)
 	case model.ApplicationKind_LAMBDA:
-		dr, err = b.lambdadiff(ctx, app, targetDSP, preCommit, &buf)
+		dr, err = b.lambdadiff(ctx, app, targetDSP, preCommit, preArtifactDigest, &buf)
 	default:
 		dr = &diffResult{
 			summary: fmt.Sprintf("%s application is not implemented yet (coming soon)", app.Kind.String()),
@@ -270,12 +292,25 @@ func (b *builder) buildApp(ctx context.Context, worker int, command string, app
 		return r
 	}
 
+	r.PolicyViolations = b.policies.Evaluate(policy.Input{
+		ApplicationName: app.Name,
+		ApplicationKind: app.Kind,
+		Labels:          app.Labels,
+		Manifests:       out.Manifests,
+		Plan:            dr.plan,
+		SyncStrategy:    out.SyncStrategy,
+		Stages:          out.Stages,
+		PlanPreview:     true,
+	})
+
 	return r
 }
 
 type diffResult struct {
 	summary  string
 	noChange bool
+	// The result of the dry-run which is used to evaluate the policies.
+	plan map[string]interface{}
 }
 
 func (b *builder) cloneHeadCommit(ctx context.Context, headBranch, headCommit string) (git.Repo, error) {
@@ -328,7 +363,7 @@ func (b *builder) findTriggerApps(ctx context.Context, repo git.Repo, apps []*mo
 	return
 }
 
-func (b *builder) plan(ctx context.Context, app *model.Application, targetDSP deploysource.Provider, lastSuccessfulCommit string) (strategy model.SyncStrategy, err error) {
+func (b *builder) plan(ctx context.Context, app *model.Application, targetDSP deploysource.Provider, lastSuccessfulCommit, lastSuccessfulArtifactDigest string) (out planner.Output, err error) {
 	p, ok := defaultPlannerRegistry.Planner(app.Kind)
 	if !ok {
 		err = fmt.Errorf("application kind %s is not supported yet", app.Kind.String())
@@ -355,21 +390,10 @@ func (b *builder) plan(ctx context.Context, app *model.Application, targetDSP de
 	}
 
 	if lastSuccessfulCommit != "" {
-		in.RunningDSP = deploysource.NewProvider(
-			b.workingDir,
-			deploysource.NewGitSourceCloner(b.gitClient, b.repoCfg, "running", lastSuccessfulCommit),
-			*app.GitPath,
-			b.secretDecrypter,
-		)
-	}
-
-	out, err := p.Plan(ctx, in)
-	if err != nil {
-		return
+		in.RunningDSP = b.newRunningDSP(app, lastSuccessfulCommit, lastSuccessfulArtifactDigest)
 	}
 
-	strategy = out.SyncStrategy
-	return
+	return p.Plan(ctx, in)
 }
 
 func (b *builder) listApplications(repo config.PipedRepository) []*model.Application {
@@ -392,6 +416,18 @@ func (b *builder) listApplications(repo config.PipedRepository) []*model.Applica
 	return out
 }
 
+// newRunningDSP returns the deploy source provider for the last successful deployment of the given application.
+func (b *builder) newRunningDSP(app *model.Application, lastCommit, lastArtifactDigest string) deploysource.Provider {
+	return deploysource.NewProvider(
+		b.workingDir,
+		deploysource.NewGitSourceCloner(b.gitClient, b.repoCfg, "running", lastCommit),
+		*app.GitPath,
+		b.secretDecrypter,
+		deploysource.WithOCIArtifact(b.ociClient, lastArtifactDigest),
+		deploysource.WithSecretProvider(sourceprocesser.NewCachingSecretProvider(b.secretProvider)),
+	)
+}
+
 func (b *builder) getMostRecentlySuccessfulDeployment(ctx context.Context, applicationID string) (*model.ApplicationDeploymentReference, error) {
 	retry := pipedservice.NewRetry(3)
 
@@ -33,6 +33,7 @@ func (b *builder) ecsdiff(
 	app *model.Application,
 	targetDSP deploysource.Provider,
 	lastCommit string,
+	lastArtifactDigest string,
 	buf *bytes.Buffer,
 ) (*diffResult, error) {
 	var (
@@ -51,12 +52,7 @@ func (b *builder) ecsdiff(
 		return nil, fmt.Errorf("cannot get the old manifests without the last successful deployment")
 	}
 
-	runningDSP := deploysource.NewProvider(
-		b.workingDir,
-		deploysource.NewGitSourceCloner(b.gitClient, b.repoCfg, "running", lastCommit),
-		*app.GitPath,
-		b.secretDecrypter,
-	)
+	runningDSP := b.newRunningDSP(app, lastCommit, lastArtifactDigest)
 
 	oldManifests, err = b.loadECSManifests(ctx, *app, runningDSP)
 	if err != nil {
@@ -24,6 +24,7 @@ import (
 	"google.golang.org/grpc"
 
 	metrics "github.com/pipe-cd/pipecd/pkg/app/piped/planpreview/planpreviewmetrics"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/policy"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
 	"github.com/pipe-cd/pipecd/pkg/cache"
 	"github.com/pipe-cd/pipecd/pkg/config"
@@ -107,6 +108,7 @@ type Handler struct {
 	prevCommands map[string]struct{}
 
 	options        *options
+	policyLoader   *policy.Loader
 	builderFactory func() Builder
 	logger         *zap.Logger
 }
@@ -144,8 +146,9 @@ func NewHandler(
 	}
 
 	regexPool := regexpool.DefaultPool()
+	h.policyLoader = policy.NewLoader(gc, cfg, h.logger)
 	h.builderFactory = func() Builder {
-		return newBuilder(gc, ac, al, cg, sd, appManifestsCache, regexPool, cfg, h.logger)
+		return newBuilder(gc, ac, al, cg, sd, appManifestsCache, regexPool, cfg, h.policyLoader, h.logger)
 	}
 
 	return h
@@ -169,6 +172,9 @@ func (h *Handler) Run(ctx context.Context) error {
 		}
 	}
 
+	// Start refreshing the policies evaluated for the plan-preview results in the background.
+	go h.policyLoader.Run(ctx)
+
 	h.logger.Info(fmt.Sprintf("spawn %d worker to handle commands", h.options.workerNum))
 	for i := 0; i < h.options.workerNum; i++ {
 		go startWorker(ctx, h.commandCh)
@@ -34,6 +34,7 @@ func (b *builder) kubernetesDiff(
 	app *model.Application,
 	targetDSP deploysource.Provider,
 	lastSuccessfulCommit string,
+	lastArtifactDigest string,
 	buf *bytes.Buffer,
 ) (*diffResult, error) {
 
@@ -47,12 +48,7 @@ func (b *builder) kubernetesDiff(
 	}
 
 	if lastSuccessfulCommit != "" {
-		runningDSP := deploysource.NewProvider(
-			b.workingDir,
-			deploysource.NewGitSourceCloner(b.gitClient, b.repoCfg, "running", lastSuccessfulCommit),
-			*app.GitPath,
-			b.secretDecrypter,
-		)
+		runningDSP := b.newRunningDSP(app, lastSuccessfulCommit, lastArtifactDigest)
 		oldManifests, err = loadKubernetesManifests(ctx, *app, runningDSP, b.appManifestsCache, b.gitClient, b.logger)
 		if err != nil {
 			fmt.Fprintf(buf, "failed to load kubernetes manifests at the running commit (%v)\n", err)
@@ -31,6 +31,7 @@ func (b *builder) lambdadiff(
 	app *model.Application,
 	targetDSP deploysource.Provider,
 	lastCommit string,
+	lastArtifactDigest string,
 	buf *bytes.Buffer,
 ) (*diffResult, error) {
 	var (
@@ -49,12 +50,7 @@ func (b *builder) lambdadiff(
 		return nil, fmt.Errorf("cannot get the old manifest without the last successful deployment")
 	}
 
-	runningDSP := deploysource.NewProvider(
-		b.workingDir,
-		deploysource.NewGitSourceCloner(b.gitClient, b.repoCfg, "running", lastCommit),
-		*app.GitPath,
-		b.secretDecrypter,
-	)
+	runningDSP := b.newRunningDSP(app, lastCommit, lastArtifactDigest)
 
 	oldManifest, err = b.loadFunctionManifest(ctx, *app, runningDSP)
 	if err != nil {
@@ -16,13 +16,13 @@ package planpreview
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"fmt"
 	"io"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
 	terraformprovider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/terraform"
-	"github.com/pipe-cd/pipecd/pkg/app/piped/toolregistry"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
@@ -54,14 +54,14 @@ func (b *builder) terraformDiff(
 		return nil, err
 	}
 
-	version := appCfg.Input.TerraformVersion
-	terraformPath, installed, err := toolregistry.DefaultRegistry().Terraform(ctx, version)
+	binary, version := appCfg.Input.Binary, appCfg.Input.TerraformVersion
+	terraformPath, installed, err := terraformprovider.FindBinary(ctx, binary, version)
 	if err != nil {
-		fmt.Fprintf(buf, "unable to find the specified terraform version %q (%v)\n", version, err)
+		fmt.Fprintf(buf, "unable to find the specified %s version %q (%v)\n", cmp.Or(binary, "terraform"), version, err)
 		return nil, err
 	}
 	if installed {
-		b.logger.Info(fmt.Sprintf("terraform %q has just been installed to %q because of no pre-installed binary for that version", version, terraformPath))
+		b.logger.Info(fmt.Sprintf("%s %q has just been installed to %q because of no pre-installed binary for that version", cmp.Or(binary, "terraform"), version, terraformPath))
 	}
 
 	vars := make([]string, 0, len(cpCfg.Vars)+len(appCfg.Input.Vars))
@@ -108,12 +108,38 @@ func (b *builder) terraformDiff(
 		return &diffResult{
 			summary:  "No changes were detected",
 			noChange: true,
+			plan:     makePolicyPlan(result),
 		}, nil
 	}
 
 	summary := fmt.Sprintf("%d to import, %d to add, %d to change, %d to destroy", result.Imports, result.Adds, result.Changes, result.Destroys)
+	// Show the changes per resource address instead of the raw outputs of terraform commands.
+	if changes := result.RenderChanges(); changes != "" {
+		buf.Reset()
+		fmt.Fprint(buf, changes)
+	}
 	fmt.Fprintln(buf, summary)
 	return &diffResult{
 		summary: summary,
+		plan:    makePolicyPlan(result),
 	}, nil
 }
+
+// makePolicyPlan converts the given plan result to the data used to evaluate the policies.
+func makePolicyPlan(result terraformprovider.PlanResult) map[string]interface{} {
+	changes := make([]interface{}, 0)
+	for _, c := range result.ResourceChanges {
+		changes = append(changes, map[string]interface{}{
+			"address": c.Address,
+			"type":    c.Type,
+			"action":  c.Action,
+		})
+	}
+	return map[string]interface{}{
+		"imports":         int64(result.Imports),
+		"adds":            int64(result.Adds),
+		"changes":         int64(result.Changes),
+		"destroys":        int64(result.Destroys),
+		"resourceChanges": changes,
+	}
+}
@@ -23,6 +23,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy"
+	cdtypes "github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
@@ -45,9 +47,10 @@ const (
 )
 
 type client struct {
-	ecsClient *ecs.Client
-	elbClient *elasticloadbalancingv2.Client
-	logger    *zap.Logger
+	ecsClient        *ecs.Client
+	elbClient        *elasticloadbalancingv2.Client
+	codedeployClient *codedeploy.Client
+	logger           *zap.Logger
 }
 
 func newClient(region, profile, credentialsFile, roleARN, tokenPath string, logger *zap.Logger) (Client, error) {
@@ -79,13 +82,19 @@ func newClient(region, profile, credentialsFile, roleARN, tokenPath string, logg
 	}
 	c.ecsClient = ecs.NewFromConfig(cfg)
 	c.elbClient = elasticloadbalancingv2.NewFromConfig(cfg)
+	c.codedeployClient = codedeploy.NewFromConfig(cfg)
 
 	return c, nil
 }
 
 func (c *client) CreateService(ctx context.Context, service types.Service) (*types.Service, error) {
-	if service.DeploymentController == nil || service.DeploymentController.Type != types.DeploymentControllerTypeExternal {
-		return nil, fmt.Errorf("failed to create ECS service %s: deployment controller of type EXTERNAL is required", *service.ServiceName)
+	if service.DeploymentController == nil {
+		return nil, fmt.Errorf("failed to create ECS service %s: deployment controller of type EXTERNAL or CODE_DEPLOY is required", *service.ServiceName)
+	}
+	switch service.DeploymentController.Type {
+	case types.DeploymentControllerTypeExternal, types.DeploymentControllerTypeCodeDeploy:
+	default:
+		return nil, fmt.Errorf("failed to create ECS service %s: deployment controller of type EXTERNAL or CODE_DEPLOY is required", *service.ServiceName)
 	}
 	input := &ecs.CreateServiceInput{
 		Cluster:                       service.ClusterArn,
@@ -103,6 +112,16 @@ func (c *client) CreateService(ctx context.Context, service types.Service) (*typ
 		Role:                          service.RoleArn,
 		SchedulingStrategy:            service.SchedulingStrategy,
 		Tags:                          service.Tags,
+		ServiceConnectConfiguration:   ServiceConnectConfiguration(service),
+	}
+	if IsCodeDeployService(service) {
+		// Services using CODE_DEPLOY controller run their initial task set from the service itself.
+		input.TaskDefinition = service.TaskDefinition
+		input.LoadBalancers = service.LoadBalancers
+		input.LaunchType = service.LaunchType
+		input.NetworkConfiguration = service.NetworkConfiguration
+		input.ServiceRegistries = service.ServiceRegistries
+		input.CapacityProviderStrategy = service.CapacityProviderStrategy
 	}
 	output, err := c.ecsClient.CreateService(ctx, input)
 	if err != nil {
@@ -147,6 +166,15 @@ func (c *client) UpdateService(ctx context.Context, service types.Service) (*typ
 		EnableECSManagedTags: aws.Bool(service.EnableECSManagedTags),
 	}
 
+	if IsCodeDeployService(service) {
+		// Only a subset of properties can be updated for services using CODE_DEPLOY controller,
+		// others must be changed through a CodeDeploy deployment.
+		// ref: https://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_UpdateService.html
+		input.EnableExecuteCommand = nil
+	} else {
+		input.ServiceConnectConfiguration = ServiceConnectConfiguration(service)
+	}
+
 	// If desiredCount is 0 or not set, keep current desiredCount because a user might use AutoScaling.
 	if service.DesiredCount != 0 {
 		input.DesiredCount = aws.Int32(service.DesiredCount)
@@ -682,3 +710,107 @@ func (c *client) UntagResource(ctx context.Context, resourceArn string, tagKeys
 	_, err := c.ecsClient.UntagResource(ctx, input)
 	return err
 }
+
+func (c *client) CreateCodeDeployDeployment(ctx context.Context, deployment CodeDeployDeployment) (string, error) {
+	content, err := makeAppSpecContent(deployment)
+	if err != nil {
+		return "", fmt.Errorf("failed to make AppSpec content for CodeDeploy deployment: %w", err)
+	}
+
+	input := &codedeploy.CreateDeploymentInput{
+		ApplicationName:     aws.String(deployment.ApplicationName),
+		DeploymentGroupName: aws.String(deployment.DeploymentGroupName),
+		Revision: &cdtypes.RevisionLocation{
+			RevisionType: cdtypes.RevisionLocationTypeAppSpecContent,
+			AppSpecContent: &cdtypes.AppSpecContent{
+				Content: aws.String(content),
+			},
+		},
+	}
+	if deployment.DeploymentConfigName != "" {
+		input.DeploymentConfigName = aws.String(deployment.DeploymentConfigName)
+	}
+	if deployment.Description != "" {
+		input.Description = aws.String(deployment.Description)
+	}
+
+	output, err := c.codedeployClient.CreateDeployment(ctx, input)
+	if err != nil {
+		return "", fmt.Errorf("failed to create CodeDeploy deployment for deployment group %s: %w", deployment.DeploymentGroupName, err)
+	}
+	return *output.DeploymentId, nil
+}
+
+func (c *client) GetCodeDeployDeploymentStatus(ctx context.Context, deploymentID string) (cdtypes.DeploymentStatus, error) {
+	output, err := c.codedeployClient.GetDeployment(ctx, &codedeploy.GetDeploymentInput{
+		DeploymentId: aws.String(deploymentID),
+	})
+	if err != nil {
+		var nfe *cdtypes.DeploymentDoesNotExistException
+		if errors.As(err, &nfe) {
+			return "", platformprovider.ErrNotFound
+		}
+		return "", fmt.Errorf("failed to get CodeDeploy deployment %s: %w", deploymentID, err)
+	}
+	return output.DeploymentInfo.Status, nil
+}
+
+func (c *client) ContinueCodeDeployDeployment(ctx context.Context, deploymentID string) error {
+	_, err := c.codedeployClient.ContinueDeployment(ctx, &codedeploy.ContinueDeploymentInput{
+		DeploymentId:       aws.String(deploymentID),
+		DeploymentWaitType: cdtypes.DeploymentWaitTypeReadyWait,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to continue CodeDeploy deployment %s: %w", deploymentID, err)
+	}
+	return nil
+}
+
+func (c *client) StopCodeDeployDeployment(ctx context.Context, deploymentID string, rollback bool) error {
+	_, err := c.codedeployClient.StopDeployment(ctx, &codedeploy.StopDeploymentInput{
+		DeploymentId:        aws.String(deploymentID),
+		AutoRollbackEnabled: aws.Bool(rollback),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stop CodeDeploy deployment %s: %w", deploymentID, err)
+	}
+	return nil
+}
+
+func (c *client) CodeDeployWaitsBeforeRerouting(ctx context.Context, applicationName, deploymentGroupName string) (bool, error) {
+	output, err := c.codedeployClient.GetDeploymentGroup(ctx, &codedeploy.GetDeploymentGroupInput{
+		ApplicationName:     aws.String(applicationName),
+		DeploymentGroupName: aws.String(deploymentGroupName),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to get CodeDeploy deployment group %s: %w", deploymentGroupName, err)
+	}
+	cfg := output.DeploymentGroupInfo.BlueGreenDeploymentConfiguration
+	if cfg == nil || cfg.DeploymentReadyOption == nil {
+		return false, nil
+	}
+	return cfg.DeploymentReadyOption.ActionOnTimeout == cdtypes.DeploymentReadyActionStopDeployment, nil
+}
+
+func (c *client) EnsureCodeDeployCanaryConfig(ctx context.Context, percentage, intervalMinutes int32) (string, error) {
+	name := fmt.Sprintf("PipeCD.ECSCanary%dPercent%dMinutes", percentage, intervalMinutes)
+	_, err := c.codedeployClient.CreateDeploymentConfig(ctx, &codedeploy.CreateDeploymentConfigInput{
+		DeploymentConfigName: aws.String(name),
+		ComputePlatform:      cdtypes.ComputePlatformEcs,
+		TrafficRoutingConfig: &cdtypes.TrafficRoutingConfig{
+			Type: cdtypes.TrafficRoutingTypeTimeBasedCanary,
+			TimeBasedCanary: &cdtypes.TimeBasedCanary{
+				CanaryPercentage: percentage,
+				CanaryInterval:   intervalMinutes,
+			},
+		},
+	})
+	if err != nil {
+		var aee *cdtypes.DeploymentConfigAlreadyExistsException
+		if errors.As(err, &aee) {
+			return name, nil
+		}
+		return "", fmt.Errorf("failed to create CodeDeploy deployment config %s: %w", name, err)
+	}
+	return name, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// CodeDeployDeployment contains the values required to create a CodeDeploy blue/green deployment
+// for an ECS service using the CODE_DEPLOY deployment controller.
+type CodeDeployDeployment struct {
+	ApplicationName      string
+	DeploymentGroupName  string
+	DeploymentConfigName string
+	// The ARN of the task definition which will be run by the replacement (green) task set.
+	TaskDefinitionArn string
+	// The container and port that the load balancer routes traffic to.
+	ContainerName string
+	ContainerPort int32
+	Description   string
+}
+
+type appSpec struct {
+	Version   string            `json:"version"`
+	Resources []appSpecResource `json:"Resources"`
+}
+
+type appSpecResource struct {
+	TargetService appSpecTargetService `json:"TargetService"`
+}
+
+type appSpecTargetService struct {
+	Type       string                   `json:"Type"`
+	Properties appSpecServiceProperties `json:"Properties"`
+}
+
+type appSpecServiceProperties struct {
+	TaskDefinition   string                  `json:"TaskDefinition"`
+	LoadBalancerInfo appSpecLoadBalancerInfo `json:"LoadBalancerInfo"`
+}
+
+type appSpecLoadBalancerInfo struct {
+	ContainerName string `json:"ContainerName"`
+	ContainerPort int32  `json:"ContainerPort"`
+}
+
+// makeAppSpecContent builds the AppSpec content of the given deployment.
+// ref: https://docs.aws.amazon.com/codedeploy/latest/userguide/reference-appspec-file-structure-resources.html#reference-appspec-file-structure-resources-ecs
+func makeAppSpecContent(d CodeDeployDeployment) (string, error) {
+	if d.TaskDefinitionArn == "" {
+		return "", fmt.Errorf("task definition ARN is required")
+	}
+	if d.ContainerName == "" || d.ContainerPort == 0 {
+		return "", fmt.Errorf("container name and port are required")
+	}
+
+	spec := appSpec{
+		Version: "0.0",
+		Resources: []appSpecResource{
+			{
+				TargetService: appSpecTargetService{
+					Type: "AWS::ECS::Service",
+					Properties: appSpecServiceProperties{
+						TaskDefinition: d.TaskDefinitionArn,
+						LoadBalancerInfo: appSpecLoadBalancerInfo{
+							ContainerName: d.ContainerName,
+							ContainerPort: d.ContainerPort,
+						},
+					},
+				},
+			},
+		},
+	}
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
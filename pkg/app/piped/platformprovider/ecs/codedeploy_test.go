@@ -0,0 +1,73 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeAppSpecContent(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		deployment  CodeDeployDeployment
+		expected    string
+		expectedErr bool
+	}{
+		{
+			name: "valid deployment",
+			deployment: CodeDeployDeployment{
+				TaskDefinitionArn: "arn:aws:ecs:ap-northeast-1:123456789012:task-definition/web:3",
+				ContainerName:     "web",
+				ContainerPort:     80,
+			},
+			expected: `{"version":"0.0","Resources":[{"TargetService":{"Type":"AWS::ECS::Service","Properties":{"TaskDefinition":"arn:aws:ecs:ap-northeast-1:123456789012:task-definition/web:3","LoadBalancerInfo":{"ContainerName":"web","ContainerPort":80}}}}]}`,
+		},
+		{
+			name: "missing task definition",
+			deployment: CodeDeployDeployment{
+				ContainerName: "web",
+				ContainerPort: 80,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "missing container port",
+			deployment: CodeDeployDeployment{
+				TaskDefinitionArn: "arn:aws:ecs:ap-northeast-1:123456789012:task-definition/web:3",
+				ContainerName:     "web",
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := makeAppSpecContent(tc.deployment)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expected, got)
+		})
+	}
+}
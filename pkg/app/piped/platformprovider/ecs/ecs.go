@@ -20,6 +20,7 @@ import (
 	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	cdtypes "github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	"go.uber.org/zap"
 	"golang.org/x/sync/singleflight"
@@ -39,6 +40,7 @@ const (
 type Client interface {
 	ECS
 	ELB
+	CodeDeploy
 }
 
 type ECS interface {
@@ -70,6 +72,22 @@ type ELB interface {
 	ModifyListeners(ctx context.Context, listenerArns []string, routingTrafficCfg RoutingTrafficConfig) (modifiedRuleArns []string, err error)
 }
 
+// CodeDeploy drives blue/green deployments of services using the CODE_DEPLOY deployment controller.
+type CodeDeploy interface {
+	CreateCodeDeployDeployment(ctx context.Context, deployment CodeDeployDeployment) (deploymentID string, err error)
+	GetCodeDeployDeploymentStatus(ctx context.Context, deploymentID string) (cdtypes.DeploymentStatus, error)
+	// ContinueCodeDeployDeployment starts rerouting all traffic to the replacement task set
+	// of a deployment waiting in the Ready state.
+	ContinueCodeDeployDeployment(ctx context.Context, deploymentID string) error
+	StopCodeDeployDeployment(ctx context.Context, deploymentID string, rollback bool) error
+	// CodeDeployWaitsBeforeRerouting returns true if the deployment group keeps its deployments
+	// in the Ready state until they are continued.
+	CodeDeployWaitsBeforeRerouting(ctx context.Context, applicationName, deploymentGroupName string) (bool, error)
+	// EnsureCodeDeployCanaryConfig creates the deployment configuration which shifts the given percentage
+	// of traffic first and the rest after the given minutes unless it exists, and returns its name.
+	EnsureCodeDeployCanaryConfig(ctx context.Context, percentage, intervalMinutes int32) (name string, err error)
+}
+
 // Registry holds a pool of aws client wrappers.
 type Registry interface {
 	Client(name string, cfg *config.PlatformProviderECSConfig, logger *zap.Logger) (Client, error)
@@ -155,6 +155,73 @@ deploymentController:
 				},
 			},
 		},
+		{
+			name: "yaml format input with service connect configuration",
+			input: `
+cluster: arn:aws:ecs:ap-northeast-1:XXXX:cluster/YYYY
+serviceName: nginx-blue-green
+desiredCount: 2
+deploymentController:
+  type: ECS
+serviceConnectConfiguration:
+  enabled: true
+  namespace: internal
+  services:
+    - portName: http
+      clientAliases:
+        - port: 80
+          dnsName: nginx
+`,
+			expected: types.Service{
+				ClusterArn:   aws.String("arn:aws:ecs:ap-northeast-1:XXXX:cluster/YYYY"),
+				ServiceName:  aws.String("nginx-blue-green"),
+				DesiredCount: 2,
+				RoleArn:      aws.String(""),
+				DeploymentController: &types.DeploymentController{
+					Type: types.DeploymentControllerTypeEcs,
+				},
+				Deployments: []types.Deployment{
+					{
+						ServiceConnectConfiguration: &types.ServiceConnectConfiguration{
+							Enabled:   true,
+							Namespace: aws.String("internal"),
+							Services: []types.ServiceConnectService{
+								{
+									PortName: aws.String("http"),
+									ClientAliases: []types.ServiceConnectClientAlias{
+										{Port: aws.Int32(80), DnsName: aws.String("nginx")},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "service connect configuration with CODE_DEPLOY deployment controller",
+			input: `
+cluster: arn:aws:ecs:ap-northeast-1:XXXX:cluster/YYYY
+serviceName: nginx-blue-green
+deploymentController:
+  type: CODE_DEPLOY
+serviceConnectConfiguration:
+  enabled: true
+`,
+			expectedErr: true,
+		},
+		{
+			name: "service connect configuration with EXTERNAL deployment controller",
+			input: `
+cluster: arn:aws:ecs:ap-northeast-1:XXXX:cluster/YYYY
+serviceName: nginx-external-canary
+deploymentController:
+  type: EXTERNAL
+serviceConnectConfiguration:
+  enabled: true
+`,
+			expectedErr: true,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -165,3 +232,13 @@ deploymentController:
 		})
 	}
 }
+
+func TestServiceConnectConfiguration(t *testing.T) {
+	t.Parallel()
+
+	cfg := &types.ServiceConnectConfiguration{Enabled: true, Namespace: aws.String("internal")}
+	assert.Nil(t, ServiceConnectConfiguration(types.Service{}))
+	assert.Equal(t, cfg, ServiceConnectConfiguration(types.Service{
+		Deployments: []types.Deployment{{}, {ServiceConnectConfiguration: cfg}},
+	}))
+}
@@ -15,6 +15,7 @@
 package ecs
 
 import (
+	"fmt"
 	"os"
 
 	"sigs.k8s.io/yaml"
@@ -54,9 +55,39 @@ func parseServiceDefinition(data []byte) (types.Service, error) {
 		obj.RoleArn = &roleArn
 	}
 
+	scCfg, err := parseServiceDefinitionForServiceConnect(data)
+	if err != nil {
+		return types.Service{}, err
+	}
+	if scCfg != nil {
+		// ECS supports Service Connect only for services using the ECS deployment controller.
+		// ref: https://docs.aws.amazon.com/AmazonECS/latest/developerguide/service-connect.html#service-connect-considerations
+		if c := obj.DeploymentController; c != nil && (c.Type == types.DeploymentControllerTypeExternal || c.Type == types.DeploymentControllerTypeCodeDeploy) {
+			return types.Service{}, fmt.Errorf("serviceConnectConfiguration cannot be used with the %s deployment controller", c.Type)
+		}
+		// types.Service has no field for Service Connect since ECS reports it per deployment,
+		// so we keep the desired configuration as the only deployment of the service.
+		obj.Deployments = []types.Deployment{{ServiceConnectConfiguration: scCfg}}
+	}
+
 	return obj, nil
 }
 
+// ServiceConnectConfiguration returns the Service Connect configuration specified in the given service definition.
+func ServiceConnectConfiguration(service types.Service) *types.ServiceConnectConfiguration {
+	for _, d := range service.Deployments {
+		if d.ServiceConnectConfiguration != nil {
+			return d.ServiceConnectConfiguration
+		}
+	}
+	return nil
+}
+
+// IsCodeDeployService returns true if the given service uses CODE_DEPLOY deployment controller.
+func IsCodeDeployService(service types.Service) bool {
+	return service.DeploymentController != nil && service.DeploymentController.Type == types.DeploymentControllerTypeCodeDeploy
+}
+
 func parseServiceDefinitionForCluster(data []byte) (string, error) {
 	var obj struct {
 		Cluster string `json:"cluster"`
@@ -76,3 +107,13 @@ func parseServiceDefinitionForRole(data []byte) (string, error) {
 	}
 	return obj.Role, nil
 }
+
+func parseServiceDefinitionForServiceConnect(data []byte) (*types.ServiceConnectConfiguration, error) {
+	var obj struct {
+		ServiceConnectConfiguration *types.ServiceConnectConfiguration `json:"serviceConnectConfiguration"`
+	}
+	if err := yaml.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	return obj.ServiceConnectConfiguration, nil
+}
@@ -60,6 +60,11 @@ func (m Manifest) MarshalJSON() ([]byte, error) {
 	return m.u.MarshalJSON()
 }
 
+// Object returns a copy of the whole content of the manifest.
+func (m Manifest) Object() map[string]interface{} {
+	return m.u.DeepCopy().Object
+}
+
 func (m Manifest) AddAnnotations(annotations map[string]string) {
 	if len(annotations) == 0 {
 		return
@@ -138,6 +138,9 @@ func (c *client) CreateFunction(ctx context.Context, fm FunctionManifest) error
 			SubnetIds:        fm.Spec.VPCConfig.SubnetIDs,
 		}
 	}
+	input.DeadLetterConfig = makeDeadLetterConfig(fm)
+	input.TracingConfig = makeTracingConfig(fm)
+	input.LoggingConfig = makeLoggingConfig(fm)
 	// Container image packing.
 	if fm.Spec.ImageURI != "" {
 		input.PackageType = types.PackageTypeImage
@@ -160,7 +163,7 @@ func (c *client) CreateFunction(ctx context.Context, fm FunctionManifest) error
 	if err != nil {
 		return fmt.Errorf("failed to create Lambda function %s: %w", fm.Spec.Name, err)
 	}
-	return nil
+	return c.updateFunctionAdditionalConfig(ctx, fm)
 }
 
 func (c *client) CreateFunctionFromSource(ctx context.Context, fm FunctionManifest, zip io.Reader) error {
@@ -204,11 +207,14 @@ func (c *client) CreateFunctionFromSource(ctx context.Context, fm FunctionManife
 			SubnetIds:        fm.Spec.VPCConfig.SubnetIDs,
 		}
 	}
+	input.DeadLetterConfig = makeDeadLetterConfig(fm)
+	input.TracingConfig = makeTracingConfig(fm)
+	input.LoggingConfig = makeLoggingConfig(fm)
 	_, err = c.client.CreateFunction(ctx, input)
 	if err != nil {
 		return fmt.Errorf("failed to create Lambda function %s: %w", fm.Spec.Name, err)
 	}
-	return nil
+	return c.updateFunctionAdditionalConfig(ctx, fm)
 }
 
 func (c *client) UpdateFunction(ctx context.Context, fm FunctionManifest) error {
@@ -314,6 +320,9 @@ func (c *client) updateFunctionConfiguration(ctx context.Context, fm FunctionMan
 				SubnetIds:        fm.Spec.VPCConfig.SubnetIDs,
 			}
 		}
+		configInput.DeadLetterConfig = makeDeadLetterConfig(fm)
+		configInput.TracingConfig = makeTracingConfig(fm)
+		configInput.LoggingConfig = makeLoggingConfig(fm)
 		_, err = c.client.UpdateFunctionConfiguration(ctx, configInput)
 		if err != nil {
 			c.logger.Error("Failed to update function configuration")
@@ -342,9 +351,337 @@ func (c *client) updateFunctionConfiguration(ctx context.Context, fm FunctionMan
 		}
 		return nil, nil
 	})
+	if err != nil {
+		return err
+	}
+
+	return c.updateFunctionAdditionalConfig(ctx, fm)
+}
+
+// updateFunctionAdditionalConfig applies the configurations which are managed by the dedicated APIs
+// instead of UpdateFunctionConfiguration. Each of them is left untouched when it is not set in the manifest.
+func (c *client) updateFunctionAdditionalConfig(ctx context.Context, fm FunctionManifest) error {
+	if fm.Spec.ReservedConcurrentExecutions != nil {
+		input := &lambda.PutFunctionConcurrencyInput{
+			FunctionName:                 aws.String(fm.Spec.Name),
+			ReservedConcurrentExecutions: fm.Spec.ReservedConcurrentExecutions,
+		}
+		if _, err := c.client.PutFunctionConcurrency(ctx, input); err != nil {
+			return fmt.Errorf("failed to update reserved concurrency for Lambda function %s: %w", fm.Spec.Name, err)
+		}
+	}
+	if fm.Spec.Destinations != nil {
+		input := &lambda.PutFunctionEventInvokeConfigInput{
+			FunctionName:         aws.String(fm.Spec.Name),
+			MaximumRetryAttempts: fm.Spec.Destinations.MaximumRetryAttempts,
+			DestinationConfig:    &types.DestinationConfig{},
+		}
+		if fm.Spec.Destinations.MaximumEventAgeInSeconds != 0 {
+			input.MaximumEventAgeInSeconds = aws.Int32(fm.Spec.Destinations.MaximumEventAgeInSeconds)
+		}
+		if fm.Spec.Destinations.OnSuccess != "" {
+			input.DestinationConfig.OnSuccess = &types.OnSuccess{Destination: aws.String(fm.Spec.Destinations.OnSuccess)}
+		}
+		if fm.Spec.Destinations.OnFailure != "" {
+			input.DestinationConfig.OnFailure = &types.OnFailure{Destination: aws.String(fm.Spec.Destinations.OnFailure)}
+		}
+		if _, err := c.client.PutFunctionEventInvokeConfig(ctx, input); err != nil {
+			return fmt.Errorf("failed to update destinations for Lambda function %s: %w", fm.Spec.Name, err)
+		}
+	}
+	return nil
+}
+
+// updateEventSourceMappings makes the event source mappings of the alias match the manifest.
+// The mappings are identified by their event source ARNs.
+func (c *client) updateEventSourceMappings(ctx context.Context, fm FunctionManifest) error {
+	current, err := c.ListEventSourceMappings(ctx, fm.Spec.Name)
+	if err != nil {
+		return err
+	}
+	currentByARN := make(map[string]types.EventSourceMappingConfiguration, len(current))
+	for _, m := range current {
+		currentByARN[aws.ToString(m.EventSourceArn)] = m
+	}
+
+	for _, m := range fm.Spec.EventSourceMappings {
+		cur, ok := currentByARN[m.EventSourceARN]
+		delete(currentByARN, m.EventSourceARN)
+
+		if !ok {
+			input := &lambda.CreateEventSourceMappingInput{
+				FunctionName:          aws.String(aliasQualifiedName(fm.Spec.Name)),
+				EventSourceArn:        aws.String(m.EventSourceARN),
+				Enabled:               m.Enabled,
+				StartingPosition:      types.EventSourcePosition(m.StartingPosition),
+				FunctionResponseTypes: makeFunctionResponseTypes(m.FunctionResponseTypes),
+			}
+			if m.BatchSize != 0 {
+				input.BatchSize = aws.Int32(m.BatchSize)
+			}
+			if m.MaximumBatchingWindowInSeconds != 0 {
+				input.MaximumBatchingWindowInSeconds = aws.Int32(m.MaximumBatchingWindowInSeconds)
+			}
+			if _, err := c.client.CreateEventSourceMapping(ctx, input); err != nil {
+				return fmt.Errorf("failed to create event source mapping for %s: %w", m.EventSourceARN, err)
+			}
+			continue
+		}
+
+		// The starting position can not be changed after the mapping was created.
+		input := &lambda.UpdateEventSourceMappingInput{
+			UUID:                  cur.UUID,
+			FunctionName:          aws.String(aliasQualifiedName(fm.Spec.Name)),
+			Enabled:               m.Enabled,
+			FunctionResponseTypes: makeFunctionResponseTypes(m.FunctionResponseTypes),
+		}
+		if m.Enabled == nil {
+			input.Enabled = aws.Bool(true)
+		}
+		if m.BatchSize != 0 {
+			input.BatchSize = aws.Int32(m.BatchSize)
+		}
+		if m.MaximumBatchingWindowInSeconds != 0 {
+			input.MaximumBatchingWindowInSeconds = aws.Int32(m.MaximumBatchingWindowInSeconds)
+		}
+		if _, err := c.client.UpdateEventSourceMapping(ctx, input); err != nil {
+			return fmt.Errorf("failed to update event source mapping for %s: %w", m.EventSourceARN, err)
+		}
+	}
+
+	// Remove the mappings which are no longer defined in the manifest.
+	for arn, m := range currentByARN {
+		input := &lambda.DeleteEventSourceMappingInput{
+			UUID: m.UUID,
+		}
+		if _, err := c.client.DeleteEventSourceMapping(ctx, input); err != nil {
+			return fmt.Errorf("failed to delete event source mapping for %s: %w", arn, err)
+		}
+	}
+	return nil
+}
+
+// updateFunctionURLConfig makes the function URL of the alias match the manifest.
+func (c *client) updateFunctionURLConfig(ctx context.Context, fm FunctionManifest) error {
+	url := fm.Spec.FunctionURL
+	var cors *types.Cors
+	if url.Cors != nil {
+		cors = &types.Cors{
+			AllowCredentials: aws.Bool(url.Cors.AllowCredentials),
+			AllowHeaders:     url.Cors.AllowHeaders,
+			AllowMethods:     url.Cors.AllowMethods,
+			AllowOrigins:     url.Cors.AllowOrigins,
+			ExposeHeaders:    url.Cors.ExposeHeaders,
+		}
+		if url.Cors.MaxAge != 0 {
+			cors.MaxAge = aws.Int32(url.Cors.MaxAge)
+		}
+	}
+
+	_, err := c.GetFunctionURLConfig(ctx, fm.Spec.Name)
+	if errors.Is(err, ErrNotFound) {
+		input := &lambda.CreateFunctionUrlConfigInput{
+			FunctionName: aws.String(fm.Spec.Name),
+			Qualifier:    aws.String(defaultAliasName),
+			AuthType:     types.FunctionUrlAuthType(url.AuthType),
+			InvokeMode:   types.InvokeMode(url.InvokeMode),
+			Cors:         cors,
+		}
+		_, err = c.client.CreateFunctionUrlConfig(ctx, input)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	input := &lambda.UpdateFunctionUrlConfigInput{
+		FunctionName: aws.String(fm.Spec.Name),
+		Qualifier:    aws.String(defaultAliasName),
+		AuthType:     types.FunctionUrlAuthType(url.AuthType),
+		InvokeMode:   types.InvokeMode(url.InvokeMode),
+		Cors:         cors,
+	}
+	_, err = c.client.UpdateFunctionUrlConfig(ctx, input)
+	return err
+}
+
+// ListEventSourceMappings returns the event source mappings of the alias of the function.
+func (c *client) ListEventSourceMappings(ctx context.Context, functionName string) ([]types.EventSourceMappingConfiguration, error) {
+	input := &lambda.ListEventSourceMappingsInput{
+		FunctionName: aws.String(aliasQualifiedName(functionName)),
+	}
+	mappings := []types.EventSourceMappingConfiguration{}
+	for {
+		output, err := c.client.ListEventSourceMappings(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list event source mappings of function %s: %w", functionName, err)
+		}
+		mappings = append(mappings, output.EventSourceMappings...)
+
+		if output.NextMarker == nil {
+			return mappings, nil
+		}
+		input.Marker = output.NextMarker
+	}
+}
+
+// GetFunctionURLConfig returns lambda provider.ErrNotFound in case the alias of the function has no function URL.
+func (c *client) GetFunctionURLConfig(ctx context.Context, functionName string) (*lambda.GetFunctionUrlConfigOutput, error) {
+	input := &lambda.GetFunctionUrlConfigInput{
+		FunctionName: aws.String(functionName),
+		Qualifier:    aws.String(defaultAliasName),
+	}
+	output, err := c.client.GetFunctionUrlConfig(ctx, input)
+	if err != nil {
+		return nil, wrapNotFoundError(err)
+	}
+	return output, nil
+}
+
+// GetFunctionEventInvokeConfig returns lambda provider.ErrNotFound in case the function has no asynchronous invocation config.
+func (c *client) GetFunctionEventInvokeConfig(ctx context.Context, functionName string) (*lambda.GetFunctionEventInvokeConfigOutput, error) {
+	input := &lambda.GetFunctionEventInvokeConfigInput{
+		FunctionName: aws.String(functionName),
+	}
+	output, err := c.client.GetFunctionEventInvokeConfig(ctx, input)
+	if err != nil {
+		return nil, wrapNotFoundError(err)
+	}
+	return output, nil
+}
+
+// GetProvisionedConcurrency returns lambda provider.ErrNotFound in case the alias has no provisioned concurrency.
+func (c *client) GetProvisionedConcurrency(ctx context.Context, functionName string) (*lambda.GetProvisionedConcurrencyConfigOutput, error) {
+	input := &lambda.GetProvisionedConcurrencyConfigInput{
+		FunctionName: aws.String(functionName),
+		Qualifier:    aws.String(defaultAliasName),
+	}
+	output, err := c.client.GetProvisionedConcurrencyConfig(ctx, input)
+	if err != nil {
+		var pcnfe *types.ProvisionedConcurrencyConfigNotFoundException
+		if errors.As(err, &pcnfe) {
+			return nil, ErrNotFound
+		}
+		return nil, wrapNotFoundError(err)
+	}
+	return output, nil
+}
+
+// UpdateAliasConfig applies the provisioned concurrency, the event source mappings and the function URL
+// on the alias of the function, so that they follow the versions the alias routes the traffic to.
+// It must be called after the alias was created or updated to route the traffic to the new version.
+func (c *client) UpdateAliasConfig(ctx context.Context, fm FunctionManifest) error {
+	if fm.Spec.ProvisionedConcurrentExecutions != nil {
+		if err := c.updateProvisionedConcurrency(ctx, fm); err != nil {
+			return err
+		}
+	}
+	if fm.Spec.EventSourceMappings != nil {
+		if err := c.updateEventSourceMappings(ctx, fm); err != nil {
+			return fmt.Errorf("failed to update event source mappings for Lambda function %s: %w", fm.Spec.Name, err)
+		}
+	}
+	if fm.Spec.FunctionURL != nil {
+		if err := c.updateFunctionURLConfig(ctx, fm); err != nil {
+			return fmt.Errorf("failed to update function URL for Lambda function %s: %w", fm.Spec.Name, err)
+		}
+	}
+	return nil
+}
+
+func (c *client) updateProvisionedConcurrency(ctx context.Context, fm FunctionManifest) error {
+
+	if *fm.Spec.ProvisionedConcurrentExecutions == 0 {
+		input := &lambda.DeleteProvisionedConcurrencyConfigInput{
+			FunctionName: aws.String(fm.Spec.Name),
+			Qualifier:    aws.String(defaultAliasName),
+		}
+		if _, err := c.client.DeleteProvisionedConcurrencyConfig(ctx, input); err != nil && !errors.Is(wrapNotFoundError(err), ErrNotFound) {
+			return fmt.Errorf("failed to delete provisioned concurrency for Lambda function %s: %w", fm.Spec.Name, err)
+		}
+		return nil
+	}
+
+	input := &lambda.PutProvisionedConcurrencyConfigInput{
+		FunctionName:                    aws.String(fm.Spec.Name),
+		Qualifier:                       aws.String(defaultAliasName),
+		ProvisionedConcurrentExecutions: fm.Spec.ProvisionedConcurrentExecutions,
+	}
+	if _, err := c.client.PutProvisionedConcurrencyConfig(ctx, input); err != nil {
+		return fmt.Errorf("failed to update provisioned concurrency for Lambda function %s: %w", fm.Spec.Name, err)
+	}
+	return nil
+}
+
+// aliasQualifiedName returns the name of the function qualified by its alias.
+func aliasQualifiedName(functionName string) string {
+	return functionName + ":" + defaultAliasName
+}
+
+func wrapNotFoundError(err error) error {
+	var nfe *types.ResourceNotFoundException
+	if errors.As(err, &nfe) {
+		return ErrNotFound
+	}
 	return err
 }
 
+// IsNotFoundError reports whether the given error means that the requested Lambda resource does not exist.
+func IsNotFoundError(err error) bool {
+	var nfe *types.ResourceNotFoundException
+	return errors.Is(err, ErrNotFound) || errors.As(err, &nfe)
+}
+
+// IsAccessDeniedError reports whether the given error was caused by missing IAM permissions.
+func IsAccessDeniedError(err error) bool {
+	var apiErr interface{ ErrorCode() string }
+	return errors.As(err, &apiErr) && apiErr.ErrorCode() == "AccessDeniedException"
+}
+
+func makeDeadLetterConfig(fm FunctionManifest) *types.DeadLetterConfig {
+	if fm.Spec.DeadLetterTargetARN == "" {
+		return nil
+	}
+	return &types.DeadLetterConfig{
+		TargetArn: aws.String(fm.Spec.DeadLetterTargetARN),
+	}
+}
+
+func makeTracingConfig(fm FunctionManifest) *types.TracingConfig {
+	if fm.Spec.TracingMode == "" {
+		return nil
+	}
+	return &types.TracingConfig{
+		Mode: types.TracingMode(fm.Spec.TracingMode),
+	}
+}
+
+func makeLoggingConfig(fm FunctionManifest) *types.LoggingConfig {
+	if fm.Spec.LoggingConfig == nil {
+		return nil
+	}
+	cfg := &types.LoggingConfig{
+		LogFormat:           types.LogFormat(fm.Spec.LoggingConfig.LogFormat),
+		ApplicationLogLevel: types.ApplicationLogLevel(fm.Spec.LoggingConfig.ApplicationLogLevel),
+		SystemLogLevel:      types.SystemLogLevel(fm.Spec.LoggingConfig.SystemLogLevel),
+	}
+	if fm.Spec.LoggingConfig.LogGroup != "" {
+		cfg.LogGroup = aws.String(fm.Spec.LoggingConfig.LogGroup)
+	}
+	return cfg
+}
+
+func makeFunctionResponseTypes(in []string) []types.FunctionResponseType {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]types.FunctionResponseType, 0, len(in))
+	for _, t := range in {
+		out = append(out, types.FunctionResponseType(t))
+	}
+	return out
+}
+
 func (c *client) PublishFunction(ctx context.Context, fm FunctionManifest) (string, error) {
 	input := &lambda.PublishVersionInput{
 		FunctionName: aws.String(fm.Spec.Name),
@@ -79,6 +79,120 @@ type FunctionManifestSpec struct {
 	// You can use layers only with Lambda functions deployed as a .zip file archive. Layers are ignored for a container image.
 	// See https://docs.aws.amazon.com/lambda/latest/dg/chapter-layers.html.
 	Layers []string `json:"layers,omitempty"`
+	// The number of concurrent executions reserved for the function.
+	// Set 0 to throttle all invocations of the function.
+	ReservedConcurrentExecutions *int32 `json:"reservedConcurrentExecutions,omitempty"`
+	// The number of provisioned concurrent executions allocated to the alias of the function.
+	// It is applied on the alias when the traffic is routed to the new version, set 0 to remove it.
+	ProvisionedConcurrentExecutions *int32 `json:"provisionedConcurrentExecutions,omitempty"`
+	// The event sources which invoke the alias of the function, e.g. SQS queues, Kinesis and DynamoDB streams.
+	// When this is set, the event source mappings not listed here are removed from the alias.
+	EventSourceMappings []EventSourceMapping `json:"eventSourceMappings,omitempty"`
+	// The configuration of the function URL of the alias.
+	FunctionURL *FunctionURL `json:"functionUrl,omitempty"`
+	// The ARN of an SQS queue or SNS topic to send the events that failed all asynchronous processing attempts.
+	DeadLetterTargetARN string `json:"deadLetterTargetArn,omitempty"`
+	// The destinations and retry settings for the asynchronous invocations.
+	Destinations *Destinations `json:"destinations,omitempty"`
+	// The tracing mode of AWS X-Ray, either Active or PassThrough.
+	TracingMode string `json:"tracingMode,omitempty"`
+	// The logging configuration of the function.
+	LoggingConfig *LoggingConfig `json:"loggingConfig,omitempty"`
+}
+
+type EventSourceMapping struct {
+	// The ARN of the event source, e.g. an SQS queue, a Kinesis or DynamoDB stream.
+	EventSourceARN string `json:"eventSourceArn"`
+	// The maximum number of records in each batch.
+	BatchSize int32 `json:"batchSize,omitempty"`
+	// Whether the mapping is active or not. Default is true.
+	Enabled *bool `json:"enabled,omitempty"`
+	// The position in a stream to start reading from, either TRIM_HORIZON or LATEST.
+	// Required for Kinesis and DynamoDB streams.
+	StartingPosition string `json:"startingPosition,omitempty"`
+	// The maximum amount of time in seconds to gather records before invoking the function.
+	MaximumBatchingWindowInSeconds int32 `json:"maximumBatchingWindowInSeconds,omitempty"`
+	// The response types applied to the mapping, e.g. ReportBatchItemFailures.
+	FunctionResponseTypes []string `json:"functionResponseTypes,omitempty"`
+}
+
+func (m EventSourceMapping) validate() error {
+	if m.EventSourceARN == "" {
+		return fmt.Errorf("eventSourceArn is missing")
+	}
+	switch m.StartingPosition {
+	case "", "TRIM_HORIZON", "LATEST":
+	default:
+		return fmt.Errorf("startingPosition %s is invalid", m.StartingPosition)
+	}
+	if m.BatchSize < 0 || m.MaximumBatchingWindowInSeconds < 0 {
+		return fmt.Errorf("batchSize and maximumBatchingWindowInSeconds must not be negative")
+	}
+	return nil
+}
+
+type FunctionURL struct {
+	// The type of authentication, either AWS_IAM or NONE.
+	// Note that a resource-based policy allowing lambda:InvokeFunctionUrl is also required to use NONE.
+	AuthType string `json:"authType"`
+	// The invoke mode, either BUFFERED or RESPONSE_STREAM. Default is BUFFERED.
+	InvokeMode string           `json:"invokeMode,omitempty"`
+	Cors       *FunctionURLCors `json:"cors,omitempty"`
+}
+
+func (u FunctionURL) validate() error {
+	if u.AuthType != "AWS_IAM" && u.AuthType != "NONE" {
+		return fmt.Errorf("authType must be either AWS_IAM or NONE")
+	}
+	switch u.InvokeMode {
+	case "", "BUFFERED", "RESPONSE_STREAM":
+	default:
+		return fmt.Errorf("invokeMode %s is invalid", u.InvokeMode)
+	}
+	return nil
+}
+
+type FunctionURLCors struct {
+	AllowCredentials bool     `json:"allowCredentials,omitempty"`
+	AllowHeaders     []string `json:"allowHeaders,omitempty"`
+	AllowMethods     []string `json:"allowMethods,omitempty"`
+	AllowOrigins     []string `json:"allowOrigins,omitempty"`
+	ExposeHeaders    []string `json:"exposeHeaders,omitempty"`
+	MaxAge           int32    `json:"maxAge,omitempty"`
+}
+
+type Destinations struct {
+	// The ARN of the destination for the successful asynchronous invocations.
+	OnSuccess string `json:"onSuccess,omitempty"`
+	// The ARN of the destination for the failed asynchronous invocations.
+	OnFailure string `json:"onFailure,omitempty"`
+	// The maximum number of times to retry when the function returns an error.
+	MaximumRetryAttempts *int32 `json:"maximumRetryAttempts,omitempty"`
+	// The maximum age of a request that Lambda sends to the function for processing.
+	MaximumEventAgeInSeconds int32 `json:"maximumEventAgeInSeconds,omitempty"`
+}
+
+type LoggingConfig struct {
+	// The format of the logs, either Text or JSON.
+	LogFormat string `json:"logFormat,omitempty"`
+	// The level of the application logs, only available with JSON format.
+	ApplicationLogLevel string `json:"applicationLogLevel,omitempty"`
+	// The level of the system logs, only available with JSON format.
+	SystemLogLevel string `json:"systemLogLevel,omitempty"`
+	// The name of the CloudWatch log group to send the logs to.
+	LogGroup string `json:"logGroup,omitempty"`
+}
+
+func (l LoggingConfig) validate() error {
+	switch l.LogFormat {
+	case "", "Text", "JSON":
+	default:
+		return fmt.Errorf("logFormat %s is invalid", l.LogFormat)
+	}
+	if l.LogFormat != "JSON" && (l.ApplicationLogLevel != "" || l.SystemLogLevel != "") {
+		return fmt.Errorf("applicationLogLevel and systemLogLevel are only available with JSON logFormat")
+	}
+	return nil
 }
 
 type VPCConfig struct {
@@ -122,6 +236,35 @@ func (fmp FunctionManifestSpec) validate() error {
 	if fmp.Timeout < timeoutLowerLimit || fmp.Timeout > timeoutUpperLimit {
 		return fmt.Errorf("timeout is missing or out of range")
 	}
+	if fmp.ReservedConcurrentExecutions != nil && *fmp.ReservedConcurrentExecutions < 0 {
+		return fmt.Errorf("reservedConcurrentExecutions must not be negative")
+	}
+	if fmp.ProvisionedConcurrentExecutions != nil && *fmp.ProvisionedConcurrentExecutions < 0 {
+		return fmt.Errorf("provisionedConcurrentExecutions must not be negative")
+	}
+	eventSources := make(map[string]struct{}, len(fmp.EventSourceMappings))
+	for _, m := range fmp.EventSourceMappings {
+		if err := m.validate(); err != nil {
+			return fmt.Errorf("event source mapping is invalid: %w", err)
+		}
+		if _, ok := eventSources[m.EventSourceARN]; ok {
+			return fmt.Errorf("event source %s is mapped more than once", m.EventSourceARN)
+		}
+		eventSources[m.EventSourceARN] = struct{}{}
+	}
+	if fmp.FunctionURL != nil {
+		if err := fmp.FunctionURL.validate(); err != nil {
+			return fmt.Errorf("function URL is invalid: %w", err)
+		}
+	}
+	if fmp.TracingMode != "" && fmp.TracingMode != "Active" && fmp.TracingMode != "PassThrough" {
+		return fmt.Errorf("tracingMode must be either Active or PassThrough")
+	}
+	if fmp.LoggingConfig != nil {
+		if err := fmp.LoggingConfig.validate(); err != nil {
+			return fmt.Errorf("logging config is invalid: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -17,6 +17,7 @@ package lambda
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/pipe-cd/pipecd/pkg/model"
@@ -322,6 +323,146 @@ func TestParseFunctionManifest(t *testing.T) {
 	  "s3Key": "function-code",
 	  "s3ObjectVersion": "xyz"
   }
+}`,
+			wantSpec: FunctionManifest{},
+			wantErr:  true,
+		},
+		{
+			name: "correct config for LambdaFunction with concurrency, event sources, URL, destinations, tracing and logging",
+			data: `{
+  "apiVersion": "pipecd.dev/v1beta1",
+  "kind": "LambdaFunction",
+  "spec": {
+	  "name": "SimpleFunction",
+	  "role": "arn:aws:iam::xxxxx:role/lambda-role",
+	  "memory": 128,
+	  "timeout": 5,
+	  "image": "ecr.region.amazonaws.com/lambda-simple-function:v0.0.1",
+	  "reservedConcurrentExecutions": 0,
+	  "provisionedConcurrentExecutions": 5,
+	  "eventSourceMappings": [
+	    {
+	      "eventSourceArn": "arn:aws:sqs:region:xxxxx:queue",
+	      "batchSize": 10,
+	      "functionResponseTypes": ["ReportBatchItemFailures"]
+	    },
+	    {
+	      "eventSourceArn": "arn:aws:kinesis:region:xxxxx:stream/stream",
+	      "enabled": false,
+	      "startingPosition": "LATEST"
+	    }
+	  ],
+	  "functionUrl": {
+	    "authType": "AWS_IAM",
+	    "cors": {
+	      "allowOrigins": ["https://example.com"]
+	    }
+	  },
+	  "deadLetterTargetArn": "arn:aws:sqs:region:xxxxx:dlq",
+	  "destinations": {
+	    "onFailure": "arn:aws:sqs:region:xxxxx:on-failure",
+	    "maximumRetryAttempts": 0
+	  },
+	  "tracingMode": "Active",
+	  "loggingConfig": {
+	    "logFormat": "JSON",
+	    "applicationLogLevel": "INFO"
+	  }
+  }
+}`,
+			wantSpec: FunctionManifest{
+				Kind:       "LambdaFunction",
+				APIVersion: "pipecd.dev/v1beta1",
+				Spec: FunctionManifestSpec{
+					Name:                            "SimpleFunction",
+					Role:                            "arn:aws:iam::xxxxx:role/lambda-role",
+					Memory:                          128,
+					Timeout:                         5,
+					ImageURI:                        "ecr.region.amazonaws.com/lambda-simple-function:v0.0.1",
+					ReservedConcurrentExecutions:    aws.Int32(0),
+					ProvisionedConcurrentExecutions: aws.Int32(5),
+					EventSourceMappings: []EventSourceMapping{
+						{
+							EventSourceARN:        "arn:aws:sqs:region:xxxxx:queue",
+							BatchSize:             10,
+							FunctionResponseTypes: []string{"ReportBatchItemFailures"},
+						},
+						{
+							EventSourceARN:   "arn:aws:kinesis:region:xxxxx:stream/stream",
+							Enabled:          aws.Bool(false),
+							StartingPosition: "LATEST",
+						},
+					},
+					FunctionURL: &FunctionURL{
+						AuthType: "AWS_IAM",
+						Cors: &FunctionURLCors{
+							AllowOrigins: []string{"https://example.com"},
+						},
+					},
+					DeadLetterTargetARN: "arn:aws:sqs:region:xxxxx:dlq",
+					Destinations: &Destinations{
+						OnFailure:            "arn:aws:sqs:region:xxxxx:on-failure",
+						MaximumRetryAttempts: aws.Int32(0),
+					},
+					TracingMode: "Active",
+					LoggingConfig: &LoggingConfig{
+						LogFormat:           "JSON",
+						ApplicationLogLevel: "INFO",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "duplicated event source mappings",
+			data: `{
+  "apiVersion": "pipecd.dev/v1beta1",
+  "kind": "LambdaFunction",
+  "spec": {
+	  "name": "SimpleFunction",
+	  "role": "arn:aws:iam::xxxxx:role/lambda-role",
+	  "memory": 128,
+	  "timeout": 5,
+	  "image": "ecr.region.amazonaws.com/lambda-simple-function:v0.0.1",
+	  "eventSourceMappings": [
+	    {"eventSourceArn": "arn:aws:sqs:region:xxxxx:queue"},
+	    {"eventSourceArn": "arn:aws:sqs:region:xxxxx:queue"}
+	  ]
+  }
+}`,
+			wantSpec: FunctionManifest{},
+			wantErr:  true,
+		},
+		{
+			name: "invalid function URL auth type",
+			data: `{
+  "apiVersion": "pipecd.dev/v1beta1",
+  "kind": "LambdaFunction",
+  "spec": {
+	  "name": "SimpleFunction",
+	  "role": "arn:aws:iam::xxxxx:role/lambda-role",
+	  "memory": 128,
+	  "timeout": 5,
+	  "image": "ecr.region.amazonaws.com/lambda-simple-function:v0.0.1",
+	  "functionUrl": {"authType": "PUBLIC"}
+  }
+}`,
+			wantSpec: FunctionManifest{},
+			wantErr:  true,
+		},
+		{
+			name: "log levels without JSON log format",
+			data: `{
+  "apiVersion": "pipecd.dev/v1beta1",
+  "kind": "LambdaFunction",
+  "spec": {
+	  "name": "SimpleFunction",
+	  "role": "arn:aws:iam::xxxxx:role/lambda-role",
+	  "memory": 128,
+	  "timeout": 5,
+	  "image": "ecr.region.amazonaws.com/lambda-simple-function:v0.0.1",
+	  "loggingConfig": {"logFormat": "Text", "systemLogLevel": "WARN"}
+  }
 }`,
 			wantSpec: FunctionManifest{},
 			wantErr:  true,
@@ -49,6 +49,11 @@ type Client interface {
 	GetTrafficConfig(ctx context.Context, fm FunctionManifest) (routingTrafficCfg RoutingTrafficConfig, err error)
 	CreateTrafficConfig(ctx context.Context, fm FunctionManifest, version string) error
 	UpdateTrafficConfig(ctx context.Context, fm FunctionManifest, routingTraffic RoutingTrafficConfig) error
+	UpdateAliasConfig(ctx context.Context, fm FunctionManifest) error
+	GetProvisionedConcurrency(ctx context.Context, functionName string) (*lambda.GetProvisionedConcurrencyConfigOutput, error)
+	ListEventSourceMappings(ctx context.Context, functionName string) ([]types.EventSourceMappingConfiguration, error)
+	GetFunctionURLConfig(ctx context.Context, functionName string) (*lambda.GetFunctionUrlConfigOutput, error)
+	GetFunctionEventInvokeConfig(ctx context.Context, functionName string) (*lambda.GetFunctionEventInvokeConfigOutput, error)
 }
 
 // Registry holds a pool of aws client wrappers.
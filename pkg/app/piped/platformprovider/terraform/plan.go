@@ -0,0 +1,279 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// The actions planned for resources.
+const (
+	ActionCreate  = "create"
+	ActionUpdate  = "update"
+	ActionDelete  = "delete"
+	ActionReplace = "replace"
+	ActionRead    = "read"
+	ActionImport  = "import"
+	ActionForget  = "forget"
+)
+
+// ResourceChange represents a change to a resource planned by terraform plan.
+type ResourceChange struct {
+	// The address of the resource. e.g. module.db.aws_db_instance.main
+	Address string
+	// The type of the resource. e.g. aws_db_instance
+	Type string
+	// One of create, update, delete, replace, read, import and forget.
+	Action string
+	// The changed top-level attributes.
+	// This is only available when the plan was read from the JSON output.
+	Attributes []AttributeChange
+}
+
+// AttributeChange represents a change to a top-level attribute of a resource.
+type AttributeChange struct {
+	Name string
+	// The JSON representation of the values.
+	// Before is empty for the created resources while After is empty for the deleted ones.
+	Before string
+	After  string
+}
+
+// jsonPlan is a subset of the JSON output format of terraform show -json.
+// https://developer.hashicorp.com/terraform/internals/json-format#plan-representation
+type jsonPlan struct {
+	ResourceChanges []struct {
+		Address string     `json:"address"`
+		Type    string     `json:"type"`
+		Change  jsonChange `json:"change"`
+	} `json:"resource_changes"`
+	OutputChanges map[string]jsonChange `json:"output_changes"`
+}
+
+type jsonChange struct {
+	Actions         []string        `json:"actions"`
+	Before          interface{}     `json:"before"`
+	After           interface{}     `json:"after"`
+	AfterUnknown    interface{}     `json:"after_unknown"`
+	BeforeSensitive interface{}     `json:"before_sensitive"`
+	AfterSensitive  interface{}     `json:"after_sensitive"`
+	Importing       json.RawMessage `json:"importing"`
+}
+
+func parseJSONPlan(data []byte) (PlanResult, error) {
+	var plan jsonPlan
+	if err := json.Unmarshal(data, &plan); err != nil {
+		return PlanResult{}, fmt.Errorf("failed to parse JSON plan (%w)", err)
+	}
+
+	var r PlanResult
+	for _, rc := range plan.ResourceChanges {
+		importing := len(rc.Change.Importing) > 0 && string(rc.Change.Importing) != "null"
+		if importing {
+			r.Imports++
+		}
+
+		action := decideAction(rc.Change.Actions)
+		switch action {
+		case ActionCreate:
+			r.Adds++
+		case ActionUpdate:
+			r.Changes++
+		case ActionDelete:
+			r.Destroys++
+		case ActionReplace:
+			r.Adds++
+			r.Destroys++
+		case "":
+			if !importing {
+				continue
+			}
+			action = ActionImport
+		}
+
+		c := ResourceChange{
+			Address: rc.Address,
+			Type:    rc.Type,
+			Action:  action,
+		}
+		switch action {
+		case ActionCreate, ActionUpdate, ActionReplace:
+			c.Attributes = attributeChanges(rc.Change)
+		}
+		r.ResourceChanges = append(r.ResourceChanges, c)
+	}
+
+	for _, oc := range plan.OutputChanges {
+		if decideAction(oc.Actions) != "" {
+			r.HasStateChanges = true
+			break
+		}
+	}
+	if len(r.ResourceChanges) > 0 {
+		r.HasStateChanges = true
+	}
+	return r, nil
+}
+
+// decideAction converts the list of actions in the JSON plan to a single action.
+// An empty string is returned for no-op.
+func decideAction(actions []string) string {
+	switch {
+	case len(actions) == 2:
+		return ActionReplace
+	case len(actions) != 1:
+		return ""
+	}
+	switch actions[0] {
+	case "create":
+		return ActionCreate
+	case "update":
+		return ActionUpdate
+	case "delete":
+		return ActionDelete
+	case "read":
+		return ActionRead
+	case "forget":
+		return ActionForget
+	default:
+		return ""
+	}
+}
+
+func attributeChanges(c jsonChange) []AttributeChange {
+	before, _ := c.Before.(map[string]interface{})
+	after, _ := c.After.(map[string]interface{})
+	unknown, _ := c.AfterUnknown.(map[string]interface{})
+
+	names := make(map[string]struct{}, len(before)+len(after)+len(unknown))
+	for k := range before {
+		names[k] = struct{}{}
+	}
+	for k := range after {
+		names[k] = struct{}{}
+	}
+	for k := range unknown {
+		names[k] = struct{}{}
+	}
+
+	changes := make([]AttributeChange, 0, len(names))
+	for name := range names {
+		bv, inBefore := before[name]
+		av, inAfter := after[name]
+		_, isUnknown := unknown[name]
+		if isUnknown && unknown[name] != true {
+			// Only some of the nested values are unknown.
+			isUnknown = !inAfter
+		}
+		if !isUnknown && reflect.DeepEqual(bv, av) {
+			continue
+		}
+
+		ac := AttributeChange{Name: name}
+		if inBefore && bv != nil {
+			ac.Before = renderValue(bv, isSensitive(c.BeforeSensitive, name))
+		}
+		switch {
+		case isUnknown:
+			ac.After = "(known after apply)"
+		case inAfter && av != nil:
+			ac.After = renderValue(av, isSensitive(c.AfterSensitive, name))
+		}
+		if ac.Before == "" && ac.After == "" {
+			continue
+		}
+		changes = append(changes, ac)
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Name < changes[j].Name
+	})
+	return changes
+}
+
+// isSensitive reports whether the given attribute contains any sensitive values.
+func isSensitive(sensitive interface{}, name string) bool {
+	switch v := sensitive.(type) {
+	case bool:
+		return v
+	case map[string]interface{}:
+		return containsTrue(v[name])
+	}
+	return false
+}
+
+func containsTrue(v interface{}) bool {
+	switch v := v.(type) {
+	case bool:
+		return v
+	case map[string]interface{}:
+		for _, e := range v {
+			if containsTrue(e) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, e := range v {
+			if containsTrue(e) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func renderValue(v interface{}, sensitive bool) string {
+	if sensitive {
+		return "(sensitive value)"
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(data)
+}
+
+var actionSigns = map[string]string{
+	ActionCreate:  "+",
+	ActionUpdate:  "~",
+	ActionDelete:  "-",
+	ActionReplace: "-/+",
+	ActionRead:    "<=",
+	ActionImport:  "<-",
+	ActionForget:  ".",
+}
+
+// RenderChanges renders the planned changes per resource address in a diff-like format.
+func (r PlanResult) RenderChanges() string {
+	var b strings.Builder
+	for _, c := range r.ResourceChanges {
+		sign := actionSigns[c.Action]
+		fmt.Fprintf(&b, "%s %s (%s)\n", sign, c.Address, c.Action)
+		for _, a := range c.Attributes {
+			switch {
+			case a.Before == "":
+				fmt.Fprintf(&b, "+     %s: %s\n", a.Name, a.After)
+			case a.After == "":
+				fmt.Fprintf(&b, "-     %s: %s\n", a.Name, a.Before)
+			default:
+				fmt.Fprintf(&b, "~     %s: %s -> %s\n", a.Name, a.Before, a.After)
+			}
+		}
+	}
+	return b.String()
+}
@@ -15,9 +15,14 @@
 package terraform
 
 import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPlanHasChangeRegex(t *testing.T) {
@@ -197,6 +202,51 @@ Note: You didn't use the -out option to save this plan, so Terraform can't guara
 	}
 }
 
+func TestParseResourceChanges(t *testing.T) {
+	t.Parallel()
+
+	out := `
+Terraform will perform the following actions:
+
+  # aws_instance.web will be created
+  + resource "aws_instance" "web" {
+      + ami = "ami-123"
+    }
+
+  # aws_security_group.web will be updated in-place
+  ~ resource "aws_security_group" "web" {
+    }
+
+  # module.db.aws_db_instance.main["primary"] will be destroyed
+  - resource "aws_db_instance" "main" {
+    }
+
+  # aws_s3_bucket.logs must be replaced
+-/+ resource "aws_s3_bucket" "logs" {
+    }
+
+  # data.aws_iam_policy_document.assume will be read during apply
+ <= data "aws_iam_policy_document" "assume" {
+    }
+
+  # aws_instance.old (deposed object 1a2b3c4d) will be destroyed
+  - resource "aws_instance" "old" {
+    }
+
+Plan: 1 to add, 1 to change, 3 to destroy.
+`
+
+	expected := []ResourceChange{
+		{Address: "aws_instance.web", Type: "aws_instance", Action: "create"},
+		{Address: "aws_security_group.web", Type: "aws_security_group", Action: "update"},
+		{Address: `module.db.aws_db_instance.main["primary"]`, Type: "aws_db_instance", Action: "delete"},
+		{Address: "aws_s3_bucket.logs", Type: "aws_s3_bucket", Action: "replace"},
+		{Address: "data.aws_iam_policy_document.assume", Type: "aws_iam_policy_document", Action: "read"},
+		{Address: "aws_instance.old", Type: "aws_instance", Action: "delete"},
+	}
+	assert.Equal(t, expected, parseResourceChanges(out))
+}
+
 func TestRender(t *testing.T) {
 	t.Parallel()
 
@@ -297,3 +347,66 @@ guarantee to take exactly these actions if you run "terraform apply" now.`,
 		})
 	}
 }
+
+func TestCommandName(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		execPath string
+		expected string
+	}{
+		{
+			name:     "terraform",
+			execPath: "/usr/local/bin/terraform",
+			expected: "terraform",
+		},
+		{
+			name:     "terraform with version",
+			execPath: "/home/pipecd/tools/terraform-1.5.7",
+			expected: "terraform",
+		},
+		{
+			name:     "opentofu",
+			execPath: "/usr/local/bin/tofu",
+			expected: "tofu",
+		},
+		{
+			name:     "opentofu with version",
+			execPath: "/home/pipecd/tools/tofu-1.6.2",
+			expected: "tofu",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, commandName(tc.execPath))
+		})
+	}
+}
+
+func TestOpenTofuBinary(t *testing.T) {
+	t.Parallel()
+
+	// A fake tofu binary which prints its version and echoes the given arguments.
+	execPath := filepath.Join(t.TempDir(), "tofu-1.6.2")
+	script := `#!/bin/sh
+if [ "$1" = "version" ]; then
+  echo "OpenTofu v1.6.2"
+  exit 0
+fi
+echo "args: $@"
+`
+	require.NoError(t, os.WriteFile(execPath, []byte(script), 0755))
+
+	ctx := context.Background()
+	tf := NewTerraform(execPath, t.TempDir(), WithoutColor())
+
+	version, err := tf.Version(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "OpenTofu v1.6.2", version)
+
+	var buf bytes.Buffer
+	require.NoError(t, tf.Init(ctx, &buf))
+	assert.Equal(t, "tofu init -no-colorargs: init -no-color\n", buf.String())
+}
@@ -0,0 +1,365 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policy provides a piped component that evaluates the policy rules
+// configured for piped against the planned deployments of applications.
+// The policies are enforced by the planner and the plan-preview of piped v0 only.
+package policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type gitClient interface {
+	Clone(ctx context.Context, repoID, remote, branch, destination string) (git.Repo, error)
+}
+
+// Input contains the data of a planned deployment which policy rules are evaluated against.
+type Input struct {
+	ApplicationName string
+	ApplicationKind model.ApplicationKind
+	Labels          map[string]string
+	// The rendered manifests, only available for the application kinds
+	// whose manifests can be rendered while planning such as Kubernetes.
+	Manifests []map[string]interface{}
+	// The result of the dry-run such as terraform plan.
+	// Nil means no dry-run was executed.
+	Plan         map[string]interface{}
+	SyncStrategy model.SyncStrategy
+	Stages       []*model.PipelineStage
+	// Whether the input is built for a plan-preview result.
+	// The rules only for plan-preview are skipped otherwise.
+	PlanPreview bool
+}
+
+// Evaluator evaluates the policy rules against the planned deployments.
+type Evaluator struct {
+	rules []rule
+}
+
+type rule struct {
+	config.PolicyRule
+	program cel.Program
+	// Whether the rule refers to the result of the dry-run.
+	usesPlan bool
+}
+
+// The interval to check whether a new commit was pushed to the branch of the policies.
+var policyRefreshInterval = time.Minute
+
+// Loader loads the policies from the configured branch of the policy repository.
+// Since the policies are always loaded from the configured branch,
+// changes being previewed or deployed cannot affect the policies applied to themselves.
+// The repository is cloned only once and the compiled policies are cached by commit,
+// while Run refreshes them in the background when a new commit is pushed to the branch.
+type Loader struct {
+	gitClient gitClient
+	cfg       *config.PipedSpec
+	logger    *zap.Logger
+
+	// Serializes the operations on the cloned repository.
+	repoMu sync.Mutex
+	repo   git.Repo
+
+	mu        sync.RWMutex
+	loaded    bool
+	commit    string
+	evaluator *Evaluator
+}
+
+// NewLoader returns a Loader for the policies configured in the given piped config.
+func NewLoader(gc gitClient, cfg *config.PipedSpec, logger *zap.Logger) *Loader {
+	return &Loader{
+		gitClient: gc,
+		cfg:       cfg,
+		logger:    logger.Named("policy-loader"),
+	}
+}
+
+// Run refreshes the cached policies periodically until the given context has done.
+// The cached policies are kept when they could not be refreshed.
+func (l *Loader) Run(ctx context.Context) error {
+	if l.cfg.Policy == nil {
+		return nil
+	}
+
+	ticker := time.NewTicker(policyRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if _, err := l.refresh(ctx); err != nil {
+				l.logger.Error("failed to refresh the policies", zap.Error(err))
+			}
+		}
+	}
+}
+
+// Load returns the evaluator of the cached policies.
+// The policies are loaded from the head commit of the configured branch when they have not been loaded yet.
+// A nil evaluator is returned if no policy was configured for the given piped.
+func (l *Loader) Load(ctx context.Context) (*Evaluator, error) {
+	if l.cfg.Policy == nil {
+		return nil, nil
+	}
+
+	l.mu.RLock()
+	loaded, evaluator := l.loaded, l.evaluator
+	l.mu.RUnlock()
+	if loaded {
+		return evaluator, nil
+	}
+	return l.refresh(ctx)
+}
+
+// refresh pulls the configured branch and compiles the policies placed at its head commit
+// unless they were already compiled.
+func (l *Loader) refresh(ctx context.Context) (*Evaluator, error) {
+	repoCfg, ok := l.cfg.GetRepository(l.cfg.Policy.RepoID)
+	if !ok {
+		return nil, fmt.Errorf("repository %s for policies was not found in piped config", l.cfg.Policy.RepoID)
+	}
+
+	l.repoMu.Lock()
+	defer l.repoMu.Unlock()
+
+	if l.repo == nil {
+		dir, err := os.MkdirTemp("", "policy-*")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create temporary directory (%w)", err)
+		}
+		repo, err := l.gitClient.Clone(ctx, repoCfg.RepoID, repoCfg.Remote, repoCfg.Branch, dir)
+		if err != nil {
+			os.RemoveAll(dir)
+			return nil, fmt.Errorf("failed to clone repository %s for policies (%w)", repoCfg.RepoID, err)
+		}
+		l.repo = repo
+	} else if err := l.repo.Pull(ctx, repoCfg.Branch); err != nil {
+		return nil, fmt.Errorf("failed to pull repository %s for policies (%w)", repoCfg.RepoID, err)
+	}
+
+	commit, err := l.repo.GetLatestCommit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the latest commit of repository %s for policies (%w)", repoCfg.RepoID, err)
+	}
+
+	l.mu.RLock()
+	loaded, cached, evaluator := l.loaded, l.commit, l.evaluator
+	l.mu.RUnlock()
+	if loaded && commit.Hash == cached {
+		return evaluator, nil
+	}
+
+	spec, err := config.LoadPolicies(filepath.Join(l.repo.GetPath(), l.cfg.Policy.Path))
+	if errors.Is(err, config.ErrNotFound) {
+		return nil, fmt.Errorf("policy directory %s was not found in repository %s", l.cfg.Policy.Path, repoCfg.RepoID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	evaluator, err = NewEvaluator(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	l.mu.Lock()
+	l.loaded = true
+	l.commit = commit.Hash
+	l.evaluator = evaluator
+	l.mu.Unlock()
+	return evaluator, nil
+}
+
+// NewEvaluator compiles all rules of the given policy spec.
+func NewEvaluator(spec *config.PolicySpec) (*Evaluator, error) {
+	env, err := cel.NewEnv(
+		cel.Variable("app", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("manifests", cel.ListType(cel.DynType)),
+		cel.Variable("plan", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("strategy", cel.StringType),
+		cel.Variable("stages", cel.ListType(cel.MapType(cel.StringType, cel.DynType))),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]rule, 0, len(spec.Rules))
+	for _, r := range spec.Rules {
+		ast, iss := env.Compile(r.Expression)
+		if iss.Err() != nil {
+			return nil, fmt.Errorf("failed to compile policy rule %s (%w)", r.Name, iss.Err())
+		}
+		if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+			return nil, fmt.Errorf("policy rule %s must be evaluated to a bool but got %s", r.Name, ast.OutputType())
+		}
+		usesPlan := referencesVariable(ast, "plan")
+		if usesPlan && !r.PlanPreviewOnly {
+			return nil, fmt.Errorf("policy rule %s refers to the plan which is only available in plan-preview, planPreviewOnly must be set", r.Name)
+		}
+		prg, err := env.Program(ast)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build policy rule %s (%w)", r.Name, err)
+		}
+		rules = append(rules, rule{PolicyRule: r, program: prg, usesPlan: usesPlan})
+	}
+	return &Evaluator{rules: rules}, nil
+}
+
+func referencesVariable(ast *cel.Ast, name string) bool {
+	for _, ref := range ast.NativeRep().ReferenceMap() {
+		if ref.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Evaluate returns the violations of all rules applied to the given input.
+// Rules which could not be evaluated, including the ones referring to the plan
+// while no dry-run was executed, are treated as violated
+// to avoid applying changes which were not verified.
+// The rules only for plan-preview are skipped unless the input is built for a plan-preview result.
+func (e *Evaluator) Evaluate(in Input) []*model.PolicyViolation {
+	if e == nil || len(e.rules) == 0 {
+		return nil
+	}
+
+	var (
+		kind       = in.ApplicationKind.String()
+		activation = makeActivation(in)
+		violations = make([]*model.PolicyViolation, 0)
+	)
+	for _, r := range e.rules {
+		if !r.Matches(kind, in.Labels) {
+			continue
+		}
+		if r.PlanPreviewOnly && !in.PlanPreview {
+			continue
+		}
+
+		msg := r.Message
+		if msg == "" {
+			msg = fmt.Sprintf("policy rule %s was violated", r.Name)
+		}
+		if r.usesPlan && in.Plan == nil {
+			violations = append(violations, &model.PolicyViolation{
+				Rule:    r.Name,
+				Message: fmt.Sprintf("policy rule %s requires the result of the dry-run which is not available", r.Name),
+				Action:  makeViolationAction(r.Action),
+			})
+			continue
+		}
+
+		out, _, err := r.program.Eval(activation)
+		switch {
+		case err != nil:
+			msg = fmt.Sprintf("unable to evaluate policy rule %s (%v)", r.Name, err)
+		case out.Value() == true:
+			continue
+		case out.Value() != false:
+			msg = fmt.Sprintf("policy rule %s must be evaluated to a bool but got %v", r.Name, out.Value())
+		}
+
+		violations = append(violations, &model.PolicyViolation{
+			Rule:    r.Name,
+			Message: msg,
+			Action:  makeViolationAction(r.Action),
+		})
+	}
+	return violations
+}
+
+func makeActivation(in Input) map[string]interface{} {
+	labels := in.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	manifests := make([]interface{}, 0, len(in.Manifests))
+	for _, m := range in.Manifests {
+		manifests = append(manifests, m)
+	}
+	// The plan is always provided with the list of resource changes
+	// so that rules about them can be written without checking its existence.
+	plan := map[string]interface{}{
+		"resourceChanges": []interface{}{},
+	}
+	for k, v := range in.Plan {
+		plan[k] = v
+	}
+	stages := make([]map[string]interface{}, 0, len(in.Stages))
+	for _, s := range in.Stages {
+		stages = append(stages, map[string]interface{}{
+			"id":         s.Id,
+			"name":       s.Name,
+			"desc":       s.Desc,
+			"index":      int64(s.Index),
+			"predefined": s.Predefined,
+			"visible":    s.Visible,
+		})
+	}
+	return map[string]interface{}{
+		"app": map[string]interface{}{
+			"name":   in.ApplicationName,
+			"kind":   in.ApplicationKind.String(),
+			"labels": labels,
+		},
+		"manifests": manifests,
+		"plan":      plan,
+		"strategy":  in.SyncStrategy.String(),
+		"stages":    stages,
+	}
+}
+
+func makeViolationAction(a config.PolicyAction) model.PolicyViolation_Action {
+	if a == config.PolicyActionRequireApproval {
+		return model.PolicyViolation_REQUIRE_APPROVAL
+	}
+	return model.PolicyViolation_DENY
+}
+
+// Denied reports whether any of the given violations requires failing the deployment.
+func Denied(violations []*model.PolicyViolation) bool {
+	for _, v := range violations {
+		if v.Action == model.PolicyViolation_DENY {
+			return true
+		}
+	}
+	return false
+}
+
+// Describe returns a human-readable description of the given violations.
+func Describe(violations []*model.PolicyViolation) string {
+	msgs := make([]string, 0, len(violations))
+	for _, v := range violations {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", v.Rule, v.Message))
+	}
+	return strings.Join(msgs, "; ")
+}
@@ -0,0 +1,332 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/git/gittest"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestNewEvaluator(t *testing.T) {
+	testcases := []struct {
+		name            string
+		expression      string
+		planPreviewOnly bool
+		wantErr         bool
+	}{
+		{
+			name:       "valid expression",
+			expression: `app.labels["env"] == "prod"`,
+		},
+		{
+			name:            "plan rule only for plan-preview",
+			expression:      `plan.destroys == 0`,
+			planPreviewOnly: true,
+		},
+		{
+			name:       "plan rule also for deployments",
+			expression: `plan.destroys == 0`,
+			wantErr:    true,
+		},
+		{
+			name:       "syntax error",
+			expression: `app.labels[`,
+			wantErr:    true,
+		},
+		{
+			name:       "undeclared variable",
+			expression: `foo == 1`,
+			wantErr:    true,
+		},
+		{
+			name:       "non-bool expression",
+			expression: `strategy + "foo"`,
+			wantErr:    true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewEvaluator(&config.PolicySpec{
+				Rules: []config.PolicyRule{{Name: "rule", Expression: tc.expression, PlanPreviewOnly: tc.planPreviewOnly}},
+			})
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	spec := &config.PolicySpec{
+		Rules: []config.PolicyRule{
+			{
+				Name:       "no-host-network",
+				Match:      config.PolicyMatch{Kinds: []string{"KUBERNETES"}},
+				Expression: `manifests.all(m, !has(m.spec.template) || !has(m.spec.template.spec.hostNetwork) || !m.spec.template.spec.hostNetwork)`,
+				Message:    "hostNetwork must not be enabled",
+				Action:     config.PolicyActionDeny,
+			},
+			{
+				Name:            "no-rds-destroy",
+				Match:           config.PolicyMatch{Kinds: []string{"TERRAFORM"}},
+				Expression:      `!plan.resourceChanges.exists(c, c.type == "aws_db_instance" && c.action in ["delete", "replace"])`,
+				Action:          config.PolicyActionDeny,
+				PlanPreviewOnly: true,
+			},
+			{
+				Name:       "prod-needs-approval",
+				Match:      config.PolicyMatch{Labels: map[string]string{"env": "prod"}},
+				Expression: `stages.exists(s, s.name == "WAIT_APPROVAL")`,
+				Message:    "production applications must be approved",
+				Action:     config.PolicyActionRequireApproval,
+			},
+			{
+				Name:       "broken",
+				Match:      config.PolicyMatch{Labels: map[string]string{"team": "broken"}},
+				Expression: `app.labels["missing"] == "foo"`,
+				Action:     config.PolicyActionRequireApproval,
+			},
+		},
+	}
+	e, err := NewEvaluator(spec)
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name     string
+		input    Input
+		expected []*model.PolicyViolation
+	}{
+		{
+			name: "compliant kubernetes application",
+			input: Input{
+				ApplicationKind: model.ApplicationKind_KUBERNETES,
+				Manifests: []map[string]interface{}{
+					{
+						"kind": "Deployment",
+						"spec": map[string]interface{}{
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{"hostNetwork": false},
+							},
+						},
+					},
+					{"kind": "Service", "spec": map[string]interface{}{}},
+				},
+			},
+			expected: []*model.PolicyViolation{},
+		},
+		{
+			name: "pod using host network",
+			input: Input{
+				ApplicationKind: model.ApplicationKind_KUBERNETES,
+				Manifests: []map[string]interface{}{
+					{
+						"kind": "Deployment",
+						"spec": map[string]interface{}{
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{"hostNetwork": true},
+							},
+						},
+					},
+				},
+			},
+			expected: []*model.PolicyViolation{
+				{
+					Rule:    "no-host-network",
+					Message: "hostNetwork must not be enabled",
+					Action:  model.PolicyViolation_DENY,
+				},
+			},
+		},
+		{
+			name: "terraform destroying rds instance",
+			input: Input{
+				ApplicationKind: model.ApplicationKind_TERRAFORM,
+				PlanPreview:     true,
+				Plan: map[string]interface{}{
+					"resourceChanges": []interface{}{
+						map[string]interface{}{"address": "aws_db_instance.main", "type": "aws_db_instance", "action": "delete"},
+					},
+				},
+			},
+			expected: []*model.PolicyViolation{
+				{
+					Rule:    "no-rds-destroy",
+					Message: "policy rule no-rds-destroy was violated",
+					Action:  model.PolicyViolation_DENY,
+				},
+			},
+		},
+		{
+			name: "terraform without plan data",
+			input: Input{
+				ApplicationKind: model.ApplicationKind_TERRAFORM,
+				PlanPreview:     true,
+			},
+			expected: []*model.PolicyViolation{
+				{
+					Rule:    "no-rds-destroy",
+					Message: "policy rule no-rds-destroy requires the result of the dry-run which is not available",
+					Action:  model.PolicyViolation_DENY,
+				},
+			},
+		},
+		{
+			name: "terraform with empty plan data",
+			input: Input{
+				ApplicationKind: model.ApplicationKind_TERRAFORM,
+				Plan:            map[string]interface{}{},
+				PlanPreview:     true,
+			},
+			expected: []*model.PolicyViolation{},
+		},
+		{
+			name: "rules only for plan-preview are skipped for deployments",
+			input: Input{
+				ApplicationKind: model.ApplicationKind_TERRAFORM,
+			},
+			expected: []*model.PolicyViolation{},
+		},
+		{
+			name: "production application without approval",
+			input: Input{
+				ApplicationKind: model.ApplicationKind_ECS,
+				Labels:          map[string]string{"env": "prod"},
+				Stages: []*model.PipelineStage{
+					{Id: "stage-0", Name: model.StageECSSync.String()},
+				},
+			},
+			expected: []*model.PolicyViolation{
+				{
+					Rule:    "prod-needs-approval",
+					Message: "production applications must be approved",
+					Action:  model.PolicyViolation_REQUIRE_APPROVAL,
+				},
+			},
+		},
+		{
+			name: "production application with approval",
+			input: Input{
+				ApplicationKind: model.ApplicationKind_ECS,
+				Labels:          map[string]string{"env": "prod"},
+				Stages: []*model.PipelineStage{
+					{Id: "stage-0", Name: model.StageWaitApproval.String()},
+					{Id: "stage-1", Name: model.StageECSSync.String()},
+				},
+			},
+			expected: []*model.PolicyViolation{},
+		},
+		{
+			name: "evaluation error is treated as violation",
+			input: Input{
+				ApplicationKind: model.ApplicationKind_ECS,
+				Labels:          map[string]string{"team": "broken"},
+			},
+			expected: []*model.PolicyViolation{
+				{
+					Rule:    "broken",
+					Message: "unable to evaluate policy rule broken (no such key: missing)",
+					Action:  model.PolicyViolation_REQUIRE_APPROVAL,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := e.Evaluate(tc.input)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestDenied(t *testing.T) {
+	assert.False(t, Denied(nil))
+	assert.False(t, Denied([]*model.PolicyViolation{
+		{Rule: "a", Action: model.PolicyViolation_REQUIRE_APPROVAL},
+	}))
+	assert.True(t, Denied([]*model.PolicyViolation{
+		{Rule: "a", Action: model.PolicyViolation_REQUIRE_APPROVAL},
+		{Rule: "b", Action: model.PolicyViolation_DENY},
+	}))
+}
+
+type fakeGitClient struct {
+	repo   git.Repo
+	clones int
+}
+
+func (c *fakeGitClient) Clone(_ context.Context, _, _, _, _ string) (git.Repo, error) {
+	c.clones++
+	return c.repo, nil
+}
+
+func TestLoader(t *testing.T) {
+	dir := t.TempDir()
+	writePolicy := func(expression string) {
+		data := "apiVersion: pipecd.dev/v1beta1\nkind: Policy\nspec:\n  rules:\n    - name: rule\n      expression: " + expression + "\n"
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "policy.yaml"), []byte(data), 0644))
+	}
+	writePolicy("'strategy == \"PIPELINE\"'")
+
+	ctrl := gomock.NewController(t)
+	repo := gittest.NewMockRepo(ctrl)
+	repo.EXPECT().GetPath().Return(filepath.Dir(dir)).AnyTimes()
+	repo.EXPECT().Pull(gomock.Any(), "main").Return(nil).Times(2)
+	gomock.InOrder(
+		repo.EXPECT().GetLatestCommit(gomock.Any()).Return(git.Commit{Hash: "commit-1"}, nil).Times(2),
+		repo.EXPECT().GetLatestCommit(gomock.Any()).Return(git.Commit{Hash: "commit-2"}, nil),
+	)
+
+	gc := &fakeGitClient{repo: repo}
+	loader := NewLoader(gc, &config.PipedSpec{
+		Repositories: []config.PipedRepository{{RepoID: "policies", Remote: "remote", Branch: "main"}},
+		Policy:       &config.PipedPolicy{RepoID: "policies", Path: filepath.Base(dir)},
+	}, zap.NewNop())
+
+	first, err := loader.Load(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, first)
+
+	// The cached policies are returned without accessing the repository.
+	writePolicy("'strategy == \"QUICK_SYNC\"'")
+	second, err := loader.Load(context.Background())
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+
+	// The compiled policies are reused while the branch has no new commit.
+	refreshed, err := loader.refresh(context.Background())
+	require.NoError(t, err)
+	assert.Same(t, first, refreshed)
+
+	refreshed, err = loader.refresh(context.Background())
+	require.NoError(t, err)
+	assert.NotSame(t, first, refreshed)
+
+	third, err := loader.Load(context.Background())
+	require.NoError(t, err)
+	assert.Same(t, refreshed, third)
+	assert.Equal(t, 1, gc.clones)
+}
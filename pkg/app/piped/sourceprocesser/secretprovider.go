@@ -0,0 +1,197 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sourceprocesser
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// SecretProvider resolves the secrets stored in an external secret manager.
+type SecretProvider interface {
+	// Name returns the name of the template function used to refer the secrets,
+	// e.g. "vault" for {{ .vault "kv/data/app#password" }}.
+	Name() string
+	// Resolve returns the value of the secret referred by the given reference.
+	Resolve(ctx context.Context, ref string) (string, error)
+}
+
+type cachingSecretProvider struct {
+	provider SecretProvider
+	values   map[string]string
+	mu       sync.Mutex
+}
+
+// NewCachingSecretProvider returns a SecretProvider which resolves every reference only once.
+// It is intended to be created per deployment so that all deploy sources of
+// the deployment see the same values without querying the provider again.
+// It returns nil when the given provider is nil.
+func NewCachingSecretProvider(p SecretProvider) SecretProvider {
+	if p == nil {
+		return nil
+	}
+	return &cachingSecretProvider{
+		provider: p,
+		values:   make(map[string]string),
+	}
+}
+
+func (c *cachingSecretProvider) Name() string {
+	return c.provider.Name()
+}
+
+func (c *cachingSecretProvider) Resolve(ctx context.Context, ref string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if v, ok := c.values[ref]; ok {
+		return v, nil
+	}
+	v, err := c.provider.Resolve(ctx, ref)
+	if err != nil {
+		return "", err
+	}
+	c.values[ref] = v
+	return v, nil
+}
+
+// NewSecretProvider returns the SecretProvider configured in the given piped configuration.
+// It returns nil when no secret provider was configured.
+func NewSecretProvider(cfg *config.PipedSecretProviders) SecretProvider {
+	if cfg == nil || cfg.Vault == nil {
+		return nil
+	}
+	return NewVaultSecretProvider(*cfg.Vault)
+}
+
+type secretProviderProcessor struct {
+	ctx      context.Context
+	es       *config.ExternalSecrets
+	provider SecretProvider
+	refRegex *regexp.Regexp
+	// Matches the references written as the data field, e.g. {{ .vault "kv/data/app#password" }}.
+	fieldRefRegex *regexp.Regexp
+}
+
+// actionRegex matches the actions of the templates.
+var actionRegex = regexp.MustCompile(`(?s){{.*?}}`)
+
+// NewSecretProviderProcessor returns a processor that renders the target files
+// by resolving the secrets they refer from the given provider.
+// The resolved values are written only to the target files.
+func NewSecretProviderProcessor(ctx context.Context, es *config.ExternalSecrets, p SecretProvider) *secretProviderProcessor {
+	return &secretProviderProcessor{
+		ctx:      ctx,
+		es:       es,
+		provider: p,
+		refRegex: regexp.MustCompile(`\b` + regexp.QuoteMeta(p.Name()) + `\s+"([^"]+)"`),
+		// The field must not be a part of a chain such as $x.vault or .foo.vault.
+		// The ones given to the index function such as {{ index .vault "ref" }} are also matched to be left as they are.
+		fieldRefRegex: regexp.MustCompile(`(^|[^\w$.)\]])(index\s+)?\.` + regexp.QuoteMeta(p.Name()) + `\s+"`),
+	}
+}
+
+func (s *secretProviderProcessor) BuildTemplateData(appDir string) (map[string]string, error) {
+	secrets := make(map[string]string)
+	for _, t := range s.es.Targets {
+		buff, err := os.ReadFile(filepath.Join(appDir, t))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read target file %s (%w)", t, err)
+		}
+		for _, m := range s.refRegex.FindAllStringSubmatch(string(buff), -1) {
+			ref := m[1]
+			if _, ok := secrets[ref]; ok {
+				continue
+			}
+			v, err := s.provider.Resolve(s.ctx, ref)
+			if err != nil {
+				// Only the reference is included in the error, never the value.
+				return nil, fmt.Errorf("failed to resolve %s secret %s (%w)", s.provider.Name(), ref, err)
+			}
+			secrets[ref] = v
+		}
+	}
+	return secrets, nil
+}
+
+func (s *secretProviderProcessor) TemplateKey() string {
+	return s.provider.Name()
+}
+
+func (s *secretProviderProcessor) TemplateSource(appDir string, data map[string]map[string]string) error {
+	secrets := data[s.TemplateKey()]
+	funcs := template.FuncMap{
+		s.provider.Name(): func(ref string) (string, error) {
+			v, ok := secrets[ref]
+			if !ok {
+				return "", fmt.Errorf("%s secret %s was not resolved", s.provider.Name(), ref)
+			}
+			return v, nil
+		},
+	}
+
+	for _, t := range s.es.Targets {
+		targetPath := filepath.Join(appDir, t)
+		fileName := filepath.Base(targetPath)
+		buff, err := os.ReadFile(targetPath)
+		if err != nil {
+			return fmt.Errorf("failed to read target file %s (%w)", t, err)
+		}
+		tmpl := template.New(fileName).Funcs(sprig.TxtFuncMap()).Funcs(funcs).Option("missingkey=error")
+		tmpl, err = tmpl.Parse(s.rewriteFieldRefs(string(buff)))
+		if err != nil {
+			return fmt.Errorf("failed to parse target file %s (%w)", t, err)
+		}
+
+		f, err := os.OpenFile(targetPath, os.O_WRONLY|os.O_TRUNC, 0644)
+		if err != nil {
+			return fmt.Errorf("failed to open target file %s (%w)", t, err)
+		}
+
+		if err := tmpl.Execute(f, data); err != nil {
+			f.Close()
+			return fmt.Errorf("failed to render target file %s (%w)", t, err)
+		}
+
+		if err := f.Close(); err != nil {
+			return fmt.Errorf("failed to close target file %s (%w)", t, err)
+		}
+	}
+	return nil
+}
+
+// rewriteFieldRefs rewrites the references written as the data field such as {{ .vault "ref" }}
+// into the calls of the template function such as {{ vault "ref" }}
+// since text/template does not allow passing arguments to the fields of maps.
+func (s *secretProviderProcessor) rewriteFieldRefs(content string) string {
+	return actionRegex.ReplaceAllStringFunc(content, func(action string) string {
+		return s.fieldRefRegex.ReplaceAllStringFunc(action, func(ref string) string {
+			m := s.fieldRefRegex.FindStringSubmatch(ref)
+			if m[2] != "" {
+				return ref
+			}
+			return m[1] + s.provider.Name() + ref[len(m[1])+len(s.provider.Name())+1:]
+		})
+	})
+}
@@ -0,0 +1,155 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sourceprocesser
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+type testSecretProvider struct {
+	secrets map[string]string
+	calls   int
+}
+
+func (p *testSecretProvider) Name() string {
+	return "vault"
+}
+
+func (p *testSecretProvider) Resolve(_ context.Context, ref string) (string, error) {
+	p.calls++
+	v, ok := p.secrets[ref]
+	if !ok {
+		return "", fmt.Errorf("secret not found")
+	}
+	return v, nil
+}
+
+func TestSecretProviderProcessor(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		sources   map[string]string
+		targets   []string
+		expected  map[string]string
+		wantErr   bool
+		wantCalls int
+	}{
+		{
+			name: "single target",
+			sources: map[string]string{
+				"resource.yaml": `password: {{ vault "kv/data/app#password" }}`,
+			},
+			targets: []string{"resource.yaml"},
+			expected: map[string]string{
+				"resource.yaml": "password: secret-password",
+			},
+			wantCalls: 1,
+		},
+		{
+			name: "same reference is resolved once",
+			sources: map[string]string{
+				"resource1.yaml": `password: {{ vault "kv/data/app#password" }}`,
+				"resource2.yaml": `password: {{ vault "kv/data/app#password" | b64enc }}, user: {{ index .vault "kv/data/app#user" }}, {{ vault "kv/data/app#user" }}`,
+			},
+			targets: []string{"resource1.yaml", "resource2.yaml"},
+			expected: map[string]string{
+				"resource1.yaml": "password: secret-password",
+				"resource2.yaml": "password: c2VjcmV0LXBhc3N3b3Jk, user: admin, admin",
+			},
+			wantCalls: 2,
+		},
+		{
+			name: "reference as data field",
+			sources: map[string]string{
+				"resource.yaml": `password: {{ .vault "kv/data/app#password" | quote }}, user: {{- upper (.vault "kv/data/app#user") }}, text: .vault "kv/data/app#user"`,
+			},
+			targets: []string{"resource.yaml"},
+			expected: map[string]string{
+				"resource.yaml": `password: "secret-password", user:ADMIN, text: .vault "kv/data/app#user"`,
+			},
+			wantCalls: 2,
+		},
+		{
+			name: "unknown secret",
+			sources: map[string]string{
+				"resource.yaml": `password: {{ vault "kv/data/app#unknown" }}`,
+			},
+			targets:   []string{"resource.yaml"},
+			wantErr:   true,
+			wantCalls: 1,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			appDir := t.TempDir()
+			for p, c := range tc.sources {
+				err := os.WriteFile(filepath.Join(appDir, p), []byte(c), 0600)
+				require.NoError(t, err)
+			}
+
+			provider := &testSecretProvider{
+				secrets: map[string]string{
+					"kv/data/app#password": "secret-password",
+					"kv/data/app#user":     "admin",
+				},
+			}
+			p := NewSecretProviderProcessor(context.Background(), &config.ExternalSecrets{Targets: tc.targets}, NewCachingSecretProvider(provider))
+			err := NewSourceProcessor(appDir, p).Process()
+			assert.Equal(t, tc.wantCalls, provider.calls)
+			if tc.wantErr {
+				require.Error(t, err)
+				assert.NotContains(t, err.Error(), "secret-password")
+				return
+			}
+			require.NoError(t, err)
+
+			for p, expected := range tc.expected {
+				data, err := os.ReadFile(filepath.Join(appDir, p))
+				require.NoError(t, err)
+				assert.Equal(t, expected, string(data))
+			}
+		})
+	}
+}
+
+func TestCachingSecretProvider(t *testing.T) {
+	t.Parallel()
+
+	provider := &testSecretProvider{
+		secrets: map[string]string{"kv/data/app#password": "secret-password"},
+	}
+	p := NewCachingSecretProvider(provider)
+
+	for i := 0; i < 3; i++ {
+		v, err := p.Resolve(context.Background(), "kv/data/app#password")
+		require.NoError(t, err)
+		assert.Equal(t, "secret-password", v)
+	}
+	assert.Equal(t, 1, provider.calls)
+	assert.Equal(t, "vault", p.Name())
+}
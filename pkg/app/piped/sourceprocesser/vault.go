@@ -0,0 +1,259 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sourceprocesser
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	defaultVaultAppRoleMountPath        = "approle"
+	defaultVaultKubernetesMountPath     = "kubernetes"
+	defaultVaultServiceAccountTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	vaultRequestTimeout                 = 30 * time.Second
+	vaultSecretProviderName             = "vault"
+	vaultTokenHeader                    = "X-Vault-Token"
+	vaultNamespaceHeader                = "X-Vault-Namespace"
+)
+
+var errVaultPermissionDenied = errors.New("permission denied")
+
+type vaultSecretProvider struct {
+	cfg    config.SecretProviderVault
+	client *http.Client
+
+	token string
+	mu    sync.Mutex
+}
+
+// NewVaultSecretProvider returns a SecretProvider that reads secrets from HashiCorp Vault.
+// The references are in the form of "<path>#<key>", e.g. "kv/data/app#password".
+// Both KV version 1 and version 2 secret engines are supported.
+func NewVaultSecretProvider(cfg config.SecretProviderVault) SecretProvider {
+	return &vaultSecretProvider{
+		cfg:    cfg,
+		client: &http.Client{Timeout: vaultRequestTimeout},
+	}
+}
+
+func (v *vaultSecretProvider) Name() string {
+	return vaultSecretProviderName
+}
+
+func (v *vaultSecretProvider) Resolve(ctx context.Context, ref string) (string, error) {
+	path, key, ok := strings.Cut(ref, "#")
+	if !ok || path == "" || key == "" {
+		return "", fmt.Errorf("invalid reference %q, it must be in the form of <path>#<key>", ref)
+	}
+
+	data, err := v.read(ctx, path)
+	if errors.Is(err, errVaultPermissionDenied) {
+		// The token might be expired, so login again and retry once.
+		v.resetToken()
+		data, err = v.read(ctx, path)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	value, ok := data[key]
+	if !ok {
+		return "", fmt.Errorf("key %s was not found in %s", key, path)
+	}
+	switch value := value.(type) {
+	case string:
+		return value, nil
+	default:
+		b, err := json.Marshal(value)
+		if err != nil {
+			return "", fmt.Errorf("failed to encode the value of key %s in %s (%w)", key, path, err)
+		}
+		return string(b), nil
+	}
+}
+
+// read returns the key-value pairs of the secret stored at the given path.
+func (v *vaultSecretProvider) read(ctx context.Context, path string) (map[string]interface{}, error) {
+	token, err := v.getToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := v.do(ctx, http.MethodGet, "/v1/"+strings.TrimPrefix(path, "/"), token, nil, &resp); err != nil {
+		return nil, fmt.Errorf("failed to read %s (%w)", path, err)
+	}
+
+	// The KV version 2 engine wraps the key-value pairs with their metadata.
+	if inner, ok := resp.Data["data"].(map[string]interface{}); ok {
+		if _, ok := resp.Data["metadata"]; ok {
+			return inner, nil
+		}
+	}
+	return resp.Data, nil
+}
+
+func (v *vaultSecretProvider) resetToken() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.token = ""
+}
+
+func (v *vaultSecretProvider) getToken(ctx context.Context) (string, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.token != "" {
+		return v.token, nil
+	}
+	token, err := v.login(ctx)
+	if err != nil {
+		return "", err
+	}
+	v.token = token
+	return token, nil
+}
+
+func (v *vaultSecretProvider) login(ctx context.Context) (string, error) {
+	auth := v.cfg.Auth
+	var (
+		mountPath string
+		body      map[string]string
+	)
+
+	switch auth.Method {
+	case config.VaultAuthMethodToken:
+		token, err := readTrimmedFile(auth.TokenFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read vault token file (%w)", err)
+		}
+		return token, nil
+
+	case config.VaultAuthMethodAppRole:
+		secretID, err := readTrimmedFile(auth.SecretIDFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read vault secret ID file (%w)", err)
+		}
+		mountPath = defaultVaultAppRoleMountPath
+		body = map[string]string{
+			"role_id":   auth.RoleID,
+			"secret_id": secretID,
+		}
+
+	case config.VaultAuthMethodKubernetes:
+		tokenFile := auth.ServiceAccountTokenFile
+		if tokenFile == "" {
+			tokenFile = defaultVaultServiceAccountTokenFile
+		}
+		jwt, err := readTrimmedFile(tokenFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read service account token file (%w)", err)
+		}
+		mountPath = defaultVaultKubernetesMountPath
+		body = map[string]string{
+			"role": auth.Role,
+			"jwt":  jwt,
+		}
+
+	default:
+		return "", fmt.Errorf("unsupported vault auth method %q", auth.Method)
+	}
+
+	if auth.MountPath != "" {
+		mountPath = strings.Trim(auth.MountPath, "/")
+	}
+
+	var resp struct {
+		Auth struct {
+			ClientToken string `json:"client_token"`
+		} `json:"auth"`
+	}
+	if err := v.do(ctx, http.MethodPost, fmt.Sprintf("/v1/auth/%s/login", mountPath), "", body, &resp); err != nil {
+		return "", fmt.Errorf("failed to login to vault with %s method (%w)", auth.Method, err)
+	}
+	if resp.Auth.ClientToken == "" {
+		return "", fmt.Errorf("vault returned no client token for %s method", auth.Method)
+	}
+	return resp.Auth.ClientToken, nil
+}
+
+func (v *vaultSecretProvider) do(ctx context.Context, method, path, token string, body interface{}, out interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(v.cfg.Address, "/")+path, reqBody)
+	if err != nil {
+		return err
+	}
+	if token != "" {
+		req.Header.Set(vaultTokenHeader, token)
+	}
+	if v.cfg.Namespace != "" {
+		req.Header.Set(vaultNamespaceHeader, v.cfg.Namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusForbidden:
+		return errVaultPermissionDenied
+	case resp.StatusCode == http.StatusNotFound:
+		return errors.New("not found")
+	case resp.StatusCode >= 300:
+		// The error body of Vault contains only the error messages.
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response (%w)", err)
+	}
+	return nil
+}
+
+func readTrimmedFile(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(b)), nil
+}
@@ -0,0 +1,181 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sourceprocesser
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// newFakeVaultServer returns a server which behaves like a Vault dev server
+// having a KV version 2 engine at "kv" and a KV version 1 engine at "secret".
+func newFakeVaultServer(t *testing.T, validToken string) *httptest.Server {
+	writeJSON := func(w http.ResponseWriter, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(v))
+	}
+	login := func(w http.ResponseWriter, r *http.Request, field, expected string) {
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		if body[field] != expected {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		writeJSON(w, map[string]interface{}{"auth": map[string]string{"client_token": validToken}})
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/auth/approle/login", func(w http.ResponseWriter, r *http.Request) {
+		login(w, r, "secret_id", "secret-id")
+	})
+	mux.HandleFunc("/v1/auth/k8s/login", func(w http.ResponseWriter, r *http.Request) {
+		login(w, r, "jwt", "service-account-token")
+	})
+	mux.HandleFunc("/v1/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != validToken {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/kv/data/app":
+			writeJSON(w, map[string]interface{}{
+				"data": map[string]interface{}{
+					"data":     map[string]interface{}{"password": "kv2-password", "port": 5432},
+					"metadata": map[string]interface{}{"version": 1},
+				},
+			})
+		case "/v1/secret/app":
+			writeJSON(w, map[string]interface{}{
+				"data": map[string]interface{}{"password": "kv1-password"},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestVaultSecretProvider(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeVaultServer(t, "valid-token")
+
+	dir := t.TempDir()
+	writeFile := func(name, data string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(data), 0600))
+		return path
+	}
+	var (
+		validTokenFile   = writeFile("valid-token", "valid-token\n")
+		invalidTokenFile = writeFile("invalid-token", "invalid-token")
+		secretIDFile     = writeFile("secret-id", "secret-id")
+		saTokenFile      = writeFile("sa-token", "service-account-token")
+	)
+
+	testcases := []struct {
+		name     string
+		auth     config.VaultAuth
+		ref      string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "token auth with kv version 2",
+			auth:     config.VaultAuth{Method: config.VaultAuthMethodToken, TokenFile: validTokenFile},
+			ref:      "kv/data/app#password",
+			expected: "kv2-password",
+		},
+		{
+			name:     "token auth with kv version 1",
+			auth:     config.VaultAuth{Method: config.VaultAuthMethodToken, TokenFile: validTokenFile},
+			ref:      "secret/app#password",
+			expected: "kv1-password",
+		},
+		{
+			name:     "non string value",
+			auth:     config.VaultAuth{Method: config.VaultAuthMethodToken, TokenFile: validTokenFile},
+			ref:      "kv/data/app#port",
+			expected: "5432",
+		},
+		{
+			name:     "approle auth",
+			auth:     config.VaultAuth{Method: config.VaultAuthMethodAppRole, RoleID: "role-id", SecretIDFile: secretIDFile},
+			ref:      "kv/data/app#password",
+			expected: "kv2-password",
+		},
+		{
+			name:     "kubernetes auth with custom mount path",
+			auth:     config.VaultAuth{Method: config.VaultAuthMethodKubernetes, MountPath: "k8s", Role: "piped", ServiceAccountTokenFile: saTokenFile},
+			ref:      "kv/data/app#password",
+			expected: "kv2-password",
+		},
+		{
+			name:    "invalid token",
+			auth:    config.VaultAuth{Method: config.VaultAuthMethodToken, TokenFile: invalidTokenFile},
+			ref:     "kv/data/app#password",
+			wantErr: true,
+		},
+		{
+			name:    "missing key",
+			auth:    config.VaultAuth{Method: config.VaultAuthMethodToken, TokenFile: validTokenFile},
+			ref:     "kv/data/app#unknown",
+			wantErr: true,
+		},
+		{
+			name:    "missing path",
+			auth:    config.VaultAuth{Method: config.VaultAuthMethodToken, TokenFile: validTokenFile},
+			ref:     "kv/data/unknown#password",
+			wantErr: true,
+		},
+		{
+			name:    "invalid reference",
+			auth:    config.VaultAuth{Method: config.VaultAuthMethodToken, TokenFile: validTokenFile},
+			ref:     "kv/data/app",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := NewVaultSecretProvider(config.SecretProviderVault{
+				Address: server.URL,
+				Auth:    tc.auth,
+			})
+			v, err := p.Resolve(context.Background(), tc.ref)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, v)
+		})
+	}
+}
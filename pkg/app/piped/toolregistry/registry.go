@@ -33,6 +33,7 @@ type Registry interface {
 	Kustomize(ctx context.Context, version string) (string, bool, error)
 	Helm(ctx context.Context, version string) (string, bool, error)
 	Terraform(ctx context.Context, version string) (string, bool, error)
+	OpenTofu(ctx context.Context, version string) (string, bool, error)
 }
 
 var defaultRegistry *registry
@@ -96,6 +97,7 @@ const (
 	kustomizePrefix = "kustomize"
 	helmPrefix      = "helm"
 	terraformPrefix = "terraform"
+	opentofuPrefix  = "tofu"
 )
 
 type registry struct {
@@ -217,3 +219,31 @@ func (r *registry) Terraform(ctx context.Context, version string) (string, bool,
 
 	return path, true, nil
 }
+
+func (r *registry) OpenTofu(ctx context.Context, version string) (string, bool, error) {
+	name := opentofuPrefix
+	if version != "" {
+		name = fmt.Sprintf("%s-%s", opentofuPrefix, version)
+	}
+	path := filepath.Join(r.binDir, name)
+
+	r.mu.RLock()
+	_, ok := r.versions[name]
+	r.mu.RUnlock()
+	if ok {
+		return path, false, nil
+	}
+
+	_, err, _ := r.installGroup.Do(name, func() (interface{}, error) {
+		return nil, r.installOpenTofu(ctx, version)
+	})
+	if err != nil {
+		return "", true, err
+	}
+
+	r.mu.Lock()
+	r.versions[name] = struct{}{}
+	r.mu.Unlock()
+
+	return path, true, nil
+}
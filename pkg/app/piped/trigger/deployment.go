@@ -51,6 +51,8 @@ func buildDeployment(
 	noti *config.DeploymentNotification,
 	deploymentChainID string,
 	deploymentChainBlockIndex uint32,
+	freezeBypassJustification string,
+	artifactDigest string,
 ) (*model.Deployment, error) {
 
 	var commitURL string
@@ -70,6 +72,9 @@ func buildDeployment(
 		}
 		metadata[model.MetadataKeyDeploymentNotification] = string(value)
 	}
+	if freezeBypassJustification != "" {
+		metadata[model.MetadataKeyDeploymentFreezeBypassJustification] = freezeBypassJustification
+	}
 
 	deployment := &model.Deployment{
 		Id:              uuid.New().String(),
@@ -91,6 +96,7 @@ func buildDeployment(
 			Timestamp:       now.Unix(),
 			SyncStrategy:    syncStrategy,
 			StrategySummary: strategySummary,
+			ArtifactDigest:  artifactDigest,
 		},
 		GitPath:                   app.GitPath,
 		CloudProvider:             app.CloudProvider,
@@ -24,7 +24,10 @@ import (
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
 	"github.com/pipe-cd/pipecd/pkg/cache/memorycache"
 	"github.com/pipe-cd/pipecd/pkg/config"
@@ -35,6 +38,7 @@ import (
 const (
 	ondemandCheckInterval               = 10 * time.Second
 	defaultLastTriggeredCommitCacheSize = 500
+	ociResolveErrorReportInterval       = 30 * time.Minute
 )
 
 type apiClient interface {
@@ -73,6 +77,24 @@ func (c *candidate) HasCommand() bool {
 	return c.kind == model.TriggerKind_ON_COMMAND || c.kind == model.TriggerKind_ON_CHAIN
 }
 
+// RollbackCommit returns the previously running commit to which the application was commanded to be rolled back.
+// Empty string is returned when the candidate is not a rollback.
+func (c *candidate) RollbackCommit() string {
+	if c.kind != model.TriggerKind_ON_COMMAND {
+		return ""
+	}
+	return c.command.GetSyncApplication().GetTargetCommit()
+}
+
+// FreezeBypassJustification returns the reason given by the user
+// who commanded to deploy the application regardless of deployment freeze windows.
+func (c *candidate) FreezeBypassJustification() string {
+	if !c.HasCommand() {
+		return ""
+	}
+	return c.command.Metadata[model.MetadataKeyDeploymentFreezeBypassJustification]
+}
+
 type Trigger struct {
 	apiClient         apiClient
 	gitClient         gitClient
@@ -81,9 +103,13 @@ type Trigger struct {
 	notifier          notifier
 	config            *config.PipedSpec
 	commitStore       *lastTriggeredCommitStore
+	ociClient         deploysource.OCIClient
 	gitRepos          map[string]git.Repo
 	gracePeriod       time.Duration
 	logger            *zap.Logger
+
+	// Map from application ID to the last time its OCI artifact failed to be resolved.
+	ociResolveErrors map[string]time.Time
 }
 
 func NewTrigger(
@@ -114,6 +140,8 @@ func NewTrigger(
 		notifier:          notifier,
 		config:            cfg,
 		commitStore:       commitStore,
+		ociClient:         deploysource.NewOCIClient(cfg.OCIRegistries),
+		ociResolveErrors:  make(map[string]time.Time),
 		gitRepos:          make(map[string]git.Repo, len(cfg.Repositories)),
 		gracePeriod:       gracePeriod,
 		logger:            logger.Named("trigger"),
@@ -257,11 +285,64 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 			continue
 		}
 
+		// Resolve the digest of the OCI artifact to deploy when the manifests are stored in an OCI registry.
+		// A new artifact pushed to the registry is handled in the same way as a new commit.
+		var artifactDigest string
+		if src := appCfg.OCISource(); src != nil {
+			artifactDigest, err = t.ociClient.Resolve(ctx, src.URL)
+			switch {
+			case err != nil:
+				// Fall back to the triggering based on commits, the artifact is resolved again while deploying.
+				t.reportOCIResolveError(app, appCfg, src.URL, headCommit, err)
+			default:
+				delete(t.ociResolveErrors, app.Id)
+				if !shouldTrigger && c.kind == model.TriggerKind_ON_COMMIT && !appCfg.Trigger.OnCommit.Disabled {
+					shouldTrigger = artifactDigest != app.MostRecentlyTriggeredDeployment.GetTrigger().GetArtifactDigest()
+				}
+			}
+		}
+
 		if !shouldTrigger {
 			t.commitStore.Put(app.Id, headCommit.Hash)
 			continue
 		}
 
+		// Hold the deployment while the application is inside one of its deployment freeze windows.
+		// The commit is not marked as triggered so that it will be deployed once the window is over.
+		// Deployments in a chain are not held because that would stall the chain forever,
+		// the planner checks them again right before planning and fails the frozen ones instead.
+		freezeBypassJustification := c.FreezeBypassJustification()
+		if freezeBypassJustification == "" && c.kind != model.TriggerKind_ON_CHAIN {
+			w, err := appCfg.FindActiveDeploymentFreezeWindow(time.Now())
+			if err != nil {
+				msg := fmt.Sprintf("failed while checking deployment freeze windows of application %s: %s", app.Name, err)
+				t.notifyDeploymentTriggerFailed(app, appCfg, msg, headCommit)
+				t.logger.Error(msg, zap.Error(err))
+				continue
+			}
+			if w != nil {
+				t.holdFrozenCandidate(ctx, c, w.Reason())
+				continue
+			}
+		}
+
+		// Deploy the commit specified by the command instead of the head commit
+		// in case the application is commanded to be rolled back.
+		commit := headCommit
+		rollbackCommit := c.RollbackCommit()
+		if rollbackCommit != "" {
+			commit, err = gitRepo.GetCommitForRev(ctx, rollbackCommit)
+			if err != nil {
+				msg := fmt.Sprintf("failed to find commit %s to roll back application %s: %v", rollbackCommit, app.Name, err)
+				t.notifyDeploymentTriggerFailed(app, appCfg, msg, headCommit)
+				t.logger.Error(msg, zap.Error(err))
+				if err := c.command.Report(ctx, model.CommandStatus_COMMAND_FAILED, nil, []byte(msg)); err != nil {
+					t.logger.Error("failed to report command status", zap.Error(err))
+				}
+				continue
+			}
+		}
+
 		var (
 			commander                 string
 			strategy                  model.SyncStrategy
@@ -279,6 +360,9 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 			} else {
 				strategySummary = "Sync with the specified pipeline because piped received a command from user via web console or pipectl"
 			}
+			if rollbackCommit != "" {
+				strategySummary = fmt.Sprintf("Roll back to the previously running commit %s because piped received a command to revert the application", rollbackCommit)
+			}
 
 		case model.TriggerKind_ON_CHAIN:
 			strategy = c.command.GetChainSyncApplication().SyncStrategy
@@ -295,7 +379,7 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 		deployment, err := buildDeployment(
 			app,
 			branch,
-			headCommit,
+			commit,
 			commander,
 			strategy,
 			strategySummary,
@@ -303,6 +387,8 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 			appCfg.DeploymentNotification,
 			deploymentChainID,
 			deploymentChainBlockIndex,
+			freezeBypassJustification,
+			artifactDigest,
 		)
 		if err != nil {
 			msg := fmt.Sprintf("failed to build deployment for application %s: %v", app.Id, err)
@@ -312,10 +398,33 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 		}
 
 		// In case the triggered deployment is of application that can trigger a deployment chain
-		// create a new deployment chain with its configuration besides with the first deployment
-		// in that chain.
-		if appCfg.PostSync != nil && appCfg.PostSync.DeploymentChain != nil {
+		// and satisfies the chain conditions, create a new deployment chain with its configuration
+		// besides with the first deployment in that chain.
+		// Rollbacks never trigger the deployment chain again.
+		var chainTriggered bool
+		if rollbackCommit == "" && appCfg.PostSync != nil && appCfg.PostSync.DeploymentChain != nil {
+			chainTriggered, err = t.shouldTriggerDeploymentChain(ctx, gitRepo, app, headCommit, appCfg.PostSync.DeploymentChain)
+			if err != nil {
+				msg := fmt.Sprintf("failed while determining whether the deployment chain of application %s should be triggered or not: %v", app.Id, err)
+				t.notifyDeploymentTriggerFailed(app, appCfg, msg, headCommit)
+				t.logger.Error(msg, zap.Error(err))
+				continue
+			}
+			if !chainTriggered {
+				t.logger.Info("deployment chain conditions were not satisfied, only the application will be deployed",
+					zap.String("app", app.Name),
+					zap.String("app-id", app.Id),
+					zap.String("commit", headCommit.Hash),
+				)
+			}
+		}
+		if chainTriggered {
 			if err := t.triggerDeploymentChain(ctx, appCfg.PostSync.DeploymentChain, deployment); err != nil {
+				// The control-plane rejects chains having any block inside the deployment freeze windows of the project.
+				if status.Code(err) == codes.FailedPrecondition {
+					t.holdFrozenCandidate(ctx, c, status.Convert(err).Message())
+					continue
+				}
 				msg := fmt.Sprintf("failed to trigger application %s and its deployment chain: %v", app.Id, err)
 				t.notifyDeploymentTriggerFailed(app, appCfg, msg, headCommit)
 				t.logger.Error(msg, zap.Error(err))
@@ -324,6 +433,11 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 		} else {
 			// Send a request to API to create a new deployment.
 			if err := t.triggerDeployment(ctx, deployment); err != nil {
+				// The control-plane rejects deployments inside the deployment freeze windows of the project.
+				if status.Code(err) == codes.FailedPrecondition {
+					t.holdFrozenCandidate(ctx, c, status.Convert(err).Message())
+					continue
+				}
 				msg := fmt.Sprintf("failed to trigger application %s: %v", app.Id, err)
 				t.notifyDeploymentTriggerFailed(app, appCfg, msg, headCommit)
 				t.logger.Error(msg, zap.Error(err))
@@ -334,8 +448,11 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 		// TODO: Find a better way to ensure that the application should be updated correctly
 		// when the deployment was successfully triggered.
 		// This error is ignored because the deployment was already registered successfully.
-		if e := reportMostRecentlyTriggeredDeployment(ctx, t.apiClient, deployment); e != nil {
-			t.logger.Error("failed to report most recently triggered deployment", zap.Error(e))
+		// Rollbacks are not reported so that the head commit is not deployed again right after them.
+		if rollbackCommit == "" {
+			if e := reportMostRecentlyTriggeredDeployment(ctx, t.apiClient, deployment); e != nil {
+				t.logger.Error("failed to report most recently triggered deployment", zap.Error(e))
+			}
 		}
 
 		triggered[app.Id] = struct{}{}
@@ -356,6 +473,22 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 	return nil
 }
 
+// holdFrozenCandidate skips triggering the given candidate because of a deployment freeze window.
+// The sync command is marked as failed since it will not be retried, while the other triggers
+// will be checked again at the next interval.
+func (t *Trigger) holdFrozenCandidate(ctx context.Context, c candidate, reason string) {
+	t.logger.Info(fmt.Sprintf("deployment of application %s was held: %s", c.application.Name, reason),
+		zap.String("app-id", c.application.Id),
+		zap.String("trigger-kind", c.kind.String()),
+	)
+	if !c.HasCommand() {
+		return
+	}
+	if err := c.command.Report(ctx, model.CommandStatus_COMMAND_FAILED, nil, []byte(reason)); err != nil {
+		t.logger.Error("failed to report command status", zap.Error(err))
+	}
+}
+
 // listCommandCandidates finds all applications that have been commanded to sync.
 func (t *Trigger) listCommandCandidates() []candidate {
 	var (
@@ -488,6 +621,20 @@ func (t *Trigger) notifyDeploymentTriggered(_ context.Context, appCfg *config.Ge
 	})
 }
 
+// reportOCIResolveError logs and notifies the failure of resolving the OCI artifact of the given application.
+// Since the artifact is resolved at every sync interval, the same failure is reported at most once per ociResolveErrorReportInterval.
+func (t *Trigger) reportOCIResolveError(app *model.Application, appCfg *config.GenericApplicationSpec, url string, headCommit git.Commit, err error) {
+	now := time.Now()
+	if last, ok := t.ociResolveErrors[app.Id]; ok && now.Sub(last) < ociResolveErrorReportInterval {
+		return
+	}
+	t.ociResolveErrors[app.Id] = now
+
+	msg := fmt.Sprintf("failed to resolve the OCI artifact %s of application %s, only new commits can trigger its deployments until it is resolved: %v", url, app.Name, err)
+	t.notifyDeploymentTriggerFailed(app, appCfg, msg, headCommit)
+	t.logger.Warn(msg, zap.Error(err))
+}
+
 func (t *Trigger) notifyDeploymentTriggerFailed(app *model.Application, appCfg *config.GenericApplicationSpec, reason string, commit git.Commit) {
 	var users []string
 	var groups []string
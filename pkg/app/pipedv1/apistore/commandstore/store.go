@@ -43,6 +43,7 @@ type Lister interface {
 	ListApplicationCommands() []model.ReportableCommand
 	ListDeploymentCommands() []model.ReportableCommand
 	ListStageCommands(deploymentID, stageID string) []*model.Command
+	ListRetryStageCommands(deploymentID, stageID string) []model.ReportableCommand
 	ListBuildPlanPreviewCommands() []model.ReportableCommand
 	ListPipedCommands() []model.ReportableCommand
 }
@@ -66,6 +67,7 @@ type store struct {
 	planPreviewCommands []model.ReportableCommand
 	pipedCommands       []model.ReportableCommand
 	stageCommands       stageCommandsMap
+	retryStageCommands  []model.ReportableCommand
 	handledCommands     map[string]time.Time
 	mu                  sync.RWMutex
 	gracePeriod         time.Duration
@@ -134,6 +136,7 @@ func (s *store) sync(ctx context.Context) error {
 		applicationCommands = make([]model.ReportableCommand, 0)
 		deploymentCommands  = make([]model.ReportableCommand, 0)
 		stageCommands       = make(stageCommandsMap, 0)
+		retryStageCommands  = make([]model.ReportableCommand, 0)
 		planPreviewCommands = make([]model.ReportableCommand, 0)
 		pipedCommands       = make([]model.ReportableCommand, 0)
 	)
@@ -149,6 +152,9 @@ func (s *store) sync(ctx context.Context) error {
 			pipedCommands = append(pipedCommands, s.makeReportableCommand(cmd))
 		case model.Command_APPROVE_STAGE, model.Command_SKIP_STAGE:
 			stageCommands.append(cmd)
+		case model.Command_RETRY_STAGE:
+			// Retry commands are handled by piped itself instead of the plugins executing the stage.
+			retryStageCommands = append(retryStageCommands, s.makeReportableCommand(cmd))
 		}
 	}
 
@@ -158,6 +164,7 @@ func (s *store) sync(ctx context.Context) error {
 	s.planPreviewCommands = planPreviewCommands
 	s.pipedCommands = pipedCommands
 	s.stageCommands = stageCommands
+	s.retryStageCommands = retryStageCommands
 	s.mu.Unlock()
 
 	return nil
@@ -216,6 +223,23 @@ func (s *store) ListStageCommands(deploymentID, stageID string) []*model.Command
 	return s.stageCommands[deploymentID][stageID]
 }
 
+func (s *store) ListRetryStageCommands(deploymentID, stageID string) []model.ReportableCommand {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	commands := make([]model.ReportableCommand, 0)
+	for _, cmd := range s.retryStageCommands {
+		if _, ok := s.handledCommands[cmd.Id]; ok {
+			continue
+		}
+		if cmd.DeploymentId != deploymentID || cmd.StageId != stageID {
+			continue
+		}
+		commands = append(commands, cmd)
+	}
+	return commands
+}
+
 func (s *store) ListBuildPlanPreviewCommands() []model.ReportableCommand {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -140,6 +140,75 @@ func TestListStageCommands(t *testing.T) {
 	}
 }
 
+func TestListRetryStageCommands(t *testing.T) {
+	t.Parallel()
+
+	store := store{
+		retryStageCommands: []model.ReportableCommand{
+			{
+				Command: &model.Command{
+					Id:           "command-1",
+					DeploymentId: "deployment-1",
+					StageId:      "stage-1",
+					Type:         model.Command_RETRY_STAGE,
+				},
+			},
+			{
+				Command: &model.Command{
+					Id:           "command-2",
+					DeploymentId: "deployment-1",
+					StageId:      "stage-1",
+					Type:         model.Command_RETRY_STAGE,
+				},
+			},
+			{
+				Command: &model.Command{
+					Id:           "command-3",
+					DeploymentId: "deployment-1",
+					StageId:      "stage-2",
+					Type:         model.Command_RETRY_STAGE,
+				},
+			},
+		},
+		handledCommands: map[string]time.Time{
+			"command-2": time.Now(),
+		},
+		logger: zap.NewNop(),
+	}
+
+	testcases := []struct {
+		name         string
+		deploymentID string
+		stageID      string
+		want         []string
+	}{
+		{
+			name:         "only unhandled commands of the given stage",
+			deploymentID: "deployment-1",
+			stageID:      "stage-1",
+			want:         []string{"command-1"},
+		},
+		{
+			name:         "deploymentID not exist",
+			deploymentID: "xxx",
+			stageID:      "stage-1",
+			want:         []string{},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := store.ListRetryStageCommands(tc.deploymentID, tc.stageID)
+			ids := make([]string, 0, len(got))
+			for _, cmd := range got {
+				ids = append(ids, cmd.Id)
+			}
+			assert.Equal(t, tc.want, ids)
+		})
+	}
+}
+
 func TestReportStageCommandsHandled(t *testing.T) {
 	t.Parallel()
 
@@ -205,16 +205,6 @@ func (p *piped) run(ctx context.Context, input cli.Input) (runErr error) {
 		return err
 	}
 
-	// Initialize notifier and add piped events.
-	notifier, err := notifier.NewNotifier(cfg, input.Logger)
-	if err != nil {
-		input.Logger.Error("failed to initialize notifier", zap.Error(err))
-		return err
-	}
-	group.Go(func() error {
-		return notifier.Run(ctx)
-	})
-
 	// Start running admin server.
 	{
 		var (
@@ -383,6 +373,18 @@ func (p *piped) run(ctx context.Context, input cli.Input) (runErr error) {
 		return err
 	}
 
+	// Initialize notifier and add piped events.
+	// The notifier must be initialized after the plugin registry
+	// since notifications can be sent through notification plugins.
+	notifier, err := notifier.NewNotifier(cfg, pluginRegistry, input.Logger)
+	if err != nil {
+		input.Logger.Error("failed to initialize notifier", zap.Error(err))
+		return err
+	}
+	group.Go(func() error {
+		return notifier.Run(ctx)
+	})
+
 	// Initialize secret decrypter.
 	decrypter, err := p.initializeSecretDecrypter(cfg)
 	if err != nil {
@@ -737,7 +739,11 @@ func (p *piped) initializeSecretDecrypter(cfg *config.PipedSpec) (crypto.Decrypt
 		if err != nil {
 			return nil, err
 		}
-		decrypter, err := crypto.NewHybridDecrypter(key)
+		previousKeys, err := sm.KeyPair.LoadPreviousPrivateKeys()
+		if err != nil {
+			return nil, err
+		}
+		decrypter, err := crypto.NewHybridDecrypter(key, previousKeys...)
 		if err != nil {
 			return nil, fmt.Errorf("failed to initialize decrypter (%w)", err)
 		}
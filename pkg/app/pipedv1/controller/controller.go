@@ -69,6 +69,7 @@ type deploymentLister interface {
 
 type commandLister interface {
 	ListDeploymentCommands() []model.ReportableCommand
+	ListRetryStageCommands(deploymentID, stageID string) []model.ReportableCommand
 }
 
 type notifier interface {
@@ -586,6 +587,7 @@ func (c *controller) startNewScheduler(ctx context.Context, d *model.Deployment)
 		c.pluginRegistry,
 		c.notifier,
 		c.secretDecrypter,
+		c.commandLister,
 		c.commandReporter,
 		metadataStore,
 		c.logger,
@@ -225,6 +225,11 @@ func (p *planner) Run(ctx context.Context) error {
 		runningDS = rds.ToPluginDeploySource()
 	}
 
+	if reason, frozen := p.checkDeploymentFreeze(targetDS); frozen {
+		p.doneDeploymentStatus = model.DeploymentStatus_DEPLOYMENT_FAILURE
+		return p.reportDeploymentFailed(ctx, reason)
+	}
+
 	out, err := p.buildPlan(ctx, runningDS, targetDS)
 
 	// If the deployment was already cancelled, we ignore the plan result.
@@ -248,6 +253,27 @@ func (p *planner) Run(ctx context.Context) error {
 	return p.reportDeploymentPlanned(ctx, out)
 }
 
+// checkDeploymentFreeze reports whether the deployment is inside one of the deployment freeze windows
+// configured in the application configuration and was not bypassed by a privileged user.
+func (p *planner) checkDeploymentFreeze(ds *common.DeploymentSource) (string, bool) {
+	if p.deployment.DeploymentFreezeBypassJustification() != "" {
+		return "", false
+	}
+	// Failures while parsing the application config are reported while building the plan.
+	cfg, err := config.DecodeYAML[*config.GenericApplicationSpec](ds.GetApplicationConfig())
+	if err != nil {
+		return "", false
+	}
+	w, err := cfg.Spec.FindActiveDeploymentFreezeWindow(p.nowFunc())
+	if err != nil {
+		return fmt.Sprintf("Unable to check deployment freeze windows (%v)", err), true
+	}
+	if w == nil {
+		return "", false
+	}
+	return fmt.Sprintf("Deployment was rejected because the application is frozen. %s", w.Reason()), true
+}
+
 // buildPlan builds the deployment plan.
 // The strategy determination logic is based on the following order:
 //   - Direct trigger via web console
@@ -1221,3 +1221,62 @@ func TestValidateStageIndexes(t *testing.T) {
 		})
 	}
 }
+
+func TestPlanner_CheckDeploymentFreeze(t *testing.T) {
+	t.Parallel()
+
+	appConfig := []byte(`
+apiVersion: pipecd.dev/v1beta1
+kind: Application
+spec:
+  deploymentFreezeWindows:
+    - name: release-freeze
+      description: Quarterly release
+      startAt: 2024-03-01T00:00:00Z
+      endAt: 2024-03-08T00:00:00Z
+`)
+
+	testcases := []struct {
+		name       string
+		now        time.Time
+		deployment *model.Deployment
+		wantReason string
+		wantFrozen bool
+	}{
+		{
+			name:       "inside the window",
+			now:        time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC),
+			deployment: &model.Deployment{},
+			wantReason: `Deployment was rejected because the application is frozen. Deployments are frozen by window "release-freeze": Quarterly release`,
+			wantFrozen: true,
+		},
+		{
+			name:       "outside the window",
+			now:        time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC),
+			deployment: &model.Deployment{},
+		},
+		{
+			name: "bypassed with a justification",
+			now:  time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC),
+			deployment: &model.Deployment{
+				Metadata: map[string]string{
+					model.MetadataKeyDeploymentFreezeBypassJustification: "hotfix",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &planner{
+				deployment: tc.deployment,
+				nowFunc:    func() time.Time { return tc.now },
+			}
+			reason, frozen := p.checkDeploymentFreeze(&common.DeploymentSource{ApplicationConfig: appConfig})
+			assert.Equal(t, tc.wantReason, reason)
+			assert.Equal(t, tc.wantFrozen, frozen)
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/apistore/commandstore"
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/controller/controllermetrics"
@@ -40,6 +41,9 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/plugin/api/v1alpha1/deployment"
 )
 
+// retryStageCommandCheckInterval is the interval to check whether a RETRY_STAGE command was issued.
+const retryStageCommandCheckInterval = 5 * time.Second
+
 // scheduler is a dedicated object for a specific deployment of a single application.
 type scheduler struct {
 	deployment *model.Deployment
@@ -51,6 +55,7 @@ type scheduler struct {
 	gitClient       gitClient
 	notifier        notifier
 	secretDecrypter secretDecrypter
+	commandLister   commandLister
 	commandReporter commandstore.Reporter
 	metadataStore   metadatastore.MetadataStore
 
@@ -64,6 +69,8 @@ type scheduler struct {
 	// when the stages can be executed concurrently.
 	stageStatuses            map[string]model.StageStatus
 	genericApplicationConfig *config.GenericApplicationSpec
+	// The number of times each stage has been retried.
+	stageRetriedCounts map[string]int32
 
 	logger *zap.Logger
 	tracer trace.Tracer
@@ -85,6 +92,7 @@ func newScheduler(
 	pluginRegistry plugin.PluginRegistry,
 	notifier notifier,
 	secretsDecrypter secretDecrypter,
+	commandLister commandLister,
 	commandReporter commandstore.Reporter,
 	metadataStore metadatastore.MetadataStore,
 	logger *zap.Logger,
@@ -106,6 +114,7 @@ func newScheduler(
 		pluginRegistry:       pluginRegistry,
 		notifier:             notifier,
 		secretDecrypter:      secretsDecrypter,
+		commandLister:        commandLister,
 		commandReporter:      commandReporter,
 		metadataStore:        metadataStore,
 		doneDeploymentStatus: d.Status,
@@ -117,8 +126,10 @@ func newScheduler(
 
 	// Initialize the map of current status of all stages.
 	s.stageStatuses = make(map[string]model.StageStatus, len(d.Stages))
+	s.stageRetriedCounts = make(map[string]int32, len(d.Stages))
 	for _, stage := range d.Stages {
 		s.stageStatuses[stage.Id] = stage.Status
+		s.stageRetriedCounts[stage.Id] = stage.RetriedCount
 	}
 
 	return s
@@ -321,67 +332,97 @@ func (s *scheduler) Run(ctx context.Context) error {
 		}
 
 		var (
-			result       model.StageStatus
-			sig, handler = NewStopSignal()
-			doneCh       = make(chan struct{})
-			timeout      <-chan time.Time // nil means no timeout.
+			result model.StageStatus
+			sig    StopSignal
 		)
 
-		// Set timeout for stage execution. Default to 6h.
-		if stageCfg, ok := s.genericApplicationConfig.GetStage(ps.Index); ok {
-			timeout = time.After(stageCfg.Timeout.Duration())
-		}
+		// Execute the stage and retry it in place while its retry options allow.
+		for {
+			var (
+				handler StopSignalHandler
+				doneCh  = make(chan struct{})
+				timeout <-chan time.Time // nil means no timeout.
+			)
+			sig, handler = NewStopSignal()
 
-		go func() {
-			_, span := s.tracer.Start(ctx, ps.Name, trace.WithAttributes(
-				attribute.String("application-id", s.deployment.ApplicationId),
-				attribute.String("deployment-id", s.deployment.Id),
-				attribute.String("labels", s.deployment.GetLabelsString()),
-				attribute.String("stage-id", ps.Id),
-			))
-			defer span.End()
+			// Set timeout for stage execution. Default to 6h.
+			if stageCfg, ok := s.genericApplicationConfig.GetStage(ps.Index); ok {
+				timeout = time.After(stageCfg.Timeout.Duration())
+			}
 
-			s.notifyStageStartEvent(ps)
+			go func() {
+				_, span := s.tracer.Start(ctx, ps.Name, trace.WithAttributes(
+					attribute.String("application-id", s.deployment.ApplicationId),
+					attribute.String("deployment-id", s.deployment.Id),
+					attribute.String("labels", s.deployment.GetLabelsString()),
+					attribute.String("stage-id", ps.Id),
+				))
+				defer span.End()
 
-			result = s.executeStage(sig, ps)
+				s.notifyStageStartEvent(ps)
 
-			s.notifyStageEndEvent(ps, result)
+				result = s.executeStage(sig, ps)
 
-			switch result {
-			case model.StageStatus_STAGE_SUCCESS:
-				span.SetStatus(codes.Ok, statusReason)
-			case model.StageStatus_STAGE_FAILURE, model.StageStatus_STAGE_CANCELLED:
-				span.SetStatus(codes.Error, statusReason)
-			}
+				s.notifyStageEndEvent(ps, result)
 
-			// Mark commands as handled regardless of the stage status because the commands will no longer be used.
-			s.commandReporter.ReportStageCommandsHandled(ctx, s.deployment.Id, ps.Id)
+				switch result {
+				case model.StageStatus_STAGE_SUCCESS:
+					span.SetStatus(codes.Ok, statusReason)
+				case model.StageStatus_STAGE_FAILURE, model.StageStatus_STAGE_CANCELLED:
+					span.SetStatus(codes.Error, statusReason)
+				}
 
-			close(doneCh)
-		}()
+				// Mark commands as handled regardless of the stage status because the commands will no longer be used.
+				s.commandReporter.ReportStageCommandsHandled(ctx, s.deployment.Id, ps.Id)
 
-		select {
-		case <-ctx.Done():
-			handler.Terminate()
-			<-doneCh
+				close(doneCh)
+			}()
 
-		case <-timer.C:
-			handler.Timeout()
-			<-doneCh
+			select {
+			case <-ctx.Done():
+				handler.Terminate()
+				<-doneCh
 
-		case cmd := <-s.cancelledCh:
-			if cmd != nil {
-				cancelCommand = cmd
-				cancelCommander = cmd.Commander
-				handler.Cancel()
+			case <-timer.C:
+				handler.Timeout()
 				<-doneCh
+
+			case cmd := <-s.cancelledCh:
+				if cmd != nil {
+					cancelCommand = cmd
+					cancelCommander = cmd.Commander
+					handler.Cancel()
+					<-doneCh
+				}
+
+			case <-timeout:
+				handler.Timeout()
+				<-doneCh
+
+			case <-doneCh:
+				break
 			}
 
-		case <-timeout:
-			handler.Timeout()
-			<-doneCh
+			// Only the stages failed by themselves are retried.
+			if result != model.StageStatus_STAGE_FAILURE || sig.Terminated() || sig.Signal() == StopSignalTimeout {
+				break
+			}
 
-		case <-doneCh:
+			retry, cmd := s.waitStageRetry(ctx, timer, ps)
+			if cmd != nil {
+				cancelCommand = cmd
+				cancelCommander = cmd.Commander
+				result = model.StageStatus_STAGE_CANCELLED
+			}
+			if retry {
+				s.stageRetriedCounts[ps.Id]++
+				continue
+			}
+			// The piped was terminated while waiting to retry the failed stage.
+			if cmd == nil && ctx.Err() != nil {
+				s.logger.Info("stop scheduler because of temination signal", zap.String("stage-id", ps.Id))
+				return nil
+			}
 			break
 		}
 
@@ -593,11 +634,18 @@ func (s *scheduler) executeStage(sig StopSignal, ps *model.PipelineStage) (final
 		pRds = rds.ToPluginDeploySource()
 	}
 
+	// Pass the number of retries to the plugin so that the logs of each retry are stored separately.
+	stage := ps
+	if retriedCount := s.stageRetriedCounts[ps.Id]; retriedCount != ps.RetriedCount {
+		stage = proto.Clone(ps).(*model.PipelineStage)
+		stage.RetriedCount = retriedCount
+	}
+
 	// Start running executor.
 	res, err := plugin.ExecuteStage(ctx, &deployment.ExecuteStageRequest{
 		Input: &deployment.ExecutePluginInput{
 			Deployment:              s.deployment,
-			Stage:                   ps,
+			Stage:                   stage,
 			StageConfig:             stageConfig,
 			RunningDeploymentSource: pRds,
 			TargetDeploymentSource:  tds.ToPluginDeploySource(),
@@ -692,6 +740,78 @@ func (s *scheduler) shouldSkipStage(ctx context.Context, ps *model.PipelineStage
 	return false, model.StageStatus_STAGE_RUNNING
 }
 
+// waitStageRetry waits until the given failed stage should be executed again.
+// The stage is retried automatically after a backoff while its retry limit is not reached,
+// and then it waits for a RETRY_STAGE command from users if a manual retry timeout was configured.
+// The returned command is not nil when the deployment was cancelled during the wait.
+func (s *scheduler) waitStageRetry(ctx context.Context, timer *time.Timer, ps *model.PipelineStage) (bool, *model.ReportableCommand) {
+	stageConfig, ok := s.genericApplicationConfig.GetStage(ps.Index)
+	if !ok {
+		return false, nil
+	}
+
+	var (
+		opts         = stageConfig.Retries
+		retriedCount = s.stageRetriedCounts[ps.Id]
+		automatic    = int(retriedCount) < opts.Limit
+		wait         time.Duration
+	)
+	switch {
+	case automatic:
+		wait = opts.BackoffFor(retriedCount)
+		s.logger.Info(fmt.Sprintf("stage %s failed, it will be retried in %v", ps.Id, wait),
+			zap.Int32("retried-count", retriedCount),
+		)
+	case opts.ManualRetryTimeout > 0:
+		wait = opts.ManualRetryTimeout.Duration()
+		s.logger.Info(fmt.Sprintf("stage %s failed, waiting for a retry command up to %v", ps.Id, wait),
+			zap.Int32("retried-count", retriedCount),
+		)
+	default:
+		return false, nil
+	}
+
+	waitTimer := time.NewTimer(wait)
+	defer waitTimer.Stop()
+
+	ticker := time.NewTicker(retryStageCommandCheckInterval)
+	defer ticker.Stop()
+
+	cancelledCh := s.cancelledCh
+	for {
+		select {
+		case <-ctx.Done():
+			return false, nil
+
+		case <-timer.C:
+			return false, nil
+
+		case cmd := <-cancelledCh:
+			if cmd != nil {
+				return false, cmd
+			}
+			cancelledCh = nil
+
+		case <-waitTimer.C:
+			return automatic, nil
+
+		case <-ticker.C:
+			// A RETRY_STAGE command triggers the retry right away without waiting for the rest of backoff.
+			cmds := s.commandLister.ListRetryStageCommands(s.deployment.Id, ps.Id)
+			if len(cmds) == 0 {
+				continue
+			}
+			for _, cmd := range cmds {
+				if err := cmd.Report(ctx, model.CommandStatus_COMMAND_SUCCEEDED, nil, nil); err != nil {
+					s.logger.Error("failed to report command status", zap.Error(err))
+				}
+			}
+			s.logger.Info(fmt.Sprintf("stage %s will be retried by %s", ps.Id, cmds[0].Commander))
+			return true, nil
+		}
+	}
+}
+
 func (s *scheduler) reportStageStatus(ctx context.Context, stageID string, status model.StageStatus, requires []string) error {
 	var (
 		now = s.nowFunc()
@@ -700,6 +820,7 @@ func (s *scheduler) reportStageStatus(ctx context.Context, stageID string, statu
 			StageId:      stageID,
 			Status:       status,
 			Requires:     requires,
+			RetriedCount: s.stageRetriedCounts[stageID],
 			CompletedAt:  now.Unix(),
 		}
 		retry = pipedservice.NewRetry(10)
@@ -331,3 +331,106 @@ func TestExecuteStage_SignalCancelled(t *testing.T) {
 	finalStatus := s.executeStage(sig, s.deployment.Stages[0])
 	assert.Equal(t, model.StageStatus_STAGE_CANCELLED, finalStatus)
 }
+
+type fakeCommandLister struct {
+	commandLister
+	retryStageCommands []model.ReportableCommand
+}
+
+func (f *fakeCommandLister) ListRetryStageCommands(deploymentID, stageID string) []model.ReportableCommand {
+	return f.retryStageCommands
+}
+
+func TestWaitStageRetry(t *testing.T) {
+	t.Parallel()
+
+	stage := &model.PipelineStage{
+		Id:    "stage-id",
+		Name:  "stage-name",
+		Index: 0,
+	}
+
+	testcases := []struct {
+		name         string
+		retries      config.StageRetryOptions
+		retriedCount int32
+		cancelled    bool
+		wantRetry    bool
+		wantCancel   bool
+	}{
+		{
+			name:      "no retry configured",
+			retries:   config.StageRetryOptions{},
+			wantRetry: false,
+		},
+		{
+			name: "retry automatically after backoff",
+			retries: config.StageRetryOptions{
+				Limit:   2,
+				Backoff: config.Duration(time.Millisecond),
+			},
+			retriedCount: 1,
+			wantRetry:    true,
+		},
+		{
+			name: "retry limit was reached",
+			retries: config.StageRetryOptions{
+				Limit:   2,
+				Backoff: config.Duration(time.Millisecond),
+			},
+			retriedCount: 2,
+			wantRetry:    false,
+		},
+		{
+			name: "no retry command issued before manual retry timeout",
+			retries: config.StageRetryOptions{
+				ManualRetryTimeout: config.Duration(time.Millisecond),
+			},
+			wantRetry: false,
+		},
+		{
+			name: "deployment was cancelled while waiting",
+			retries: config.StageRetryOptions{
+				ManualRetryTimeout: config.Duration(time.Hour),
+			},
+			cancelled:  true,
+			wantRetry:  false,
+			wantCancel: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &scheduler{
+				deployment: &model.Deployment{
+					Id:     "deployment-id",
+					Stages: []*model.PipelineStage{stage},
+				},
+				genericApplicationConfig: &config.GenericApplicationSpec{
+					Pipeline: &config.DeploymentPipeline{
+						Stages: []config.PipelineStage{
+							{Name: "stage-name", Retries: tc.retries},
+						},
+					},
+				},
+				commandLister:      &fakeCommandLister{},
+				stageRetriedCounts: map[string]int32{"stage-id": tc.retriedCount},
+				cancelledCh:        make(chan *model.ReportableCommand, 1),
+				logger:             zaptest.NewLogger(t),
+				nowFunc:            time.Now,
+			}
+			if tc.cancelled {
+				s.Cancel(model.ReportableCommand{Command: &model.Command{Commander: "user"}})
+			}
+
+			timer := time.NewTimer(time.Hour)
+			defer timer.Stop()
+
+			retry, cmd := s.waitStageRetry(context.Background(), timer, stage)
+			assert.Equal(t, tc.wantRetry, retry)
+			assert.Equal(t, tc.wantCancel, cmd != nil)
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 
 	config "github.com/pipe-cd/pipecd/pkg/configv1"
 	"github.com/pipe-cd/pipecd/pkg/model"
+	pluginapi "github.com/pipe-cd/pipecd/pkg/plugin/api/v1alpha1"
 	"github.com/pipe-cd/pipecd/pkg/version"
 )
 
@@ -49,7 +50,12 @@ type sender interface {
 	Close(ctx context.Context)
 }
 
-func NewNotifier(cfg *config.PipedSpec, logger *zap.Logger) (*Notifier, error) {
+// pluginRegistry is the subset of the piped plugin registry used to find notification plugins.
+type pluginRegistry interface {
+	GetPluginClientByName(name string) (pluginapi.PluginClient, error)
+}
+
+func NewNotifier(cfg *config.PipedSpec, plugins pluginRegistry, logger *zap.Logger) (*Notifier, error) {
 	logger = logger.Named("notifier")
 	receivers := make(map[string]config.NotificationReceiver, len(cfg.Notifications.Receivers))
 	for _, r := range cfg.Notifications.Receivers {
@@ -73,6 +79,12 @@ func NewNotifier(cfg *config.PipedSpec, logger *zap.Logger) (*Notifier, error) {
 			sd = slacksender
 		case receiver.Webhook != nil:
 			sd = newWebhookSender(receiver.Name, *receiver.Webhook, cfg.WebAddress, logger)
+		case receiver.Plugin != nil:
+			cli, err := plugins.GetPluginClientByName(receiver.Plugin.Name)
+			if err != nil {
+				return nil, fmt.Errorf("failed to find plugin %s for receiver %s: %w", receiver.Plugin.Name, receiver.Name, err)
+			}
+			sd = newPluginSender(receiver.Name, *receiver.Plugin, cfg.WebAddress, cli, logger)
 		default:
 			continue
 		}
@@ -0,0 +1,120 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	config "github.com/pipe-cd/pipecd/pkg/configv1"
+	"github.com/pipe-cd/pipecd/pkg/model"
+	"github.com/pipe-cd/pipecd/pkg/plugin/api/v1alpha1/notification"
+)
+
+const pluginNotifyTimeout = 10 * time.Second
+
+// pluginSender sends the notification events to a notification plugin.
+type pluginSender struct {
+	name    string
+	config  config.NotificationReceiverPlugin
+	webURL  string
+	client  notification.NotificationServiceClient
+	eventCh chan model.NotificationEvent
+	logger  *zap.Logger
+}
+
+func newPluginSender(name string, cfg config.NotificationReceiverPlugin, webURL string, client notification.NotificationServiceClient, logger *zap.Logger) *pluginSender {
+	return &pluginSender{
+		name:    name,
+		config:  cfg,
+		webURL:  strings.TrimRight(webURL, "/"),
+		client:  client,
+		eventCh: make(chan model.NotificationEvent, eventChannelBufferSize),
+		logger:  logger.Named("plugin").With(zap.String("name", name), zap.String("plugin", cfg.Name)),
+	}
+}
+
+func (p *pluginSender) Run(ctx context.Context) error {
+	for {
+		select {
+		case event, ok := <-p.eventCh:
+			if ok {
+				p.sendEvent(ctx, event)
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (p *pluginSender) Notify(event model.NotificationEvent) {
+	p.eventCh <- event
+}
+
+func (p *pluginSender) sendEvent(ctx context.Context, event model.NotificationEvent) {
+	req, err := p.buildRequest(event)
+	if err != nil {
+		p.logger.Error("unable to build the notification request", zap.String("type", event.Type.String()), zap.Error(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, pluginNotifyTimeout)
+	defer cancel()
+
+	if _, err := p.client.Notify(ctx, req); err != nil {
+		p.logger.Error("unable to send the notification to the plugin", zap.String("type", event.Type.String()), zap.Error(err))
+	}
+}
+
+func (p *pluginSender) buildRequest(event model.NotificationEvent) (*notification.NotifyRequest, error) {
+	md, ok := event.Metadata.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("unsupported metadata type %T", event.Metadata)
+	}
+	anyMD, err := anypb.New(md)
+	if err != nil {
+		return nil, err
+	}
+	return &notification.NotifyRequest{
+		Receiver:       p.name,
+		ReceiverConfig: p.config.Config,
+		EventType:      event.Type,
+		EventMetadata:  anyMD,
+		WebUrl:         p.webURL,
+	}, nil
+}
+
+func (p *pluginSender) Close(ctx context.Context) {
+	close(p.eventCh)
+
+	// Send all remaining events.
+	for {
+		select {
+		case event, ok := <-p.eventCh:
+			if !ok {
+				return
+			}
+			p.sendEvent(ctx, event)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	config "github.com/pipe-cd/pipecd/pkg/configv1"
+	"github.com/pipe-cd/pipecd/pkg/model"
+	"github.com/pipe-cd/pipecd/pkg/plugin/api/v1alpha1/notification"
+)
+
+type fakeNotificationClient struct {
+	requests []*notification.NotifyRequest
+}
+
+func (c *fakeNotificationClient) Notify(_ context.Context, req *notification.NotifyRequest, _ ...grpc.CallOption) (*notification.NotifyResponse, error) {
+	c.requests = append(c.requests, req)
+	return &notification.NotifyResponse{}, nil
+}
+
+func TestPluginSender(t *testing.T) {
+	t.Parallel()
+
+	cli := &fakeNotificationClient{}
+	cfg := config.NotificationReceiverPlugin{
+		Name:   "slack",
+		Config: json.RawMessage(`{"channelID":"testid"}`),
+	}
+	sender := newPluginSender("dev-slack", cfg, "https://pipecd.dev/", cli, zap.NewNop())
+
+	sender.Notify(model.NotificationEvent{
+		Type: model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGERED,
+		Metadata: &model.NotificationEventDeploymentTriggered{
+			Deployment: &model.Deployment{Id: "deployment-id"},
+		},
+	})
+	sender.Notify(model.NotificationEvent{
+		Type:     model.NotificationEventType_EVENT_PIPED_STARTED,
+		Metadata: "invalid metadata",
+	})
+	// Close sends all remaining events.
+	sender.Close(context.Background())
+
+	require.Len(t, cli.requests, 1)
+	req := cli.requests[0]
+	assert.Equal(t, "dev-slack", req.Receiver)
+	assert.JSONEq(t, `{"channelID":"testid"}`, string(req.ReceiverConfig))
+	assert.Equal(t, model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGERED, req.EventType)
+	assert.Equal(t, "https://pipecd.dev", req.WebUrl)
+
+	var md model.NotificationEventDeploymentTriggered
+	require.NoError(t, req.EventMetadata.UnmarshalTo(&md))
+	assert.Equal(t, "deployment-id", md.Deployment.Id)
+}
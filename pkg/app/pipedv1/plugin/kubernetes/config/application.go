@@ -16,6 +16,8 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 
 	"github.com/creasty/defaults"
 )
@@ -73,6 +75,24 @@ func (s *KubernetesApplicationSpec) UnmarshalJSON(data []byte) error {
 
 func (s *KubernetesApplicationSpec) Validate() error {
 	// TODO: Validate KubernetesApplicationSpec fields.
+	var templatings []string
+	if s.Input.HelmChart != nil {
+		templatings = append(templatings, "helmChart")
+	}
+	if s.Input.JsonnetOptions != nil {
+		templatings = append(templatings, "jsonnetOptions")
+	}
+	if s.Input.CueOptions != nil {
+		templatings = append(templatings, "cueOptions")
+	}
+	if len(templatings) > 1 {
+		return fmt.Errorf("only one of %s can be specified in input", strings.Join(templatings, ", "))
+	}
+	for i := range s.Input.PostRenderers {
+		if err := s.Input.PostRenderers[i].Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -96,6 +116,19 @@ type KubernetesDeploymentInput struct {
 	// Configurable parameters for helm commands.
 	HelmOptions *InputHelmOptions `json:"helmOptions,omitempty"`
 
+	// Configurable parameters for rendering manifests with jsonnet.
+	// Specifying this renders the manifests with jsonnet.
+	JsonnetOptions *InputJsonnetOptions `json:"jsonnetOptions,omitempty"`
+
+	// Version of cue will be used.
+	CueVersion string `json:"cueVersion,omitempty"`
+	// Configurable parameters for rendering manifests with cue.
+	// Specifying this renders the manifests with cue.
+	CueOptions *InputCueOptions `json:"cueOptions,omitempty"`
+
+	// List of post-renderers run in order to transform the rendered manifests.
+	PostRenderers []InputPostRenderer `json:"postRenderers,omitempty"`
+
 	// The namespace where manifests will be applied.
 	Namespace string `json:"namespace,omitempty"`
 
@@ -144,18 +177,39 @@ type K8sResourcePatchOpName string
 const (
 	// K8sResourcePatchOpYAMLReplace is the name of the patch operation that replaces the target with a new YAML document.
 	K8sResourcePatchOpYAMLReplace = "yaml-replace"
+	// K8sResourcePatchOpJSONAdd is the name of the RFC 6902 JSON Patch "add" operation.
+	K8sResourcePatchOpJSONAdd = "json-add"
+	// K8sResourcePatchOpJSONRemove is the name of the RFC 6902 JSON Patch "remove" operation.
+	K8sResourcePatchOpJSONRemove = "json-remove"
+	// K8sResourcePatchOpJSONReplace is the name of the RFC 6902 JSON Patch "replace" operation.
+	K8sResourcePatchOpJSONReplace = "json-replace"
+	// K8sResourcePatchOpJSONMove is the name of the RFC 6902 JSON Patch "move" operation.
+	K8sResourcePatchOpJSONMove = "json-move"
+	// K8sResourcePatchOpJSONCopy is the name of the RFC 6902 JSON Patch "copy" operation.
+	K8sResourcePatchOpJSONCopy = "json-copy"
+	// K8sResourcePatchOpJSONTest is the name of the RFC 6902 JSON Patch "test" operation.
+	K8sResourcePatchOpJSONTest = "json-test"
+	// K8sResourcePatchOpStrategicMerge is the name of the patch operation that merges the given document into the target
+	// by the strategic merge patch. It falls back to the RFC 7386 JSON Merge Patch when the target is not a built-in resource.
+	K8sResourcePatchOpStrategicMerge = "strategic-merge"
 )
 
 // K8sResourcePatchOp represents a patch operation for a Kubernetes resource.
 type K8sResourcePatchOp struct {
 	// The operation type.
-	// Currently, only "yaml-replace" is supported.
+	// This must be one of "yaml-replace", "json-add", "json-remove", "json-replace",
+	// "json-move", "json-copy", "json-test" or "strategic-merge".
 	// Default is "yaml-replace".
-	// TODO: support "yaml-add", "yaml-remove", "json-replace" and "text-regex".
 	Op K8sResourcePatchOpName `json:"op" default:"yaml-replace"`
 	// The path string pointing to the manipulated field.
-	// E.g. "$.spec.foos[0].bar"
+	// E.g. "$.spec.foos[0].bar" for yaml-replace
+	// and a JSON Pointer like "/spec/foos/0/bar" for the JSON Patch operations.
+	// This is not used by strategic-merge.
 	Path string `json:"path"`
+	// The JSON Pointer to the source field of json-move and json-copy.
+	From string `json:"from"`
 	// The value string whose content will be used as new value for the field.
+	// It is parsed as a YAML value for the JSON Patch operations
+	// and as a YAML document of the patch for strategic-merge.
 	Value string `json:"value"`
 }
@@ -0,0 +1,28 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+// InputCueOptions represents the options to render manifests with CUE.
+type InputCueOptions struct {
+	// List of packages or files to be exported, relative to the application directory.
+	// Paths must be placed in the application directory, while import paths are resolved by the CUE module.
+	// Default is the package in the application directory.
+	Packages []string `json:"packages,omitempty"`
+	// The expression to be exported, e.g. objects.
+	// Empty means the whole value is exported.
+	Expression string `json:"expression,omitempty"`
+	// Values injected into the fields having @tag attributes by the --inject flag.
+	Tags map[string]string `json:"tags,omitempty"`
+}
@@ -0,0 +1,34 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+// InputJsonnetOptions represents the options to render manifests with Jsonnet.
+type InputJsonnetOptions struct {
+	// Relative path from the application directory to the main Jsonnet file.
+	// It must be placed in the application directory.
+	// Default is main.jsonnet.
+	File string `json:"file,omitempty"`
+	// List of relative paths from the application directory to the library directories.
+	// They must be placed in the application directory and are searched from the last one like the --jpath flag.
+	LibPaths []string `json:"libPaths,omitempty"`
+	// External variables passed as strings like the --ext-str flag.
+	ExtVars map[string]string `json:"extVars,omitempty"`
+	// External variables passed as Jsonnet code like the --ext-code flag.
+	ExtCode map[string]string `json:"extCode,omitempty"`
+	// Top-level arguments passed as strings like the --tla-str flag.
+	TLAVars map[string]string `json:"tlaVars,omitempty"`
+	// Top-level arguments passed as Jsonnet code like the --tla-code flag.
+	TLACode map[string]string `json:"tlaCode,omitempty"`
+}
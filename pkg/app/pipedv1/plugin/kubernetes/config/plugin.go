@@ -25,6 +25,10 @@ type KubernetesPluginConfig struct {
 	ChartRepositories []HelmChartRepository `json:"chartRepositories,omitempty"`
 	// List of helm chart registries that should be logged in while starting up.
 	ChartRegistries []HelmChartRegistry `json:"chartRegistries,omitempty"`
+	// List of executables that applications are allowed to use as exec post-renderers.
+	// Each entry is a pattern in the form of path.Match, matched against the exec path relative to the application directory.
+	// Exec post-renderers are disabled when this is empty.
+	AllowedPostRendererExecs []string `json:"allowedPostRendererExecs,omitempty"`
 }
 
 func (c *KubernetesPluginConfig) UnmarshalJSON(data []byte) error {
@@ -0,0 +1,41 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import "errors"
+
+// InputPostRenderer represents a step to transform the rendered manifests before they are applied.
+// Exactly one of Kustomize and Exec must be specified.
+type InputPostRenderer struct {
+	// Relative path from the application directory to a Kustomize overlay directory.
+	// The rendered manifests are placed in that directory as rendered.yaml,
+	// so the kustomization file must list it in its resources.
+	Kustomize string `json:"kustomize,omitempty"`
+	// Relative path from the application directory to an executable.
+	// It receives the rendered manifests from stdin and must write the transformed manifests to stdout.
+	Exec string `json:"exec,omitempty"`
+	// List of arguments passed to the executable.
+	Args []string `json:"args,omitempty"`
+}
+
+func (r *InputPostRenderer) Validate() error {
+	if (r.Kustomize == "") == (r.Exec == "") {
+		return errors.New("exactly one of kustomize and exec must be specified for postRenderers")
+	}
+	if r.Kustomize != "" && len(r.Args) > 0 {
+		return errors.New("args can be specified only with exec for postRenderers")
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInputPostRenderer_Validate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		renderer InputPostRenderer
+		wantErr  bool
+	}{
+		{
+			name:     "kustomize",
+			renderer: InputPostRenderer{Kustomize: "overlay"},
+		},
+		{
+			name:     "exec with args",
+			renderer: InputPostRenderer{Exec: "hack/post-render.sh", Args: []string{"--env", "prod"}},
+		},
+		{
+			name:    "nothing specified",
+			wantErr: true,
+		},
+		{
+			name:     "both specified",
+			renderer: InputPostRenderer{Kustomize: "overlay", Exec: "hack/post-render.sh"},
+			wantErr:  true,
+		},
+		{
+			name:     "args with kustomize",
+			renderer: InputPostRenderer{Kustomize: "overlay", Args: []string{"--env"}},
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.renderer.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
@@ -19,6 +19,10 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/provider"
 )
@@ -284,3 +288,153 @@ data:
 		})
 	}
 }
+
+// mockSyncWaveApplier records the applied manifests and returns
+// the live manifests in order for each resource.
+type mockSyncWaveApplier struct {
+	mockApplier
+	applied []string
+	// A map from resource name to the live manifests returned in order.
+	// The last one is returned once all of them are consumed.
+	lives map[string][]provider.Manifest
+}
+
+func (m *mockSyncWaveApplier) ApplyManifest(ctx context.Context, manifest provider.Manifest) error {
+	m.applied = append(m.applied, manifest.Name())
+	return m.applyErr
+}
+
+func (m *mockSyncWaveApplier) GetManifest(_ context.Context, key provider.ResourceKey) (provider.Manifest, error) {
+	lives, ok := m.lives[key.Name()]
+	if !ok {
+		return provider.Manifest{}, provider.ErrNotFound
+	}
+	live := lives[0]
+	if len(lives) > 1 {
+		m.lives[key.Name()] = lives[1:]
+	}
+	return live, nil
+}
+
+func Test_applyManifestsBySyncWave(t *testing.T) {
+	syncWaveCheckInterval = time.Millisecond
+
+	manifests := mustParseManifests(t, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+---
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+  annotations:
+    pipecd.dev/sync-wave: "-1"
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+  annotations:
+    pipecd.dev/sync-wave: "-1"
+`)
+	parse := func(data string) provider.Manifest {
+		ms := mustParseManifests(t, data)
+		require.Len(t, ms, 1)
+		return ms[0]
+	}
+	runningJob := parse(`
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+status:
+  active: 1
+`)
+	completedJob := parse(`
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+status:
+  succeeded: 1
+  conditions:
+  - type: Complete
+    status: "True"
+`)
+	failedJob := parse(`
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+status:
+  failed: 1
+  conditions:
+  - type: Failed
+    status: "True"
+`)
+	config := parse(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+`)
+
+	tests := []struct {
+		name        string
+		lives       map[string][]provider.Manifest
+		wantApplied []string
+		wantErr     bool
+	}{
+		{
+			name: "apply the next wave after the job completes",
+			lives: map[string][]provider.Manifest{
+				"migrate": {runningJob, runningJob, completedJob},
+				"config":  {config},
+			},
+			wantApplied: []string{"migrate", "config", "app"},
+		},
+		{
+			name: "stop applying when the job fails",
+			lives: map[string][]provider.Manifest{
+				"migrate": {runningJob, failedJob},
+				"config":  {config},
+			},
+			wantApplied: []string{"migrate", "config"},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applier := &mockSyncWaveApplier{lives: tt.lives}
+			lp := new(mockStageLogPersister)
+			err := applyManifestsBySyncWave(t.Context(), applier, manifests, "", lp)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantApplied, applier.applied)
+		})
+	}
+}
+
+func Test_applyManifestsBySyncWave_WithoutWaves(t *testing.T) {
+	t.Parallel()
+
+	manifests := mustParseManifests(t, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+`)
+	// No live manifest is required since there is only one wave.
+	applier := &mockSyncWaveApplier{}
+	lp := new(mockStageLogPersister)
+	err := applyManifestsBySyncWave(t.Context(), applier, manifests, "", lp)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"app", "config"}, applier.applied)
+}
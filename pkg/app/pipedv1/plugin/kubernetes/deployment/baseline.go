@@ -27,7 +27,7 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/toolregistry"
 )
 
-func (p *Plugin) executeK8sBaselineRolloutStage(ctx context.Context, input *sdk.ExecuteStageInput[kubeconfig.KubernetesApplicationSpec], dts []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]) sdk.StageStatus {
+func (p *Plugin) executeK8sBaselineRolloutStage(ctx context.Context, pluginCfg *kubeconfig.KubernetesPluginConfig, input *sdk.ExecuteStageInput[kubeconfig.KubernetesApplicationSpec], dts []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]) sdk.StageStatus {
 	lp := input.Client.LogPersister()
 	lp.Info("Start baseline rollout")
 
@@ -60,7 +60,7 @@ func (p *Plugin) executeK8sBaselineRolloutStage(ctx context.Context, input *sdk.
 	loader := provider.NewLoader(toolRegistry)
 
 	lp.Infof("Loading manifests at commit %s for handling", input.Request.RunningDeploymentSource.CommitHash)
-	manifests, err := p.loadManifests(ctx, &input.Request.Deployment, appCfg, &input.Request.RunningDeploymentSource, loader, input.Logger)
+	manifests, err := p.loadManifests(ctx, pluginCfg, &input.Request.Deployment, appCfg, &input.Request.RunningDeploymentSource, loader, input.Logger)
 	if err != nil {
 		lp.Errorf("Failed while loading manifests (%v)", err)
 		return sdk.StageStatusFailure
@@ -102,7 +102,7 @@ func (p *Plugin) executeK8sBaselineRolloutStage(ctx context.Context, input *sdk.
 	return sdk.StageStatusSuccess
 }
 
-func (p *Plugin) executeK8sBaselineCleanStage(ctx context.Context, input *sdk.ExecuteStageInput[kubeconfig.KubernetesApplicationSpec], dts []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]) sdk.StageStatus {
+func (p *Plugin) executeK8sBaselineCleanStage(ctx context.Context, pluginCfg *kubeconfig.KubernetesPluginConfig, input *sdk.ExecuteStageInput[kubeconfig.KubernetesApplicationSpec], dts []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]) sdk.StageStatus {
 	lp := input.Client.LogPersister()
 	lp.Info("Start baseline clean")
 
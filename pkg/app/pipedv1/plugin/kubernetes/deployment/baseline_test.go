@@ -69,7 +69,7 @@ func TestPlugin_executeK8sBaselineRolloutStage_withCreateService(t *testing.T) {
 
 	plugin := &Plugin{}
 
-	status := plugin.executeK8sBaselineRolloutStage(ctx, input, []*sdk.DeployTarget[kubeConfigPkg.KubernetesDeployTargetConfig]{
+	status := plugin.executeK8sBaselineRolloutStage(ctx, &kubeConfigPkg.KubernetesPluginConfig{}, input, []*sdk.DeployTarget[kubeConfigPkg.KubernetesDeployTargetConfig]{
 		{
 			Name:   "default",
 			Config: *dtConfig,
@@ -168,7 +168,7 @@ func TestPlugin_executeK8sBaselineRolloutStage_withoutCreateService(t *testing.T
 
 	plugin := &Plugin{}
 
-	status := plugin.executeK8sBaselineRolloutStage(ctx, input, []*sdk.DeployTarget[kubeConfigPkg.KubernetesDeployTargetConfig]{
+	status := plugin.executeK8sBaselineRolloutStage(ctx, &kubeConfigPkg.KubernetesPluginConfig{}, input, []*sdk.DeployTarget[kubeConfigPkg.KubernetesDeployTargetConfig]{
 		{
 			Name:   "default",
 			Config: *dtConfig,
@@ -242,7 +242,7 @@ func TestPlugin_executeK8sBaselineCleanStage_withCreateService(t *testing.T) {
 			Logger: zaptest.NewLogger(t),
 		}
 
-		status := plugin.executeK8sSyncStage(ctx, input, dts)
+		status := plugin.executeK8sSyncStage(ctx, &kubeConfigPkg.KubernetesPluginConfig{}, input, dts)
 
 		assert.Equal(t, sdk.StageStatusSuccess, status)
 
@@ -288,7 +288,7 @@ func TestPlugin_executeK8sBaselineCleanStage_withCreateService(t *testing.T) {
 			Logger: zaptest.NewLogger(t),
 		}
 
-		status := plugin.executeK8sBaselineRolloutStage(ctx, input, dts)
+		status := plugin.executeK8sBaselineRolloutStage(ctx, &kubeConfigPkg.KubernetesPluginConfig{}, input, dts)
 
 		assert.Equal(t, sdk.StageStatusSuccess, status)
 
@@ -334,7 +334,7 @@ func TestPlugin_executeK8sBaselineCleanStage_withCreateService(t *testing.T) {
 			Logger: zaptest.NewLogger(t),
 		}
 
-		status := plugin.executeK8sBaselineCleanStage(ctx, input, dts)
+		status := plugin.executeK8sBaselineCleanStage(ctx, &kubeConfigPkg.KubernetesPluginConfig{}, input, dts)
 
 		assert.Equal(t, sdk.StageStatusSuccess, status)
 
@@ -413,7 +413,7 @@ func TestPlugin_executeK8sBaselineCleanStage_withoutCreateService(t *testing.T)
 			Logger: zaptest.NewLogger(t),
 		}
 
-		status := plugin.executeK8sSyncStage(ctx, input, dts)
+		status := plugin.executeK8sSyncStage(ctx, &kubeConfigPkg.KubernetesPluginConfig{}, input, dts)
 
 		assert.Equal(t, sdk.StageStatusSuccess, status)
 
@@ -459,7 +459,7 @@ func TestPlugin_executeK8sBaselineCleanStage_withoutCreateService(t *testing.T)
 			Logger: zaptest.NewLogger(t),
 		}
 
-		status := plugin.executeK8sBaselineRolloutStage(ctx, input, dts)
+		status := plugin.executeK8sBaselineRolloutStage(ctx, &kubeConfigPkg.KubernetesPluginConfig{}, input, dts)
 
 		assert.Equal(t, sdk.StageStatusSuccess, status)
 
@@ -505,7 +505,7 @@ func TestPlugin_executeK8sBaselineCleanStage_withoutCreateService(t *testing.T)
 			Logger: zaptest.NewLogger(t),
 		}
 
-		status := plugin.executeK8sBaselineCleanStage(ctx, input, dts)
+		status := plugin.executeK8sBaselineCleanStage(ctx, &kubeConfigPkg.KubernetesPluginConfig{}, input, dts)
 
 		assert.Equal(t, sdk.StageStatusSuccess, status)
 
@@ -28,7 +28,7 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/toolregistry"
 )
 
-func (p *Plugin) executeK8sCanaryRolloutStage(ctx context.Context, input *sdk.ExecuteStageInput[kubeconfig.KubernetesApplicationSpec], dts []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]) sdk.StageStatus {
+func (p *Plugin) executeK8sCanaryRolloutStage(ctx context.Context, pluginCfg *kubeconfig.KubernetesPluginConfig, input *sdk.ExecuteStageInput[kubeconfig.KubernetesApplicationSpec], dts []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]) sdk.StageStatus {
 	lp := input.Client.LogPersister()
 	lp.Info("Start canary rollout")
 
@@ -62,7 +62,7 @@ func (p *Plugin) executeK8sCanaryRolloutStage(ctx context.Context, input *sdk.Ex
 
 	lp.Infof("Loading manifests at commit %s for handling", input.Request.TargetDeploymentSource.CommitHash)
 
-	manifests, err := p.loadManifests(ctx, &input.Request.Deployment, cfg.Spec, &input.Request.TargetDeploymentSource, loader, input.Logger)
+	manifests, err := p.loadManifests(ctx, pluginCfg, &input.Request.Deployment, cfg.Spec, &input.Request.TargetDeploymentSource, loader, input.Logger)
 	if err != nil {
 		lp.Errorf("Failed while loading manifests (%v)", err)
 		return sdk.StageStatusFailure
@@ -179,7 +179,7 @@ func (p *Plugin) generateCanaryManifests(appCfg *kubeconfig.KubernetesApplicatio
 	return canaryManifests, nil
 }
 
-func (p *Plugin) executeK8sCanaryCleanStage(ctx context.Context, input *sdk.ExecuteStageInput[kubeconfig.KubernetesApplicationSpec], dts []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]) sdk.StageStatus {
+func (p *Plugin) executeK8sCanaryCleanStage(ctx context.Context, pluginCfg *kubeconfig.KubernetesPluginConfig, input *sdk.ExecuteStageInput[kubeconfig.KubernetesApplicationSpec], dts []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]) sdk.StageStatus {
 	lp := input.Client.LogPersister()
 	lp.Info("Start canary clean")
 
@@ -293,25 +293,14 @@ func patchManifest(m provider.Manifest, patch kubeconfig.K8sResourcePatch) (*pro
 		return nil, err
 	}
 
-	process := func(bytes []byte) ([]byte, error) {
-		p, err := yamlprocessor.NewProcessor(bytes)
-		if err != nil {
-			return nil, err
-		}
-
+	process := func(bytes []byte, dataStruct any) ([]byte, error) {
 		for _, o := range patch.Ops {
-			switch o.Op {
-			case kubeconfig.K8sResourcePatchOpYAMLReplace:
-				if err := p.ReplaceString(o.Path, o.Value); err != nil {
-					return nil, fmt.Errorf("failed to replace value at path: %s, error: %w", o.Path, err)
-				}
-			default:
-				// TODO: Support more patch operation for K8sCanaryRolloutStageOptions.
-				return nil, fmt.Errorf("%s operation is not supported currently", o.Op)
+			var err error
+			if bytes, err = applyPatchOp(bytes, o, dataStruct); err != nil {
+				return nil, err
 			}
 		}
-
-		return p.Bytes(), nil
+		return bytes, nil
 	}
 
 	buildManifest := func(bytes []byte) (*provider.Manifest, error) {
@@ -329,7 +318,7 @@ func patchManifest(m provider.Manifest, patch kubeconfig.K8sResourcePatch) (*pro
 	// just pass full bytes to process and build a new manifest based on the returned data.
 	root := patch.Target.DocumentRoot
 	if root == "" {
-		out, err := process(fullBytes)
+		out, err := process(fullBytes, strategicMergeDataStruct(m))
 		if err != nil {
 			return nil, err
 		}
@@ -353,7 +342,8 @@ func patchManifest(m provider.Manifest, patch kubeconfig.K8sResourcePatch) (*pro
 	}
 
 	// And process that field data.
-	out, err := process([]byte(sv))
+	// Its type is unknown so strategic-merge falls back to the JSON merge patch.
+	out, err := process([]byte(sv), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -76,7 +76,7 @@ func TestPlugin_executeK8sCanaryRolloutStage_withCreateService(t *testing.T) {
 
 	plugin := &Plugin{}
 
-	status := plugin.executeK8sCanaryRolloutStage(ctx, input, []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]{
+	status := plugin.executeK8sCanaryRolloutStage(ctx, &kubeconfig.KubernetesPluginConfig{}, input, []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]{
 		{
 			Name:   "default",
 			Config: *dtConfig,
@@ -175,7 +175,7 @@ func TestPlugin_executeK8sCanaryRolloutStage_withoutCreateService(t *testing.T)
 
 	plugin := &Plugin{}
 
-	status := plugin.executeK8sCanaryRolloutStage(ctx, input, []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]{
+	status := plugin.executeK8sCanaryRolloutStage(ctx, &kubeconfig.KubernetesPluginConfig{}, input, []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]{
 		{
 			Name:   "default",
 			Config: *dtConfig,
@@ -247,7 +247,7 @@ func TestPlugin_executeK8sCanaryRolloutStage_withPatch(t *testing.T) {
 
 	plugin := &Plugin{}
 
-	status := plugin.executeK8sCanaryRolloutStage(ctx, input, []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]{
+	status := plugin.executeK8sCanaryRolloutStage(ctx, &kubeconfig.KubernetesPluginConfig{}, input, []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]{
 		{
 			Name:   "default",
 			Config: *dtConfig,
@@ -336,7 +336,7 @@ func TestPlugin_executeK8sCanaryCleanStage_withCreateService(t *testing.T) {
 	plugin := &Plugin{}
 
 	ok := t.Run("execute canary rollout stage", func(t *testing.T) {
-		status := plugin.executeK8sCanaryRolloutStage(ctx, input, []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]{
+		status := plugin.executeK8sCanaryRolloutStage(ctx, &kubeconfig.KubernetesPluginConfig{}, input, []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]{
 			{
 				Name:   "default",
 				Config: *dtConfig,
@@ -400,7 +400,7 @@ func TestPlugin_executeK8sCanaryCleanStage_withCreateService(t *testing.T) {
 	require.True(t, ok)
 
 	ok = t.Run("execute canary clean stage", func(t *testing.T) {
-		status := plugin.executeK8sCanaryCleanStage(ctx, input, []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]{
+		status := plugin.executeK8sCanaryCleanStage(ctx, &kubeconfig.KubernetesPluginConfig{}, input, []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]{
 			{
 				Name:   "default",
 				Config: *dtConfig,
@@ -460,7 +460,7 @@ func TestPlugin_executeK8sCanaryCleanStage_withoutCreateService(t *testing.T) {
 	plugin := &Plugin{}
 
 	ok := t.Run("execute canary rollout stage", func(t *testing.T) {
-		status := plugin.executeK8sCanaryRolloutStage(ctx, input, []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]{
+		status := plugin.executeK8sCanaryRolloutStage(ctx, &kubeconfig.KubernetesPluginConfig{}, input, []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]{
 			{
 				Name:   "default",
 				Config: *dtConfig,
@@ -498,7 +498,7 @@ func TestPlugin_executeK8sCanaryCleanStage_withoutCreateService(t *testing.T) {
 	require.True(t, ok)
 
 	ok = t.Run("execute canary clean stage", func(t *testing.T) {
-		status := plugin.executeK8sCanaryCleanStage(ctx, input, []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]{
+		status := plugin.executeK8sCanaryCleanStage(ctx, &kubeconfig.KubernetesPluginConfig{}, input, []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]{
 			{
 				Name:   "default",
 				Config: *dtConfig,
@@ -564,7 +564,7 @@ func TestPlugin_executeK8sCanaryCleanStage_withPatch(t *testing.T) {
 			Logger: zaptest.NewLogger(t),
 		}
 
-		status := plugin.executeK8sCanaryRolloutStage(ctx, input, []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]{
+		status := plugin.executeK8sCanaryRolloutStage(ctx, &kubeconfig.KubernetesPluginConfig{}, input, []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]{
 			{
 				Name:   "default",
 				Config: *dtConfig,
@@ -645,7 +645,7 @@ func TestPlugin_executeK8sCanaryCleanStage_withPatch(t *testing.T) {
 			Logger: zaptest.NewLogger(t),
 		}
 
-		status := plugin.executeK8sCanaryCleanStage(ctx, input, []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]{
+		status := plugin.executeK8sCanaryCleanStage(ctx, &kubeconfig.KubernetesPluginConfig{}, input, []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]{
 			{
 				Name:   "default",
 				Config: *dtConfig,
@@ -900,6 +900,86 @@ func Test_patchManifest(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:      "json patch ops",
+			manifests: "testdata/patch_manifest/patch_deployment_json_ops.yaml",
+			patch: kubeconfig.K8sResourcePatch{
+				Ops: []kubeconfig.K8sResourcePatchOp{
+					{
+						Op:    kubeconfig.K8sResourcePatchOpJSONTest,
+						Path:  "/spec/replicas",
+						Value: "3",
+					},
+					{
+						Op:    kubeconfig.K8sResourcePatchOpJSONReplace,
+						Path:  "/spec/replicas",
+						Value: "1",
+					},
+					{
+						Op:   kubeconfig.K8sResourcePatchOpJSONRemove,
+						Path: "/metadata/annotations",
+					},
+					{
+						Op:   kubeconfig.K8sResourcePatchOpJSONCopy,
+						From: "/metadata/labels/app",
+						Path: "/metadata/labels/component",
+					},
+					{
+						Op:   kubeconfig.K8sResourcePatchOpJSONMove,
+						From: "/spec/template/metadata/labels/team",
+						Path: "/spec/template/metadata/labels/owner",
+					},
+					{
+						Op:   kubeconfig.K8sResourcePatchOpJSONAdd,
+						Path: "/spec/template/spec/containers/-",
+						Value: `name: envoy
+image: envoyproxy/envoy:v1.30.0
+args: [--config-path, /etc/envoy/envoy.yaml]`,
+					},
+				},
+			},
+		},
+		{
+			name:      "strategic merge",
+			manifests: "testdata/patch_manifest/patch_deployment_strategic_merge.yaml",
+			patch: kubeconfig.K8sResourcePatch{
+				Ops: []kubeconfig.K8sResourcePatchOp{
+					{
+						Op: kubeconfig.K8sResourcePatchOpStrategicMerge,
+						Value: `spec:
+  template:
+    spec:
+      containers:
+      - name: helloworld
+        env:
+        - name: LOG_LEVEL
+          value: debug
+        - name: CANARY
+          value: "true"`,
+					},
+				},
+			},
+		},
+		{
+			name:      "json patch ops with a given field",
+			manifests: "testdata/patch_manifest/patch_configmap_field_json_ops.yaml",
+			patch: kubeconfig.K8sResourcePatch{
+				Target: kubeconfig.K8sResourcePatchTarget{
+					DocumentRoot: "$.data.envoy-config",
+				},
+				Ops: []kubeconfig.K8sResourcePatchOp{
+					{
+						Op:    kubeconfig.K8sResourcePatchOpJSONReplace,
+						Path:  "/admin/address/socket_address/port_value",
+						Value: "9096",
+					},
+					{
+						Op:   kubeconfig.K8sResourcePatchOpJSONRemove,
+						Path: "/static_resources/clusters/1",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -0,0 +1,155 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/provider"
+)
+
+// helmHookApplier is an applier which can also get and delete the hook resources.
+type helmHookApplier interface {
+	syncWaveApplier
+	// Delete deletes the given resource.
+	Delete(ctx context.Context, key provider.ResourceKey) error
+}
+
+// helmHookCheckInterval is the interval to check whether the running hook has finished.
+var helmHookCheckInterval = 5 * time.Second
+
+// helmHookPhase returns the phase of the Helm hooks to be run by the deployment.
+// It is the install phase when there is no running commit.
+func helmHookPhase(runningCommitHash string) provider.HelmHookPhase {
+	if runningCommitHash == "" {
+		return provider.HelmHookPhaseInstall
+	}
+	return provider.HelmHookPhaseUpgrade
+}
+
+// applyManifestsWithHelmHooks applies the given manifests while honoring the helm.sh/hook annotations.
+// The pre hooks of the given phase are run before applying the other manifests and the post hooks are run after that.
+// The hooks of the other phases are not applied at all.
+func applyManifestsWithHelmHooks(ctx context.Context, applier helmHookApplier, manifests []provider.Manifest, namespace string, phase provider.HelmHookPhase, lp sdk.StageLogPersister) error {
+	regular, hooks, err := provider.SplitHelmHooks(manifests, phase)
+	if err != nil {
+		lp.Errorf("Failed to find Helm hooks (%v)", err)
+		return err
+	}
+	if skipped := len(manifests) - len(regular) - len(hooks.Pre) - len(hooks.Post); skipped > 0 {
+		lp.Infof("Skipped %d Helm hooks which are not for %s", skipped, phase)
+	}
+
+	if len(hooks.Pre) > 0 {
+		lp.Infof("Start running %d pre-%s hooks", len(hooks.Pre), phase)
+		if err := runHelmHooks(ctx, applier, hooks.Pre, lp); err != nil {
+			return err
+		}
+	}
+
+	if err := applyManifestsBySyncWave(ctx, applier, regular, namespace, lp); err != nil {
+		return err
+	}
+
+	if len(hooks.Post) > 0 {
+		lp.Infof("Start running %d post-%s hooks", len(hooks.Post), phase)
+		if err := runHelmHooks(ctx, applier, hooks.Post, lp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runHelmHooks runs the given hooks one by one in the given order.
+// Each hook is created according to its delete policy and waited until it finishes.
+// It stops at the first failed hook.
+func runHelmHooks(ctx context.Context, applier helmHookApplier, hooks []provider.Manifest, lp sdk.StageLogPersister) error {
+	for _, h := range hooks {
+		key := h.Key().ReadableString()
+		if h.HasHelmHookDeletePolicy(provider.HelmHookBeforeHookCreation) {
+			if err := deleteHelmHook(ctx, applier, h); err != nil {
+				lp.Errorf("Failed to delete the previous hook %s (%v)", key, err)
+				return err
+			}
+		}
+
+		if err := applier.ApplyManifest(ctx, h); err != nil {
+			lp.Errorf("Failed to apply hook %s (%v)", key, err)
+			return err
+		}
+		lp.Infof("- applied hook: %s", key)
+
+		if err := waitForHelmHook(ctx, applier, h, lp); err != nil {
+			lp.Errorf("Hook %s failed (%v)", key, err)
+			if h.HasHelmHookDeletePolicy(provider.HelmHookFailed) {
+				if err := deleteHelmHook(ctx, applier, h); err != nil {
+					lp.Errorf("Failed to delete the failed hook %s (%v)", key, err)
+				}
+			}
+			return err
+		}
+		lp.Successf("- hook succeeded: %s", key)
+
+		if h.HasHelmHookDeletePolicy(provider.HelmHookSucceeded) {
+			if err := deleteHelmHook(ctx, applier, h); err != nil {
+				lp.Errorf("Failed to delete the succeeded hook %s (%v)", key, err)
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// waitForHelmHook waits until the given hook finishes.
+func waitForHelmHook(ctx context.Context, applier helmHookApplier, hook provider.Manifest, lp sdk.StageLogPersister) error {
+	ticker := time.NewTicker(helmHookCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		var reason string
+		live, err := applier.GetManifest(ctx, hook.Key())
+		if err != nil {
+			reason = fmt.Sprintf("Unable to get %s (%v)", hook.Key().ReadableString(), err)
+		} else {
+			var readiness provider.ResourceReadiness
+			readiness, reason = live.HelmHookReadiness()
+			switch readiness {
+			case provider.ResourceReady:
+				return nil
+			case provider.ResourceFailed:
+				return errors.New(reason)
+			}
+		}
+		lp.Infof("Waiting for hook %s to finish: %s", hook.Key().ReadableString(), reason)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func deleteHelmHook(ctx context.Context, applier helmHookApplier, hook provider.Manifest) error {
+	if err := applier.Delete(ctx, hook.Key()); err != nil && !errors.Is(err, provider.ErrNotFound) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/provider"
+)
+
+// mockHelmHookApplier records the applied and deleted resources in order.
+type mockHelmHookApplier struct {
+	mockSyncWaveApplier
+	operations []string
+}
+
+func (m *mockHelmHookApplier) ApplyManifest(ctx context.Context, manifest provider.Manifest) error {
+	m.operations = append(m.operations, "apply "+manifest.Name())
+	return m.mockSyncWaveApplier.ApplyManifest(ctx, manifest)
+}
+
+func (m *mockHelmHookApplier) Delete(_ context.Context, key provider.ResourceKey) error {
+	m.operations = append(m.operations, "delete "+key.Name())
+	return nil
+}
+
+func Test_applyManifestsWithHelmHooks(t *testing.T) {
+	helmHookCheckInterval = time.Millisecond
+
+	manifests := mustParseManifests(t, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+---
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+  annotations:
+    helm.sh/hook: pre-install,pre-upgrade
+    helm.sh/hook-delete-policy: before-hook-creation,hook-succeeded
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: migrate-config
+  annotations:
+    helm.sh/hook: pre-upgrade
+    helm.sh/hook-weight: "-1"
+    helm.sh/hook-delete-policy: hook-failed
+---
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: notify
+  annotations:
+    helm.sh/hook: post-upgrade
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: test-connection
+  annotations:
+    helm.sh/hook: test
+`)
+	parse := func(data string) provider.Manifest {
+		ms := mustParseManifests(t, data)
+		require.Len(t, ms, 1)
+		return ms[0]
+	}
+	job := func(name, condition string) provider.Manifest {
+		return parse(`
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: ` + name + `
+status:
+  conditions:
+  - type: ` + condition + `
+    status: "True"
+`)
+	}
+	runningJob := func(name string) provider.Manifest {
+		return parse(`
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: ` + name + `
+status:
+  active: 1
+`)
+	}
+	configMap := parse(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: migrate-config
+`)
+
+	testcases := []struct {
+		name           string
+		phase          provider.HelmHookPhase
+		lives          map[string][]provider.Manifest
+		wantOperations []string
+		wantErr        bool
+	}{
+		{
+			name:  "install runs only install hooks",
+			phase: provider.HelmHookPhaseInstall,
+			lives: map[string][]provider.Manifest{
+				"migrate": {runningJob("migrate"), job("migrate", "Complete")},
+			},
+			wantOperations: []string{
+				"delete migrate",
+				"apply migrate",
+				"delete migrate",
+				"apply app",
+			},
+		},
+		{
+			name:  "upgrade runs pre and post hooks in order",
+			phase: provider.HelmHookPhaseUpgrade,
+			lives: map[string][]provider.Manifest{
+				"migrate-config": {configMap},
+				"migrate":        {job("migrate", "Complete")},
+				"notify":         {runningJob("notify"), job("notify", "Complete")},
+			},
+			wantOperations: []string{
+				"apply migrate-config",
+				"delete migrate",
+				"apply migrate",
+				"delete migrate",
+				"apply app",
+				"delete notify",
+				"apply notify",
+			},
+		},
+		{
+			name:  "failed pre hook stops the deployment",
+			phase: provider.HelmHookPhaseUpgrade,
+			lives: map[string][]provider.Manifest{
+				"migrate-config": {configMap},
+				"migrate":        {job("migrate", "Failed")},
+			},
+			wantOperations: []string{
+				"apply migrate-config",
+				"delete migrate",
+				"apply migrate",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			applier := &mockHelmHookApplier{mockSyncWaveApplier: mockSyncWaveApplier{lives: tc.lives}}
+			lp := new(mockStageLogPersister)
+			err := applyManifestsWithHelmHooks(t.Context(), applier, manifests, "", tc.phase, lp)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.wantOperations, applier.operations)
+		})
+	}
+}
+
+func Test_helmHookPhase(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, provider.HelmHookPhaseInstall, helmHookPhase(""))
+	assert.Equal(t, provider.HelmHookPhaseUpgrade, helmHookPhase("commit-hash"))
+}
@@ -0,0 +1,134 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/yaml"
+
+	kubeconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/deployment/yamlprocessor"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/provider"
+)
+
+// applyPatchOp applies the given operation to the given YAML or JSON document.
+// The dataStruct is used to look up the patch strategy of strategic-merge,
+// nil means the type of the document is unknown.
+func applyPatchOp(doc []byte, op kubeconfig.K8sResourcePatchOp, dataStruct any) ([]byte, error) {
+	switch op.Op {
+	case kubeconfig.K8sResourcePatchOpYAMLReplace:
+		p, err := yamlprocessor.NewProcessor(doc)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.ReplaceString(op.Path, op.Value); err != nil {
+			return nil, fmt.Errorf("failed to replace value at path: %s, error: %w", op.Path, err)
+		}
+		return p.Bytes(), nil
+
+	case kubeconfig.K8sResourcePatchOpJSONAdd,
+		kubeconfig.K8sResourcePatchOpJSONRemove,
+		kubeconfig.K8sResourcePatchOpJSONReplace,
+		kubeconfig.K8sResourcePatchOpJSONMove,
+		kubeconfig.K8sResourcePatchOpJSONCopy,
+		kubeconfig.K8sResourcePatchOpJSONTest:
+		patch, err := makeJSONPatch(op)
+		if err != nil {
+			return nil, err
+		}
+		out, err := patchAsJSON(doc, patch.Apply)
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply %s operation at path: %s, error: %w", op.Op, op.Path, err)
+		}
+		return out, nil
+
+	case kubeconfig.K8sResourcePatchOpStrategicMerge:
+		patch, err := yaml.YAMLToJSON([]byte(op.Value))
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %s operation: %w", op.Op, err)
+		}
+		out, err := patchAsJSON(doc, func(in []byte) ([]byte, error) {
+			if dataStruct == nil {
+				return jsonpatch.MergePatch(in, patch)
+			}
+			return strategicpatch.StrategicMergePatch(in, patch, dataStruct)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply %s operation: %w", op.Op, err)
+		}
+		return out, nil
+
+	default:
+		return nil, fmt.Errorf("%s operation is not supported currently", op.Op)
+	}
+}
+
+// makeJSONPatch builds an RFC 6902 JSON Patch containing only the given operation.
+func makeJSONPatch(op kubeconfig.K8sResourcePatchOp) (jsonpatch.Patch, error) {
+	operation := map[string]any{
+		"op":   strings.TrimPrefix(string(op.Op), "json-"),
+		"path": op.Path,
+	}
+	switch op.Op {
+	case kubeconfig.K8sResourcePatchOpJSONAdd, kubeconfig.K8sResourcePatchOpJSONReplace, kubeconfig.K8sResourcePatchOpJSONTest:
+		var value any
+		if err := yaml.Unmarshal([]byte(op.Value), &value); err != nil {
+			return nil, fmt.Errorf("invalid value for %s operation at path: %s, error: %w", op.Op, op.Path, err)
+		}
+		operation["value"] = value
+	case kubeconfig.K8sResourcePatchOpJSONMove, kubeconfig.K8sResourcePatchOpJSONCopy:
+		operation["from"] = op.From
+	}
+
+	data, err := json.Marshal([]any{operation})
+	if err != nil {
+		return nil, err
+	}
+	return jsonpatch.DecodePatch(data)
+}
+
+// patchAsJSON converts the given YAML or JSON document into JSON to patch it,
+// and then converts the result back into the original format.
+func patchAsJSON(doc []byte, patch func([]byte) ([]byte, error)) ([]byte, error) {
+	if json.Valid(doc) {
+		return patch(doc)
+	}
+	in, err := yaml.YAMLToJSON(doc)
+	if err != nil {
+		return nil, err
+	}
+	out, err := patch(in)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.JSONToYAML(out)
+}
+
+// strategicMergeDataStruct returns an empty object of the manifest's type
+// to look up the patch strategy of strategic-merge.
+// It returns nil when the type is not a built-in one.
+func strategicMergeDataStruct(m provider.Manifest) any {
+	obj, err := scheme.Scheme.New(m.GroupVersionKind())
+	if err != nil {
+		return nil
+	}
+	return obj
+}
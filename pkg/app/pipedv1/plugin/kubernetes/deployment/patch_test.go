@@ -0,0 +1,107 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kubeconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/config"
+)
+
+func TestApplyPatchOp(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		doc      string
+		op       kubeconfig.K8sResourcePatchOp
+		expected string
+		wantErr  bool
+	}{
+		{
+			name: "json-add keeps JSON document as JSON",
+			doc:  `{"ports":[8080]}`,
+			op: kubeconfig.K8sResourcePatchOp{
+				Op:    kubeconfig.K8sResourcePatchOpJSONAdd,
+				Path:  "/ports/-",
+				Value: "9090",
+			},
+			expected: `{"ports":[8080,9090]}`,
+		},
+		{
+			name: "json-replace with a quoted string value",
+			doc:  "port: 8080\n",
+			op: kubeconfig.K8sResourcePatchOp{
+				Op:    kubeconfig.K8sResourcePatchOpJSONReplace,
+				Path:  "/port",
+				Value: `"9090"`,
+			},
+			expected: "port: \"9090\"\n",
+		},
+		{
+			name: "json-test fails when the value is different",
+			doc:  "port: 8080\n",
+			op: kubeconfig.K8sResourcePatchOp{
+				Op:    kubeconfig.K8sResourcePatchOpJSONTest,
+				Path:  "/port",
+				Value: "9090",
+			},
+			wantErr: true,
+		},
+		{
+			name: "json-remove fails when the path does not exist",
+			doc:  "port: 8080\n",
+			op: kubeconfig.K8sResourcePatchOp{
+				Op:   kubeconfig.K8sResourcePatchOpJSONRemove,
+				Path: "/host",
+			},
+			wantErr: true,
+		},
+		{
+			name: "strategic-merge falls back to JSON merge patch for unknown documents",
+			doc:  "hosts:\n- a\n- b\nport: 8080\ntls: true\n",
+			op: kubeconfig.K8sResourcePatchOp{
+				Op:    kubeconfig.K8sResourcePatchOpStrategicMerge,
+				Value: "hosts:\n- c\ntls: null\n",
+			},
+			expected: "hosts:\n- c\nport: 8080\n",
+		},
+		{
+			name: "unsupported operation",
+			doc:  "port: 8080\n",
+			op: kubeconfig.K8sResourcePatchOp{
+				Op: "text-regex",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := applyPatchOp([]byte(tc.doc), tc.op, nil)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, string(got))
+		})
+	}
+}
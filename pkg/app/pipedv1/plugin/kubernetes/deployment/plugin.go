@@ -62,61 +62,66 @@ func (p *Plugin) BuildPipelineSyncStages(ctx context.Context, _ *kubeconfig.Kube
 }
 
 // ExecuteStage executes the stage.
-func (p *Plugin) ExecuteStage(ctx context.Context, _ *kubeconfig.KubernetesPluginConfig, dts []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig], input *sdk.ExecuteStageInput[kubeconfig.KubernetesApplicationSpec]) (*sdk.ExecuteStageResponse, error) {
+func (p *Plugin) ExecuteStage(ctx context.Context, pluginCfg *kubeconfig.KubernetesPluginConfig, dts []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig], input *sdk.ExecuteStageInput[kubeconfig.KubernetesApplicationSpec]) (*sdk.ExecuteStageResponse, error) {
 	switch input.Request.StageName {
 	case StageK8sSync:
 		return &sdk.ExecuteStageResponse{
-			Status: p.executeK8sSyncStage(ctx, input, dts),
+			Status: p.executeK8sSyncStage(ctx, pluginCfg, input, dts),
 		}, nil
 	case StageK8sPrimaryRollout:
 		return &sdk.ExecuteStageResponse{
-			Status: p.executeK8sPrimaryRolloutStage(ctx, input, dts),
+			Status: p.executeK8sPrimaryRolloutStage(ctx, pluginCfg, input, dts),
 		}, nil
 	case StageK8sCanaryRollout:
 		return &sdk.ExecuteStageResponse{
-			Status: p.executeK8sCanaryRolloutStage(ctx, input, dts),
+			Status: p.executeK8sCanaryRolloutStage(ctx, pluginCfg, input, dts),
 		}, nil
 	case StageK8sCanaryClean:
 		return &sdk.ExecuteStageResponse{
-			Status: p.executeK8sCanaryCleanStage(ctx, input, dts),
+			Status: p.executeK8sCanaryCleanStage(ctx, pluginCfg, input, dts),
 		}, nil
 	case StageK8sBaselineRollout:
 		return &sdk.ExecuteStageResponse{
-			Status: p.executeK8sBaselineRolloutStage(ctx, input, dts),
+			Status: p.executeK8sBaselineRolloutStage(ctx, pluginCfg, input, dts),
 		}, nil
 	case StageK8sBaselineClean:
 		return &sdk.ExecuteStageResponse{
-			Status: p.executeK8sBaselineCleanStage(ctx, input, dts),
+			Status: p.executeK8sBaselineCleanStage(ctx, pluginCfg, input, dts),
 		}, nil
 	case StageK8sTrafficRouting:
 		return &sdk.ExecuteStageResponse{
-			Status: p.executeK8sTrafficRoutingStage(ctx, input, dts),
+			Status: p.executeK8sTrafficRoutingStage(ctx, pluginCfg, input, dts),
 		}, nil
 	case StageK8sRollback:
 		return &sdk.ExecuteStageResponse{
-			Status: p.executeK8sRollbackStage(ctx, input, dts),
+			Status: p.executeK8sRollbackStage(ctx, pluginCfg, input, dts),
 		}, nil
 	default:
 		return nil, errors.New("unimplemented or unsupported stage")
 	}
 }
 
-func (p *Plugin) loadManifests(ctx context.Context, deploy *sdk.Deployment, spec *kubeconfig.KubernetesApplicationSpec, deploymentSource *sdk.DeploymentSource[kubeconfig.KubernetesApplicationSpec], loader loader, logger *zap.Logger) ([]provider.Manifest, error) {
+func (p *Plugin) loadManifests(ctx context.Context, pluginCfg *kubeconfig.KubernetesPluginConfig, deploy *sdk.Deployment, spec *kubeconfig.KubernetesApplicationSpec, deploymentSource *sdk.DeploymentSource[kubeconfig.KubernetesApplicationSpec], loader loader, logger *zap.Logger) ([]provider.Manifest, error) {
 	manifests, err := loader.LoadManifests(ctx, provider.LoaderInput{
-		PipedID:          deploy.PipedID,
-		AppID:            deploy.ApplicationID,
-		CommitHash:       deploymentSource.CommitHash,
-		AppName:          deploy.ApplicationName,
-		AppDir:           deploymentSource.ApplicationDirectory,
-		ConfigFilename:   deploymentSource.ApplicationConfigFilename,
-		Manifests:        spec.Input.Manifests,
-		Namespace:        spec.Input.Namespace,
-		KustomizeVersion: spec.Input.KustomizeVersion,
-		KustomizeOptions: spec.Input.KustomizeOptions,
-		HelmVersion:      spec.Input.HelmVersion,
-		HelmChart:        spec.Input.HelmChart,
-		HelmOptions:      spec.Input.HelmOptions,
-		Logger:           logger,
+		PipedID:                  deploy.PipedID,
+		AppID:                    deploy.ApplicationID,
+		CommitHash:               deploymentSource.CommitHash,
+		AppName:                  deploy.ApplicationName,
+		AppDir:                   deploymentSource.ApplicationDirectory,
+		ConfigFilename:           deploymentSource.ApplicationConfigFilename,
+		Manifests:                spec.Input.Manifests,
+		Namespace:                spec.Input.Namespace,
+		KustomizeVersion:         spec.Input.KustomizeVersion,
+		KustomizeOptions:         spec.Input.KustomizeOptions,
+		HelmVersion:              spec.Input.HelmVersion,
+		HelmChart:                spec.Input.HelmChart,
+		HelmOptions:              spec.Input.HelmOptions,
+		JsonnetOptions:           spec.Input.JsonnetOptions,
+		CueVersion:               spec.Input.CueVersion,
+		CueOptions:               spec.Input.CueOptions,
+		PostRenderers:            spec.Input.PostRenderers,
+		AllowedPostRendererExecs: pluginCfg.AllowedPostRendererExecs,
+		Logger:                   logger,
 	})
 
 	if err != nil {
@@ -146,7 +151,7 @@ func (p *Plugin) loadManifests(ctx context.Context, deploy *sdk.Deployment, spec
 }
 
 // DetermineVersions determines the versions of the application.
-func (p *Plugin) DetermineVersions(ctx context.Context, _ *kubeconfig.KubernetesPluginConfig, input *sdk.DetermineVersionsInput[kubeconfig.KubernetesApplicationSpec]) (*sdk.DetermineVersionsResponse, error) {
+func (p *Plugin) DetermineVersions(ctx context.Context, pluginCfg *kubeconfig.KubernetesPluginConfig, input *sdk.DetermineVersionsInput[kubeconfig.KubernetesApplicationSpec]) (*sdk.DetermineVersionsResponse, error) {
 	logger := input.Logger
 
 	cfg, err := input.Request.DeploymentSource.AppConfig()
@@ -155,7 +160,7 @@ func (p *Plugin) DetermineVersions(ctx context.Context, _ *kubeconfig.Kubernetes
 		return nil, err
 	}
 
-	manifests, err := p.loadManifests(ctx, &input.Request.Deployment, cfg.Spec, &input.Request.DeploymentSource, provider.NewLoader(toolregistry.NewRegistry(input.Client.ToolRegistry())), logger)
+	manifests, err := p.loadManifests(ctx, pluginCfg, &input.Request.Deployment, cfg.Spec, &input.Request.DeploymentSource, provider.NewLoader(toolregistry.NewRegistry(input.Client.ToolRegistry())), logger)
 	if err != nil {
 		logger.Error("Failed while loading manifests", zap.Error(err))
 		return nil, err
@@ -167,7 +172,7 @@ func (p *Plugin) DetermineVersions(ctx context.Context, _ *kubeconfig.Kubernetes
 }
 
 // DetermineStrategy determines the strategy for the deployment.
-func (p *Plugin) DetermineStrategy(ctx context.Context, _ *kubeconfig.KubernetesPluginConfig, input *sdk.DetermineStrategyInput[kubeconfig.KubernetesApplicationSpec]) (*sdk.DetermineStrategyResponse, error) {
+func (p *Plugin) DetermineStrategy(ctx context.Context, pluginCfg *kubeconfig.KubernetesPluginConfig, input *sdk.DetermineStrategyInput[kubeconfig.KubernetesApplicationSpec]) (*sdk.DetermineStrategyResponse, error) {
 	logger := input.Logger
 	loader := provider.NewLoader(toolregistry.NewRegistry(input.Client.ToolRegistry()))
 
@@ -177,13 +182,13 @@ func (p *Plugin) DetermineStrategy(ctx context.Context, _ *kubeconfig.Kubernetes
 		return nil, err
 	}
 
-	runnings, err := p.loadManifests(ctx, &input.Request.Deployment, cfg.Spec, &input.Request.RunningDeploymentSource, loader, logger)
+	runnings, err := p.loadManifests(ctx, pluginCfg, &input.Request.Deployment, cfg.Spec, &input.Request.RunningDeploymentSource, loader, logger)
 	if err != nil {
 		logger.Error("Failed while loading running manifests", zap.Error(err))
 		return nil, err
 	}
 
-	targets, err := p.loadManifests(ctx, &input.Request.Deployment, cfg.Spec, &input.Request.TargetDeploymentSource, loader, logger)
+	targets, err := p.loadManifests(ctx, pluginCfg, &input.Request.Deployment, cfg.Spec, &input.Request.TargetDeploymentSource, loader, logger)
 	if err != nil {
 		logger.Error("Failed while loading target manifests", zap.Error(err))
 		return nil, err
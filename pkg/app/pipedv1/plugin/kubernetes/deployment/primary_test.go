@@ -70,7 +70,7 @@ func TestPlugin_executeK8sPrimaryRolloutStage(t *testing.T) {
 
 	plugin := &Plugin{}
 
-	status := plugin.executeK8sPrimaryRolloutStage(ctx, input, []*sdk.DeployTarget[kubeConfigPkg.KubernetesDeployTargetConfig]{
+	status := plugin.executeK8sPrimaryRolloutStage(ctx, &kubeConfigPkg.KubernetesPluginConfig{}, input, []*sdk.DeployTarget[kubeConfigPkg.KubernetesDeployTargetConfig]{
 		{
 			Name:   "default",
 			Config: *dtConfig,
@@ -138,7 +138,7 @@ func TestPlugin_executeK8sPrimaryRolloutStage_withIstio(t *testing.T) {
 
 		plugin := &Plugin{}
 
-		status := plugin.executeK8sSyncStage(ctx, input, []*sdk.DeployTarget[kubeConfigPkg.KubernetesDeployTargetConfig]{
+		status := plugin.executeK8sSyncStage(ctx, &kubeConfigPkg.KubernetesPluginConfig{}, input, []*sdk.DeployTarget[kubeConfigPkg.KubernetesDeployTargetConfig]{
 			{
 				Name:   "default",
 				Config: *dtConfig,
@@ -184,7 +184,7 @@ func TestPlugin_executeK8sPrimaryRolloutStage_withIstio(t *testing.T) {
 
 		plugin := &Plugin{}
 
-		status := plugin.executeK8sCanaryRolloutStage(ctx, input, []*sdk.DeployTarget[kubeConfigPkg.KubernetesDeployTargetConfig]{
+		status := plugin.executeK8sCanaryRolloutStage(ctx, &kubeConfigPkg.KubernetesPluginConfig{}, input, []*sdk.DeployTarget[kubeConfigPkg.KubernetesDeployTargetConfig]{
 			{
 				Name:   "default",
 				Config: *dtConfig,
@@ -232,7 +232,7 @@ func TestPlugin_executeK8sPrimaryRolloutStage_withIstio(t *testing.T) {
 
 		plugin := &Plugin{}
 
-		status := plugin.executeK8sTrafficRoutingStage(ctx, input, []*sdk.DeployTarget[kubeConfigPkg.KubernetesDeployTargetConfig]{
+		status := plugin.executeK8sTrafficRoutingStage(ctx, &kubeConfigPkg.KubernetesPluginConfig{}, input, []*sdk.DeployTarget[kubeConfigPkg.KubernetesDeployTargetConfig]{
 			{
 				Name:   "default",
 				Config: *dtConfig,
@@ -284,7 +284,7 @@ func TestPlugin_executeK8sPrimaryRolloutStage_withIstio(t *testing.T) {
 
 	plugin := &Plugin{}
 
-	status := plugin.executeK8sPrimaryRolloutStage(ctx, input, []*sdk.DeployTarget[kubeConfigPkg.KubernetesDeployTargetConfig]{
+	status := plugin.executeK8sPrimaryRolloutStage(ctx, &kubeConfigPkg.KubernetesPluginConfig{}, input, []*sdk.DeployTarget[kubeConfigPkg.KubernetesDeployTargetConfig]{
 		{
 			Name:   "default",
 			Config: *dtConfig,
@@ -349,7 +349,7 @@ func TestPlugin_executeK8sPrimaryRolloutStage_withPrune(t *testing.T) {
 		}
 
 		plugin := &Plugin{}
-		status := plugin.executeK8sPrimaryRolloutStage(ctx, runningInput, []*sdk.DeployTarget[kubeConfigPkg.KubernetesDeployTargetConfig]{
+		status := plugin.executeK8sPrimaryRolloutStage(ctx, &kubeConfigPkg.KubernetesPluginConfig{}, runningInput, []*sdk.DeployTarget[kubeConfigPkg.KubernetesDeployTargetConfig]{
 			{
 				Name:   "default",
 				Config: *dtConfig,
@@ -398,7 +398,7 @@ func TestPlugin_executeK8sPrimaryRolloutStage_withPrune(t *testing.T) {
 		}
 
 		plugin := &Plugin{}
-		status := plugin.executeK8sPrimaryRolloutStage(ctx, targetInput, []*sdk.DeployTarget[kubeConfigPkg.KubernetesDeployTargetConfig]{
+		status := plugin.executeK8sPrimaryRolloutStage(ctx, &kubeConfigPkg.KubernetesPluginConfig{}, targetInput, []*sdk.DeployTarget[kubeConfigPkg.KubernetesDeployTargetConfig]{
 			{
 				Name:   "default",
 				Config: *dtConfig,
@@ -67,7 +67,7 @@ func TestPlugin_executeK8sRollbackStage_NoPreviousDeployment(t *testing.T) {
 	}
 
 	plugin := &Plugin{}
-	status := plugin.executeK8sRollbackStage(t.Context(), input, []*sdk.DeployTarget[kubeConfigPkg.KubernetesDeployTargetConfig]{
+	status := plugin.executeK8sRollbackStage(t.Context(), &kubeConfigPkg.KubernetesPluginConfig{}, input, []*sdk.DeployTarget[kubeConfigPkg.KubernetesDeployTargetConfig]{
 		{
 			Name:   "default",
 			Config: *dtConfig,
@@ -116,7 +116,7 @@ func TestPlugin_executeK8sRollbackStage_SuccessfulRollback(t *testing.T) {
 	}
 
 	plugin := &Plugin{}
-	status := plugin.executeK8sRollbackStage(t.Context(), input, []*sdk.DeployTarget[kubeConfigPkg.KubernetesDeployTargetConfig]{
+	status := plugin.executeK8sRollbackStage(t.Context(), &kubeConfigPkg.KubernetesPluginConfig{}, input, []*sdk.DeployTarget[kubeConfigPkg.KubernetesDeployTargetConfig]{
 		{
 			Name:   "default",
 			Config: *dtConfig,
@@ -182,7 +182,7 @@ func TestPlugin_executeK8sRollbackStage_WithVariantLabels(t *testing.T) {
 	}
 
 	plugin := &Plugin{}
-	status := plugin.executeK8sRollbackStage(t.Context(), input, []*sdk.DeployTarget[kubeConfigPkg.KubernetesDeployTargetConfig]{
+	status := plugin.executeK8sRollbackStage(t.Context(), &kubeConfigPkg.KubernetesPluginConfig{}, input, []*sdk.DeployTarget[kubeConfigPkg.KubernetesDeployTargetConfig]{
 		{
 			Name:   "default",
 			Config: *dtConfig,
@@ -271,7 +271,7 @@ func TestPlugin_executeK8sRollbackStage_PrunesCanaryAndBaselineVariants(t *testi
 			Client: sdk.NewClient(nil, "kubernetes", "", "", logpersistertest.NewTestLogPersister(t), testRegistry),
 			Logger: zaptest.NewLogger(t),
 		}
-		status, err := plugin.ExecuteStage(ctx, nil, deployTargets, baselineInput)
+		status, err := plugin.ExecuteStage(ctx, &kubeConfigPkg.KubernetesPluginConfig{}, deployTargets, baselineInput)
 		require.NoError(t, err)
 		assert.Equal(t, successResponse, status)
 
@@ -320,7 +320,7 @@ func TestPlugin_executeK8sRollbackStage_PrunesCanaryAndBaselineVariants(t *testi
 			Client: sdk.NewClient(nil, "kubernetes", "", "", logpersistertest.NewTestLogPersister(t), testRegistry),
 			Logger: zaptest.NewLogger(t),
 		}
-		status, err := plugin.ExecuteStage(ctx, nil, deployTargets, canaryInput)
+		status, err := plugin.ExecuteStage(ctx, &kubeConfigPkg.KubernetesPluginConfig{}, deployTargets, canaryInput)
 		require.NoError(t, err)
 		assert.Equal(t, successResponse, status)
 
@@ -358,7 +358,7 @@ func TestPlugin_executeK8sRollbackStage_PrunesCanaryAndBaselineVariants(t *testi
 			Client: sdk.NewClient(nil, "kubernetes", "", "", logpersistertest.NewTestLogPersister(t), testRegistry),
 			Logger: zaptest.NewLogger(t),
 		}
-		status, err := plugin.ExecuteStage(ctx, nil, deployTargets, primaryInput)
+		status, err := plugin.ExecuteStage(ctx, &kubeConfigPkg.KubernetesPluginConfig{}, deployTargets, primaryInput)
 		require.NoError(t, err)
 		assert.Equal(t, successResponse, status)
 
@@ -400,7 +400,7 @@ func TestPlugin_executeK8sRollbackStage_PrunesCanaryAndBaselineVariants(t *testi
 			Client: sdk.NewClient(nil, "kubernetes", "", "", logpersistertest.NewTestLogPersister(t), testRegistry),
 			Logger: zaptest.NewLogger(t),
 		}
-		status, err := plugin.ExecuteStage(ctx, nil, deployTargets, rollbackInput)
+		status, err := plugin.ExecuteStage(ctx, &kubeConfigPkg.KubernetesPluginConfig{}, deployTargets, rollbackInput)
 		require.NoError(t, err)
 		assert.Equal(t, successResponse, status)
 
@@ -29,7 +29,7 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/toolregistry"
 )
 
-func (p *Plugin) executeK8sTrafficRoutingStage(ctx context.Context, input *sdk.ExecuteStageInput[kubeconfig.KubernetesApplicationSpec], dts []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]) sdk.StageStatus {
+func (p *Plugin) executeK8sTrafficRoutingStage(ctx context.Context, pluginCfg *kubeconfig.KubernetesPluginConfig, input *sdk.ExecuteStageInput[kubeconfig.KubernetesApplicationSpec], dts []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]) sdk.StageStatus {
 	lp := input.Client.LogPersister()
 	lp.Info("Start routing the traffic")
 
@@ -41,16 +41,16 @@ func (p *Plugin) executeK8sTrafficRoutingStage(ctx context.Context, input *sdk.E
 
 	switch kubeconfig.DetermineKubernetesTrafficRoutingMethod(cfg.Spec.TrafficRouting) {
 	case kubeconfig.KubernetesTrafficRoutingMethodPodSelector:
-		return p.executeK8sTrafficRoutingStagePodSelector(ctx, input, dts, cfg)
+		return p.executeK8sTrafficRoutingStagePodSelector(ctx, pluginCfg, input, dts, cfg)
 	case kubeconfig.KubernetesTrafficRoutingMethodIstio:
-		return p.executeK8sTrafficRoutingStageIstio(ctx, input, dts, cfg)
+		return p.executeK8sTrafficRoutingStageIstio(ctx, pluginCfg, input, dts, cfg)
 	default:
 		lp.Errorf("Unknown traffic routing method: %s", cfg.Spec.TrafficRouting.Method)
 		return sdk.StageStatusFailure
 	}
 }
 
-func (p *Plugin) executeK8sTrafficRoutingStagePodSelector(ctx context.Context, input *sdk.ExecuteStageInput[kubeconfig.KubernetesApplicationSpec], dts []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig], cfg *sdk.ApplicationConfig[kubeconfig.KubernetesApplicationSpec]) sdk.StageStatus {
+func (p *Plugin) executeK8sTrafficRoutingStagePodSelector(ctx context.Context, pluginCfg *kubeconfig.KubernetesPluginConfig, input *sdk.ExecuteStageInput[kubeconfig.KubernetesApplicationSpec], dts []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig], cfg *sdk.ApplicationConfig[kubeconfig.KubernetesApplicationSpec]) sdk.StageStatus {
 	lp := input.Client.LogPersister()
 
 	// 1. Parse stage configuration
@@ -91,7 +91,7 @@ func (p *Plugin) executeK8sTrafficRoutingStagePodSelector(ctx context.Context, i
 
 	// 5. Load manifests
 	lp.Infof("Loading manifests at commit %s", input.Request.TargetDeploymentSource.CommitHash)
-	manifests, err := p.loadManifests(ctx, &input.Request.Deployment, cfg.Spec,
+	manifests, err := p.loadManifests(ctx, pluginCfg, &input.Request.Deployment, cfg.Spec,
 		&input.Request.TargetDeploymentSource, loader, input.Logger)
 	if err != nil {
 		lp.Errorf("Failed while loading manifests (%v)", err)
@@ -162,7 +162,7 @@ func (p *Plugin) executeK8sTrafficRoutingStagePodSelector(ctx context.Context, i
 	return sdk.StageStatusSuccess
 }
 
-func (p *Plugin) executeK8sTrafficRoutingStageIstio(ctx context.Context, input *sdk.ExecuteStageInput[kubeconfig.KubernetesApplicationSpec], dts []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig], cfg *sdk.ApplicationConfig[kubeconfig.KubernetesApplicationSpec]) sdk.StageStatus {
+func (p *Plugin) executeK8sTrafficRoutingStageIstio(ctx context.Context, pluginCfg *kubeconfig.KubernetesPluginConfig, input *sdk.ExecuteStageInput[kubeconfig.KubernetesApplicationSpec], dts []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig], cfg *sdk.ApplicationConfig[kubeconfig.KubernetesApplicationSpec]) sdk.StageStatus {
 	lp := input.Client.LogPersister()
 
 	var stageCfg kubeconfig.K8sTrafficRoutingStageOptions
@@ -179,7 +179,7 @@ func (p *Plugin) executeK8sTrafficRoutingStageIstio(ctx context.Context, input *
 	loader := provider.NewLoader(toolRegistry)
 
 	lp.Infof("Loading manifests at commit %s", input.Request.TargetDeploymentSource.CommitHash)
-	manifests, err := p.loadManifests(ctx, &input.Request.Deployment, cfg.Spec,
+	manifests, err := p.loadManifests(ctx, pluginCfg, &input.Request.Deployment, cfg.Spec,
 		&input.Request.TargetDeploymentSource, loader, input.Logger)
 	if err != nil {
 		lp.Errorf("Failed while loading manifests (%v)", err)
@@ -141,7 +141,7 @@ func installIstioCRDs(t *testing.T, dtConfig *kubeconfig.KubernetesDeployTargetC
 	}
 
 	plugin := &Plugin{}
-	status := plugin.executeK8sSyncStage(t.Context(), input, []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]{
+	status := plugin.executeK8sSyncStage(t.Context(), &kubeconfig.KubernetesPluginConfig{}, input, []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]{
 		{
 			Name:   "default",
 			Config: *dtConfig,
@@ -176,7 +176,7 @@ func applyServiceByK8sSync(t *testing.T, ctx context.Context, testdataDir string
 	}
 
 	plugin := &Plugin{}
-	status := plugin.executeK8sSyncStage(ctx, applyInput, []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]{
+	status := plugin.executeK8sSyncStage(ctx, &kubeconfig.KubernetesPluginConfig{}, applyInput, []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]{
 		{
 			Name:   "default",
 			Config: *dtConfig,
@@ -363,7 +363,7 @@ func TestPlugin_executeK8sTrafficRoutingStagePodSelector(t *testing.T) {
 				},
 			}
 
-			status := plugin.executeK8sTrafficRoutingStagePodSelector(ctx, input, deployTargets, appCfg)
+			status := plugin.executeK8sTrafficRoutingStagePodSelector(ctx, &kubeconfig.KubernetesPluginConfig{}, input, deployTargets, appCfg)
 			assert.Equal(t, tc.expectedStatus, status)
 
 			// Run verification if provided
@@ -404,7 +404,7 @@ func TestPlugin_executeK8sTrafficRoutingStagePodSelector_InvalidInputs(t *testin
 			appCfg := sdk.LoadApplicationConfigForTest[kubeconfig.KubernetesApplicationSpec](t, filepath.Join("testdata", "traffic_routing_pod_selector", "app.pipecd.yaml"), "kubernetes")
 
 			plugin := &Plugin{}
-			status := plugin.executeK8sTrafficRoutingStagePodSelector(t.Context(), &sdk.ExecuteStageInput[kubeconfig.KubernetesApplicationSpec]{
+			status := plugin.executeK8sTrafficRoutingStagePodSelector(t.Context(), &kubeconfig.KubernetesPluginConfig{}, &sdk.ExecuteStageInput[kubeconfig.KubernetesApplicationSpec]{
 				Request: sdk.ExecuteStageRequest[kubeconfig.KubernetesApplicationSpec]{
 					StageConfig: tc.stageCfg,
 					TargetDeploymentSource: sdk.DeploymentSource[kubeconfig.KubernetesApplicationSpec]{
@@ -1698,7 +1698,7 @@ func TestPlugin_executeK8sTrafficRoutingStageIstio(t *testing.T) {
 				},
 			}
 
-			status := plugin.executeK8sTrafficRoutingStageIstio(ctx, input, deployTargets, appCfg)
+			status := plugin.executeK8sTrafficRoutingStageIstio(ctx, &kubeconfig.KubernetesPluginConfig{}, input, deployTargets, appCfg)
 			assert.Equal(t, tc.expectedStatus, status)
 
 			// Run verification if provided
@@ -1740,7 +1740,7 @@ func TestPlugin_executeK8sTrafficRoutingStageIstio_InvalidInputs(t *testing.T) {
 			appCfg := sdk.LoadApplicationConfigForTest[kubeconfig.KubernetesApplicationSpec](t, filepath.Join("testdata", "traffic_routing_istio", "app.pipecd.yaml"), "kubernetes")
 
 			plugin := &Plugin{}
-			status := plugin.executeK8sTrafficRoutingStageIstio(t.Context(), &sdk.ExecuteStageInput[kubeconfig.KubernetesApplicationSpec]{
+			status := plugin.executeK8sTrafficRoutingStageIstio(t.Context(), &kubeconfig.KubernetesPluginConfig{}, &sdk.ExecuteStageInput[kubeconfig.KubernetesApplicationSpec]{
 				Request: sdk.ExecuteStageRequest[kubeconfig.KubernetesApplicationSpec]{
 					StageConfig: tc.stageCfg,
 					TargetDeploymentSource: sdk.DeploymentSource[kubeconfig.KubernetesApplicationSpec]{
@@ -57,7 +57,7 @@ func (p *Plugin) Initialize(ctx context.Context, input *sdk.InitializeInput[kube
 }
 
 // GetLivestate implements sdk.LivestatePlugin.
-func (p *Plugin) GetLivestate(ctx context.Context, _ *kubeconfig.KubernetesPluginConfig, deployTargets []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig], input *sdk.GetLivestateInput[kubeconfig.KubernetesApplicationSpec]) (*sdk.GetLivestateResponse, error) {
+func (p *Plugin) GetLivestate(ctx context.Context, pluginCfg *kubeconfig.KubernetesPluginConfig, deployTargets []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig], input *sdk.GetLivestateInput[kubeconfig.KubernetesApplicationSpec]) (*sdk.GetLivestateResponse, error) {
 	if len(deployTargets) != 1 {
 		return nil, fmt.Errorf("only 1 deploy target is allowed but got %d", len(deployTargets))
 	}
@@ -86,7 +86,7 @@ func (p *Plugin) GetLivestate(ctx context.Context, _ *kubeconfig.KubernetesPlugi
 	// Currently, we create them every time the stage is executed beucause we can't pass input.Client.toolRegistry to the plugin when starting the plugin.
 	toolRegistry := toolregistry.NewRegistry(input.Client.ToolRegistry())
 
-	manifests, err := p.loadManifests(ctx, input, cfg.Spec, provider.NewLoader(toolRegistry), input.Logger)
+	manifests, err := p.loadManifests(ctx, pluginCfg, input, cfg.Spec, provider.NewLoader(toolRegistry), input.Logger)
 	if err != nil {
 		input.Logger.Error("Failed to load manifests", zap.Error(err))
 		return nil, err
@@ -174,6 +174,10 @@ func filterIgnoringManifests(manifests []provider.Manifest) []provider.Manifest
 		if annotations[provider.LabelIgnoreDriftDirection] == provider.IgnoreDriftDetectionTrue {
 			continue
 		}
+		// Helm hooks are created and deleted while deploying, so they are not the target of drift detection.
+		if m.IsHelmHook() {
+			continue
+		}
 		out = append(out, m)
 	}
 	return out
@@ -201,22 +205,27 @@ type loader interface {
 }
 
 // TODO: share this implementation with the deployment plugin
-func (p *Plugin) loadManifests(ctx context.Context, input *sdk.GetLivestateInput[kubeconfig.KubernetesApplicationSpec], spec *kubeconfig.KubernetesApplicationSpec, loader loader, logger *zap.Logger) ([]provider.Manifest, error) {
+func (p *Plugin) loadManifests(ctx context.Context, pluginCfg *kubeconfig.KubernetesPluginConfig, input *sdk.GetLivestateInput[kubeconfig.KubernetesApplicationSpec], spec *kubeconfig.KubernetesApplicationSpec, loader loader, logger *zap.Logger) ([]provider.Manifest, error) {
 	manifests, err := loader.LoadManifests(ctx, provider.LoaderInput{
-		PipedID:          input.Request.PipedID,
-		AppID:            input.Request.ApplicationID,
-		CommitHash:       input.Request.DeploymentSource.CommitHash,
-		AppName:          input.Request.ApplicationName,
-		AppDir:           input.Request.DeploymentSource.ApplicationDirectory,
-		ConfigFilename:   input.Request.DeploymentSource.ApplicationConfigFilename,
-		Manifests:        spec.Input.Manifests,
-		Namespace:        spec.Input.Namespace,
-		KustomizeVersion: spec.Input.KustomizeVersion,
-		KustomizeOptions: spec.Input.KustomizeOptions,
-		HelmVersion:      spec.Input.HelmVersion,
-		HelmChart:        spec.Input.HelmChart,
-		HelmOptions:      spec.Input.HelmOptions,
-		Logger:           logger,
+		PipedID:                  input.Request.PipedID,
+		AppID:                    input.Request.ApplicationID,
+		CommitHash:               input.Request.DeploymentSource.CommitHash,
+		AppName:                  input.Request.ApplicationName,
+		AppDir:                   input.Request.DeploymentSource.ApplicationDirectory,
+		ConfigFilename:           input.Request.DeploymentSource.ApplicationConfigFilename,
+		Manifests:                spec.Input.Manifests,
+		Namespace:                spec.Input.Namespace,
+		KustomizeVersion:         spec.Input.KustomizeVersion,
+		KustomizeOptions:         spec.Input.KustomizeOptions,
+		HelmVersion:              spec.Input.HelmVersion,
+		HelmChart:                spec.Input.HelmChart,
+		HelmOptions:              spec.Input.HelmOptions,
+		JsonnetOptions:           spec.Input.JsonnetOptions,
+		CueVersion:               spec.Input.CueVersion,
+		CueOptions:               spec.Input.CueOptions,
+		PostRenderers:            spec.Input.PostRenderers,
+		AllowedPostRendererExecs: pluginCfg.AllowedPostRendererExecs,
+		Logger:                   logger,
 	})
 
 	if err != nil {
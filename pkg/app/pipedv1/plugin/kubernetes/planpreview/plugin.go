@@ -32,7 +32,7 @@ var (
 
 type Plugin struct{}
 
-func (p *Plugin) GetPlanPreview(ctx context.Context, _ *kubeconfig.KubernetesPluginConfig, dts []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig], input *sdk.GetPlanPreviewInput[kubeconfig.KubernetesApplicationSpec]) (*sdk.GetPlanPreviewResponse, error) {
+func (p *Plugin) GetPlanPreview(ctx context.Context, pluginCfg *kubeconfig.KubernetesPluginConfig, dts []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig], input *sdk.GetPlanPreviewInput[kubeconfig.KubernetesApplicationSpec]) (*sdk.GetPlanPreviewResponse, error) {
 	toolRegistry := toolregistry.NewRegistry(input.Client.ToolRegistry())
 	loader := provider.NewLoader(toolRegistry)
 
@@ -51,23 +51,28 @@ func (p *Plugin) GetPlanPreview(ctx context.Context, _ *kubeconfig.KubernetesPlu
 	tagetSpec := targetAppCfg.Spec
 
 	newManifests, err = loader.LoadManifests(ctx, provider.LoaderInput{
-		PipedID:          input.Request.PipedID,
-		AppID:            input.Request.ApplicationID,
-		CommitHash:       targetDS.CommitHash,
-		AppName:          input.Request.ApplicationName,
-		AppDir:           targetDS.ApplicationDirectory,
-		ConfigFilename:   targetDS.ApplicationConfigFilename,
-		Manifests:        tagetSpec.Input.Manifests,
-		Namespace:        tagetSpec.Input.Namespace,
-		KustomizeVersion: tagetSpec.Input.KustomizeVersion,
-		KustomizeOptions: tagetSpec.Input.KustomizeOptions,
-		HelmVersion:      tagetSpec.Input.HelmVersion,
-		HelmChart:        tagetSpec.Input.HelmChart,
-		HelmOptions:      tagetSpec.Input.HelmOptions,
-		Logger:           input.Logger,
+		PipedID:                  input.Request.PipedID,
+		AppID:                    input.Request.ApplicationID,
+		CommitHash:               targetDS.CommitHash,
+		AppName:                  input.Request.ApplicationName,
+		AppDir:                   targetDS.ApplicationDirectory,
+		ConfigFilename:           targetDS.ApplicationConfigFilename,
+		Manifests:                tagetSpec.Input.Manifests,
+		Namespace:                tagetSpec.Input.Namespace,
+		KustomizeVersion:         tagetSpec.Input.KustomizeVersion,
+		KustomizeOptions:         tagetSpec.Input.KustomizeOptions,
+		HelmVersion:              tagetSpec.Input.HelmVersion,
+		HelmChart:                tagetSpec.Input.HelmChart,
+		HelmOptions:              tagetSpec.Input.HelmOptions,
+		JsonnetOptions:           tagetSpec.Input.JsonnetOptions,
+		CueVersion:               tagetSpec.Input.CueVersion,
+		CueOptions:               tagetSpec.Input.CueOptions,
+		PostRenderers:            tagetSpec.Input.PostRenderers,
+		AllowedPostRendererExecs: pluginCfg.AllowedPostRendererExecs,
+		Logger:                   input.Logger,
 	})
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to load manifests at commit %s: %w", targetDS.CommitHash, err)
 	}
 
 	runningDS := input.Request.RunningDeploymentSource
@@ -78,23 +83,28 @@ func (p *Plugin) GetPlanPreview(ctx context.Context, _ *kubeconfig.KubernetesPlu
 		}
 		runningSpec := runningAppCfg.Spec
 		oldManifests, err = loader.LoadManifests(ctx, provider.LoaderInput{
-			PipedID:          input.Request.PipedID,
-			AppID:            input.Request.ApplicationID,
-			CommitHash:       runningDS.CommitHash,
-			AppName:          input.Request.ApplicationName,
-			AppDir:           runningDS.ApplicationDirectory,
-			ConfigFilename:   runningDS.ApplicationConfigFilename,
-			Manifests:        runningSpec.Input.Manifests,
-			Namespace:        runningSpec.Input.Namespace,
-			KustomizeVersion: runningSpec.Input.KustomizeVersion,
-			KustomizeOptions: runningSpec.Input.KustomizeOptions,
-			HelmVersion:      runningSpec.Input.HelmVersion,
-			HelmChart:        runningSpec.Input.HelmChart,
-			HelmOptions:      runningSpec.Input.HelmOptions,
-			Logger:           input.Logger,
+			PipedID:                  input.Request.PipedID,
+			AppID:                    input.Request.ApplicationID,
+			CommitHash:               runningDS.CommitHash,
+			AppName:                  input.Request.ApplicationName,
+			AppDir:                   runningDS.ApplicationDirectory,
+			ConfigFilename:           runningDS.ApplicationConfigFilename,
+			Manifests:                runningSpec.Input.Manifests,
+			Namespace:                runningSpec.Input.Namespace,
+			KustomizeVersion:         runningSpec.Input.KustomizeVersion,
+			KustomizeOptions:         runningSpec.Input.KustomizeOptions,
+			HelmVersion:              runningSpec.Input.HelmVersion,
+			HelmChart:                runningSpec.Input.HelmChart,
+			HelmOptions:              runningSpec.Input.HelmOptions,
+			JsonnetOptions:           runningSpec.Input.JsonnetOptions,
+			CueVersion:               runningSpec.Input.CueVersion,
+			CueOptions:               runningSpec.Input.CueOptions,
+			PostRenderers:            runningSpec.Input.PostRenderers,
+			AllowedPostRendererExecs: pluginCfg.AllowedPostRendererExecs,
+			Logger:                   input.Logger,
 		})
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("failed to load manifests at commit %s: %w", runningDS.CommitHash, err)
 		}
 	}
 
@@ -0,0 +1,96 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/config"
+)
+
+type Cue struct {
+	execPath string
+	logger   *zap.Logger
+}
+
+func NewCue(path string, logger *zap.Logger) *Cue {
+	return &Cue{
+		execPath: path,
+		logger:   logger,
+	}
+}
+
+// Template exports the CUE packages in the application directory and returns the result as JSON.
+func (c *Cue) Template(ctx context.Context, appName, appDir string, opts *config.InputCueOptions) (string, error) {
+	for _, p := range opts.Packages {
+		if err := verifyCuePackagePath(appDir, p); err != nil {
+			return "", err
+		}
+	}
+
+	args := []string{"export", "--out", "json"}
+	if opts.Expression != "" {
+		args = append(args, "--expression", opts.Expression)
+	}
+	for _, k := range slices.Sorted(maps.Keys(opts.Tags)) {
+		args = append(args, "--inject", fmt.Sprintf("%s=%s", k, opts.Tags[k]))
+	}
+	// The packages are placed after "--" so that none of them is parsed as a flag.
+	if len(opts.Packages) > 0 {
+		args = append(args, "--")
+		args = append(args, opts.Packages...)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, c.execPath, args...)
+	cmd.Dir = appDir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	c.logger.Info(fmt.Sprintf("start templating a CUE application %s", appName),
+		zap.Any("args", args),
+	)
+
+	if err := cmd.Run(); err != nil {
+		return stdout.String(), fmt.Errorf("%w: %s", err, stderr.String())
+	}
+	return stdout.String(), nil
+}
+
+// verifyCuePackagePath checks the given package is placed in the application directory
+// if it is specified as a file or a directory, e.g. ./deploy/..., ./deploy:app or app.cue.
+// Import paths like example.com/app are resolved by the CUE module, so they are not checked.
+// Packages starting with "-" are rejected since they look like flags of cue.
+func verifyCuePackagePath(appDir, pkg string) error {
+	if strings.HasPrefix(pkg, "-") {
+		return fmt.Errorf("invalid CUE package %s: must not start with -", pkg)
+	}
+	if !strings.HasPrefix(pkg, ".") && !filepath.IsAbs(pkg) && !strings.HasSuffix(pkg, ".cue") {
+		return nil
+	}
+	path, _, _ := strings.Cut(pkg, ":")
+	path = strings.TrimSuffix(path, "/...")
+	_, err := resolvePathInAppDir(appDir, path)
+	return err
+}
@@ -0,0 +1,126 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/config"
+)
+
+// writeFakeTool writes an executable script which runs the given shell commands.
+func writeFakeTool(t *testing.T, commands string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "tool")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+commands+"\n"), 0755))
+	return path
+}
+
+func TestCue_Template(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name string
+		opts *config.InputCueOptions
+		want []string
+	}{
+		{
+			name: "default",
+			opts: &config.InputCueOptions{},
+			want: []string{"export", "--out", "json"},
+		},
+		{
+			name: "with all options",
+			opts: &config.InputCueOptions{
+				Packages:   []string{"./deploy", "./config"},
+				Expression: "objects",
+				Tags:       map[string]string{"env": "prod", "cluster": "tokyo"},
+			},
+			want: []string{
+				"export",
+				"--out", "json",
+				"--expression", "objects",
+				"--inject", "cluster=tokyo",
+				"--inject", "env=prod",
+				"--", "./deploy", "./config",
+			},
+		},
+	}
+
+	// The fake cue prints the given arguments one per line.
+	path := writeFakeTool(t, `printf '%s\n' "$@"`)
+	c := NewCue(path, zap.NewNop())
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			out, err := c.Template(context.Background(), "app", t.TempDir(), tc.opts)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, strings.Fields(out))
+		})
+	}
+}
+
+func TestCue_Template_Error(t *testing.T) {
+	t.Parallel()
+
+	path := writeFakeTool(t, `echo "objects.deployment.spec.replicas: conflicting values 2 and 3" >&2; exit 1`)
+	c := NewCue(path, zap.NewNop())
+
+	_, err := c.Template(context.Background(), "app", t.TempDir(), &config.InputCueOptions{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "conflicting values 2 and 3")
+}
+
+func TestCue_Template_PackageOutsideAppDir(t *testing.T) {
+	t.Parallel()
+
+	path := writeFakeTool(t, `printf '%s\n' "$@"`)
+	c := NewCue(path, zap.NewNop())
+
+	testcases := []struct {
+		name    string
+		pkg     string
+		wantErr bool
+	}{
+		{name: "package in the application directory", pkg: "./deploy/..."},
+		{name: "import path", pkg: "example.com/app"},
+		{name: "parent directory", pkg: "../other", wantErr: true},
+		{name: "parent directory with qualifier", pkg: "../other:app", wantErr: true},
+		{name: "absolute path", pkg: "/etc/app.cue", wantErr: true},
+		{name: "long flag", pkg: "--outfile=/etc/x", wantErr: true},
+		{name: "short flag", pkg: "-o/etc/x", wantErr: true},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := c.Template(context.Background(), "app", t.TempDir(), &config.InputCueOptions{
+				Packages: []string{tc.pkg},
+			})
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/google/go-jsonnet"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/config"
+)
+
+const defaultJsonnetFile = "main.jsonnet"
+
+type Jsonnet struct {
+	logger *zap.Logger
+}
+
+func NewJsonnet(logger *zap.Logger) *Jsonnet {
+	return &Jsonnet{
+		logger: logger,
+	}
+}
+
+// Template evaluates the Jsonnet file in the application directory and returns the result as JSON.
+// The file and all files imported by it must be placed in the application directory.
+func (j *Jsonnet) Template(appName, appDir string, opts *config.InputJsonnetOptions) (string, error) {
+	file, err := resolvePathInAppDir(appDir, cmp.Or(opts.File, defaultJsonnetFile))
+	if err != nil {
+		return "", err
+	}
+	libPaths := make([]string, 0, len(opts.LibPaths))
+	for _, p := range opts.LibPaths {
+		lp, err := resolvePathInAppDir(appDir, p)
+		if err != nil {
+			return "", err
+		}
+		libPaths = append(libPaths, lp)
+	}
+
+	vm := jsonnet.MakeVM()
+	vm.Importer(&appDirImporter{
+		appDir:   appDir,
+		libPaths: libPaths,
+		cache:    make(map[string]*jsonnet.Contents),
+	})
+	for k, v := range opts.ExtVars {
+		vm.ExtVar(k, v)
+	}
+	for k, v := range opts.ExtCode {
+		vm.ExtCode(k, v)
+	}
+	for k, v := range opts.TLAVars {
+		vm.TLAVar(k, v)
+	}
+	for k, v := range opts.TLACode {
+		vm.TLACode(k, v)
+	}
+
+	j.logger.Info(fmt.Sprintf("start templating a Jsonnet application %s", appName),
+		zap.String("file", file),
+		zap.Strings("lib-paths", libPaths),
+	)
+
+	return vm.EvaluateFile(file)
+}
+
+// appDirImporter imports the files relative to the importing file or the library paths
+// like the jsonnet command does, but only from the application directory.
+type appDirImporter struct {
+	appDir   string
+	libPaths []string
+	// A map from the absolute path to the contents of the file.
+	// A nil value means the file does not exist.
+	cache map[string]*jsonnet.Contents
+}
+
+func (i *appDirImporter) Import(importedFrom, importedPath string) (jsonnet.Contents, string, error) {
+	dirs := make([]string, 0, len(i.libPaths)+1)
+	dirs = append(dirs, filepath.Dir(importedFrom))
+	// The library paths are searched from the last one like the jsonnet command does.
+	for k := len(i.libPaths) - 1; k >= 0; k-- {
+		dirs = append(dirs, i.libPaths[k])
+	}
+
+	for _, dir := range dirs {
+		path := importedPath
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+		path, err := resolvePathInAppDir(i.appDir, path)
+		if err != nil {
+			return jsonnet.Contents{}, "", fmt.Errorf("failed to import %q: %w", importedPath, err)
+		}
+
+		contents, ok := i.cache[path]
+		if !ok {
+			data, err := os.ReadFile(path)
+			switch {
+			case err == nil:
+				c := jsonnet.MakeContentsRaw(data)
+				contents = &c
+			case errors.Is(err, os.ErrNotExist):
+			default:
+				return jsonnet.Contents{}, "", err
+			}
+			i.cache[path] = contents
+		}
+		if contents != nil {
+			return *contents, path, nil
+		}
+		if filepath.IsAbs(importedPath) {
+			break
+		}
+	}
+	return jsonnet.Contents{}, "", fmt.Errorf("couldn't open import %q: no match locally or in the Jsonnet library paths", importedPath)
+}
@@ -0,0 +1,159 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/config"
+)
+
+// writeFiles writes the given files under the given directory.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+}
+
+func TestJsonnet_Template(t *testing.T) {
+	t.Parallel()
+
+	appDir := t.TempDir()
+	writeFiles(t, appDir, map[string]string{
+		"app.jsonnet": `
+local lib = import 'lib.libsonnet';
+function(image, debug) {
+  apiVersion: 'v1',
+  kind: 'ConfigMap',
+  metadata: { name: lib.name(std.extVar('env'), std.extVar('cluster')) },
+  data: {
+    image: image,
+    debug: std.toString(debug),
+    replicas: std.toString(std.extVar('replicas')),
+    config: importstr 'config.txt',
+  },
+}
+`,
+		"config.txt": "key=value",
+		// The library in the last path takes precedence.
+		"vendor/lib.libsonnet": `{ name(env, cluster):: 'vendor' }`,
+		"lib/lib.libsonnet":    `{ name(env, cluster):: env + '-' + cluster }`,
+	})
+
+	j := NewJsonnet(zap.NewNop())
+	out, err := j.Template("app", appDir, &config.InputJsonnetOptions{
+		File:     "app.jsonnet",
+		LibPaths: []string{"vendor", "lib"},
+		ExtVars:  map[string]string{"env": "prod", "cluster": "tokyo"},
+		ExtCode:  map[string]string{"replicas": "3"},
+		TLAVars:  map[string]string{"image": "nginx"},
+		TLACode:  map[string]string{"debug": "false"},
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+  "apiVersion": "v1",
+  "kind": "ConfigMap",
+  "metadata": {"name": "prod-tokyo"},
+  "data": {"image": "nginx", "debug": "false", "replicas": "3", "config": "key=value"}
+}`, out)
+}
+
+func TestJsonnet_Template_DefaultFile(t *testing.T) {
+	t.Parallel()
+
+	appDir := t.TempDir()
+	writeFiles(t, appDir, map[string]string{
+		"main.jsonnet": `[{ apiVersion: 'v1', kind: 'Namespace', metadata: { name: 'app' } }]`,
+	})
+
+	j := NewJsonnet(zap.NewNop())
+	out, err := j.Template("app", appDir, &config.InputJsonnetOptions{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"apiVersion": "v1", "kind": "Namespace", "metadata": {"name": "app"}}]`, out)
+}
+
+func TestJsonnet_Template_Error(t *testing.T) {
+	t.Parallel()
+
+	appDir := t.TempDir()
+	writeFiles(t, appDir, map[string]string{
+		"main.jsonnet": `{ image: std.extVar('image') }`,
+	})
+
+	j := NewJsonnet(zap.NewNop())
+	_, err := j.Template("app", appDir, &config.InputJsonnetOptions{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Undefined external variable: image")
+}
+
+func TestJsonnet_Template_OutsideAppDir(t *testing.T) {
+	t.Parallel()
+
+	outside := t.TempDir()
+	writeFiles(t, outside, map[string]string{
+		"secret.libsonnet": `{ password: 'secret' }`,
+		"main.jsonnet":     `{}`,
+	})
+
+	testcases := []struct {
+		name  string
+		files map[string]string
+		opts  *config.InputJsonnetOptions
+	}{
+		{
+			name: "file outside the application directory",
+			opts: &config.InputJsonnetOptions{File: filepath.Join(outside, "main.jsonnet")},
+		},
+		{
+			name:  "library path outside the application directory",
+			files: map[string]string{"main.jsonnet": `{}`},
+			opts:  &config.InputJsonnetOptions{LibPaths: []string{outside}},
+		},
+		{
+			name:  "relative import outside the application directory",
+			files: map[string]string{"main.jsonnet": `import '../` + filepath.Base(outside) + `/secret.libsonnet'`},
+			opts:  &config.InputJsonnetOptions{},
+		},
+		{
+			name:  "absolute import",
+			files: map[string]string{"main.jsonnet": `importstr '/etc/hostname'`},
+			opts:  &config.InputJsonnetOptions{},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			appDir := filepath.Join(t.TempDir(), "app")
+			require.NoError(t, os.Mkdir(appDir, 0755))
+			writeFiles(t, appDir, tc.files)
+
+			j := NewJsonnet(zap.NewNop())
+			_, err := j.Template("app", appDir, tc.opts)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "outside the application configuration directory")
+		})
+	}
+}
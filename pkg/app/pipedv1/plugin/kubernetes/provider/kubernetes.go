@@ -36,6 +36,7 @@ const (
 	// annotations
 	AnnotationOrder      = "pipecd.dev/order"       // The order number of resource used to sort them before using.
 	AnnotationConfigHash = "pipecd.dev/config-hash" // The hash value of all mouting config resources.
+	AnnotationSyncWave   = "pipecd.dev/sync-wave"   // The wave number of resource. Resources are applied wave by wave in ascending order.
 
 	// label/annotation values
 	ManagedByPiped           = "piped"
@@ -15,9 +15,12 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io/fs"
+	"maps"
 	"os"
 	"path/filepath"
 	"slices"
@@ -36,6 +39,8 @@ type TemplatingMethod string
 const (
 	TemplatingMethodHelm      TemplatingMethod = "helm"
 	TemplatingMethodKustomize TemplatingMethod = "kustomize"
+	TemplatingMethodJsonnet   TemplatingMethod = "jsonnet"
+	TemplatingMethodCue       TemplatingMethod = "cue"
 	TemplatingMethodNone      TemplatingMethod = "none"
 )
 
@@ -60,6 +65,15 @@ type LoaderInput struct {
 	HelmChart   *config.InputHelmChart
 	HelmOptions *config.InputHelmOptions
 
+	JsonnetOptions *config.InputJsonnetOptions
+
+	CueVersion string
+	CueOptions *config.InputCueOptions
+
+	PostRenderers []config.InputPostRenderer
+	// List of patterns of executables allowed to be used as exec post-renderers.
+	AllowedPostRendererExecs []string
+
 	Logger *zap.Logger
 
 	// TODO: define fields for LoaderInput.
@@ -72,6 +86,7 @@ type Loader struct {
 type ToolRegistry interface {
 	Kustomize(ctx context.Context, version string) (string, error)
 	Helm(ctx context.Context, version string) (string, error)
+	Cue(ctx context.Context, version string) (string, error)
 }
 
 func NewLoader(registry ToolRegistry) *Loader {
@@ -84,6 +99,12 @@ func (l *Loader) determineTemplatingMethod(input LoaderInput) TemplatingMethod {
 	if input.HelmChart != nil {
 		return TemplatingMethodHelm
 	}
+	if input.JsonnetOptions != nil {
+		return TemplatingMethodJsonnet
+	}
+	if input.CueOptions != nil {
+		return TemplatingMethodCue
+	}
 	if isKustomizationFileExists(input.AppDir) {
 		return TemplatingMethodKustomize
 	}
@@ -122,6 +143,15 @@ func (l *Loader) LoadManifests(ctx context.Context, input LoaderInput) (manifest
 		sortManifests(manifests)
 	}()
 
+	manifests, err = l.render(ctx, input)
+	if err != nil || len(input.PostRenderers) == 0 {
+		return manifests, err
+	}
+	return l.postRender(ctx, input, manifests)
+}
+
+// render renders the manifests by the templating method determined from the given input.
+func (l *Loader) render(ctx context.Context, input LoaderInput) ([]Manifest, error) {
 	templatingMethod := l.determineTemplatingMethod(input)
 
 	switch templatingMethod {
@@ -139,6 +169,20 @@ func (l *Loader) LoadManifests(ctx context.Context, input LoaderInput) (manifest
 		}
 
 		return ParseManifests(data)
+	case TemplatingMethodJsonnet:
+		data, err := NewJsonnet(input.Logger).Template(input.AppName, input.AppDir, input.JsonnetOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to template jsonnet manifests: %w", err)
+		}
+
+		return ParseJSONManifests([]byte(data))
+	case TemplatingMethodCue:
+		data, err := l.templateCue(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to template cue manifests: %w", err)
+		}
+
+		return ParseJSONManifests([]byte(data))
 	case TemplatingMethodNone:
 		return LoadPlainYAMLManifests(input.AppDir, input.Manifests, input.ConfigFilename)
 	default:
@@ -203,6 +247,16 @@ func (l *Loader) templateKustomizeManifests(ctx context.Context, input LoaderInp
 	return k.Template(ctx, input.AppName, input.AppDir, input.KustomizeOptions, h)
 }
 
+func (l *Loader) templateCue(ctx context.Context, input LoaderInput) (string, error) {
+	cuePath, err := l.toolRegistry.Cue(ctx, input.CueVersion)
+	if err != nil {
+		return "", fmt.Errorf("failed to get cue tool: %w", err)
+	}
+
+	c := NewCue(cuePath, input.Logger)
+	return c.Template(ctx, input.AppName, input.AppDir, input.CueOptions)
+}
+
 func LoadPlainYAMLManifests(dir string, names []string, configFilename string) ([]Manifest, error) {
 	// If no name was specified we have to walk the app directory to collect the manifest list.
 	if len(names) == 0 {
@@ -282,3 +336,58 @@ func ParseManifests(data string) ([]Manifest, error) {
 	}
 	return manifests, nil
 }
+
+// ParseJSONManifests parses the given JSON rendered by Jsonnet or CUE and returns a list of Manifest.
+// The JSON can be a manifest, an array of manifests or an object whose values are manifests,
+// and they can be nested. The values of an object are collected in the order of their keys.
+func ParseJSONManifests(data []byte) ([]Manifest, error) {
+	var manifests []Manifest
+	if err := collectJSONManifests(data, &manifests); err != nil {
+		return nil, err
+	}
+	return manifests, nil
+}
+
+func collectJSONManifests(data []byte, manifests *[]Manifest) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	switch data[0] {
+	case '[':
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return err
+		}
+		for _, item := range items {
+			if err := collectJSONManifests(item, manifests); err != nil {
+				return err
+			}
+		}
+		return nil
+	case '{':
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return err
+		}
+		_, hasAPIVersion := fields["apiVersion"]
+		_, hasKind := fields["kind"]
+		if hasAPIVersion && hasKind {
+			var obj unstructured.Unstructured
+			if err := yaml.Unmarshal(data, &obj); err != nil {
+				return err
+			}
+			*manifests = append(*manifests, Manifest{body: &obj})
+			return nil
+		}
+		for _, k := range slices.Sorted(maps.Keys(fields)) {
+			if err := collectJSONManifests(fields[k], manifests); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unexpected value in rendered manifests: %s", data)
+	}
+}
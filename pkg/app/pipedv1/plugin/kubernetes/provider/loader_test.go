@@ -147,6 +147,84 @@ invalid yaml
 	}
 }
 
+func TestParseJSONManifests(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		data      string
+		wantNames []string
+		wantErr   bool
+	}{
+		{
+			name:      "single manifest",
+			data:      `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "config"}}`,
+			wantNames: []string{"config"},
+		},
+		{
+			name: "array of manifests",
+			data: `[
+  {"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "config"}},
+  {"apiVersion": "v1", "kind": "Service", "metadata": {"name": "service"}}
+]`,
+			wantNames: []string{"config", "service"},
+		},
+		{
+			name: "nested objects are collected in the order of keys",
+			data: `{
+  "b": {"apiVersion": "v1", "kind": "Service", "metadata": {"name": "service"}},
+  "a": {
+    "deployment": {"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "deployment"}},
+    "configs": [{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "config"}}, null]
+  }
+}`,
+			wantNames: []string{"config", "deployment", "service"},
+		},
+		{
+			name:      "empty",
+			data:      "",
+			wantNames: []string{},
+		},
+		{
+			name:    "unexpected value",
+			data:    `{"a": "b"}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseJSONManifests([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			names := make([]string, 0, len(got))
+			for _, m := range got {
+				names = append(names, m.Name())
+			}
+			assert.Equal(t, tt.wantNames, names)
+		})
+	}
+}
+
+func TestParseJSONManifests_KeepsIntegers(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseJSONManifests([]byte(`{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "app"}, "spec": {"replicas": 3}}`))
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+
+	replicas, found, err := unstructured.NestedInt64(got[0].body.Object, "spec", "replicas")
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, int64(3), replicas)
+}
+
 func TestLoadPlainYAMLManifests(t *testing.T) {
 	t.Parallel()
 
@@ -551,6 +629,22 @@ metadata:
 			},
 			expected: TemplatingMethodHelm,
 		},
+		{
+			name: "jsonnet options takes precedence over kustomization",
+			input: LoaderInput{
+				AppDir:         "testdata/testkustomize",
+				JsonnetOptions: &config.InputJsonnetOptions{},
+			},
+			expected: TemplatingMethodJsonnet,
+		},
+		{
+			name: "cue options",
+			input: LoaderInput{
+				AppDir:     t.TempDir(),
+				CueOptions: &config.InputCueOptions{},
+			},
+			expected: TemplatingMethodCue,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,60 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// resolvePathInAppDir returns the absolute path of the given path which is relative to the application directory.
+// If the path points outside of the application directory, even through symbolic links,
+// it may indicate that someone is trying to illegally read files in the environment where Piped is running,
+// so an error is returned.
+func resolvePathInAppDir(appDir, path string) (string, error) {
+	absAppDir, err := filepath.Abs(appDir)
+	if err != nil {
+		return "", err
+	}
+
+	absPath := path
+	if !filepath.IsAbs(absPath) {
+		absPath = filepath.Join(absAppDir, absPath)
+	}
+	absPath = filepath.Clean(absPath)
+	if !isInDir(absAppDir, absPath) {
+		return "", fmt.Errorf("%s references outside the application configuration directory", path)
+	}
+
+	// Non-existent paths cannot be resolved, but they cannot be read either.
+	resolved, err := filepath.EvalSymlinks(absPath)
+	if err != nil {
+		return absPath, nil
+	}
+	resolvedAppDir, err := filepath.EvalSymlinks(absAppDir)
+	if err != nil {
+		return "", err
+	}
+	if !isInDir(resolvedAppDir, resolved) {
+		return "", fmt.Errorf("%s references outside the application configuration directory", path)
+	}
+	return absPath, nil
+}
+
+// isInDir returns true if the given path is the given directory or under it.
+func isInDir(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && filepath.IsLocal(rel)
+}
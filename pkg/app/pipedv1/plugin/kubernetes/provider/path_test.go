@@ -0,0 +1,91 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolvePathInAppDir(t *testing.T) {
+	t.Parallel()
+
+	appDir := t.TempDir()
+	outside := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(appDir, "lib"), 0755))
+	require.NoError(t, os.Symlink(outside, filepath.Join(appDir, "escape")))
+	require.NoError(t, os.Symlink(filepath.Join(appDir, "lib"), filepath.Join(appDir, "lib-link")))
+
+	testcases := []struct {
+		name     string
+		path     string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "relative path",
+			path:     "lib",
+			expected: filepath.Join(appDir, "lib"),
+		},
+		{
+			name:     "application directory itself",
+			path:     ".",
+			expected: appDir,
+		},
+		{
+			name:     "non-existent path",
+			path:     "lib/missing.jsonnet",
+			expected: filepath.Join(appDir, "lib/missing.jsonnet"),
+		},
+		{
+			name:     "symbolic link inside",
+			path:     "lib-link",
+			expected: filepath.Join(appDir, "lib-link"),
+		},
+		{
+			name:    "parent directory",
+			path:    "../other",
+			wantErr: true,
+		},
+		{
+			name:    "absolute path outside",
+			path:    "/etc/passwd",
+			wantErr: true,
+		},
+		{
+			name:    "symbolic link outside",
+			path:    "escape",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := resolvePathInAppDir(appDir, tc.path)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
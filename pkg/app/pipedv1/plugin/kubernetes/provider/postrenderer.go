@@ -0,0 +1,164 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/config"
+)
+
+// PostRendererKustomizeInputFile is the name of the file containing the rendered manifests
+// placed in the overlay directory of a Kustomize post-renderer.
+const PostRendererKustomizeInputFile = "rendered.yaml"
+
+// postRender runs the post-renderers in order to transform the given manifests.
+func (l *Loader) postRender(ctx context.Context, input LoaderInput, manifests []Manifest) ([]Manifest, error) {
+	data, err := marshalManifests(manifests)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, r := range input.PostRenderers {
+		switch {
+		case r.Kustomize != "":
+			data, err = l.postRenderWithKustomize(ctx, input, r, data)
+		case r.Exec != "":
+			data, err = postRenderWithExec(ctx, input, r, data)
+		default:
+			err = fmt.Errorf("no post-renderer is specified")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to run post-renderer #%d: %w", i, err)
+		}
+	}
+
+	return ParseManifests(string(data))
+}
+
+// postRenderWithKustomize builds the Kustomize overlay on a temporary copy of the overlay directory
+// with the given manifests placed as rendered.yaml.
+func (l *Loader) postRenderWithKustomize(ctx context.Context, input LoaderInput, r config.InputPostRenderer, data []byte) ([]byte, error) {
+	overlayDir, err := resolvePathInAppDir(input.AppDir, r.Kustomize)
+	if err != nil {
+		return nil, err
+	}
+
+	kustomizePath, err := l.toolRegistry.Kustomize(ctx, input.KustomizeVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kustomize tool: %w", err)
+	}
+
+	dir, err := os.MkdirTemp("", "post-renderer-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	if err := os.CopyFS(dir, os.DirFS(overlayDir)); err != nil {
+		return nil, fmt.Errorf("failed to copy kustomize overlay %s: %w", r.Kustomize, err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, PostRendererKustomizeInputFile), data, 0600); err != nil {
+		return nil, err
+	}
+
+	k := NewKustomize(input.KustomizeVersion, kustomizePath, input.Logger)
+	out, err := k.Template(ctx, input.AppName, dir, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(out), nil
+}
+
+// postRenderWithExec passes the given manifests to the executable via stdin and returns its stdout.
+func postRenderWithExec(ctx context.Context, input LoaderInput, r config.InputPostRenderer, data []byte) ([]byte, error) {
+	execPath, err := resolvePathInAppDir(input.AppDir, r.Exec)
+	if err != nil {
+		return nil, err
+	}
+	allowed, err := isPostRendererExecAllowed(input.AppDir, execPath, input.AllowedPostRendererExecs)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, fmt.Errorf("exec post-renderer %s is not allowed by allowedPostRendererExecs of the plugin config", r.Exec)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, execPath, r.Args...)
+	cmd.Dir = input.AppDir
+	cmd.Stdin = bytes.NewReader(data)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	input.Logger.Info(fmt.Sprintf("start post-rendering manifests of application %s", input.AppName),
+		zap.String("exec", r.Exec),
+		zap.Any("args", r.Args),
+	)
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%w: %s", err, stderr.String())
+	}
+	return stdout.Bytes(), nil
+}
+
+// isPostRendererExecAllowed returns true if the given executable matches one of the allowed patterns.
+// The patterns are matched against the slash-separated path of the executable relative to the application directory.
+func isPostRendererExecAllowed(appDir, execPath string, patterns []string) (bool, error) {
+	absAppDir, err := filepath.Abs(appDir)
+	if err != nil {
+		return false, err
+	}
+	rel, err := filepath.Rel(absAppDir, execPath)
+	if err != nil {
+		return false, err
+	}
+	rel = filepath.ToSlash(rel)
+
+	for _, p := range patterns {
+		matched, err := path.Match(p, rel)
+		if err != nil {
+			return false, fmt.Errorf("invalid pattern %q in allowedPostRendererExecs: %w", p, err)
+		}
+		if matched {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// marshalManifests encodes the given manifests into a multi-document YAML.
+func marshalManifests(manifests []Manifest) ([]byte, error) {
+	var buf bytes.Buffer
+	for i := range manifests {
+		b, err := manifests[i].YamlBytes()
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(b)
+	}
+	return buf.Bytes(), nil
+}
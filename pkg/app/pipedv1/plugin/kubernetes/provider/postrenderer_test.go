@@ -0,0 +1,277 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/pipe-cd/piped-plugin-sdk-go/toolregistry/toolregistrytest"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/toolregistry"
+)
+
+func TestLoader_LoadManifests_WithExecPostRenderers(t *testing.T) {
+	t.Parallel()
+
+	appDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(appDir, "configmap.yaml"), []byte(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+data:
+  key: value
+`), 0644))
+	// The scripts are run in order, so the second one sees the output of the first one.
+	require.NoError(t, os.WriteFile(filepath.Join(appDir, "rename.sh"), []byte("#!/bin/sh\nsed \"s/name: config/name: $1/\"\n"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(appDir, "suffix.sh"), []byte("#!/bin/sh\nsed 's/name: renamed/name: renamed-suffixed/'\n"), 0755))
+
+	loader := &Loader{}
+	manifests, err := loader.LoadManifests(context.Background(), LoaderInput{
+		AppName:   "testapp",
+		AppDir:    appDir,
+		Manifests: []string{"configmap.yaml"},
+		Namespace: "test",
+		PostRenderers: []config.InputPostRenderer{
+			{Exec: "rename.sh", Args: []string{"renamed"}},
+			{Exec: "suffix.sh"},
+		},
+		AllowedPostRendererExecs: []string{"*.sh"},
+		Logger:                   zaptest.NewLogger(t),
+	})
+	require.NoError(t, err)
+	require.Len(t, manifests, 1)
+	assert.Equal(t, "renamed-suffixed", manifests[0].Name())
+	assert.Equal(t, "test", manifests[0].Key().Namespace())
+}
+
+func TestLoader_LoadManifests_WithFailedPostRenderer(t *testing.T) {
+	t.Parallel()
+
+	appDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(appDir, "configmap.yaml"), []byte(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(appDir, "fail.sh"), []byte("#!/bin/sh\necho 'invalid patch' >&2\nexit 1\n"), 0755))
+
+	loader := &Loader{}
+	_, err := loader.LoadManifests(context.Background(), LoaderInput{
+		AppName:                  "testapp",
+		AppDir:                   appDir,
+		Manifests:                []string{"configmap.yaml"},
+		PostRenderers:            []config.InputPostRenderer{{Exec: "fail.sh"}},
+		AllowedPostRendererExecs: []string{"fail.sh"},
+		Logger:                   zaptest.NewLogger(t),
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid patch")
+}
+
+func TestLoader_LoadManifests_WithRejectedPostRenderers(t *testing.T) {
+	t.Parallel()
+
+	appDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(appDir, "configmap.yaml"), []byte(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(appDir, "rename.sh"), []byte("#!/bin/sh\nsed 's/name: config/name: renamed/'\n"), 0755))
+
+	testcases := []struct {
+		name          string
+		postRenderer  config.InputPostRenderer
+		allowedExecs  []string
+		expectedError string
+	}{
+		{
+			name:          "exec is not allowed when no allowlist is configured",
+			postRenderer:  config.InputPostRenderer{Exec: "rename.sh"},
+			expectedError: "exec post-renderer rename.sh is not allowed",
+		},
+		{
+			name:          "exec does not match the allowlist",
+			postRenderer:  config.InputPostRenderer{Exec: "rename.sh"},
+			allowedExecs:  []string{"scripts/*.sh"},
+			expectedError: "exec post-renderer rename.sh is not allowed",
+		},
+		{
+			name:          "exec outside the application directory",
+			postRenderer:  config.InputPostRenderer{Exec: "../../bin/sh"},
+			allowedExecs:  []string{"*"},
+			expectedError: "references outside the application configuration directory",
+		},
+		{
+			name:          "absolute exec outside the application directory",
+			postRenderer:  config.InputPostRenderer{Exec: "/bin/sh"},
+			allowedExecs:  []string{"*"},
+			expectedError: "references outside the application configuration directory",
+		},
+		{
+			name:          "kustomize overlay outside the application directory",
+			postRenderer:  config.InputPostRenderer{Kustomize: "../overlay"},
+			expectedError: "references outside the application configuration directory",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			loader := &Loader{}
+			_, err := loader.LoadManifests(context.Background(), LoaderInput{
+				AppName:                  "testapp",
+				AppDir:                   appDir,
+				Manifests:                []string{"configmap.yaml"},
+				PostRenderers:            []config.InputPostRenderer{tc.postRenderer},
+				AllowedPostRendererExecs: tc.allowedExecs,
+				Logger:                   zaptest.NewLogger(t),
+			})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expectedError)
+		})
+	}
+}
+
+func TestIsPostRendererExecAllowed(t *testing.T) {
+	t.Parallel()
+
+	appDir := t.TempDir()
+	testcases := []struct {
+		name     string
+		exec     string
+		patterns []string
+		expected bool
+	}{
+		{
+			name:     "no patterns",
+			exec:     "render.sh",
+			expected: false,
+		},
+		{
+			name:     "exact match",
+			exec:     "render.sh",
+			patterns: []string{"render.sh"},
+			expected: true,
+		},
+		{
+			name:     "wildcard match in a sub directory",
+			exec:     "scripts/render.sh",
+			patterns: []string{"other.sh", "scripts/*.sh"},
+			expected: true,
+		},
+		{
+			name:     "wildcard does not match path separators",
+			exec:     "scripts/render.sh",
+			patterns: []string{"*.sh"},
+			expected: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			allowed, err := isPostRendererExecAllowed(appDir, filepath.Join(appDir, tc.exec), tc.patterns)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, allowed)
+		})
+	}
+
+	_, err := isPostRendererExecAllowed(appDir, filepath.Join(appDir, "render.sh"), []string{"["})
+	assert.Error(t, err)
+}
+
+func TestLoader_LoadManifests_WithKustomizePostRenderer(t *testing.T) {
+	t.Parallel()
+
+	c := toolregistrytest.NewTestToolRegistry(t)
+	loader := &Loader{
+		toolRegistry: toolregistry.NewRegistry(c),
+	}
+
+	manifests, err := loader.LoadManifests(context.Background(), LoaderInput{
+		AppName:          "testapp",
+		AppDir:           "testdata/testpostrenderer",
+		ConfigFilename:   "app.pipecd.yaml",
+		Manifests:        []string{"deployment.yaml"},
+		KustomizeVersion: "5.4.3",
+		PostRenderers:    []config.InputPostRenderer{{Kustomize: "overlay"}},
+		Logger:           zaptest.NewLogger(t),
+	})
+	require.NoError(t, err)
+	require.Len(t, manifests, 1)
+	assert.Equal(t, "platform", manifests[0].body.GetLabels()["team"])
+
+	// The overlay directory must be kept as is.
+	_, err = os.Stat(filepath.Join("testdata/testpostrenderer/overlay", PostRendererKustomizeInputFile))
+	assert.True(t, os.IsNotExist(err))
+}
+
+// fakeKustomizeRegistry returns the given path as the kustomize tool.
+type fakeKustomizeRegistry struct {
+	ToolRegistry
+	kustomizePath string
+}
+
+func (r fakeKustomizeRegistry) Kustomize(context.Context, string) (string, error) {
+	return r.kustomizePath, nil
+}
+
+func TestLoader_LoadManifests_WithKustomizePostRenderer_OverlayCopy(t *testing.T) {
+	t.Parallel()
+
+	// The fake kustomize checks that it runs in a copy of the overlay directory
+	// containing rendered.yaml, and outputs rendered.yaml with the overlay name.
+	kustomizePath := writeFakeTool(t, `test -f kustomization.yaml || exit 1
+sed "s/name: simple/name: simple-$(cat suffix)/" rendered.yaml`)
+	loader := &Loader{
+		toolRegistry: fakeKustomizeRegistry{kustomizePath: kustomizePath},
+	}
+
+	appDir := t.TempDir()
+	overlayDir := filepath.Join(appDir, "overlay")
+	require.NoError(t, os.MkdirAll(overlayDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(overlayDir, "kustomization.yaml"), []byte("resources:\n- rendered.yaml\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(overlayDir, "suffix"), []byte("patched"), 0644))
+	data, err := os.ReadFile("testdata/testpostrenderer/deployment.yaml")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(appDir, "deployment.yaml"), data, 0644))
+
+	manifests, err := loader.LoadManifests(context.Background(), LoaderInput{
+		AppName:          "testapp",
+		AppDir:           appDir,
+		Manifests:        []string{"deployment.yaml"},
+		KustomizeVersion: "5.4.3",
+		PostRenderers:    []config.InputPostRenderer{{Kustomize: "overlay"}},
+		Logger:           zaptest.NewLogger(t),
+	})
+	require.NoError(t, err)
+	require.Len(t, manifests, 1)
+	assert.Equal(t, "simple-patched", manifests[0].Name())
+
+	_, err = os.Stat(filepath.Join(overlayDir, PostRendererKustomizeInputFile))
+	assert.True(t, os.IsNotExist(err))
+}
@@ -37,6 +37,12 @@ const (
 	KindSecret    = "Secret"
 	KindConfigMap = "ConfigMap"
 
+	// Job
+	KindJob = "Job"
+
+	// CustomResourceDefinition
+	KindCustomResourceDefinition = "CustomResourceDefinition"
+
 	DefaultNamespace = "default"
 )
 
@@ -0,0 +1,212 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+// SyncWave represents a group of manifests having the same sync wave.
+type SyncWave struct {
+	// The wave number given by the pipecd.dev/sync-wave annotation.
+	Wave int
+	// The manifests belonging to the wave.
+	Manifests []Manifest
+}
+
+// GroupManifestsBySyncWave groups the given manifests by their sync wave annotation
+// and returns the groups sorted by the wave number in ascending order.
+// The manifests without the annotation belong to the wave 0.
+// The order of the manifests in each group is kept as is.
+func GroupManifestsBySyncWave(manifests []Manifest) ([]SyncWave, error) {
+	groups := make(map[int][]Manifest)
+	for _, m := range manifests {
+		wave, err := m.SyncWave()
+		if err != nil {
+			return nil, err
+		}
+		groups[wave] = append(groups[wave], m)
+	}
+
+	waves := make([]SyncWave, 0, len(groups))
+	for w, ms := range groups {
+		waves = append(waves, SyncWave{Wave: w, Manifests: ms})
+	}
+	slices.SortFunc(waves, func(a, b SyncWave) int {
+		return a.Wave - b.Wave
+	})
+	return waves, nil
+}
+
+// SyncWave returns the wave number specified by the pipecd.dev/sync-wave annotation.
+func (m Manifest) SyncWave() (int, error) {
+	v, ok := m.GetAnnotations()[AnnotationSyncWave]
+	if !ok || v == "" {
+		return 0, nil
+	}
+	wave, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s annotation %q in %s: %w", AnnotationSyncWave, v, m.Key().ReadableString(), err)
+	}
+	return wave, nil
+}
+
+// IsJob returns true if the manifest is a Job.
+// It checks the API group and the kind of the manifest.
+func (m Manifest) IsJob() bool {
+	return m.body.GroupVersionKind().Group == "batch" && m.body.GetKind() == KindJob
+}
+
+// IsCustomResourceDefinition returns true if the manifest is a CustomResourceDefinition.
+// It checks the API group and the kind of the manifest.
+func (m Manifest) IsCustomResourceDefinition() bool {
+	return m.body.GroupVersionKind().Group == "apiextensions.k8s.io" && m.body.GetKind() == KindCustomResourceDefinition
+}
+
+// ResourceReadiness represents whether a live resource is ready for the next sync wave.
+type ResourceReadiness int
+
+const (
+	// ResourceProgressing means the resource is still being reconciled.
+	ResourceProgressing ResourceReadiness = iota
+	// ResourceReady means the resource is ready for the next sync wave.
+	ResourceReady
+	// ResourceFailed means the resource will never become ready without any change.
+	ResourceFailed
+)
+
+// Readiness returns the readiness of the live resource for the next sync wave with its reason.
+// Jobs must be completed, CustomResourceDefinitions must be established
+// and workloads must be healthy. The other resources are ready once they exist.
+func (m Manifest) Readiness() (ResourceReadiness, string) {
+	switch {
+	case m.IsJob():
+		obj := &batchv1.Job{}
+		if err := m.ConvertToStructuredObject(obj); err != nil {
+			return ResourceFailed, fmt.Sprintf("Unable to convert to Job: %v", err)
+		}
+		return jobReadiness(obj)
+	case m.IsCustomResourceDefinition():
+		return crdReadiness(m.body)
+	case m.IsDeployment():
+		obj := &appsv1.Deployment{}
+		if err := m.ConvertToStructuredObject(obj); err != nil {
+			return ResourceFailed, fmt.Sprintf("Unable to convert to Deployment: %v", err)
+		}
+		for _, cond := range obj.Status.Conditions {
+			if cond.Type == appsv1.DeploymentProgressing && cond.Reason == "ProgressDeadlineExceeded" {
+				return ResourceFailed, fmt.Sprintf("Deployment %q exceeded its progress deadline", obj.GetName())
+			}
+		}
+		return readinessFromHealthStatus(deploymentHealthStatus(obj))
+	case m.IsStatefulSet(), m.IsReplicaSet():
+		return readinessFromHealthStatus(m.calculateHealthStatus())
+	case isBuiltinAPIGroup(m.body.GroupVersionKind().Group) && m.body.GetKind() == KindDaemonSet:
+		obj := &appsv1.DaemonSet{}
+		if err := m.ConvertToStructuredObject(obj); err != nil {
+			return ResourceFailed, fmt.Sprintf("Unable to convert to DaemonSet: %v", err)
+		}
+		return daemonSetReadiness(obj)
+	case isBuiltinAPIGroup(m.body.GroupVersionKind().Group) && m.body.GetKind() == KindPod:
+		obj := &corev1.Pod{}
+		if err := m.ConvertToStructuredObject(obj); err != nil {
+			return ResourceFailed, fmt.Sprintf("Unable to convert to Pod: %v", err)
+		}
+		return podReadiness(obj)
+	default:
+		return ResourceReady, ""
+	}
+}
+
+func readinessFromHealthStatus(status sdk.ResourceHealthStatus, desc string) (ResourceReadiness, string) {
+	if status == sdk.ResourceHealthStateHealthy {
+		return ResourceReady, ""
+	}
+	return ResourceProgressing, desc
+}
+
+func jobReadiness(obj *batchv1.Job) (ResourceReadiness, string) {
+	for _, cond := range obj.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return ResourceReady, ""
+		case batchv1.JobFailed:
+			return ResourceFailed, fmt.Sprintf("Job %q failed: %s", obj.GetName(), cond.Message)
+		}
+	}
+	return ResourceProgressing, fmt.Sprintf("Waiting for Job %q to complete (%d active, %d succeeded, %d failed)", obj.GetName(), obj.Status.Active, obj.Status.Succeeded, obj.Status.Failed)
+}
+
+func crdReadiness(obj *unstructured.Unstructured) (ResourceReadiness, string) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		cond, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		switch cond["type"] {
+		case "Established":
+			if cond["status"] == string(corev1.ConditionTrue) {
+				return ResourceReady, ""
+			}
+		case "NamesAccepted":
+			if cond["status"] == string(corev1.ConditionFalse) {
+				return ResourceFailed, fmt.Sprintf("CustomResourceDefinition %q has conflicting names: %v", obj.GetName(), cond["message"])
+			}
+		}
+	}
+	return ResourceProgressing, fmt.Sprintf("Waiting for CustomResourceDefinition %q to be established", obj.GetName())
+}
+
+func daemonSetReadiness(obj *appsv1.DaemonSet) (ResourceReadiness, string) {
+	if obj.Generation > obj.Status.ObservedGeneration {
+		return ResourceProgressing, "Waiting for daemon set spec update to be observed"
+	}
+	if obj.Status.UpdatedNumberScheduled < obj.Status.DesiredNumberScheduled {
+		return ResourceProgressing, fmt.Sprintf("Waiting for %d/%d pods to be updated", obj.Status.UpdatedNumberScheduled, obj.Status.DesiredNumberScheduled)
+	}
+	if obj.Status.NumberAvailable < obj.Status.DesiredNumberScheduled {
+		return ResourceProgressing, fmt.Sprintf("Waiting for %d/%d pods to be available", obj.Status.NumberAvailable, obj.Status.DesiredNumberScheduled)
+	}
+	return ResourceReady, ""
+}
+
+func podReadiness(obj *corev1.Pod) (ResourceReadiness, string) {
+	switch obj.Status.Phase {
+	case corev1.PodSucceeded:
+		return ResourceReady, ""
+	case corev1.PodFailed:
+		return ResourceFailed, fmt.Sprintf("Pod %q failed: %s", obj.GetName(), obj.Status.Message)
+	case corev1.PodRunning:
+		for _, cond := range obj.Status.Conditions {
+			if cond.Type == corev1.PodReady && cond.Status == corev1.ConditionTrue {
+				return ResourceReady, ""
+			}
+		}
+	}
+	return ResourceProgressing, fmt.Sprintf("Waiting for Pod %q to be ready", obj.GetName())
+}
@@ -0,0 +1,252 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupManifestsBySyncWave(t *testing.T) {
+	t.Parallel()
+
+	manifests := mustParseManifests(t, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+---
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+  annotations:
+    pipecd.dev/sync-wave: "-1"
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: crontabs.stable.example.com
+  annotations:
+    pipecd.dev/sync-wave: "-2"
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: app
+---
+apiVersion: stable.example.com/v1
+kind: CronTab
+metadata:
+  name: crontab
+  annotations:
+    pipecd.dev/sync-wave: "1"
+`)
+
+	waves, err := GroupManifestsBySyncWave(manifests)
+	require.NoError(t, err)
+
+	got := make(map[int][]string, len(waves))
+	order := make([]int, 0, len(waves))
+	for _, w := range waves {
+		order = append(order, w.Wave)
+		for _, m := range w.Manifests {
+			got[w.Wave] = append(got[w.Wave], m.Name())
+		}
+	}
+	assert.Equal(t, []int{-2, -1, 0, 1}, order)
+	assert.Equal(t, map[int][]string{
+		-2: {"crontabs.stable.example.com"},
+		-1: {"migrate"},
+		0:  {"app", "app"},
+		1:  {"crontab"},
+	}, got)
+}
+
+func TestGroupManifestsBySyncWave_InvalidAnnotation(t *testing.T) {
+	t.Parallel()
+
+	manifests := mustParseManifests(t, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+  annotations:
+    pipecd.dev/sync-wave: "first"
+`)
+	_, err := GroupManifestsBySyncWave(manifests)
+	require.Error(t, err)
+}
+
+func TestManifestReadiness(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		manifest string
+		expected ResourceReadiness
+	}{
+		{
+			name: "completed job",
+			manifest: `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+status:
+  succeeded: 1
+  conditions:
+  - type: Complete
+    status: "True"
+`,
+			expected: ResourceReady,
+		},
+		{
+			name: "failed job",
+			manifest: `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+status:
+  failed: 7
+  conditions:
+  - type: Failed
+    status: "True"
+    message: Job has reached the specified backoff limit
+`,
+			expected: ResourceFailed,
+		},
+		{
+			name: "running job",
+			manifest: `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+status:
+  active: 1
+`,
+			expected: ResourceProgressing,
+		},
+		{
+			name: "established crd",
+			manifest: `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: crontabs.stable.example.com
+status:
+  conditions:
+  - type: NamesAccepted
+    status: "True"
+  - type: Established
+    status: "True"
+`,
+			expected: ResourceReady,
+		},
+		{
+			name: "crd with conflicting names",
+			manifest: `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: crontabs.stable.example.com
+status:
+  conditions:
+  - type: NamesAccepted
+    status: "False"
+    message: the plural name is already in use
+`,
+			expected: ResourceFailed,
+		},
+		{
+			name: "crd not established yet",
+			manifest: `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: crontabs.stable.example.com
+`,
+			expected: ResourceProgressing,
+		},
+		{
+			name: "deployment exceeded progress deadline",
+			manifest: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+spec:
+  replicas: 1
+status:
+  conditions:
+  - type: Progressing
+    status: "False"
+    reason: ProgressDeadlineExceeded
+`,
+			expected: ResourceFailed,
+		},
+		{
+			name: "available deployment",
+			manifest: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+spec:
+  replicas: 2
+status:
+  replicas: 2
+  updatedReplicas: 2
+  availableReplicas: 2
+`,
+			expected: ResourceReady,
+		},
+		{
+			name: "failed pod",
+			manifest: `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: pod
+status:
+  phase: Failed
+`,
+			expected: ResourceFailed,
+		},
+		{
+			name: "config map",
+			manifest: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+`,
+			expected: ResourceReady,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			manifests := mustParseManifests(t, tc.manifest)
+			require.Len(t, manifests, 1)
+			got, _ := manifests[0].Readiness()
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -25,6 +25,7 @@ const (
 	defaultKubectlVersion   = "1.18.2"
 	defaultKustomizeVersion = "3.8.1"
 	defaultHelmVersion      = "3.8.2"
+	defaultCueVersion       = "0.9.2"
 )
 
 type client interface {
@@ -59,3 +60,9 @@ func (r *Registry) Kustomize(ctx context.Context, version string) (string, error
 func (r *Registry) Helm(ctx context.Context, version string) (string, error) {
 	return r.client.InstallTool(ctx, "helm", cmp.Or(version, defaultHelmVersion), helmInstallScript)
 }
+
+// Cue installs the cue tool with the given version and return the path to the installed binary.
+// If the version is empty, the default version will be used.
+func (r *Registry) Cue(ctx context.Context, version string) (string, error) {
+	return r.client.InstallTool(ctx, "cue", cmp.Or(version, defaultCueVersion), cueInstallScript)
+}
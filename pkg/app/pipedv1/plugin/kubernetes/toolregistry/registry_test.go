@@ -82,3 +82,20 @@ func TestRegistry_Helm(t *testing.T) {
 
 	assert.Equal(t, strings.TrimSpace(expected), strings.TrimSpace(string(out)))
 }
+
+func TestRegistry_Cue(t *testing.T) {
+	t.Parallel()
+
+	c := toolregistrytest.NewTestToolRegistry(t)
+
+	r := NewRegistry(c)
+
+	p, err := r.Cue(context.Background(), "0.9.2")
+	require.NoError(t, err)
+	require.NotEmpty(t, p)
+
+	out, err := exec.CommandContext(context.Background(), p, "version").CombinedOutput()
+	require.NoError(t, err)
+
+	assert.Contains(t, string(out), "cue version v0.9.2")
+}
@@ -31,3 +31,9 @@ cd {{ .TmpDir }}
 curl -L https://get.helm.sh/helm-v{{ .Version }}-{{ .Os }}-{{ .Arch }}.tar.gz | tar xvz
 mv {{ .Os }}-{{ .Arch }}/helm {{ .OutPath }}
 `
+
+const cueInstallScript = `
+cd {{ .TmpDir }}
+curl -L https://github.com/cue-lang/cue/releases/download/v{{ .Version }}/cue_v{{ .Version }}_{{ .Os }}_{{ .Arch }}.tar.gz | tar xvz
+mv cue {{ .OutPath }}
+`
@@ -17,6 +17,8 @@ package deployment
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
 
@@ -34,6 +36,89 @@ type applier interface {
 	ForceReplaceManifest(ctx context.Context, manifest provider.Manifest) error
 }
 
+// syncWaveApplier is an applier which can also get the live manifests to check the readiness of sync waves.
+type syncWaveApplier interface {
+	applier
+	// GetManifest returns the live manifest of the given resource.
+	GetManifest(ctx context.Context, key provider.ResourceKey) (provider.Manifest, error)
+}
+
+// syncWaveCheckInterval is the interval to check the readiness of the resources in a sync wave.
+var syncWaveCheckInterval = 5 * time.Second
+
+// applyManifestsBySyncWave applies the given manifests wave by wave in ascending order of their pipecd.dev/sync-wave annotation.
+// Before starting the next wave, it waits for all resources in the current wave to become ready,
+// and fails if any of them fails, e.g. a Job fails.
+func applyManifestsBySyncWave(ctx context.Context, applier syncWaveApplier, manifests []provider.Manifest, namespace string, lp sdk.StageLogPersister) error {
+	waves, err := provider.GroupManifestsBySyncWave(manifests)
+	if err != nil {
+		lp.Errorf("Failed to group manifests by sync wave (%v)", err)
+		return err
+	}
+	if len(waves) <= 1 {
+		return applyManifests(ctx, applier, manifests, namespace, lp)
+	}
+
+	lp.Infof("Start applying %d manifests in %d sync waves", len(manifests), len(waves))
+	for i, w := range waves {
+		lp.Infof("Start applying sync wave %d", w.Wave)
+		if err := applyManifests(ctx, applier, w.Manifests, namespace, lp); err != nil {
+			return err
+		}
+		// No need to wait for the last wave.
+		if i == len(waves)-1 {
+			break
+		}
+		if err := waitForSyncWave(ctx, applier, w, lp); err != nil {
+			lp.Errorf("Sync wave %d failed (%v)", w.Wave, err)
+			return err
+		}
+		lp.Successf("All resources in sync wave %d are ready", w.Wave)
+	}
+	return nil
+}
+
+// waitForSyncWave waits until all resources in the given wave become ready.
+func waitForSyncWave(ctx context.Context, applier syncWaveApplier, wave provider.SyncWave, lp sdk.StageLogPersister) error {
+	ticker := time.NewTicker(syncWaveCheckInterval)
+	defer ticker.Stop()
+
+	pending := wave.Manifests
+	for {
+		var (
+			remaining = make([]provider.Manifest, 0, len(pending))
+			reason    string
+		)
+		for _, m := range pending {
+			live, err := applier.GetManifest(ctx, m.Key())
+			if err != nil {
+				remaining = append(remaining, m)
+				reason = fmt.Sprintf("Unable to get %s (%v)", m.Key().ReadableString(), err)
+				continue
+			}
+			readiness, desc := live.Readiness()
+			switch readiness {
+			case provider.ResourceFailed:
+				return fmt.Errorf("%s failed: %s", m.Key().ReadableString(), desc)
+			case provider.ResourceProgressing:
+				remaining = append(remaining, m)
+				reason = desc
+			}
+		}
+		if len(remaining) == 0 {
+			return nil
+		}
+		pending = remaining
+		lp.Infof("Waiting for %d resources in sync wave %d to be ready: %s", len(pending), wave.Wave, reason)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
 func applyManifests(ctx context.Context, applier applier, manifests []provider.Manifest, namespace string, lp sdk.StageLogPersister) error {
 	if namespace == "" {
 		lp.Infof("Start applying %d manifests", len(manifests))
@@ -183,7 +183,7 @@ func (p *Plugin) sync(
 
 	// Start applying all manifests to add or update running resources.
 	// TODO: use applyManifests instead of applyManifestsSDK
-	if err := applyManifests(ctx, applier, manifests, cfg.Spec.Input.Namespace, lp); err != nil {
+	if err := applyManifestsWithHelmHooks(ctx, applier, manifests, cfg.Spec.Input.Namespace, helmHookPhase(input.Request.RunningDeploymentSource.CommitHash), lp); err != nil {
 		lp.Errorf("Failed while applying manifests (%v)", err)
 		return sdk.StageStatusFailure
 	}
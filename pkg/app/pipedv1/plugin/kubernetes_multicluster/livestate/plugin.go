@@ -159,7 +159,7 @@ func (p Plugin) makeAppLivestate(namespacedLiveResources, clusterScopedLiveResou
 func (p Plugin) makeAppSyncState(liveManifests, gitManifests []provider.Manifest, dt *sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig], commit string, logger *zap.Logger) (sdk.ApplicationSyncState, error) {
 	// Calculate SyncState by comparing live manifests with desired manifests
 	// TODO: Implement drift detection ignore configs
-	diffResult, err := provider.DiffList(liveManifests, gitManifests, logger,
+	diffResult, err := provider.DiffList(filterHelmHooks(liveManifests), filterHelmHooks(gitManifests), logger,
 		diff.WithEquateEmpty(),
 		diff.WithIgnoreAddingMapKeys(),
 		diff.WithCompareNumberAndNumericString(),
@@ -171,6 +171,19 @@ func (p Plugin) makeAppSyncState(liveManifests, gitManifests []provider.Manifest
 	return calculateSyncState(diffResult, commit, dt), nil
 }
 
+// filterHelmHooks removes the Helm hooks from the given manifests.
+// They are created and deleted while deploying, so they are not the target of drift detection.
+func filterHelmHooks(manifests []provider.Manifest) []provider.Manifest {
+	out := make([]provider.Manifest, 0, len(manifests))
+	for _, m := range manifests {
+		if m.IsHelmHook() {
+			continue
+		}
+		out = append(out, m)
+	}
+	return out
+}
+
 func calculateSyncState(diffResult *provider.DiffListResult, commit string, dt *sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]) sdk.ApplicationSyncState {
 	if diffResult.NoChanges() {
 		return sdk.ApplicationSyncState{
@@ -130,6 +130,16 @@ func (a *Applier) ForceReplaceManifest(ctx context.Context, manifest Manifest) e
 	return err
 }
 
+// GetManifest returns the live manifest of the given resource from Kubernetes cluster.
+func (a *Applier) GetManifest(ctx context.Context, k ResourceKey) (Manifest, error) {
+	return a.kubectl.Get(
+		ctx,
+		a.deployTarget.KubeConfigPath,
+		k.Namespace(),
+		k,
+	)
+}
+
 // Delete deletes the given resource from Kubernetes cluster.
 // If the resource key is different, this returns ErrNotFound.
 func (a *Applier) Delete(ctx context.Context, k ResourceKey) (err error) {
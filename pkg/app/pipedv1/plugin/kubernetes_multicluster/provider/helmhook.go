@@ -0,0 +1,168 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// AnnotationHelmHook is the annotation used by Helm to mark a resource as a hook.
+	AnnotationHelmHook = "helm.sh/hook"
+	// AnnotationHelmHookWeight is the annotation used by Helm to order the hooks.
+	AnnotationHelmHookWeight = "helm.sh/hook-weight"
+	// AnnotationHelmHookDeletePolicy is the annotation used by Helm to decide when the hook resources are deleted.
+	AnnotationHelmHookDeletePolicy = "helm.sh/hook-delete-policy"
+)
+
+// HelmHookDeletePolicy represents a value of the helm.sh/hook-delete-policy annotation.
+type HelmHookDeletePolicy string
+
+const (
+	// HelmHookBeforeHookCreation deletes the previous hook resource before a new one is created.
+	HelmHookBeforeHookCreation HelmHookDeletePolicy = "before-hook-creation"
+	// HelmHookSucceeded deletes the hook resource after the hook succeeded.
+	HelmHookSucceeded HelmHookDeletePolicy = "hook-succeeded"
+	// HelmHookFailed deletes the hook resource if the hook failed.
+	HelmHookFailed HelmHookDeletePolicy = "hook-failed"
+)
+
+// HelmHookPhase represents the kind of the release operation the hooks are run for.
+type HelmHookPhase string
+
+const (
+	// HelmHookPhaseInstall is used when the application is deployed for the first time.
+	HelmHookPhaseInstall HelmHookPhase = "install"
+	// HelmHookPhaseUpgrade is used when the application is updated.
+	HelmHookPhaseUpgrade HelmHookPhase = "upgrade"
+	// HelmHookPhaseRollback is used when the application is rolled back.
+	HelmHookPhaseRollback HelmHookPhase = "rollback"
+)
+
+// HelmHooks holds the hook manifests to be run before and after applying the other manifests.
+// Each of them is sorted by the hook weight in ascending order.
+type HelmHooks struct {
+	Pre  []Manifest
+	Post []Manifest
+}
+
+// SplitHelmHooks separates the Helm hook manifests from the others.
+// Only the hooks for the given phase are returned, so hooks of the other phases,
+// test hooks and delete hooks are dropped from both of the results.
+func SplitHelmHooks(manifests []Manifest, phase HelmHookPhase) ([]Manifest, HelmHooks, error) {
+	var (
+		regular = make([]Manifest, 0, len(manifests))
+		hooks   HelmHooks
+	)
+	for _, m := range manifests {
+		events := m.HelmHooks()
+		if len(events) == 0 {
+			regular = append(regular, m)
+			continue
+		}
+		if _, err := m.HelmHookWeight(); err != nil {
+			return nil, HelmHooks{}, err
+		}
+		switch {
+		case slices.Contains(events, "pre-"+string(phase)):
+			hooks.Pre = append(hooks.Pre, m)
+		case slices.Contains(events, "post-"+string(phase)):
+			hooks.Post = append(hooks.Post, m)
+		}
+	}
+	sortHelmHooks(hooks.Pre)
+	sortHelmHooks(hooks.Post)
+	return regular, hooks, nil
+}
+
+// sortHelmHooks sorts the hooks by weight and then by name as Helm does.
+func sortHelmHooks(hooks []Manifest) {
+	slices.SortStableFunc(hooks, func(a, b Manifest) int {
+		// The weights were validated while splitting the hooks.
+		wa, _ := a.HelmHookWeight()
+		wb, _ := b.HelmHookWeight()
+		if wa != wb {
+			return wa - wb
+		}
+		return strings.Compare(a.Name(), b.Name())
+	})
+}
+
+// IsHelmHook returns true if the manifest is a Helm hook.
+func (m Manifest) IsHelmHook() bool {
+	return len(m.HelmHooks()) > 0
+}
+
+// HelmHooks returns the hook events specified by the helm.sh/hook annotation, e.g. pre-install.
+func (m Manifest) HelmHooks() []string {
+	return splitAnnotationList(m.GetAnnotations()[AnnotationHelmHook])
+}
+
+// HelmHookWeight returns the weight specified by the helm.sh/hook-weight annotation.
+func (m Manifest) HelmHookWeight() (int, error) {
+	v := strings.TrimSpace(m.GetAnnotations()[AnnotationHelmHookWeight])
+	if v == "" {
+		return 0, nil
+	}
+	weight, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s annotation %q in %s: %w", AnnotationHelmHookWeight, v, m.Key().ReadableString(), err)
+	}
+	return weight, nil
+}
+
+// HasHelmHookDeletePolicy returns true if the hook has the given delete policy.
+// Hooks without the helm.sh/hook-delete-policy annotation have before-hook-creation by default.
+func (m Manifest) HasHelmHookDeletePolicy(policy HelmHookDeletePolicy) bool {
+	policies := splitAnnotationList(m.GetAnnotations()[AnnotationHelmHookDeletePolicy])
+	if len(policies) == 0 {
+		return policy == HelmHookBeforeHookCreation
+	}
+	return slices.Contains(policies, string(policy))
+}
+
+// HelmHookReadiness returns whether the live hook resource has finished with its reason.
+// Unlike Readiness, Pods must be completed instead of just being ready.
+func (m Manifest) HelmHookReadiness() (ResourceReadiness, string) {
+	if !isBuiltinAPIGroup(m.body.GroupVersionKind().Group) || m.body.GetKind() != KindPod {
+		return m.Readiness()
+	}
+	obj := &corev1.Pod{}
+	if err := m.ConvertToStructuredObject(obj); err != nil {
+		return ResourceFailed, fmt.Sprintf("Unable to convert to Pod: %v", err)
+	}
+	switch obj.Status.Phase {
+	case corev1.PodSucceeded:
+		return ResourceReady, ""
+	case corev1.PodFailed:
+		return ResourceFailed, fmt.Sprintf("Pod %q failed: %s", obj.GetName(), obj.Status.Message)
+	}
+	return ResourceProgressing, fmt.Sprintf("Waiting for Pod %q to complete", obj.GetName())
+}
+
+func splitAnnotationList(v string) []string {
+	var out []string
+	for _, s := range strings.Split(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
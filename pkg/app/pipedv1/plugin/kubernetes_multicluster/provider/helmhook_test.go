@@ -0,0 +1,270 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitHelmHooks(t *testing.T) {
+	t.Parallel()
+
+	manifests := mustParseManifests(t, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+---
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+  annotations:
+    helm.sh/hook: pre-install,pre-upgrade
+    helm.sh/hook-weight: "5"
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: migrate-config
+  annotations:
+    helm.sh/hook: pre-upgrade
+    helm.sh/hook-weight: "-5"
+---
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: notify
+  annotations:
+    helm.sh/hook: post-upgrade, post-rollback
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: test-connection
+  annotations:
+    helm.sh/hook: test
+`)
+
+	names := func(ms []Manifest) []string {
+		out := make([]string, 0, len(ms))
+		for _, m := range ms {
+			out = append(out, m.Name())
+		}
+		return out
+	}
+
+	testcases := []struct {
+		name        string
+		phase       HelmHookPhase
+		wantRegular []string
+		wantPre     []string
+		wantPost    []string
+	}{
+		{
+			name:        "install",
+			phase:       HelmHookPhaseInstall,
+			wantRegular: []string{"app"},
+			wantPre:     []string{"migrate"},
+			wantPost:    []string{},
+		},
+		{
+			name:        "upgrade",
+			phase:       HelmHookPhaseUpgrade,
+			wantRegular: []string{"app"},
+			wantPre:     []string{"migrate-config", "migrate"},
+			wantPost:    []string{"notify"},
+		},
+		{
+			name:        "rollback",
+			phase:       HelmHookPhaseRollback,
+			wantRegular: []string{"app"},
+			wantPre:     []string{},
+			wantPost:    []string{"notify"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			regular, hooks, err := SplitHelmHooks(manifests, tc.phase)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantRegular, names(regular))
+			assert.Equal(t, tc.wantPre, names(hooks.Pre))
+			assert.Equal(t, tc.wantPost, names(hooks.Post))
+		})
+	}
+}
+
+func TestSplitHelmHooks_InvalidWeight(t *testing.T) {
+	t.Parallel()
+
+	manifests := mustParseManifests(t, `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+  annotations:
+    helm.sh/hook: pre-upgrade
+    helm.sh/hook-weight: heavy
+`)
+	_, _, err := SplitHelmHooks(manifests, HelmHookPhaseUpgrade)
+	require.Error(t, err)
+}
+
+func TestManifestHasHelmHookDeletePolicy(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		manifest string
+		want     map[HelmHookDeletePolicy]bool
+	}{
+		{
+			name: "default policy",
+			manifest: `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+  annotations:
+    helm.sh/hook: pre-upgrade
+`,
+			want: map[HelmHookDeletePolicy]bool{
+				HelmHookBeforeHookCreation: true,
+				HelmHookSucceeded:          false,
+				HelmHookFailed:             false,
+			},
+		},
+		{
+			name: "multiple policies",
+			manifest: `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+  annotations:
+    helm.sh/hook: pre-upgrade
+    helm.sh/hook-delete-policy: hook-succeeded, hook-failed
+`,
+			want: map[HelmHookDeletePolicy]bool{
+				HelmHookBeforeHookCreation: false,
+				HelmHookSucceeded:          true,
+				HelmHookFailed:             true,
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ms := mustParseManifests(t, tc.manifest)
+			require.Len(t, ms, 1)
+			for policy, want := range tc.want {
+				assert.Equal(t, want, ms[0].HasHelmHookDeletePolicy(policy), policy)
+			}
+		})
+	}
+}
+
+func TestManifestHelmHookReadiness(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		manifest string
+		want     ResourceReadiness
+	}{
+		{
+			name: "running pod is not finished",
+			manifest: `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: hook
+status:
+  phase: Running
+  conditions:
+  - type: Ready
+    status: "True"
+`,
+			want: ResourceProgressing,
+		},
+		{
+			name: "succeeded pod",
+			manifest: `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: hook
+status:
+  phase: Succeeded
+`,
+			want: ResourceReady,
+		},
+		{
+			name: "failed pod",
+			manifest: `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: hook
+status:
+  phase: Failed
+`,
+			want: ResourceFailed,
+		},
+		{
+			name: "completed job",
+			manifest: `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: hook
+status:
+  conditions:
+  - type: Complete
+    status: "True"
+`,
+			want: ResourceReady,
+		},
+		{
+			name: "config map",
+			manifest: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: hook
+`,
+			want: ResourceReady,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ms := mustParseManifests(t, tc.manifest)
+			require.Len(t, ms, 1)
+			got, _ := ms[0].HelmHookReadiness()
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
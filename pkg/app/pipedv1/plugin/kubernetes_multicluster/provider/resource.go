@@ -25,12 +25,20 @@ import (
 const (
 	// Workload
 	KindDeployment  = "Deployment"
+	KindDaemonSet   = "DaemonSet"
+	KindPod         = "Pod"
 	KindStatefulSet = "StatefulSet"
 
 	// ConfigMap and Secret
 	KindSecret    = "Secret"
 	KindConfigMap = "ConfigMap"
 
+	// Job
+	KindJob = "Job"
+
+	// CustomResourceDefinition
+	KindCustomResourceDefinition = "CustomResourceDefinition"
+
 	DefaultNamespace = "default"
 )
 
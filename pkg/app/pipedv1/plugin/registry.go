@@ -18,6 +18,9 @@ import (
 	"context"
 	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	config "github.com/pipe-cd/pipecd/pkg/configv1"
 	pluginapi "github.com/pipe-cd/pipecd/pkg/plugin/api/v1alpha1"
 	"github.com/pipe-cd/pipecd/pkg/plugin/api/v1alpha1/deployment"
@@ -33,6 +36,7 @@ type Plugin struct {
 type PluginRegistry interface {
 	GetPluginClientByStageName(name string) (pluginapi.PluginClient, error)
 	GetPluginClientsByAppConfig(cfg *config.GenericApplicationSpec) ([]pluginapi.PluginClient, error)
+	GetPluginClientByName(name string) (pluginapi.PluginClient, error)
 }
 
 type pluginRegistry struct {
@@ -53,6 +57,10 @@ func NewPluginRegistry(ctx context.Context, plugins []Plugin) (PluginRegistry, e
 
 		// add the plugin to the stage-based plugins
 		res, err := plg.Cli.FetchDefinedStages(ctx, &deployment.FetchDefinedStagesRequest{})
+		if status.Code(err) == codes.Unimplemented {
+			// The plugin does not serve deployments, e.g. a notification plugin.
+			continue
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -78,6 +86,16 @@ func (pr *pluginRegistry) GetPluginClientByStageName(name string) (pluginapi.Plu
 	return plugin, nil
 }
 
+// GetPluginClientByName returns the plugin client based on the given plugin name.
+func (pr *pluginRegistry) GetPluginClientByName(name string) (pluginapi.PluginClient, error) {
+	plugin, ok := pr.nameBasedPlugins[name]
+	if !ok {
+		return nil, fmt.Errorf("no plugin found for the given plugin name %v", name)
+	}
+
+	return plugin, nil
+}
+
 // GetPluginClientsByAppConfig returns the plugin clients based on the given configuration.
 // The priority of determining plugins is as follows:
 //  1. If the pipeline is specified, it will determine the plugins based on the pipeline stages.
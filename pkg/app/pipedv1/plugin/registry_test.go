@@ -348,3 +348,20 @@ func TestPluginRegistry_GetPluginClientByStageName(t *testing.T) {
 		})
 	}
 }
+
+func TestPluginRegistry_GetPluginClientByName(t *testing.T) {
+	t.Parallel()
+
+	pr := &pluginRegistry{
+		nameBasedPlugins: map[string]pluginapi.PluginClient{
+			"plugin1": fakePluginClient{name: "plugin1"},
+		},
+	}
+
+	plugin, err := pr.GetPluginClientByName("plugin1")
+	assert.NoError(t, err)
+	assert.Equal(t, fakePluginClient{name: "plugin1"}, plugin)
+
+	_, err = pr.GetPluginClientByName("plugin2")
+	assert.Error(t, err)
+}
@@ -0,0 +1,72 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// receiverConfig is the receiver specific configuration for the Slack plugin.
+// It is set on the plugin field of the notification receivers in the piped config.
+type receiverConfig struct {
+	HookURL           string   `json:"hookURL"`
+	OAuthTokenData    string   `json:"oauthTokenData"`
+	OAuthTokenFile    string   `json:"oauthTokenFile"`
+	ChannelID         string   `json:"channelID"`
+	MentionedAccounts []string `json:"mentionedAccounts,omitempty"`
+	MentionedGroups   []string `json:"mentionedGroups,omitempty"`
+}
+
+func (c *receiverConfig) validate() error {
+	mentionedAccounts := make([]string, 0, len(c.MentionedAccounts))
+	for _, mentionedAccount := range c.MentionedAccounts {
+		mentionedAccounts = append(mentionedAccounts, strings.TrimPrefix(mentionedAccount, "@"))
+	}
+	c.MentionedAccounts = mentionedAccounts
+
+	if c.HookURL != "" && (c.OAuthTokenFile != "" || c.OAuthTokenData != "" || c.ChannelID != "") {
+		return errors.New("only one of sending via hook URL or API should be used")
+	}
+	if c.HookURL != "" {
+		return nil
+	}
+	if c.ChannelID == "" || (c.OAuthTokenFile == "" && c.OAuthTokenData == "") {
+		return errors.New("missing channelID or OAuth token configuration")
+	}
+	if c.OAuthTokenFile != "" && c.OAuthTokenData != "" {
+		return errors.New("only one of oauthTokenData or oauthTokenFile should be set")
+	}
+	return nil
+}
+
+// loadOAuthToken returns the OAuth token from either oauthTokenData or oauthTokenFile.
+func (c *receiverConfig) loadOAuthToken() (string, error) {
+	if c.OAuthTokenData != "" {
+		data, err := base64.StdEncoding.DecodeString(c.OAuthTokenData)
+		if err != nil {
+			return "", fmt.Errorf("failed to decode the oauth token data: %w", err)
+		}
+		return string(data), nil
+	}
+	data, err := os.ReadFile(c.OAuthTokenFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the oauth token file: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+type plugin struct{}
+
+var httpClient = &http.Client{
+	Timeout: 5 * time.Second,
+}
+
+// Notify implements sdk.NotificationPlugin.
+func (p *plugin) Notify(ctx context.Context, _ sdk.ConfigNone, input *sdk.NotifyInput) error {
+	cfg, err := sdk.ParseReceiverConfig[receiverConfig](input.Request)
+	if err != nil {
+		return fmt.Errorf("failed to parse the receiver config: %w", err)
+	}
+	if err := cfg.validate(); err != nil {
+		return fmt.Errorf("invalid receiver config: %w", err)
+	}
+
+	msg, ok := buildSlackMessage(input.Request.Event, strings.TrimRight(input.Request.WebURL, "/"), cfg, time.Now())
+	if !ok {
+		input.Logger.Info(fmt.Sprintf("ignore event %s", input.Request.Event.Type.String()))
+		return nil
+	}
+
+	if cfg.HookURL != "" {
+		return sendMessageViaHookURL(ctx, httpClient, cfg.HookURL, msg)
+	}
+
+	token, err := cfg.loadOAuthToken()
+	if err != nil {
+		return err
+	}
+	return sendMessageViaAPI(ctx, token, cfg.ChannelID, msg)
+}
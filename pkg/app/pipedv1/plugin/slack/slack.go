@@ -0,0 +1,362 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	slackgo "github.com/slack-go/slack"
+
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	slackUsername     = "PipeCD"
+	slackInfoColor    = "#222429"
+	slackSuccessColor = "#629650"
+	slackErrorColor   = "#9C3C31"
+	slackWarnColor    = "#C1A337"
+)
+
+func sendMessageViaHookURL(ctx context.Context, client *http.Client, hookURL string, msg slackMessage) error {
+	buf := &bytes.Buffer{}
+	if err := json.NewEncoder(buf).Encode(msg); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", hookURL, buf)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024*1024))
+		return fmt.Errorf("%s from Slack: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	return nil
+}
+
+func sendMessageViaAPI(ctx context.Context, token, channelID string, msg slackMessage) error {
+	attachments := make([]slackgo.Attachment, 0, len(msg.Attachments))
+	for _, a := range msg.Attachments {
+		attchmentFiled := make([]slackgo.AttachmentField, 0, len(a.Fields))
+		for _, f := range a.Fields {
+			attchmentFiled = append(attchmentFiled, slackgo.AttachmentField{
+				Title: f.Title,
+				Value: f.Value,
+				Short: f.Short,
+			})
+		}
+		attachments = append(attachments, slackgo.Attachment{
+			Title:      a.Title,
+			TitleLink:  a.TitleLink,
+			Text:       a.Text,
+			Fields:     attchmentFiled,
+			Color:      a.Color,
+			MarkdownIn: a.Markdown,
+			Ts:         json.Number(fmt.Sprint(a.Timestamp)),
+		})
+	}
+
+	if _, _, err := slackgo.New(token).PostMessageContext(ctx, channelID, slackgo.MsgOptionUsername(msg.Username), slackgo.MsgOptionAttachments(attachments...)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func buildSlackMessage(event model.NotificationEvent, webURL string, cfg *receiverConfig, now time.Time) (slackMessage, bool) {
+	var (
+		title, link, text string
+		color             = slackInfoColor
+		timestamp         = now.Unix()
+		fields            []slackField
+	)
+
+	generateDeploymentEventData := func(d *model.Deployment, accounts []string, groups []string) {
+		accountsStr := getAccountsAsString(accounts)
+		groupsStr := getGroupsAsString(groups)
+		link = fmt.Sprintf("%s/deployments/%s?project=%s", webURL, d.Id, d.ProjectId)
+		fields = []slackField{
+			{"Project", truncateText(d.ProjectId, 8), true},
+			{"Application", makeSlackLink(d.ApplicationName, fmt.Sprintf("%s/applications/%s?project=%s", webURL, d.ApplicationId, d.ProjectId)), true},
+			{"Labels", d.GetLabelsString(), true},
+			{"Deployment", makeSlackLink(truncateText(d.Id, 8), link), true},
+			{"Triggered By", d.TriggeredBy(), true},
+			{"Mention To Users", accountsStr, true},
+			{"Mention To Groups", groupsStr, true},
+			{"Started At", makeSlackDate(d.CreatedAt), true},
+		}
+	}
+
+	generateDeploymentEventDataForTriggerFailed := func(app *model.Application, hash string, msg string, accounts []string, groups []string) {
+		accountsStr := getAccountsAsString(accounts)
+		groupsStr := getGroupsAsString(groups)
+		link = fmt.Sprintf("%s/applications/%s?project=%s", webURL, app.Id, app.ProjectId)
+		// The commit link is omitted when the URL can not be built from the remote.
+		commitURL, _ := git.MakeCommitURL(app.GitPath.Repo.Remote, hash)
+		fields = []slackField{
+			{"Project", truncateText(app.ProjectId, 8), true},
+			{"Application", makeSlackLink(app.Name, link), true},
+			{"Labels", app.GetLabelsString(), true},
+			{"Mention To Users", accountsStr, true},
+			{"Mention To Groups", groupsStr, true},
+		}
+		if commitURL != "" {
+			fields = append(fields, slackField{"Commit", makeSlackLink(truncateText(msg, 8), commitURL), true})
+		}
+	}
+
+	generatePipedEventData := func(id string, name string, version string, project string, accounts []string, groups []string) {
+		accountStr := getAccountsAsString(accounts)
+		groupsStr := getGroupsAsString(groups)
+		link = fmt.Sprintf("%s/settings/piped?project=%s", webURL, project)
+		fields = []slackField{
+			{"Name", name, true},
+			{"Version", version, true},
+			{"Project", truncateText(project, 8), true},
+			{"Id", id, true},
+			{"Mention To Users", accountStr, true},
+			{"Mention To Groups", groupsStr, true},
+		}
+	}
+
+	generateStageEventData := func(d *model.Deployment, s *model.PipelineStage, accounts []string, groups []string) {
+		accountsStr := getAccountsAsString(accounts)
+		groupsStr := getGroupsAsString(groups)
+		link = fmt.Sprintf("%s/deployments/%s?project=%s", webURL, d.Id, d.ProjectId)
+		fields = []slackField{
+			{"Project", truncateText(d.ProjectId, 8), true},
+			{"Application", makeSlackLink(d.ApplicationName, fmt.Sprintf("%s/applications/%s?project=%s", webURL, d.ApplicationId, d.ProjectId)), true},
+			{"Labels", d.GetLabelsString(), true},
+			{"Deployment", makeSlackLink(truncateText(d.Id, 8), link), true},
+			{"Stage", s.Name, true},
+			{"Triggered By", d.TriggeredBy(), true},
+			{"Mention To Users", accountsStr, true},
+			{"Mention To Groups", groupsStr, true},
+		}
+	}
+
+	switch event.Type {
+	case model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGERED:
+		md := event.Metadata.(*model.NotificationEventDeploymentTriggered)
+		md.MentionedAccounts = append(md.MentionedAccounts, cfg.MentionedAccounts...)
+		md.MentionedGroups = append(md.MentionedGroups, cfg.MentionedGroups...)
+		title = fmt.Sprintf("Triggered a new deployment for %q", md.Deployment.ApplicationName)
+		generateDeploymentEventData(md.Deployment, md.MentionedAccounts, md.MentionedGroups)
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_PLANNED:
+		md := event.Metadata.(*model.NotificationEventDeploymentPlanned)
+		md.MentionedAccounts = append(md.MentionedAccounts, cfg.MentionedAccounts...)
+		md.MentionedGroups = append(md.MentionedGroups, cfg.MentionedGroups...)
+		title = fmt.Sprintf("Deployment for %q was planned", md.Deployment.ApplicationName)
+		text = md.Summary
+		generateDeploymentEventData(md.Deployment, md.MentionedAccounts, md.MentionedGroups)
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_STARTED:
+		md := event.Metadata.(*model.NotificationEventDeploymentStarted)
+		md.MentionedAccounts = append(md.MentionedAccounts, cfg.MentionedAccounts...)
+		md.MentionedGroups = append(md.MentionedGroups, cfg.MentionedGroups...)
+		title = fmt.Sprintf("Deployment for %q was started", md.Deployment.ApplicationName)
+		generateDeploymentEventData(md.Deployment, md.MentionedAccounts, md.MentionedGroups)
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_WAIT_APPROVAL:
+		md := event.Metadata.(*model.NotificationEventDeploymentWaitApproval)
+		md.MentionedAccounts = append(md.MentionedAccounts, cfg.MentionedAccounts...)
+		md.MentionedGroups = append(md.MentionedGroups, cfg.MentionedGroups...)
+		title = fmt.Sprintf("Deployment for %q is waiting for an approval", md.Deployment.ApplicationName)
+		generateDeploymentEventData(md.Deployment, md.MentionedAccounts, md.MentionedGroups)
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_APPROVED:
+		md := event.Metadata.(*model.NotificationEventDeploymentApproved)
+		md.MentionedAccounts = append(md.MentionedAccounts, cfg.MentionedAccounts...)
+		md.MentionedGroups = append(md.MentionedGroups, cfg.MentionedGroups...)
+		title = fmt.Sprintf("Deployment for %q was approved", md.Deployment.ApplicationName)
+		text = fmt.Sprintf("Approved by %s", md.Approver)
+		generateDeploymentEventData(md.Deployment, md.MentionedAccounts, md.MentionedGroups)
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_SUCCEEDED:
+		md := event.Metadata.(*model.NotificationEventDeploymentSucceeded)
+		md.MentionedAccounts = append(md.MentionedAccounts, cfg.MentionedAccounts...)
+		md.MentionedGroups = append(md.MentionedGroups, cfg.MentionedGroups...)
+		title = fmt.Sprintf("Deployment for %q was completed successfully", md.Deployment.ApplicationName)
+		color = slackSuccessColor
+		generateDeploymentEventData(md.Deployment, md.MentionedAccounts, md.MentionedGroups)
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_FAILED:
+		md := event.Metadata.(*model.NotificationEventDeploymentFailed)
+		md.MentionedAccounts = append(md.MentionedAccounts, cfg.MentionedAccounts...)
+		md.MentionedGroups = append(md.MentionedGroups, cfg.MentionedGroups...)
+		title = fmt.Sprintf("Deployment for %q was failed", md.Deployment.ApplicationName)
+		text = md.Reason
+		color = slackErrorColor
+		generateDeploymentEventData(md.Deployment, md.MentionedAccounts, md.MentionedGroups)
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_CANCELLED:
+		md := event.Metadata.(*model.NotificationEventDeploymentCancelled)
+		md.MentionedAccounts = append(md.MentionedAccounts, cfg.MentionedAccounts...)
+		md.MentionedGroups = append(md.MentionedGroups, cfg.MentionedGroups...)
+		title = fmt.Sprintf("Deployment for %q was cancelled", md.Deployment.ApplicationName)
+		text = fmt.Sprintf("Cancelled by %s", md.Commander)
+		color = slackWarnColor
+		generateDeploymentEventData(md.Deployment, md.MentionedAccounts, md.MentionedGroups)
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGER_FAILED:
+		md := event.Metadata.(*model.NotificationEventDeploymentTriggerFailed)
+		md.MentionedAccounts = append(md.MentionedAccounts, cfg.MentionedAccounts...)
+		md.MentionedGroups = append(md.MentionedGroups, cfg.MentionedGroups...)
+		title = fmt.Sprintf("Failed to trigger a new deployment for %s", md.Application.Name)
+		text = md.Reason
+		generateDeploymentEventDataForTriggerFailed(md.Application, md.CommitHash, md.CommitMessage, md.MentionedAccounts, md.MentionedGroups)
+
+	case model.NotificationEventType_EVENT_PIPED_STARTED:
+		md := event.Metadata.(*model.NotificationEventPipedStarted)
+		title = "A piped has been started"
+		generatePipedEventData(md.Id, md.Name, md.Version, md.ProjectId, cfg.MentionedAccounts, cfg.MentionedGroups)
+
+	case model.NotificationEventType_EVENT_PIPED_STOPPED:
+		md := event.Metadata.(*model.NotificationEventPipedStopped)
+		title = "A piped has been stopped"
+		generatePipedEventData(md.Id, md.Name, md.Version, md.ProjectId, cfg.MentionedAccounts, cfg.MentionedGroups)
+
+	case model.NotificationEventType_EVENT_STAGE_STARTED:
+		md := event.Metadata.(*model.NotificationEventStageStarted)
+		title = fmt.Sprintf("Stage %q was started", md.Stage.Name)
+		generateStageEventData(md.Deployment, md.Stage, cfg.MentionedAccounts, cfg.MentionedGroups)
+
+	case model.NotificationEventType_EVENT_STAGE_SKIPPED:
+		md := event.Metadata.(*model.NotificationEventStageSkipped)
+		title = fmt.Sprintf("Stage %q was skipped", md.Stage.Name)
+		generateStageEventData(md.Deployment, md.Stage, cfg.MentionedAccounts, cfg.MentionedGroups)
+
+	case model.NotificationEventType_EVENT_STAGE_SUCCEEDED:
+		md := event.Metadata.(*model.NotificationEventStageSucceeded)
+		title = fmt.Sprintf("Stage %q was completed successfully", md.Stage.Name)
+		color = slackSuccessColor
+		generateStageEventData(md.Deployment, md.Stage, cfg.MentionedAccounts, cfg.MentionedGroups)
+
+	case model.NotificationEventType_EVENT_STAGE_FAILED:
+		md := event.Metadata.(*model.NotificationEventStageFailed)
+		title = fmt.Sprintf("Stage %q was failed", md.Stage.Name)
+		text = md.Stage.StatusReason
+		color = slackErrorColor
+		generateStageEventData(md.Deployment, md.Stage, cfg.MentionedAccounts, cfg.MentionedGroups)
+
+	case model.NotificationEventType_EVENT_STAGE_CANCELLED:
+		md := event.Metadata.(*model.NotificationEventStageCancelled)
+		title = fmt.Sprintf("Stage %q was cancelled", md.Stage.Name)
+		color = slackWarnColor
+		generateStageEventData(md.Deployment, md.Stage, cfg.MentionedAccounts, cfg.MentionedGroups)
+
+	// TODO: Support application type of notification event.
+	default:
+		return slackMessage{}, false
+	}
+
+	return makeSlackMessage(title, link, text, color, timestamp, fields...), true
+}
+
+type slackMessage struct {
+	Username    string            `json:"username"`
+	Attachments []slackAttachment `json:"attachments,omitempty"`
+}
+
+type slackAttachment struct {
+	Title     string       `json:"title"`
+	TitleLink string       `json:"title_link"`
+	Text      string       `json:"text"`
+	Fields    []slackField `json:"fields"`
+	Color     string       `json:"color,omitempty"`
+	Markdown  []string     `json:"mrkdwn_in,omitempty"`
+	Timestamp int64        `json:"ts,omitempty"`
+}
+
+type slackField struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+	Short bool   `json:"short"`
+}
+
+func makeSlackLink(title, url string) string {
+	return fmt.Sprintf("<%s|%s>", url, title)
+}
+
+func makeSlackDate(unix int64) string {
+	return fmt.Sprintf("<!date^%d^{date_num} {time_secs}|date>", unix)
+}
+
+// nolint:unparam
+func truncateText(text string, max int) string {
+	if len(text) <= max {
+		return text
+	}
+	return text[:max] + "..."
+}
+
+func makeSlackMessage(title, titleLink, text, color string, timestamp int64, fields ...slackField) slackMessage {
+	return slackMessage{
+		Username: slackUsername,
+		Attachments: []slackAttachment{{
+			Title:     title,
+			TitleLink: titleLink,
+			Text:      text,
+			Fields:    fields,
+			Color:     color,
+			Markdown:  []string{"text"},
+			Timestamp: timestamp,
+		}},
+	}
+}
+
+func getAccountsAsString(accounts []string) string {
+	if len(accounts) == 0 {
+		return ""
+	}
+	formattedAccounts := make([]string, 0, len(accounts))
+	for _, a := range accounts {
+		formattedAccounts = append(formattedAccounts, fmt.Sprintf("<@%s>", a))
+	}
+	return strings.Join(formattedAccounts, " ")
+}
+
+func getGroupsAsString(groups []string) string {
+	if len(groups) == 0 {
+		return ""
+	}
+	formattedGroups := make([]string, 0, len(groups))
+	for _, g := range groups {
+		if !strings.Contains(g, "!subteam^") {
+			formattedGroups = append(formattedGroups, fmt.Sprintf("<!subteam^%s>", g))
+		} else {
+			formattedGroups = append(formattedGroups, g)
+		}
+	}
+	return strings.Join(formattedGroups, " ")
+}
@@ -0,0 +1,216 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+func TestReceiverConfig_validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		config  receiverConfig
+		wantErr bool
+	}{
+		{
+			name:   "hook URL",
+			config: receiverConfig{HookURL: "https://hooks.slack.com/services/xxx"},
+		},
+		{
+			name:   "API with token data",
+			config: receiverConfig{ChannelID: "testid", OAuthTokenData: "dG9rZW4="},
+		},
+		{
+			name:    "both hook URL and API",
+			config:  receiverConfig{HookURL: "https://hooks.slack.com/services/xxx", ChannelID: "testid"},
+			wantErr: true,
+		},
+		{
+			name:    "missing token",
+			config:  receiverConfig{ChannelID: "testid"},
+			wantErr: true,
+		},
+		{
+			name:    "both token data and file",
+			config:  receiverConfig{ChannelID: "testid", OAuthTokenData: "dG9rZW4=", OAuthTokenFile: "/path/to/token"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.config.validate()
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestBuildSlackMessage(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1700000000, 0)
+	cfg := &receiverConfig{MentionedAccounts: []string{"foo"}}
+	event := model.NotificationEvent{
+		Type: model.NotificationEventType_EVENT_DEPLOYMENT_SUCCEEDED,
+		Metadata: &model.NotificationEventDeploymentSucceeded{
+			Deployment: &model.Deployment{
+				Id:              "deployment-id",
+				ProjectId:       "project",
+				ApplicationId:   "app-id",
+				ApplicationName: "app",
+				Trigger: &model.DeploymentTrigger{
+					Commander: "user",
+					Commit:    &model.Commit{},
+				},
+			},
+		},
+	}
+
+	msg, ok := buildSlackMessage(event, "https://pipecd.dev", cfg, now)
+	require.True(t, ok)
+	require.Len(t, msg.Attachments, 1)
+
+	attachment := msg.Attachments[0]
+	assert.Equal(t, slackUsername, msg.Username)
+	assert.Equal(t, `Deployment for "app" was completed successfully`, attachment.Title)
+	assert.Equal(t, "https://pipecd.dev/deployments/deployment-id?project=project", attachment.TitleLink)
+	assert.Equal(t, slackSuccessColor, attachment.Color)
+	assert.Equal(t, now.Unix(), attachment.Timestamp)
+	assert.Contains(t, attachment.Fields, slackField{"Mention To Users", "<@foo>", true})
+
+	_, ok = buildSlackMessage(model.NotificationEvent{Type: model.NotificationEventType_EVENT_APPLICATION_SYNCED}, "https://pipecd.dev", cfg, now)
+	assert.False(t, ok)
+}
+
+func TestPlugin_Notify(t *testing.T) {
+	t.Parallel()
+
+	var received slackMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(body, &received))
+	}))
+	defer server.Close()
+
+	cfg, err := json.Marshal(receiverConfig{HookURL: server.URL})
+	require.NoError(t, err)
+
+	p := &plugin{}
+	err = p.Notify(context.Background(), nil, &sdk.NotifyInput{
+		Request: sdk.NotifyRequest{
+			Receiver:       "dev-slack",
+			ReceiverConfig: cfg,
+			Event: model.NotificationEvent{
+				Type: model.NotificationEventType_EVENT_PIPED_STARTED,
+				Metadata: &model.NotificationEventPipedStarted{
+					Id:        "piped-id",
+					Name:      "piped",
+					Version:   "v1.0.0",
+					ProjectId: "project",
+				},
+			},
+			WebURL: "https://pipecd.dev/",
+		},
+		Logger: zaptest.NewLogger(t),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, received.Attachments, 1)
+	assert.Equal(t, "A piped has been started", received.Attachments[0].Title)
+	assert.Equal(t, "https://pipecd.dev/settings/piped?project=project", received.Attachments[0].TitleLink)
+}
+
+func Test_getAccountsAsString(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		accounts []string
+		want     string
+	}{
+		{
+			name:     "empty",
+			accounts: []string{},
+			want:     "",
+		},
+		{
+			name:     "single",
+			accounts: []string{"foo"},
+			want:     "<@foo>",
+		},
+		{
+			name:     "multiple",
+			accounts: []string{"foo", "bar"},
+			want:     "<@foo> <@bar>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, getAccountsAsString(tt.accounts))
+		})
+	}
+}
+
+func Test_getGroupsAsString(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		groups []string
+		want   string
+	}{
+		{
+			name:   "empty",
+			groups: []string{},
+			want:   "",
+		},
+		{
+			name:   "single",
+			groups: []string{"foo"},
+			want:   "<!subteam^foo>",
+		},
+		{
+			name:   "with correct format <!subteam^foo>",
+			groups: []string{"<!subteam^foo>"},
+			want:   "<!subteam^foo>",
+		},
+		{
+			name:   "multiple",
+			groups: []string{"foo", "bar"},
+			want:   "<!subteam^foo> <!subteam^bar>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, getGroupsAsString(tt.groups))
+		})
+	}
+}
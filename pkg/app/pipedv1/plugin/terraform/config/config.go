@@ -14,11 +14,22 @@
 
 package config
 
+const (
+	// BinaryTerraform uses HashiCorp Terraform.
+	BinaryTerraform = "terraform"
+	// BinaryOpenTofu uses OpenTofu.
+	BinaryOpenTofu = "tofu"
+)
+
 // Config represents the plugin-scoped configuration.
 type Config struct{}
 
 // DeployTargetConfig represents the deploy-target-scoped configuration.
 type DeployTargetConfig struct {
+	// The binary used to execute the terraform commands for the applications deployed to this deploy target.
+	// Available values are "terraform" and "tofu" to use OpenTofu.
+	// Empty means "terraform".
+	Binary string `json:"binary,omitempty"`
 	// List of variables that will be set directly on terraform commands with "-var" flag.
 	// The variable must be formatted by "key=value" as below:
 	// "image_id=ami-abc123"
@@ -36,6 +47,7 @@ type ApplicationConfigSpec struct {
 	// Empty means "default" workpsace.
 	Workspace string `json:"workspace,omitempty"`
 	// The version of terraform should be used.
+	// When the binary of the deploy target is "tofu", this is the version of OpenTofu.
 	// Empty means the pre-installed version will be used.
 	TerraformVersion string `json:"terraformVersion,omitempty"`
 	// List of variables that will be set directly on terraform commands with "-var" flag.
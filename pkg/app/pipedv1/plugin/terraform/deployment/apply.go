@@ -16,6 +16,8 @@ package deployment
 
 import (
 	"context"
+	"errors"
+	"io/fs"
 
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/terraform/config"
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/terraform/provider"
@@ -37,11 +39,50 @@ func (p *Plugin) executeApplyStage(ctx context.Context, input *sdk.ExecuteStageI
 		return sdk.StageStatusFailure
 	}
 
-	if err = cmd.Apply(ctx, slp); err != nil {
-		slp.Errorf("Failed to apply changes (%v)", err)
+	planDigest, planned, err := input.Client.GetDeploymentPluginMetadata(ctx, savedPlanDigestKey)
+	if err != nil {
+		slp.Errorf("Failed to get the saved plan result (%v)", err)
 		return sdk.StageStatusFailure
 	}
 
+	switch {
+	case !planned:
+		// No plan stage was executed before, so apply the current changes.
+		if err = cmd.Apply(ctx, slp); err != nil {
+			slp.Errorf("Failed to apply changes (%v)", err)
+			return sdk.StageStatusFailure
+		}
+	case planDigest == savedPlanNoChanges:
+		slp.Success("No changes to apply since the plan stage detected no changes")
+		return sdk.StageStatusSuccess
+	default:
+		planFile := planFilePath(input.Request.Deployment.ID)
+		digest, err := planFileDigest(planFile)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			// For example, the plugin was moved to another host after the plan stage.
+			slp.Errorf("The plan saved by the %s stage was not found. Please trigger a new deployment to plan again", stagePlan)
+			return sdk.StageStatusFailure
+		case err != nil:
+			slp.Errorf("Failed to find the saved plan (%v)", err)
+			return sdk.StageStatusFailure
+		case digest != planDigest:
+			slp.Errorf("The saved plan differs from the one made by the %s stage. Please trigger a new deployment to plan again", stagePlan)
+			return sdk.StageStatusFailure
+		}
+
+		slp.Info("Applying the changes saved by the plan stage")
+		if err = cmd.ApplyPlan(ctx, slp, planFile); err != nil {
+			// The plan file is kept on failure so that the stage can be retried with the same plan.
+			slp.Errorf("Failed to apply changes (%v)", err)
+			return sdk.StageStatusFailure
+		}
+		// A saved plan can be applied only once.
+		if err := removePlanFile(input.Request.Deployment.ID); err != nil {
+			input.Logger.Warn("failed to remove the applied plan file", zap.Error(err))
+		}
+	}
+
 	slp.Success("Successfully applied changes")
 	return sdk.StageStatusSuccess
 }
@@ -16,7 +16,12 @@ package deployment
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/terraform/config"
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/terraform/provider"
@@ -44,13 +49,25 @@ func (p *Plugin) executePlanStage(ctx context.Context, input *sdk.ExecuteStageIn
 		return sdk.StageStatusFailure
 	}
 
-	planResult, err := cmd.Plan(ctx, slp)
+	planFile, err := preparePlanFile(input.Request.Deployment.ID)
 	if err != nil {
+		slp.Errorf("Failed to prepare the plan file (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	planResult, err := cmd.SavePlan(ctx, slp, planFile)
+	if err != nil {
+		os.Remove(planFile)
 		slp.Errorf("Failed to plan (%v)", err)
 		return sdk.StageStatusFailure
 	}
 
 	if planResult.NoChanges() {
+		os.Remove(planFile)
+		if err := input.Client.PutDeploymentPluginMetadata(ctx, savedPlanDigestKey, savedPlanNoChanges); err != nil {
+			slp.Errorf("Failed to save the plan result (%v)", err)
+			return sdk.StageStatusFailure
+		}
 		slp.Success("No changes to apply")
 		if stageConfig.ExitOnNoChanges {
 			return sdk.StageStatusExited
@@ -58,6 +75,57 @@ func (p *Plugin) executePlanStage(ctx context.Context, input *sdk.ExecuteStageIn
 		return sdk.StageStatusSuccess
 	}
 
-	slp.Successf("Detected %d import, %d add, %d change, %d destroy.", planResult.Imports, planResult.Adds, planResult.Changes, planResult.Destroys)
+	// The plan file is kept on the local disk since it contains the values of variables and state
+	// which must not be stored outside of piped. Only its digest is saved in the deployment metadata
+	// so that the apply stage can confirm that it applies exactly the plan made by this stage.
+	digest, err := planFileDigest(planFile)
+	if err != nil {
+		slp.Errorf("Failed to read the plan file (%v)", err)
+		return sdk.StageStatusFailure
+	}
+	if err := input.Client.PutDeploymentPluginMetadata(ctx, savedPlanDigestKey, digest); err != nil {
+		slp.Errorf("Failed to save the plan result (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	if changes := planResult.RenderChanges(); changes != "" {
+		slp.Info(changes)
+	}
+	slp.Successf("Detected %d import, %d add, %d change, %d destroy. These changes will be applied by %s stage.", planResult.Imports, planResult.Adds, planResult.Changes, planResult.Destroys, stageApply)
 	return sdk.StageStatusSuccess
 }
+
+const (
+	// The key of the deployment metadata to store the digest of the plan file saved by the plan stage.
+	savedPlanDigestKey = "terraform-saved-plan-digest"
+	// The digest value used when the plan stage detected no changes.
+	savedPlanNoChanges = "no-changes"
+)
+
+// planFilePath returns the path to the plan file saved for the given deployment.
+func planFilePath(deploymentID string) string {
+	return filepath.Join(os.TempDir(), "terraform-plans", deploymentID, "plan.tfplan")
+}
+
+func preparePlanFile(deploymentID string) (string, error) {
+	path := planFilePath(deploymentID)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// removePlanFile removes the plan file saved for the given deployment together with its directory.
+func removePlanFile(deploymentID string) error {
+	return os.RemoveAll(filepath.Dir(planFilePath(deploymentID)))
+}
+
+// planFileDigest returns the SHA-256 digest of the given plan file.
+func planFileDigest(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read plan file %s (%w)", path, err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSavedPlanFile(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+
+	path, err := preparePlanFile("deployment-1")
+	require.NoError(t, err)
+	assert.Equal(t, planFilePath("deployment-1"), path)
+	assert.NotEqual(t, planFilePath("deployment-2"), path)
+
+	require.NoError(t, os.WriteFile(path, []byte("plan"), 0600))
+	digest, err := planFileDigest(path)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte("another plan"), 0600))
+	changed, err := planFileDigest(path)
+	require.NoError(t, err)
+	assert.NotEqual(t, digest, changed)
+
+	require.NoError(t, removePlanFile("deployment-1"))
+	_, err = os.Stat(filepath.Dir(path))
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+
+	_, err = planFileDigest(path)
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
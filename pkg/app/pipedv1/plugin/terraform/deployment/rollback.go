@@ -44,6 +44,11 @@ func (p *Plugin) executeRollbackStage(ctx context.Context, input *sdk.ExecuteSta
 		return sdk.StageStatusFailure
 	}
 
+	// The plan saved by the plan stage must not be applied anymore.
+	if err := removePlanFile(input.Request.Deployment.ID); err != nil {
+		input.Logger.Warn("failed to remove the saved plan file", zap.Error(err))
+	}
+
 	slp.Infof("Start rolling back to the state defined at commit %s", rds.CommitHash)
 	if err = cmd.Apply(ctx, slp); err != nil {
 		slp.Errorf("Failed to apply changes (%v)", err)
@@ -70,14 +70,20 @@ func toResponse(planResult provider.PlanResult, planBuf *bytes.Buffer, deployTar
 		}
 	}
 
+	// Prefer the changes read from the JSON plan since they are more compact than the plan output.
+	diffLanguage, details := "hcl", planBuf.Bytes()
+	if changes := planResult.RenderChanges(); changes != "" {
+		diffLanguage, details = "diff", []byte(changes)
+	}
+
 	return &sdk.GetPlanPreviewResponse{
 		Results: []sdk.PlanPreviewResult{
 			{
 				DeployTarget: deployTarget,
 				NoChange:     false,
 				Summary:      fmt.Sprintf("%d to import, %d to add, %d to change, %d to destroy", planResult.Imports, planResult.Adds, planResult.Changes, planResult.Destroys),
-				DiffLanguage: "hcl",
-				Details:      planBuf.Bytes(),
+				DiffLanguage: diffLanguage,
+				Details:      details,
 			},
 		},
 	}
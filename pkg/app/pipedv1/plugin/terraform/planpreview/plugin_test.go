@@ -84,6 +84,48 @@ Note: You didn't use the -out option to save this plan, so Terraform can't guara
 				},
 			},
 		},
+		{
+			name: "with resource changes",
+			planResult: provider.PlanResult{
+				Adds:     1,
+				Changes:  1,
+				Destroys: 0,
+				ResourceChanges: []provider.ResourceChange{
+					{
+						Address: "aws_s3_bucket.logs",
+						Type:    "aws_s3_bucket",
+						Action:  provider.ActionCreate,
+						Attributes: []provider.AttributeChange{
+							{Name: "bucket", After: `"logs"`},
+						},
+					},
+					{
+						Address: "aws_instance.web",
+						Type:    "aws_instance",
+						Action:  provider.ActionUpdate,
+						Attributes: []provider.AttributeChange{
+							{Name: "instance_type", Before: `"t3.micro"`, After: `"t3.small"`},
+						},
+					},
+				},
+			},
+			planBuf: bytes.NewBuffer([]byte("<plan-output>")),
+			want: &sdk.GetPlanPreviewResponse{
+				Results: []sdk.PlanPreviewResult{
+					{
+						DeployTarget: "dt-1",
+						NoChange:     false,
+						Summary:      "0 to import, 1 to add, 1 to change, 0 to destroy",
+						DiffLanguage: "diff",
+						Details: []byte(`+ aws_s3_bucket.logs (create)
++     bucket: "logs"
+~ aws_instance.web (update)
+~     instance_type: "t3.micro" -> "t3.small"
+`),
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
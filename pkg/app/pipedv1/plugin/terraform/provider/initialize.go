@@ -44,9 +44,9 @@ func NewTerraformCommand(ctx context.Context, client *sdk.Client, ds sdk.Deploym
 	}
 
 	tr := toolregistry.NewRegistry(client.ToolRegistry())
-	terraformPath, err := tr.Terraform(ctx, appSpec.TerraformVersion)
+	terraformPath, err := findBinary(ctx, tr, dt.Config.Binary, appSpec.TerraformVersion)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find terraform (%v)", err)
+		return nil, err
 	}
 
 	cmd := newTerraform(
@@ -73,6 +73,27 @@ func NewTerraformCommand(ctx context.Context, client *sdk.Client, ds sdk.Deploym
 	return cmd, nil
 }
 
+// findBinary installs the given binary with the specified version and returns the path to it.
+// The binary must be either "terraform" or "tofu", and empty means "terraform".
+func findBinary(ctx context.Context, tr *toolregistry.Registry, binary, version string) (string, error) {
+	switch binary {
+	case "", config.BinaryTerraform:
+		path, err := tr.Terraform(ctx, version)
+		if err != nil {
+			return "", fmt.Errorf("failed to find terraform (%v)", err)
+		}
+		return path, nil
+	case config.BinaryOpenTofu:
+		path, err := tr.OpenTofu(ctx, version)
+		if err != nil {
+			return "", fmt.Errorf("failed to find tofu (%v)", err)
+		}
+		return path, nil
+	default:
+		return "", fmt.Errorf("unsupported terraform binary %q, it must be one of %q and %q", binary, config.BinaryTerraform, config.BinaryOpenTofu)
+	}
+}
+
 func mergeVars(deployTargetVars []string, appVars []string) []string {
 	// TODO: Validate duplication
 	mergedVars := make([]string, 0, len(deployTargetVars)+len(appVars))
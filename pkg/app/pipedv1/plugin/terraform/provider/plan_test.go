@@ -0,0 +1,131 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJSONPlan(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("testdata/plan.json")
+	require.NoError(t, err)
+
+	result, err := parseJSONPlan(data)
+	require.NoError(t, err)
+
+	expected := PlanResult{
+		Adds:            2,
+		Changes:         1,
+		Destroys:        2,
+		Imports:         1,
+		HasStateChanges: true,
+		ResourceChanges: []ResourceChange{
+			{
+				Address: "aws_instance.web",
+				Type:    "aws_instance",
+				Action:  ActionCreate,
+				Attributes: []AttributeChange{
+					{Name: "ami", After: `"ami-123"`},
+					{Name: "id", After: "(known after apply)"},
+					{Name: "tags", After: `{"Name":"web"}`},
+				},
+			},
+			{
+				Address: "aws_security_group.web",
+				Type:    "aws_security_group",
+				Action:  ActionUpdate,
+				Attributes: []AttributeChange{
+					{Name: "description", Before: `"old"`, After: `"new"`},
+				},
+			},
+			{
+				Address: `module.db.aws_db_instance.main["primary"]`,
+				Type:    "aws_db_instance",
+				Action:  ActionDelete,
+			},
+			{
+				Address: "aws_s3_bucket.logs",
+				Type:    "aws_s3_bucket",
+				Action:  ActionReplace,
+				Attributes: []AttributeChange{
+					{Name: "bucket", Before: `"logs"`, After: `"logs-v2"`},
+					{Name: "id", Before: `"logs"`, After: "(known after apply)"},
+					{Name: "token", Before: "(sensitive value)", After: "(sensitive value)"},
+				},
+			},
+			{
+				Address: "aws_iam_role.imported",
+				Type:    "aws_iam_role",
+				Action:  ActionImport,
+			},
+		},
+	}
+	assert.Equal(t, expected, result)
+	assert.False(t, result.NoChanges())
+
+	_, err = parseJSONPlan([]byte("invalid"))
+	assert.Error(t, err)
+}
+
+func TestParseJSONPlanNoResourceChanges(t *testing.T) {
+	t.Parallel()
+
+	result, err := parseJSONPlan([]byte(`{"output_changes":{"foo":{"actions":["create"],"before":null,"after":"bar"}}}`))
+	require.NoError(t, err)
+	assert.Equal(t, PlanResult{HasStateChanges: true}, result)
+}
+
+func TestRenderChanges(t *testing.T) {
+	t.Parallel()
+
+	r := PlanResult{
+		ResourceChanges: []ResourceChange{
+			{
+				Address: "aws_instance.web",
+				Action:  ActionCreate,
+				Attributes: []AttributeChange{
+					{Name: "ami", After: `"ami-123"`},
+				},
+			},
+			{
+				Address: "aws_security_group.web",
+				Action:  ActionUpdate,
+				Attributes: []AttributeChange{
+					{Name: "description", Before: `"old"`, After: `"new"`},
+					{Name: "tags", Before: `{"a":"b"}`},
+				},
+			},
+			{
+				Address: "aws_db_instance.main",
+				Action:  ActionDelete,
+			},
+		},
+	}
+
+	expected := `+ aws_instance.web (create)
++     ami: "ami-123"
+~ aws_security_group.web (update)
+~     description: "old" -> "new"
+-     tags: {"a":"b"}
+- aws_db_instance.main (delete)
+`
+	assert.Equal(t, expected, r.RenderChanges())
+}
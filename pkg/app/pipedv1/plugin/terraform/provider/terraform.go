@@ -22,6 +22,7 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
@@ -83,7 +84,9 @@ func WithAdditionalEnvs(shared, init, plan, apply []string) Option {
 
 type Terraform struct {
 	execPath string
-	dir      string
+	// The name of the command, either terraform or tofu.
+	name string
+	dir  string
 
 	options options
 }
@@ -96,11 +99,22 @@ func newTerraform(execPath, dir string, opts ...Option) *Terraform {
 
 	return &Terraform{
 		execPath: execPath,
+		name:     commandName(execPath),
 		dir:      dir,
 		options:  opt,
 	}
 }
 
+// commandName returns the name of the command at the given path.
+// The tools are installed as tofu or tofu-{version} for OpenTofu,
+// and any other binaries are treated as Terraform.
+func commandName(execPath string) string {
+	if strings.HasPrefix(filepath.Base(execPath), "tofu") {
+		return "tofu"
+	}
+	return "terraform"
+}
+
 func (t *Terraform) version(ctx context.Context) (string, error) {
 	args := []string{"version"}
 	cmd := exec.CommandContext(ctx, t.execPath, args...)
@@ -131,7 +145,7 @@ func (t *Terraform) init(ctx context.Context, w io.Writer) error {
 	env = append(env, t.options.initEnvs...)
 	cmd.Env = env
 
-	io.WriteString(w, fmt.Sprintf("terraform %s", strings.Join(args, " ")))
+	io.WriteString(w, fmt.Sprintf("%s %s", t.name, strings.Join(args, " ")))
 	return cmd.Run()
 }
 
@@ -159,6 +173,9 @@ type PlanResult struct {
 	Destroys        int
 	Imports         int
 	HasStateChanges bool
+	// The changes per resource address.
+	// This is only available when the plan was read from the JSON output.
+	ResourceChanges []ResourceChange
 
 	PlanOutput string
 }
@@ -273,11 +290,27 @@ func GetExitCode(err error) int {
 	return 1
 }
 
+// Plan runs terraform plan and returns the planned changes.
 func (t *Terraform) Plan(ctx context.Context, w io.Writer) (PlanResult, error) {
+	f, err := os.CreateTemp("", "terraform-plan-*")
+	if err != nil {
+		return PlanResult{}, fmt.Errorf("failed to create plan file (%w)", err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+
+	return t.SavePlan(ctx, w, f.Name())
+}
+
+// SavePlan runs terraform plan and writes the plan into the given file
+// so that exactly the same changes can be applied later by ApplyPlan.
+// The planned changes are read from the saved plan by terraform show -json.
+func (t *Terraform) SavePlan(ctx context.Context, w io.Writer, planFile string) (PlanResult, error) {
 	args := []string{
 		"plan",
 		"-lock=false",
 		"-detailed-exitcode",
+		fmt.Sprintf("-out=%s", planFile),
 	}
 	args = append(args, t.makeCommonCommandArgs()...)
 	args = append(args, t.options.planFlags...)
@@ -294,18 +327,47 @@ func (t *Terraform) Plan(ctx context.Context, w io.Writer) (PlanResult, error) {
 	env = append(env, t.options.planEnvs...)
 	cmd.Env = env
 
-	io.WriteString(w, fmt.Sprintf("terraform %s", strings.Join(args, " ")))
+	io.WriteString(w, fmt.Sprintf("%s %s", t.name, strings.Join(args, " ")))
 	err := cmd.Run()
 	switch GetExitCode(err) {
 	case 0:
 		return PlanResult{}, nil
 	case 2:
-		return parsePlanResult(buf.String(), !t.options.noColor)
+		result, err := t.showPlan(ctx, planFile)
+		if err != nil {
+			// The terraform versions older than v0.12 do not support the JSON output.
+			return parsePlanResult(buf.String(), !t.options.noColor)
+		}
+		result.PlanOutput = buf.String()
+		if !t.options.noColor {
+			result.PlanOutput = stripAnsiCodes(result.PlanOutput)
+		}
+		return result, nil
 	default:
 		return PlanResult{}, err
 	}
 }
 
+func (t *Terraform) showPlan(ctx context.Context, planFile string) (PlanResult, error) {
+	args := []string{
+		"show",
+		"-json",
+		planFile,
+	}
+	cmd := exec.CommandContext(ctx, t.execPath, args...)
+	cmd.Dir = t.dir
+
+	env := append(os.Environ(), t.options.sharedEnvs...)
+	env = append(env, t.options.planEnvs...)
+	cmd.Env = env
+
+	out, err := cmd.Output()
+	if err != nil {
+		return PlanResult{}, fmt.Errorf("failed to show plan file %s (%w)", planFile, err)
+	}
+	return parseJSONPlan(out)
+}
+
 func (t *Terraform) makeCommonCommandArgs() (args []string) {
 	if t.options.noColor {
 		args = append(args, "-no-color")
@@ -407,6 +469,34 @@ func (t *Terraform) Apply(ctx context.Context, w io.Writer) error {
 	env = append(env, t.options.applyEnvs...)
 	cmd.Env = env
 
-	io.WriteString(w, fmt.Sprintf("terraform %s", strings.Join(args, " ")))
+	io.WriteString(w, fmt.Sprintf("%s %s", t.name, strings.Join(args, " ")))
+	return cmd.Run()
+}
+
+// ApplyPlan applies exactly the changes saved in the given plan file.
+// Terraform rejects the plan if the state was changed after it was planned.
+func (t *Terraform) ApplyPlan(ctx context.Context, w io.Writer, planFile string) error {
+	args := []string{
+		"apply",
+		"-input=false",
+	}
+	// The variables were already saved in the plan file so they must not be given again.
+	if t.options.noColor {
+		args = append(args, "-no-color")
+	}
+	args = append(args, t.options.sharedFlags...)
+	args = append(args, t.options.applyFlags...)
+	args = append(args, planFile)
+
+	cmd := exec.CommandContext(ctx, t.execPath, args...)
+	cmd.Dir = t.dir
+	cmd.Stdout = w
+	cmd.Stderr = w
+
+	env := append(os.Environ(), t.options.sharedEnvs...)
+	env = append(env, t.options.applyEnvs...)
+	cmd.Env = env
+
+	io.WriteString(w, fmt.Sprintf("%s %s", t.name, strings.Join(args, " ")))
 	return cmd.Run()
 }
@@ -15,9 +15,14 @@
 package provider
 
 import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPlanHasChangeRegex(t *testing.T) {
@@ -297,3 +302,66 @@ guarantee to take exactly these actions if you run "terraform apply" now.`,
 		})
 	}
 }
+
+func TestCommandName(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		execPath string
+		expected string
+	}{
+		{
+			name:     "terraform",
+			execPath: "/usr/local/bin/terraform",
+			expected: "terraform",
+		},
+		{
+			name:     "terraform with version",
+			execPath: "/home/pipecd/tools/terraform-1.5.7",
+			expected: "terraform",
+		},
+		{
+			name:     "opentofu",
+			execPath: "/usr/local/bin/tofu",
+			expected: "tofu",
+		},
+		{
+			name:     "opentofu with version",
+			execPath: "/home/pipecd/tools/tofu-1.6.2",
+			expected: "tofu",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, commandName(tc.execPath))
+		})
+	}
+}
+
+func TestOpenTofuBinary(t *testing.T) {
+	t.Parallel()
+
+	// A fake tofu binary which prints its version and echoes the given arguments.
+	execPath := filepath.Join(t.TempDir(), "tofu-1.6.2")
+	script := `#!/bin/sh
+if [ "$1" = "version" ]; then
+  echo "OpenTofu v1.6.2"
+  exit 0
+fi
+echo "args: $@"
+`
+	require.NoError(t, os.WriteFile(execPath, []byte(script), 0755))
+
+	ctx := context.Background()
+	tf := newTerraform(execPath, t.TempDir(), WithoutColor())
+
+	version, err := tf.version(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "OpenTofu v1.6.2", version)
+
+	var buf bytes.Buffer
+	require.NoError(t, tf.init(ctx, &buf))
+	assert.Equal(t, "tofu init -no-colorargs: init -no-color\n", buf.String())
+}
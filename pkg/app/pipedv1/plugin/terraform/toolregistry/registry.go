@@ -21,6 +21,7 @@ import (
 
 const (
 	defaultTerraformVersion = "0.13.0"
+	defaultOpenTofuVersion  = "1.6.2"
 )
 
 type client interface {
@@ -43,3 +44,9 @@ type Registry struct {
 func (r *Registry) Terraform(ctx context.Context, version string) (string, error) {
 	return r.client.InstallTool(ctx, "terraform", cmp.Or(version, defaultTerraformVersion), terraformInstallScript)
 }
+
+// OpenTofu installs the tofu command with the given version and return the path to the installed binary.
+// If the version is empty, the default version will be used.
+func (r *Registry) OpenTofu(ctx context.Context, version string) (string, error) {
+	return r.client.InstallTool(ctx, "tofu", cmp.Or(version, defaultOpenTofuVersion), opentofuInstallScript)
+}
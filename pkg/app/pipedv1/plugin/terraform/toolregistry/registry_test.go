@@ -16,7 +16,10 @@ package toolregistry
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"testing"
 
 	"github.com/pipe-cd/piped-plugin-sdk-go/toolregistry/toolregistrytest"
@@ -42,3 +45,56 @@ func TestRegistry_Terraform(t *testing.T) {
 
 	assert.Contains(t, string(out), expected)
 }
+
+// fakeClient installs a stub binary which only prints the installed tool and version.
+type fakeClient struct {
+	binDir string
+	script string
+}
+
+func (c *fakeClient) InstallTool(_ context.Context, name, version, script string) (string, error) {
+	c.script = script
+	path := filepath.Join(c.binDir, fmt.Sprintf("%s-%s", name, version))
+	stub := fmt.Sprintf("#!/bin/sh\necho %s v%s\n", name, version)
+	if err := os.WriteFile(path, []byte(stub), 0755); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+func TestRegistry_OpenTofu(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		version  string
+		expected string
+	}{
+		{
+			name:     "specified version",
+			version:  "1.8.0",
+			expected: "tofu v1.8.0",
+		},
+		{
+			name:     "default version",
+			version:  "",
+			expected: "tofu v" + defaultOpenTofuVersion,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := &fakeClient{binDir: t.TempDir()}
+			r := NewRegistry(c)
+
+			p, err := r.OpenTofu(context.Background(), tc.version)
+			require.NoError(t, err)
+			assert.Contains(t, c.script, "github.com/opentofu/opentofu/releases")
+
+			out, err := exec.CommandContext(context.Background(), p, "version").CombinedOutput()
+			require.NoError(t, err)
+			assert.Contains(t, string(out), tc.expected)
+		})
+	}
+}
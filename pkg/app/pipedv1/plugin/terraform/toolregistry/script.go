@@ -20,3 +20,10 @@ curl https://releases.hashicorp.com/terraform/{{ .Version }}/terraform_{{ .Versi
 unzip terraform_{{ .Version }}_{{ .Os }}_{{ .Arch }}.zip
 mv terraform {{ .OutPath }}
 `
+
+var opentofuInstallScript = `
+cd {{ .TmpDir }}
+curl -L https://github.com/opentofu/opentofu/releases/download/v{{ .Version }}/tofu_{{ .Version }}_{{ .Os }}_{{ .Arch }}.zip -o tofu_{{ .Version }}_{{ .Os }}_{{ .Arch }}.zip
+unzip tofu_{{ .Version }}_{{ .Os }}_{{ .Arch }}.zip
+mv tofu {{ .OutPath }}
+`
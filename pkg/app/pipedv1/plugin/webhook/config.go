@@ -0,0 +1,59 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"os"
+	"strings"
+)
+
+const defaultSignatureKey = "PipeCD-Signature"
+
+// receiverConfig is the receiver specific configuration for the webhook plugin.
+// It is set on the plugin field of the notification receivers in the piped config.
+type receiverConfig struct {
+	URL                string `json:"url"`
+	SignatureKey       string `json:"signatureKey,omitempty"`
+	SignatureValue     string `json:"signatureValue,omitempty"`
+	SignatureValueFile string `json:"signatureValueFile,omitempty"`
+}
+
+func (c *receiverConfig) validate() error {
+	if c.URL == "" {
+		return errors.New("url must be set")
+	}
+	if c.SignatureValue != "" && c.SignatureValueFile != "" {
+		return errors.New("only either signatureValue or signatureValueFile can be set")
+	}
+	if c.SignatureKey == "" {
+		c.SignatureKey = defaultSignatureKey
+	}
+	return nil
+}
+
+func (c *receiverConfig) loadSignatureValue() (string, error) {
+	if c.SignatureValue != "" {
+		return c.SignatureValue, nil
+	}
+	if c.SignatureValueFile != "" {
+		val, err := os.ReadFile(c.SignatureValueFile)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(val)), nil
+	}
+	return "", nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+type plugin struct{}
+
+var httpClient = &http.Client{
+	Timeout: 5 * time.Second,
+}
+
+// Notify implements sdk.NotificationPlugin.
+// It posts the JSON encoded event to the configured URL.
+func (p *plugin) Notify(ctx context.Context, _ sdk.ConfigNone, input *sdk.NotifyInput) error {
+	cfg, err := sdk.ParseReceiverConfig[receiverConfig](input.Request)
+	if err != nil {
+		return fmt.Errorf("failed to parse the receiver config: %w", err)
+	}
+	if err := cfg.validate(); err != nil {
+		return fmt.Errorf("invalid receiver config: %w", err)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := json.NewEncoder(buf).Encode(input.Request.Event); err != nil {
+		return fmt.Errorf("failed to encode the event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", cfg.URL, buf)
+	if err != nil {
+		return err
+	}
+
+	signature, err := cfg.loadSignatureValue()
+	if err != nil {
+		return fmt.Errorf("unable to load webhook signature value: %w", err)
+	}
+	req.Header.Add(cfg.SignatureKey, signature)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status was returned from the destination of webhook: %s", resp.Status)
+	}
+	return nil
+}
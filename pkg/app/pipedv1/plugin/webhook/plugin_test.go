@@ -0,0 +1,131 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+func TestReceiverConfig_validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		config           receiverConfig
+		wantSignatureKey string
+		wantErr          bool
+	}{
+		{
+			name:             "default signature key",
+			config:           receiverConfig{URL: "https://example.com"},
+			wantSignatureKey: defaultSignatureKey,
+		},
+		{
+			name:             "custom signature key",
+			config:           receiverConfig{URL: "https://example.com", SignatureKey: "X-Signature"},
+			wantSignatureKey: "X-Signature",
+		},
+		{
+			name:    "missing url",
+			config:  receiverConfig{},
+			wantErr: true,
+		},
+		{
+			name:    "both signature value and file",
+			config:  receiverConfig{URL: "https://example.com", SignatureValue: "value", SignatureValueFile: "/path/to/file"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.config.validate()
+			assert.Equal(t, tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(t, tt.wantSignatureKey, tt.config.SignatureKey)
+			}
+		})
+	}
+}
+
+func TestPlugin_Notify(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{
+			name:   "success",
+			status: http.StatusOK,
+		},
+		{
+			name:    "unexpected status",
+			status:  http.StatusInternalServerError,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				signature string
+				body      []byte
+			)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				signature = r.Header.Get(defaultSignatureKey)
+				body, _ = io.ReadAll(r.Body)
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			cfg, err := json.Marshal(receiverConfig{URL: server.URL, SignatureValue: "secret"})
+			require.NoError(t, err)
+
+			p := &plugin{}
+			err = p.Notify(context.Background(), nil, &sdk.NotifyInput{
+				Request: sdk.NotifyRequest{
+					Receiver:       "dev-webhook",
+					ReceiverConfig: cfg,
+					Event: model.NotificationEvent{
+						Type: model.NotificationEventType_EVENT_PIPED_STARTED,
+						Metadata: &model.NotificationEventPipedStarted{
+							Id:   "piped-id",
+							Name: "piped",
+						},
+					},
+				},
+				Logger: zaptest.NewLogger(t),
+			})
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, "secret", signature)
+			assert.JSONEq(t, `{"Type":300,"Metadata":{"id":"piped-id","name":"piped"}}`, string(body))
+		})
+	}
+}
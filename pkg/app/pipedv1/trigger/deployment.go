@@ -51,6 +51,7 @@ func buildDeployment(
 	noti *config.DeploymentNotification,
 	deploymentChainID string,
 	deploymentChainBlockIndex uint32,
+	freezeBypassJustification string,
 ) (*model.Deployment, error) {
 
 	var commitURL string
@@ -70,6 +71,9 @@ func buildDeployment(
 		}
 		metadata[model.MetadataKeyDeploymentNotification] = string(value)
 	}
+	if freezeBypassJustification != "" {
+		metadata[model.MetadataKeyDeploymentFreezeBypassJustification] = freezeBypassJustification
+	}
 
 	deployment := &model.Deployment{
 		Id:              uuid.New().String(),
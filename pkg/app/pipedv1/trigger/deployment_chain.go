@@ -20,6 +20,7 @@ import (
 
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
 	config "github.com/pipe-cd/pipecd/pkg/configv1"
+	"github.com/pipe-cd/pipecd/pkg/git"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
@@ -31,9 +32,12 @@ func (t *Trigger) triggerDeploymentChain(
 	matchers := make([]*pipedservice.CreateDeploymentChainRequest_ApplicationMatcher, 0, len(dc.ApplicationMatchers))
 	for _, m := range dc.ApplicationMatchers {
 		matchers = append(matchers, &pipedservice.CreateDeploymentChainRequest_ApplicationMatcher{
-			Name:   m.Name,
-			Kind:   m.Kind,
-			Labels: m.Labels,
+			Name:                   m.Name,
+			Kind:                   m.Kind,
+			Labels:                 m.Labels,
+			ContinueOnFailure:      m.ContinueOnFailure,
+			CancelOnChainFailure:   m.CancelOnChainFailure,
+			RollbackOnChainFailure: m.RollbackOnChainFailure,
 		})
 	}
 
@@ -45,3 +49,35 @@ func (t *Trigger) triggerDeploymentChain(
 	}
 	return nil
 }
+
+// shouldTriggerDeploymentChain checks whether the deployment of the given application at the given commit
+// satisfies the conditions to trigger a whole new deployment chain or not.
+func (t *Trigger) shouldTriggerDeploymentChain(
+	ctx context.Context,
+	repo git.Repo,
+	app *model.Application,
+	commit git.Commit,
+	dc *config.DeploymentChain,
+) (bool, error) {
+	cond := dc.Conditions
+	if cond == nil {
+		return true, nil
+	}
+
+	// Nil changed files means all files are considered as changed.
+	var changedFiles []string
+	if len(cond.Paths) != 0 {
+		preCommit, err := t.commitStore.Get(ctx, app.Id)
+		if err != nil {
+			return false, fmt.Errorf("could not get last triggered commit: %w", err)
+		}
+		if preCommit != "" && preCommit != commit.Hash {
+			changedFiles, err = repo.ChangedFiles(ctx, preCommit, commit.Hash)
+			if err != nil {
+				return false, fmt.Errorf("could not list changed files: %w", err)
+			}
+		}
+	}
+
+	return cond.Match(commit.Message, app.Labels, changedFiles)
+}
@@ -24,6 +24,8 @@ import (
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
 	"github.com/pipe-cd/pipecd/pkg/cache/memorycache"
@@ -73,6 +75,24 @@ func (c *candidate) HasCommand() bool {
 	return c.kind == model.TriggerKind_ON_COMMAND || c.kind == model.TriggerKind_ON_CHAIN
 }
 
+// RollbackCommit returns the previously running commit to which the application was commanded to be rolled back.
+// Empty string is returned when the candidate is not a rollback.
+func (c *candidate) RollbackCommit() string {
+	if c.kind != model.TriggerKind_ON_COMMAND {
+		return ""
+	}
+	return c.command.GetSyncApplication().GetTargetCommit()
+}
+
+// FreezeBypassJustification returns the reason given by the user
+// who commanded to deploy the application regardless of deployment freeze windows.
+func (c *candidate) FreezeBypassJustification() string {
+	if !c.HasCommand() {
+		return ""
+	}
+	return c.command.Metadata[model.MetadataKeyDeploymentFreezeBypassJustification]
+}
+
 type Trigger struct {
 	apiClient         apiClient
 	gitClient         gitClient
@@ -262,6 +282,42 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 			continue
 		}
 
+		// Hold the deployment while the application is inside one of its deployment freeze windows.
+		// The commit is not marked as triggered so that it will be deployed once the window is over.
+		// Deployments in a chain are not held because that would stall the chain forever,
+		// the planner checks them again right before planning and fails the frozen ones instead.
+		freezeBypassJustification := c.FreezeBypassJustification()
+		if freezeBypassJustification == "" && c.kind != model.TriggerKind_ON_CHAIN {
+			w, err := appCfg.FindActiveDeploymentFreezeWindow(time.Now())
+			if err != nil {
+				msg := fmt.Sprintf("failed while checking deployment freeze windows of application %s: %s", app.Name, err)
+				t.notifyDeploymentTriggerFailed(app, appCfg, msg, headCommit)
+				t.logger.Error(msg, zap.Error(err))
+				continue
+			}
+			if w != nil {
+				t.holdFrozenCandidate(ctx, c, w.Reason())
+				continue
+			}
+		}
+
+		// Deploy the commit specified by the command instead of the head commit
+		// in case the application is commanded to be rolled back.
+		commit := headCommit
+		rollbackCommit := c.RollbackCommit()
+		if rollbackCommit != "" {
+			commit, err = gitRepo.GetCommitForRev(ctx, rollbackCommit)
+			if err != nil {
+				msg := fmt.Sprintf("failed to find commit %s to roll back application %s: %v", rollbackCommit, app.Name, err)
+				t.notifyDeploymentTriggerFailed(app, appCfg, msg, headCommit)
+				t.logger.Error(msg, zap.Error(err))
+				if err := c.command.Report(ctx, model.CommandStatus_COMMAND_FAILED, nil, []byte(msg)); err != nil {
+					t.logger.Error("failed to report command status", zap.Error(err))
+				}
+				continue
+			}
+		}
+
 		var (
 			commander                 string
 			strategy                  model.SyncStrategy
@@ -279,6 +335,9 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 			} else {
 				strategySummary = "Sync with the specified pipeline because piped received a command from user via web console or pipectl"
 			}
+			if rollbackCommit != "" {
+				strategySummary = fmt.Sprintf("Roll back to the previously running commit %s because piped received a command to revert the application", rollbackCommit)
+			}
 
 		case model.TriggerKind_ON_CHAIN:
 			strategy = c.command.GetChainSyncApplication().SyncStrategy
@@ -295,7 +354,7 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 		deployment, err := buildDeployment(
 			app,
 			branch,
-			headCommit,
+			commit,
 			commander,
 			strategy,
 			strategySummary,
@@ -303,6 +362,7 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 			appCfg.DeploymentNotification,
 			deploymentChainID,
 			deploymentChainBlockIndex,
+			freezeBypassJustification,
 		)
 		if err != nil {
 			msg := fmt.Sprintf("failed to build deployment for application %s: %v", app.Id, err)
@@ -312,10 +372,33 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 		}
 
 		// In case the triggered deployment is of application that can trigger a deployment chain
-		// create a new deployment chain with its configuration besides with the first deployment
-		// in that chain.
-		if appCfg.PostSync != nil && appCfg.PostSync.DeploymentChain != nil {
+		// and satisfies the chain conditions, create a new deployment chain with its configuration
+		// besides with the first deployment in that chain.
+		// Rollbacks never trigger the deployment chain again.
+		var chainTriggered bool
+		if rollbackCommit == "" && appCfg.PostSync != nil && appCfg.PostSync.DeploymentChain != nil {
+			chainTriggered, err = t.shouldTriggerDeploymentChain(ctx, gitRepo, app, headCommit, appCfg.PostSync.DeploymentChain)
+			if err != nil {
+				msg := fmt.Sprintf("failed while determining whether the deployment chain of application %s should be triggered or not: %v", app.Id, err)
+				t.notifyDeploymentTriggerFailed(app, appCfg, msg, headCommit)
+				t.logger.Error(msg, zap.Error(err))
+				continue
+			}
+			if !chainTriggered {
+				t.logger.Info("deployment chain conditions were not satisfied, only the application will be deployed",
+					zap.String("app", app.Name),
+					zap.String("app-id", app.Id),
+					zap.String("commit", headCommit.Hash),
+				)
+			}
+		}
+		if chainTriggered {
 			if err := t.triggerDeploymentChain(ctx, appCfg.PostSync.DeploymentChain, deployment); err != nil {
+				// The control-plane rejects chains having any block inside the deployment freeze windows of the project.
+				if status.Code(err) == codes.FailedPrecondition {
+					t.holdFrozenCandidate(ctx, c, status.Convert(err).Message())
+					continue
+				}
 				msg := fmt.Sprintf("failed to trigger application %s and its deployment chain: %v", app.Id, err)
 				t.notifyDeploymentTriggerFailed(app, appCfg, msg, headCommit)
 				t.logger.Error(msg, zap.Error(err))
@@ -324,6 +407,11 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 		} else {
 			// Send a request to API to create a new deployment.
 			if err := t.triggerDeployment(ctx, deployment); err != nil {
+				// The control-plane rejects deployments inside the deployment freeze windows of the project.
+				if status.Code(err) == codes.FailedPrecondition {
+					t.holdFrozenCandidate(ctx, c, status.Convert(err).Message())
+					continue
+				}
 				msg := fmt.Sprintf("failed to trigger application %s: %v", app.Id, err)
 				t.notifyDeploymentTriggerFailed(app, appCfg, msg, headCommit)
 				t.logger.Error(msg, zap.Error(err))
@@ -334,8 +422,11 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 		// TODO: Find a better way to ensure that the application should be updated correctly
 		// when the deployment was successfully triggered.
 		// This error is ignored because the deployment was already registered successfully.
-		if e := reportMostRecentlyTriggeredDeployment(ctx, t.apiClient, deployment); e != nil {
-			t.logger.Error("failed to report most recently triggered deployment", zap.Error(e))
+		// Rollbacks are not reported so that the head commit is not deployed again right after them.
+		if rollbackCommit == "" {
+			if e := reportMostRecentlyTriggeredDeployment(ctx, t.apiClient, deployment); e != nil {
+				t.logger.Error("failed to report most recently triggered deployment", zap.Error(e))
+			}
 		}
 
 		triggered[app.Id] = struct{}{}
@@ -356,6 +447,22 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 	return nil
 }
 
+// holdFrozenCandidate skips triggering the given candidate because of a deployment freeze window.
+// The sync command is marked as failed since it will not be retried, while the other triggers
+// will be checked again at the next interval.
+func (t *Trigger) holdFrozenCandidate(ctx context.Context, c candidate, reason string) {
+	t.logger.Info(fmt.Sprintf("deployment of application %s was held: %s", c.application.Name, reason),
+		zap.String("app-id", c.application.Id),
+		zap.String("trigger-kind", c.kind.String()),
+	)
+	if !c.HasCommand() {
+		return
+	}
+	if err := c.command.Report(ctx, model.CommandStatus_COMMAND_FAILED, nil, []byte(reason)); err != nil {
+		t.logger.Error("failed to report command status", zap.Error(err))
+	}
+}
+
 // listCommandCandidates finds all applications that have been commanded to sync.
 func (t *Trigger) listCommandCandidates() []candidate {
 	var (
@@ -31,13 +31,15 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
 	"github.com/pipe-cd/pipecd/pkg/app/server/stagelogstore"
 	"github.com/pipe-cd/pipecd/pkg/cache"
-	"github.com/pipe-cd/pipecd/pkg/cache/memorycache"
 	"github.com/pipe-cd/pipecd/pkg/datastore"
 	"github.com/pipe-cd/pipecd/pkg/filestore"
 	"github.com/pipe-cd/pipecd/pkg/model"
 	"github.com/pipe-cd/pipecd/pkg/rpc/rpcauth"
 )
 
+// The interval to check newly arrived stage logs while streaming them.
+const stageLogStreamInterval = 2 * time.Second
+
 type apiApplicationStore interface {
 	Add(ctx context.Context, app *model.Application) error
 	Get(ctx context.Context, id string) (*model.Application, error)
@@ -64,8 +66,22 @@ type apiPipedStore interface {
 	DisablePiped(ctx context.Context, id string) error
 }
 
+type apiProjectStore interface {
+	Get(ctx context.Context, id string) (*model.Project, error)
+	AddProjectStaticUser(ctx context.Context, id, username, password, role string) error
+	DeleteProjectStaticUser(ctx context.Context, id, username string) error
+	UpdateProjectStaticUserRole(ctx context.Context, id, username, role string) error
+	UpdateProjectStaticUserPassword(ctx context.Context, id, username, password string) error
+	UpdateProjectStaticUserTOTP(ctx context.Context, id, username, encryptedSecret string) error
+}
+
+type apiDeploymentChainStore interface {
+	Get(ctx context.Context, id string) (*model.DeploymentChain, error)
+	Cancel(ctx context.Context, chainID string) error
+}
+
 type apiEventStore interface {
-	Add(ctx context.Context, event model.Event) error
+	Add(ctx context.Context, event *model.Event) error
 }
 
 type apiDeploymentTraceStore interface {
@@ -82,17 +98,20 @@ type API struct {
 
 	applicationStore     apiApplicationStore
 	deploymentStore      apiDeploymentStore
+	deploymentChainStore apiDeploymentChainStore
 	pipedStore           apiPipedStore
+	projectStore         apiProjectStore
 	eventStore           apiEventStore
 	deploymentTraceStore apiDeploymentTraceStore
 	commandStore         commandstore.Store
 	stageLogStore        stagelogstore.Store
 	commandOutputGetter  commandOutputGetter
 
-	encryptionKeyCache cache.Cache
-	pipedStatCache     cache.Cache
+	pipedStatCache cache.Cache
 
 	webBaseURL string
+	// Whether to embed the key ID into the encrypted secrets.
+	embedKeyID bool
 	logger     *zap.Logger
 }
 
@@ -105,22 +124,24 @@ func NewAPI(
 	cog commandOutputGetter,
 	psc cache.Cache,
 	webBaseURL string,
+	embedKeyID bool,
 	logger *zap.Logger,
 ) *API {
 	a := &API{
 		applicationStore:     datastore.NewApplicationStore(ds),
 		deploymentStore:      datastore.NewDeploymentStore(ds),
+		deploymentChainStore: datastore.NewDeploymentChainStore(ds),
 		pipedStore:           datastore.NewPipedStore(ds),
+		projectStore:         datastore.NewProjectStore(ds),
 		eventStore:           datastore.NewEventStore(ds),
 		deploymentTraceStore: datastore.NewDeploymentTraceStore(ds),
 		commandStore:         commandstore.NewStore(ds, sc, logger),
 		stageLogStore:        stagelogstore.NewStore(fs, sc, logger),
 		commandOutputGetter:  cog,
-		// Public key is variable but likely to be accessed multiple times in a short period.
-		encryptionKeyCache: memorycache.NewTTLCache(ctx, 5*time.Minute, 5*time.Minute),
-		pipedStatCache:     psc,
-		webBaseURL:         webBaseURL,
-		logger:             logger.Named("api"),
+		pipedStatCache:       psc,
+		webBaseURL:           webBaseURL,
+		embedKeyID:           embedKeyID,
+		logger:               logger.Named("api"),
 	}
 	return a
 }
@@ -691,6 +712,54 @@ func (a *API) ListStageLogs(ctx context.Context, req *apiservice.ListStageLogsRe
 	}, nil
 }
 
+// StreamStageLogs streams the stage logs of a deployment until the deployment completes.
+func (a *API) StreamStageLogs(req *apiservice.StreamStageLogsRequest, stream apiservice.APIService_StreamStageLogsServer) error {
+	// The request validation interceptor only handles unary requests.
+	if err := req.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := stream.Context()
+	key, err := requireAPIKey(ctx, model.APIKey_READ_ONLY, a.logger)
+	if err != nil {
+		return err
+	}
+
+	deployment, err := getDeployment(ctx, a.deploymentStore, req.DeploymentId, a.logger)
+	if err != nil {
+		return err
+	}
+
+	if key.ProjectId != deployment.ProjectId {
+		return status.Error(codes.InvalidArgument, "Requested deployment does not belong to your project")
+	}
+
+	// Stages are determined after planning, so only check the stage of a planned deployment.
+	if req.StageId != "" && len(deployment.Stages) > 0 {
+		if _, ok := deployment.Stage(req.StageId); !ok {
+			return status.Error(codes.NotFound, "Stage is not found")
+		}
+	}
+
+	return streamStageLogs(ctx, a.deploymentStore, a.stageLogStore, deployment, req.StageId, stageLogStreamInterval, stream.Send, a.logger)
+}
+
+func (a *API) CancelDeploymentChain(ctx context.Context, req *apiservice.CancelDeploymentChainRequest) (*apiservice.CancelDeploymentChainResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_WRITE, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	commandIDs, err := cancelDeploymentChain(ctx, a.deploymentChainStore, a.deploymentStore, a.commandStore, req.DeploymentChainId, key.ProjectId, key.Id, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiservice.CancelDeploymentChainResponse{
+		CommandIds: commandIDs,
+	}, nil
+}
+
 func (a *API) GetCommand(ctx context.Context, req *apiservice.GetCommandRequest) (*apiservice.GetCommandResponse, error) {
 	_, err := requireAPIKey(ctx, model.APIKey_READ_ONLY, a.logger)
 	if err != nil {
@@ -807,6 +876,104 @@ func (a *API) updatePiped(ctx context.Context, pipedID string, updater func(cont
 	return nil
 }
 
+func (a *API) ListStaticUsers(ctx context.Context, req *apiservice.ListStaticUsersRequest) (*apiservice.ListStaticUsersResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_ONLY, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := a.projectStore.Get(ctx, key.ProjectId)
+	if err != nil {
+		return nil, gRPCStoreError(err, fmt.Sprintf("get project %s", key.ProjectId))
+	}
+
+	users := project.StaticUsers
+	for _, u := range users {
+		u.RedactSensitiveData()
+	}
+	return &apiservice.ListStaticUsersResponse{
+		Users: users,
+	}, nil
+}
+
+func (a *API) AddStaticUser(ctx context.Context, req *apiservice.AddStaticUserRequest) (*apiservice.AddStaticUserResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_ADMIN, a.logger)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.validateNotStaticAdmin(ctx, key.ProjectId, req.Username); err != nil {
+		return nil, err
+	}
+
+	if err := a.projectStore.AddProjectStaticUser(ctx, key.ProjectId, req.Username, req.Password, req.Role); err != nil {
+		return nil, gRPCStoreError(err, fmt.Sprintf("add static user %s", req.Username))
+	}
+	return &apiservice.AddStaticUserResponse{}, nil
+}
+
+func (a *API) DeleteStaticUser(ctx context.Context, req *apiservice.DeleteStaticUserRequest) (*apiservice.DeleteStaticUserResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_ADMIN, a.logger)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.validateNotStaticAdmin(ctx, key.ProjectId, req.Username); err != nil {
+		return nil, err
+	}
+
+	if err := a.projectStore.DeleteProjectStaticUser(ctx, key.ProjectId, req.Username); err != nil {
+		return nil, gRPCStoreError(err, fmt.Sprintf("delete static user %s", req.Username))
+	}
+	return &apiservice.DeleteStaticUserResponse{}, nil
+}
+
+func (a *API) UpdateStaticUserRole(ctx context.Context, req *apiservice.UpdateStaticUserRoleRequest) (*apiservice.UpdateStaticUserRoleResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_ADMIN, a.logger)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.validateNotStaticAdmin(ctx, key.ProjectId, req.Username); err != nil {
+		return nil, err
+	}
+
+	if err := a.projectStore.UpdateProjectStaticUserRole(ctx, key.ProjectId, req.Username, req.Role); err != nil {
+		return nil, gRPCStoreError(err, fmt.Sprintf("update role of static user %s", req.Username))
+	}
+	return &apiservice.UpdateStaticUserRoleResponse{}, nil
+}
+
+func (a *API) ResetStaticUserPassword(ctx context.Context, req *apiservice.ResetStaticUserPasswordRequest) (*apiservice.ResetStaticUserPasswordResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_ADMIN, a.logger)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.validateNotStaticAdmin(ctx, key.ProjectId, req.Username); err != nil {
+		return nil, err
+	}
+
+	if err := a.projectStore.UpdateProjectStaticUserPassword(ctx, key.ProjectId, req.Username, req.Password); err != nil {
+		return nil, gRPCStoreError(err, fmt.Sprintf("reset password of static user %s", req.Username))
+	}
+	if req.ResetTotp {
+		if err := a.projectStore.UpdateProjectStaticUserTOTP(ctx, key.ProjectId, req.Username, ""); err != nil {
+			return nil, gRPCStoreError(err, fmt.Sprintf("reset two-factor authentication of static user %s", req.Username))
+		}
+	}
+	return &apiservice.ResetStaticUserPasswordResponse{}, nil
+}
+
+// validateNotStaticAdmin checks if the given username is not the one of the static admin of the project.
+// The static admin can be changed only by itself from the web console.
+func (a *API) validateNotStaticAdmin(ctx context.Context, projectID, username string) error {
+	project, err := a.projectStore.Get(ctx, projectID)
+	if err != nil {
+		return gRPCStoreError(err, fmt.Sprintf("get project %s", projectID))
+	}
+	if project.IsStaticAdmin(username) {
+		return status.Error(codes.PermissionDenied, "The static admin cannot be changed via API")
+	}
+	return nil
+}
+
 func (a *API) RegisterEvent(ctx context.Context, req *apiservice.RegisterEventRequest) (*apiservice.RegisterEventResponse, error) {
 	key, err := requireAPIKey(ctx, model.APIKey_READ_WRITE, a.logger)
 	if err != nil {
@@ -814,7 +981,7 @@ func (a *API) RegisterEvent(ctx context.Context, req *apiservice.RegisterEventRe
 	}
 	id := uuid.New().String()
 
-	event := model.Event{
+	event := &model.Event{
 		Id:                id,
 		Name:              req.Name,
 		Data:              req.Data,
@@ -1049,22 +1216,17 @@ func (a *API) Encrypt(ctx context.Context, req *apiservice.EncryptRequest) (*api
 		return nil, err
 	}
 
-	var pubkey []byte
-	if v, err := a.encryptionKeyCache.Get(req.PipedId); err == nil {
-		pubkey = v.([]byte)
+	// Always read the public key from the datastore instead of caching it
+	// so that the newest key reported by the piped is used after a key rotation.
+	piped, err := getPiped(ctx, a.pipedStore, req.PipedId, a.logger)
+	if err != nil {
+		return nil, err
 	}
-	if pubkey == nil {
-		piped, err := getPiped(ctx, a.pipedStore, req.PipedId, a.logger)
-		if err != nil {
-			return nil, err
-		}
-		pubkey, err = getEncriptionKey(piped.SecretEncryption)
-		if err != nil {
-			return nil, err
-		}
-		a.encryptionKeyCache.Put(req.PipedId, pubkey)
+	pubkey, err := getEncriptionKey(piped.SecretEncryption)
+	if err != nil {
+		return nil, err
 	}
-	ciphertext, err := encrypt(req.Plaintext, pubkey, req.Base64Encoding, a.logger)
+	ciphertext, err := encrypt(req.Plaintext, pubkey, req.Base64Encoding, a.embedKeyID, a.logger)
 	if err != nil {
 		return nil, err
 	}
@@ -1083,9 +1245,16 @@ func requireAPIKey(ctx context.Context, role model.APIKey_Role, logger *zap.Logg
 	}
 
 	switch key.Role {
-	case model.APIKey_READ_WRITE:
+	case model.APIKey_ADMIN:
 		return key, nil
 
+	case model.APIKey_READ_WRITE:
+		if role != model.APIKey_ADMIN {
+			return key, nil
+		}
+		logger.Warn("detected an API key that has insufficient permissions", zap.String("key", key.Id))
+		return nil, status.Error(codes.PermissionDenied, "Permission denied")
+
 	case model.APIKey_READ_ONLY:
 		if role == model.APIKey_READ_ONLY {
 			return key, nil
@@ -71,6 +71,24 @@ func TestRequireAPIKey(t *testing.T) {
 			requireRole: model.APIKey_READ_WRITE,
 			expectedErr: "rpc error: code = PermissionDenied desc = Permission denied",
 		},
+		{
+			name: "ok: using ADMIN to manage users",
+			key: &model.APIKey{
+				Role: model.APIKey_ADMIN,
+			},
+			requireRole: model.APIKey_ADMIN,
+			expectedKey: &model.APIKey{
+				Role: model.APIKey_ADMIN,
+			},
+		},
+		{
+			name: "invalid: using READ_WRITE to manage users",
+			key: &model.APIKey{
+				Role: model.APIKey_READ_WRITE,
+			},
+			requireRole: model.APIKey_ADMIN,
+			expectedErr: "rpc error: code = PermissionDenied desc = Permission denied",
+		},
 		{
 			name: "invalid: invalid role",
 			key: &model.APIKey{
@@ -19,12 +19,15 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/pipe-cd/pipecd/pkg/app/server/commandstore"
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
 	"github.com/pipe-cd/pipecd/pkg/app/server/stagelogstore"
 	"github.com/pipe-cd/pipecd/pkg/cache"
 	"github.com/pipe-cd/pipecd/pkg/crypto"
@@ -42,6 +45,15 @@ type deploymentGetter interface {
 	Get(ctx context.Context, id string) (*model.Deployment, error)
 }
 
+type deploymentChainCanceler interface {
+	Get(ctx context.Context, id string) (*model.DeploymentChain, error)
+	Cancel(ctx context.Context, chainID string) error
+}
+
+type stageLogFetcher interface {
+	FetchLogs(ctx context.Context, deploymentID, stageID string, retriedCount int32, offsetIndex int64) ([]*model.LogBlock, bool, error)
+}
+
 type pipedGetter interface {
 	Get(ctx context.Context, id string) (*model.Piped, error)
 }
@@ -85,6 +97,65 @@ func getDeployment(ctx context.Context, store deploymentGetter, id string, logge
 	return deployment, nil
 }
 
+// cancelDeploymentChain marks the given deployment chain as cancelled and sends commands
+// to cancel all of its not yet completed deployments. The running deployments of the blocks
+// configured to roll back on chain failure are cancelled with rollback.
+func cancelDeploymentChain(
+	ctx context.Context,
+	chainStore deploymentChainCanceler,
+	deploymentStore deploymentGetter,
+	commandStore commandstore.Store,
+	chainID, projectID, commander string,
+	logger *zap.Logger,
+) ([]string, error) {
+	dc, err := chainStore.Get(ctx, chainID)
+	if err != nil {
+		return nil, gRPCStoreError(err, fmt.Sprintf("get deployment chain %s", chainID))
+	}
+	if dc.ProjectId != projectID {
+		return nil, status.Error(codes.PermissionDenied, "Requested deployment chain does not belong to your project")
+	}
+	if dc.IsCompleted() {
+		return nil, status.Error(codes.FailedPrecondition, "could not cancel the deployment chain because it was already completed")
+	}
+
+	if err := chainStore.Cancel(ctx, chainID); err != nil {
+		logger.Error("failed to cancel deployment chain", zap.String("deployment-chain-id", chainID), zap.Error(err))
+		return nil, gRPCStoreError(err, fmt.Sprintf("cancel deployment chain %s", chainID))
+	}
+
+	commandIDs := make([]string, 0)
+	for ref, block := range dc.ListNotCompletedDeployments() {
+		deployment, err := getDeployment(ctx, deploymentStore, ref.DeploymentId, logger)
+		if err != nil {
+			return nil, err
+		}
+		if deployment.Status.IsCompleted() {
+			continue
+		}
+		cmd := model.Command{
+			Id:            uuid.New().String(),
+			PipedId:       deployment.PipedId,
+			ApplicationId: deployment.ApplicationId,
+			ProjectId:     deployment.ProjectId,
+			DeploymentId:  deployment.Id,
+			Type:          model.Command_CANCEL_DEPLOYMENT,
+			Commander:     commander,
+			CancelDeployment: &model.Command_CancelDeployment{
+				DeploymentId:    deployment.Id,
+				ForceRollback:   block.CancelOnChainFailure,
+				ForceNoRollback: !block.CancelOnChainFailure,
+			},
+		}
+		if err := addCommand(ctx, commandStore, &cmd, logger); err != nil {
+			return nil, err
+		}
+		commandIDs = append(commandIDs, cmd.Id)
+	}
+
+	return commandIDs, nil
+}
+
 func getCommand(ctx context.Context, store commandstore.Store, id string, logger *zap.Logger) (*model.Command, error) {
 	cmd, err := store.GetCommand(ctx, id)
 	if errors.Is(err, datastore.ErrNotFound) {
@@ -133,7 +204,7 @@ func makeGitPath(repoID, path, cfgFilename string, piped *model.Piped, logger *z
 	}, nil
 }
 
-func encrypt(plaintext string, key []byte, base64Encoding bool, logger *zap.Logger) (string, error) {
+func encrypt(plaintext string, key []byte, base64Encoding, embedKeyID bool, logger *zap.Logger) (string, error) {
 	if base64Encoding {
 		plaintext = base64.StdEncoding.EncodeToString([]byte(plaintext))
 	}
@@ -142,7 +213,11 @@ func encrypt(plaintext string, key []byte, base64Encoding bool, logger *zap.Logg
 		logger.Error("failed to initialize the crypter", zap.Error(err))
 		return "", status.Error(codes.InvalidArgument, "Invalid public key")
 	}
-	ciphertext, err := encrypter.Encrypt(plaintext)
+	encrypt := encrypter.Encrypt
+	if embedKeyID {
+		encrypt = encrypter.EncryptWithKeyID
+	}
+	ciphertext, err := encrypt(plaintext)
 	if err != nil {
 		logger.Error("failed to encrypt the secret", zap.Error(err))
 		return "", status.Error(codes.FailedPrecondition, "Failed to encrypt the secret")
@@ -202,3 +277,91 @@ func getPipedStatus(cs cache.Cache, id string) (model.Piped_ConnectionStatus, er
 	}
 	return model.Piped_ONLINE, nil
 }
+
+// streamStageLogs sends the newly arrived stage logs of the given deployment every interval
+// until all logs were sent after the deployment completed.
+// Logs are read from the stage log store which serves them from the shared cache where
+// the piped reported logs are put, so that all followers of a deployment share the same data.
+func streamStageLogs(
+	ctx context.Context,
+	deploymentStore deploymentGetter,
+	logStore stageLogFetcher,
+	deployment *model.Deployment,
+	stageID string,
+	interval time.Duration,
+	send func(*apiservice.StreamStageLogsResponse) error,
+	logger *zap.Logger,
+) error {
+	type stageKey struct {
+		id           string
+		retriedCount int32
+	}
+	var (
+		offsets   = make(map[stageKey]int64)
+		completed = make(map[stageKey]bool)
+		ticker    = time.NewTicker(interval)
+	)
+	defer ticker.Stop()
+
+	for {
+		// Logs may be reported right before the deployment is marked as completed,
+		// so one more pass is required after observing the completion.
+		deploymentCompleted := deployment.Status.IsCompleted()
+
+		for _, stage := range deployment.Stages {
+			if stageID != "" && stage.Id != stageID {
+				continue
+			}
+			key := stageKey{id: stage.Id, retriedCount: stage.RetriedCount}
+			if completed[key] {
+				continue
+			}
+
+			blocks, done, err := logStore.FetchLogs(ctx, deployment.Id, stage.Id, stage.RetriedCount, offsets[key])
+			if errors.Is(err, stagelogstore.ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				logger.Error("failed to fetch stage logs", zap.String("deployment-id", deployment.Id), zap.String("stage-id", stage.Id), zap.Error(err))
+				return status.Error(codes.Internal, "Failed to fetch stage logs")
+			}
+			if len(blocks) == 0 && !done {
+				continue
+			}
+
+			for _, b := range blocks {
+				if b.Index >= offsets[key] {
+					offsets[key] = b.Index + 1
+				}
+			}
+			completed[key] = done
+
+			resp := &apiservice.StreamStageLogsResponse{
+				StageId:      stage.Id,
+				StageName:    stage.Name,
+				RetriedCount: stage.RetriedCount,
+				Blocks:       blocks,
+				Completed:    done,
+			}
+			if err := send(resp); err != nil {
+				return err
+			}
+		}
+
+		if deploymentCompleted {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		var err error
+		deployment, err = getDeployment(ctx, deploymentStore, deployment.Id, logger)
+		if err != nil {
+			return err
+		}
+	}
+}
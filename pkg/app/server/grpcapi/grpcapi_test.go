@@ -15,16 +15,23 @@
 package grpcapi
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/app/server/stagelogstore"
 	"github.com/pipe-cd/pipecd/pkg/datastore"
 	"github.com/pipe-cd/pipecd/pkg/filestore"
+	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
 func TestGRPCStoreError(t *testing.T) {
@@ -91,3 +98,113 @@ func TestGRPCStoreError(t *testing.T) {
 		})
 	}
 }
+
+// fakeDeploymentGetter returns the given deployments in order and keeps returning the last one.
+type fakeDeploymentGetter struct {
+	deployments []*model.Deployment
+}
+
+func (g *fakeDeploymentGetter) Get(_ context.Context, _ string) (*model.Deployment, error) {
+	d := g.deployments[0]
+	if len(g.deployments) > 1 {
+		g.deployments = g.deployments[1:]
+	}
+	return d, nil
+}
+
+// fakeStageLogFetcher returns the logs of each stage in order and keeps returning the last one.
+type fakeStageLogFetcher struct {
+	logs map[string][]fakeStageLog
+}
+
+type fakeStageLog struct {
+	blocks    []*model.LogBlock
+	completed bool
+}
+
+func (f *fakeStageLogFetcher) FetchLogs(_ context.Context, _, stageID string, _ int32, offsetIndex int64) ([]*model.LogBlock, bool, error) {
+	logs, ok := f.logs[stageID]
+	if !ok {
+		return nil, false, stagelogstore.ErrNotFound
+	}
+	l := logs[0]
+	if len(logs) > 1 {
+		f.logs[stageID] = logs[1:]
+	}
+	blocks := make([]*model.LogBlock, 0, len(l.blocks))
+	for _, b := range l.blocks {
+		if b.Index >= offsetIndex {
+			blocks = append(blocks, b)
+		}
+	}
+	return blocks, l.completed, nil
+}
+
+func TestStreamStageLogs(t *testing.T) {
+	t.Parallel()
+
+	var (
+		block0  = &model.LogBlock{Index: 0, Log: "log 0"}
+		block1  = &model.LogBlock{Index: 1, Log: "log 1"}
+		block2  = &model.LogBlock{Index: 2, Log: "log 2"}
+		stages  = []*model.PipelineStage{{Id: "stage-1", Name: "K8S_SYNC"}, {Id: "stage-2", Name: "WAIT"}}
+		running = &model.Deployment{Id: "deployment-id", Status: model.DeploymentStatus_DEPLOYMENT_RUNNING, Stages: stages}
+		success = &model.Deployment{Id: "deployment-id", Status: model.DeploymentStatus_DEPLOYMENT_SUCCESS, Stages: stages}
+	)
+
+	testcases := []struct {
+		name        string
+		deployments []*model.Deployment
+		logs        map[string][]fakeStageLog
+		stageID     string
+		expected    []*apiservice.StreamStageLogsResponse
+	}{
+		{
+			name:        "stream logs until the deployment completes",
+			deployments: []*model.Deployment{running, running, success},
+			logs: map[string][]fakeStageLog{
+				"stage-1": {
+					{blocks: []*model.LogBlock{block0}},
+					{blocks: []*model.LogBlock{block0}},
+					{blocks: []*model.LogBlock{block0, block1, block2}, completed: true},
+				},
+			},
+			expected: []*apiservice.StreamStageLogsResponse{
+				{StageId: "stage-1", StageName: "K8S_SYNC", Blocks: []*model.LogBlock{block0}},
+				{StageId: "stage-1", StageName: "K8S_SYNC", Blocks: []*model.LogBlock{block1, block2}, Completed: true},
+			},
+		},
+		{
+			name:        "stream logs of the specified stage",
+			deployments: []*model.Deployment{success},
+			logs: map[string][]fakeStageLog{
+				"stage-1": {{blocks: []*model.LogBlock{block0}, completed: true}},
+				"stage-2": {{blocks: []*model.LogBlock{block1}, completed: true}},
+			},
+			stageID: "stage-2",
+			expected: []*apiservice.StreamStageLogsResponse{
+				{StageId: "stage-2", StageName: "WAIT", Blocks: []*model.LogBlock{block1}, Completed: true},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := &fakeDeploymentGetter{deployments: tc.deployments}
+			deployment, err := store.Get(context.Background(), "deployment-id")
+			require.NoError(t, err)
+
+			var got []*apiservice.StreamStageLogsResponse
+			send := func(resp *apiservice.StreamStageLogsResponse) error {
+				got = append(got, resp)
+				return nil
+			}
+			err = streamStageLogs(context.Background(), store, &fakeStageLogFetcher{logs: tc.logs}, deployment, tc.stageID, time.Millisecond, send, zap.NewNop())
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
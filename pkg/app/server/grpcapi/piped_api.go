@@ -56,7 +56,7 @@ type pipedAPIDeploymentStore interface {
 	Add(ctx context.Context, app *model.Deployment) error
 	Get(ctx context.Context, id string) (*model.Deployment, error)
 	List(ctx context.Context, opts datastore.ListOptions) ([]*model.Deployment, string, error)
-	UpdateToPlanned(ctx context.Context, id, summary, reason, runningCommitHash, runningConfigFilename string, syncStrategy model.SyncStrategy, versions []*model.ArtifactVersion, stages []*model.PipelineStage) error
+	UpdateToPlanned(ctx context.Context, id, summary, reason, runningCommitHash, runningConfigFilename, runningArtifactDigest string, syncStrategy model.SyncStrategy, versions []*model.ArtifactVersion, stages []*model.PipelineStage) error
 	UpdateToCompleted(ctx context.Context, id string, status model.DeploymentStatus, stageStatuses map[string]model.StageStatus, reason string, completedAt int64) error
 	UpdateStatus(ctx context.Context, id string, status model.DeploymentStatus, reason string) error
 	UpdateStageStatus(ctx context.Context, id, stageID string, status model.StageStatus, reason string, requires []string, visible bool, retriedCount int32, completedAt int64) error
@@ -72,6 +72,10 @@ type pipedAPIDeploymentChainStore interface {
 	Get(ctx context.Context, id string) (*model.DeploymentChain, error)
 }
 
+type pipedAPIProjectStore interface {
+	Get(ctx context.Context, id string) (*model.Project, error)
+}
+
 type pipedAPIPipedStore interface {
 	Get(ctx context.Context, id string) (*model.Piped, error)
 	UpdateMetadata(ctx context.Context, id, version, config string, pps []*model.Piped_PlatformProvider, pls []*model.Piped_Plugin, repos []*model.ApplicationGitRepository, se *model.Piped_SecretEncryption, startedAt int64) error
@@ -93,6 +97,7 @@ type PipedAPI struct {
 	applicationStore          pipedAPIApplicationStore
 	deploymentStore           pipedAPIDeploymentStore
 	deploymentChainStore      pipedAPIDeploymentChainStore
+	projectStore              pipedAPIProjectStore
 	pipedStore                pipedAPIPipedStore
 	eventStore                pipedAPIEventStore
 	stageLogStore             stagelogstore.Store
@@ -117,6 +122,7 @@ func NewPipedAPI(ctx context.Context, ds datastore.DataStore, sc cache.Cache, sl
 		applicationStore:          datastore.NewApplicationStore(ds),
 		deploymentStore:           datastore.NewDeploymentStore(ds),
 		deploymentChainStore:      datastore.NewDeploymentChainStore(ds),
+		projectStore:              datastore.NewProjectStore(ds),
 		pipedStore:                datastore.NewPipedStore(ds),
 		eventStore:                datastore.NewEventStore(ds),
 		stageLogStore:             sls,
@@ -396,6 +402,12 @@ func (a *PipedAPI) CreateDeployment(ctx context.Context, req *pipedservice.Creat
 	if err := a.validateAppBelongsToPiped(ctx, req.Deployment.ApplicationId, pipedID); err != nil {
 		return nil, err
 	}
+	// Deployments in a chain are checked when their block is about to start instead.
+	if !req.Deployment.IsInChainDeployment() {
+		if err := a.validateDeploymentNotFrozen(ctx, projectID, req.Deployment); err != nil {
+			return nil, err
+		}
+	}
 
 	if err := a.deploymentStore.Add(ctx, req.Deployment); err != nil {
 		return nil, gRPCStoreError(err, fmt.Sprintf("add deployment %s", req.Deployment.Id))
@@ -424,6 +436,7 @@ func (a *PipedAPI) ReportDeploymentPlanned(ctx context.Context, req *pipedservic
 		req.StatusReason,
 		req.RunningCommitHash,
 		req.RunningConfigFilename,
+		req.RunningArtifactDigest,
 		req.SyncStrategy,
 		req.Versions,
 		req.Stages,
@@ -1050,12 +1063,28 @@ func (a *PipedAPI) CreateDeploymentChain(ctx context.Context, req *pipedservice.
 
 		blockAppsMap[i+1] = blockApps
 		chainBlocks = append(chainBlocks, &model.ChainBlock{
-			Nodes:     nodes,
-			Status:    model.ChainBlockStatus_DEPLOYMENT_BLOCK_PENDING,
-			StartedAt: time.Now().Unix(),
+			Nodes:                  nodes,
+			Status:                 model.ChainBlockStatus_DEPLOYMENT_BLOCK_PENDING,
+			ContinueOnFailure:      filter.ContinueOnFailure,
+			CancelOnChainFailure:   filter.CancelOnChainFailure,
+			RollbackOnChainFailure: filter.RollbackOnChainFailure,
+			StartedAt:              time.Now().Unix(),
 		})
 	}
 
+	// The whole chain is held while any of its applications is inside a deployment freeze window of the project,
+	// so that a chain is not started only to be stopped at a frozen block.
+	if err := a.validateDeploymentNotFrozen(ctx, projectID, firstDeployment); err != nil {
+		return nil, err
+	}
+	for _, apps := range blockAppsMap {
+		for _, app := range apps {
+			if err := a.validateLabelsNotFrozen(ctx, projectID, app.Labels); err != nil {
+				return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("application %s in the deployment chain is frozen: %s", app.Name, status.Convert(err).Message()))
+			}
+		}
+	}
+
 	dc := model.DeploymentChain{
 		Id:        uuid.New().String(),
 		ProjectId: projectID,
@@ -1111,7 +1140,7 @@ func (a *PipedAPI) CreateDeploymentChain(ctx context.Context, req *pipedservice.
 // In case the previous block is finished with unsuccessfully status, cancelled flag will be returned
 // so that the in charge piped will be aware and stop that deployment.
 func (a *PipedAPI) InChainDeploymentPlannable(ctx context.Context, req *pipedservice.InChainDeploymentPlannableRequest) (*pipedservice.InChainDeploymentPlannableResponse, error) {
-	_, pipedID, _, err := rpcauth.ExtractPipedToken(ctx)
+	projectID, pipedID, _, err := rpcauth.ExtractPipedToken(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1161,6 +1190,11 @@ func (a *PipedAPI) InChainDeploymentPlannable(ctx context.Context, req *pipedser
 	case model.ChainBlockStatus_DEPLOYMENT_BLOCK_SUCCESS:
 		plannable = true
 	case model.ChainBlockStatus_DEPLOYMENT_BLOCK_FAILURE:
+		// The later blocks are still deployed when the failed block allows continuing on failure.
+		if previousBlock.ContinueOnFailure {
+			plannable = true
+			break
+		}
 		cancel = true
 		reason = "Previous block finished with FAILURE status"
 	case model.ChainBlockStatus_DEPLOYMENT_BLOCK_CANCELLED:
@@ -1168,6 +1202,21 @@ func (a *PipedAPI) InChainDeploymentPlannable(ctx context.Context, req *pipedser
 		reason = "Previous block finished with CANCELLED status"
 	}
 
+	// A block which became inside a deployment freeze window of the project while the chain is running
+	// waits until the window is over.
+	if plannable {
+		d, err := a.deploymentStore.Get(ctx, req.DeploymentId)
+		if err != nil {
+			return nil, gRPCStoreError(err, fmt.Sprintf("get deployment %s", req.DeploymentId))
+		}
+		if err := a.validateDeploymentNotFrozen(ctx, projectID, d); err != nil {
+			if status.Code(err) != codes.FailedPrecondition {
+				return nil, err
+			}
+			plannable = false
+		}
+	}
+
 	return &pipedservice.InChainDeploymentPlannableResponse{
 		Plannable:    plannable,
 		Cancel:       cancel,
@@ -1199,6 +1248,39 @@ func (a *PipedAPI) validateAppBelongsToPiped(ctx context.Context, appID, pipedID
 	return nil
 }
 
+// validateDeploymentNotFrozen checks if the given deployment is not inside any deployment freeze window of the project.
+// It gives back a FailedPrecondition error unless the freeze was bypassed with a justification.
+func (a *PipedAPI) validateDeploymentNotFrozen(ctx context.Context, projectID string, d *model.Deployment) error {
+	if d.DeploymentFreezeBypassJustification() != "" {
+		return nil
+	}
+	return a.validateLabelsNotFrozen(ctx, projectID, d.Labels)
+}
+
+// validateLabelsNotFrozen checks if the application having the given labels is not inside
+// any deployment freeze window of the project.
+// It gives back a FailedPrecondition error while a window is active.
+func (a *PipedAPI) validateLabelsNotFrozen(ctx context.Context, projectID string, labels map[string]string) error {
+	proj, err := a.projectStore.Get(ctx, projectID)
+	if err != nil {
+		// The debug projects specified in the control-plane configuration are not stored in the datastore.
+		if errors.Is(err, datastore.ErrNotFound) {
+			return nil
+		}
+		return gRPCStoreError(err, fmt.Sprintf("get project %s", projectID))
+	}
+
+	w, err := model.FindActiveDeploymentFreezeWindow(proj.DeploymentFreezeWindows, labels, time.Now())
+	if err != nil {
+		a.logger.Error("failed to check deployment freeze windows", zap.String("project", projectID), zap.Error(err))
+		return status.Error(codes.Internal, "failed to check deployment freeze windows")
+	}
+	if w != nil {
+		return status.Error(codes.FailedPrecondition, w.Reason())
+	}
+	return nil
+}
+
 // validateDeploymentBelongsToPiped checks if the given deployment belongs to the given piped.
 // It gives back an error unless the deployment belongs to the piped.
 func (a *PipedAPI) validateDeploymentBelongsToPiped(ctx context.Context, deploymentID, pipedID string) error {
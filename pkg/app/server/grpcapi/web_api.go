@@ -44,12 +44,21 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/insight"
 	"github.com/pipe-cd/pipecd/pkg/model"
 	"github.com/pipe-cd/pipecd/pkg/rpc/rpcauth"
+	"github.com/pipe-cd/pipecd/pkg/totp"
 )
 
 var (
 	breakingChangesRegex = regexp.MustCompile(`(?s)### Breaking Changes(.*?)(### |$)`)
 )
 
+const (
+	// The default and maximum number of log blocks returned by SearchStageLogs.
+	defaultStageLogSearchLimit = 100
+	maxStageLogSearchLimit     = 1000
+	// The maximum number of deployments whose stage logs are searched by SearchStageLogs.
+	maxStageLogSearchDeployments = 100
+)
+
 type encrypter interface {
 	Encrypt(text string) (string, error)
 }
@@ -67,6 +76,7 @@ type webAPIApplicationStore interface {
 type webAPIDeploymentChainStore interface {
 	Get(ctx context.Context, id string) (*model.DeploymentChain, error)
 	List(ctx context.Context, opts datastore.ListOptions) ([]*model.DeploymentChain, string, error)
+	Cancel(ctx context.Context, chainID string) error
 }
 
 type webAPIDeploymentStore interface {
@@ -98,6 +108,13 @@ type webAPIProjectStore interface {
 	DeleteProjectRBACRole(ctx context.Context, id, name string) error
 	AddProjectUserGroup(ctx context.Context, id, sso, role string) error
 	DeleteProjectUserGroup(ctx context.Context, id, sso string) error
+	AddProjectDeploymentFreezeWindow(ctx context.Context, id string, window *model.DeploymentFreezeWindow) error
+	DeleteProjectDeploymentFreezeWindow(ctx context.Context, id, name string) error
+	AddProjectStaticUser(ctx context.Context, id, username, password, role string) error
+	DeleteProjectStaticUser(ctx context.Context, id, username string) error
+	UpdateProjectStaticUserRole(ctx context.Context, id, username, role string) error
+	UpdateProjectStaticUserPassword(ctx context.Context, id, username, password string) error
+	UpdateProjectStaticUserTOTP(ctx context.Context, id, username, encryptedSecret string) error
 }
 
 type webAPIEventStore interface {
@@ -145,7 +162,9 @@ type WebAPI struct {
 	pipedStatCache         cache.Cache
 
 	projectsInConfig map[string]config.ControlPlaneProject
-	logger           *zap.Logger
+	// Whether to embed the key ID into the encrypted secrets.
+	embedKeyID bool
+	logger     *zap.Logger
 }
 
 // NewWebAPI creates a new WebAPI instance.
@@ -161,6 +180,7 @@ func NewWebAPI(
 	psc cache.Cache,
 	projs map[string]config.ControlPlaneProject,
 	encrypter encrypter,
+	embedKeyID bool,
 	logger *zap.Logger,
 ) *WebAPI {
 	a := &WebAPI{
@@ -185,6 +205,7 @@ func NewWebAPI(
 		deploymentProjectCache:    memorycache.NewTTLCache(ctx, 24*time.Hour, 3*time.Hour),
 		pipedProjectCache:         memorycache.NewTTLCache(ctx, 24*time.Hour, 3*time.Hour),
 		pipedStatCache:            psc,
+		embedKeyID:                embedKeyID,
 		logger:                    logger.Named("web-api"),
 	}
 	return a
@@ -740,6 +761,46 @@ func (a *WebAPI) SyncApplication(ctx context.Context, req *webservice.SyncApplic
 	}, nil
 }
 
+func (a *WebAPI) SyncApplicationBypassingFreeze(ctx context.Context, req *webservice.SyncApplicationBypassingFreezeRequest) (*webservice.SyncApplicationBypassingFreezeResponse, error) {
+	claims, err := rpcauth.ExtractClaims(ctx)
+	if err != nil {
+		a.logger.Error("failed to authenticate the current user", zap.Error(err))
+		return nil, err
+	}
+
+	app, err := getApplication(ctx, a.applicationStore, req.ApplicationId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role.ProjectId != app.ProjectId {
+		return nil, status.Error(codes.PermissionDenied, "Requested application does not belong to your project")
+	}
+
+	cmd := model.Command{
+		Id:            uuid.New().String(),
+		PipedId:       app.PipedId,
+		ApplicationId: app.Id,
+		ProjectId:     app.ProjectId,
+		Type:          model.Command_SYNC_APPLICATION,
+		Commander:     claims.Subject,
+		Metadata: map[string]string{
+			model.MetadataKeyDeploymentFreezeBypassJustification: req.Justification,
+		},
+		SyncApplication: &model.Command_SyncApplication{
+			ApplicationId: app.Id,
+			SyncStrategy:  req.SyncStrategy,
+		},
+	}
+	if err := addCommand(ctx, a.commandStore, &cmd, a.logger); err != nil {
+		return nil, err
+	}
+
+	return &webservice.SyncApplicationBypassingFreezeResponse{
+		CommandId: cmd.Id,
+	}, nil
+}
+
 func (a *WebAPI) GetApplication(ctx context.Context, req *webservice.GetApplicationRequest) (*webservice.GetApplicationResponse, error) {
 	claims, err := rpcauth.ExtractClaims(ctx)
 	if err != nil {
@@ -781,7 +842,7 @@ func (a *WebAPI) GenerateApplicationSealedSecret(ctx context.Context, req *webse
 		return nil, err
 	}
 
-	ciphertext, err := encrypt(req.Data, pubkey, req.Base64Encoding, a.logger)
+	ciphertext, err := encrypt(req.Data, pubkey, req.Base64Encoding, a.embedKeyID, a.logger)
 	if err != nil {
 		return nil, err
 	}
@@ -1095,12 +1156,120 @@ func (a *WebAPI) GetStageLog(ctx context.Context, req *webservice.GetStageLogReq
 		return nil, gRPCStoreError(err, "get stage logs")
 	}
 
+	if !req.Verbose {
+		blocks = filterDebugLogBlocks(blocks)
+	}
+
 	return &webservice.GetStageLogResponse{
 		Blocks:    blocks,
 		Completed: completed,
 	}, nil
 }
 
+// filterDebugLogBlocks returns the given log blocks excepting the DEBUG ones.
+func filterDebugLogBlocks(blocks []*model.LogBlock) []*model.LogBlock {
+	filtered := make([]*model.LogBlock, 0, len(blocks))
+	for _, b := range blocks {
+		if b.Severity != model.LogSeverity_DEBUG {
+			filtered = append(filtered, b)
+		}
+	}
+	return filtered
+}
+
+// SearchStageLogs finds the stage log blocks of the given application which match the given conditions.
+// The deployments updated in the given time range are searched from the newest one.
+func (a *WebAPI) SearchStageLogs(ctx context.Context, req *webservice.SearchStageLogsRequest) (*webservice.SearchStageLogsResponse, error) {
+	claims, err := rpcauth.ExtractClaims(ctx)
+	if err != nil {
+		a.logger.Error("failed to authenticate the current user", zap.Error(err))
+		return nil, err
+	}
+
+	if req.To > 0 && req.From > req.To {
+		return nil, status.Error(codes.InvalidArgument, "from must not be after to")
+	}
+	if err := a.validateAppBelongsToProject(ctx, req.ApplicationId, claims.Role.ProjectId); err != nil {
+		return nil, err
+	}
+
+	options := datastore.ListOptions{
+		Filters: []datastore.ListFilter{
+			{
+				Field:    "ProjectId",
+				Operator: datastore.OperatorEqual,
+				Value:    claims.Role.ProjectId,
+			},
+			{
+				Field:    "ApplicationId",
+				Operator: datastore.OperatorEqual,
+				Value:    req.ApplicationId,
+			},
+			{
+				Field:    "UpdatedAt",
+				Operator: datastore.OperatorGreaterThanOrEqual,
+				Value:    req.From,
+			},
+		},
+		Orders: []datastore.Order{
+			{
+				Field:     "UpdatedAt",
+				Direction: datastore.Desc,
+			},
+			{
+				Field:     "Id",
+				Direction: datastore.Asc,
+			},
+		},
+		Limit: maxStageLogSearchDeployments,
+	}
+	deployments, _, err := a.deploymentStore.List(ctx, options)
+	if err != nil {
+		a.logger.Error("failed to get deployments", zap.Error(err))
+		return nil, gRPCStoreError(err, "get deployments")
+	}
+
+	targets := make([]*model.Deployment, 0, len(deployments))
+	for _, d := range deployments {
+		// Deployments created after the time range can not have any log in it.
+		if req.To > 0 && d.CreatedAt > req.To {
+			continue
+		}
+		targets = append(targets, d)
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultStageLogSearchLimit
+	}
+	limit = min(limit, maxStageLogSearchLimit)
+
+	matches, err := a.stageLogStore.SearchLogs(ctx, targets, stagelogstore.SearchOptions{
+		From:       req.From,
+		To:         req.To,
+		Severities: req.Severities,
+		Query:      req.Query,
+		Limit:      limit,
+	})
+	if err != nil {
+		a.logger.Error("failed to search stage logs", zap.Error(err))
+		return nil, gRPCStoreError(err, "search stage logs")
+	}
+
+	resp := &webservice.SearchStageLogsResponse{
+		Matches: make([]*webservice.SearchStageLogsResponse_Match, 0, len(matches)),
+	}
+	for _, m := range matches {
+		resp.Matches = append(resp.Matches, &webservice.SearchStageLogsResponse_Match{
+			DeploymentId: m.DeploymentID,
+			StageId:      m.StageID,
+			RetriedCount: m.RetriedCount,
+			Block:        m.Block,
+		})
+	}
+	return resp, nil
+}
+
 func (a *WebAPI) CancelDeployment(ctx context.Context, req *webservice.CancelDeploymentRequest) (*webservice.CancelDeploymentResponse, error) {
 	claims, err := rpcauth.ExtractClaims(ctx)
 	if err != nil {
@@ -1193,6 +1362,55 @@ func (a *WebAPI) SkipStage(ctx context.Context, req *webservice.SkipStageRequest
 	}, nil
 }
 
+func (a *WebAPI) RetryStage(ctx context.Context, req *webservice.RetryStageRequest) (*webservice.RetryStageResponse, error) {
+	claims, err := rpcauth.ExtractClaims(ctx)
+	if err != nil {
+		a.logger.Error("failed to authenticate the current user", zap.Error(err))
+		return nil, err
+	}
+
+	deployment, err := getDeployment(ctx, a.deploymentStore, req.DeploymentId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Role.ProjectId != deployment.ProjectId {
+		return nil, status.Error(codes.PermissionDenied, "Requested deployment does not belong to your project")
+	}
+	if deployment.Status.IsCompleted() || deployment.Status == model.DeploymentStatus_DEPLOYMENT_ROLLING_BACK {
+		return nil, status.Error(codes.FailedPrecondition, "Could not retry the stage because the deployment was already completed or is rolling back")
+	}
+	stage, ok := deployment.Stage(req.StageId)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "The stage was not found in the deployment")
+	}
+	if stage.Status != model.StageStatus_STAGE_FAILURE {
+		return nil, status.Error(codes.FailedPrecondition, "Could not retry the stage because it has not failed")
+	}
+
+	commandID := uuid.New().String()
+	cmd := model.Command{
+		Id:            commandID,
+		PipedId:       deployment.PipedId,
+		ApplicationId: deployment.ApplicationId,
+		ProjectId:     deployment.ProjectId,
+		DeploymentId:  req.DeploymentId,
+		StageId:       req.StageId,
+		Type:          model.Command_RETRY_STAGE,
+		Commander:     claims.Subject,
+		RetryStage: &model.Command_RetryStage{
+			DeploymentId: req.DeploymentId,
+			StageId:      req.StageId,
+		},
+	}
+	if err := addCommand(ctx, a.commandStore, &cmd, a.logger); err != nil {
+		return nil, err
+	}
+
+	return &webservice.RetryStageResponse{
+		CommandId: commandID,
+	}, nil
+}
+
 func (a *WebAPI) ApproveStage(ctx context.Context, req *webservice.ApproveStageRequest) (*webservice.ApproveStageResponse, error) {
 	claims, err := rpcauth.ExtractClaims(ctx)
 	if err != nil {
@@ -1567,6 +1785,18 @@ func (a *WebAPI) GenerateAPIKey(ctx context.Context, req *webservice.GenerateAPI
 		return nil, err
 	}
 
+	// The ADMIN keys can manage the users of the project,
+	// so that they are only generated by the users who can update the project.
+	if req.Role == model.APIKey_ADMIN {
+		project, err := a.getProject(ctx, claims.Role.ProjectId)
+		if err != nil {
+			return nil, err
+		}
+		if !project.HasRBACPermission(claims.Role.ProjectRbacRoles, model.ProjectRBACResource_PROJECT, model.ProjectRBACPolicy_UPDATE) {
+			return nil, status.Error(codes.PermissionDenied, "Only users who can update the project can generate API keys with ADMIN role")
+		}
+	}
+
 	id := uuid.New().String()
 	key, hash, err := model.GenerateAPIKey(id)
 	if err != nil {
@@ -1816,6 +2046,23 @@ func (a *WebAPI) GetDeploymentChain(ctx context.Context, req *webservice.GetDepl
 	}, nil
 }
 
+func (a *WebAPI) CancelDeploymentChain(ctx context.Context, req *webservice.CancelDeploymentChainRequest) (*webservice.CancelDeploymentChainResponse, error) {
+	claims, err := rpcauth.ExtractClaims(ctx)
+	if err != nil {
+		a.logger.Error("failed to authenticate the current user", zap.Error(err))
+		return nil, err
+	}
+
+	commandIDs, err := cancelDeploymentChain(ctx, a.deploymentChainStore, a.deploymentStore, a.commandStore, req.DeploymentChainId, claims.Role.ProjectId, claims.Subject, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webservice.CancelDeploymentChainResponse{
+		CommandIds: commandIDs,
+	}, nil
+}
+
 func (a *WebAPI) ListEvents(ctx context.Context, req *webservice.ListEventsRequest) (*webservice.ListEventsResponse, error) {
 	claims, err := rpcauth.ExtractClaims(ctx)
 	if err != nil {
@@ -2040,3 +2287,268 @@ func (a *WebAPI) ListDeprecatedNotes(ctx context.Context, req *webservice.ListDe
 		Notes: notes,
 	}, nil
 }
+
+func (a *WebAPI) AddProjectDeploymentFreezeWindow(ctx context.Context, req *webservice.AddProjectDeploymentFreezeWindowRequest) (*webservice.AddProjectDeploymentFreezeWindowResponse, error) {
+	claims, err := rpcauth.ExtractClaims(ctx)
+	if err != nil {
+		a.logger.Error("failed to authenticate the current user", zap.Error(err))
+		return nil, err
+	}
+
+	if _, ok := a.projectsInConfig[claims.Role.ProjectId]; ok {
+		return nil, status.Error(codes.FailedPrecondition, "Failed to update a debug project specified in the control-plane configuration")
+	}
+
+	if err := req.Window.ValidateSchedule(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.projectStore.AddProjectDeploymentFreezeWindow(ctx, claims.Role.ProjectId, req.Window); err != nil {
+		a.logger.Error("failed to add deployment freeze window", zap.Error(err))
+		return nil, gRPCStoreError(err, "add deployment freeze window")
+	}
+	return &webservice.AddProjectDeploymentFreezeWindowResponse{}, nil
+}
+
+func (a *WebAPI) DeleteProjectDeploymentFreezeWindow(ctx context.Context, req *webservice.DeleteProjectDeploymentFreezeWindowRequest) (*webservice.DeleteProjectDeploymentFreezeWindowResponse, error) {
+	claims, err := rpcauth.ExtractClaims(ctx)
+	if err != nil {
+		a.logger.Error("failed to authenticate the current user", zap.Error(err))
+		return nil, err
+	}
+
+	if _, ok := a.projectsInConfig[claims.Role.ProjectId]; ok {
+		return nil, status.Error(codes.FailedPrecondition, "Failed to update a debug project specified in the control-plane configuration")
+	}
+
+	if err := a.projectStore.DeleteProjectDeploymentFreezeWindow(ctx, claims.Role.ProjectId, req.Name); err != nil {
+		a.logger.Error("failed to delete deployment freeze window", zap.Error(err))
+		return nil, gRPCStoreError(err, "delete deployment freeze window")
+	}
+	return &webservice.DeleteProjectDeploymentFreezeWindowResponse{}, nil
+}
+
+// ListStaticUsers lists the static users of the project without sensitive data.
+func (a *WebAPI) ListStaticUsers(ctx context.Context, req *webservice.ListStaticUsersRequest) (*webservice.ListStaticUsersResponse, error) {
+	claims, err := rpcauth.ExtractClaims(ctx)
+	if err != nil {
+		a.logger.Error("failed to authenticate the current user", zap.Error(err))
+		return nil, err
+	}
+
+	project, err := a.getProject(ctx, claims.Role.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	users := project.StaticUsers
+	for _, u := range users {
+		u.RedactSensitiveData()
+	}
+	return &webservice.ListStaticUsersResponse{
+		Users: users,
+	}, nil
+}
+
+// AddStaticUser adds a static user who can log in by username and password.
+func (a *WebAPI) AddStaticUser(ctx context.Context, req *webservice.AddStaticUserRequest) (*webservice.AddStaticUserResponse, error) {
+	claims, err := rpcauth.ExtractClaims(ctx)
+	if err != nil {
+		a.logger.Error("failed to authenticate the current user", zap.Error(err))
+		return nil, err
+	}
+
+	if _, ok := a.projectsInConfig[claims.Role.ProjectId]; ok {
+		return nil, status.Error(codes.FailedPrecondition, "Failed to update a debug project specified in the control-plane configuration")
+	}
+
+	if err := a.projectStore.AddProjectStaticUser(ctx, claims.Role.ProjectId, req.Username, req.Password, req.Role); err != nil {
+		a.logger.Error("failed to add static user", zap.Error(err))
+		return nil, gRPCStoreError(err, "add static user")
+	}
+	return &webservice.AddStaticUserResponse{}, nil
+}
+
+// DeleteStaticUser deletes a static user.
+func (a *WebAPI) DeleteStaticUser(ctx context.Context, req *webservice.DeleteStaticUserRequest) (*webservice.DeleteStaticUserResponse, error) {
+	claims, err := rpcauth.ExtractClaims(ctx)
+	if err != nil {
+		a.logger.Error("failed to authenticate the current user", zap.Error(err))
+		return nil, err
+	}
+
+	if _, ok := a.projectsInConfig[claims.Role.ProjectId]; ok {
+		return nil, status.Error(codes.FailedPrecondition, "Failed to update a debug project specified in the control-plane configuration")
+	}
+
+	if err := a.projectStore.DeleteProjectStaticUser(ctx, claims.Role.ProjectId, req.Username); err != nil {
+		a.logger.Error("failed to delete static user", zap.Error(err))
+		return nil, gRPCStoreError(err, "delete static user")
+	}
+	return &webservice.DeleteStaticUserResponse{}, nil
+}
+
+// UpdateStaticUserRole changes the role of a static user.
+func (a *WebAPI) UpdateStaticUserRole(ctx context.Context, req *webservice.UpdateStaticUserRoleRequest) (*webservice.UpdateStaticUserRoleResponse, error) {
+	claims, err := rpcauth.ExtractClaims(ctx)
+	if err != nil {
+		a.logger.Error("failed to authenticate the current user", zap.Error(err))
+		return nil, err
+	}
+
+	if _, ok := a.projectsInConfig[claims.Role.ProjectId]; ok {
+		return nil, status.Error(codes.FailedPrecondition, "Failed to update a debug project specified in the control-plane configuration")
+	}
+
+	if err := a.projectStore.UpdateProjectStaticUserRole(ctx, claims.Role.ProjectId, req.Username, req.Role); err != nil {
+		a.logger.Error("failed to update static user role", zap.Error(err))
+		return nil, gRPCStoreError(err, "update static user role")
+	}
+	return &webservice.UpdateStaticUserRoleResponse{}, nil
+}
+
+// ResetStaticUserPassword sets a new password for a static user on behalf of them.
+func (a *WebAPI) ResetStaticUserPassword(ctx context.Context, req *webservice.ResetStaticUserPasswordRequest) (*webservice.ResetStaticUserPasswordResponse, error) {
+	claims, err := rpcauth.ExtractClaims(ctx)
+	if err != nil {
+		a.logger.Error("failed to authenticate the current user", zap.Error(err))
+		return nil, err
+	}
+
+	if _, ok := a.projectsInConfig[claims.Role.ProjectId]; ok {
+		return nil, status.Error(codes.FailedPrecondition, "Failed to update a debug project specified in the control-plane configuration")
+	}
+
+	project, err := a.getProject(ctx, claims.Role.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	// The static admin can be changed only by itself.
+	if project.IsStaticAdmin(req.Username) {
+		return nil, status.Error(codes.PermissionDenied, "The password of the static admin cannot be reset by other users")
+	}
+
+	if err := a.projectStore.UpdateProjectStaticUserPassword(ctx, claims.Role.ProjectId, req.Username, req.Password); err != nil {
+		a.logger.Error("failed to reset static user password", zap.Error(err))
+		return nil, gRPCStoreError(err, "reset static user password")
+	}
+	if req.ResetTotp {
+		if err := a.projectStore.UpdateProjectStaticUserTOTP(ctx, claims.Role.ProjectId, req.Username, ""); err != nil {
+			a.logger.Error("failed to reset static user two-factor authentication", zap.Error(err))
+			return nil, gRPCStoreError(err, "reset static user two-factor authentication")
+		}
+	}
+	return &webservice.ResetStaticUserPasswordResponse{}, nil
+}
+
+// ChangeStaticUserPassword changes the password of the current static user.
+func (a *WebAPI) ChangeStaticUserPassword(ctx context.Context, req *webservice.ChangeStaticUserPasswordRequest) (*webservice.ChangeStaticUserPasswordResponse, error) {
+	claims, err := rpcauth.ExtractClaims(ctx)
+	if err != nil {
+		a.logger.Error("failed to authenticate the current user", zap.Error(err))
+		return nil, err
+	}
+
+	if err := a.authenticateStaticUser(ctx, claims.Role.ProjectId, claims.Subject, req.CurrentPassword); err != nil {
+		return nil, err
+	}
+
+	if err := a.projectStore.UpdateProjectStaticUserPassword(ctx, claims.Role.ProjectId, claims.Subject, req.NewPassword); err != nil {
+		a.logger.Error("failed to change static user password", zap.Error(err))
+		return nil, gRPCStoreError(err, "change static user password")
+	}
+	return &webservice.ChangeStaticUserPasswordResponse{}, nil
+}
+
+// GenerateStaticUserTOTPSecret generates a new secret for the two-factor authentication of the current user.
+// The secret is not saved until it is confirmed by EnableStaticUserTOTP.
+func (a *WebAPI) GenerateStaticUserTOTPSecret(ctx context.Context, req *webservice.GenerateStaticUserTOTPSecretRequest) (*webservice.GenerateStaticUserTOTPSecretResponse, error) {
+	claims, err := rpcauth.ExtractClaims(ctx)
+	if err != nil {
+		a.logger.Error("failed to authenticate the current user", zap.Error(err))
+		return nil, err
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		a.logger.Error("failed to generate totp secret", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Failed to generate secret")
+	}
+
+	return &webservice.GenerateStaticUserTOTPSecretResponse{
+		Secret: secret,
+		Url:    totp.URL("PipeCD", fmt.Sprintf("%s@%s", claims.Subject, claims.Role.ProjectId), secret),
+	}, nil
+}
+
+// EnableStaticUserTOTP enables the two-factor authentication of the current static user
+// after confirming that the given passcode was generated from the given secret.
+func (a *WebAPI) EnableStaticUserTOTP(ctx context.Context, req *webservice.EnableStaticUserTOTPRequest) (*webservice.EnableStaticUserTOTPResponse, error) {
+	claims, err := rpcauth.ExtractClaims(ctx)
+	if err != nil {
+		a.logger.Error("failed to authenticate the current user", zap.Error(err))
+		return nil, err
+	}
+
+	if err := a.authenticateStaticUser(ctx, claims.Role.ProjectId, claims.Subject, req.Password); err != nil {
+		return nil, err
+	}
+
+	if err := totp.Validate(req.Secret, req.Passcode, time.Now()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Failed to verify the passcode: %v", err))
+	}
+
+	encrypted, err := a.encrypter.Encrypt(req.Secret)
+	if err != nil {
+		a.logger.Error("failed to encrypt totp secret", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Failed to encrypt the secret")
+	}
+
+	if err := a.projectStore.UpdateProjectStaticUserTOTP(ctx, claims.Role.ProjectId, claims.Subject, encrypted); err != nil {
+		a.logger.Error("failed to enable static user two-factor authentication", zap.Error(err))
+		return nil, gRPCStoreError(err, "enable static user two-factor authentication")
+	}
+	return &webservice.EnableStaticUserTOTPResponse{}, nil
+}
+
+// DisableStaticUserTOTP disables the two-factor authentication of the current static user.
+func (a *WebAPI) DisableStaticUserTOTP(ctx context.Context, req *webservice.DisableStaticUserTOTPRequest) (*webservice.DisableStaticUserTOTPResponse, error) {
+	claims, err := rpcauth.ExtractClaims(ctx)
+	if err != nil {
+		a.logger.Error("failed to authenticate the current user", zap.Error(err))
+		return nil, err
+	}
+
+	if err := a.authenticateStaticUser(ctx, claims.Role.ProjectId, claims.Subject, req.Password); err != nil {
+		return nil, err
+	}
+
+	if err := a.projectStore.UpdateProjectStaticUserTOTP(ctx, claims.Role.ProjectId, claims.Subject, ""); err != nil {
+		a.logger.Error("failed to disable static user two-factor authentication", zap.Error(err))
+		return nil, gRPCStoreError(err, "disable static user two-factor authentication")
+	}
+	return &webservice.DisableStaticUserTOTPResponse{}, nil
+}
+
+// authenticateStaticUser confirms that the current user is a static user of the project
+// by checking the given password. This prevents SSO users who have the same name from
+// managing the account of the static user.
+func (a *WebAPI) authenticateStaticUser(ctx context.Context, projectID, username, password string) error {
+	if _, ok := a.projectsInConfig[projectID]; ok {
+		return status.Error(codes.FailedPrecondition, "Failed to update a debug project specified in the control-plane configuration")
+	}
+
+	project, err := a.getProject(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	user, ok := project.FindStaticUser(username)
+	if !ok {
+		return status.Error(codes.FailedPrecondition, "The current user is not a static user")
+	}
+	if err := user.Auth(username, password); err != nil {
+		return status.Error(codes.PermissionDenied, "Wrong password")
+	}
+	return nil
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/jwt"
 	"github.com/pipe-cd/pipecd/pkg/model"
+	"github.com/pipe-cd/pipecd/pkg/totp"
 )
 
 const (
@@ -42,6 +43,7 @@ const (
 	projectFormKey  = "project"
 	usernameFormKey = "username"
 	passwordFormKey = "password"
+	passcodeFormKey = "passcode"
 	authCodeFormKey = "code"
 	stateFormKey    = "state"
 
@@ -71,6 +73,7 @@ type authHandler struct {
 	projectsInConfig map[string]config.ControlPlaneProject
 	sharedSSOConfigs map[string]*model.ProjectSSOConfig
 	projectGetter    projectGetter
+	passcodeGuard    *totp.ReplayGuard
 	secureCookie     bool
 	logger           *zap.Logger
 }
@@ -95,6 +98,7 @@ func newAuthHandler(
 		projectsInConfig: projectsInConfig,
 		sharedSSOConfigs: sharedSSOConfigs,
 		projectGetter:    projectGetter,
+		passcodeGuard:    totp.NewReplayGuard(),
 		secureCookie:     secureCookie,
 		logger:           logger,
 	}
@@ -29,6 +29,7 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/jwt"
 	"github.com/pipe-cd/pipecd/pkg/model"
 	"github.com/pipe-cd/pipecd/pkg/oauth/github"
+	"github.com/pipe-cd/pipecd/pkg/oauth/google"
 	"github.com/pipe-cd/pipecd/pkg/oauth/oidc"
 )
 
@@ -89,7 +90,7 @@ func (h *authHandler) handleCallback(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	}
-	user, err := getUser(ctx, sso, proj, authCode)
+	user, err := getUser(ctx, sso, proj, h.callbackURL, authCode)
 	if err != nil {
 		h.handleError(w, r, "Unable to find user", err)
 		return
@@ -142,7 +143,7 @@ func checkState(r *http.Request, key string, state string) error {
 	return nil
 }
 
-func getUser(ctx context.Context, sso *model.ProjectSSOConfig, project *model.Project, code string) (*model.User, error) {
+func getUser(ctx context.Context, sso *model.ProjectSSOConfig, project *model.Project, callbackURL, code string) (*model.User, error) {
 	switch sso.Provider {
 	case model.ProjectSSOConfig_GITHUB:
 		if sso.Github == nil {
@@ -153,6 +154,15 @@ func getUser(ctx context.Context, sso *model.ProjectSSOConfig, project *model.Pr
 			return nil, err
 		}
 		return cli.GetUser(ctx)
+	case model.ProjectSSOConfig_GOOGLE:
+		if sso.Google == nil {
+			return nil, fmt.Errorf("missing Google oauth in the SSO configuration")
+		}
+		cli, err := google.NewOAuthClient(ctx, sso.Google, project, callbackURL, code)
+		if err != nil {
+			return nil, err
+		}
+		return cli.GetUser(ctx)
 	case model.ProjectSSOConfig_OIDC:
 		if sso.Oidc == nil {
 			return nil, fmt.Errorf("missing OIDC oauth in the SSO configuration")
@@ -173,7 +183,7 @@ func parseProjectAndState(r *http.Request) (string, string, error) {
 		return "", "", fmt.Errorf("missing state")
 	}
 
-	// When using OIDC SSO, the state is in the format of "state-token:project-id".
+	// When using OIDC or Google SSO, the state is in the format of "state-token:project-id".
 	s := strings.Split(state, ":")
 	if len(s) != 2 {
 		projectID := r.FormValue(projectFormKey)
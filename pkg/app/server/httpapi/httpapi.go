@@ -37,6 +37,9 @@ func NewHandler(
 	projectsInConfig map[string]config.ControlPlaneProject,
 	sharedSSOConfigs map[string]*model.ProjectSSOConfig,
 	projectGetter projectGetter,
+	registryWebhooks []config.ControlPlaneRegistryWebhook,
+	apiKeyGetter apiKeyGetter,
+	eventAdder eventAdder,
 	secureCookie bool,
 	logger *zap.Logger,
 ) http.Handler {
@@ -52,6 +55,7 @@ func NewHandler(
 		secureCookie,
 		logger,
 	)
+	rw := newRegistryWebhookHandler(registryWebhooks, apiKeyGetter, eventAdder, logger)
 
 	fs := http.FileServer(http.Dir(filepath.Join(staticDir, "assets")))
 	assetsHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -75,6 +79,7 @@ func NewHandler(
 	register(staticLoginPath, http.HandlerFunc(a.handleStaticAdminLogin))
 	register(callbackPath, http.HandlerFunc(a.handleCallback))
 	register(logoutPath, http.HandlerFunc(a.handleLogout))
+	register(registryWebhookPath, http.HandlerFunc(rw.handle))
 
 	return mux
 }
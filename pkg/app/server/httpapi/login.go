@@ -79,7 +79,7 @@ func (h *authHandler) handleSSOLogin(w http.ResponseWriter, r *http.Request) {
 	http.Redirect(w, r, authURL, http.StatusFound)
 }
 
-// handleStaticAdminLogin is called when an user requested to login as a static admin.
+// handleStaticAdminLogin is called when an user requested to login as the static admin or a static user.
 func (h *authHandler) handleStaticAdminLogin(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "text/html")
 
@@ -104,9 +104,12 @@ func (h *authHandler) handleStaticAdminLogin(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	var admin *model.ProjectStaticUser
+	var (
+		user *model.ProjectStaticUser
+		role = model.BuiltinRBACRoleAdmin.String()
+	)
 	if p, ok := h.projectsInConfig[projectID]; ok {
-		admin = &model.ProjectStaticUser{
+		user = &model.ProjectStaticUser{
 			Username:     p.StaticAdmin.Username,
 			PasswordHash: p.StaticAdmin.PasswordHash,
 		}
@@ -119,25 +122,40 @@ func (h *authHandler) handleStaticAdminLogin(w http.ResponseWriter, r *http.Requ
 			h.handleError(w, r, fmt.Sprintf("Unable to find project: %s", projectID), err)
 			return
 		}
-		if proj.StaticAdminDisabled {
-			h.handleError(w, r, "Static admin is disabling", nil)
+		u, ok := proj.FindStaticUser(username)
+		if !ok {
+			h.handleError(w, r, "Unable to login", fmt.Errorf("wrong username %q", username))
 			return
 		}
-		admin = proj.StaticAdmin
+		if u == proj.StaticAdmin {
+			if proj.StaticAdminDisabled {
+				h.handleError(w, r, "Static admin is disabling", nil)
+				return
+			}
+		} else {
+			role = u.Role
+		}
+		user = u
 	}
 
-	if err := admin.Auth(username, password); err != nil {
+	if err := user.Auth(username, password); err != nil {
 		h.handleError(w, r, "Unable to login", err)
 		return
 	}
+	if user.TotpEnabled {
+		if err := h.verifyPasscode(projectID, user, r.FormValue(passcodeFormKey)); err != nil {
+			h.handleError(w, r, "Unable to login", err)
+			return
+		}
+	}
 
 	claims := jwt.NewClaims(
-		admin.Username,
+		user.Username,
 		"",
 		defaultTokenTTL,
 		model.Role{
 			ProjectId:        projectID,
-			ProjectRbacRoles: []string{model.BuiltinRBACRoleAdmin.String()},
+			ProjectRbacRoles: []string{role},
 		},
 	)
 	signedToken, err := h.signer.Sign(claims)
@@ -147,10 +165,26 @@ func (h *authHandler) handleStaticAdminLogin(w http.ResponseWriter, r *http.Requ
 	}
 
 	h.logger.Info("a new user has been logged in",
-		zap.String("user", admin.Username),
+		zap.String("user", user.Username),
 		zap.String("project-id", projectID),
-		zap.String("project-role", model.BuiltinRBACRoleAdmin.String()),
+		zap.String("project-role", role),
 	)
 	http.SetCookie(w, makeTokenCookie(signedToken, h.secureCookie))
 	http.Redirect(w, r, rootPath, http.StatusFound)
 }
+
+// verifyPasscode checks the time-based one-time passcode of a static user who enabled the two-factor authentication.
+// Each passcode can be used only once.
+func (h *authHandler) verifyPasscode(projectID string, user *model.ProjectStaticUser, passcode string) error {
+	if passcode == "" {
+		return fmt.Errorf("missing one-time passcode for username %q", user.Username)
+	}
+	secret, err := h.decrypter.Decrypt(user.TotpSecret)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt totp secret: %w", err)
+	}
+	if err := h.passcodeGuard.Validate(projectID+"/"+user.Username, secret, passcode, time.Now()); err != nil {
+		return fmt.Errorf("wrong one-time passcode for username %q: %w", user.Username, err)
+	}
+	return nil
+}
@@ -13,3 +13,176 @@
 // limitations under the License.
 
 package httpapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/pipe-cd/pipecd/pkg/jwt"
+	"github.com/pipe-cd/pipecd/pkg/model"
+	"github.com/pipe-cd/pipecd/pkg/totp"
+)
+
+type fakeSigner struct {
+	claims *jwt.Claims
+}
+
+func (s *fakeSigner) Sign(claims *jwt.Claims) (string, error) {
+	s.claims = claims
+	return "signed-token", nil
+}
+
+type fakeProjectGetter struct {
+	project *model.Project
+}
+
+func (g *fakeProjectGetter) Get(_ context.Context, id string) (*model.Project, error) {
+	if g.project.Id != id {
+		return nil, fmt.Errorf("not found")
+	}
+	return g.project, nil
+}
+
+type fakeDecrypter struct{}
+
+func (fakeDecrypter) Decrypt(encryptedText string) (string, error) {
+	return strings.TrimPrefix(encryptedText, "encrypted-"), nil
+}
+
+func TestHandleStaticAdminLogin(t *testing.T) {
+	t.Parallel()
+
+	hash := func(password string) string {
+		h, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+		require.NoError(t, err)
+		return string(h)
+	}
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+	passcode, err := totp.Generate(secret, time.Now())
+	require.NoError(t, err)
+
+	project := &model.Project{
+		Id: "project",
+		StaticAdmin: &model.ProjectStaticUser{
+			Username:     "admin",
+			PasswordHash: hash("admin-password"),
+		},
+		StaticUsers: []*model.ProjectStaticUser{
+			{
+				Username:     "alice",
+				PasswordHash: hash("alice-password"),
+				Role:         "Editor",
+			},
+			{
+				Username:     "bob",
+				PasswordHash: hash("bob-password"),
+				Role:         "Viewer",
+				TotpSecret:   "encrypted-" + secret,
+				TotpEnabled:  true,
+			},
+		},
+	}
+
+	testcases := []struct {
+		name         string
+		form         url.Values
+		expectedUser string
+		expectedRole string
+	}{
+		{
+			name: "static admin",
+			form: url.Values{
+				usernameFormKey: {"admin"},
+				passwordFormKey: {"admin-password"},
+			},
+			expectedUser: "admin",
+			expectedRole: "Admin",
+		},
+		{
+			name: "static user",
+			form: url.Values{
+				usernameFormKey: {"alice"},
+				passwordFormKey: {"alice-password"},
+			},
+			expectedUser: "alice",
+			expectedRole: "Editor",
+		},
+		{
+			name: "wrong password",
+			form: url.Values{
+				usernameFormKey: {"alice"},
+				passwordFormKey: {"admin-password"},
+			},
+		},
+		{
+			name: "unknown user",
+			form: url.Values{
+				usernameFormKey: {"carol"},
+				passwordFormKey: {"carol-password"},
+			},
+		},
+		{
+			name: "static user with two-factor authentication",
+			form: url.Values{
+				usernameFormKey: {"bob"},
+				passwordFormKey: {"bob-password"},
+				passcodeFormKey: {passcode},
+			},
+			expectedUser: "bob",
+			expectedRole: "Viewer",
+		},
+		{
+			name: "missing passcode",
+			form: url.Values{
+				usernameFormKey: {"bob"},
+				passwordFormKey: {"bob-password"},
+			},
+		},
+		{
+			name: "wrong passcode",
+			form: url.Values{
+				usernameFormKey: {"bob"},
+				passwordFormKey: {"bob-password"},
+				passcodeFormKey: {"000000"},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			signer := &fakeSigner{}
+			h := newAuthHandler(signer, fakeDecrypter{}, "https://pipecd.dev", "state-key", nil, nil, &fakeProjectGetter{project: project}, false, zap.NewNop())
+
+			tc.form.Set(projectFormKey, "project")
+			req := httptest.NewRequest(http.MethodPost, staticLoginPath, strings.NewReader(tc.form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			rec := httptest.NewRecorder()
+
+			h.handleStaticAdminLogin(rec, req)
+
+			if tc.expectedUser == "" {
+				assert.Equal(t, http.StatusSeeOther, rec.Code)
+				assert.Nil(t, signer.claims)
+				return
+			}
+			assert.Equal(t, http.StatusFound, rec.Code)
+			require.NotNil(t, signer.claims)
+			assert.Equal(t, tc.expectedUser, signer.claims.Subject)
+			assert.Equal(t, []string{tc.expectedRole}, signer.claims.Role.ProjectRbacRoles)
+		})
+	}
+}
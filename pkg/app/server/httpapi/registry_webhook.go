@@ -0,0 +1,558 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"text/template"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	// registryWebhookPath is the path prefix of the endpoints receiving
+	// push notifications from container registries.
+	registryWebhookPath = "/webhooks/registry/"
+
+	registryWebhookMaxBodySize = 1 << 20
+	defaultRegistryWebhookData = "{{ .Image }}"
+
+	dockerHubRegistry = "docker.io"
+	ghcrRegistry      = "ghcr.io"
+)
+
+type apiKeyGetter interface {
+	Get(ctx context.Context, id string) (*model.APIKey, error)
+}
+
+type eventAdder interface {
+	Add(ctx context.Context, e *model.Event) error
+}
+
+// imagePush represents a container image pushed to a registry.
+// Its fields are available in the templates of registry webhook rules.
+type imagePush struct {
+	Image      string
+	Registry   string
+	Repository string
+	Tag        string
+	Digest     string
+}
+
+func newImagePush(registry, repository, tag, digest string) imagePush {
+	p := imagePush{
+		Registry:   registry,
+		Repository: repository,
+		Tag:        tag,
+		Digest:     digest,
+	}
+	image := repository
+	if registry != "" {
+		image = registry + "/" + repository
+	}
+	switch {
+	case tag != "":
+		p.Image = image + ":" + tag
+	case digest != "":
+		p.Image = image + "@" + digest
+	default:
+		p.Image = image
+	}
+	return p
+}
+
+type registryWebhookRule struct {
+	config.RegistryWebhookRule
+	data   *template.Template
+	labels map[string]*template.Template
+}
+
+func (r *registryWebhookRule) match(p imagePush) bool {
+	if r.Repository != "" {
+		if ok, _ := path.Match(r.Repository, p.Repository); !ok {
+			return false
+		}
+	}
+	if r.Tag != "" {
+		if ok, _ := path.Match(r.Tag, p.Tag); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *registryWebhookRule) makeEvent(id string, p imagePush, projectID string) (*model.Event, error) {
+	execute := func(t *template.Template) (string, error) {
+		var buf bytes.Buffer
+		if err := t.Execute(&buf, p); err != nil {
+			return "", err
+		}
+		return buf.String(), nil
+	}
+
+	data, err := execute(r.data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render event data: %w", err)
+	}
+	labels := make(map[string]string, len(r.labels))
+	for k, t := range r.labels {
+		v, err := execute(t)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render label %s: %w", k, err)
+		}
+		labels[k] = v
+	}
+
+	return &model.Event{
+		Id:                id,
+		Name:              r.EventName,
+		Data:              data,
+		Labels:            labels,
+		EventKey:          model.MakeEventKey(r.EventName, labels),
+		ProjectId:         projectID,
+		Status:            model.EventStatus_EVENT_NOT_HANDLED,
+		StatusDescription: fmt.Sprintf("It is going to be replaced by %s", data),
+	}, nil
+}
+
+type registryWebhook struct {
+	config.ControlPlaneRegistryWebhook
+	rules []registryWebhookRule
+}
+
+// registryWebhookHandler handles push notifications sent from container registries
+// and registers events to be handled by EventWatcher.
+type registryWebhookHandler struct {
+	webhooks     map[string]*registryWebhook
+	apiKeyGetter apiKeyGetter
+	eventAdder   eventAdder
+	logger       *zap.Logger
+}
+
+func newRegistryWebhookHandler(
+	webhooks []config.ControlPlaneRegistryWebhook,
+	apiKeyGetter apiKeyGetter,
+	eventAdder eventAdder,
+	logger *zap.Logger,
+) *registryWebhookHandler {
+	h := &registryWebhookHandler{
+		webhooks:     make(map[string]*registryWebhook, len(webhooks)),
+		apiKeyGetter: apiKeyGetter,
+		eventAdder:   eventAdder,
+		logger:       logger.Named("registry-webhook-handler"),
+	}
+	for _, w := range webhooks {
+		rw, err := newRegistryWebhook(w)
+		if err != nil {
+			h.logger.Error("ignored invalid registry webhook", zap.String("name", w.Name), zap.Error(err))
+			continue
+		}
+		h.webhooks[w.Name] = rw
+	}
+	return h
+}
+
+func newRegistryWebhook(w config.ControlPlaneRegistryWebhook) (*registryWebhook, error) {
+	rw := &registryWebhook{
+		ControlPlaneRegistryWebhook: w,
+		rules:                       make([]registryWebhookRule, 0, len(w.Rules)),
+	}
+	for _, r := range w.Rules {
+		data := r.Data
+		if data == "" {
+			data = defaultRegistryWebhookData
+		}
+		dt, err := template.New("data").Option("missingkey=error").Parse(data)
+		if err != nil {
+			return nil, err
+		}
+		labels := make(map[string]*template.Template, len(r.Labels))
+		for k, v := range r.Labels {
+			lt, err := template.New(k).Option("missingkey=error").Parse(v)
+			if err != nil {
+				return nil, err
+			}
+			labels[k] = lt
+		}
+		rw.rules = append(rw.rules, registryWebhookRule{
+			RegistryWebhookRule: r,
+			data:                dt,
+			labels:              labels,
+		})
+	}
+	return rw, nil
+}
+
+func (h *registryWebhookHandler) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// The path is /webhooks/registry/{name} or /webhooks/registry/{name}/{secret}.
+	// Never log it since the latter contains the secret.
+	name, pathSecret, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, registryWebhookPath), "/")
+	webhook, ok := h.webhooks[name]
+	if !ok {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+	logger := h.logger.With(zap.String("webhook", name))
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, registryWebhookMaxBodySize))
+	if err != nil {
+		logger.Error("failed to read request body", zap.Error(err))
+		http.Error(w, "Bad request", http.StatusBadRequest)
+		return
+	}
+	if !verifyRegistryWebhookSecret(webhook.Provider, webhook.Secret, r, pathSecret, body) {
+		logger.Warn("received a registry webhook with invalid secret")
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx := r.Context()
+	key, err := h.apiKeyGetter.Get(ctx, webhook.APIKeyID)
+	if err != nil {
+		logger.Error("failed to get the API key tied to registry webhook", zap.Error(err))
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+	if key.Disabled || key.Role != model.APIKey_READ_WRITE {
+		logger.Warn("the API key tied to registry webhook is disabled or does not have READ_WRITE role")
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	pushes, err := parseRegistryWebhook(webhook.Provider, r, body)
+	if err != nil {
+		logger.Warn("failed to parse registry webhook payload", zap.Error(err))
+		http.Error(w, "Bad request", http.StatusBadRequest)
+		return
+	}
+
+	// Registries resend the same payload when a delivery failed, so the event IDs are derived
+	// from the payload to register every event only once even if only some of them were added.
+	delivery := sha256.Sum256(body)
+	events := make([]*model.Event, 0)
+	for i, p := range pushes {
+		for j := range webhook.rules {
+			rule := &webhook.rules[j]
+			if !rule.match(p) {
+				continue
+			}
+			id := uuid.NewSHA1(uuid.NameSpaceURL, []byte(fmt.Sprintf("%s/%x/%d/%d", name, delivery, i, j))).String()
+			event, err := rule.makeEvent(id, p, key.ProjectId)
+			if err != nil {
+				logger.Error("failed to make event from registry webhook", zap.String("image", p.Image), zap.Error(err))
+				http.Error(w, "Unprocessable entity", http.StatusUnprocessableEntity)
+				return
+			}
+			events = append(events, event)
+		}
+	}
+
+	ids := make([]string, 0, len(events))
+	for _, event := range events {
+		err := h.eventAdder.Add(ctx, event)
+		switch {
+		case err == nil:
+			logger.Info("registered event from registry webhook",
+				zap.String("event", event.Name),
+				zap.String("data", event.Data),
+				zap.String("project", key.ProjectId),
+			)
+		case errors.Is(err, datastore.ErrAlreadyExists):
+			logger.Info("event from registry webhook was already registered by a previous delivery",
+				zap.String("event", event.Name),
+				zap.String("id", event.Id),
+			)
+		default:
+			logger.Error("failed to add event", zap.String("event", event.Name), zap.Error(err))
+			http.Error(w, "Internal error", http.StatusInternalServerError)
+			return
+		}
+		ids = append(ids, event.Id)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(struct {
+		EventIDs []string `json:"eventIds"`
+	}{ids})
+}
+
+// verifyRegistryWebhookSecret checks whether the request was sent with the given secret.
+// The way to send the secret depends on what each registry supports:
+// - DOCKER_HUB: the last path element since Docker Hub allows neither headers nor signatures
+// - HARBOR: Authorization header
+// - GITHUB: HMAC-SHA256 signature of the body in X-Hub-Signature-256 header
+// - GITLAB: X-Gitlab-Token or Authorization header
+// - CLOUDEVENTS: Authorization header
+func verifyRegistryWebhookSecret(provider config.RegistryWebhookProvider, secret string, r *http.Request, pathSecret string, body []byte) bool {
+	equal := func(given string) bool {
+		return given != "" && subtle.ConstantTimeCompare([]byte(given), []byte(secret)) == 1
+	}
+	authorization := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+	// The secret in the path is only accepted from Docker Hub.
+	if provider != config.RegistryWebhookProviderDockerHub && pathSecret != "" {
+		return false
+	}
+
+	switch provider {
+	case config.RegistryWebhookProviderDockerHub:
+		return equal(pathSecret)
+	case config.RegistryWebhookProviderHarbor, config.RegistryWebhookProviderCloudEvents:
+		return equal(authorization)
+	case config.RegistryWebhookProviderGitHub:
+		signature, ok := strings.CutPrefix(r.Header.Get("X-Hub-Signature-256"), "sha256=")
+		if !ok {
+			return false
+		}
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(body)
+		expected := hex.EncodeToString(mac.Sum(nil))
+		return hmac.Equal([]byte(signature), []byte(expected))
+	case config.RegistryWebhookProviderGitLab:
+		return equal(r.Header.Get("X-Gitlab-Token")) || equal(authorization)
+	default:
+		return false
+	}
+}
+
+// parseRegistryWebhook extracts the pushed images from the given webhook payload.
+// Notifications not related to image pushes are ignored and give an empty list.
+func parseRegistryWebhook(provider config.RegistryWebhookProvider, r *http.Request, body []byte) ([]imagePush, error) {
+	switch provider {
+	case config.RegistryWebhookProviderDockerHub:
+		return parseDockerHubWebhook(body)
+	case config.RegistryWebhookProviderHarbor:
+		return parseHarborWebhook(body)
+	case config.RegistryWebhookProviderGitHub:
+		return parseGitHubPackageWebhook(r.Header.Get("X-GitHub-Event"), body)
+	case config.RegistryWebhookProviderGitLab:
+		return parseGitLabRegistryWebhook(body)
+	case config.RegistryWebhookProviderCloudEvents:
+		return parseCloudEventsWebhook(r.Header, body)
+	default:
+		return nil, fmt.Errorf("unsupported provider %q", provider)
+	}
+}
+
+func parseDockerHubWebhook(body []byte) ([]imagePush, error) {
+	var payload struct {
+		PushData struct {
+			Tag string `json:"tag"`
+		} `json:"push_data"`
+		Repository struct {
+			RepoName string `json:"repo_name"`
+		} `json:"repository"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	if payload.Repository.RepoName == "" {
+		return nil, errors.New("missing repository.repo_name")
+	}
+	return []imagePush{
+		newImagePush(dockerHubRegistry, payload.Repository.RepoName, payload.PushData.Tag, ""),
+	}, nil
+}
+
+func parseHarborWebhook(body []byte) ([]imagePush, error) {
+	var payload struct {
+		Type      string `json:"type"`
+		EventData struct {
+			Resources []struct {
+				Digest      string `json:"digest"`
+				Tag         string `json:"tag"`
+				ResourceURL string `json:"resource_url"`
+			} `json:"resources"`
+			Repository struct {
+				RepoFullName string `json:"repo_full_name"`
+			} `json:"repository"`
+		} `json:"event_data"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	if payload.Type != "PUSH_ARTIFACT" {
+		return nil, nil
+	}
+	repository := payload.EventData.Repository.RepoFullName
+	if repository == "" {
+		return nil, errors.New("missing event_data.repository.repo_full_name")
+	}
+
+	pushes := make([]imagePush, 0, len(payload.EventData.Resources))
+	for _, res := range payload.EventData.Resources {
+		registry, _, _ := strings.Cut(res.ResourceURL, "/")
+		pushes = append(pushes, newImagePush(registry, repository, res.Tag, res.Digest))
+	}
+	return pushes, nil
+}
+
+func parseGitHubPackageWebhook(event string, body []byte) ([]imagePush, error) {
+	if event != "package" && event != "registry_package" {
+		return nil, nil
+	}
+
+	type githubPackage struct {
+		Name        string `json:"name"`
+		PackageType string `json:"package_type"`
+		Owner       struct {
+			Login string `json:"login"`
+		} `json:"owner"`
+		PackageVersion struct {
+			ContainerMetadata struct {
+				Tag struct {
+					Name   string `json:"name"`
+					Digest string `json:"digest"`
+				} `json:"tag"`
+			} `json:"container_metadata"`
+		} `json:"package_version"`
+	}
+	var payload struct {
+		Action          string         `json:"action"`
+		Package         *githubPackage `json:"package"`
+		RegistryPackage *githubPackage `json:"registry_package"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	if payload.Action != "published" {
+		return nil, nil
+	}
+
+	pkg := payload.Package
+	if pkg == nil {
+		pkg = payload.RegistryPackage
+	}
+	if pkg == nil {
+		return nil, errors.New("missing package")
+	}
+	if !strings.EqualFold(pkg.PackageType, "container") {
+		return nil, nil
+	}
+
+	// Image names on GitHub Container Registry are always lowercase.
+	repository := strings.ToLower(pkg.Owner.Login + "/" + pkg.Name)
+	tag := pkg.PackageVersion.ContainerMetadata.Tag
+	return []imagePush{
+		newImagePush(ghcrRegistry, repository, tag.Name, tag.Digest),
+	}, nil
+}
+
+// distributionTarget is the target of notifications sent by the registries
+// built on top of the CNCF Distribution, e.g. GitLab Container Registry.
+type distributionTarget struct {
+	Repository string `json:"repository"`
+	Tag        string `json:"tag"`
+	Digest     string `json:"digest"`
+}
+
+func parseGitLabRegistryWebhook(body []byte) ([]imagePush, error) {
+	var payload struct {
+		Events []struct {
+			Action  string             `json:"action"`
+			Target  distributionTarget `json:"target"`
+			Request struct {
+				Host string `json:"host"`
+			} `json:"request"`
+		} `json:"events"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+
+	pushes := make([]imagePush, 0, len(payload.Events))
+	for _, e := range payload.Events {
+		// Layer pushes are also notified but they do not have any tag.
+		if e.Action != "push" || e.Target.Tag == "" {
+			continue
+		}
+		pushes = append(pushes, newImagePush(e.Request.Host, e.Target.Repository, e.Target.Tag, e.Target.Digest))
+	}
+	return pushes, nil
+}
+
+// cloudEventsImageData is the expected data of CloudEvents.
+// The target field is for the registries forwarding Distribution notifications.
+type cloudEventsImageData struct {
+	Registry   string              `json:"registry"`
+	Repository string              `json:"repository"`
+	Tag        string              `json:"tag"`
+	Digest     string              `json:"digest"`
+	Target     *distributionTarget `json:"target"`
+}
+
+func parseCloudEventsWebhook(header http.Header, body []byte) ([]imagePush, error) {
+	var data cloudEventsImageData
+
+	// In binary content mode, the event attributes are sent as headers
+	// and the body is the event data.
+	if header.Get("Ce-Specversion") != "" {
+		if err := json.Unmarshal(body, &data); err != nil {
+			return nil, err
+		}
+	} else {
+		var event struct {
+			SpecVersion string               `json:"specversion"`
+			Data        cloudEventsImageData `json:"data"`
+		}
+		if err := json.Unmarshal(body, &event); err != nil {
+			return nil, err
+		}
+		if event.SpecVersion == "" {
+			return nil, errors.New("missing specversion")
+		}
+		data = event.Data
+	}
+
+	if data.Target != nil {
+		if data.Repository == "" {
+			data.Repository = data.Target.Repository
+		}
+		if data.Tag == "" {
+			data.Tag = data.Target.Tag
+		}
+		if data.Digest == "" {
+			data.Digest = data.Target.Digest
+		}
+	}
+	if data.Repository == "" {
+		return nil, errors.New("missing repository in event data")
+	}
+	return []imagePush{
+		newImagePush(data.Registry, data.Repository, data.Tag, data.Digest),
+	}, nil
+}
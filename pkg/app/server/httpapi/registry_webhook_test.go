@@ -0,0 +1,462 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpapi
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeAPIKeyGetter struct {
+	keys map[string]*model.APIKey
+}
+
+func (g *fakeAPIKeyGetter) Get(_ context.Context, id string) (*model.APIKey, error) {
+	k, ok := g.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("not found")
+	}
+	return k, nil
+}
+
+type fakeEventAdder struct {
+	events []*model.Event
+	// The number of events which can be added before failing.
+	// Zero means no limit.
+	limit int
+}
+
+func (a *fakeEventAdder) Add(_ context.Context, e *model.Event) error {
+	for _, added := range a.events {
+		if added.Id == e.Id {
+			return datastore.ErrAlreadyExists
+		}
+	}
+	if a.limit > 0 && len(a.events) >= a.limit {
+		return fmt.Errorf("unavailable")
+	}
+	a.events = append(a.events, e)
+	return nil
+}
+
+func TestParseRegistryWebhook(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		provider config.RegistryWebhookProvider
+		header   map[string]string
+		body     string
+		expected []imagePush
+		wantErr  bool
+	}{
+		{
+			name:     "docker hub",
+			provider: config.RegistryWebhookProviderDockerHub,
+			body:     `{"push_data":{"tag":"v1.0.0","pusher":"user"},"repository":{"repo_name":"my-org/app","namespace":"my-org","name":"app"}}`,
+			expected: []imagePush{
+				{Image: "docker.io/my-org/app:v1.0.0", Registry: "docker.io", Repository: "my-org/app", Tag: "v1.0.0"},
+			},
+		},
+		{
+			name:     "docker hub missing repository",
+			provider: config.RegistryWebhookProviderDockerHub,
+			body:     `{"push_data":{"tag":"v1.0.0"}}`,
+			wantErr:  true,
+		},
+		{
+			name:     "harbor push artifact",
+			provider: config.RegistryWebhookProviderHarbor,
+			body:     `{"type":"PUSH_ARTIFACT","event_data":{"resources":[{"digest":"sha256:abc","tag":"v1.0.0","resource_url":"harbor.example.com/library/app:v1.0.0"}],"repository":{"name":"app","namespace":"library","repo_full_name":"library/app"}}}`,
+			expected: []imagePush{
+				{Image: "harbor.example.com/library/app:v1.0.0", Registry: "harbor.example.com", Repository: "library/app", Tag: "v1.0.0", Digest: "sha256:abc"},
+			},
+		},
+		{
+			name:     "harbor other event type",
+			provider: config.RegistryWebhookProviderHarbor,
+			body:     `{"type":"DELETE_ARTIFACT","event_data":{}}`,
+		},
+		{
+			name:     "github package published",
+			provider: config.RegistryWebhookProviderGitHub,
+			header:   map[string]string{"X-GitHub-Event": "package"},
+			body:     `{"action":"published","package":{"name":"App","package_type":"CONTAINER","owner":{"login":"My-Org"},"package_version":{"container_metadata":{"tag":{"name":"v1.0.0","digest":"sha256:abc"}}}}}`,
+			expected: []imagePush{
+				{Image: "ghcr.io/my-org/app:v1.0.0", Registry: "ghcr.io", Repository: "my-org/app", Tag: "v1.0.0", Digest: "sha256:abc"},
+			},
+		},
+		{
+			name:     "github untagged package",
+			provider: config.RegistryWebhookProviderGitHub,
+			header:   map[string]string{"X-GitHub-Event": "registry_package"},
+			body:     `{"action":"published","registry_package":{"name":"app","package_type":"container","owner":{"login":"my-org"},"package_version":{"container_metadata":{"tag":{"name":"","digest":"sha256:abc"}}}}}`,
+			expected: []imagePush{
+				{Image: "ghcr.io/my-org/app@sha256:abc", Registry: "ghcr.io", Repository: "my-org/app", Digest: "sha256:abc"},
+			},
+		},
+		{
+			name:     "github ping",
+			provider: config.RegistryWebhookProviderGitHub,
+			header:   map[string]string{"X-GitHub-Event": "ping"},
+			body:     `{"zen":"Keep it simple."}`,
+		},
+		{
+			name:     "github npm package",
+			provider: config.RegistryWebhookProviderGitHub,
+			header:   map[string]string{"X-GitHub-Event": "package"},
+			body:     `{"action":"published","package":{"name":"lib","package_type":"npm","owner":{"login":"my-org"}}}`,
+		},
+		{
+			name:     "gitlab registry notification",
+			provider: config.RegistryWebhookProviderGitLab,
+			body:     `{"events":[{"action":"push","target":{"mediaType":"application/octet-stream","repository":"group/app","digest":"sha256:layer"},"request":{"host":"registry.gitlab.com"}},{"action":"push","target":{"mediaType":"application/vnd.docker.distribution.manifest.v2+json","repository":"group/app","tag":"v1.0.0","digest":"sha256:abc"},"request":{"host":"registry.gitlab.com"}},{"action":"pull","target":{"repository":"group/app","tag":"v0.9.0"}}]}`,
+			expected: []imagePush{
+				{Image: "registry.gitlab.com/group/app:v1.0.0", Registry: "registry.gitlab.com", Repository: "group/app", Tag: "v1.0.0", Digest: "sha256:abc"},
+			},
+		},
+		{
+			name:     "cloudevents structured mode",
+			provider: config.RegistryWebhookProviderCloudEvents,
+			body:     `{"specversion":"1.0","type":"dev.example.image.pushed","source":"registry","id":"1","data":{"registry":"registry.example.com","repository":"team/app","tag":"v1.0.0"}}`,
+			expected: []imagePush{
+				{Image: "registry.example.com/team/app:v1.0.0", Registry: "registry.example.com", Repository: "team/app", Tag: "v1.0.0"},
+			},
+		},
+		{
+			name:     "cloudevents binary mode with distribution target",
+			provider: config.RegistryWebhookProviderCloudEvents,
+			header:   map[string]string{"Ce-Specversion": "1.0", "Ce-Type": "Microsoft.ContainerRegistry.ImagePushed"},
+			body:     `{"target":{"repository":"team/app","tag":"v1.0.0","digest":"sha256:abc"}}`,
+			expected: []imagePush{
+				{Image: "team/app:v1.0.0", Repository: "team/app", Tag: "v1.0.0", Digest: "sha256:abc"},
+			},
+		},
+		{
+			name:     "cloudevents missing specversion",
+			provider: config.RegistryWebhookProviderCloudEvents,
+			body:     `{"data":{"repository":"team/app","tag":"v1.0.0"}}`,
+			wantErr:  true,
+		},
+		{
+			name:     "invalid json",
+			provider: config.RegistryWebhookProviderGitLab,
+			body:     `{`,
+			wantErr:  true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			req := httptest.NewRequest(http.MethodPost, registryWebhookPath+"test", nil)
+			for k, v := range tc.header {
+				req.Header.Set(k, v)
+			}
+			pushes, err := parseRegistryWebhook(tc.provider, req, []byte(tc.body))
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, len(tc.expected), len(pushes))
+			for i := range tc.expected {
+				assert.Equal(t, tc.expected[i], pushes[i])
+			}
+		})
+	}
+}
+
+func TestVerifyRegistryWebhookSecret(t *testing.T) {
+	t.Parallel()
+
+	const secret = "webhook-secret"
+	body := []byte(`{"action":"published"}`)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	testcases := []struct {
+		name       string
+		provider   config.RegistryWebhookProvider
+		pathSecret string
+		header     map[string]string
+		expected   bool
+	}{
+		{
+			name:       "docker hub path secret",
+			provider:   config.RegistryWebhookProviderDockerHub,
+			pathSecret: secret,
+			expected:   true,
+		},
+		{
+			name:       "docker hub wrong path secret",
+			provider:   config.RegistryWebhookProviderDockerHub,
+			pathSecret: "wrong",
+			expected:   false,
+		},
+		{
+			name:     "docker hub missing path secret",
+			provider: config.RegistryWebhookProviderDockerHub,
+			expected: false,
+		},
+		{
+			name:       "path secret is not accepted from other registries",
+			provider:   config.RegistryWebhookProviderHarbor,
+			pathSecret: secret,
+			header:     map[string]string{"Authorization": secret},
+			expected:   false,
+		},
+		{
+			name:     "harbor authorization header",
+			provider: config.RegistryWebhookProviderHarbor,
+			header:   map[string]string{"Authorization": secret},
+			expected: true,
+		},
+		{
+			name:     "cloudevents bearer token",
+			provider: config.RegistryWebhookProviderCloudEvents,
+			header:   map[string]string{"Authorization": "Bearer " + secret},
+			expected: true,
+		},
+		{
+			name:     "cloudevents missing authorization",
+			provider: config.RegistryWebhookProviderCloudEvents,
+			expected: false,
+		},
+		{
+			name:     "github signature",
+			provider: config.RegistryWebhookProviderGitHub,
+			header:   map[string]string{"X-Hub-Signature-256": signature},
+			expected: true,
+		},
+		{
+			name:     "github wrong signature",
+			provider: config.RegistryWebhookProviderGitHub,
+			header:   map[string]string{"X-Hub-Signature-256": "sha256=0123"},
+			expected: false,
+		},
+		{
+			name:     "gitlab token",
+			provider: config.RegistryWebhookProviderGitLab,
+			header:   map[string]string{"X-Gitlab-Token": secret},
+			expected: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			req := httptest.NewRequest(http.MethodPost, registryWebhookPath+"test", nil)
+			for k, v := range tc.header {
+				req.Header.Set(k, v)
+			}
+			got := verifyRegistryWebhookSecret(tc.provider, secret, req, tc.pathSecret, body)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestHandleRegistryWebhook(t *testing.T) {
+	t.Parallel()
+
+	webhooks := []config.ControlPlaneRegistryWebhook{
+		{
+			Name:     "harbor",
+			Provider: config.RegistryWebhookProviderHarbor,
+			APIKeyID: "read-write-key",
+			Secret:   "secret",
+			Rules: []config.RegistryWebhookRule{
+				{
+					Repository: "library/*",
+					Tag:        "v*",
+					EventName:  "image-update",
+					Labels:     map[string]string{"repo": "{{ .Repository }}"},
+				},
+				{
+					Repository: "library/app",
+					EventName:  "app-tag-update",
+					Data:       "{{ .Tag }}",
+				},
+				{
+					Repository: "other/*",
+					EventName:  "other-update",
+				},
+			},
+		},
+		{
+			Name:     "read-only",
+			Provider: config.RegistryWebhookProviderHarbor,
+			APIKeyID: "read-only-key",
+			Secret:   "secret",
+			Rules:    []config.RegistryWebhookRule{{EventName: "image-update"}},
+		},
+	}
+	keys := &fakeAPIKeyGetter{
+		keys: map[string]*model.APIKey{
+			"read-write-key": {Id: "read-write-key", ProjectId: "project", Role: model.APIKey_READ_WRITE},
+			"read-only-key":  {Id: "read-only-key", ProjectId: "project", Role: model.APIKey_READ_ONLY},
+		},
+	}
+	body := `{"type":"PUSH_ARTIFACT","event_data":{"resources":[{"digest":"sha256:abc","tag":"v1.0.0","resource_url":"harbor.example.com/library/app:v1.0.0"}],"repository":{"repo_full_name":"library/app"}}}`
+
+	testcases := []struct {
+		name           string
+		method         string
+		webhook        string
+		authorization  string
+		expectedStatus int
+		expectedEvents []*model.Event
+	}{
+		{
+			name:           "method not allowed",
+			method:         http.MethodGet,
+			webhook:        "harbor",
+			authorization:  "secret",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "unknown webhook",
+			method:         http.MethodPost,
+			webhook:        "unknown",
+			authorization:  "secret",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "invalid secret",
+			method:         http.MethodPost,
+			webhook:        "harbor",
+			authorization:  "wrong",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "read only API key",
+			method:         http.MethodPost,
+			webhook:        "read-only",
+			authorization:  "secret",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "registered events",
+			method:         http.MethodPost,
+			webhook:        "harbor",
+			authorization:  "secret",
+			expectedStatus: http.StatusOK,
+			expectedEvents: []*model.Event{
+				{
+					Name:      "image-update",
+					Data:      "harbor.example.com/library/app:v1.0.0",
+					Labels:    map[string]string{"repo": "library/app"},
+					EventKey:  model.MakeEventKey("image-update", map[string]string{"repo": "library/app"}),
+					ProjectId: "project",
+					Status:    model.EventStatus_EVENT_NOT_HANDLED,
+				},
+				{
+					Name:      "app-tag-update",
+					Data:      "v1.0.0",
+					Labels:    map[string]string{},
+					EventKey:  model.MakeEventKey("app-tag-update", nil),
+					ProjectId: "project",
+					Status:    model.EventStatus_EVENT_NOT_HANDLED,
+				},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			adder := &fakeEventAdder{}
+			h := newRegistryWebhookHandler(webhooks, keys, adder, zap.NewNop())
+
+			req := httptest.NewRequest(tc.method, registryWebhookPath+tc.webhook, strings.NewReader(body))
+			req.Header.Set("Authorization", tc.authorization)
+			rec := httptest.NewRecorder()
+			h.handle(rec, req)
+
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			require.Equal(t, len(tc.expectedEvents), len(adder.events))
+			for i, expected := range tc.expectedEvents {
+				got := adder.events[i]
+				assert.NotEmpty(t, got.Id)
+				assert.Equal(t, expected.Name, got.Name)
+				assert.Equal(t, expected.Data, got.Data)
+				assert.Equal(t, expected.Labels, got.Labels)
+				assert.Equal(t, expected.EventKey, got.EventKey)
+				assert.Equal(t, expected.ProjectId, got.ProjectId)
+				assert.Equal(t, expected.Status, got.Status)
+			}
+		})
+	}
+}
+
+func TestHandleRegistryWebhookRetry(t *testing.T) {
+	t.Parallel()
+
+	webhooks := []config.ControlPlaneRegistryWebhook{
+		{
+			Name:     "docker-hub",
+			Provider: config.RegistryWebhookProviderDockerHub,
+			APIKeyID: "read-write-key",
+			Secret:   "secret",
+			Rules: []config.RegistryWebhookRule{
+				{EventName: "image-update"},
+				{EventName: "tag-update", Data: "{{ .Tag }}"},
+			},
+		},
+	}
+	keys := &fakeAPIKeyGetter{
+		keys: map[string]*model.APIKey{
+			"read-write-key": {Id: "read-write-key", ProjectId: "project", Role: model.APIKey_READ_WRITE},
+		},
+	}
+	body := `{"push_data":{"tag":"v1.0.0"},"repository":{"repo_name":"org/app"}}`
+	adder := &fakeEventAdder{limit: 1}
+	h := newRegistryWebhookHandler(webhooks, keys, adder, zap.NewNop())
+
+	send := func() int {
+		req := httptest.NewRequest(http.MethodPost, registryWebhookPath+"docker-hub/secret", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		h.handle(rec, req)
+		return rec.Code
+	}
+
+	// The first delivery fails after adding only one event.
+	require.Equal(t, http.StatusInternalServerError, send())
+	require.Len(t, adder.events, 1)
+
+	// The retried delivery adds the rest without duplicating the added one.
+	adder.limit = 0
+	require.Equal(t, http.StatusOK, send())
+	require.Len(t, adder.events, 2)
+	assert.Equal(t, "image-update", adder.events[0].Name)
+	assert.Equal(t, "tag-update", adder.events[1].Name)
+
+	// Resending the same delivery again registers nothing new.
+	require.Equal(t, http.StatusOK, send())
+	assert.Len(t, adder.events, 2)
+}
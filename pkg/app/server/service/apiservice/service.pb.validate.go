@@ -3686,6 +3686,223 @@ var _ interface {
 	ErrorName() string
 } = ListDeploymentsResponseValidationError{}
 
+// Validate checks the field values on CancelDeploymentChainRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelDeploymentChainRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelDeploymentChainRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelDeploymentChainRequestMultiError, or nil if none found.
+func (m *CancelDeploymentChainRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelDeploymentChainRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentChainId()) < 1 {
+		err := CancelDeploymentChainRequestValidationError{
+			field:  "DeploymentChainId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CancelDeploymentChainRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelDeploymentChainRequestMultiError is an error wrapping multiple
+// validation errors returned by CancelDeploymentChainRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CancelDeploymentChainRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelDeploymentChainRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelDeploymentChainRequestMultiError) AllErrors() []error { return m }
+
+// CancelDeploymentChainRequestValidationError is the validation error returned
+// by CancelDeploymentChainRequest.Validate if the designated constraints
+// aren't met.
+type CancelDeploymentChainRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelDeploymentChainRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelDeploymentChainRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelDeploymentChainRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelDeploymentChainRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelDeploymentChainRequestValidationError) ErrorName() string {
+	return "CancelDeploymentChainRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelDeploymentChainRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelDeploymentChainRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelDeploymentChainRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelDeploymentChainRequestValidationError{}
+
+// Validate checks the field values on CancelDeploymentChainResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelDeploymentChainResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelDeploymentChainResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CancelDeploymentChainResponseMultiError, or nil if none found.
+func (m *CancelDeploymentChainResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelDeploymentChainResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CancelDeploymentChainResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelDeploymentChainResponseMultiError is an error wrapping multiple
+// validation errors returned by CancelDeploymentChainResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CancelDeploymentChainResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelDeploymentChainResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelDeploymentChainResponseMultiError) AllErrors() []error { return m }
+
+// CancelDeploymentChainResponseValidationError is the validation error
+// returned by CancelDeploymentChainResponse.Validate if the designated
+// constraints aren't met.
+type CancelDeploymentChainResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelDeploymentChainResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelDeploymentChainResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelDeploymentChainResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelDeploymentChainResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelDeploymentChainResponseValidationError) ErrorName() string {
+	return "CancelDeploymentChainResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelDeploymentChainResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelDeploymentChainResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelDeploymentChainResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelDeploymentChainResponseValidationError{}
+
 // Validate checks the field values on GetCommandRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -4185,13 +4402,1159 @@ func (m *DisablePipedRequest) validate(all bool) error {
 	return nil
 }
 
-// DisablePipedRequestMultiError is an error wrapping multiple validation
-// errors returned by DisablePipedRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DisablePipedRequestMultiError []error
+// DisablePipedRequestMultiError is an error wrapping multiple validation
+// errors returned by DisablePipedRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DisablePipedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisablePipedRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisablePipedRequestMultiError) AllErrors() []error { return m }
+
+// DisablePipedRequestValidationError is the validation error returned by
+// DisablePipedRequest.Validate if the designated constraints aren't met.
+type DisablePipedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisablePipedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisablePipedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisablePipedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisablePipedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisablePipedRequestValidationError) ErrorName() string {
+	return "DisablePipedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisablePipedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisablePipedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisablePipedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisablePipedRequestValidationError{}
+
+// Validate checks the field values on DisablePipedResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisablePipedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisablePipedResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisablePipedResponseMultiError, or nil if none found.
+func (m *DisablePipedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisablePipedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DisablePipedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisablePipedResponseMultiError is an error wrapping multiple validation
+// errors returned by DisablePipedResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DisablePipedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisablePipedResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisablePipedResponseMultiError) AllErrors() []error { return m }
+
+// DisablePipedResponseValidationError is the validation error returned by
+// DisablePipedResponse.Validate if the designated constraints aren't met.
+type DisablePipedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisablePipedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisablePipedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisablePipedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisablePipedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisablePipedResponseValidationError) ErrorName() string {
+	return "DisablePipedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisablePipedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisablePipedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisablePipedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisablePipedResponseValidationError{}
+
+// Validate checks the field values on ListStaticUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStaticUsersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStaticUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStaticUsersRequestMultiError, or nil if none found.
+func (m *ListStaticUsersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStaticUsersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListStaticUsersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStaticUsersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListStaticUsersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListStaticUsersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStaticUsersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStaticUsersRequestMultiError) AllErrors() []error { return m }
+
+// ListStaticUsersRequestValidationError is the validation error returned by
+// ListStaticUsersRequest.Validate if the designated constraints aren't met.
+type ListStaticUsersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStaticUsersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStaticUsersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStaticUsersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStaticUsersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStaticUsersRequestValidationError) ErrorName() string {
+	return "ListStaticUsersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStaticUsersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStaticUsersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStaticUsersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStaticUsersRequestValidationError{}
+
+// Validate checks the field values on ListStaticUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStaticUsersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStaticUsersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStaticUsersResponseMultiError, or nil if none found.
+func (m *ListStaticUsersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStaticUsersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListStaticUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListStaticUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListStaticUsersResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListStaticUsersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStaticUsersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListStaticUsersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListStaticUsersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStaticUsersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStaticUsersResponseMultiError) AllErrors() []error { return m }
+
+// ListStaticUsersResponseValidationError is the validation error returned by
+// ListStaticUsersResponse.Validate if the designated constraints aren't met.
+type ListStaticUsersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStaticUsersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStaticUsersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStaticUsersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStaticUsersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStaticUsersResponseValidationError) ErrorName() string {
+	return "ListStaticUsersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStaticUsersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStaticUsersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStaticUsersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStaticUsersResponseValidationError{}
+
+// Validate checks the field values on AddStaticUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddStaticUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddStaticUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddStaticUserRequestMultiError, or nil if none found.
+func (m *AddStaticUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddStaticUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUsername()) < 1 {
+		err := AddStaticUserRequestValidationError{
+			field:  "Username",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPassword()) < 1 {
+		err := AddStaticUserRequestValidationError{
+			field:  "Password",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := AddStaticUserRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddStaticUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddStaticUserRequestMultiError is an error wrapping multiple validation
+// errors returned by AddStaticUserRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddStaticUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddStaticUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddStaticUserRequestMultiError) AllErrors() []error { return m }
+
+// AddStaticUserRequestValidationError is the validation error returned by
+// AddStaticUserRequest.Validate if the designated constraints aren't met.
+type AddStaticUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddStaticUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddStaticUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddStaticUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddStaticUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddStaticUserRequestValidationError) ErrorName() string {
+	return "AddStaticUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddStaticUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddStaticUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddStaticUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddStaticUserRequestValidationError{}
+
+// Validate checks the field values on AddStaticUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddStaticUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddStaticUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddStaticUserResponseMultiError, or nil if none found.
+func (m *AddStaticUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddStaticUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AddStaticUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddStaticUserResponseMultiError is an error wrapping multiple validation
+// errors returned by AddStaticUserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddStaticUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddStaticUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddStaticUserResponseMultiError) AllErrors() []error { return m }
+
+// AddStaticUserResponseValidationError is the validation error returned by
+// AddStaticUserResponse.Validate if the designated constraints aren't met.
+type AddStaticUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddStaticUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddStaticUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddStaticUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddStaticUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddStaticUserResponseValidationError) ErrorName() string {
+	return "AddStaticUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddStaticUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddStaticUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddStaticUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddStaticUserResponseValidationError{}
+
+// Validate checks the field values on DeleteStaticUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteStaticUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteStaticUserRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteStaticUserRequestMultiError, or nil if none found.
+func (m *DeleteStaticUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteStaticUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUsername()) < 1 {
+		err := DeleteStaticUserRequestValidationError{
+			field:  "Username",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteStaticUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteStaticUserRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteStaticUserRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteStaticUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteStaticUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteStaticUserRequestMultiError) AllErrors() []error { return m }
+
+// DeleteStaticUserRequestValidationError is the validation error returned by
+// DeleteStaticUserRequest.Validate if the designated constraints aren't met.
+type DeleteStaticUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteStaticUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteStaticUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteStaticUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteStaticUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteStaticUserRequestValidationError) ErrorName() string {
+	return "DeleteStaticUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteStaticUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteStaticUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteStaticUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteStaticUserRequestValidationError{}
+
+// Validate checks the field values on DeleteStaticUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteStaticUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteStaticUserResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteStaticUserResponseMultiError, or nil if none found.
+func (m *DeleteStaticUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteStaticUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteStaticUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteStaticUserResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteStaticUserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteStaticUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteStaticUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteStaticUserResponseMultiError) AllErrors() []error { return m }
+
+// DeleteStaticUserResponseValidationError is the validation error returned by
+// DeleteStaticUserResponse.Validate if the designated constraints aren't met.
+type DeleteStaticUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteStaticUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteStaticUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteStaticUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteStaticUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteStaticUserResponseValidationError) ErrorName() string {
+	return "DeleteStaticUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteStaticUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteStaticUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteStaticUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteStaticUserResponseValidationError{}
+
+// Validate checks the field values on UpdateStaticUserRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateStaticUserRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateStaticUserRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateStaticUserRoleRequestMultiError, or nil if none found.
+func (m *UpdateStaticUserRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateStaticUserRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUsername()) < 1 {
+		err := UpdateStaticUserRoleRequestValidationError{
+			field:  "Username",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := UpdateStaticUserRoleRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateStaticUserRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateStaticUserRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateStaticUserRoleRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateStaticUserRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateStaticUserRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateStaticUserRoleRequestMultiError) AllErrors() []error { return m }
+
+// UpdateStaticUserRoleRequestValidationError is the validation error returned
+// by UpdateStaticUserRoleRequest.Validate if the designated constraints
+// aren't met.
+type UpdateStaticUserRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateStaticUserRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateStaticUserRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateStaticUserRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateStaticUserRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateStaticUserRoleRequestValidationError) ErrorName() string {
+	return "UpdateStaticUserRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateStaticUserRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateStaticUserRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateStaticUserRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateStaticUserRoleRequestValidationError{}
+
+// Validate checks the field values on UpdateStaticUserRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateStaticUserRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateStaticUserRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateStaticUserRoleResponseMultiError, or nil if none found.
+func (m *UpdateStaticUserRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateStaticUserRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateStaticUserRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateStaticUserRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateStaticUserRoleResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateStaticUserRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateStaticUserRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateStaticUserRoleResponseMultiError) AllErrors() []error { return m }
+
+// UpdateStaticUserRoleResponseValidationError is the validation error returned
+// by UpdateStaticUserRoleResponse.Validate if the designated constraints
+// aren't met.
+type UpdateStaticUserRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateStaticUserRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateStaticUserRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateStaticUserRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateStaticUserRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateStaticUserRoleResponseValidationError) ErrorName() string {
+	return "UpdateStaticUserRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateStaticUserRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateStaticUserRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateStaticUserRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateStaticUserRoleResponseValidationError{}
+
+// Validate checks the field values on ResetStaticUserPasswordRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResetStaticUserPasswordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetStaticUserPasswordRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ResetStaticUserPasswordRequestMultiError, or nil if none found.
+func (m *ResetStaticUserPasswordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetStaticUserPasswordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUsername()) < 1 {
+		err := ResetStaticUserPasswordRequestValidationError{
+			field:  "Username",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPassword()) < 1 {
+		err := ResetStaticUserPasswordRequestValidationError{
+			field:  "Password",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ResetTotp
+
+	if len(errors) > 0 {
+		return ResetStaticUserPasswordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetStaticUserPasswordRequestMultiError is an error wrapping multiple
+// validation errors returned by ResetStaticUserPasswordRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ResetStaticUserPasswordRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DisablePipedRequestMultiError) Error() string {
+func (m ResetStaticUserPasswordRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4200,11 +5563,12 @@ func (m DisablePipedRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DisablePipedRequestMultiError) AllErrors() []error { return m }
+func (m ResetStaticUserPasswordRequestMultiError) AllErrors() []error { return m }
 
-// DisablePipedRequestValidationError is the validation error returned by
-// DisablePipedRequest.Validate if the designated constraints aren't met.
-type DisablePipedRequestValidationError struct {
+// ResetStaticUserPasswordRequestValidationError is the validation error
+// returned by ResetStaticUserPasswordRequest.Validate if the designated
+// constraints aren't met.
+type ResetStaticUserPasswordRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4212,24 +5576,24 @@ type DisablePipedRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DisablePipedRequestValidationError) Field() string { return e.field }
+func (e ResetStaticUserPasswordRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DisablePipedRequestValidationError) Reason() string { return e.reason }
+func (e ResetStaticUserPasswordRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DisablePipedRequestValidationError) Cause() error { return e.cause }
+func (e ResetStaticUserPasswordRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DisablePipedRequestValidationError) Key() bool { return e.key }
+func (e ResetStaticUserPasswordRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DisablePipedRequestValidationError) ErrorName() string {
-	return "DisablePipedRequestValidationError"
+func (e ResetStaticUserPasswordRequestValidationError) ErrorName() string {
+	return "ResetStaticUserPasswordRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DisablePipedRequestValidationError) Error() string {
+func (e ResetStaticUserPasswordRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4241,14 +5605,14 @@ func (e DisablePipedRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDisablePipedRequest.%s: %s%s",
+		"invalid %sResetStaticUserPasswordRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DisablePipedRequestValidationError{}
+var _ error = ResetStaticUserPasswordRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4256,24 +5620,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DisablePipedRequestValidationError{}
+} = ResetStaticUserPasswordRequestValidationError{}
 
-// Validate checks the field values on DisablePipedResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ResetStaticUserPasswordResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DisablePipedResponse) Validate() error {
+func (m *ResetStaticUserPasswordResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DisablePipedResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DisablePipedResponseMultiError, or nil if none found.
-func (m *DisablePipedResponse) ValidateAll() error {
+// ValidateAll checks the field values on ResetStaticUserPasswordResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ResetStaticUserPasswordResponseMultiError, or nil if none found.
+func (m *ResetStaticUserPasswordResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DisablePipedResponse) validate(all bool) error {
+func (m *ResetStaticUserPasswordResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4281,19 +5645,19 @@ func (m *DisablePipedResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return DisablePipedResponseMultiError(errors)
+		return ResetStaticUserPasswordResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DisablePipedResponseMultiError is an error wrapping multiple validation
-// errors returned by DisablePipedResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DisablePipedResponseMultiError []error
+// ResetStaticUserPasswordResponseMultiError is an error wrapping multiple
+// validation errors returned by ResetStaticUserPasswordResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ResetStaticUserPasswordResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DisablePipedResponseMultiError) Error() string {
+func (m ResetStaticUserPasswordResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4302,11 +5666,12 @@ func (m DisablePipedResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DisablePipedResponseMultiError) AllErrors() []error { return m }
+func (m ResetStaticUserPasswordResponseMultiError) AllErrors() []error { return m }
 
-// DisablePipedResponseValidationError is the validation error returned by
-// DisablePipedResponse.Validate if the designated constraints aren't met.
-type DisablePipedResponseValidationError struct {
+// ResetStaticUserPasswordResponseValidationError is the validation error
+// returned by ResetStaticUserPasswordResponse.Validate if the designated
+// constraints aren't met.
+type ResetStaticUserPasswordResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4314,24 +5679,24 @@ type DisablePipedResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DisablePipedResponseValidationError) Field() string { return e.field }
+func (e ResetStaticUserPasswordResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DisablePipedResponseValidationError) Reason() string { return e.reason }
+func (e ResetStaticUserPasswordResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DisablePipedResponseValidationError) Cause() error { return e.cause }
+func (e ResetStaticUserPasswordResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DisablePipedResponseValidationError) Key() bool { return e.key }
+func (e ResetStaticUserPasswordResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DisablePipedResponseValidationError) ErrorName() string {
-	return "DisablePipedResponseValidationError"
+func (e ResetStaticUserPasswordResponseValidationError) ErrorName() string {
+	return "ResetStaticUserPasswordResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DisablePipedResponseValidationError) Error() string {
+func (e ResetStaticUserPasswordResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4343,14 +5708,14 @@ func (e DisablePipedResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDisablePipedResponse.%s: %s%s",
+		"invalid %sResetStaticUserPasswordResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DisablePipedResponseValidationError{}
+var _ error = ResetStaticUserPasswordResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4358,7 +5723,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DisablePipedResponseValidationError{}
+} = ResetStaticUserPasswordResponseValidationError{}
 
 // Validate checks the field values on RegisterEventRequest with the rules
 // defined in the proto definition for this message. If any rules are
@@ -5827,3 +7192,262 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ListStageLogsResponseValidationError{}
+
+// Validate checks the field values on StreamStageLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamStageLogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamStageLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamStageLogsRequestMultiError, or nil if none found.
+func (m *StreamStageLogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamStageLogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := StreamStageLogsRequestValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for StageId
+
+	if len(errors) > 0 {
+		return StreamStageLogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamStageLogsRequestMultiError is an error wrapping multiple validation
+// errors returned by StreamStageLogsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StreamStageLogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamStageLogsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamStageLogsRequestMultiError) AllErrors() []error { return m }
+
+// StreamStageLogsRequestValidationError is the validation error returned by
+// StreamStageLogsRequest.Validate if the designated constraints aren't met.
+type StreamStageLogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamStageLogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamStageLogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamStageLogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamStageLogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamStageLogsRequestValidationError) ErrorName() string {
+	return "StreamStageLogsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamStageLogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamStageLogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamStageLogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamStageLogsRequestValidationError{}
+
+// Validate checks the field values on StreamStageLogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamStageLogsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamStageLogsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamStageLogsResponseMultiError, or nil if none found.
+func (m *StreamStageLogsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamStageLogsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StageId
+
+	// no validation rules for StageName
+
+	// no validation rules for RetriedCount
+
+	for idx, item := range m.GetBlocks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StreamStageLogsResponseValidationError{
+						field:  fmt.Sprintf("Blocks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StreamStageLogsResponseValidationError{
+						field:  fmt.Sprintf("Blocks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StreamStageLogsResponseValidationError{
+					field:  fmt.Sprintf("Blocks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Completed
+
+	if len(errors) > 0 {
+		return StreamStageLogsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamStageLogsResponseMultiError is an error wrapping multiple validation
+// errors returned by StreamStageLogsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StreamStageLogsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamStageLogsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamStageLogsResponseMultiError) AllErrors() []error { return m }
+
+// StreamStageLogsResponseValidationError is the validation error returned by
+// StreamStageLogsResponse.Validate if the designated constraints aren't met.
+type StreamStageLogsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamStageLogsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamStageLogsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamStageLogsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamStageLogsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamStageLogsResponseValidationError) ErrorName() string {
+	return "StreamStageLogsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamStageLogsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamStageLogsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamStageLogsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamStageLogsResponseValidationError{}
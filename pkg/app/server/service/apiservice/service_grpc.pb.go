@@ -34,17 +34,26 @@ type APIServiceClient interface {
 	UpdateApplicationDeployTargets(ctx context.Context, in *UpdateApplicationDeployTargetsRequest, opts ...grpc.CallOption) (*UpdateApplicationDeployTargetsResponse, error)
 	GetDeployment(ctx context.Context, in *GetDeploymentRequest, opts ...grpc.CallOption) (*GetDeploymentResponse, error)
 	ListDeployments(ctx context.Context, in *ListDeploymentsRequest, opts ...grpc.CallOption) (*ListDeploymentsResponse, error)
+	CancelDeploymentChain(ctx context.Context, in *CancelDeploymentChainRequest, opts ...grpc.CallOption) (*CancelDeploymentChainResponse, error)
 	GetCommand(ctx context.Context, in *GetCommandRequest, opts ...grpc.CallOption) (*GetCommandResponse, error)
 	GetPiped(ctx context.Context, in *GetPipedRequest, opts ...grpc.CallOption) (*GetPipedResponse, error)
 	RegisterPiped(ctx context.Context, in *RegisterPipedRequest, opts ...grpc.CallOption) (*RegisterPipedResponse, error)
 	UpdatePiped(ctx context.Context, in *UpdatePipedRequest, opts ...grpc.CallOption) (*UpdatePipedResponse, error)
 	EnablePiped(ctx context.Context, in *EnablePipedRequest, opts ...grpc.CallOption) (*EnablePipedResponse, error)
 	DisablePiped(ctx context.Context, in *DisablePipedRequest, opts ...grpc.CallOption) (*DisablePipedResponse, error)
+	ListStaticUsers(ctx context.Context, in *ListStaticUsersRequest, opts ...grpc.CallOption) (*ListStaticUsersResponse, error)
+	AddStaticUser(ctx context.Context, in *AddStaticUserRequest, opts ...grpc.CallOption) (*AddStaticUserResponse, error)
+	DeleteStaticUser(ctx context.Context, in *DeleteStaticUserRequest, opts ...grpc.CallOption) (*DeleteStaticUserResponse, error)
+	UpdateStaticUserRole(ctx context.Context, in *UpdateStaticUserRoleRequest, opts ...grpc.CallOption) (*UpdateStaticUserRoleResponse, error)
+	ResetStaticUserPassword(ctx context.Context, in *ResetStaticUserPasswordRequest, opts ...grpc.CallOption) (*ResetStaticUserPasswordResponse, error)
 	RegisterEvent(ctx context.Context, in *RegisterEventRequest, opts ...grpc.CallOption) (*RegisterEventResponse, error)
 	RequestPlanPreview(ctx context.Context, in *RequestPlanPreviewRequest, opts ...grpc.CallOption) (*RequestPlanPreviewResponse, error)
 	GetPlanPreviewResults(ctx context.Context, in *GetPlanPreviewResultsRequest, opts ...grpc.CallOption) (*GetPlanPreviewResultsResponse, error)
 	Encrypt(ctx context.Context, in *EncryptRequest, opts ...grpc.CallOption) (*EncryptResponse, error)
 	ListStageLogs(ctx context.Context, in *ListStageLogsRequest, opts ...grpc.CallOption) (*ListStageLogsResponse, error)
+	// StreamStageLogs streams the stage logs of a deployment as they arrive.
+	// The stream is closed after all logs were sent once the deployment completed.
+	StreamStageLogs(ctx context.Context, in *StreamStageLogsRequest, opts ...grpc.CallOption) (APIService_StreamStageLogsClient, error)
 }
 
 type aPIServiceClient struct {
@@ -163,6 +172,15 @@ func (c *aPIServiceClient) ListDeployments(ctx context.Context, in *ListDeployme
 	return out, nil
 }
 
+func (c *aPIServiceClient) CancelDeploymentChain(ctx context.Context, in *CancelDeploymentChainRequest, opts ...grpc.CallOption) (*CancelDeploymentChainResponse, error) {
+	out := new(CancelDeploymentChainResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/CancelDeploymentChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIServiceClient) GetCommand(ctx context.Context, in *GetCommandRequest, opts ...grpc.CallOption) (*GetCommandResponse, error) {
 	out := new(GetCommandResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/GetCommand", in, out, opts...)
@@ -217,6 +235,51 @@ func (c *aPIServiceClient) DisablePiped(ctx context.Context, in *DisablePipedReq
 	return out, nil
 }
 
+func (c *aPIServiceClient) ListStaticUsers(ctx context.Context, in *ListStaticUsersRequest, opts ...grpc.CallOption) (*ListStaticUsersResponse, error) {
+	out := new(ListStaticUsersResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/ListStaticUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) AddStaticUser(ctx context.Context, in *AddStaticUserRequest, opts ...grpc.CallOption) (*AddStaticUserResponse, error) {
+	out := new(AddStaticUserResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/AddStaticUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) DeleteStaticUser(ctx context.Context, in *DeleteStaticUserRequest, opts ...grpc.CallOption) (*DeleteStaticUserResponse, error) {
+	out := new(DeleteStaticUserResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/DeleteStaticUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) UpdateStaticUserRole(ctx context.Context, in *UpdateStaticUserRoleRequest, opts ...grpc.CallOption) (*UpdateStaticUserRoleResponse, error) {
+	out := new(UpdateStaticUserRoleResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/UpdateStaticUserRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ResetStaticUserPassword(ctx context.Context, in *ResetStaticUserPasswordRequest, opts ...grpc.CallOption) (*ResetStaticUserPasswordResponse, error) {
+	out := new(ResetStaticUserPasswordResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/ResetStaticUserPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIServiceClient) RegisterEvent(ctx context.Context, in *RegisterEventRequest, opts ...grpc.CallOption) (*RegisterEventResponse, error) {
 	out := new(RegisterEventResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/RegisterEvent", in, out, opts...)
@@ -262,6 +325,38 @@ func (c *aPIServiceClient) ListStageLogs(ctx context.Context, in *ListStageLogsR
 	return out, nil
 }
 
+func (c *aPIServiceClient) StreamStageLogs(ctx context.Context, in *StreamStageLogsRequest, opts ...grpc.CallOption) (APIService_StreamStageLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &APIService_ServiceDesc.Streams[0], "/grpc.service.apiservice.APIService/StreamStageLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIServiceStreamStageLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type APIService_StreamStageLogsClient interface {
+	Recv() (*StreamStageLogsResponse, error)
+	grpc.ClientStream
+}
+
+type aPIServiceStreamStageLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIServiceStreamStageLogsClient) Recv() (*StreamStageLogsResponse, error) {
+	m := new(StreamStageLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // APIServiceServer is the server API for APIService service.
 // All implementations must embed UnimplementedAPIServiceServer
 // for forward compatibility
@@ -278,17 +373,26 @@ type APIServiceServer interface {
 	UpdateApplicationDeployTargets(context.Context, *UpdateApplicationDeployTargetsRequest) (*UpdateApplicationDeployTargetsResponse, error)
 	GetDeployment(context.Context, *GetDeploymentRequest) (*GetDeploymentResponse, error)
 	ListDeployments(context.Context, *ListDeploymentsRequest) (*ListDeploymentsResponse, error)
+	CancelDeploymentChain(context.Context, *CancelDeploymentChainRequest) (*CancelDeploymentChainResponse, error)
 	GetCommand(context.Context, *GetCommandRequest) (*GetCommandResponse, error)
 	GetPiped(context.Context, *GetPipedRequest) (*GetPipedResponse, error)
 	RegisterPiped(context.Context, *RegisterPipedRequest) (*RegisterPipedResponse, error)
 	UpdatePiped(context.Context, *UpdatePipedRequest) (*UpdatePipedResponse, error)
 	EnablePiped(context.Context, *EnablePipedRequest) (*EnablePipedResponse, error)
 	DisablePiped(context.Context, *DisablePipedRequest) (*DisablePipedResponse, error)
+	ListStaticUsers(context.Context, *ListStaticUsersRequest) (*ListStaticUsersResponse, error)
+	AddStaticUser(context.Context, *AddStaticUserRequest) (*AddStaticUserResponse, error)
+	DeleteStaticUser(context.Context, *DeleteStaticUserRequest) (*DeleteStaticUserResponse, error)
+	UpdateStaticUserRole(context.Context, *UpdateStaticUserRoleRequest) (*UpdateStaticUserRoleResponse, error)
+	ResetStaticUserPassword(context.Context, *ResetStaticUserPasswordRequest) (*ResetStaticUserPasswordResponse, error)
 	RegisterEvent(context.Context, *RegisterEventRequest) (*RegisterEventResponse, error)
 	RequestPlanPreview(context.Context, *RequestPlanPreviewRequest) (*RequestPlanPreviewResponse, error)
 	GetPlanPreviewResults(context.Context, *GetPlanPreviewResultsRequest) (*GetPlanPreviewResultsResponse, error)
 	Encrypt(context.Context, *EncryptRequest) (*EncryptResponse, error)
 	ListStageLogs(context.Context, *ListStageLogsRequest) (*ListStageLogsResponse, error)
+	// StreamStageLogs streams the stage logs of a deployment as they arrive.
+	// The stream is closed after all logs were sent once the deployment completed.
+	StreamStageLogs(*StreamStageLogsRequest, APIService_StreamStageLogsServer) error
 	mustEmbedUnimplementedAPIServiceServer()
 }
 
@@ -332,6 +436,9 @@ func (UnimplementedAPIServiceServer) GetDeployment(context.Context, *GetDeployme
 func (UnimplementedAPIServiceServer) ListDeployments(context.Context, *ListDeploymentsRequest) (*ListDeploymentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDeployments not implemented")
 }
+func (UnimplementedAPIServiceServer) CancelDeploymentChain(context.Context, *CancelDeploymentChainRequest) (*CancelDeploymentChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDeploymentChain not implemented")
+}
 func (UnimplementedAPIServiceServer) GetCommand(context.Context, *GetCommandRequest) (*GetCommandResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCommand not implemented")
 }
@@ -350,6 +457,21 @@ func (UnimplementedAPIServiceServer) EnablePiped(context.Context, *EnablePipedRe
 func (UnimplementedAPIServiceServer) DisablePiped(context.Context, *DisablePipedRequest) (*DisablePipedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisablePiped not implemented")
 }
+func (UnimplementedAPIServiceServer) ListStaticUsers(context.Context, *ListStaticUsersRequest) (*ListStaticUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStaticUsers not implemented")
+}
+func (UnimplementedAPIServiceServer) AddStaticUser(context.Context, *AddStaticUserRequest) (*AddStaticUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStaticUser not implemented")
+}
+func (UnimplementedAPIServiceServer) DeleteStaticUser(context.Context, *DeleteStaticUserRequest) (*DeleteStaticUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStaticUser not implemented")
+}
+func (UnimplementedAPIServiceServer) UpdateStaticUserRole(context.Context, *UpdateStaticUserRoleRequest) (*UpdateStaticUserRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStaticUserRole not implemented")
+}
+func (UnimplementedAPIServiceServer) ResetStaticUserPassword(context.Context, *ResetStaticUserPasswordRequest) (*ResetStaticUserPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetStaticUserPassword not implemented")
+}
 func (UnimplementedAPIServiceServer) RegisterEvent(context.Context, *RegisterEventRequest) (*RegisterEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterEvent not implemented")
 }
@@ -365,6 +487,9 @@ func (UnimplementedAPIServiceServer) Encrypt(context.Context, *EncryptRequest) (
 func (UnimplementedAPIServiceServer) ListStageLogs(context.Context, *ListStageLogsRequest) (*ListStageLogsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListStageLogs not implemented")
 }
+func (UnimplementedAPIServiceServer) StreamStageLogs(*StreamStageLogsRequest, APIService_StreamStageLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamStageLogs not implemented")
+}
 func (UnimplementedAPIServiceServer) mustEmbedUnimplementedAPIServiceServer() {}
 
 // UnsafeAPIServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -594,6 +719,24 @@ func _APIService_ListDeployments_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_CancelDeploymentChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelDeploymentChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).CancelDeploymentChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/CancelDeploymentChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).CancelDeploymentChain(ctx, req.(*CancelDeploymentChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _APIService_GetCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCommandRequest)
 	if err := dec(in); err != nil {
@@ -702,6 +845,96 @@ func _APIService_DisablePiped_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_ListStaticUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStaticUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ListStaticUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/ListStaticUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ListStaticUsers(ctx, req.(*ListStaticUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_AddStaticUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddStaticUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).AddStaticUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/AddStaticUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).AddStaticUser(ctx, req.(*AddStaticUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_DeleteStaticUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStaticUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).DeleteStaticUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/DeleteStaticUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).DeleteStaticUser(ctx, req.(*DeleteStaticUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_UpdateStaticUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStaticUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).UpdateStaticUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/UpdateStaticUserRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).UpdateStaticUserRole(ctx, req.(*UpdateStaticUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ResetStaticUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetStaticUserPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ResetStaticUserPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/ResetStaticUserPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ResetStaticUserPassword(ctx, req.(*ResetStaticUserPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _APIService_RegisterEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RegisterEventRequest)
 	if err := dec(in); err != nil {
@@ -792,6 +1025,27 @@ func _APIService_ListStageLogs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_StreamStageLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamStageLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServiceServer).StreamStageLogs(m, &aPIServiceStreamStageLogsServer{stream})
+}
+
+type APIService_StreamStageLogsServer interface {
+	Send(*StreamStageLogsResponse) error
+	grpc.ServerStream
+}
+
+type aPIServiceStreamStageLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIServiceStreamStageLogsServer) Send(m *StreamStageLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // APIService_ServiceDesc is the grpc.ServiceDesc for APIService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -847,6 +1101,10 @@ var APIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListDeployments",
 			Handler:    _APIService_ListDeployments_Handler,
 		},
+		{
+			MethodName: "CancelDeploymentChain",
+			Handler:    _APIService_CancelDeploymentChain_Handler,
+		},
 		{
 			MethodName: "GetCommand",
 			Handler:    _APIService_GetCommand_Handler,
@@ -871,6 +1129,26 @@ var APIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DisablePiped",
 			Handler:    _APIService_DisablePiped_Handler,
 		},
+		{
+			MethodName: "ListStaticUsers",
+			Handler:    _APIService_ListStaticUsers_Handler,
+		},
+		{
+			MethodName: "AddStaticUser",
+			Handler:    _APIService_AddStaticUser_Handler,
+		},
+		{
+			MethodName: "DeleteStaticUser",
+			Handler:    _APIService_DeleteStaticUser_Handler,
+		},
+		{
+			MethodName: "UpdateStaticUserRole",
+			Handler:    _APIService_UpdateStaticUserRole_Handler,
+		},
+		{
+			MethodName: "ResetStaticUserPassword",
+			Handler:    _APIService_ResetStaticUserPassword_Handler,
+		},
 		{
 			MethodName: "RegisterEvent",
 			Handler:    _APIService_RegisterEvent_Handler,
@@ -892,6 +1170,12 @@ var APIService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _APIService_ListStageLogs_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamStageLogs",
+			Handler:       _APIService_StreamStageLogs_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "pkg/app/server/service/apiservice/service.proto",
 }
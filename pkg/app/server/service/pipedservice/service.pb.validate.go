@@ -2695,6 +2695,8 @@ func (m *ReportDeploymentPlannedRequest) validate(all bool) error {
 
 	// no validation rules for RunningConfigFilename
 
+	// no validation rules for RunningArtifactDigest
+
 	// no validation rules for SyncStrategy
 
 	// no validation rules for Version
@@ -9290,6 +9292,12 @@ func (m *CreateDeploymentChainRequest_ApplicationMatcher) validate(all bool) err
 
 	// no validation rules for Labels
 
+	// no validation rules for ContinueOnFailure
+
+	// no validation rules for CancelOnChainFailure
+
+	// no validation rules for RollbackOnChainFailure
+
 	if len(errors) > 0 {
 		return CreateDeploymentChainRequest_ApplicationMatcherMultiError(errors)
 	}
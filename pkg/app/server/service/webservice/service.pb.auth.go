@@ -156,14 +156,20 @@ func (a *authorizer) Authorize(ctx context.Context, method string, r model.Role)
 		return verify(model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_GET)
 	case "/grpc.service.webservice.WebService/GetStageLog":
 		return verify(model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_GET)
+	case "/grpc.service.webservice.WebService/SearchStageLogs":
+		return verify(model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_GET)
 	case "/grpc.service.webservice.WebService/CancelDeployment":
 		return verify(model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_UPDATE)
 	case "/grpc.service.webservice.WebService/SkipStage":
 		return verify(model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_UPDATE)
+	case "/grpc.service.webservice.WebService/RetryStage":
+		return verify(model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_UPDATE)
 	case "/grpc.service.webservice.WebService/ApproveStage":
 		return verify(model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_UPDATE)
 	case "/grpc.service.webservice.WebService/ListDeploymentTraces":
 		return verify(model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_LIST)
+	case "/grpc.service.webservice.WebService/CancelDeploymentChain":
+		return verify(model.ProjectRBACResource_DEPLOYMENT_CHAIN, model.ProjectRBACPolicy_UPDATE)
 	case "/grpc.service.webservice.WebService/ListEvents":
 		return verify(model.ProjectRBACResource_EVENT, model.ProjectRBACPolicy_LIST)
 	case "/grpc.service.webservice.WebService/GetInsightData":
@@ -194,6 +200,8 @@ func (a *authorizer) Authorize(ctx context.Context, method string, r model.Role)
 		return verify(model.ProjectRBACResource_PIPED, model.ProjectRBACPolicy_LIST)
 	case "/grpc.service.webservice.WebService/ListDeprecatedNotes":
 		return verify(model.ProjectRBACResource_PIPED, model.ProjectRBACPolicy_LIST)
+	case "/grpc.service.webservice.WebService/SyncApplicationBypassingFreeze":
+		return verify(model.ProjectRBACResource_PROJECT, model.ProjectRBACPolicy_UPDATE)
 	case "/grpc.service.webservice.WebService/GetProject":
 		return verify(model.ProjectRBACResource_PROJECT, model.ProjectRBACPolicy_GET)
 	case "/grpc.service.webservice.WebService/UpdateProjectStaticAdmin":
@@ -218,6 +226,28 @@ func (a *authorizer) Authorize(ctx context.Context, method string, r model.Role)
 		return verify(model.ProjectRBACResource_PROJECT, model.ProjectRBACPolicy_UPDATE)
 	case "/grpc.service.webservice.WebService/DeleteProjectUserGroup":
 		return verify(model.ProjectRBACResource_PROJECT, model.ProjectRBACPolicy_UPDATE)
+	case "/grpc.service.webservice.WebService/AddProjectDeploymentFreezeWindow":
+		return verify(model.ProjectRBACResource_PROJECT, model.ProjectRBACPolicy_UPDATE)
+	case "/grpc.service.webservice.WebService/DeleteProjectDeploymentFreezeWindow":
+		return verify(model.ProjectRBACResource_PROJECT, model.ProjectRBACPolicy_UPDATE)
+	case "/grpc.service.webservice.WebService/ListStaticUsers":
+		return verify(model.ProjectRBACResource_PROJECT, model.ProjectRBACPolicy_GET)
+	case "/grpc.service.webservice.WebService/AddStaticUser":
+		return verify(model.ProjectRBACResource_PROJECT, model.ProjectRBACPolicy_UPDATE)
+	case "/grpc.service.webservice.WebService/DeleteStaticUser":
+		return verify(model.ProjectRBACResource_PROJECT, model.ProjectRBACPolicy_UPDATE)
+	case "/grpc.service.webservice.WebService/UpdateStaticUserRole":
+		return verify(model.ProjectRBACResource_PROJECT, model.ProjectRBACPolicy_UPDATE)
+	case "/grpc.service.webservice.WebService/ResetStaticUserPassword":
+		return verify(model.ProjectRBACResource_PROJECT, model.ProjectRBACPolicy_UPDATE)
+	case "/grpc.service.webservice.WebService/ChangeStaticUserPassword":
+		return verify(model.ProjectRBACResource_PROJECT, model.ProjectRBACPolicy_GET)
+	case "/grpc.service.webservice.WebService/GenerateStaticUserTOTPSecret":
+		return verify(model.ProjectRBACResource_PROJECT, model.ProjectRBACPolicy_GET)
+	case "/grpc.service.webservice.WebService/EnableStaticUserTOTP":
+		return verify(model.ProjectRBACResource_PROJECT, model.ProjectRBACPolicy_GET)
+	case "/grpc.service.webservice.WebService/DisableStaticUserTOTP":
+		return verify(model.ProjectRBACResource_PROJECT, model.ProjectRBACPolicy_GET)
 	}
 	return false
 }
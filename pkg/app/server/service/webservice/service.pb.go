@@ -1895,6 +1895,117 @@ func (x *SyncApplicationResponse) GetCommandId() string {
 	return ""
 }
 
+type SyncApplicationBypassingFreezeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationId string             `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	SyncStrategy  model.SyncStrategy `protobuf:"varint,2,opt,name=sync_strategy,json=syncStrategy,proto3,enum=model.SyncStrategy" json:"sync_strategy,omitempty"`
+	// The reason why the freeze should be bypassed. It is recorded in the deployment.
+	Justification string `protobuf:"bytes,3,opt,name=justification,proto3" json:"justification,omitempty"`
+}
+
+func (x *SyncApplicationBypassingFreezeRequest) Reset() {
+	*x = SyncApplicationBypassingFreezeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncApplicationBypassingFreezeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncApplicationBypassingFreezeRequest) ProtoMessage() {}
+
+func (x *SyncApplicationBypassingFreezeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncApplicationBypassingFreezeRequest.ProtoReflect.Descriptor instead.
+func (*SyncApplicationBypassingFreezeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SyncApplicationBypassingFreezeRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *SyncApplicationBypassingFreezeRequest) GetSyncStrategy() model.SyncStrategy {
+	if x != nil {
+		return x.SyncStrategy
+	}
+	return model.SyncStrategy(0)
+}
+
+func (x *SyncApplicationBypassingFreezeRequest) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+type SyncApplicationBypassingFreezeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommandId string `protobuf:"bytes,1,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+}
+
+func (x *SyncApplicationBypassingFreezeResponse) Reset() {
+	*x = SyncApplicationBypassingFreezeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncApplicationBypassingFreezeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncApplicationBypassingFreezeResponse) ProtoMessage() {}
+
+func (x *SyncApplicationBypassingFreezeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncApplicationBypassingFreezeResponse.ProtoReflect.Descriptor instead.
+func (*SyncApplicationBypassingFreezeResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SyncApplicationBypassingFreezeResponse) GetCommandId() string {
+	if x != nil {
+		return x.CommandId
+	}
+	return ""
+}
+
 type GetApplicationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1906,7 +2017,7 @@ type GetApplicationRequest struct {
 func (x *GetApplicationRequest) Reset() {
 	*x = GetApplicationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[38]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1919,7 +2030,7 @@ func (x *GetApplicationRequest) String() string {
 func (*GetApplicationRequest) ProtoMessage() {}
 
 func (x *GetApplicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[38]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1932,7 +2043,7 @@ func (x *GetApplicationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetApplicationRequest.ProtoReflect.Descriptor instead.
 func (*GetApplicationRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{38}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetApplicationRequest) GetApplicationId() string {
@@ -1953,7 +2064,7 @@ type GetApplicationResponse struct {
 func (x *GetApplicationResponse) Reset() {
 	*x = GetApplicationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[39]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1966,7 +2077,7 @@ func (x *GetApplicationResponse) String() string {
 func (*GetApplicationResponse) ProtoMessage() {}
 
 func (x *GetApplicationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[39]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1979,7 +2090,7 @@ func (x *GetApplicationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetApplicationResponse.ProtoReflect.Descriptor instead.
 func (*GetApplicationResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{39}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GetApplicationResponse) GetApplication() *model.Application {
@@ -2003,7 +2114,7 @@ type GenerateApplicationSealedSecretRequest struct {
 func (x *GenerateApplicationSealedSecretRequest) Reset() {
 	*x = GenerateApplicationSealedSecretRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[40]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2016,7 +2127,7 @@ func (x *GenerateApplicationSealedSecretRequest) String() string {
 func (*GenerateApplicationSealedSecretRequest) ProtoMessage() {}
 
 func (x *GenerateApplicationSealedSecretRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[40]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2029,7 +2140,7 @@ func (x *GenerateApplicationSealedSecretRequest) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use GenerateApplicationSealedSecretRequest.ProtoReflect.Descriptor instead.
 func (*GenerateApplicationSealedSecretRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{40}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *GenerateApplicationSealedSecretRequest) GetPipedId() string {
@@ -2064,7 +2175,7 @@ type GenerateApplicationSealedSecretResponse struct {
 func (x *GenerateApplicationSealedSecretResponse) Reset() {
 	*x = GenerateApplicationSealedSecretResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[41]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2077,7 +2188,7 @@ func (x *GenerateApplicationSealedSecretResponse) String() string {
 func (*GenerateApplicationSealedSecretResponse) ProtoMessage() {}
 
 func (x *GenerateApplicationSealedSecretResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[41]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2090,7 +2201,7 @@ func (x *GenerateApplicationSealedSecretResponse) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use GenerateApplicationSealedSecretResponse.ProtoReflect.Descriptor instead.
 func (*GenerateApplicationSealedSecretResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{41}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *GenerateApplicationSealedSecretResponse) GetData() string {
@@ -2109,7 +2220,7 @@ type ListUnregisteredApplicationsRequest struct {
 func (x *ListUnregisteredApplicationsRequest) Reset() {
 	*x = ListUnregisteredApplicationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[42]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2122,7 +2233,7 @@ func (x *ListUnregisteredApplicationsRequest) String() string {
 func (*ListUnregisteredApplicationsRequest) ProtoMessage() {}
 
 func (x *ListUnregisteredApplicationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[42]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2135,7 +2246,7 @@ func (x *ListUnregisteredApplicationsRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ListUnregisteredApplicationsRequest.ProtoReflect.Descriptor instead.
 func (*ListUnregisteredApplicationsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{42}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{44}
 }
 
 type ListUnregisteredApplicationsResponse struct {
@@ -2149,7 +2260,7 @@ type ListUnregisteredApplicationsResponse struct {
 func (x *ListUnregisteredApplicationsResponse) Reset() {
 	*x = ListUnregisteredApplicationsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[43]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2162,7 +2273,7 @@ func (x *ListUnregisteredApplicationsResponse) String() string {
 func (*ListUnregisteredApplicationsResponse) ProtoMessage() {}
 
 func (x *ListUnregisteredApplicationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[43]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2175,7 +2286,7 @@ func (x *ListUnregisteredApplicationsResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use ListUnregisteredApplicationsResponse.ProtoReflect.Descriptor instead.
 func (*ListUnregisteredApplicationsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{43}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *ListUnregisteredApplicationsResponse) GetApplications() []*model.ApplicationInfo {
@@ -2201,7 +2312,7 @@ type ListDeploymentsRequest struct {
 func (x *ListDeploymentsRequest) Reset() {
 	*x = ListDeploymentsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[44]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2214,7 +2325,7 @@ func (x *ListDeploymentsRequest) String() string {
 func (*ListDeploymentsRequest) ProtoMessage() {}
 
 func (x *ListDeploymentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[44]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2227,7 +2338,7 @@ func (x *ListDeploymentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDeploymentsRequest.ProtoReflect.Descriptor instead.
 func (*ListDeploymentsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{44}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *ListDeploymentsRequest) GetOptions() *ListDeploymentsRequest_Options {
@@ -2270,7 +2381,7 @@ type ListDeploymentsResponse struct {
 func (x *ListDeploymentsResponse) Reset() {
 	*x = ListDeploymentsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[45]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2283,7 +2394,7 @@ func (x *ListDeploymentsResponse) String() string {
 func (*ListDeploymentsResponse) ProtoMessage() {}
 
 func (x *ListDeploymentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[45]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2296,7 +2407,7 @@ func (x *ListDeploymentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDeploymentsResponse.ProtoReflect.Descriptor instead.
 func (*ListDeploymentsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{45}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ListDeploymentsResponse) GetDeployments() []*model.Deployment {
@@ -2324,7 +2435,7 @@ type GetDeploymentRequest struct {
 func (x *GetDeploymentRequest) Reset() {
 	*x = GetDeploymentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[46]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2337,7 +2448,7 @@ func (x *GetDeploymentRequest) String() string {
 func (*GetDeploymentRequest) ProtoMessage() {}
 
 func (x *GetDeploymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[46]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2350,7 +2461,7 @@ func (x *GetDeploymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDeploymentRequest.ProtoReflect.Descriptor instead.
 func (*GetDeploymentRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{46}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *GetDeploymentRequest) GetDeploymentId() string {
@@ -2371,7 +2482,7 @@ type GetDeploymentResponse struct {
 func (x *GetDeploymentResponse) Reset() {
 	*x = GetDeploymentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[47]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2384,7 +2495,7 @@ func (x *GetDeploymentResponse) String() string {
 func (*GetDeploymentResponse) ProtoMessage() {}
 
 func (x *GetDeploymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[47]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2397,7 +2508,7 @@ func (x *GetDeploymentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDeploymentResponse.ProtoReflect.Descriptor instead.
 func (*GetDeploymentResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{47}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *GetDeploymentResponse) GetDeployment() *model.Deployment {
@@ -2416,12 +2527,14 @@ type GetStageLogRequest struct {
 	StageId      string `protobuf:"bytes,2,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
 	RetriedCount int32  `protobuf:"varint,3,opt,name=retried_count,json=retriedCount,proto3" json:"retried_count,omitempty"`
 	OffsetIndex  int64  `protobuf:"varint,4,opt,name=offset_index,json=offsetIndex,proto3" json:"offset_index,omitempty"`
+	// Whether to include the DEBUG log blocks.
+	Verbose bool `protobuf:"varint,5,opt,name=verbose,proto3" json:"verbose,omitempty"`
 }
 
 func (x *GetStageLogRequest) Reset() {
 	*x = GetStageLogRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[48]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2434,7 +2547,7 @@ func (x *GetStageLogRequest) String() string {
 func (*GetStageLogRequest) ProtoMessage() {}
 
 func (x *GetStageLogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[48]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2447,7 +2560,7 @@ func (x *GetStageLogRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStageLogRequest.ProtoReflect.Descriptor instead.
 func (*GetStageLogRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{48}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *GetStageLogRequest) GetDeploymentId() string {
@@ -2478,6 +2591,13 @@ func (x *GetStageLogRequest) GetOffsetIndex() int64 {
 	return 0
 }
 
+func (x *GetStageLogRequest) GetVerbose() bool {
+	if x != nil {
+		return x.Verbose
+	}
+	return false
+}
+
 type GetStageLogResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2490,7 +2610,7 @@ type GetStageLogResponse struct {
 func (x *GetStageLogResponse) Reset() {
 	*x = GetStageLogResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[49]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2503,7 +2623,7 @@ func (x *GetStageLogResponse) String() string {
 func (*GetStageLogResponse) ProtoMessage() {}
 
 func (x *GetStageLogResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[49]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2516,7 +2636,7 @@ func (x *GetStageLogResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStageLogResponse.ProtoReflect.Descriptor instead.
 func (*GetStageLogResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{49}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *GetStageLogResponse) GetBlocks() []*model.LogBlock {
@@ -2533,33 +2653,44 @@ func (x *GetStageLogResponse) GetCompleted() bool {
 	return false
 }
 
-type CancelDeploymentRequest struct {
+type SearchStageLogsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DeploymentId    string `protobuf:"bytes,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	ForceRollback   bool   `protobuf:"varint,2,opt,name=force_rollback,json=forceRollback,proto3" json:"force_rollback,omitempty"`
-	ForceNoRollback bool   `protobuf:"varint,3,opt,name=force_no_rollback,json=forceNoRollback,proto3" json:"force_no_rollback,omitempty"`
-}
-
-func (x *CancelDeploymentRequest) Reset() {
-	*x = CancelDeploymentRequest{}
+	ApplicationId string `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	// Only log blocks created at or after this unix time are returned.
+	From int64 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	// Only log blocks created at or before this unix time are returned.
+	// Zero means no upper bound.
+	To int64 `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	// Only log blocks having one of these severities are returned.
+	// Empty means all severities.
+	Severities []model.LogSeverity `protobuf:"varint,4,rep,packed,name=severities,proto3,enum=model.LogSeverity" json:"severities,omitempty"`
+	// Space separated terms that must all be contained in the log block.
+	// The matching is case-insensitive.
+	Query string `protobuf:"bytes,5,opt,name=query,proto3" json:"query,omitempty"`
+	// The maximum number of matched log blocks to return.
+	Limit int32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SearchStageLogsRequest) Reset() {
+	*x = SearchStageLogsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[50]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelDeploymentRequest) String() string {
+func (x *SearchStageLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelDeploymentRequest) ProtoMessage() {}
+func (*SearchStageLogsRequest) ProtoMessage() {}
 
-func (x *CancelDeploymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[50]
+func (x *SearchStageLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2570,57 +2701,78 @@ func (x *CancelDeploymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelDeploymentRequest.ProtoReflect.Descriptor instead.
-func (*CancelDeploymentRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use SearchStageLogsRequest.ProtoReflect.Descriptor instead.
+func (*SearchStageLogsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *CancelDeploymentRequest) GetDeploymentId() string {
+func (x *SearchStageLogsRequest) GetApplicationId() string {
 	if x != nil {
-		return x.DeploymentId
+		return x.ApplicationId
 	}
 	return ""
 }
 
-func (x *CancelDeploymentRequest) GetForceRollback() bool {
+func (x *SearchStageLogsRequest) GetFrom() int64 {
 	if x != nil {
-		return x.ForceRollback
+		return x.From
 	}
-	return false
+	return 0
 }
 
-func (x *CancelDeploymentRequest) GetForceNoRollback() bool {
+func (x *SearchStageLogsRequest) GetTo() int64 {
 	if x != nil {
-		return x.ForceNoRollback
+		return x.To
 	}
-	return false
+	return 0
 }
 
-type CancelDeploymentResponse struct {
+func (x *SearchStageLogsRequest) GetSeverities() []model.LogSeverity {
+	if x != nil {
+		return x.Severities
+	}
+	return nil
+}
+
+func (x *SearchStageLogsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchStageLogsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchStageLogsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CommandId string `protobuf:"bytes,1,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+	Matches []*SearchStageLogsResponse_Match `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
 }
 
-func (x *CancelDeploymentResponse) Reset() {
-	*x = CancelDeploymentResponse{}
+func (x *SearchStageLogsResponse) Reset() {
+	*x = SearchStageLogsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[51]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelDeploymentResponse) String() string {
+func (x *SearchStageLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelDeploymentResponse) ProtoMessage() {}
+func (*SearchStageLogsResponse) ProtoMessage() {}
 
-func (x *CancelDeploymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[51]
+func (x *SearchStageLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2631,44 +2783,45 @@ func (x *CancelDeploymentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelDeploymentResponse.ProtoReflect.Descriptor instead.
-func (*CancelDeploymentResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use SearchStageLogsResponse.ProtoReflect.Descriptor instead.
+func (*SearchStageLogsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *CancelDeploymentResponse) GetCommandId() string {
+func (x *SearchStageLogsResponse) GetMatches() []*SearchStageLogsResponse_Match {
 	if x != nil {
-		return x.CommandId
+		return x.Matches
 	}
-	return ""
+	return nil
 }
 
-type SkipStageRequest struct {
+type CancelDeploymentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DeploymentId string `protobuf:"bytes,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	StageId      string `protobuf:"bytes,2,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
+	DeploymentId    string `protobuf:"bytes,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	ForceRollback   bool   `protobuf:"varint,2,opt,name=force_rollback,json=forceRollback,proto3" json:"force_rollback,omitempty"`
+	ForceNoRollback bool   `protobuf:"varint,3,opt,name=force_no_rollback,json=forceNoRollback,proto3" json:"force_no_rollback,omitempty"`
 }
 
-func (x *SkipStageRequest) Reset() {
-	*x = SkipStageRequest{}
+func (x *CancelDeploymentRequest) Reset() {
+	*x = CancelDeploymentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[52]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SkipStageRequest) String() string {
+func (x *CancelDeploymentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SkipStageRequest) ProtoMessage() {}
+func (*CancelDeploymentRequest) ProtoMessage() {}
 
-func (x *SkipStageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[52]
+func (x *CancelDeploymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2679,11 +2832,120 @@ func (x *SkipStageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SkipStageRequest.ProtoReflect.Descriptor instead.
-func (*SkipStageRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{52}
-}
-
+// Deprecated: Use CancelDeploymentRequest.ProtoReflect.Descriptor instead.
+func (*CancelDeploymentRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CancelDeploymentRequest) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return ""
+}
+
+func (x *CancelDeploymentRequest) GetForceRollback() bool {
+	if x != nil {
+		return x.ForceRollback
+	}
+	return false
+}
+
+func (x *CancelDeploymentRequest) GetForceNoRollback() bool {
+	if x != nil {
+		return x.ForceNoRollback
+	}
+	return false
+}
+
+type CancelDeploymentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommandId string `protobuf:"bytes,1,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+}
+
+func (x *CancelDeploymentResponse) Reset() {
+	*x = CancelDeploymentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelDeploymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelDeploymentResponse) ProtoMessage() {}
+
+func (x *CancelDeploymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDeploymentResponse.ProtoReflect.Descriptor instead.
+func (*CancelDeploymentResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CancelDeploymentResponse) GetCommandId() string {
+	if x != nil {
+		return x.CommandId
+	}
+	return ""
+}
+
+type SkipStageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeploymentId string `protobuf:"bytes,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	StageId      string `protobuf:"bytes,2,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
+}
+
+func (x *SkipStageRequest) Reset() {
+	*x = SkipStageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SkipStageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipStageRequest) ProtoMessage() {}
+
+func (x *SkipStageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipStageRequest.ProtoReflect.Descriptor instead.
+func (*SkipStageRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{56}
+}
+
 func (x *SkipStageRequest) GetDeploymentId() string {
 	if x != nil {
 		return x.DeploymentId
@@ -2709,7 +2971,7 @@ type SkipStageResponse struct {
 func (x *SkipStageResponse) Reset() {
 	*x = SkipStageResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[53]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2722,7 +2984,7 @@ func (x *SkipStageResponse) String() string {
 func (*SkipStageResponse) ProtoMessage() {}
 
 func (x *SkipStageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[53]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2735,7 +2997,7 @@ func (x *SkipStageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SkipStageResponse.ProtoReflect.Descriptor instead.
 func (*SkipStageResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{53}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *SkipStageResponse) GetCommandId() string {
@@ -2745,6 +3007,108 @@ func (x *SkipStageResponse) GetCommandId() string {
 	return ""
 }
 
+type RetryStageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeploymentId string `protobuf:"bytes,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	StageId      string `protobuf:"bytes,2,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
+}
+
+func (x *RetryStageRequest) Reset() {
+	*x = RetryStageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryStageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryStageRequest) ProtoMessage() {}
+
+func (x *RetryStageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryStageRequest.ProtoReflect.Descriptor instead.
+func (*RetryStageRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RetryStageRequest) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return ""
+}
+
+func (x *RetryStageRequest) GetStageId() string {
+	if x != nil {
+		return x.StageId
+	}
+	return ""
+}
+
+type RetryStageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommandId string `protobuf:"bytes,1,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+}
+
+func (x *RetryStageResponse) Reset() {
+	*x = RetryStageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryStageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryStageResponse) ProtoMessage() {}
+
+func (x *RetryStageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryStageResponse.ProtoReflect.Descriptor instead.
+func (*RetryStageResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RetryStageResponse) GetCommandId() string {
+	if x != nil {
+		return x.CommandId
+	}
+	return ""
+}
+
 type ApproveStageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2757,7 +3121,7 @@ type ApproveStageRequest struct {
 func (x *ApproveStageRequest) Reset() {
 	*x = ApproveStageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[54]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2770,7 +3134,7 @@ func (x *ApproveStageRequest) String() string {
 func (*ApproveStageRequest) ProtoMessage() {}
 
 func (x *ApproveStageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[54]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2783,7 +3147,7 @@ func (x *ApproveStageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApproveStageRequest.ProtoReflect.Descriptor instead.
 func (*ApproveStageRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{54}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *ApproveStageRequest) GetDeploymentId() string {
@@ -2811,7 +3175,7 @@ type ApproveStageResponse struct {
 func (x *ApproveStageResponse) Reset() {
 	*x = ApproveStageResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[55]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2824,7 +3188,7 @@ func (x *ApproveStageResponse) String() string {
 func (*ApproveStageResponse) ProtoMessage() {}
 
 func (x *ApproveStageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[55]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2837,7 +3201,7 @@ func (x *ApproveStageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApproveStageResponse.ProtoReflect.Descriptor instead.
 func (*ApproveStageResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{55}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *ApproveStageResponse) GetCommandId() string {
@@ -2863,7 +3227,7 @@ type ListDeploymentTracesRequest struct {
 func (x *ListDeploymentTracesRequest) Reset() {
 	*x = ListDeploymentTracesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[56]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2876,7 +3240,7 @@ func (x *ListDeploymentTracesRequest) String() string {
 func (*ListDeploymentTracesRequest) ProtoMessage() {}
 
 func (x *ListDeploymentTracesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[56]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2889,7 +3253,7 @@ func (x *ListDeploymentTracesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDeploymentTracesRequest.ProtoReflect.Descriptor instead.
 func (*ListDeploymentTracesRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{56}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *ListDeploymentTracesRequest) GetOptions() *ListDeploymentTracesRequest_Options {
@@ -2932,7 +3296,7 @@ type ListDeploymentTracesResponse struct {
 func (x *ListDeploymentTracesResponse) Reset() {
 	*x = ListDeploymentTracesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[57]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2945,7 +3309,7 @@ func (x *ListDeploymentTracesResponse) String() string {
 func (*ListDeploymentTracesResponse) ProtoMessage() {}
 
 func (x *ListDeploymentTracesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[57]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2958,7 +3322,7 @@ func (x *ListDeploymentTracesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDeploymentTracesResponse.ProtoReflect.Descriptor instead.
 func (*ListDeploymentTracesResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{57}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *ListDeploymentTracesResponse) GetTraces() []*ListDeploymentTracesResponse_DeploymentTraceRes {
@@ -2986,7 +3350,7 @@ type GetApplicationLiveStateRequest struct {
 func (x *GetApplicationLiveStateRequest) Reset() {
 	*x = GetApplicationLiveStateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[58]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2999,7 +3363,7 @@ func (x *GetApplicationLiveStateRequest) String() string {
 func (*GetApplicationLiveStateRequest) ProtoMessage() {}
 
 func (x *GetApplicationLiveStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[58]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3012,7 +3376,7 @@ func (x *GetApplicationLiveStateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetApplicationLiveStateRequest.ProtoReflect.Descriptor instead.
 func (*GetApplicationLiveStateRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{58}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *GetApplicationLiveStateRequest) GetApplicationId() string {
@@ -3033,7 +3397,7 @@ type GetApplicationLiveStateResponse struct {
 func (x *GetApplicationLiveStateResponse) Reset() {
 	*x = GetApplicationLiveStateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[59]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3046,7 +3410,7 @@ func (x *GetApplicationLiveStateResponse) String() string {
 func (*GetApplicationLiveStateResponse) ProtoMessage() {}
 
 func (x *GetApplicationLiveStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[59]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3059,7 +3423,7 @@ func (x *GetApplicationLiveStateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetApplicationLiveStateResponse.ProtoReflect.Descriptor instead.
 func (*GetApplicationLiveStateResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{59}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *GetApplicationLiveStateResponse) GetSnapshot() *model.ApplicationLiveStateSnapshot {
@@ -3078,7 +3442,7 @@ type GetProjectRequest struct {
 func (x *GetProjectRequest) Reset() {
 	*x = GetProjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[60]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3091,7 +3455,7 @@ func (x *GetProjectRequest) String() string {
 func (*GetProjectRequest) ProtoMessage() {}
 
 func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[60]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3104,7 +3468,7 @@ func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProjectRequest.ProtoReflect.Descriptor instead.
 func (*GetProjectRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{60}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{66}
 }
 
 type GetProjectResponse struct {
@@ -3118,7 +3482,7 @@ type GetProjectResponse struct {
 func (x *GetProjectResponse) Reset() {
 	*x = GetProjectResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[61]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3131,7 +3495,7 @@ func (x *GetProjectResponse) String() string {
 func (*GetProjectResponse) ProtoMessage() {}
 
 func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[61]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3144,7 +3508,7 @@ func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProjectResponse.ProtoReflect.Descriptor instead.
 func (*GetProjectResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{61}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *GetProjectResponse) GetProject() *model.Project {
@@ -3166,7 +3530,7 @@ type UpdateProjectStaticAdminRequest struct {
 func (x *UpdateProjectStaticAdminRequest) Reset() {
 	*x = UpdateProjectStaticAdminRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[62]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3179,7 +3543,7 @@ func (x *UpdateProjectStaticAdminRequest) String() string {
 func (*UpdateProjectStaticAdminRequest) ProtoMessage() {}
 
 func (x *UpdateProjectStaticAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[62]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3192,7 +3556,7 @@ func (x *UpdateProjectStaticAdminRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProjectStaticAdminRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProjectStaticAdminRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{62}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *UpdateProjectStaticAdminRequest) GetUsername() string {
@@ -3218,7 +3582,7 @@ type UpdateProjectStaticAdminResponse struct {
 func (x *UpdateProjectStaticAdminResponse) Reset() {
 	*x = UpdateProjectStaticAdminResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[63]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3231,7 +3595,7 @@ func (x *UpdateProjectStaticAdminResponse) String() string {
 func (*UpdateProjectStaticAdminResponse) ProtoMessage() {}
 
 func (x *UpdateProjectStaticAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[63]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3244,7 +3608,7 @@ func (x *UpdateProjectStaticAdminResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProjectStaticAdminResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProjectStaticAdminResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{63}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{69}
 }
 
 type UpdateProjectSSOConfigRequest struct {
@@ -3258,7 +3622,7 @@ type UpdateProjectSSOConfigRequest struct {
 func (x *UpdateProjectSSOConfigRequest) Reset() {
 	*x = UpdateProjectSSOConfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[64]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3271,7 +3635,7 @@ func (x *UpdateProjectSSOConfigRequest) String() string {
 func (*UpdateProjectSSOConfigRequest) ProtoMessage() {}
 
 func (x *UpdateProjectSSOConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[64]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3284,7 +3648,7 @@ func (x *UpdateProjectSSOConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProjectSSOConfigRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProjectSSOConfigRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{64}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *UpdateProjectSSOConfigRequest) GetSso() *model.ProjectSSOConfig {
@@ -3303,7 +3667,7 @@ type UpdateProjectSSOConfigResponse struct {
 func (x *UpdateProjectSSOConfigResponse) Reset() {
 	*x = UpdateProjectSSOConfigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[65]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3316,7 +3680,7 @@ func (x *UpdateProjectSSOConfigResponse) String() string {
 func (*UpdateProjectSSOConfigResponse) ProtoMessage() {}
 
 func (x *UpdateProjectSSOConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[65]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3329,7 +3693,7 @@ func (x *UpdateProjectSSOConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProjectSSOConfigResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProjectSSOConfigResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{65}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{71}
 }
 
 type UpdateProjectRBACConfigRequest struct {
@@ -3343,7 +3707,7 @@ type UpdateProjectRBACConfigRequest struct {
 func (x *UpdateProjectRBACConfigRequest) Reset() {
 	*x = UpdateProjectRBACConfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[66]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3356,7 +3720,7 @@ func (x *UpdateProjectRBACConfigRequest) String() string {
 func (*UpdateProjectRBACConfigRequest) ProtoMessage() {}
 
 func (x *UpdateProjectRBACConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[66]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3369,7 +3733,7 @@ func (x *UpdateProjectRBACConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProjectRBACConfigRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProjectRBACConfigRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{66}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *UpdateProjectRBACConfigRequest) GetRbac() *model.ProjectRBACConfig {
@@ -3388,7 +3752,7 @@ type UpdateProjectRBACConfigResponse struct {
 func (x *UpdateProjectRBACConfigResponse) Reset() {
 	*x = UpdateProjectRBACConfigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[67]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3401,7 +3765,7 @@ func (x *UpdateProjectRBACConfigResponse) String() string {
 func (*UpdateProjectRBACConfigResponse) ProtoMessage() {}
 
 func (x *UpdateProjectRBACConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[67]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3414,7 +3778,7 @@ func (x *UpdateProjectRBACConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProjectRBACConfigResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProjectRBACConfigResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{67}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{73}
 }
 
 type EnableStaticAdminRequest struct {
@@ -3426,7 +3790,7 @@ type EnableStaticAdminRequest struct {
 func (x *EnableStaticAdminRequest) Reset() {
 	*x = EnableStaticAdminRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[68]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3439,7 +3803,7 @@ func (x *EnableStaticAdminRequest) String() string {
 func (*EnableStaticAdminRequest) ProtoMessage() {}
 
 func (x *EnableStaticAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[68]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3452,7 +3816,7 @@ func (x *EnableStaticAdminRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnableStaticAdminRequest.ProtoReflect.Descriptor instead.
 func (*EnableStaticAdminRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{68}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{74}
 }
 
 type EnableStaticAdminResponse struct {
@@ -3464,7 +3828,7 @@ type EnableStaticAdminResponse struct {
 func (x *EnableStaticAdminResponse) Reset() {
 	*x = EnableStaticAdminResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[69]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3477,7 +3841,7 @@ func (x *EnableStaticAdminResponse) String() string {
 func (*EnableStaticAdminResponse) ProtoMessage() {}
 
 func (x *EnableStaticAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[69]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3490,7 +3854,7 @@ func (x *EnableStaticAdminResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnableStaticAdminResponse.ProtoReflect.Descriptor instead.
 func (*EnableStaticAdminResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{69}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{75}
 }
 
 type DisableStaticAdminRequest struct {
@@ -3502,7 +3866,7 @@ type DisableStaticAdminRequest struct {
 func (x *DisableStaticAdminRequest) Reset() {
 	*x = DisableStaticAdminRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[70]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3515,7 +3879,7 @@ func (x *DisableStaticAdminRequest) String() string {
 func (*DisableStaticAdminRequest) ProtoMessage() {}
 
 func (x *DisableStaticAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[70]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3528,7 +3892,7 @@ func (x *DisableStaticAdminRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisableStaticAdminRequest.ProtoReflect.Descriptor instead.
 func (*DisableStaticAdminRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{70}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{76}
 }
 
 type DisableStaticAdminResponse struct {
@@ -3540,7 +3904,7 @@ type DisableStaticAdminResponse struct {
 func (x *DisableStaticAdminResponse) Reset() {
 	*x = DisableStaticAdminResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[71]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3553,7 +3917,7 @@ func (x *DisableStaticAdminResponse) String() string {
 func (*DisableStaticAdminResponse) ProtoMessage() {}
 
 func (x *DisableStaticAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[71]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3566,7 +3930,7 @@ func (x *DisableStaticAdminResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisableStaticAdminResponse.ProtoReflect.Descriptor instead.
 func (*DisableStaticAdminResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{71}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{77}
 }
 
 type GetMeRequest struct {
@@ -3578,7 +3942,7 @@ type GetMeRequest struct {
 func (x *GetMeRequest) Reset() {
 	*x = GetMeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[72]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3591,7 +3955,7 @@ func (x *GetMeRequest) String() string {
 func (*GetMeRequest) ProtoMessage() {}
 
 func (x *GetMeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[72]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3604,7 +3968,7 @@ func (x *GetMeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMeRequest.ProtoReflect.Descriptor instead.
 func (*GetMeRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{72}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{78}
 }
 
 type GetMeResponse struct {
@@ -3620,7 +3984,7 @@ type GetMeResponse struct {
 func (x *GetMeResponse) Reset() {
 	*x = GetMeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[73]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3633,7 +3997,7 @@ func (x *GetMeResponse) String() string {
 func (*GetMeResponse) ProtoMessage() {}
 
 func (x *GetMeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[73]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3646,7 +4010,7 @@ func (x *GetMeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMeResponse.ProtoReflect.Descriptor instead.
 func (*GetMeResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{73}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *GetMeResponse) GetSubject() string {
@@ -3682,7 +4046,7 @@ type AddProjectRBACRoleRequest struct {
 func (x *AddProjectRBACRoleRequest) Reset() {
 	*x = AddProjectRBACRoleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[74]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3695,7 +4059,7 @@ func (x *AddProjectRBACRoleRequest) String() string {
 func (*AddProjectRBACRoleRequest) ProtoMessage() {}
 
 func (x *AddProjectRBACRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[74]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3708,7 +4072,7 @@ func (x *AddProjectRBACRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddProjectRBACRoleRequest.ProtoReflect.Descriptor instead.
 func (*AddProjectRBACRoleRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{74}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *AddProjectRBACRoleRequest) GetName() string {
@@ -3734,7 +4098,7 @@ type AddProjectRBACRoleResponse struct {
 func (x *AddProjectRBACRoleResponse) Reset() {
 	*x = AddProjectRBACRoleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[75]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3747,7 +4111,7 @@ func (x *AddProjectRBACRoleResponse) String() string {
 func (*AddProjectRBACRoleResponse) ProtoMessage() {}
 
 func (x *AddProjectRBACRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[75]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3760,7 +4124,7 @@ func (x *AddProjectRBACRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddProjectRBACRoleResponse.ProtoReflect.Descriptor instead.
 func (*AddProjectRBACRoleResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{75}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{81}
 }
 
 type UpdateProjectRBACRoleRequest struct {
@@ -3775,7 +4139,7 @@ type UpdateProjectRBACRoleRequest struct {
 func (x *UpdateProjectRBACRoleRequest) Reset() {
 	*x = UpdateProjectRBACRoleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[76]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3788,7 +4152,7 @@ func (x *UpdateProjectRBACRoleRequest) String() string {
 func (*UpdateProjectRBACRoleRequest) ProtoMessage() {}
 
 func (x *UpdateProjectRBACRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[76]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3801,7 +4165,7 @@ func (x *UpdateProjectRBACRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProjectRBACRoleRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProjectRBACRoleRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{76}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *UpdateProjectRBACRoleRequest) GetName() string {
@@ -3827,7 +4191,7 @@ type UpdateProjectRBACRoleResponse struct {
 func (x *UpdateProjectRBACRoleResponse) Reset() {
 	*x = UpdateProjectRBACRoleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[77]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3840,7 +4204,7 @@ func (x *UpdateProjectRBACRoleResponse) String() string {
 func (*UpdateProjectRBACRoleResponse) ProtoMessage() {}
 
 func (x *UpdateProjectRBACRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[77]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3853,7 +4217,7 @@ func (x *UpdateProjectRBACRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProjectRBACRoleResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProjectRBACRoleResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{77}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{83}
 }
 
 type DeleteProjectRBACRoleRequest struct {
@@ -3867,7 +4231,7 @@ type DeleteProjectRBACRoleRequest struct {
 func (x *DeleteProjectRBACRoleRequest) Reset() {
 	*x = DeleteProjectRBACRoleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[78]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3880,7 +4244,7 @@ func (x *DeleteProjectRBACRoleRequest) String() string {
 func (*DeleteProjectRBACRoleRequest) ProtoMessage() {}
 
 func (x *DeleteProjectRBACRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[78]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3893,7 +4257,7 @@ func (x *DeleteProjectRBACRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProjectRBACRoleRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProjectRBACRoleRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{78}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *DeleteProjectRBACRoleRequest) GetName() string {
@@ -3912,7 +4276,7 @@ type DeleteProjectRBACRoleResponse struct {
 func (x *DeleteProjectRBACRoleResponse) Reset() {
 	*x = DeleteProjectRBACRoleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[79]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3925,7 +4289,7 @@ func (x *DeleteProjectRBACRoleResponse) String() string {
 func (*DeleteProjectRBACRoleResponse) ProtoMessage() {}
 
 func (x *DeleteProjectRBACRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[79]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3938,7 +4302,7 @@ func (x *DeleteProjectRBACRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProjectRBACRoleResponse.ProtoReflect.Descriptor instead.
 func (*DeleteProjectRBACRoleResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{79}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{85}
 }
 
 type AddProjectUserGroupRequest struct {
@@ -3953,7 +4317,7 @@ type AddProjectUserGroupRequest struct {
 func (x *AddProjectUserGroupRequest) Reset() {
 	*x = AddProjectUserGroupRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[80]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3966,7 +4330,7 @@ func (x *AddProjectUserGroupRequest) String() string {
 func (*AddProjectUserGroupRequest) ProtoMessage() {}
 
 func (x *AddProjectUserGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[80]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3979,7 +4343,7 @@ func (x *AddProjectUserGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddProjectUserGroupRequest.ProtoReflect.Descriptor instead.
 func (*AddProjectUserGroupRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{80}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *AddProjectUserGroupRequest) GetSsoGroup() string {
@@ -4005,7 +4369,7 @@ type AddProjectUserGroupResponse struct {
 func (x *AddProjectUserGroupResponse) Reset() {
 	*x = AddProjectUserGroupResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[81]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4018,7 +4382,7 @@ func (x *AddProjectUserGroupResponse) String() string {
 func (*AddProjectUserGroupResponse) ProtoMessage() {}
 
 func (x *AddProjectUserGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[81]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4031,7 +4395,7 @@ func (x *AddProjectUserGroupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddProjectUserGroupResponse.ProtoReflect.Descriptor instead.
 func (*AddProjectUserGroupResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{81}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{87}
 }
 
 type DeleteProjectUserGroupRequest struct {
@@ -4045,7 +4409,7 @@ type DeleteProjectUserGroupRequest struct {
 func (x *DeleteProjectUserGroupRequest) Reset() {
 	*x = DeleteProjectUserGroupRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[82]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4058,7 +4422,7 @@ func (x *DeleteProjectUserGroupRequest) String() string {
 func (*DeleteProjectUserGroupRequest) ProtoMessage() {}
 
 func (x *DeleteProjectUserGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[82]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4071,7 +4435,7 @@ func (x *DeleteProjectUserGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProjectUserGroupRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProjectUserGroupRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{82}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *DeleteProjectUserGroupRequest) GetSsoGroup() string {
@@ -4090,7 +4454,7 @@ type DeleteProjectUserGroupResponse struct {
 func (x *DeleteProjectUserGroupResponse) Reset() {
 	*x = DeleteProjectUserGroupResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[83]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4103,7 +4467,7 @@ func (x *DeleteProjectUserGroupResponse) String() string {
 func (*DeleteProjectUserGroupResponse) ProtoMessage() {}
 
 func (x *DeleteProjectUserGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[83]
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4116,34 +4480,34 @@ func (x *DeleteProjectUserGroupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProjectUserGroupResponse.ProtoReflect.Descriptor instead.
 func (*DeleteProjectUserGroupResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{83}
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{89}
 }
 
-type GetCommandRequest struct {
+type AddProjectDeploymentFreezeWindowRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CommandId string `protobuf:"bytes,1,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+	Window *model.DeploymentFreezeWindow `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
 }
 
-func (x *GetCommandRequest) Reset() {
-	*x = GetCommandRequest{}
+func (x *AddProjectDeploymentFreezeWindowRequest) Reset() {
+	*x = AddProjectDeploymentFreezeWindowRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[84]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCommandRequest) String() string {
+func (x *AddProjectDeploymentFreezeWindowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommandRequest) ProtoMessage() {}
+func (*AddProjectDeploymentFreezeWindowRequest) ProtoMessage() {}
 
-func (x *GetCommandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[84]
+func (x *AddProjectDeploymentFreezeWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4154,43 +4518,41 @@ func (x *GetCommandRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommandRequest.ProtoReflect.Descriptor instead.
-func (*GetCommandRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use AddProjectDeploymentFreezeWindowRequest.ProtoReflect.Descriptor instead.
+func (*AddProjectDeploymentFreezeWindowRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *GetCommandRequest) GetCommandId() string {
+func (x *AddProjectDeploymentFreezeWindowRequest) GetWindow() *model.DeploymentFreezeWindow {
 	if x != nil {
-		return x.CommandId
+		return x.Window
 	}
-	return ""
+	return nil
 }
 
-type GetCommandResponse struct {
+type AddProjectDeploymentFreezeWindowResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Command *model.Command `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
 }
 
-func (x *GetCommandResponse) Reset() {
-	*x = GetCommandResponse{}
+func (x *AddProjectDeploymentFreezeWindowResponse) Reset() {
+	*x = AddProjectDeploymentFreezeWindowResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[85]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCommandResponse) String() string {
+func (x *AddProjectDeploymentFreezeWindowResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommandResponse) ProtoMessage() {}
+func (*AddProjectDeploymentFreezeWindowResponse) ProtoMessage() {}
 
-func (x *GetCommandResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[85]
+func (x *AddProjectDeploymentFreezeWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4201,44 +4563,36 @@ func (x *GetCommandResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommandResponse.ProtoReflect.Descriptor instead.
-func (*GetCommandResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{85}
-}
-
-func (x *GetCommandResponse) GetCommand() *model.Command {
-	if x != nil {
-		return x.Command
-	}
-	return nil
+// Deprecated: Use AddProjectDeploymentFreezeWindowResponse.ProtoReflect.Descriptor instead.
+func (*AddProjectDeploymentFreezeWindowResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{91}
 }
 
-type GenerateAPIKeyRequest struct {
+type DeleteProjectDeploymentFreezeWindowRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Role model.APIKey_Role `protobuf:"varint,2,opt,name=role,proto3,enum=model.APIKey_Role" json:"role,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *GenerateAPIKeyRequest) Reset() {
-	*x = GenerateAPIKeyRequest{}
+func (x *DeleteProjectDeploymentFreezeWindowRequest) Reset() {
+	*x = DeleteProjectDeploymentFreezeWindowRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[86]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenerateAPIKeyRequest) String() string {
+func (x *DeleteProjectDeploymentFreezeWindowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateAPIKeyRequest) ProtoMessage() {}
+func (*DeleteProjectDeploymentFreezeWindowRequest) ProtoMessage() {}
 
-func (x *GenerateAPIKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[86]
+func (x *DeleteProjectDeploymentFreezeWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4249,50 +4603,41 @@ func (x *GenerateAPIKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateAPIKeyRequest.ProtoReflect.Descriptor instead.
-func (*GenerateAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use DeleteProjectDeploymentFreezeWindowRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProjectDeploymentFreezeWindowRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *GenerateAPIKeyRequest) GetName() string {
+func (x *DeleteProjectDeploymentFreezeWindowRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *GenerateAPIKeyRequest) GetRole() model.APIKey_Role {
-	if x != nil {
-		return x.Role
-	}
-	return model.APIKey_Role(0)
-}
-
-type GenerateAPIKeyResponse struct {
+type DeleteProjectDeploymentFreezeWindowResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *GenerateAPIKeyResponse) Reset() {
-	*x = GenerateAPIKeyResponse{}
+func (x *DeleteProjectDeploymentFreezeWindowResponse) Reset() {
+	*x = DeleteProjectDeploymentFreezeWindowResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[87]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenerateAPIKeyResponse) String() string {
+func (x *DeleteProjectDeploymentFreezeWindowResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateAPIKeyResponse) ProtoMessage() {}
+func (*DeleteProjectDeploymentFreezeWindowResponse) ProtoMessage() {}
 
-func (x *GenerateAPIKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[87]
+func (x *DeleteProjectDeploymentFreezeWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4303,43 +4648,34 @@ func (x *GenerateAPIKeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateAPIKeyResponse.ProtoReflect.Descriptor instead.
-func (*GenerateAPIKeyResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{87}
-}
-
-func (x *GenerateAPIKeyResponse) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
+// Deprecated: Use DeleteProjectDeploymentFreezeWindowResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProjectDeploymentFreezeWindowResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{93}
 }
 
-type DisableAPIKeyRequest struct {
+type ListStaticUsersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *DisableAPIKeyRequest) Reset() {
-	*x = DisableAPIKeyRequest{}
+func (x *ListStaticUsersRequest) Reset() {
+	*x = ListStaticUsersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[88]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DisableAPIKeyRequest) String() string {
+func (x *ListStaticUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisableAPIKeyRequest) ProtoMessage() {}
+func (*ListStaticUsersRequest) ProtoMessage() {}
 
-func (x *DisableAPIKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[88]
+func (x *ListStaticUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4350,41 +4686,36 @@ func (x *DisableAPIKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisableAPIKeyRequest.ProtoReflect.Descriptor instead.
-func (*DisableAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{88}
-}
-
-func (x *DisableAPIKeyRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use ListStaticUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListStaticUsersRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{94}
 }
 
-type DisableAPIKeyResponse struct {
+type ListStaticUsersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Users []*model.ProjectStaticUser `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
 }
 
-func (x *DisableAPIKeyResponse) Reset() {
-	*x = DisableAPIKeyResponse{}
+func (x *ListStaticUsersResponse) Reset() {
+	*x = ListStaticUsersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[89]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DisableAPIKeyResponse) String() string {
+func (x *ListStaticUsersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisableAPIKeyResponse) ProtoMessage() {}
+func (*ListStaticUsersResponse) ProtoMessage() {}
 
-func (x *DisableAPIKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[89]
+func (x *ListStaticUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4395,36 +4726,45 @@ func (x *DisableAPIKeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisableAPIKeyResponse.ProtoReflect.Descriptor instead.
-func (*DisableAPIKeyResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use ListStaticUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListStaticUsersResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{95}
 }
 
-type ListAPIKeysRequest struct {
+func (x *ListStaticUsersResponse) GetUsers() []*model.ProjectStaticUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type AddStaticUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Options *ListAPIKeysRequest_Options `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Role     string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
 }
 
-func (x *ListAPIKeysRequest) Reset() {
-	*x = ListAPIKeysRequest{}
+func (x *AddStaticUserRequest) Reset() {
+	*x = AddStaticUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[90]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListAPIKeysRequest) String() string {
+func (x *AddStaticUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAPIKeysRequest) ProtoMessage() {}
+func (*AddStaticUserRequest) ProtoMessage() {}
 
-func (x *ListAPIKeysRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[90]
+func (x *AddStaticUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4435,43 +4775,55 @@ func (x *ListAPIKeysRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAPIKeysRequest.ProtoReflect.Descriptor instead.
-func (*ListAPIKeysRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use AddStaticUserRequest.ProtoReflect.Descriptor instead.
+func (*AddStaticUserRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *ListAPIKeysRequest) GetOptions() *ListAPIKeysRequest_Options {
+func (x *AddStaticUserRequest) GetUsername() string {
 	if x != nil {
-		return x.Options
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-type ListAPIKeysResponse struct {
+func (x *AddStaticUserRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *AddStaticUserRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type AddStaticUserResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Keys []*model.APIKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
-func (x *ListAPIKeysResponse) Reset() {
-	*x = ListAPIKeysResponse{}
+func (x *AddStaticUserResponse) Reset() {
+	*x = AddStaticUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[91]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListAPIKeysResponse) String() string {
+func (x *AddStaticUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAPIKeysResponse) ProtoMessage() {}
+func (*AddStaticUserResponse) ProtoMessage() {}
 
-func (x *ListAPIKeysResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[91]
+func (x *AddStaticUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4482,48 +4834,36 @@ func (x *ListAPIKeysResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAPIKeysResponse.ProtoReflect.Descriptor instead.
-func (*ListAPIKeysResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{91}
-}
-
-func (x *ListAPIKeysResponse) GetKeys() []*model.APIKey {
-	if x != nil {
-		return x.Keys
-	}
-	return nil
+// Deprecated: Use AddStaticUserResponse.ProtoReflect.Descriptor instead.
+func (*AddStaticUserResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{97}
 }
 
-type GetInsightDataRequest struct {
+type DeleteStaticUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MetricsKind   model.InsightMetricsKind `protobuf:"varint,1,opt,name=metrics_kind,json=metricsKind,proto3,enum=model.InsightMetricsKind" json:"metrics_kind,omitempty"`
-	RangeFrom     int64                    `protobuf:"varint,2,opt,name=range_from,json=rangeFrom,proto3" json:"range_from,omitempty"`
-	RangeTo       int64                    `protobuf:"varint,3,opt,name=range_to,json=rangeTo,proto3" json:"range_to,omitempty"`
-	Resolution    model.InsightResolution  `protobuf:"varint,4,opt,name=resolution,proto3,enum=model.InsightResolution" json:"resolution,omitempty"`
-	ApplicationId string                   `protobuf:"bytes,10,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
-	Labels        map[string]string        `protobuf:"bytes,11,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 }
 
-func (x *GetInsightDataRequest) Reset() {
-	*x = GetInsightDataRequest{}
+func (x *DeleteStaticUserRequest) Reset() {
+	*x = DeleteStaticUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[92]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetInsightDataRequest) String() string {
+func (x *DeleteStaticUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInsightDataRequest) ProtoMessage() {}
+func (*DeleteStaticUserRequest) ProtoMessage() {}
 
-func (x *GetInsightDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[92]
+func (x *DeleteStaticUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4534,81 +4874,82 @@ func (x *GetInsightDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInsightDataRequest.ProtoReflect.Descriptor instead.
-func (*GetInsightDataRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use DeleteStaticUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStaticUserRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *GetInsightDataRequest) GetMetricsKind() model.InsightMetricsKind {
+func (x *DeleteStaticUserRequest) GetUsername() string {
 	if x != nil {
-		return x.MetricsKind
+		return x.Username
 	}
-	return model.InsightMetricsKind(0)
+	return ""
 }
 
-func (x *GetInsightDataRequest) GetRangeFrom() int64 {
-	if x != nil {
-		return x.RangeFrom
-	}
-	return 0
+type DeleteStaticUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetInsightDataRequest) GetRangeTo() int64 {
-	if x != nil {
-		return x.RangeTo
+func (x *DeleteStaticUserResponse) Reset() {
+	*x = DeleteStaticUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *GetInsightDataRequest) GetResolution() model.InsightResolution {
-	if x != nil {
-		return x.Resolution
-	}
-	return model.InsightResolution(0)
+func (x *DeleteStaticUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetInsightDataRequest) GetApplicationId() string {
-	if x != nil {
-		return x.ApplicationId
+func (*DeleteStaticUserResponse) ProtoMessage() {}
+
+func (x *DeleteStaticUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetInsightDataRequest) GetLabels() map[string]string {
-	if x != nil {
-		return x.Labels
-	}
-	return nil
+// Deprecated: Use DeleteStaticUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteStaticUserResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{99}
 }
 
-type GetInsightDataResponse struct {
+type UpdateStaticUserRoleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UpdatedAt int64                        `protobuf:"varint,1,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Type      model.InsightResultType      `protobuf:"varint,2,opt,name=type,proto3,enum=model.InsightResultType" json:"type,omitempty"`
-	Vector    []*model.InsightSample       `protobuf:"bytes,3,rep,name=vector,proto3" json:"vector,omitempty"`
-	Matrix    []*model.InsightSampleStream `protobuf:"bytes,4,rep,name=matrix,proto3" json:"matrix,omitempty"`
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Role     string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
 }
 
-func (x *GetInsightDataResponse) Reset() {
-	*x = GetInsightDataResponse{}
+func (x *UpdateStaticUserRoleRequest) Reset() {
+	*x = UpdateStaticUserRoleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[93]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[100]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetInsightDataResponse) String() string {
+func (x *UpdateStaticUserRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInsightDataResponse) ProtoMessage() {}
+func (*UpdateStaticUserRoleRequest) ProtoMessage() {}
 
-func (x *GetInsightDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[93]
+func (x *UpdateStaticUserRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[100]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4619,62 +4960,48 @@ func (x *GetInsightDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInsightDataResponse.ProtoReflect.Descriptor instead.
-func (*GetInsightDataResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{93}
-}
-
-func (x *GetInsightDataResponse) GetUpdatedAt() int64 {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return 0
-}
-
-func (x *GetInsightDataResponse) GetType() model.InsightResultType {
-	if x != nil {
-		return x.Type
-	}
-	return model.InsightResultType(0)
+// Deprecated: Use UpdateStaticUserRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStaticUserRoleRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *GetInsightDataResponse) GetVector() []*model.InsightSample {
+func (x *UpdateStaticUserRoleRequest) GetUsername() string {
 	if x != nil {
-		return x.Vector
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-func (x *GetInsightDataResponse) GetMatrix() []*model.InsightSampleStream {
+func (x *UpdateStaticUserRoleRequest) GetRole() string {
 	if x != nil {
-		return x.Matrix
+		return x.Role
 	}
-	return nil
+	return ""
 }
 
-type GetInsightApplicationCountRequest struct {
+type UpdateStaticUserRoleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetInsightApplicationCountRequest) Reset() {
-	*x = GetInsightApplicationCountRequest{}
+func (x *UpdateStaticUserRoleResponse) Reset() {
+	*x = UpdateStaticUserRoleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[94]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[101]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetInsightApplicationCountRequest) String() string {
+func (x *UpdateStaticUserRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInsightApplicationCountRequest) ProtoMessage() {}
+func (*UpdateStaticUserRoleResponse) ProtoMessage() {}
 
-func (x *GetInsightApplicationCountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[94]
+func (x *UpdateStaticUserRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[101]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4685,37 +5012,40 @@ func (x *GetInsightApplicationCountRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInsightApplicationCountRequest.ProtoReflect.Descriptor instead.
-func (*GetInsightApplicationCountRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use UpdateStaticUserRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateStaticUserRoleResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{101}
 }
 
-type GetInsightApplicationCountResponse struct {
+type ResetStaticUserPasswordRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UpdatedAt int64                            `protobuf:"varint,1,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Counts    []*model.InsightApplicationCount `protobuf:"bytes,2,rep,name=counts,proto3" json:"counts,omitempty"`
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Whether to disable the two-factor authentication of the user as well,
+	// e.g. when the user lost their authenticator device.
+	ResetTotp bool `protobuf:"varint,3,opt,name=reset_totp,json=resetTotp,proto3" json:"reset_totp,omitempty"`
 }
 
-func (x *GetInsightApplicationCountResponse) Reset() {
-	*x = GetInsightApplicationCountResponse{}
+func (x *ResetStaticUserPasswordRequest) Reset() {
+	*x = ResetStaticUserPasswordRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[95]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[102]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetInsightApplicationCountResponse) String() string {
+func (x *ResetStaticUserPasswordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInsightApplicationCountResponse) ProtoMessage() {}
+func (*ResetStaticUserPasswordRequest) ProtoMessage() {}
 
-func (x *GetInsightApplicationCountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[95]
+func (x *ResetStaticUserPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[102]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4726,55 +5056,55 @@ func (x *GetInsightApplicationCountResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInsightApplicationCountResponse.ProtoReflect.Descriptor instead.
-func (*GetInsightApplicationCountResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{95}
+// Deprecated: Use ResetStaticUserPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetStaticUserPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *GetInsightApplicationCountResponse) GetUpdatedAt() int64 {
+func (x *ResetStaticUserPasswordRequest) GetUsername() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Username
 	}
-	return 0
+	return ""
 }
 
-func (x *GetInsightApplicationCountResponse) GetCounts() []*model.InsightApplicationCount {
+func (x *ResetStaticUserPasswordRequest) GetPassword() string {
 	if x != nil {
-		return x.Counts
+		return x.Password
 	}
-	return nil
+	return ""
 }
 
-type ListDeploymentChainsRequest struct {
+func (x *ResetStaticUserPasswordRequest) GetResetTotp() bool {
+	if x != nil {
+		return x.ResetTotp
+	}
+	return false
+}
+
+type ResetStaticUserPasswordResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Options  *ListDeploymentChainsRequest_Options `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
-	PageSize int32                                `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Cursor   string                               `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
-	// It will not return any data older than this timestamp, even if it does not meet the page size.
-	// This aims to prevent the server from scanning the entire database to look for deployments that have the specified fields in spite of nothing.
-	PageMinUpdatedAt int64 `protobuf:"varint,4,opt,name=page_min_updated_at,json=pageMinUpdatedAt,proto3" json:"page_min_updated_at,omitempty"`
 }
 
-func (x *ListDeploymentChainsRequest) Reset() {
-	*x = ListDeploymentChainsRequest{}
+func (x *ResetStaticUserPasswordResponse) Reset() {
+	*x = ResetStaticUserPasswordResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[96]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[103]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListDeploymentChainsRequest) String() string {
+func (x *ResetStaticUserPasswordResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDeploymentChainsRequest) ProtoMessage() {}
+func (*ResetStaticUserPasswordResponse) ProtoMessage() {}
 
-func (x *ListDeploymentChainsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[96]
+func (x *ResetStaticUserPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[103]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4785,65 +5115,37 @@ func (x *ListDeploymentChainsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDeploymentChainsRequest.ProtoReflect.Descriptor instead.
-func (*ListDeploymentChainsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{96}
+// Deprecated: Use ResetStaticUserPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetStaticUserPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *ListDeploymentChainsRequest) GetOptions() *ListDeploymentChainsRequest_Options {
-	if x != nil {
-		return x.Options
-	}
-	return nil
+type ChangeStaticUserPasswordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurrentPassword string `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	NewPassword     string `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
 }
 
-func (x *ListDeploymentChainsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
+func (x *ChangeStaticUserPasswordRequest) Reset() {
+	*x = ChangeStaticUserPasswordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *ListDeploymentChainsRequest) GetCursor() string {
-	if x != nil {
-		return x.Cursor
-	}
-	return ""
-}
-
-func (x *ListDeploymentChainsRequest) GetPageMinUpdatedAt() int64 {
-	if x != nil {
-		return x.PageMinUpdatedAt
-	}
-	return 0
-}
-
-type ListDeploymentChainsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	DeploymentChains []*model.DeploymentChain `protobuf:"bytes,1,rep,name=deployment_chains,json=deploymentChains,proto3" json:"deployment_chains,omitempty"`
-	Cursor           string                   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
-}
-
-func (x *ListDeploymentChainsResponse) Reset() {
-	*x = ListDeploymentChainsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[97]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListDeploymentChainsResponse) String() string {
+func (x *ChangeStaticUserPasswordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDeploymentChainsResponse) ProtoMessage() {}
+func (*ChangeStaticUserPasswordRequest) ProtoMessage() {}
 
-func (x *ListDeploymentChainsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[97]
+func (x *ChangeStaticUserPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[104]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4854,50 +5156,48 @@ func (x *ListDeploymentChainsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDeploymentChainsResponse.ProtoReflect.Descriptor instead.
-func (*ListDeploymentChainsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use ChangeStaticUserPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangeStaticUserPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *ListDeploymentChainsResponse) GetDeploymentChains() []*model.DeploymentChain {
+func (x *ChangeStaticUserPasswordRequest) GetCurrentPassword() string {
 	if x != nil {
-		return x.DeploymentChains
+		return x.CurrentPassword
 	}
-	return nil
+	return ""
 }
 
-func (x *ListDeploymentChainsResponse) GetCursor() string {
+func (x *ChangeStaticUserPasswordRequest) GetNewPassword() string {
 	if x != nil {
-		return x.Cursor
+		return x.NewPassword
 	}
 	return ""
 }
 
-type GetDeploymentChainRequest struct {
+type ChangeStaticUserPasswordResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	DeploymentChainId string `protobuf:"bytes,1,opt,name=deployment_chain_id,json=deploymentChainId,proto3" json:"deployment_chain_id,omitempty"`
 }
 
-func (x *GetDeploymentChainRequest) Reset() {
-	*x = GetDeploymentChainRequest{}
+func (x *ChangeStaticUserPasswordResponse) Reset() {
+	*x = ChangeStaticUserPasswordResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[98]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[105]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDeploymentChainRequest) String() string {
+func (x *ChangeStaticUserPasswordResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDeploymentChainRequest) ProtoMessage() {}
+func (*ChangeStaticUserPasswordResponse) ProtoMessage() {}
 
-func (x *GetDeploymentChainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[98]
+func (x *ChangeStaticUserPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[105]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4908,43 +5208,34 @@ func (x *GetDeploymentChainRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDeploymentChainRequest.ProtoReflect.Descriptor instead.
-func (*GetDeploymentChainRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{98}
-}
-
-func (x *GetDeploymentChainRequest) GetDeploymentChainId() string {
-	if x != nil {
-		return x.DeploymentChainId
-	}
-	return ""
+// Deprecated: Use ChangeStaticUserPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangeStaticUserPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{105}
 }
 
-type GetDeploymentChainResponse struct {
+type GenerateStaticUserTOTPSecretRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	DeploymentChain *model.DeploymentChain `protobuf:"bytes,1,opt,name=deployment_chain,json=deploymentChain,proto3" json:"deployment_chain,omitempty"`
 }
 
-func (x *GetDeploymentChainResponse) Reset() {
-	*x = GetDeploymentChainResponse{}
+func (x *GenerateStaticUserTOTPSecretRequest) Reset() {
+	*x = GenerateStaticUserTOTPSecretRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[99]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[106]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDeploymentChainResponse) String() string {
+func (x *GenerateStaticUserTOTPSecretRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDeploymentChainResponse) ProtoMessage() {}
+func (*GenerateStaticUserTOTPSecretRequest) ProtoMessage() {}
 
-func (x *GetDeploymentChainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[99]
+func (x *GenerateStaticUserTOTPSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[106]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4955,48 +5246,39 @@ func (x *GetDeploymentChainResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDeploymentChainResponse.ProtoReflect.Descriptor instead.
-func (*GetDeploymentChainResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{99}
-}
-
-func (x *GetDeploymentChainResponse) GetDeploymentChain() *model.DeploymentChain {
-	if x != nil {
-		return x.DeploymentChain
-	}
-	return nil
+// Deprecated: Use GenerateStaticUserTOTPSecretRequest.ProtoReflect.Descriptor instead.
+func (*GenerateStaticUserTOTPSecretRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{106}
 }
 
-type ListEventsRequest struct {
+type GenerateStaticUserTOTPSecretResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Options  *ListEventsRequest_Options `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
-	PageSize int32                      `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Cursor   string                     `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
-	// It will not return any data older than this timestamp, even if it does not meet the page size.
-	// This aims to prevent the server from scanning the entire database to look for deployments that have the specified fields in spite of nothing.
-	PageMinUpdatedAt int64 `protobuf:"varint,4,opt,name=page_min_updated_at,json=pageMinUpdatedAt,proto3" json:"page_min_updated_at,omitempty"`
+	// The base32 encoded secret to be registered in an authenticator app.
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// The otpauth URL of the secret, usually shown as a QR code.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
 }
 
-func (x *ListEventsRequest) Reset() {
-	*x = ListEventsRequest{}
+func (x *GenerateStaticUserTOTPSecretResponse) Reset() {
+	*x = GenerateStaticUserTOTPSecretResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[100]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[107]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListEventsRequest) String() string {
+func (x *GenerateStaticUserTOTPSecretResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventsRequest) ProtoMessage() {}
+func (*GenerateStaticUserTOTPSecretResponse) ProtoMessage() {}
 
-func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[100]
+func (x *GenerateStaticUserTOTPSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[107]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5007,65 +5289,54 @@ func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
-func (*ListEventsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{100}
-}
-
-func (x *ListEventsRequest) GetOptions() *ListEventsRequest_Options {
-	if x != nil {
-		return x.Options
-	}
-	return nil
-}
-
-func (x *ListEventsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use GenerateStaticUserTOTPSecretResponse.ProtoReflect.Descriptor instead.
+func (*GenerateStaticUserTOTPSecretResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *ListEventsRequest) GetCursor() string {
+func (x *GenerateStaticUserTOTPSecretResponse) GetSecret() string {
 	if x != nil {
-		return x.Cursor
+		return x.Secret
 	}
 	return ""
 }
 
-func (x *ListEventsRequest) GetPageMinUpdatedAt() int64 {
+func (x *GenerateStaticUserTOTPSecretResponse) GetUrl() string {
 	if x != nil {
-		return x.PageMinUpdatedAt
+		return x.Url
 	}
-	return 0
+	return ""
 }
 
-type ListEventsResponse struct {
+type EnableStaticUserTOTPRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Events []*model.Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	Cursor string         `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	// The secret returned by GenerateStaticUserTOTPSecret.
+	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	// A passcode generated from the secret to confirm that it was registered correctly.
+	Passcode string `protobuf:"bytes,3,opt,name=passcode,proto3" json:"passcode,omitempty"`
 }
 
-func (x *ListEventsResponse) Reset() {
-	*x = ListEventsResponse{}
+func (x *EnableStaticUserTOTPRequest) Reset() {
+	*x = EnableStaticUserTOTPRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[101]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[108]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListEventsResponse) String() string {
+func (x *EnableStaticUserTOTPRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventsResponse) ProtoMessage() {}
+func (*EnableStaticUserTOTPRequest) ProtoMessage() {}
 
-func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[101]
+func (x *EnableStaticUserTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[108]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5076,50 +5347,55 @@ func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
-func (*ListEventsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{101}
+// Deprecated: Use EnableStaticUserTOTPRequest.ProtoReflect.Descriptor instead.
+func (*EnableStaticUserTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *ListEventsResponse) GetEvents() []*model.Event {
+func (x *EnableStaticUserTOTPRequest) GetPassword() string {
 	if x != nil {
-		return x.Events
+		return x.Password
 	}
-	return nil
+	return ""
 }
 
-func (x *ListEventsResponse) GetCursor() string {
+func (x *EnableStaticUserTOTPRequest) GetSecret() string {
 	if x != nil {
-		return x.Cursor
+		return x.Secret
 	}
 	return ""
 }
 
-type ListPipedsRequest_Options struct {
+func (x *EnableStaticUserTOTPRequest) GetPasscode() string {
+	if x != nil {
+		return x.Passcode
+	}
+	return ""
+}
+
+type EnableStaticUserTOTPResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Enabled *wrapperspb.BoolValue `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (x *ListPipedsRequest_Options) Reset() {
-	*x = ListPipedsRequest_Options{}
+func (x *EnableStaticUserTOTPResponse) Reset() {
+	*x = EnableStaticUserTOTPResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[102]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[109]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListPipedsRequest_Options) String() string {
+func (x *EnableStaticUserTOTPResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPipedsRequest_Options) ProtoMessage() {}
+func (*EnableStaticUserTOTPResponse) ProtoMessage() {}
 
-func (x *ListPipedsRequest_Options) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[102]
+func (x *EnableStaticUserTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[109]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5130,48 +5406,36 @@ func (x *ListPipedsRequest_Options) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPipedsRequest_Options.ProtoReflect.Descriptor instead.
-func (*ListPipedsRequest_Options) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{12, 0}
-}
-
-func (x *ListPipedsRequest_Options) GetEnabled() *wrapperspb.BoolValue {
-	if x != nil {
-		return x.Enabled
-	}
-	return nil
+// Deprecated: Use EnableStaticUserTOTPResponse.ProtoReflect.Descriptor instead.
+func (*EnableStaticUserTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{109}
 }
 
-type ListApplicationsRequest_Options struct {
+type DisableStaticUserTOTPRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enabled      *wrapperspb.BoolValue         `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Kinds        []model.ApplicationKind       `protobuf:"varint,2,rep,packed,name=kinds,proto3,enum=model.ApplicationKind" json:"kinds,omitempty"`
-	SyncStatuses []model.ApplicationSyncStatus `protobuf:"varint,3,rep,packed,name=sync_statuses,json=syncStatuses,proto3,enum=model.ApplicationSyncStatus" json:"sync_statuses,omitempty"`
-	Name         string                        `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
-	Labels       map[string]string             `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	PipedId      string                        `protobuf:"bytes,7,opt,name=piped_id,json=pipedId,proto3" json:"piped_id,omitempty"`
+	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *ListApplicationsRequest_Options) Reset() {
-	*x = ListApplicationsRequest_Options{}
+func (x *DisableStaticUserTOTPRequest) Reset() {
+	*x = DisableStaticUserTOTPRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[106]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[110]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListApplicationsRequest_Options) String() string {
+func (x *DisableStaticUserTOTPRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListApplicationsRequest_Options) ProtoMessage() {}
+func (*DisableStaticUserTOTPRequest) ProtoMessage() {}
 
-func (x *ListApplicationsRequest_Options) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[106]
+func (x *DisableStaticUserTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[110]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5182,82 +5446,81 @@ func (x *ListApplicationsRequest_Options) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListApplicationsRequest_Options.ProtoReflect.Descriptor instead.
-func (*ListApplicationsRequest_Options) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{34, 0}
+// Deprecated: Use DisableStaticUserTOTPRequest.ProtoReflect.Descriptor instead.
+func (*DisableStaticUserTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *ListApplicationsRequest_Options) GetEnabled() *wrapperspb.BoolValue {
+func (x *DisableStaticUserTOTPRequest) GetPassword() string {
 	if x != nil {
-		return x.Enabled
+		return x.Password
 	}
-	return nil
+	return ""
 }
 
-func (x *ListApplicationsRequest_Options) GetKinds() []model.ApplicationKind {
-	if x != nil {
-		return x.Kinds
-	}
-	return nil
+type DisableStaticUserTOTPResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ListApplicationsRequest_Options) GetSyncStatuses() []model.ApplicationSyncStatus {
-	if x != nil {
-		return x.SyncStatuses
+func (x *DisableStaticUserTOTPResponse) Reset() {
+	*x = DisableStaticUserTOTPResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *ListApplicationsRequest_Options) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x *DisableStaticUserTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ListApplicationsRequest_Options) GetLabels() map[string]string {
-	if x != nil {
-		return x.Labels
+func (*DisableStaticUserTOTPResponse) ProtoMessage() {}
+
+func (x *DisableStaticUserTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ListApplicationsRequest_Options) GetPipedId() string {
-	if x != nil {
-		return x.PipedId
-	}
-	return ""
+// Deprecated: Use DisableStaticUserTOTPResponse.ProtoReflect.Descriptor instead.
+func (*DisableStaticUserTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{111}
 }
 
-type ListDeploymentsRequest_Options struct {
+type GetCommandRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Statuses        []model.DeploymentStatus `protobuf:"varint,1,rep,packed,name=statuses,proto3,enum=model.DeploymentStatus" json:"statuses,omitempty"`
-	Kinds           []model.ApplicationKind  `protobuf:"varint,2,rep,packed,name=kinds,proto3,enum=model.ApplicationKind" json:"kinds,omitempty"`
-	ApplicationIds  []string                 `protobuf:"bytes,3,rep,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
-	ApplicationName string                   `protobuf:"bytes,5,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
-	Labels          map[string]string        `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CommandId string `protobuf:"bytes,1,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
 }
 
-func (x *ListDeploymentsRequest_Options) Reset() {
-	*x = ListDeploymentsRequest_Options{}
+func (x *GetCommandRequest) Reset() {
+	*x = GetCommandRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[108]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[112]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListDeploymentsRequest_Options) String() string {
+func (x *GetCommandRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDeploymentsRequest_Options) ProtoMessage() {}
+func (*GetCommandRequest) ProtoMessage() {}
 
-func (x *ListDeploymentsRequest_Options) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[108]
+func (x *GetCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[112]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5268,71 +5531,91 @@ func (x *ListDeploymentsRequest_Options) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDeploymentsRequest_Options.ProtoReflect.Descriptor instead.
-func (*ListDeploymentsRequest_Options) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{44, 0}
-}
+// Deprecated: Use GetCommandRequest.ProtoReflect.Descriptor instead.
+func (*GetCommandRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{112}
+}
 
-func (x *ListDeploymentsRequest_Options) GetStatuses() []model.DeploymentStatus {
+func (x *GetCommandRequest) GetCommandId() string {
 	if x != nil {
-		return x.Statuses
+		return x.CommandId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListDeploymentsRequest_Options) GetKinds() []model.ApplicationKind {
-	if x != nil {
-		return x.Kinds
-	}
-	return nil
+type GetCommandResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Command *model.Command `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
 }
 
-func (x *ListDeploymentsRequest_Options) GetApplicationIds() []string {
-	if x != nil {
-		return x.ApplicationIds
+func (x *GetCommandResponse) Reset() {
+	*x = GetCommandResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *ListDeploymentsRequest_Options) GetApplicationName() string {
-	if x != nil {
-		return x.ApplicationName
+func (x *GetCommandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommandResponse) ProtoMessage() {}
+
+func (x *GetCommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListDeploymentsRequest_Options) GetLabels() map[string]string {
+// Deprecated: Use GetCommandResponse.ProtoReflect.Descriptor instead.
+func (*GetCommandResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetCommandResponse) GetCommand() *model.Command {
 	if x != nil {
-		return x.Labels
+		return x.Command
 	}
 	return nil
 }
 
-type ListDeploymentTracesRequest_Options struct {
+type GenerateAPIKeyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CommitHash string `protobuf:"bytes,1,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	Name string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Role model.APIKey_Role `protobuf:"varint,2,opt,name=role,proto3,enum=model.APIKey_Role" json:"role,omitempty"`
 }
 
-func (x *ListDeploymentTracesRequest_Options) Reset() {
-	*x = ListDeploymentTracesRequest_Options{}
+func (x *GenerateAPIKeyRequest) Reset() {
+	*x = GenerateAPIKeyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[110]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[114]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListDeploymentTracesRequest_Options) String() string {
+func (x *GenerateAPIKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDeploymentTracesRequest_Options) ProtoMessage() {}
+func (*GenerateAPIKeyRequest) ProtoMessage() {}
 
-func (x *ListDeploymentTracesRequest_Options) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[110]
+func (x *GenerateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[114]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5343,44 +5626,50 @@ func (x *ListDeploymentTracesRequest_Options) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDeploymentTracesRequest_Options.ProtoReflect.Descriptor instead.
-func (*ListDeploymentTracesRequest_Options) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{56, 0}
+// Deprecated: Use GenerateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*GenerateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *ListDeploymentTracesRequest_Options) GetCommitHash() string {
+func (x *GenerateAPIKeyRequest) GetName() string {
 	if x != nil {
-		return x.CommitHash
+		return x.Name
 	}
 	return ""
 }
 
-type ListDeploymentTracesResponse_DeploymentTraceRes struct {
+func (x *GenerateAPIKeyRequest) GetRole() model.APIKey_Role {
+	if x != nil {
+		return x.Role
+	}
+	return model.APIKey_Role(0)
+}
+
+type GenerateAPIKeyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Trace       *model.DeploymentTrace `protobuf:"bytes,1,opt,name=trace,proto3" json:"trace,omitempty"`
-	Deployments []*model.Deployment    `protobuf:"bytes,2,rep,name=deployments,proto3" json:"deployments,omitempty"`
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *ListDeploymentTracesResponse_DeploymentTraceRes) Reset() {
-	*x = ListDeploymentTracesResponse_DeploymentTraceRes{}
+func (x *GenerateAPIKeyResponse) Reset() {
+	*x = GenerateAPIKeyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[111]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[115]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListDeploymentTracesResponse_DeploymentTraceRes) String() string {
+func (x *GenerateAPIKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDeploymentTracesResponse_DeploymentTraceRes) ProtoMessage() {}
+func (*GenerateAPIKeyResponse) ProtoMessage() {}
 
-func (x *ListDeploymentTracesResponse_DeploymentTraceRes) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[111]
+func (x *GenerateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[115]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5391,50 +5680,43 @@ func (x *ListDeploymentTracesResponse_DeploymentTraceRes) ProtoReflect() protore
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDeploymentTracesResponse_DeploymentTraceRes.ProtoReflect.Descriptor instead.
-func (*ListDeploymentTracesResponse_DeploymentTraceRes) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{57, 0}
-}
-
-func (x *ListDeploymentTracesResponse_DeploymentTraceRes) GetTrace() *model.DeploymentTrace {
-	if x != nil {
-		return x.Trace
-	}
-	return nil
+// Deprecated: Use GenerateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*GenerateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *ListDeploymentTracesResponse_DeploymentTraceRes) GetDeployments() []*model.Deployment {
+func (x *GenerateAPIKeyResponse) GetKey() string {
 	if x != nil {
-		return x.Deployments
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-type ListAPIKeysRequest_Options struct {
+type DisableAPIKeyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enabled *wrapperspb.BoolValue `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ListAPIKeysRequest_Options) Reset() {
-	*x = ListAPIKeysRequest_Options{}
+func (x *DisableAPIKeyRequest) Reset() {
+	*x = DisableAPIKeyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[112]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[116]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListAPIKeysRequest_Options) String() string {
+func (x *DisableAPIKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAPIKeysRequest_Options) ProtoMessage() {}
+func (*DisableAPIKeyRequest) ProtoMessage() {}
 
-func (x *ListAPIKeysRequest_Options) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[112]
+func (x *DisableAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[116]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5445,41 +5727,41 @@ func (x *ListAPIKeysRequest_Options) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAPIKeysRequest_Options.ProtoReflect.Descriptor instead.
-func (*ListAPIKeysRequest_Options) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{90, 0}
+// Deprecated: Use DisableAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*DisableAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *ListAPIKeysRequest_Options) GetEnabled() *wrapperspb.BoolValue {
+func (x *DisableAPIKeyRequest) GetId() string {
 	if x != nil {
-		return x.Enabled
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type ListDeploymentChainsRequest_Options struct {
+type DisableAPIKeyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ListDeploymentChainsRequest_Options) Reset() {
-	*x = ListDeploymentChainsRequest_Options{}
+func (x *DisableAPIKeyResponse) Reset() {
+	*x = DisableAPIKeyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[114]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[117]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListDeploymentChainsRequest_Options) String() string {
+func (x *DisableAPIKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDeploymentChainsRequest_Options) ProtoMessage() {}
+func (*DisableAPIKeyResponse) ProtoMessage() {}
 
-func (x *ListDeploymentChainsRequest_Options) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[114]
+func (x *DisableAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[117]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5490,38 +5772,36 @@ func (x *ListDeploymentChainsRequest_Options) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDeploymentChainsRequest_Options.ProtoReflect.Descriptor instead.
-func (*ListDeploymentChainsRequest_Options) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{96, 0}
+// Deprecated: Use DisableAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*DisableAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{117}
 }
 
-type ListEventsRequest_Options struct {
+type ListAPIKeysRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Statuses []model.EventStatus `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=model.EventStatus" json:"statuses,omitempty"`
-	Labels   map[string]string   `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Options *ListAPIKeysRequest_Options `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
 }
 
-func (x *ListEventsRequest_Options) Reset() {
-	*x = ListEventsRequest_Options{}
+func (x *ListAPIKeysRequest) Reset() {
+	*x = ListAPIKeysRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[115]
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[118]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListEventsRequest_Options) String() string {
+func (x *ListAPIKeysRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventsRequest_Options) ProtoMessage() {}
+func (*ListAPIKeysRequest) ProtoMessage() {}
 
-func (x *ListEventsRequest_Options) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[115]
+func (x *ListAPIKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[118]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5532,46 +5812,1309 @@ func (x *ListEventsRequest_Options) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventsRequest_Options.ProtoReflect.Descriptor instead.
-func (*ListEventsRequest_Options) Descriptor() ([]byte, []int) {
-	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{100, 0}
+// Deprecated: Use ListAPIKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *ListEventsRequest_Options) GetName() string {
+func (x *ListAPIKeysRequest) GetOptions() *ListAPIKeysRequest_Options {
 	if x != nil {
-		return x.Name
+		return x.Options
 	}
-	return ""
+	return nil
 }
 
-func (x *ListEventsRequest_Options) GetStatuses() []model.EventStatus {
-	if x != nil {
-		return x.Statuses
+type ListAPIKeysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []*model.APIKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *ListAPIKeysResponse) Reset() {
+	*x = ListAPIKeysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *ListEventsRequest_Options) GetLabels() map[string]string {
+func (x *ListAPIKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysResponse) ProtoMessage() {}
+
+func (x *ListAPIKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ListAPIKeysResponse) GetKeys() []*model.APIKey {
 	if x != nil {
-		return x.Labels
+		return x.Keys
 	}
 	return nil
 }
 
-var File_pkg_app_server_service_webservice_service_proto protoreflect.FileDescriptor
+type GetInsightDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_pkg_app_server_service_webservice_service_proto_rawDesc = []byte{
-	0x0a, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x17, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x70, 0x6b, 0x67,
-	0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	MetricsKind   model.InsightMetricsKind `protobuf:"varint,1,opt,name=metrics_kind,json=metricsKind,proto3,enum=model.InsightMetricsKind" json:"metrics_kind,omitempty"`
+	RangeFrom     int64                    `protobuf:"varint,2,opt,name=range_from,json=rangeFrom,proto3" json:"range_from,omitempty"`
+	RangeTo       int64                    `protobuf:"varint,3,opt,name=range_to,json=rangeTo,proto3" json:"range_to,omitempty"`
+	Resolution    model.InsightResolution  `protobuf:"varint,4,opt,name=resolution,proto3,enum=model.InsightResolution" json:"resolution,omitempty"`
+	ApplicationId string                   `protobuf:"bytes,10,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Labels        map[string]string        `protobuf:"bytes,11,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetInsightDataRequest) Reset() {
+	*x = GetInsightDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInsightDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInsightDataRequest) ProtoMessage() {}
+
+func (x *GetInsightDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInsightDataRequest.ProtoReflect.Descriptor instead.
+func (*GetInsightDataRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *GetInsightDataRequest) GetMetricsKind() model.InsightMetricsKind {
+	if x != nil {
+		return x.MetricsKind
+	}
+	return model.InsightMetricsKind(0)
+}
+
+func (x *GetInsightDataRequest) GetRangeFrom() int64 {
+	if x != nil {
+		return x.RangeFrom
+	}
+	return 0
+}
+
+func (x *GetInsightDataRequest) GetRangeTo() int64 {
+	if x != nil {
+		return x.RangeTo
+	}
+	return 0
+}
+
+func (x *GetInsightDataRequest) GetResolution() model.InsightResolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return model.InsightResolution(0)
+}
+
+func (x *GetInsightDataRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *GetInsightDataRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type GetInsightDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UpdatedAt int64                        `protobuf:"varint,1,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Type      model.InsightResultType      `protobuf:"varint,2,opt,name=type,proto3,enum=model.InsightResultType" json:"type,omitempty"`
+	Vector    []*model.InsightSample       `protobuf:"bytes,3,rep,name=vector,proto3" json:"vector,omitempty"`
+	Matrix    []*model.InsightSampleStream `protobuf:"bytes,4,rep,name=matrix,proto3" json:"matrix,omitempty"`
+}
+
+func (x *GetInsightDataResponse) Reset() {
+	*x = GetInsightDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInsightDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInsightDataResponse) ProtoMessage() {}
+
+func (x *GetInsightDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInsightDataResponse.ProtoReflect.Descriptor instead.
+func (*GetInsightDataResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GetInsightDataResponse) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *GetInsightDataResponse) GetType() model.InsightResultType {
+	if x != nil {
+		return x.Type
+	}
+	return model.InsightResultType(0)
+}
+
+func (x *GetInsightDataResponse) GetVector() []*model.InsightSample {
+	if x != nil {
+		return x.Vector
+	}
+	return nil
+}
+
+func (x *GetInsightDataResponse) GetMatrix() []*model.InsightSampleStream {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+type GetInsightApplicationCountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetInsightApplicationCountRequest) Reset() {
+	*x = GetInsightApplicationCountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInsightApplicationCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInsightApplicationCountRequest) ProtoMessage() {}
+
+func (x *GetInsightApplicationCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInsightApplicationCountRequest.ProtoReflect.Descriptor instead.
+func (*GetInsightApplicationCountRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{122}
+}
+
+type GetInsightApplicationCountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UpdatedAt int64                            `protobuf:"varint,1,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Counts    []*model.InsightApplicationCount `protobuf:"bytes,2,rep,name=counts,proto3" json:"counts,omitempty"`
+}
+
+func (x *GetInsightApplicationCountResponse) Reset() {
+	*x = GetInsightApplicationCountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInsightApplicationCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInsightApplicationCountResponse) ProtoMessage() {}
+
+func (x *GetInsightApplicationCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInsightApplicationCountResponse.ProtoReflect.Descriptor instead.
+func (*GetInsightApplicationCountResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *GetInsightApplicationCountResponse) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *GetInsightApplicationCountResponse) GetCounts() []*model.InsightApplicationCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type ListDeploymentChainsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Options  *ListDeploymentChainsRequest_Options `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	PageSize int32                                `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor   string                               `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// It will not return any data older than this timestamp, even if it does not meet the page size.
+	// This aims to prevent the server from scanning the entire database to look for deployments that have the specified fields in spite of nothing.
+	PageMinUpdatedAt int64 `protobuf:"varint,4,opt,name=page_min_updated_at,json=pageMinUpdatedAt,proto3" json:"page_min_updated_at,omitempty"`
+}
+
+func (x *ListDeploymentChainsRequest) Reset() {
+	*x = ListDeploymentChainsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeploymentChainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeploymentChainsRequest) ProtoMessage() {}
+
+func (x *ListDeploymentChainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeploymentChainsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeploymentChainsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *ListDeploymentChainsRequest) GetOptions() *ListDeploymentChainsRequest_Options {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ListDeploymentChainsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeploymentChainsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListDeploymentChainsRequest) GetPageMinUpdatedAt() int64 {
+	if x != nil {
+		return x.PageMinUpdatedAt
+	}
+	return 0
+}
+
+type ListDeploymentChainsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeploymentChains []*model.DeploymentChain `protobuf:"bytes,1,rep,name=deployment_chains,json=deploymentChains,proto3" json:"deployment_chains,omitempty"`
+	Cursor           string                   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ListDeploymentChainsResponse) Reset() {
+	*x = ListDeploymentChainsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeploymentChainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeploymentChainsResponse) ProtoMessage() {}
+
+func (x *ListDeploymentChainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeploymentChainsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeploymentChainsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ListDeploymentChainsResponse) GetDeploymentChains() []*model.DeploymentChain {
+	if x != nil {
+		return x.DeploymentChains
+	}
+	return nil
+}
+
+func (x *ListDeploymentChainsResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetDeploymentChainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeploymentChainId string `protobuf:"bytes,1,opt,name=deployment_chain_id,json=deploymentChainId,proto3" json:"deployment_chain_id,omitempty"`
+}
+
+func (x *GetDeploymentChainRequest) Reset() {
+	*x = GetDeploymentChainRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeploymentChainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeploymentChainRequest) ProtoMessage() {}
+
+func (x *GetDeploymentChainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeploymentChainRequest.ProtoReflect.Descriptor instead.
+func (*GetDeploymentChainRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *GetDeploymentChainRequest) GetDeploymentChainId() string {
+	if x != nil {
+		return x.DeploymentChainId
+	}
+	return ""
+}
+
+type GetDeploymentChainResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeploymentChain *model.DeploymentChain `protobuf:"bytes,1,opt,name=deployment_chain,json=deploymentChain,proto3" json:"deployment_chain,omitempty"`
+}
+
+func (x *GetDeploymentChainResponse) Reset() {
+	*x = GetDeploymentChainResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeploymentChainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeploymentChainResponse) ProtoMessage() {}
+
+func (x *GetDeploymentChainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeploymentChainResponse.ProtoReflect.Descriptor instead.
+func (*GetDeploymentChainResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetDeploymentChainResponse) GetDeploymentChain() *model.DeploymentChain {
+	if x != nil {
+		return x.DeploymentChain
+	}
+	return nil
+}
+
+type CancelDeploymentChainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeploymentChainId string `protobuf:"bytes,1,opt,name=deployment_chain_id,json=deploymentChainId,proto3" json:"deployment_chain_id,omitempty"`
+}
+
+func (x *CancelDeploymentChainRequest) Reset() {
+	*x = CancelDeploymentChainRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelDeploymentChainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelDeploymentChainRequest) ProtoMessage() {}
+
+func (x *CancelDeploymentChainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDeploymentChainRequest.ProtoReflect.Descriptor instead.
+func (*CancelDeploymentChainRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *CancelDeploymentChainRequest) GetDeploymentChainId() string {
+	if x != nil {
+		return x.DeploymentChainId
+	}
+	return ""
+}
+
+type CancelDeploymentChainResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The IDs of commands sent to cancel the running deployments of the chain.
+	CommandIds []string `protobuf:"bytes,1,rep,name=command_ids,json=commandIds,proto3" json:"command_ids,omitempty"`
+}
+
+func (x *CancelDeploymentChainResponse) Reset() {
+	*x = CancelDeploymentChainResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelDeploymentChainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelDeploymentChainResponse) ProtoMessage() {}
+
+func (x *CancelDeploymentChainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDeploymentChainResponse.ProtoReflect.Descriptor instead.
+func (*CancelDeploymentChainResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *CancelDeploymentChainResponse) GetCommandIds() []string {
+	if x != nil {
+		return x.CommandIds
+	}
+	return nil
+}
+
+type ListEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Options  *ListEventsRequest_Options `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	PageSize int32                      `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor   string                     `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// It will not return any data older than this timestamp, even if it does not meet the page size.
+	// This aims to prevent the server from scanning the entire database to look for deployments that have the specified fields in spite of nothing.
+	PageMinUpdatedAt int64 `protobuf:"varint,4,opt,name=page_min_updated_at,json=pageMinUpdatedAt,proto3" json:"page_min_updated_at,omitempty"`
+}
+
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsRequest) ProtoMessage() {}
+
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *ListEventsRequest) GetOptions() *ListEventsRequest_Options {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetPageMinUpdatedAt() int64 {
+	if x != nil {
+		return x.PageMinUpdatedAt
+	}
+	return 0
+}
+
+type ListEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*model.Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Cursor string         `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ListEventsResponse) Reset() {
+	*x = ListEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsResponse) ProtoMessage() {}
+
+func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *ListEventsResponse) GetEvents() []*model.Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListEventsResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListPipedsRequest_Options struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled *wrapperspb.BoolValue `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *ListPipedsRequest_Options) Reset() {
+	*x = ListPipedsRequest_Options{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPipedsRequest_Options) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPipedsRequest_Options) ProtoMessage() {}
+
+func (x *ListPipedsRequest_Options) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPipedsRequest_Options.ProtoReflect.Descriptor instead.
+func (*ListPipedsRequest_Options) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *ListPipedsRequest_Options) GetEnabled() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Enabled
+	}
+	return nil
+}
+
+type ListApplicationsRequest_Options struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled      *wrapperspb.BoolValue         `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Kinds        []model.ApplicationKind       `protobuf:"varint,2,rep,packed,name=kinds,proto3,enum=model.ApplicationKind" json:"kinds,omitempty"`
+	SyncStatuses []model.ApplicationSyncStatus `protobuf:"varint,3,rep,packed,name=sync_statuses,json=syncStatuses,proto3,enum=model.ApplicationSyncStatus" json:"sync_statuses,omitempty"`
+	Name         string                        `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Labels       map[string]string             `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PipedId      string                        `protobuf:"bytes,7,opt,name=piped_id,json=pipedId,proto3" json:"piped_id,omitempty"`
+}
+
+func (x *ListApplicationsRequest_Options) Reset() {
+	*x = ListApplicationsRequest_Options{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListApplicationsRequest_Options) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApplicationsRequest_Options) ProtoMessage() {}
+
+func (x *ListApplicationsRequest_Options) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApplicationsRequest_Options.ProtoReflect.Descriptor instead.
+func (*ListApplicationsRequest_Options) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{34, 0}
+}
+
+func (x *ListApplicationsRequest_Options) GetEnabled() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Enabled
+	}
+	return nil
+}
+
+func (x *ListApplicationsRequest_Options) GetKinds() []model.ApplicationKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *ListApplicationsRequest_Options) GetSyncStatuses() []model.ApplicationSyncStatus {
+	if x != nil {
+		return x.SyncStatuses
+	}
+	return nil
+}
+
+func (x *ListApplicationsRequest_Options) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListApplicationsRequest_Options) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ListApplicationsRequest_Options) GetPipedId() string {
+	if x != nil {
+		return x.PipedId
+	}
+	return ""
+}
+
+type ListDeploymentsRequest_Options struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Statuses        []model.DeploymentStatus `protobuf:"varint,1,rep,packed,name=statuses,proto3,enum=model.DeploymentStatus" json:"statuses,omitempty"`
+	Kinds           []model.ApplicationKind  `protobuf:"varint,2,rep,packed,name=kinds,proto3,enum=model.ApplicationKind" json:"kinds,omitempty"`
+	ApplicationIds  []string                 `protobuf:"bytes,3,rep,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+	ApplicationName string                   `protobuf:"bytes,5,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	Labels          map[string]string        `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ListDeploymentsRequest_Options) Reset() {
+	*x = ListDeploymentsRequest_Options{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeploymentsRequest_Options) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeploymentsRequest_Options) ProtoMessage() {}
+
+func (x *ListDeploymentsRequest_Options) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeploymentsRequest_Options.ProtoReflect.Descriptor instead.
+func (*ListDeploymentsRequest_Options) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{46, 0}
+}
+
+func (x *ListDeploymentsRequest_Options) GetStatuses() []model.DeploymentStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListDeploymentsRequest_Options) GetKinds() []model.ApplicationKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *ListDeploymentsRequest_Options) GetApplicationIds() []string {
+	if x != nil {
+		return x.ApplicationIds
+	}
+	return nil
+}
+
+func (x *ListDeploymentsRequest_Options) GetApplicationName() string {
+	if x != nil {
+		return x.ApplicationName
+	}
+	return ""
+}
+
+func (x *ListDeploymentsRequest_Options) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type SearchStageLogsResponse_Match struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeploymentId string          `protobuf:"bytes,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	StageId      string          `protobuf:"bytes,2,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
+	RetriedCount int32           `protobuf:"varint,3,opt,name=retried_count,json=retriedCount,proto3" json:"retried_count,omitempty"`
+	Block        *model.LogBlock `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (x *SearchStageLogsResponse_Match) Reset() {
+	*x = SearchStageLogsResponse_Match{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchStageLogsResponse_Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStageLogsResponse_Match) ProtoMessage() {}
+
+func (x *SearchStageLogsResponse_Match) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStageLogsResponse_Match.ProtoReflect.Descriptor instead.
+func (*SearchStageLogsResponse_Match) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{53, 0}
+}
+
+func (x *SearchStageLogsResponse_Match) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return ""
+}
+
+func (x *SearchStageLogsResponse_Match) GetStageId() string {
+	if x != nil {
+		return x.StageId
+	}
+	return ""
+}
+
+func (x *SearchStageLogsResponse_Match) GetRetriedCount() int32 {
+	if x != nil {
+		return x.RetriedCount
+	}
+	return 0
+}
+
+func (x *SearchStageLogsResponse_Match) GetBlock() *model.LogBlock {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+type ListDeploymentTracesRequest_Options struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommitHash string `protobuf:"bytes,1,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+}
+
+func (x *ListDeploymentTracesRequest_Options) Reset() {
+	*x = ListDeploymentTracesRequest_Options{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeploymentTracesRequest_Options) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeploymentTracesRequest_Options) ProtoMessage() {}
+
+func (x *ListDeploymentTracesRequest_Options) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeploymentTracesRequest_Options.ProtoReflect.Descriptor instead.
+func (*ListDeploymentTracesRequest_Options) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{62, 0}
+}
+
+func (x *ListDeploymentTracesRequest_Options) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+type ListDeploymentTracesResponse_DeploymentTraceRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Trace       *model.DeploymentTrace `protobuf:"bytes,1,opt,name=trace,proto3" json:"trace,omitempty"`
+	Deployments []*model.Deployment    `protobuf:"bytes,2,rep,name=deployments,proto3" json:"deployments,omitempty"`
+}
+
+func (x *ListDeploymentTracesResponse_DeploymentTraceRes) Reset() {
+	*x = ListDeploymentTracesResponse_DeploymentTraceRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeploymentTracesResponse_DeploymentTraceRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeploymentTracesResponse_DeploymentTraceRes) ProtoMessage() {}
+
+func (x *ListDeploymentTracesResponse_DeploymentTraceRes) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeploymentTracesResponse_DeploymentTraceRes.ProtoReflect.Descriptor instead.
+func (*ListDeploymentTracesResponse_DeploymentTraceRes) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{63, 0}
+}
+
+func (x *ListDeploymentTracesResponse_DeploymentTraceRes) GetTrace() *model.DeploymentTrace {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+func (x *ListDeploymentTracesResponse_DeploymentTraceRes) GetDeployments() []*model.Deployment {
+	if x != nil {
+		return x.Deployments
+	}
+	return nil
+}
+
+type ListAPIKeysRequest_Options struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled *wrapperspb.BoolValue `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *ListAPIKeysRequest_Options) Reset() {
+	*x = ListAPIKeysRequest_Options{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAPIKeysRequest_Options) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysRequest_Options) ProtoMessage() {}
+
+func (x *ListAPIKeysRequest_Options) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysRequest_Options.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysRequest_Options) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{118, 0}
+}
+
+func (x *ListAPIKeysRequest_Options) GetEnabled() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Enabled
+	}
+	return nil
+}
+
+type ListDeploymentChainsRequest_Options struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListDeploymentChainsRequest_Options) Reset() {
+	*x = ListDeploymentChainsRequest_Options{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeploymentChainsRequest_Options) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeploymentChainsRequest_Options) ProtoMessage() {}
+
+func (x *ListDeploymentChainsRequest_Options) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeploymentChainsRequest_Options.ProtoReflect.Descriptor instead.
+func (*ListDeploymentChainsRequest_Options) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{124, 0}
+}
+
+type ListEventsRequest_Options struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Statuses []model.EventStatus `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=model.EventStatus" json:"statuses,omitempty"`
+	Labels   map[string]string   `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ListEventsRequest_Options) Reset() {
+	*x = ListEventsRequest_Options{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEventsRequest_Options) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsRequest_Options) ProtoMessage() {}
+
+func (x *ListEventsRequest_Options) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_app_server_service_webservice_service_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsRequest_Options.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest_Options) Descriptor() ([]byte, []int) {
+	return file_pkg_app_server_service_webservice_service_proto_rawDescGZIP(), []int{130, 0}
+}
+
+func (x *ListEventsRequest_Options) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListEventsRequest_Options) GetStatuses() []model.EventStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListEventsRequest_Options) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+var File_pkg_app_server_service_webservice_service_proto protoreflect.FileDescriptor
+
+var file_pkg_app_server_service_webservice_service_proto_rawDesc = []byte{
+	0x0a, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x17, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x70, 0x6b, 0x67,
+	0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x26, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x61, 0x70, 0x70,
 	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x74,
 	0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x70, 0x6b, 0x67, 0x2f, 0x6d,
@@ -5835,146 +7378,557 @@ var file_pkg_app_server_service_webservice_service_proto_rawDesc = []byte{
 	0x79, 0x22, 0x38, 0x0a, 0x17, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
 	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x15, 0x47,
-	0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
-	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x22, 0x4e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34,
-	0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x92, 0x01, 0x0a, 0x26, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x22, 0xc0, 0x01, 0x0a, 0x25,
+	0x53, 0x79, 0x6e, 0x63, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x79, 0x70, 0x61, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x0d, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x52, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12,
+	0x2d, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x47,
+	0x0a, 0x26, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2e, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x22, 0x4e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x92, 0x01, 0x0a, 0x26, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x08, 0x70,
+	0x69, 0x70, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x70, 0x69, 0x70, 0x65, 0x64, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x0f,
+	0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x46, 0x0a, 0x27, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
 	0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x61, 0x6c,
-	0x65, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x22, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x70, 0x69, 0x70, 0x65,
-	0x64, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x27, 0x0a, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64,
-	0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x61, 0x73, 0x65, 0x36,
-	0x34, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x46, 0x0a, 0x27, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x25, 0x0a, 0x23, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x65, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x62, 0x0a, 0x24, 0x4c, 0x69, 0x73, 0x74,
-	0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x41, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3a, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb0, 0x04, 0x0a,
-	0x16, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
-	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
-	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
-	0x2d, 0x0a, 0x13, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70, 0x61,
-	0x67, 0x65, 0x4d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0xde,
-	0x02, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12,
-	0x2c, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x16,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x27, 0x0a,
-	0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x5b, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x43, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39,
-	0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22,
-	0x66, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x64, 0x65,
-	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x44, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x65,
-	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x2c, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
-	0x0c, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x4a, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x64,
-	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xae, 0x01, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x25, 0x0a,
+	0x23, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x62, 0x0a, 0x24, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0c,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb0, 0x04, 0x0a, 0x16, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x13, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70, 0x61, 0x67, 0x65, 0x4d, 0x69,
+	0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0xde, 0x02, 0x0a, 0x07, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x6b,
+	0x69, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69,
+	0x6e, 0x64, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x5b, 0x0a,
+	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0x66, 0x0a, 0x17, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b,
+	0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x22, 0x44, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0d, 0x64,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x64, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x4a, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xc8, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x67, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0d,
+	0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x64, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x08, 0x73, 0x74,
+	0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x73, 0x74, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65,
+	0x22, 0x5c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x4c, 0x6f, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xf6,
+	0x01, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x6f,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x0e, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x17, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x02, 0x74, 0x6f, 0x12,
+	0x41, 0x0a, 0x0a, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4c, 0x6f, 0x67, 0x53,
+	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x42, 0x0d, 0xfa, 0x42, 0x0a, 0x92, 0x01, 0x07, 0x22,
+	0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x81, 0x02, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x1a, 0x93, 0x01, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x23, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4c, 0x6f, 0x67, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x9a, 0x01, 0x0a, 0x17,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x72,
+	0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x2a, 0x0a, 0x11,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x4e, 0x6f,
+	0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x39, 0x0a, 0x18, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x49, 0x64, 0x22, 0x64, 0x0a, 0x10, 0x53, 0x6b, 0x69, 0x70, 0x53, 0x74, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x07, 0x73, 0x74, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x11, 0x53, 0x6b, 0x69,
+	0x70, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x22, 0x65, 0x0a,
+	0x11, 0x52, 0x65, 0x74, 0x72, 0x79, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x22, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x73, 0x74, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x12, 0x52, 0x65, 0x74, 0x72, 0x79, 0x53, 0x74, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x22, 0x67, 0x0a, 0x13, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x2c, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
 	0x52, 0x0c, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22,
 	0x0a, 0x08, 0x73, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x73, 0x74, 0x61, 0x67, 0x65,
-	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x64, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x69,
-	0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6f,
-	0x66, 0x66, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x5c, 0x0a, 0x13, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x27, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4c, 0x6f, 0x67, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
-	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63,
-	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x9a, 0x01, 0x0a, 0x17, 0x43, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x6f, 0x6c, 0x6c,
-	0x62, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x63,
-	0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x6f, 0x72,
-	0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x52, 0x6f, 0x6c,
-	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x39, 0x0a, 0x18, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44,
-	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64,
-	0x22, 0x64, 0x0a, 0x10, 0x53, 0x6b, 0x69, 0x70, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x22, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x73,
-	0x74, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x11, 0x53, 0x6b, 0x69, 0x70, 0x53, 0x74,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x22, 0x67, 0x0a, 0x13, 0x41, 0x70,
-	0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x2c, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
-	0x01, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
-	0x22, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x73, 0x74, 0x61, 0x67,
-	0x65, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x74,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x22, 0x85, 0x02, 0x0a, 0x1b, 0x4c,
-	0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x07, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x67, 0x72,
+	0x49, 0x64, 0x22, 0x35, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x22, 0x85, 0x02, 0x0a, 0x1b, 0x4c, 0x69,
+	0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x13, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6d,
+	0x69, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x70, 0x61, 0x67, 0x65, 0x4d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x2a, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73,
+	0x68, 0x22, 0xa6, 0x02, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x60, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x48, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x52, 0x06, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x1a, 0x8b, 0x01, 0x0a,
+	0x12, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a,
+	0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x64,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x0b, 0x64,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x50, 0x0a, 0x1e, 0x47, 0x65,
+	0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x76, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x0e,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0d, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x62, 0x0a, 0x1f,
+	0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3f, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x6b, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x22, 0x22, 0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x53, 0x4f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x03, 0x73, 0x73, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x53, 0x53, 0x4f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x03, 0x73, 0x73, 0x6f, 0x22, 0x20, 0x0a, 0x1e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x53, 0x4f,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58,
+	0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x42, 0x41, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x36, 0x0a, 0x04, 0x72, 0x62, 0x61, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42,
+	0x41, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02,
+	0x10, 0x01, 0x52, 0x04, 0x72, 0x62, 0x61, 0x63, 0x22, 0x21, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x63, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x1c, 0x0a, 0x1a, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x63, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x0e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x6d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x76,
+	0x61, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0x78,
+	0x0a, 0x19, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x08,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x41, 0x64, 0x64, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7b, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x69, 0x65, 0x73, 0x22, 0x1f, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x5f, 0x0a, 0x1a, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x24, 0x0a, 0x09, 0x73, 0x73, 0x6f, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x73, 0x73,
+	0x6f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1b, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x45, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x73, 0x73, 0x6f, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x08, 0x73, 0x73, 0x6f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x20, 0x0a, 0x1e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6a, 0x0a, 0x27, 0x41,
+	0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x2a, 0x0a, 0x28, 0x41, 0x64, 0x64, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x49, 0x0a, 0x2a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x72,
+	0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2d,
+	0x0a, 0x2b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x0a,
+	0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x22, 0x7d, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x23, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x22, 0x17, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x0a, 0x17, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5f, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x23, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x6f,
+	0x74, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x73, 0x65, 0x74, 0x54,
+	0x6f, 0x74, 0x70, 0x22, 0x21, 0x0a, 0x1f, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x1f, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x10, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0f, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2a,
+	0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0b, 0x6e,
+	0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x22, 0x0a, 0x20, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25,
+	0x0a, 0x23, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63,
+	0x55, 0x73, 0x65, 0x72, 0x54, 0x4f, 0x54, 0x50, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x50, 0x0a, 0x24, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x54, 0x4f, 0x54, 0x50, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x88, 0x01, 0x0a, 0x1b, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x54, 0x4f, 0x54, 0x50,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x23, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x63, 0x6f,
+	0x64, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x43, 0x0a, 0x1c, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x44, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x54, 0x4f, 0x54, 0x50,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x49, 0x64, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x66, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2e, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x2a, 0x0a,
+	0x16, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x2f, 0x0a, 0x14, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b,
+	0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x67, 0x72,
 	0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3f, 0x0a, 0x07, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x38, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x21, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x04,
+	0x6b, 0x65, 0x79, 0x73, 0x22, 0xa5, 0x03, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x69,
+	0x67, 0x68, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46,
+	0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x73,
+	0x69, 0x67, 0x68, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x4b, 0x69, 0x6e, 0x64, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0b, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x26, 0x0a, 0x0a, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22,
+	0x02, 0x20, 0x00, 0x52, 0x09, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x22,
+	0x0a, 0x08, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x54, 0x6f, 0x12, 0x42, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49,
+	0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x52, 0x0a,
+	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x69, 0x67,
+	0x68, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc7, 0x01, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x73,
+	0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x73,
+	0x69, 0x67, 0x68, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x32, 0x0a, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x73, 0x69, 0x67,
+	0x68, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x06,
+	0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x22, 0x23, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73,
+	0x69, 0x67, 0x68, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7b, 0x0a, 0x22, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x36, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0xe4, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x13, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x69,
+	0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x70, 0x61, 0x67, 0x65, 0x4d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x1a, 0x09, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x7b, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x43, 0x0a, 0x11, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x52, 0x10, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x54, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x13, 0x64, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x11, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x64, 0x22, 0x5f, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x41, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x22, 0x57, 0x0a, 0x1c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x13, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x11, 0x64, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x1d,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x73, 0x22, 0xa8,
+	0x03, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
 	0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
@@ -5982,453 +7936,210 @@ var file_pkg_app_server_service_webservice_service_proto_rawDesc = []byte{
 	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x13, 0x70, 0x61, 0x67, 0x65, 0x5f,
 	0x6d, 0x69, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04,
 	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70, 0x61, 0x67, 0x65, 0x4d, 0x69, 0x6e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x2a, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61,
-	0x73, 0x68, 0x22, 0xa6, 0x02, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x48, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x63,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x52, 0x06, 0x74,
-	0x72, 0x61, 0x63, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x1a, 0x8b, 0x01,
-	0x0a, 0x12, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44, 0x65, 0x70, 0x6c,
-	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05,
-	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0b,
-	0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x0b,
-	0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x50, 0x0a, 0x1e, 0x47,
-	0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x76,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a,
-	0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0d,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x62, 0x0a,
-	0x1f, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
-	0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3f, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x6b, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23,
-	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x22, 0x22, 0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x53, 0x4f, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x03, 0x73, 0x73, 0x6f, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x53, 0x4f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x08,
-	0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x03, 0x73, 0x73, 0x6f, 0x22, 0x20, 0x0a,
-	0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x53,
-	0x4f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x58, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x52, 0x42, 0x41, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x36, 0x0a, 0x04, 0x72, 0x62, 0x61, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
-	0x42, 0x41, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01,
-	0x02, 0x10, 0x01, 0x52, 0x04, 0x72, 0x62, 0x61, 0x63, 0x22, 0x21, 0x0a, 0x1f, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x0a, 0x18,
-	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x1c, 0x0a, 0x1a, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x69, 0x63, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x0e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x6d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
-	0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22,
-	0x78, 0x0a, 0x19, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41,
-	0x43, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
-	0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x70, 0x6f, 0x6c,
-	0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52,
-	0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x41, 0x64, 0x64,
-	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x52, 0x6f, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7b, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x52, 0x6f, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69,
-	0x63, 0x69, 0x65, 0x73, 0x22, 0x1f, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x5f, 0x0a, 0x1a, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x24, 0x0a, 0x09, 0x73, 0x73, 0x6f, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x73,
-	0x73, 0x6f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1b, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04,
-	0x72, 0x6f, 0x6c, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x45, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x73, 0x73, 0x6f, 0x5f, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
-	0x52, 0x08, 0x73, 0x73, 0x6f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x20, 0x0a, 0x1e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x55, 0x73, 0x65, 0x72, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09,
-	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x28, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x66, 0x0a, 0x15, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x30, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x2e, 0x52, 0x6f, 0x6c,
-	0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x72, 0x6f, 0x6c,
-	0x65, 0x22, 0x2a, 0x0a, 0x16, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49,
-	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x2f, 0x0a,
-	0x14, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17,
-	0x0a, 0x15, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
-	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d,
-	0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x33, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77,
-	0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50,
-	0x49, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3f, 0x0a,
-	0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x38,
-	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x50, 0x49, 0x4b,
-	0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0xa5, 0x03, 0x0a, 0x15, 0x47, 0x65, 0x74,
-	0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x46, 0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x6b, 0x69,
-	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x2e, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x4b,
-	0x69, 0x6e, 0x64, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0b, 0x6d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x26, 0x0a, 0x0a, 0x72, 0x61,
-	0x6e, 0x67, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
-	0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x72,
-	0x6f, 0x6d, 0x12, 0x22, 0x0a, 0x08, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07, 0x72,
-	0x61, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x12, 0x42, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a,
-	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x12, 0x52, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x3a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x49,
-	0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0xc7, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x2e, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x2e, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x06,
-	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x32, 0x0a, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49,
-	0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x52, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x22, 0x23, 0x0a, 0x21, 0x47, 0x65,
-	0x74, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x7b, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x41, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x49, 0x6e, 0x73,
-	0x69, 0x67, 0x68, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0xe4, 0x01, 0x0a,
-	0x1b, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43,
-	0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x07,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e,
-	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c,
-	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x13, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70, 0x61, 0x67, 0x65, 0x4d, 0x69, 0x6e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x09, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x22, 0x7b, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x11, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x10, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73,
-	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
-	0x22, 0x54, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a,
-	0x13, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
-	0x02, 0x10, 0x01, 0x52, 0x11, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43,
-	0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70,
-	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x22, 0xa8, 0x03, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a,
-	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32,
-	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65,
-	0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70,
-	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73,
-	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
-	0x12, 0x2d, 0x0a, 0x13, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70,
-	0x61, 0x67, 0x65, 0x4d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a,
-	0xe0, 0x01, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x2e, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0e, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12,
-	0x56, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x3e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77,
-	0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x52, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x32, 0xaf, 0x36, 0x0a, 0x0a, 0x57, 0x65, 0x62, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7c, 0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x50, 0x69, 0x70, 0x65, 0x64, 0x12, 0x2d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0xe0, 0x01, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x56, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x69, 0x70, 0x65, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39,
+	0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x52, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x24, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x32, 0x9e, 0x46,
+	0x0a, 0x0a, 0x57, 0x65, 0x62, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x78, 0x0a, 0x0d,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x69, 0x70, 0x65, 0x64, 0x12, 0x2d, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x50, 0x69, 0x70, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50,
+	0x69, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed,
+	0x1c, 0x04, 0x08, 0x04, 0x10, 0x03, 0x12, 0x72, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x69, 0x70, 0x65, 0x64, 0x12, 0x2b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x69, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x04, 0x9a, 0xed, 0x1c,
-	0x02, 0x10, 0x03, 0x12, 0x76, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70,
-	0x65, 0x64, 0x12, 0x2b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
 	0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77,
-	0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x69, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a,
-	0xed, 0x1c, 0x02, 0x08, 0x04, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x04, 0x12, 0x85, 0x01, 0x0a, 0x10,
-	0x52, 0x65, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x64, 0x4b, 0x65, 0x79,
-	0x12, 0x30, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x04, 0x9a, 0xed, 0x1c,
-	0x02, 0x10, 0x04, 0x12, 0x8b, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x6c,
-	0x64, 0x50, 0x69, 0x70, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x32, 0x2e, 0x67, 0x72, 0x70,
-	0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x6c, 0x64, 0x50, 0x69,
-	0x70, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33,
-	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65,
-	0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f,
-	0x6c, 0x64, 0x50, 0x69, 0x70, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x04, 0x9a, 0xed, 0x1c, 0x02, 0x10,
-	0x04, 0x12, 0x76, 0x0a, 0x0b, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x69, 0x70, 0x65, 0x64,
-	0x12, 0x2b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x50, 0x69, 0x70, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
-	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x69,
-	0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c,
-	0x02, 0x08, 0x04, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x04, 0x12, 0x79, 0x0a, 0x0c, 0x44, 0x69, 0x73,
-	0x61, 0x62, 0x6c, 0x65, 0x50, 0x69, 0x70, 0x65, 0x64, 0x12, 0x2c, 0x2e, 0x67, 0x72, 0x70, 0x63,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x69, 0x70, 0x65, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x69, 0x70, 0x65, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x04, 0x9a, 0xed,
-	0x1c, 0x02, 0x10, 0x04, 0x12, 0x73, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x70, 0x65,
-	0x64, 0x73, 0x12, 0x2a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x69, 0x70, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
-	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65,
-	0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x70,
-	0x65, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c,
-	0x02, 0x08, 0x04, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x02, 0x12, 0x6d, 0x0a, 0x08, 0x47, 0x65, 0x74,
-	0x50, 0x69, 0x70, 0x65, 0x64, 0x12, 0x28, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x10, 0x04, 0x08, 0x04, 0x12, 0x81, 0x01, 0x0a, 0x10, 0x52,
+	0x65, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x12,
+	0x30, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77,
+	0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x31, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x08, 0x04, 0x10, 0x04, 0x12, 0x87,
+	0x01, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x6c, 0x64, 0x50, 0x69, 0x70, 0x65,
+	0x64, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x32, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x29, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77,
-	0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70,
-	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02,
-	0x08, 0x04, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x01, 0x12, 0xa0, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x64, 0x44, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x64, 0x44, 0x65, 0x73, 0x69,
-	0x72, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x3a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x64, 0x44, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a,
-	0xed, 0x1c, 0x02, 0x08, 0x04, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x04, 0x12, 0x79, 0x0a, 0x0c, 0x52,
-	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x69, 0x70, 0x65, 0x64, 0x12, 0x2c, 0x2e, 0x67, 0x72,
-	0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x69, 0x70,
-	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x6c, 0x64, 0x50, 0x69, 0x70, 0x65, 0x64, 0x4b, 0x65,
+	0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x67, 0x72, 0x70, 0x63,
 	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x69, 0x70, 0x65, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x04,
-	0x9a, 0xed, 0x1c, 0x02, 0x10, 0x04, 0x12, 0x91, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x34, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77,
-	0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed,
-	0x1c, 0x02, 0x08, 0x04, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x02, 0x12, 0x8e, 0x01, 0x0a, 0x13, 0x4c,
-	0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74,
-	0x65, 0x73, 0x12, 0x33, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73,
+	0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x6c, 0x64, 0x50, 0x69, 0x70,
+	0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08,
+	0x9a, 0xed, 0x1c, 0x04, 0x08, 0x04, 0x10, 0x04, 0x12, 0x72, 0x0a, 0x0b, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x50, 0x69, 0x70, 0x65, 0x64, 0x12, 0x2b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
-	0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a,
-	0xed, 0x1c, 0x02, 0x08, 0x04, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x02, 0x12, 0x7f, 0x0a, 0x0e, 0x41,
-	0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e,
+	0x65, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x69, 0x70, 0x65, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x69, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x08, 0x04, 0x10, 0x04, 0x12, 0x75, 0x0a, 0x0c,
+	0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x69, 0x70, 0x65, 0x64, 0x12, 0x2c, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x69,
+	0x70, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x69, 0x70, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x08,
+	0x04, 0x10, 0x04, 0x12, 0x6f, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x70, 0x65, 0x64,
+	0x73, 0x12, 0x2a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x69, 0x70, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
 	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x70, 0x65,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04,
+	0x10, 0x02, 0x08, 0x04, 0x12, 0x69, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x64,
+	0x12, 0x28, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69,
+	0x70, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x08, 0x04, 0x10, 0x01, 0x12,
+	0x9c, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x64, 0x44,
+	0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x2e,
 	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c,
-	0x9a, 0xed, 0x1c, 0x02, 0x08, 0x01, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x03, 0x12, 0x88, 0x01, 0x0a,
-	0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x31, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69,
+	0x70, 0x65, 0x64, 0x44, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x64, 0x44, 0x65,
+	0x73, 0x69, 0x72, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x10, 0x04, 0x08, 0x04, 0x12, 0x75,
+	0x0a, 0x0c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x69, 0x70, 0x65, 0x64, 0x12, 0x2c,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x50, 0x69, 0x70, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x69,
+	0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c,
+	0x04, 0x08, 0x04, 0x10, 0x04, 0x12, 0x8d, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c,
+	0x04, 0x08, 0x04, 0x10, 0x02, 0x12, 0x8a, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65,
+	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x33, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x34, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x10, 0x02,
+	0x08, 0x04, 0x12, 0x7b, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41,
+	0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41,
+	0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x08, 0x01, 0x10, 0x03, 0x12,
+	0x84, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
 	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08,
-	0x01, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x04, 0x12, 0x88, 0x01, 0x0a, 0x11, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x2e,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed,
+	0x1c, 0x04, 0x08, 0x01, 0x10, 0x04, 0x12, 0x84, 0x01, 0x0a, 0x11, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x32, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77,
+	0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x08, 0x01, 0x10, 0x04, 0x12, 0x87, 0x01,
+	0x0a, 0x12, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a,
+	0xed, 0x1c, 0x04, 0x08, 0x01, 0x10, 0x04, 0x12, 0x84, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x2e,
 	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x70,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70,
 	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x32, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
 	0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x01, 0x9a, 0xed, 0x1c, 0x02,
-	0x10, 0x04, 0x12, 0x8b, 0x01, 0x0a, 0x12, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x2e, 0x67, 0x72, 0x70, 0x63,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e,
-	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x01, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x04,
-	0x12, 0x88, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x67, 0x72, 0x70, 0x63,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a,
-	0xed, 0x1c, 0x02, 0x08, 0x01, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x05, 0x12, 0x85, 0x01, 0x0a, 0x10,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x10, 0x05, 0x08, 0x01, 0x12, 0x81,
+	0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x30, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
 	0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x30, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x01, 0x9a, 0xed, 0x1c,
-	0x02, 0x10, 0x02, 0x12, 0x82, 0x01, 0x0a, 0x0f, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02,
-	0x08, 0x01, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x04, 0x12, 0x7f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x67, 0x72, 0x70,
-	0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x67, 0x72, 0x70,
-	0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c,
-	0x02, 0x08, 0x01, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x01, 0x12, 0xb2, 0x01, 0x0a, 0x1f, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x3f, 0x2e,
-	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x61, 0x6c, 0x65,
-	0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40,
-	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65,
-	0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x61, 0x6c,
-	0x65, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x01, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x04, 0x12, 0xa9,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x08, 0x01,
+	0x10, 0x02, 0x12, 0x7e, 0x0a, 0x0f, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x53, 0x79, 0x6e, 0x63, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x08, 0x01,
+	0x10, 0x04, 0x12, 0xab, 0x01, 0x0a, 0x1e, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x46,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x12, 0x3e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x53, 0x79, 0x6e, 0x63, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x79, 0x70, 0x61, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x53, 0x79, 0x6e, 0x63, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x79, 0x70, 0x61, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x08, 0x06, 0x10, 0x04,
+	0x12, 0x7b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x10, 0x01, 0x08, 0x01, 0x12, 0xae, 0x01,
+	0x0a, 0x1f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x12, 0x3f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x61, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x40, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x08, 0x01, 0x10, 0x04, 0x12, 0xa5,
 	0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
 	0x72, 0x65, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
 	0x3c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77,
@@ -6438,64 +8149,77 @@ var file_pkg_app_server_service_webservice_service_proto_rawDesc = []byte{
 	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x72, 0x65,
 	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed,
-	0x1c, 0x02, 0x08, 0x01, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x02, 0x12, 0x82, 0x01, 0x0a, 0x0f, 0x4c,
-	0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2f,
-	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65,
-	0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70,
-	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x30, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77,
-	0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65,
-	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x02, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x02, 0x12,
-	0x7c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x2d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65,
-	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77,
-	0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70,
-	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x02, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x01, 0x12, 0x76, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x12, 0x2b, 0x2e, 0x67,
-	0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4c,
-	0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed,
+	0x1c, 0x04, 0x08, 0x01, 0x10, 0x02, 0x12, 0x7e, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2f, 0x2e, 0x67, 0x72, 0x70, 0x63,
 	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x02, 0x9a,
-	0xed, 0x1c, 0x02, 0x10, 0x01, 0x12, 0x85, 0x01, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x2e, 0x67, 0x72, 0x70,
+	0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x67, 0x72, 0x70,
 	0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x70, 0x6c, 0x6f,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x67,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed,
+	0x1c, 0x04, 0x08, 0x02, 0x10, 0x02, 0x12, 0x78, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x08, 0x02, 0x10, 0x01,
+	0x12, 0x72, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x12,
+	0x2b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77,
+	0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x67, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x67,
 	0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x70,
-	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x02, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x04, 0x12, 0x70, 0x0a,
-	0x09, 0x53, 0x6b, 0x69, 0x70, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x29, 0x2e, 0x67, 0x72, 0x70,
-	0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x6b, 0x69, 0x70, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4c,
+	0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04,
+	0x08, 0x02, 0x10, 0x01, 0x12, 0x7e, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74,
+	0x61, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x2f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x6f,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04,
+	0x08, 0x02, 0x10, 0x01, 0x12, 0x81, 0x01, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08,
+	0x9a, 0xed, 0x1c, 0x04, 0x08, 0x02, 0x10, 0x04, 0x12, 0x6c, 0x0a, 0x09, 0x53, 0x6b, 0x69, 0x70,
+	0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x29, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x53, 0x6b, 0x69, 0x70, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x6b, 0x69, 0x70, 0x53,
+	0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed,
+	0x1c, 0x04, 0x10, 0x04, 0x08, 0x02, 0x12, 0x6f, 0x0a, 0x0a, 0x52, 0x65, 0x74, 0x72, 0x79, 0x53,
+	0x74, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52,
+	0x65, 0x74, 0x72, 0x79, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a,
+	0xed, 0x1c, 0x04, 0x10, 0x04, 0x08, 0x02, 0x12, 0x75, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x53, 0x6b, 0x69, 0x70, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x02, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x04, 0x12,
-	0x79, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12,
-	0x2c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77,
-	0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
-	0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x08, 0x02, 0x10, 0x04, 0x12, 0x8d,
+	0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x12, 0x34, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e,
 	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53,
-	0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed,
-	0x1c, 0x02, 0x08, 0x02, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x04, 0x12, 0x91, 0x01, 0x0a, 0x14, 0x4c,
-	0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61,
-	0x63, 0x65, 0x73, 0x12, 0x34, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x63,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x67, 0x72, 0x70, 0x63,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x02, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x02, 0x12, 0x9a,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x10, 0x02, 0x08, 0x02, 0x12, 0x96,
 	0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x37, 0x2e, 0x67, 0x72, 0x70,
 	0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72,
@@ -6504,34 +8228,33 @@ var file_pkg_app_server_service_webservice_service_proto_rawDesc = []byte{
 	0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
 	0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
 	0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x76, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a,
-	0xed, 0x1c, 0x02, 0x08, 0x01, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x01, 0x12, 0x73, 0x0a, 0x0a, 0x47,
-	0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2a, 0x2e, 0x67, 0x72, 0x70, 0x63,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a,
+	0xed, 0x1c, 0x04, 0x08, 0x01, 0x10, 0x01, 0x12, 0x6f, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x06, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x01,
-	0x12, 0x9d, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x38, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08,
+	0x9a, 0xed, 0x1c, 0x04, 0x08, 0x06, 0x10, 0x01, 0x12, 0x99, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x38, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x63, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x39, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77,
+	0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04,
+	0x10, 0x04, 0x08, 0x06, 0x12, 0x84, 0x01, 0x0a, 0x11, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x63, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x31, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x63, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e,
 	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x69, 0x63, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x06, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x04,
-	0x12, 0x88, 0x01, 0x0a, 0x11, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69,
-	0x63, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x31, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x67, 0x72, 0x70, 0x63,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a,
-	0xed, 0x1c, 0x02, 0x08, 0x06, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x04, 0x12, 0x8b, 0x01, 0x0a, 0x12,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x63, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x10, 0x04, 0x08, 0x06, 0x12, 0x87, 0x01, 0x0a, 0x12,
 	0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x41, 0x64, 0x6d,
 	0x69, 0x6e, 0x12, 0x32, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
 	0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x69, 0x73,
@@ -6539,34 +8262,33 @@ var file_pkg_app_server_service_webservice_service_proto_rawDesc = []byte{
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
 	0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c,
-	0x02, 0x08, 0x06, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x04, 0x12, 0x97, 0x01, 0x0a, 0x16, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x53, 0x4f, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x36, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x53, 0x4f, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x67,
-	0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x53, 0x53, 0x4f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x06, 0x9a, 0xed, 0x1c,
-	0x02, 0x10, 0x04, 0x12, 0x9a, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x37, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77,
-	0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c,
+	0x04, 0x08, 0x06, 0x10, 0x04, 0x12, 0x93, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x53, 0x4f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x36, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x53, 0x4f, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69,
 	0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x52, 0x42, 0x41, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x06, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x04,
-	0x12, 0x64, 0x0a, 0x05, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x12, 0x25, 0x2e, 0x67, 0x72, 0x70, 0x63,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x26, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x06,
-	0x9a, 0xed, 0x1c, 0x02, 0x10, 0x01, 0x12, 0x8b, 0x01, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x50, 0x72,
+	0x53, 0x53, 0x4f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x08, 0x06, 0x10, 0x04, 0x12, 0x96, 0x01, 0x0a, 0x17,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41,
+	0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x37, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x42, 0x41, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x38, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04,
+	0x10, 0x04, 0x08, 0x06, 0x12, 0x60, 0x0a, 0x05, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x12, 0x25, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed,
+	0x1c, 0x04, 0x08, 0x06, 0x10, 0x01, 0x12, 0x87, 0x01, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x50, 0x72,
 	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x32, 0x2e,
 	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65,
@@ -6574,36 +8296,35 @@ var file_pkg_app_server_service_webservice_service_proto_rawDesc = []byte{
 	0x74, 0x1a, 0x33, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
 	0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x50,
 	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x06, 0x9a, 0xed,
-	0x1c, 0x02, 0x10, 0x04, 0x12, 0x94, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x35,
-	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65,
-	0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41,
-	0x43, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a,
-	0xed, 0x1c, 0x02, 0x08, 0x06, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x04, 0x12, 0x94, 0x01, 0x0a, 0x15,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41,
-	0x43, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x35, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41,
-	0x43, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x67,
-	0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x06, 0x9a, 0xed, 0x1c, 0x02,
-	0x10, 0x04, 0x12, 0x8e, 0x01, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x33, 0x2e, 0x67, 0x72, 0x70,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x10, 0x04, 0x08, 0x06,
+	0x12, 0x90, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x35, 0x2e, 0x67, 0x72, 0x70,
 	0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x34, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77,
-	0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x06, 0x9a, 0xed, 0x1c,
-	0x02, 0x10, 0x04, 0x12, 0x97, 0x01, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x36, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x08,
+	0x06, 0x10, 0x04, 0x12, 0x90, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x35, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x42, 0x41, 0x43,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed,
+	0x1c, 0x04, 0x08, 0x06, 0x10, 0x04, 0x12, 0x8a, 0x01, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x33,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64,
+	0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x08,
+	0x06, 0x10, 0x04, 0x12, 0x93, 0x01, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72,
 	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x36,
 	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65,
 	0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
@@ -6612,84 +8333,196 @@ var file_pkg_app_server_service_webservice_service_proto_rawDesc = []byte{
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
 	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x55, 0x73,
 	0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x06, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x04, 0x12, 0x6d, 0x0a,
-	0x0a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x2a, 0x2e, 0x67, 0x72,
+	0x08, 0x9a, 0xed, 0x1c, 0x04, 0x10, 0x04, 0x08, 0x06, 0x12, 0xb1, 0x01, 0x0a, 0x20, 0x41, 0x64,
+	0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x40,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x65,
+	0x65, 0x7a, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x41, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x08, 0x06, 0x10, 0x04, 0x12, 0xba, 0x01,
+	0x0a, 0x23, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x43, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x44, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x65,
+	0x7a, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x08, 0x06, 0x10, 0x04, 0x12, 0x7e, 0x0a, 0x0f, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x08, 0x06, 0x10, 0x01, 0x12, 0x78, 0x0a, 0x0d, 0x41, 0x64,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2d, 0x2e, 0x67, 0x72,
 	0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04,
+	0x10, 0x04, 0x08, 0x06, 0x12, 0x81, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x12, 0x30, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08,
+	0x9a, 0xed, 0x1c, 0x04, 0x10, 0x04, 0x08, 0x06, 0x12, 0x8d, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c,
+	0x65, 0x12, 0x34, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x9a, 0xed, 0x1c, 0x02, 0x18, 0x01, 0x12, 0x7f, 0x0a, 0x0e,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x2e,
+	0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08,
+	0x9a, 0xed, 0x1c, 0x04, 0x08, 0x06, 0x10, 0x04, 0x12, 0x96, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x37, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x08, 0x06, 0x10,
+	0x04, 0x12, 0x99, 0x01, 0x0a, 0x18, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x38,
 	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65,
-	0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55,
+	0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x08, 0x06, 0x10, 0x01, 0x12, 0xa5, 0x01,
+	0x0a, 0x1c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63,
+	0x55, 0x73, 0x65, 0x72, 0x54, 0x4f, 0x54, 0x50, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x3c,
 	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65,
 	0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x07, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x03, 0x12, 0x7c, 0x0a,
-	0x0d, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x2d,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x54, 0x4f, 0x54, 0x50, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x54, 0x4f, 0x54, 0x50, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c,
+	0x04, 0x10, 0x01, 0x08, 0x06, 0x12, 0x8d, 0x01, 0x0a, 0x14, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x34,
 	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65,
-	0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
-	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x54,
+	0x4f, 0x54, 0x50, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c,
+	0x04, 0x08, 0x06, 0x10, 0x01, 0x12, 0x90, 0x01, 0x0a, 0x15, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x54, 0x4f, 0x54, 0x50, 0x12,
+	0x35, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77,
+	0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x54, 0x4f, 0x54, 0x50, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x55, 0x73,
+	0x65, 0x72, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08,
+	0x9a, 0xed, 0x1c, 0x04, 0x08, 0x06, 0x10, 0x01, 0x12, 0x6d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x2a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x06, 0x9a, 0xed, 0x1c, 0x02, 0x18, 0x01, 0x12, 0x7b, 0x0a, 0x0e, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04,
+	0x08, 0x07, 0x10, 0x03, 0x12, 0x78, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41,
+	0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x2d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x08, 0x07, 0x10, 0x04, 0x12, 0x72,
+	0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x2b, 0x2e,
 	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41,
-	0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a,
-	0xed, 0x1c, 0x02, 0x08, 0x07, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x04, 0x12, 0x76, 0x0a, 0x0b, 0x4c,
-	0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x2b, 0x2e, 0x67, 0x72, 0x70,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b,
+	0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x67, 0x72, 0x70,
 	0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x07, 0x9a, 0xed, 0x1c,
-	0x02, 0x10, 0x02, 0x12, 0x7f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68,
-	0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02, 0x08, 0x08, 0x9a, 0xed,
-	0x1c, 0x02, 0x10, 0x01, 0x12, 0xa3, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x69,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x08, 0x07,
+	0x10, 0x02, 0x12, 0x7b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x10, 0x01, 0x08, 0x08, 0x12,
+	0x9f, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3a,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x69,
 	0x67, 0x68, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x3a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x10, 0x01, 0x08,
+	0x08, 0x12, 0x85, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x34, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x35, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12,
+	0x32, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77,
+	0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
 	0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
-	0x74, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x3b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77,
-	0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73,
-	0x69, 0x67, 0x68, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed,
-	0x1c, 0x02, 0x08, 0x08, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x01, 0x12, 0x85, 0x01, 0x0a, 0x14, 0x4c,
-	0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x73, 0x12, 0x34, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x67, 0x72, 0x70, 0x63,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x7f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x32, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x67,
-	0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x2a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
-	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x9a, 0xed, 0x1c, 0x02,
-	0x08, 0x03, 0x9a, 0xed, 0x1c, 0x02, 0x10, 0x02, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x2d, 0x63, 0x64, 0x2f, 0x70,
-	0x69, 0x70, 0x65, 0x63, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x77, 0x65, 0x62,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x90, 0x01, 0x0a, 0x15, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x12, 0x35, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x08, 0x05, 0x10, 0x04, 0x12, 0x6f, 0x0a,
+	0x0a, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2a, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x08, 0x9a, 0xed, 0x1c, 0x04, 0x08, 0x03, 0x10, 0x02, 0x42, 0x3d,
+	0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x69, 0x70,
+	0x65, 0x2d, 0x63, 0x64, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x63, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x61, 0x70, 0x70, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2f, 0x77, 0x65, 0x62, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -6704,7 +8537,7 @@ func file_pkg_app_server_service_webservice_service_proto_rawDescGZIP() []byte {
 	return file_pkg_app_server_service_webservice_service_proto_rawDescData
 }
 
-var file_pkg_app_server_service_webservice_service_proto_msgTypes = make([]protoimpl.MessageInfo, 117)
+var file_pkg_app_server_service_webservice_service_proto_msgTypes = make([]protoimpl.MessageInfo, 148)
 var file_pkg_app_server_service_webservice_service_proto_goTypes = []interface{}{
 	(*RegisterPipedRequest)(nil),                            // 0: grpc.service.webservice.RegisterPipedRequest
 	(*RegisterPipedResponse)(nil),                           // 1: grpc.service.webservice.RegisterPipedResponse
@@ -6744,281 +8577,351 @@ var file_pkg_app_server_service_webservice_service_proto_goTypes = []interface{}
 	(*ListApplicationsResponse)(nil),                        // 35: grpc.service.webservice.ListApplicationsResponse
 	(*SyncApplicationRequest)(nil),                          // 36: grpc.service.webservice.SyncApplicationRequest
 	(*SyncApplicationResponse)(nil),                         // 37: grpc.service.webservice.SyncApplicationResponse
-	(*GetApplicationRequest)(nil),                           // 38: grpc.service.webservice.GetApplicationRequest
-	(*GetApplicationResponse)(nil),                          // 39: grpc.service.webservice.GetApplicationResponse
-	(*GenerateApplicationSealedSecretRequest)(nil),          // 40: grpc.service.webservice.GenerateApplicationSealedSecretRequest
-	(*GenerateApplicationSealedSecretResponse)(nil),         // 41: grpc.service.webservice.GenerateApplicationSealedSecretResponse
-	(*ListUnregisteredApplicationsRequest)(nil),             // 42: grpc.service.webservice.ListUnregisteredApplicationsRequest
-	(*ListUnregisteredApplicationsResponse)(nil),            // 43: grpc.service.webservice.ListUnregisteredApplicationsResponse
-	(*ListDeploymentsRequest)(nil),                          // 44: grpc.service.webservice.ListDeploymentsRequest
-	(*ListDeploymentsResponse)(nil),                         // 45: grpc.service.webservice.ListDeploymentsResponse
-	(*GetDeploymentRequest)(nil),                            // 46: grpc.service.webservice.GetDeploymentRequest
-	(*GetDeploymentResponse)(nil),                           // 47: grpc.service.webservice.GetDeploymentResponse
-	(*GetStageLogRequest)(nil),                              // 48: grpc.service.webservice.GetStageLogRequest
-	(*GetStageLogResponse)(nil),                             // 49: grpc.service.webservice.GetStageLogResponse
-	(*CancelDeploymentRequest)(nil),                         // 50: grpc.service.webservice.CancelDeploymentRequest
-	(*CancelDeploymentResponse)(nil),                        // 51: grpc.service.webservice.CancelDeploymentResponse
-	(*SkipStageRequest)(nil),                                // 52: grpc.service.webservice.SkipStageRequest
-	(*SkipStageResponse)(nil),                               // 53: grpc.service.webservice.SkipStageResponse
-	(*ApproveStageRequest)(nil),                             // 54: grpc.service.webservice.ApproveStageRequest
-	(*ApproveStageResponse)(nil),                            // 55: grpc.service.webservice.ApproveStageResponse
-	(*ListDeploymentTracesRequest)(nil),                     // 56: grpc.service.webservice.ListDeploymentTracesRequest
-	(*ListDeploymentTracesResponse)(nil),                    // 57: grpc.service.webservice.ListDeploymentTracesResponse
-	(*GetApplicationLiveStateRequest)(nil),                  // 58: grpc.service.webservice.GetApplicationLiveStateRequest
-	(*GetApplicationLiveStateResponse)(nil),                 // 59: grpc.service.webservice.GetApplicationLiveStateResponse
-	(*GetProjectRequest)(nil),                               // 60: grpc.service.webservice.GetProjectRequest
-	(*GetProjectResponse)(nil),                              // 61: grpc.service.webservice.GetProjectResponse
-	(*UpdateProjectStaticAdminRequest)(nil),                 // 62: grpc.service.webservice.UpdateProjectStaticAdminRequest
-	(*UpdateProjectStaticAdminResponse)(nil),                // 63: grpc.service.webservice.UpdateProjectStaticAdminResponse
-	(*UpdateProjectSSOConfigRequest)(nil),                   // 64: grpc.service.webservice.UpdateProjectSSOConfigRequest
-	(*UpdateProjectSSOConfigResponse)(nil),                  // 65: grpc.service.webservice.UpdateProjectSSOConfigResponse
-	(*UpdateProjectRBACConfigRequest)(nil),                  // 66: grpc.service.webservice.UpdateProjectRBACConfigRequest
-	(*UpdateProjectRBACConfigResponse)(nil),                 // 67: grpc.service.webservice.UpdateProjectRBACConfigResponse
-	(*EnableStaticAdminRequest)(nil),                        // 68: grpc.service.webservice.EnableStaticAdminRequest
-	(*EnableStaticAdminResponse)(nil),                       // 69: grpc.service.webservice.EnableStaticAdminResponse
-	(*DisableStaticAdminRequest)(nil),                       // 70: grpc.service.webservice.DisableStaticAdminRequest
-	(*DisableStaticAdminResponse)(nil),                      // 71: grpc.service.webservice.DisableStaticAdminResponse
-	(*GetMeRequest)(nil),                                    // 72: grpc.service.webservice.GetMeRequest
-	(*GetMeResponse)(nil),                                   // 73: grpc.service.webservice.GetMeResponse
-	(*AddProjectRBACRoleRequest)(nil),                       // 74: grpc.service.webservice.AddProjectRBACRoleRequest
-	(*AddProjectRBACRoleResponse)(nil),                      // 75: grpc.service.webservice.AddProjectRBACRoleResponse
-	(*UpdateProjectRBACRoleRequest)(nil),                    // 76: grpc.service.webservice.UpdateProjectRBACRoleRequest
-	(*UpdateProjectRBACRoleResponse)(nil),                   // 77: grpc.service.webservice.UpdateProjectRBACRoleResponse
-	(*DeleteProjectRBACRoleRequest)(nil),                    // 78: grpc.service.webservice.DeleteProjectRBACRoleRequest
-	(*DeleteProjectRBACRoleResponse)(nil),                   // 79: grpc.service.webservice.DeleteProjectRBACRoleResponse
-	(*AddProjectUserGroupRequest)(nil),                      // 80: grpc.service.webservice.AddProjectUserGroupRequest
-	(*AddProjectUserGroupResponse)(nil),                     // 81: grpc.service.webservice.AddProjectUserGroupResponse
-	(*DeleteProjectUserGroupRequest)(nil),                   // 82: grpc.service.webservice.DeleteProjectUserGroupRequest
-	(*DeleteProjectUserGroupResponse)(nil),                  // 83: grpc.service.webservice.DeleteProjectUserGroupResponse
-	(*GetCommandRequest)(nil),                               // 84: grpc.service.webservice.GetCommandRequest
-	(*GetCommandResponse)(nil),                              // 85: grpc.service.webservice.GetCommandResponse
-	(*GenerateAPIKeyRequest)(nil),                           // 86: grpc.service.webservice.GenerateAPIKeyRequest
-	(*GenerateAPIKeyResponse)(nil),                          // 87: grpc.service.webservice.GenerateAPIKeyResponse
-	(*DisableAPIKeyRequest)(nil),                            // 88: grpc.service.webservice.DisableAPIKeyRequest
-	(*DisableAPIKeyResponse)(nil),                           // 89: grpc.service.webservice.DisableAPIKeyResponse
-	(*ListAPIKeysRequest)(nil),                              // 90: grpc.service.webservice.ListAPIKeysRequest
-	(*ListAPIKeysResponse)(nil),                             // 91: grpc.service.webservice.ListAPIKeysResponse
-	(*GetInsightDataRequest)(nil),                           // 92: grpc.service.webservice.GetInsightDataRequest
-	(*GetInsightDataResponse)(nil),                          // 93: grpc.service.webservice.GetInsightDataResponse
-	(*GetInsightApplicationCountRequest)(nil),               // 94: grpc.service.webservice.GetInsightApplicationCountRequest
-	(*GetInsightApplicationCountResponse)(nil),              // 95: grpc.service.webservice.GetInsightApplicationCountResponse
-	(*ListDeploymentChainsRequest)(nil),                     // 96: grpc.service.webservice.ListDeploymentChainsRequest
-	(*ListDeploymentChainsResponse)(nil),                    // 97: grpc.service.webservice.ListDeploymentChainsResponse
-	(*GetDeploymentChainRequest)(nil),                       // 98: grpc.service.webservice.GetDeploymentChainRequest
-	(*GetDeploymentChainResponse)(nil),                      // 99: grpc.service.webservice.GetDeploymentChainResponse
-	(*ListEventsRequest)(nil),                               // 100: grpc.service.webservice.ListEventsRequest
-	(*ListEventsResponse)(nil),                              // 101: grpc.service.webservice.ListEventsResponse
-	(*ListPipedsRequest_Options)(nil),                       // 102: grpc.service.webservice.ListPipedsRequest.Options
-	nil,                                                     // 103: grpc.service.webservice.AddApplicationRequest.DeployTargetsByPluginEntry
-	nil,                                                     // 104: grpc.service.webservice.AddApplicationRequest.LabelsEntry
-	nil,                                                     // 105: grpc.service.webservice.UpdateApplicationRequest.DeployTargetsByPluginEntry
-	(*ListApplicationsRequest_Options)(nil),                 // 106: grpc.service.webservice.ListApplicationsRequest.Options
-	nil,                                                     // 107: grpc.service.webservice.ListApplicationsRequest.Options.LabelsEntry
-	(*ListDeploymentsRequest_Options)(nil),                  // 108: grpc.service.webservice.ListDeploymentsRequest.Options
-	nil,                                                     // 109: grpc.service.webservice.ListDeploymentsRequest.Options.LabelsEntry
-	(*ListDeploymentTracesRequest_Options)(nil),             // 110: grpc.service.webservice.ListDeploymentTracesRequest.Options
-	(*ListDeploymentTracesResponse_DeploymentTraceRes)(nil), // 111: grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes
-	(*ListAPIKeysRequest_Options)(nil),                      // 112: grpc.service.webservice.ListAPIKeysRequest.Options
-	nil,                                                     // 113: grpc.service.webservice.GetInsightDataRequest.LabelsEntry
-	(*ListDeploymentChainsRequest_Options)(nil),             // 114: grpc.service.webservice.ListDeploymentChainsRequest.Options
-	(*ListEventsRequest_Options)(nil),                       // 115: grpc.service.webservice.ListEventsRequest.Options
-	nil,                                                     // 116: grpc.service.webservice.ListEventsRequest.Options.LabelsEntry
-	(*model.Piped)(nil),                                     // 117: model.Piped
-	(*model.ApplicationGitPath)(nil),                        // 118: model.ApplicationGitPath
-	(model.ApplicationKind)(0),                              // 119: model.ApplicationKind
-	(*model.Application)(nil),                               // 120: model.Application
-	(model.SyncStrategy)(0),                                 // 121: model.SyncStrategy
-	(*model.ApplicationInfo)(nil),                           // 122: model.ApplicationInfo
-	(*model.Deployment)(nil),                                // 123: model.Deployment
-	(*model.LogBlock)(nil),                                  // 124: model.LogBlock
-	(*model.ApplicationLiveStateSnapshot)(nil),              // 125: model.ApplicationLiveStateSnapshot
-	(*model.Project)(nil),                                   // 126: model.Project
-	(*model.ProjectSSOConfig)(nil),                          // 127: model.ProjectSSOConfig
-	(*model.ProjectRBACConfig)(nil),                         // 128: model.ProjectRBACConfig
-	(*model.ProjectRBACPolicy)(nil),                         // 129: model.ProjectRBACPolicy
-	(*model.Command)(nil),                                   // 130: model.Command
-	(model.APIKey_Role)(0),                                  // 131: model.APIKey.Role
-	(*model.APIKey)(nil),                                    // 132: model.APIKey
-	(model.InsightMetricsKind)(0),                           // 133: model.InsightMetricsKind
-	(model.InsightResolution)(0),                            // 134: model.InsightResolution
-	(model.InsightResultType)(0),                            // 135: model.InsightResultType
-	(*model.InsightSample)(nil),                             // 136: model.InsightSample
-	(*model.InsightSampleStream)(nil),                       // 137: model.InsightSampleStream
-	(*model.InsightApplicationCount)(nil),                   // 138: model.InsightApplicationCount
-	(*model.DeploymentChain)(nil),                           // 139: model.DeploymentChain
-	(*model.Event)(nil),                                     // 140: model.Event
-	(*wrapperspb.BoolValue)(nil),                            // 141: google.protobuf.BoolValue
-	(*model.DeployTargets)(nil),                             // 142: model.DeployTargets
-	(model.ApplicationSyncStatus)(0),                        // 143: model.ApplicationSyncStatus
-	(model.DeploymentStatus)(0),                             // 144: model.DeploymentStatus
-	(*model.DeploymentTrace)(nil),                           // 145: model.DeploymentTrace
-	(model.EventStatus)(0),                                  // 146: model.EventStatus
+	(*SyncApplicationBypassingFreezeRequest)(nil),           // 38: grpc.service.webservice.SyncApplicationBypassingFreezeRequest
+	(*SyncApplicationBypassingFreezeResponse)(nil),          // 39: grpc.service.webservice.SyncApplicationBypassingFreezeResponse
+	(*GetApplicationRequest)(nil),                           // 40: grpc.service.webservice.GetApplicationRequest
+	(*GetApplicationResponse)(nil),                          // 41: grpc.service.webservice.GetApplicationResponse
+	(*GenerateApplicationSealedSecretRequest)(nil),          // 42: grpc.service.webservice.GenerateApplicationSealedSecretRequest
+	(*GenerateApplicationSealedSecretResponse)(nil),         // 43: grpc.service.webservice.GenerateApplicationSealedSecretResponse
+	(*ListUnregisteredApplicationsRequest)(nil),             // 44: grpc.service.webservice.ListUnregisteredApplicationsRequest
+	(*ListUnregisteredApplicationsResponse)(nil),            // 45: grpc.service.webservice.ListUnregisteredApplicationsResponse
+	(*ListDeploymentsRequest)(nil),                          // 46: grpc.service.webservice.ListDeploymentsRequest
+	(*ListDeploymentsResponse)(nil),                         // 47: grpc.service.webservice.ListDeploymentsResponse
+	(*GetDeploymentRequest)(nil),                            // 48: grpc.service.webservice.GetDeploymentRequest
+	(*GetDeploymentResponse)(nil),                           // 49: grpc.service.webservice.GetDeploymentResponse
+	(*GetStageLogRequest)(nil),                              // 50: grpc.service.webservice.GetStageLogRequest
+	(*GetStageLogResponse)(nil),                             // 51: grpc.service.webservice.GetStageLogResponse
+	(*SearchStageLogsRequest)(nil),                          // 52: grpc.service.webservice.SearchStageLogsRequest
+	(*SearchStageLogsResponse)(nil),                         // 53: grpc.service.webservice.SearchStageLogsResponse
+	(*CancelDeploymentRequest)(nil),                         // 54: grpc.service.webservice.CancelDeploymentRequest
+	(*CancelDeploymentResponse)(nil),                        // 55: grpc.service.webservice.CancelDeploymentResponse
+	(*SkipStageRequest)(nil),                                // 56: grpc.service.webservice.SkipStageRequest
+	(*SkipStageResponse)(nil),                               // 57: grpc.service.webservice.SkipStageResponse
+	(*RetryStageRequest)(nil),                               // 58: grpc.service.webservice.RetryStageRequest
+	(*RetryStageResponse)(nil),                              // 59: grpc.service.webservice.RetryStageResponse
+	(*ApproveStageRequest)(nil),                             // 60: grpc.service.webservice.ApproveStageRequest
+	(*ApproveStageResponse)(nil),                            // 61: grpc.service.webservice.ApproveStageResponse
+	(*ListDeploymentTracesRequest)(nil),                     // 62: grpc.service.webservice.ListDeploymentTracesRequest
+	(*ListDeploymentTracesResponse)(nil),                    // 63: grpc.service.webservice.ListDeploymentTracesResponse
+	(*GetApplicationLiveStateRequest)(nil),                  // 64: grpc.service.webservice.GetApplicationLiveStateRequest
+	(*GetApplicationLiveStateResponse)(nil),                 // 65: grpc.service.webservice.GetApplicationLiveStateResponse
+	(*GetProjectRequest)(nil),                               // 66: grpc.service.webservice.GetProjectRequest
+	(*GetProjectResponse)(nil),                              // 67: grpc.service.webservice.GetProjectResponse
+	(*UpdateProjectStaticAdminRequest)(nil),                 // 68: grpc.service.webservice.UpdateProjectStaticAdminRequest
+	(*UpdateProjectStaticAdminResponse)(nil),                // 69: grpc.service.webservice.UpdateProjectStaticAdminResponse
+	(*UpdateProjectSSOConfigRequest)(nil),                   // 70: grpc.service.webservice.UpdateProjectSSOConfigRequest
+	(*UpdateProjectSSOConfigResponse)(nil),                  // 71: grpc.service.webservice.UpdateProjectSSOConfigResponse
+	(*UpdateProjectRBACConfigRequest)(nil),                  // 72: grpc.service.webservice.UpdateProjectRBACConfigRequest
+	(*UpdateProjectRBACConfigResponse)(nil),                 // 73: grpc.service.webservice.UpdateProjectRBACConfigResponse
+	(*EnableStaticAdminRequest)(nil),                        // 74: grpc.service.webservice.EnableStaticAdminRequest
+	(*EnableStaticAdminResponse)(nil),                       // 75: grpc.service.webservice.EnableStaticAdminResponse
+	(*DisableStaticAdminRequest)(nil),                       // 76: grpc.service.webservice.DisableStaticAdminRequest
+	(*DisableStaticAdminResponse)(nil),                      // 77: grpc.service.webservice.DisableStaticAdminResponse
+	(*GetMeRequest)(nil),                                    // 78: grpc.service.webservice.GetMeRequest
+	(*GetMeResponse)(nil),                                   // 79: grpc.service.webservice.GetMeResponse
+	(*AddProjectRBACRoleRequest)(nil),                       // 80: grpc.service.webservice.AddProjectRBACRoleRequest
+	(*AddProjectRBACRoleResponse)(nil),                      // 81: grpc.service.webservice.AddProjectRBACRoleResponse
+	(*UpdateProjectRBACRoleRequest)(nil),                    // 82: grpc.service.webservice.UpdateProjectRBACRoleRequest
+	(*UpdateProjectRBACRoleResponse)(nil),                   // 83: grpc.service.webservice.UpdateProjectRBACRoleResponse
+	(*DeleteProjectRBACRoleRequest)(nil),                    // 84: grpc.service.webservice.DeleteProjectRBACRoleRequest
+	(*DeleteProjectRBACRoleResponse)(nil),                   // 85: grpc.service.webservice.DeleteProjectRBACRoleResponse
+	(*AddProjectUserGroupRequest)(nil),                      // 86: grpc.service.webservice.AddProjectUserGroupRequest
+	(*AddProjectUserGroupResponse)(nil),                     // 87: grpc.service.webservice.AddProjectUserGroupResponse
+	(*DeleteProjectUserGroupRequest)(nil),                   // 88: grpc.service.webservice.DeleteProjectUserGroupRequest
+	(*DeleteProjectUserGroupResponse)(nil),                  // 89: grpc.service.webservice.DeleteProjectUserGroupResponse
+	(*AddProjectDeploymentFreezeWindowRequest)(nil),         // 90: grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest
+	(*AddProjectDeploymentFreezeWindowResponse)(nil),        // 91: grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse
+	(*DeleteProjectDeploymentFreezeWindowRequest)(nil),      // 92: grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest
+	(*DeleteProjectDeploymentFreezeWindowResponse)(nil),     // 93: grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse
+	(*ListStaticUsersRequest)(nil),                          // 94: grpc.service.webservice.ListStaticUsersRequest
+	(*ListStaticUsersResponse)(nil),                         // 95: grpc.service.webservice.ListStaticUsersResponse
+	(*AddStaticUserRequest)(nil),                            // 96: grpc.service.webservice.AddStaticUserRequest
+	(*AddStaticUserResponse)(nil),                           // 97: grpc.service.webservice.AddStaticUserResponse
+	(*DeleteStaticUserRequest)(nil),                         // 98: grpc.service.webservice.DeleteStaticUserRequest
+	(*DeleteStaticUserResponse)(nil),                        // 99: grpc.service.webservice.DeleteStaticUserResponse
+	(*UpdateStaticUserRoleRequest)(nil),                     // 100: grpc.service.webservice.UpdateStaticUserRoleRequest
+	(*UpdateStaticUserRoleResponse)(nil),                    // 101: grpc.service.webservice.UpdateStaticUserRoleResponse
+	(*ResetStaticUserPasswordRequest)(nil),                  // 102: grpc.service.webservice.ResetStaticUserPasswordRequest
+	(*ResetStaticUserPasswordResponse)(nil),                 // 103: grpc.service.webservice.ResetStaticUserPasswordResponse
+	(*ChangeStaticUserPasswordRequest)(nil),                 // 104: grpc.service.webservice.ChangeStaticUserPasswordRequest
+	(*ChangeStaticUserPasswordResponse)(nil),                // 105: grpc.service.webservice.ChangeStaticUserPasswordResponse
+	(*GenerateStaticUserTOTPSecretRequest)(nil),             // 106: grpc.service.webservice.GenerateStaticUserTOTPSecretRequest
+	(*GenerateStaticUserTOTPSecretResponse)(nil),            // 107: grpc.service.webservice.GenerateStaticUserTOTPSecretResponse
+	(*EnableStaticUserTOTPRequest)(nil),                     // 108: grpc.service.webservice.EnableStaticUserTOTPRequest
+	(*EnableStaticUserTOTPResponse)(nil),                    // 109: grpc.service.webservice.EnableStaticUserTOTPResponse
+	(*DisableStaticUserTOTPRequest)(nil),                    // 110: grpc.service.webservice.DisableStaticUserTOTPRequest
+	(*DisableStaticUserTOTPResponse)(nil),                   // 111: grpc.service.webservice.DisableStaticUserTOTPResponse
+	(*GetCommandRequest)(nil),                               // 112: grpc.service.webservice.GetCommandRequest
+	(*GetCommandResponse)(nil),                              // 113: grpc.service.webservice.GetCommandResponse
+	(*GenerateAPIKeyRequest)(nil),                           // 114: grpc.service.webservice.GenerateAPIKeyRequest
+	(*GenerateAPIKeyResponse)(nil),                          // 115: grpc.service.webservice.GenerateAPIKeyResponse
+	(*DisableAPIKeyRequest)(nil),                            // 116: grpc.service.webservice.DisableAPIKeyRequest
+	(*DisableAPIKeyResponse)(nil),                           // 117: grpc.service.webservice.DisableAPIKeyResponse
+	(*ListAPIKeysRequest)(nil),                              // 118: grpc.service.webservice.ListAPIKeysRequest
+	(*ListAPIKeysResponse)(nil),                             // 119: grpc.service.webservice.ListAPIKeysResponse
+	(*GetInsightDataRequest)(nil),                           // 120: grpc.service.webservice.GetInsightDataRequest
+	(*GetInsightDataResponse)(nil),                          // 121: grpc.service.webservice.GetInsightDataResponse
+	(*GetInsightApplicationCountRequest)(nil),               // 122: grpc.service.webservice.GetInsightApplicationCountRequest
+	(*GetInsightApplicationCountResponse)(nil),              // 123: grpc.service.webservice.GetInsightApplicationCountResponse
+	(*ListDeploymentChainsRequest)(nil),                     // 124: grpc.service.webservice.ListDeploymentChainsRequest
+	(*ListDeploymentChainsResponse)(nil),                    // 125: grpc.service.webservice.ListDeploymentChainsResponse
+	(*GetDeploymentChainRequest)(nil),                       // 126: grpc.service.webservice.GetDeploymentChainRequest
+	(*GetDeploymentChainResponse)(nil),                      // 127: grpc.service.webservice.GetDeploymentChainResponse
+	(*CancelDeploymentChainRequest)(nil),                    // 128: grpc.service.webservice.CancelDeploymentChainRequest
+	(*CancelDeploymentChainResponse)(nil),                   // 129: grpc.service.webservice.CancelDeploymentChainResponse
+	(*ListEventsRequest)(nil),                               // 130: grpc.service.webservice.ListEventsRequest
+	(*ListEventsResponse)(nil),                              // 131: grpc.service.webservice.ListEventsResponse
+	(*ListPipedsRequest_Options)(nil),                       // 132: grpc.service.webservice.ListPipedsRequest.Options
+	nil,                                                     // 133: grpc.service.webservice.AddApplicationRequest.DeployTargetsByPluginEntry
+	nil,                                                     // 134: grpc.service.webservice.AddApplicationRequest.LabelsEntry
+	nil,                                                     // 135: grpc.service.webservice.UpdateApplicationRequest.DeployTargetsByPluginEntry
+	(*ListApplicationsRequest_Options)(nil),                 // 136: grpc.service.webservice.ListApplicationsRequest.Options
+	nil,                                                     // 137: grpc.service.webservice.ListApplicationsRequest.Options.LabelsEntry
+	(*ListDeploymentsRequest_Options)(nil),                  // 138: grpc.service.webservice.ListDeploymentsRequest.Options
+	nil,                                                     // 139: grpc.service.webservice.ListDeploymentsRequest.Options.LabelsEntry
+	(*SearchStageLogsResponse_Match)(nil),                   // 140: grpc.service.webservice.SearchStageLogsResponse.Match
+	(*ListDeploymentTracesRequest_Options)(nil),             // 141: grpc.service.webservice.ListDeploymentTracesRequest.Options
+	(*ListDeploymentTracesResponse_DeploymentTraceRes)(nil), // 142: grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes
+	(*ListAPIKeysRequest_Options)(nil),                      // 143: grpc.service.webservice.ListAPIKeysRequest.Options
+	nil,                                                     // 144: grpc.service.webservice.GetInsightDataRequest.LabelsEntry
+	(*ListDeploymentChainsRequest_Options)(nil),             // 145: grpc.service.webservice.ListDeploymentChainsRequest.Options
+	(*ListEventsRequest_Options)(nil),                       // 146: grpc.service.webservice.ListEventsRequest.Options
+	nil,                                                     // 147: grpc.service.webservice.ListEventsRequest.Options.LabelsEntry
+	(*model.Piped)(nil),                                     // 148: model.Piped
+	(*model.ApplicationGitPath)(nil),                        // 149: model.ApplicationGitPath
+	(model.ApplicationKind)(0),                              // 150: model.ApplicationKind
+	(*model.Application)(nil),                               // 151: model.Application
+	(model.SyncStrategy)(0),                                 // 152: model.SyncStrategy
+	(*model.ApplicationInfo)(nil),                           // 153: model.ApplicationInfo
+	(*model.Deployment)(nil),                                // 154: model.Deployment
+	(*model.LogBlock)(nil),                                  // 155: model.LogBlock
+	(model.LogSeverity)(0),                                  // 156: model.LogSeverity
+	(*model.ApplicationLiveStateSnapshot)(nil),              // 157: model.ApplicationLiveStateSnapshot
+	(*model.Project)(nil),                                   // 158: model.Project
+	(*model.ProjectSSOConfig)(nil),                          // 159: model.ProjectSSOConfig
+	(*model.ProjectRBACConfig)(nil),                         // 160: model.ProjectRBACConfig
+	(*model.ProjectRBACPolicy)(nil),                         // 161: model.ProjectRBACPolicy
+	(*model.DeploymentFreezeWindow)(nil),                    // 162: model.DeploymentFreezeWindow
+	(*model.ProjectStaticUser)(nil),                         // 163: model.ProjectStaticUser
+	(*model.Command)(nil),                                   // 164: model.Command
+	(model.APIKey_Role)(0),                                  // 165: model.APIKey.Role
+	(*model.APIKey)(nil),                                    // 166: model.APIKey
+	(model.InsightMetricsKind)(0),                           // 167: model.InsightMetricsKind
+	(model.InsightResolution)(0),                            // 168: model.InsightResolution
+	(model.InsightResultType)(0),                            // 169: model.InsightResultType
+	(*model.InsightSample)(nil),                             // 170: model.InsightSample
+	(*model.InsightSampleStream)(nil),                       // 171: model.InsightSampleStream
+	(*model.InsightApplicationCount)(nil),                   // 172: model.InsightApplicationCount
+	(*model.DeploymentChain)(nil),                           // 173: model.DeploymentChain
+	(*model.Event)(nil),                                     // 174: model.Event
+	(*wrapperspb.BoolValue)(nil),                            // 175: google.protobuf.BoolValue
+	(*model.DeployTargets)(nil),                             // 176: model.DeployTargets
+	(model.ApplicationSyncStatus)(0),                        // 177: model.ApplicationSyncStatus
+	(model.DeploymentStatus)(0),                             // 178: model.DeploymentStatus
+	(*model.DeploymentTrace)(nil),                           // 179: model.DeploymentTrace
+	(model.EventStatus)(0),                                  // 180: model.EventStatus
 }
 var file_pkg_app_server_service_webservice_service_proto_depIdxs = []int32{
-	102, // 0: grpc.service.webservice.ListPipedsRequest.options:type_name -> grpc.service.webservice.ListPipedsRequest.Options
-	117, // 1: grpc.service.webservice.ListPipedsResponse.pipeds:type_name -> model.Piped
-	117, // 2: grpc.service.webservice.GetPipedResponse.piped:type_name -> model.Piped
-	118, // 3: grpc.service.webservice.AddApplicationRequest.git_path:type_name -> model.ApplicationGitPath
-	119, // 4: grpc.service.webservice.AddApplicationRequest.kind:type_name -> model.ApplicationKind
-	103, // 5: grpc.service.webservice.AddApplicationRequest.deploy_targets_by_plugin:type_name -> grpc.service.webservice.AddApplicationRequest.DeployTargetsByPluginEntry
-	104, // 6: grpc.service.webservice.AddApplicationRequest.labels:type_name -> grpc.service.webservice.AddApplicationRequest.LabelsEntry
-	119, // 7: grpc.service.webservice.UpdateApplicationRequest.kind:type_name -> model.ApplicationKind
-	105, // 8: grpc.service.webservice.UpdateApplicationRequest.deploy_targets_by_plugin:type_name -> grpc.service.webservice.UpdateApplicationRequest.DeployTargetsByPluginEntry
-	106, // 9: grpc.service.webservice.ListApplicationsRequest.options:type_name -> grpc.service.webservice.ListApplicationsRequest.Options
-	120, // 10: grpc.service.webservice.ListApplicationsResponse.applications:type_name -> model.Application
-	121, // 11: grpc.service.webservice.SyncApplicationRequest.sync_strategy:type_name -> model.SyncStrategy
-	120, // 12: grpc.service.webservice.GetApplicationResponse.application:type_name -> model.Application
-	122, // 13: grpc.service.webservice.ListUnregisteredApplicationsResponse.applications:type_name -> model.ApplicationInfo
-	108, // 14: grpc.service.webservice.ListDeploymentsRequest.options:type_name -> grpc.service.webservice.ListDeploymentsRequest.Options
-	123, // 15: grpc.service.webservice.ListDeploymentsResponse.deployments:type_name -> model.Deployment
-	123, // 16: grpc.service.webservice.GetDeploymentResponse.deployment:type_name -> model.Deployment
-	124, // 17: grpc.service.webservice.GetStageLogResponse.blocks:type_name -> model.LogBlock
-	110, // 18: grpc.service.webservice.ListDeploymentTracesRequest.options:type_name -> grpc.service.webservice.ListDeploymentTracesRequest.Options
-	111, // 19: grpc.service.webservice.ListDeploymentTracesResponse.traces:type_name -> grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes
-	125, // 20: grpc.service.webservice.GetApplicationLiveStateResponse.snapshot:type_name -> model.ApplicationLiveStateSnapshot
-	126, // 21: grpc.service.webservice.GetProjectResponse.project:type_name -> model.Project
-	127, // 22: grpc.service.webservice.UpdateProjectSSOConfigRequest.sso:type_name -> model.ProjectSSOConfig
-	128, // 23: grpc.service.webservice.UpdateProjectRBACConfigRequest.rbac:type_name -> model.ProjectRBACConfig
-	129, // 24: grpc.service.webservice.AddProjectRBACRoleRequest.policies:type_name -> model.ProjectRBACPolicy
-	129, // 25: grpc.service.webservice.UpdateProjectRBACRoleRequest.policies:type_name -> model.ProjectRBACPolicy
-	130, // 26: grpc.service.webservice.GetCommandResponse.command:type_name -> model.Command
-	131, // 27: grpc.service.webservice.GenerateAPIKeyRequest.role:type_name -> model.APIKey.Role
-	112, // 28: grpc.service.webservice.ListAPIKeysRequest.options:type_name -> grpc.service.webservice.ListAPIKeysRequest.Options
-	132, // 29: grpc.service.webservice.ListAPIKeysResponse.keys:type_name -> model.APIKey
-	133, // 30: grpc.service.webservice.GetInsightDataRequest.metrics_kind:type_name -> model.InsightMetricsKind
-	134, // 31: grpc.service.webservice.GetInsightDataRequest.resolution:type_name -> model.InsightResolution
-	113, // 32: grpc.service.webservice.GetInsightDataRequest.labels:type_name -> grpc.service.webservice.GetInsightDataRequest.LabelsEntry
-	135, // 33: grpc.service.webservice.GetInsightDataResponse.type:type_name -> model.InsightResultType
-	136, // 34: grpc.service.webservice.GetInsightDataResponse.vector:type_name -> model.InsightSample
-	137, // 35: grpc.service.webservice.GetInsightDataResponse.matrix:type_name -> model.InsightSampleStream
-	138, // 36: grpc.service.webservice.GetInsightApplicationCountResponse.counts:type_name -> model.InsightApplicationCount
-	114, // 37: grpc.service.webservice.ListDeploymentChainsRequest.options:type_name -> grpc.service.webservice.ListDeploymentChainsRequest.Options
-	139, // 38: grpc.service.webservice.ListDeploymentChainsResponse.deployment_chains:type_name -> model.DeploymentChain
-	139, // 39: grpc.service.webservice.GetDeploymentChainResponse.deployment_chain:type_name -> model.DeploymentChain
-	115, // 40: grpc.service.webservice.ListEventsRequest.options:type_name -> grpc.service.webservice.ListEventsRequest.Options
-	140, // 41: grpc.service.webservice.ListEventsResponse.events:type_name -> model.Event
-	141, // 42: grpc.service.webservice.ListPipedsRequest.Options.enabled:type_name -> google.protobuf.BoolValue
-	142, // 43: grpc.service.webservice.AddApplicationRequest.DeployTargetsByPluginEntry.value:type_name -> model.DeployTargets
-	142, // 44: grpc.service.webservice.UpdateApplicationRequest.DeployTargetsByPluginEntry.value:type_name -> model.DeployTargets
-	141, // 45: grpc.service.webservice.ListApplicationsRequest.Options.enabled:type_name -> google.protobuf.BoolValue
-	119, // 46: grpc.service.webservice.ListApplicationsRequest.Options.kinds:type_name -> model.ApplicationKind
-	143, // 47: grpc.service.webservice.ListApplicationsRequest.Options.sync_statuses:type_name -> model.ApplicationSyncStatus
-	107, // 48: grpc.service.webservice.ListApplicationsRequest.Options.labels:type_name -> grpc.service.webservice.ListApplicationsRequest.Options.LabelsEntry
-	144, // 49: grpc.service.webservice.ListDeploymentsRequest.Options.statuses:type_name -> model.DeploymentStatus
-	119, // 50: grpc.service.webservice.ListDeploymentsRequest.Options.kinds:type_name -> model.ApplicationKind
-	109, // 51: grpc.service.webservice.ListDeploymentsRequest.Options.labels:type_name -> grpc.service.webservice.ListDeploymentsRequest.Options.LabelsEntry
-	145, // 52: grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.trace:type_name -> model.DeploymentTrace
-	123, // 53: grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.deployments:type_name -> model.Deployment
-	141, // 54: grpc.service.webservice.ListAPIKeysRequest.Options.enabled:type_name -> google.protobuf.BoolValue
-	146, // 55: grpc.service.webservice.ListEventsRequest.Options.statuses:type_name -> model.EventStatus
-	116, // 56: grpc.service.webservice.ListEventsRequest.Options.labels:type_name -> grpc.service.webservice.ListEventsRequest.Options.LabelsEntry
-	0,   // 57: grpc.service.webservice.WebService.RegisterPiped:input_type -> grpc.service.webservice.RegisterPipedRequest
-	2,   // 58: grpc.service.webservice.WebService.UpdatePiped:input_type -> grpc.service.webservice.UpdatePipedRequest
-	4,   // 59: grpc.service.webservice.WebService.RecreatePipedKey:input_type -> grpc.service.webservice.RecreatePipedKeyRequest
-	6,   // 60: grpc.service.webservice.WebService.DeleteOldPipedKeys:input_type -> grpc.service.webservice.DeleteOldPipedKeysRequest
-	8,   // 61: grpc.service.webservice.WebService.EnablePiped:input_type -> grpc.service.webservice.EnablePipedRequest
-	10,  // 62: grpc.service.webservice.WebService.DisablePiped:input_type -> grpc.service.webservice.DisablePipedRequest
-	12,  // 63: grpc.service.webservice.WebService.ListPipeds:input_type -> grpc.service.webservice.ListPipedsRequest
-	14,  // 64: grpc.service.webservice.WebService.GetPiped:input_type -> grpc.service.webservice.GetPipedRequest
-	16,  // 65: grpc.service.webservice.WebService.UpdatePipedDesiredVersion:input_type -> grpc.service.webservice.UpdatePipedDesiredVersionRequest
-	18,  // 66: grpc.service.webservice.WebService.RestartPiped:input_type -> grpc.service.webservice.RestartPipedRequest
-	20,  // 67: grpc.service.webservice.WebService.ListReleasedVersions:input_type -> grpc.service.webservice.ListReleasedVersionsRequest
-	22,  // 68: grpc.service.webservice.WebService.ListDeprecatedNotes:input_type -> grpc.service.webservice.ListDeprecatedNotesRequest
-	24,  // 69: grpc.service.webservice.WebService.AddApplication:input_type -> grpc.service.webservice.AddApplicationRequest
-	26,  // 70: grpc.service.webservice.WebService.UpdateApplication:input_type -> grpc.service.webservice.UpdateApplicationRequest
-	28,  // 71: grpc.service.webservice.WebService.EnableApplication:input_type -> grpc.service.webservice.EnableApplicationRequest
-	30,  // 72: grpc.service.webservice.WebService.DisableApplication:input_type -> grpc.service.webservice.DisableApplicationRequest
-	32,  // 73: grpc.service.webservice.WebService.DeleteApplication:input_type -> grpc.service.webservice.DeleteApplicationRequest
-	34,  // 74: grpc.service.webservice.WebService.ListApplications:input_type -> grpc.service.webservice.ListApplicationsRequest
-	36,  // 75: grpc.service.webservice.WebService.SyncApplication:input_type -> grpc.service.webservice.SyncApplicationRequest
-	38,  // 76: grpc.service.webservice.WebService.GetApplication:input_type -> grpc.service.webservice.GetApplicationRequest
-	40,  // 77: grpc.service.webservice.WebService.GenerateApplicationSealedSecret:input_type -> grpc.service.webservice.GenerateApplicationSealedSecretRequest
-	42,  // 78: grpc.service.webservice.WebService.ListUnregisteredApplications:input_type -> grpc.service.webservice.ListUnregisteredApplicationsRequest
-	44,  // 79: grpc.service.webservice.WebService.ListDeployments:input_type -> grpc.service.webservice.ListDeploymentsRequest
-	46,  // 80: grpc.service.webservice.WebService.GetDeployment:input_type -> grpc.service.webservice.GetDeploymentRequest
-	48,  // 81: grpc.service.webservice.WebService.GetStageLog:input_type -> grpc.service.webservice.GetStageLogRequest
-	50,  // 82: grpc.service.webservice.WebService.CancelDeployment:input_type -> grpc.service.webservice.CancelDeploymentRequest
-	52,  // 83: grpc.service.webservice.WebService.SkipStage:input_type -> grpc.service.webservice.SkipStageRequest
-	54,  // 84: grpc.service.webservice.WebService.ApproveStage:input_type -> grpc.service.webservice.ApproveStageRequest
-	56,  // 85: grpc.service.webservice.WebService.ListDeploymentTraces:input_type -> grpc.service.webservice.ListDeploymentTracesRequest
-	58,  // 86: grpc.service.webservice.WebService.GetApplicationLiveState:input_type -> grpc.service.webservice.GetApplicationLiveStateRequest
-	60,  // 87: grpc.service.webservice.WebService.GetProject:input_type -> grpc.service.webservice.GetProjectRequest
-	62,  // 88: grpc.service.webservice.WebService.UpdateProjectStaticAdmin:input_type -> grpc.service.webservice.UpdateProjectStaticAdminRequest
-	68,  // 89: grpc.service.webservice.WebService.EnableStaticAdmin:input_type -> grpc.service.webservice.EnableStaticAdminRequest
-	70,  // 90: grpc.service.webservice.WebService.DisableStaticAdmin:input_type -> grpc.service.webservice.DisableStaticAdminRequest
-	64,  // 91: grpc.service.webservice.WebService.UpdateProjectSSOConfig:input_type -> grpc.service.webservice.UpdateProjectSSOConfigRequest
-	66,  // 92: grpc.service.webservice.WebService.UpdateProjectRBACConfig:input_type -> grpc.service.webservice.UpdateProjectRBACConfigRequest
-	72,  // 93: grpc.service.webservice.WebService.GetMe:input_type -> grpc.service.webservice.GetMeRequest
-	74,  // 94: grpc.service.webservice.WebService.AddProjectRBACRole:input_type -> grpc.service.webservice.AddProjectRBACRoleRequest
-	76,  // 95: grpc.service.webservice.WebService.UpdateProjectRBACRole:input_type -> grpc.service.webservice.UpdateProjectRBACRoleRequest
-	78,  // 96: grpc.service.webservice.WebService.DeleteProjectRBACRole:input_type -> grpc.service.webservice.DeleteProjectRBACRoleRequest
-	80,  // 97: grpc.service.webservice.WebService.AddProjectUserGroup:input_type -> grpc.service.webservice.AddProjectUserGroupRequest
-	82,  // 98: grpc.service.webservice.WebService.DeleteProjectUserGroup:input_type -> grpc.service.webservice.DeleteProjectUserGroupRequest
-	84,  // 99: grpc.service.webservice.WebService.GetCommand:input_type -> grpc.service.webservice.GetCommandRequest
-	86,  // 100: grpc.service.webservice.WebService.GenerateAPIKey:input_type -> grpc.service.webservice.GenerateAPIKeyRequest
-	88,  // 101: grpc.service.webservice.WebService.DisableAPIKey:input_type -> grpc.service.webservice.DisableAPIKeyRequest
-	90,  // 102: grpc.service.webservice.WebService.ListAPIKeys:input_type -> grpc.service.webservice.ListAPIKeysRequest
-	92,  // 103: grpc.service.webservice.WebService.GetInsightData:input_type -> grpc.service.webservice.GetInsightDataRequest
-	94,  // 104: grpc.service.webservice.WebService.GetInsightApplicationCount:input_type -> grpc.service.webservice.GetInsightApplicationCountRequest
-	96,  // 105: grpc.service.webservice.WebService.ListDeploymentChains:input_type -> grpc.service.webservice.ListDeploymentChainsRequest
-	98,  // 106: grpc.service.webservice.WebService.GetDeploymentChain:input_type -> grpc.service.webservice.GetDeploymentChainRequest
-	100, // 107: grpc.service.webservice.WebService.ListEvents:input_type -> grpc.service.webservice.ListEventsRequest
-	1,   // 108: grpc.service.webservice.WebService.RegisterPiped:output_type -> grpc.service.webservice.RegisterPipedResponse
-	3,   // 109: grpc.service.webservice.WebService.UpdatePiped:output_type -> grpc.service.webservice.UpdatePipedResponse
-	5,   // 110: grpc.service.webservice.WebService.RecreatePipedKey:output_type -> grpc.service.webservice.RecreatePipedKeyResponse
-	7,   // 111: grpc.service.webservice.WebService.DeleteOldPipedKeys:output_type -> grpc.service.webservice.DeleteOldPipedKeysResponse
-	9,   // 112: grpc.service.webservice.WebService.EnablePiped:output_type -> grpc.service.webservice.EnablePipedResponse
-	11,  // 113: grpc.service.webservice.WebService.DisablePiped:output_type -> grpc.service.webservice.DisablePipedResponse
-	13,  // 114: grpc.service.webservice.WebService.ListPipeds:output_type -> grpc.service.webservice.ListPipedsResponse
-	15,  // 115: grpc.service.webservice.WebService.GetPiped:output_type -> grpc.service.webservice.GetPipedResponse
-	17,  // 116: grpc.service.webservice.WebService.UpdatePipedDesiredVersion:output_type -> grpc.service.webservice.UpdatePipedDesiredVersionResponse
-	19,  // 117: grpc.service.webservice.WebService.RestartPiped:output_type -> grpc.service.webservice.RestartPipedResponse
-	21,  // 118: grpc.service.webservice.WebService.ListReleasedVersions:output_type -> grpc.service.webservice.ListReleasedVersionsResponse
-	23,  // 119: grpc.service.webservice.WebService.ListDeprecatedNotes:output_type -> grpc.service.webservice.ListDeprecatedNotesResponse
-	25,  // 120: grpc.service.webservice.WebService.AddApplication:output_type -> grpc.service.webservice.AddApplicationResponse
-	27,  // 121: grpc.service.webservice.WebService.UpdateApplication:output_type -> grpc.service.webservice.UpdateApplicationResponse
-	29,  // 122: grpc.service.webservice.WebService.EnableApplication:output_type -> grpc.service.webservice.EnableApplicationResponse
-	31,  // 123: grpc.service.webservice.WebService.DisableApplication:output_type -> grpc.service.webservice.DisableApplicationResponse
-	33,  // 124: grpc.service.webservice.WebService.DeleteApplication:output_type -> grpc.service.webservice.DeleteApplicationResponse
-	35,  // 125: grpc.service.webservice.WebService.ListApplications:output_type -> grpc.service.webservice.ListApplicationsResponse
-	37,  // 126: grpc.service.webservice.WebService.SyncApplication:output_type -> grpc.service.webservice.SyncApplicationResponse
-	39,  // 127: grpc.service.webservice.WebService.GetApplication:output_type -> grpc.service.webservice.GetApplicationResponse
-	41,  // 128: grpc.service.webservice.WebService.GenerateApplicationSealedSecret:output_type -> grpc.service.webservice.GenerateApplicationSealedSecretResponse
-	43,  // 129: grpc.service.webservice.WebService.ListUnregisteredApplications:output_type -> grpc.service.webservice.ListUnregisteredApplicationsResponse
-	45,  // 130: grpc.service.webservice.WebService.ListDeployments:output_type -> grpc.service.webservice.ListDeploymentsResponse
-	47,  // 131: grpc.service.webservice.WebService.GetDeployment:output_type -> grpc.service.webservice.GetDeploymentResponse
-	49,  // 132: grpc.service.webservice.WebService.GetStageLog:output_type -> grpc.service.webservice.GetStageLogResponse
-	51,  // 133: grpc.service.webservice.WebService.CancelDeployment:output_type -> grpc.service.webservice.CancelDeploymentResponse
-	53,  // 134: grpc.service.webservice.WebService.SkipStage:output_type -> grpc.service.webservice.SkipStageResponse
-	55,  // 135: grpc.service.webservice.WebService.ApproveStage:output_type -> grpc.service.webservice.ApproveStageResponse
-	57,  // 136: grpc.service.webservice.WebService.ListDeploymentTraces:output_type -> grpc.service.webservice.ListDeploymentTracesResponse
-	59,  // 137: grpc.service.webservice.WebService.GetApplicationLiveState:output_type -> grpc.service.webservice.GetApplicationLiveStateResponse
-	61,  // 138: grpc.service.webservice.WebService.GetProject:output_type -> grpc.service.webservice.GetProjectResponse
-	63,  // 139: grpc.service.webservice.WebService.UpdateProjectStaticAdmin:output_type -> grpc.service.webservice.UpdateProjectStaticAdminResponse
-	69,  // 140: grpc.service.webservice.WebService.EnableStaticAdmin:output_type -> grpc.service.webservice.EnableStaticAdminResponse
-	71,  // 141: grpc.service.webservice.WebService.DisableStaticAdmin:output_type -> grpc.service.webservice.DisableStaticAdminResponse
-	65,  // 142: grpc.service.webservice.WebService.UpdateProjectSSOConfig:output_type -> grpc.service.webservice.UpdateProjectSSOConfigResponse
-	67,  // 143: grpc.service.webservice.WebService.UpdateProjectRBACConfig:output_type -> grpc.service.webservice.UpdateProjectRBACConfigResponse
-	73,  // 144: grpc.service.webservice.WebService.GetMe:output_type -> grpc.service.webservice.GetMeResponse
-	75,  // 145: grpc.service.webservice.WebService.AddProjectRBACRole:output_type -> grpc.service.webservice.AddProjectRBACRoleResponse
-	77,  // 146: grpc.service.webservice.WebService.UpdateProjectRBACRole:output_type -> grpc.service.webservice.UpdateProjectRBACRoleResponse
-	79,  // 147: grpc.service.webservice.WebService.DeleteProjectRBACRole:output_type -> grpc.service.webservice.DeleteProjectRBACRoleResponse
-	81,  // 148: grpc.service.webservice.WebService.AddProjectUserGroup:output_type -> grpc.service.webservice.AddProjectUserGroupResponse
-	83,  // 149: grpc.service.webservice.WebService.DeleteProjectUserGroup:output_type -> grpc.service.webservice.DeleteProjectUserGroupResponse
-	85,  // 150: grpc.service.webservice.WebService.GetCommand:output_type -> grpc.service.webservice.GetCommandResponse
-	87,  // 151: grpc.service.webservice.WebService.GenerateAPIKey:output_type -> grpc.service.webservice.GenerateAPIKeyResponse
-	89,  // 152: grpc.service.webservice.WebService.DisableAPIKey:output_type -> grpc.service.webservice.DisableAPIKeyResponse
-	91,  // 153: grpc.service.webservice.WebService.ListAPIKeys:output_type -> grpc.service.webservice.ListAPIKeysResponse
-	93,  // 154: grpc.service.webservice.WebService.GetInsightData:output_type -> grpc.service.webservice.GetInsightDataResponse
-	95,  // 155: grpc.service.webservice.WebService.GetInsightApplicationCount:output_type -> grpc.service.webservice.GetInsightApplicationCountResponse
-	97,  // 156: grpc.service.webservice.WebService.ListDeploymentChains:output_type -> grpc.service.webservice.ListDeploymentChainsResponse
-	99,  // 157: grpc.service.webservice.WebService.GetDeploymentChain:output_type -> grpc.service.webservice.GetDeploymentChainResponse
-	101, // 158: grpc.service.webservice.WebService.ListEvents:output_type -> grpc.service.webservice.ListEventsResponse
-	108, // [108:159] is the sub-list for method output_type
-	57,  // [57:108] is the sub-list for method input_type
-	57,  // [57:57] is the sub-list for extension type_name
-	57,  // [57:57] is the sub-list for extension extendee
-	0,   // [0:57] is the sub-list for field type_name
+	132, // 0: grpc.service.webservice.ListPipedsRequest.options:type_name -> grpc.service.webservice.ListPipedsRequest.Options
+	148, // 1: grpc.service.webservice.ListPipedsResponse.pipeds:type_name -> model.Piped
+	148, // 2: grpc.service.webservice.GetPipedResponse.piped:type_name -> model.Piped
+	149, // 3: grpc.service.webservice.AddApplicationRequest.git_path:type_name -> model.ApplicationGitPath
+	150, // 4: grpc.service.webservice.AddApplicationRequest.kind:type_name -> model.ApplicationKind
+	133, // 5: grpc.service.webservice.AddApplicationRequest.deploy_targets_by_plugin:type_name -> grpc.service.webservice.AddApplicationRequest.DeployTargetsByPluginEntry
+	134, // 6: grpc.service.webservice.AddApplicationRequest.labels:type_name -> grpc.service.webservice.AddApplicationRequest.LabelsEntry
+	150, // 7: grpc.service.webservice.UpdateApplicationRequest.kind:type_name -> model.ApplicationKind
+	135, // 8: grpc.service.webservice.UpdateApplicationRequest.deploy_targets_by_plugin:type_name -> grpc.service.webservice.UpdateApplicationRequest.DeployTargetsByPluginEntry
+	136, // 9: grpc.service.webservice.ListApplicationsRequest.options:type_name -> grpc.service.webservice.ListApplicationsRequest.Options
+	151, // 10: grpc.service.webservice.ListApplicationsResponse.applications:type_name -> model.Application
+	152, // 11: grpc.service.webservice.SyncApplicationRequest.sync_strategy:type_name -> model.SyncStrategy
+	152, // 12: grpc.service.webservice.SyncApplicationBypassingFreezeRequest.sync_strategy:type_name -> model.SyncStrategy
+	151, // 13: grpc.service.webservice.GetApplicationResponse.application:type_name -> model.Application
+	153, // 14: grpc.service.webservice.ListUnregisteredApplicationsResponse.applications:type_name -> model.ApplicationInfo
+	138, // 15: grpc.service.webservice.ListDeploymentsRequest.options:type_name -> grpc.service.webservice.ListDeploymentsRequest.Options
+	154, // 16: grpc.service.webservice.ListDeploymentsResponse.deployments:type_name -> model.Deployment
+	154, // 17: grpc.service.webservice.GetDeploymentResponse.deployment:type_name -> model.Deployment
+	155, // 18: grpc.service.webservice.GetStageLogResponse.blocks:type_name -> model.LogBlock
+	156, // 19: grpc.service.webservice.SearchStageLogsRequest.severities:type_name -> model.LogSeverity
+	140, // 20: grpc.service.webservice.SearchStageLogsResponse.matches:type_name -> grpc.service.webservice.SearchStageLogsResponse.Match
+	141, // 21: grpc.service.webservice.ListDeploymentTracesRequest.options:type_name -> grpc.service.webservice.ListDeploymentTracesRequest.Options
+	142, // 22: grpc.service.webservice.ListDeploymentTracesResponse.traces:type_name -> grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes
+	157, // 23: grpc.service.webservice.GetApplicationLiveStateResponse.snapshot:type_name -> model.ApplicationLiveStateSnapshot
+	158, // 24: grpc.service.webservice.GetProjectResponse.project:type_name -> model.Project
+	159, // 25: grpc.service.webservice.UpdateProjectSSOConfigRequest.sso:type_name -> model.ProjectSSOConfig
+	160, // 26: grpc.service.webservice.UpdateProjectRBACConfigRequest.rbac:type_name -> model.ProjectRBACConfig
+	161, // 27: grpc.service.webservice.AddProjectRBACRoleRequest.policies:type_name -> model.ProjectRBACPolicy
+	161, // 28: grpc.service.webservice.UpdateProjectRBACRoleRequest.policies:type_name -> model.ProjectRBACPolicy
+	162, // 29: grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest.window:type_name -> model.DeploymentFreezeWindow
+	163, // 30: grpc.service.webservice.ListStaticUsersResponse.users:type_name -> model.ProjectStaticUser
+	164, // 31: grpc.service.webservice.GetCommandResponse.command:type_name -> model.Command
+	165, // 32: grpc.service.webservice.GenerateAPIKeyRequest.role:type_name -> model.APIKey.Role
+	143, // 33: grpc.service.webservice.ListAPIKeysRequest.options:type_name -> grpc.service.webservice.ListAPIKeysRequest.Options
+	166, // 34: grpc.service.webservice.ListAPIKeysResponse.keys:type_name -> model.APIKey
+	167, // 35: grpc.service.webservice.GetInsightDataRequest.metrics_kind:type_name -> model.InsightMetricsKind
+	168, // 36: grpc.service.webservice.GetInsightDataRequest.resolution:type_name -> model.InsightResolution
+	144, // 37: grpc.service.webservice.GetInsightDataRequest.labels:type_name -> grpc.service.webservice.GetInsightDataRequest.LabelsEntry
+	169, // 38: grpc.service.webservice.GetInsightDataResponse.type:type_name -> model.InsightResultType
+	170, // 39: grpc.service.webservice.GetInsightDataResponse.vector:type_name -> model.InsightSample
+	171, // 40: grpc.service.webservice.GetInsightDataResponse.matrix:type_name -> model.InsightSampleStream
+	172, // 41: grpc.service.webservice.GetInsightApplicationCountResponse.counts:type_name -> model.InsightApplicationCount
+	145, // 42: grpc.service.webservice.ListDeploymentChainsRequest.options:type_name -> grpc.service.webservice.ListDeploymentChainsRequest.Options
+	173, // 43: grpc.service.webservice.ListDeploymentChainsResponse.deployment_chains:type_name -> model.DeploymentChain
+	173, // 44: grpc.service.webservice.GetDeploymentChainResponse.deployment_chain:type_name -> model.DeploymentChain
+	146, // 45: grpc.service.webservice.ListEventsRequest.options:type_name -> grpc.service.webservice.ListEventsRequest.Options
+	174, // 46: grpc.service.webservice.ListEventsResponse.events:type_name -> model.Event
+	175, // 47: grpc.service.webservice.ListPipedsRequest.Options.enabled:type_name -> google.protobuf.BoolValue
+	176, // 48: grpc.service.webservice.AddApplicationRequest.DeployTargetsByPluginEntry.value:type_name -> model.DeployTargets
+	176, // 49: grpc.service.webservice.UpdateApplicationRequest.DeployTargetsByPluginEntry.value:type_name -> model.DeployTargets
+	175, // 50: grpc.service.webservice.ListApplicationsRequest.Options.enabled:type_name -> google.protobuf.BoolValue
+	150, // 51: grpc.service.webservice.ListApplicationsRequest.Options.kinds:type_name -> model.ApplicationKind
+	177, // 52: grpc.service.webservice.ListApplicationsRequest.Options.sync_statuses:type_name -> model.ApplicationSyncStatus
+	137, // 53: grpc.service.webservice.ListApplicationsRequest.Options.labels:type_name -> grpc.service.webservice.ListApplicationsRequest.Options.LabelsEntry
+	178, // 54: grpc.service.webservice.ListDeploymentsRequest.Options.statuses:type_name -> model.DeploymentStatus
+	150, // 55: grpc.service.webservice.ListDeploymentsRequest.Options.kinds:type_name -> model.ApplicationKind
+	139, // 56: grpc.service.webservice.ListDeploymentsRequest.Options.labels:type_name -> grpc.service.webservice.ListDeploymentsRequest.Options.LabelsEntry
+	155, // 57: grpc.service.webservice.SearchStageLogsResponse.Match.block:type_name -> model.LogBlock
+	179, // 58: grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.trace:type_name -> model.DeploymentTrace
+	154, // 59: grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.deployments:type_name -> model.Deployment
+	175, // 60: grpc.service.webservice.ListAPIKeysRequest.Options.enabled:type_name -> google.protobuf.BoolValue
+	180, // 61: grpc.service.webservice.ListEventsRequest.Options.statuses:type_name -> model.EventStatus
+	147, // 62: grpc.service.webservice.ListEventsRequest.Options.labels:type_name -> grpc.service.webservice.ListEventsRequest.Options.LabelsEntry
+	0,   // 63: grpc.service.webservice.WebService.RegisterPiped:input_type -> grpc.service.webservice.RegisterPipedRequest
+	2,   // 64: grpc.service.webservice.WebService.UpdatePiped:input_type -> grpc.service.webservice.UpdatePipedRequest
+	4,   // 65: grpc.service.webservice.WebService.RecreatePipedKey:input_type -> grpc.service.webservice.RecreatePipedKeyRequest
+	6,   // 66: grpc.service.webservice.WebService.DeleteOldPipedKeys:input_type -> grpc.service.webservice.DeleteOldPipedKeysRequest
+	8,   // 67: grpc.service.webservice.WebService.EnablePiped:input_type -> grpc.service.webservice.EnablePipedRequest
+	10,  // 68: grpc.service.webservice.WebService.DisablePiped:input_type -> grpc.service.webservice.DisablePipedRequest
+	12,  // 69: grpc.service.webservice.WebService.ListPipeds:input_type -> grpc.service.webservice.ListPipedsRequest
+	14,  // 70: grpc.service.webservice.WebService.GetPiped:input_type -> grpc.service.webservice.GetPipedRequest
+	16,  // 71: grpc.service.webservice.WebService.UpdatePipedDesiredVersion:input_type -> grpc.service.webservice.UpdatePipedDesiredVersionRequest
+	18,  // 72: grpc.service.webservice.WebService.RestartPiped:input_type -> grpc.service.webservice.RestartPipedRequest
+	20,  // 73: grpc.service.webservice.WebService.ListReleasedVersions:input_type -> grpc.service.webservice.ListReleasedVersionsRequest
+	22,  // 74: grpc.service.webservice.WebService.ListDeprecatedNotes:input_type -> grpc.service.webservice.ListDeprecatedNotesRequest
+	24,  // 75: grpc.service.webservice.WebService.AddApplication:input_type -> grpc.service.webservice.AddApplicationRequest
+	26,  // 76: grpc.service.webservice.WebService.UpdateApplication:input_type -> grpc.service.webservice.UpdateApplicationRequest
+	28,  // 77: grpc.service.webservice.WebService.EnableApplication:input_type -> grpc.service.webservice.EnableApplicationRequest
+	30,  // 78: grpc.service.webservice.WebService.DisableApplication:input_type -> grpc.service.webservice.DisableApplicationRequest
+	32,  // 79: grpc.service.webservice.WebService.DeleteApplication:input_type -> grpc.service.webservice.DeleteApplicationRequest
+	34,  // 80: grpc.service.webservice.WebService.ListApplications:input_type -> grpc.service.webservice.ListApplicationsRequest
+	36,  // 81: grpc.service.webservice.WebService.SyncApplication:input_type -> grpc.service.webservice.SyncApplicationRequest
+	38,  // 82: grpc.service.webservice.WebService.SyncApplicationBypassingFreeze:input_type -> grpc.service.webservice.SyncApplicationBypassingFreezeRequest
+	40,  // 83: grpc.service.webservice.WebService.GetApplication:input_type -> grpc.service.webservice.GetApplicationRequest
+	42,  // 84: grpc.service.webservice.WebService.GenerateApplicationSealedSecret:input_type -> grpc.service.webservice.GenerateApplicationSealedSecretRequest
+	44,  // 85: grpc.service.webservice.WebService.ListUnregisteredApplications:input_type -> grpc.service.webservice.ListUnregisteredApplicationsRequest
+	46,  // 86: grpc.service.webservice.WebService.ListDeployments:input_type -> grpc.service.webservice.ListDeploymentsRequest
+	48,  // 87: grpc.service.webservice.WebService.GetDeployment:input_type -> grpc.service.webservice.GetDeploymentRequest
+	50,  // 88: grpc.service.webservice.WebService.GetStageLog:input_type -> grpc.service.webservice.GetStageLogRequest
+	52,  // 89: grpc.service.webservice.WebService.SearchStageLogs:input_type -> grpc.service.webservice.SearchStageLogsRequest
+	54,  // 90: grpc.service.webservice.WebService.CancelDeployment:input_type -> grpc.service.webservice.CancelDeploymentRequest
+	56,  // 91: grpc.service.webservice.WebService.SkipStage:input_type -> grpc.service.webservice.SkipStageRequest
+	58,  // 92: grpc.service.webservice.WebService.RetryStage:input_type -> grpc.service.webservice.RetryStageRequest
+	60,  // 93: grpc.service.webservice.WebService.ApproveStage:input_type -> grpc.service.webservice.ApproveStageRequest
+	62,  // 94: grpc.service.webservice.WebService.ListDeploymentTraces:input_type -> grpc.service.webservice.ListDeploymentTracesRequest
+	64,  // 95: grpc.service.webservice.WebService.GetApplicationLiveState:input_type -> grpc.service.webservice.GetApplicationLiveStateRequest
+	66,  // 96: grpc.service.webservice.WebService.GetProject:input_type -> grpc.service.webservice.GetProjectRequest
+	68,  // 97: grpc.service.webservice.WebService.UpdateProjectStaticAdmin:input_type -> grpc.service.webservice.UpdateProjectStaticAdminRequest
+	74,  // 98: grpc.service.webservice.WebService.EnableStaticAdmin:input_type -> grpc.service.webservice.EnableStaticAdminRequest
+	76,  // 99: grpc.service.webservice.WebService.DisableStaticAdmin:input_type -> grpc.service.webservice.DisableStaticAdminRequest
+	70,  // 100: grpc.service.webservice.WebService.UpdateProjectSSOConfig:input_type -> grpc.service.webservice.UpdateProjectSSOConfigRequest
+	72,  // 101: grpc.service.webservice.WebService.UpdateProjectRBACConfig:input_type -> grpc.service.webservice.UpdateProjectRBACConfigRequest
+	78,  // 102: grpc.service.webservice.WebService.GetMe:input_type -> grpc.service.webservice.GetMeRequest
+	80,  // 103: grpc.service.webservice.WebService.AddProjectRBACRole:input_type -> grpc.service.webservice.AddProjectRBACRoleRequest
+	82,  // 104: grpc.service.webservice.WebService.UpdateProjectRBACRole:input_type -> grpc.service.webservice.UpdateProjectRBACRoleRequest
+	84,  // 105: grpc.service.webservice.WebService.DeleteProjectRBACRole:input_type -> grpc.service.webservice.DeleteProjectRBACRoleRequest
+	86,  // 106: grpc.service.webservice.WebService.AddProjectUserGroup:input_type -> grpc.service.webservice.AddProjectUserGroupRequest
+	88,  // 107: grpc.service.webservice.WebService.DeleteProjectUserGroup:input_type -> grpc.service.webservice.DeleteProjectUserGroupRequest
+	90,  // 108: grpc.service.webservice.WebService.AddProjectDeploymentFreezeWindow:input_type -> grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest
+	92,  // 109: grpc.service.webservice.WebService.DeleteProjectDeploymentFreezeWindow:input_type -> grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest
+	94,  // 110: grpc.service.webservice.WebService.ListStaticUsers:input_type -> grpc.service.webservice.ListStaticUsersRequest
+	96,  // 111: grpc.service.webservice.WebService.AddStaticUser:input_type -> grpc.service.webservice.AddStaticUserRequest
+	98,  // 112: grpc.service.webservice.WebService.DeleteStaticUser:input_type -> grpc.service.webservice.DeleteStaticUserRequest
+	100, // 113: grpc.service.webservice.WebService.UpdateStaticUserRole:input_type -> grpc.service.webservice.UpdateStaticUserRoleRequest
+	102, // 114: grpc.service.webservice.WebService.ResetStaticUserPassword:input_type -> grpc.service.webservice.ResetStaticUserPasswordRequest
+	104, // 115: grpc.service.webservice.WebService.ChangeStaticUserPassword:input_type -> grpc.service.webservice.ChangeStaticUserPasswordRequest
+	106, // 116: grpc.service.webservice.WebService.GenerateStaticUserTOTPSecret:input_type -> grpc.service.webservice.GenerateStaticUserTOTPSecretRequest
+	108, // 117: grpc.service.webservice.WebService.EnableStaticUserTOTP:input_type -> grpc.service.webservice.EnableStaticUserTOTPRequest
+	110, // 118: grpc.service.webservice.WebService.DisableStaticUserTOTP:input_type -> grpc.service.webservice.DisableStaticUserTOTPRequest
+	112, // 119: grpc.service.webservice.WebService.GetCommand:input_type -> grpc.service.webservice.GetCommandRequest
+	114, // 120: grpc.service.webservice.WebService.GenerateAPIKey:input_type -> grpc.service.webservice.GenerateAPIKeyRequest
+	116, // 121: grpc.service.webservice.WebService.DisableAPIKey:input_type -> grpc.service.webservice.DisableAPIKeyRequest
+	118, // 122: grpc.service.webservice.WebService.ListAPIKeys:input_type -> grpc.service.webservice.ListAPIKeysRequest
+	120, // 123: grpc.service.webservice.WebService.GetInsightData:input_type -> grpc.service.webservice.GetInsightDataRequest
+	122, // 124: grpc.service.webservice.WebService.GetInsightApplicationCount:input_type -> grpc.service.webservice.GetInsightApplicationCountRequest
+	124, // 125: grpc.service.webservice.WebService.ListDeploymentChains:input_type -> grpc.service.webservice.ListDeploymentChainsRequest
+	126, // 126: grpc.service.webservice.WebService.GetDeploymentChain:input_type -> grpc.service.webservice.GetDeploymentChainRequest
+	128, // 127: grpc.service.webservice.WebService.CancelDeploymentChain:input_type -> grpc.service.webservice.CancelDeploymentChainRequest
+	130, // 128: grpc.service.webservice.WebService.ListEvents:input_type -> grpc.service.webservice.ListEventsRequest
+	1,   // 129: grpc.service.webservice.WebService.RegisterPiped:output_type -> grpc.service.webservice.RegisterPipedResponse
+	3,   // 130: grpc.service.webservice.WebService.UpdatePiped:output_type -> grpc.service.webservice.UpdatePipedResponse
+	5,   // 131: grpc.service.webservice.WebService.RecreatePipedKey:output_type -> grpc.service.webservice.RecreatePipedKeyResponse
+	7,   // 132: grpc.service.webservice.WebService.DeleteOldPipedKeys:output_type -> grpc.service.webservice.DeleteOldPipedKeysResponse
+	9,   // 133: grpc.service.webservice.WebService.EnablePiped:output_type -> grpc.service.webservice.EnablePipedResponse
+	11,  // 134: grpc.service.webservice.WebService.DisablePiped:output_type -> grpc.service.webservice.DisablePipedResponse
+	13,  // 135: grpc.service.webservice.WebService.ListPipeds:output_type -> grpc.service.webservice.ListPipedsResponse
+	15,  // 136: grpc.service.webservice.WebService.GetPiped:output_type -> grpc.service.webservice.GetPipedResponse
+	17,  // 137: grpc.service.webservice.WebService.UpdatePipedDesiredVersion:output_type -> grpc.service.webservice.UpdatePipedDesiredVersionResponse
+	19,  // 138: grpc.service.webservice.WebService.RestartPiped:output_type -> grpc.service.webservice.RestartPipedResponse
+	21,  // 139: grpc.service.webservice.WebService.ListReleasedVersions:output_type -> grpc.service.webservice.ListReleasedVersionsResponse
+	23,  // 140: grpc.service.webservice.WebService.ListDeprecatedNotes:output_type -> grpc.service.webservice.ListDeprecatedNotesResponse
+	25,  // 141: grpc.service.webservice.WebService.AddApplication:output_type -> grpc.service.webservice.AddApplicationResponse
+	27,  // 142: grpc.service.webservice.WebService.UpdateApplication:output_type -> grpc.service.webservice.UpdateApplicationResponse
+	29,  // 143: grpc.service.webservice.WebService.EnableApplication:output_type -> grpc.service.webservice.EnableApplicationResponse
+	31,  // 144: grpc.service.webservice.WebService.DisableApplication:output_type -> grpc.service.webservice.DisableApplicationResponse
+	33,  // 145: grpc.service.webservice.WebService.DeleteApplication:output_type -> grpc.service.webservice.DeleteApplicationResponse
+	35,  // 146: grpc.service.webservice.WebService.ListApplications:output_type -> grpc.service.webservice.ListApplicationsResponse
+	37,  // 147: grpc.service.webservice.WebService.SyncApplication:output_type -> grpc.service.webservice.SyncApplicationResponse
+	39,  // 148: grpc.service.webservice.WebService.SyncApplicationBypassingFreeze:output_type -> grpc.service.webservice.SyncApplicationBypassingFreezeResponse
+	41,  // 149: grpc.service.webservice.WebService.GetApplication:output_type -> grpc.service.webservice.GetApplicationResponse
+	43,  // 150: grpc.service.webservice.WebService.GenerateApplicationSealedSecret:output_type -> grpc.service.webservice.GenerateApplicationSealedSecretResponse
+	45,  // 151: grpc.service.webservice.WebService.ListUnregisteredApplications:output_type -> grpc.service.webservice.ListUnregisteredApplicationsResponse
+	47,  // 152: grpc.service.webservice.WebService.ListDeployments:output_type -> grpc.service.webservice.ListDeploymentsResponse
+	49,  // 153: grpc.service.webservice.WebService.GetDeployment:output_type -> grpc.service.webservice.GetDeploymentResponse
+	51,  // 154: grpc.service.webservice.WebService.GetStageLog:output_type -> grpc.service.webservice.GetStageLogResponse
+	53,  // 155: grpc.service.webservice.WebService.SearchStageLogs:output_type -> grpc.service.webservice.SearchStageLogsResponse
+	55,  // 156: grpc.service.webservice.WebService.CancelDeployment:output_type -> grpc.service.webservice.CancelDeploymentResponse
+	57,  // 157: grpc.service.webservice.WebService.SkipStage:output_type -> grpc.service.webservice.SkipStageResponse
+	59,  // 158: grpc.service.webservice.WebService.RetryStage:output_type -> grpc.service.webservice.RetryStageResponse
+	61,  // 159: grpc.service.webservice.WebService.ApproveStage:output_type -> grpc.service.webservice.ApproveStageResponse
+	63,  // 160: grpc.service.webservice.WebService.ListDeploymentTraces:output_type -> grpc.service.webservice.ListDeploymentTracesResponse
+	65,  // 161: grpc.service.webservice.WebService.GetApplicationLiveState:output_type -> grpc.service.webservice.GetApplicationLiveStateResponse
+	67,  // 162: grpc.service.webservice.WebService.GetProject:output_type -> grpc.service.webservice.GetProjectResponse
+	69,  // 163: grpc.service.webservice.WebService.UpdateProjectStaticAdmin:output_type -> grpc.service.webservice.UpdateProjectStaticAdminResponse
+	75,  // 164: grpc.service.webservice.WebService.EnableStaticAdmin:output_type -> grpc.service.webservice.EnableStaticAdminResponse
+	77,  // 165: grpc.service.webservice.WebService.DisableStaticAdmin:output_type -> grpc.service.webservice.DisableStaticAdminResponse
+	71,  // 166: grpc.service.webservice.WebService.UpdateProjectSSOConfig:output_type -> grpc.service.webservice.UpdateProjectSSOConfigResponse
+	73,  // 167: grpc.service.webservice.WebService.UpdateProjectRBACConfig:output_type -> grpc.service.webservice.UpdateProjectRBACConfigResponse
+	79,  // 168: grpc.service.webservice.WebService.GetMe:output_type -> grpc.service.webservice.GetMeResponse
+	81,  // 169: grpc.service.webservice.WebService.AddProjectRBACRole:output_type -> grpc.service.webservice.AddProjectRBACRoleResponse
+	83,  // 170: grpc.service.webservice.WebService.UpdateProjectRBACRole:output_type -> grpc.service.webservice.UpdateProjectRBACRoleResponse
+	85,  // 171: grpc.service.webservice.WebService.DeleteProjectRBACRole:output_type -> grpc.service.webservice.DeleteProjectRBACRoleResponse
+	87,  // 172: grpc.service.webservice.WebService.AddProjectUserGroup:output_type -> grpc.service.webservice.AddProjectUserGroupResponse
+	89,  // 173: grpc.service.webservice.WebService.DeleteProjectUserGroup:output_type -> grpc.service.webservice.DeleteProjectUserGroupResponse
+	91,  // 174: grpc.service.webservice.WebService.AddProjectDeploymentFreezeWindow:output_type -> grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse
+	93,  // 175: grpc.service.webservice.WebService.DeleteProjectDeploymentFreezeWindow:output_type -> grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse
+	95,  // 176: grpc.service.webservice.WebService.ListStaticUsers:output_type -> grpc.service.webservice.ListStaticUsersResponse
+	97,  // 177: grpc.service.webservice.WebService.AddStaticUser:output_type -> grpc.service.webservice.AddStaticUserResponse
+	99,  // 178: grpc.service.webservice.WebService.DeleteStaticUser:output_type -> grpc.service.webservice.DeleteStaticUserResponse
+	101, // 179: grpc.service.webservice.WebService.UpdateStaticUserRole:output_type -> grpc.service.webservice.UpdateStaticUserRoleResponse
+	103, // 180: grpc.service.webservice.WebService.ResetStaticUserPassword:output_type -> grpc.service.webservice.ResetStaticUserPasswordResponse
+	105, // 181: grpc.service.webservice.WebService.ChangeStaticUserPassword:output_type -> grpc.service.webservice.ChangeStaticUserPasswordResponse
+	107, // 182: grpc.service.webservice.WebService.GenerateStaticUserTOTPSecret:output_type -> grpc.service.webservice.GenerateStaticUserTOTPSecretResponse
+	109, // 183: grpc.service.webservice.WebService.EnableStaticUserTOTP:output_type -> grpc.service.webservice.EnableStaticUserTOTPResponse
+	111, // 184: grpc.service.webservice.WebService.DisableStaticUserTOTP:output_type -> grpc.service.webservice.DisableStaticUserTOTPResponse
+	113, // 185: grpc.service.webservice.WebService.GetCommand:output_type -> grpc.service.webservice.GetCommandResponse
+	115, // 186: grpc.service.webservice.WebService.GenerateAPIKey:output_type -> grpc.service.webservice.GenerateAPIKeyResponse
+	117, // 187: grpc.service.webservice.WebService.DisableAPIKey:output_type -> grpc.service.webservice.DisableAPIKeyResponse
+	119, // 188: grpc.service.webservice.WebService.ListAPIKeys:output_type -> grpc.service.webservice.ListAPIKeysResponse
+	121, // 189: grpc.service.webservice.WebService.GetInsightData:output_type -> grpc.service.webservice.GetInsightDataResponse
+	123, // 190: grpc.service.webservice.WebService.GetInsightApplicationCount:output_type -> grpc.service.webservice.GetInsightApplicationCountResponse
+	125, // 191: grpc.service.webservice.WebService.ListDeploymentChains:output_type -> grpc.service.webservice.ListDeploymentChainsResponse
+	127, // 192: grpc.service.webservice.WebService.GetDeploymentChain:output_type -> grpc.service.webservice.GetDeploymentChainResponse
+	129, // 193: grpc.service.webservice.WebService.CancelDeploymentChain:output_type -> grpc.service.webservice.CancelDeploymentChainResponse
+	131, // 194: grpc.service.webservice.WebService.ListEvents:output_type -> grpc.service.webservice.ListEventsResponse
+	129, // [129:195] is the sub-list for method output_type
+	63,  // [63:129] is the sub-list for method input_type
+	63,  // [63:63] is the sub-list for extension type_name
+	63,  // [63:63] is the sub-list for extension extendee
+	0,   // [0:63] is the sub-list for field type_name
 }
 
 func init() { file_pkg_app_server_service_webservice_service_proto_init() }
@@ -7039,8 +8942,200 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterPipedResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterPipedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePipedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePipedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecreatePipedKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecreatePipedKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteOldPipedKeysRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteOldPipedKeysResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnablePipedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnablePipedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisablePipedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisablePipedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPipedsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPipedsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPipedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPipedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePipedDesiredVersionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePipedDesiredVersionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7051,8 +9146,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePipedRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestartPipedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7063,8 +9158,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePipedResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestartPipedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7075,8 +9170,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecreatePipedKeyRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReleasedVersionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7087,8 +9182,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecreatePipedKeyResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReleasedVersionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7099,8 +9194,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteOldPipedKeysRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeprecatedNotesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7111,8 +9206,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteOldPipedKeysResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeprecatedNotesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7123,8 +9218,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnablePipedRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddApplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7135,8 +9230,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnablePipedResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddApplicationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7147,8 +9242,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DisablePipedRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateApplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7159,8 +9254,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DisablePipedResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateApplicationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7171,8 +9266,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPipedsRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableApplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7183,8 +9278,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPipedsResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableApplicationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7195,8 +9290,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPipedRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableApplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7207,8 +9302,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPipedResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableApplicationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7219,8 +9314,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePipedDesiredVersionRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteApplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7231,8 +9326,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePipedDesiredVersionResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteApplicationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7243,8 +9338,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestartPipedRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListApplicationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7255,8 +9350,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestartPipedResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListApplicationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7267,8 +9362,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListReleasedVersionsRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncApplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7279,8 +9374,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListReleasedVersionsResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncApplicationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7291,8 +9386,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDeprecatedNotesRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncApplicationBypassingFreezeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7303,8 +9398,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDeprecatedNotesResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncApplicationBypassingFreezeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7315,8 +9410,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddApplicationRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetApplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7327,8 +9422,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddApplicationResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetApplicationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7339,8 +9434,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateApplicationRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateApplicationSealedSecretRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7351,8 +9446,152 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateApplicationResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateApplicationSealedSecretResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUnregisteredApplicationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUnregisteredApplicationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeploymentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeploymentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeploymentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeploymentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStageLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStageLogResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchStageLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchStageLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelDeploymentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelDeploymentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7363,8 +9602,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnableApplicationRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SkipStageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7375,8 +9614,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnableApplicationResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SkipStageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7387,8 +9626,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DisableApplicationRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryStageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7399,8 +9638,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DisableApplicationResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryStageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7411,8 +9650,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteApplicationRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveStageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7423,8 +9662,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteApplicationResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveStageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7435,8 +9674,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListApplicationsRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeploymentTracesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7447,8 +9686,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListApplicationsResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeploymentTracesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7459,8 +9698,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncApplicationRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetApplicationLiveStateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7471,8 +9710,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncApplicationResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetApplicationLiveStateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7483,8 +9722,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetApplicationRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7495,8 +9734,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetApplicationResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProjectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7507,8 +9746,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateApplicationSealedSecretRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProjectStaticAdminRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7519,8 +9758,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateApplicationSealedSecretResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProjectStaticAdminResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7531,8 +9770,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUnregisteredApplicationsRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProjectSSOConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7543,8 +9782,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUnregisteredApplicationsResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProjectSSOConfigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7555,8 +9794,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDeploymentsRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProjectRBACConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7567,8 +9806,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDeploymentsResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProjectRBACConfigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7579,8 +9818,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDeploymentRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableStaticAdminRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7591,8 +9830,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDeploymentResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableStaticAdminResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7603,8 +9842,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStageLogRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableStaticAdminRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7615,8 +9854,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStageLogResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableStaticAdminResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7627,8 +9866,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelDeploymentRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7639,8 +9878,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelDeploymentResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7651,8 +9890,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SkipStageRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddProjectRBACRoleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7663,8 +9902,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SkipStageResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddProjectRBACRoleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7675,8 +9914,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApproveStageRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProjectRBACRoleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7687,8 +9926,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApproveStageResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProjectRBACRoleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7699,8 +9938,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDeploymentTracesRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProjectRBACRoleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7711,8 +9950,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDeploymentTracesResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProjectRBACRoleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7723,8 +9962,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetApplicationLiveStateRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddProjectUserGroupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7735,8 +9974,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetApplicationLiveStateResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddProjectUserGroupResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7747,8 +9986,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProjectRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProjectUserGroupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7759,8 +9998,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProjectResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProjectUserGroupResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7771,8 +10010,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateProjectStaticAdminRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddProjectDeploymentFreezeWindowRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7783,8 +10022,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateProjectStaticAdminResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddProjectDeploymentFreezeWindowResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7795,8 +10034,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateProjectSSOConfigRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProjectDeploymentFreezeWindowRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7807,8 +10046,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateProjectSSOConfigResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProjectDeploymentFreezeWindowResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7819,8 +10058,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateProjectRBACConfigRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStaticUsersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7831,8 +10070,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateProjectRBACConfigResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStaticUsersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7843,8 +10082,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnableStaticAdminRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddStaticUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7855,8 +10094,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnableStaticAdminResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddStaticUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7867,8 +10106,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DisableStaticAdminRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteStaticUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7879,8 +10118,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DisableStaticAdminResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteStaticUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7891,8 +10130,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMeRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateStaticUserRoleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7903,8 +10142,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMeResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateStaticUserRoleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7915,8 +10154,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddProjectRBACRoleRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetStaticUserPasswordRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7927,8 +10166,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddProjectRBACRoleResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetStaticUserPasswordResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7939,8 +10178,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateProjectRBACRoleRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeStaticUserPasswordRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7951,8 +10190,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateProjectRBACRoleResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeStaticUserPasswordResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7963,8 +10202,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteProjectRBACRoleRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateStaticUserTOTPSecretRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7975,8 +10214,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteProjectRBACRoleResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateStaticUserTOTPSecretResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7987,8 +10226,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddProjectUserGroupRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableStaticUserTOTPRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7999,8 +10238,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddProjectUserGroupResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableStaticUserTOTPResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8011,8 +10250,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteProjectUserGroupRequest); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableStaticUserTOTPRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8023,8 +10262,8 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteProjectUserGroupResponse); i {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableStaticUserTOTPResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8035,7 +10274,7 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCommandRequest); i {
 			case 0:
 				return &v.state
@@ -8047,7 +10286,7 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCommandResponse); i {
 			case 0:
 				return &v.state
@@ -8059,7 +10298,7 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenerateAPIKeyRequest); i {
 			case 0:
 				return &v.state
@@ -8071,7 +10310,7 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenerateAPIKeyResponse); i {
 			case 0:
 				return &v.state
@@ -8083,7 +10322,7 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DisableAPIKeyRequest); i {
 			case 0:
 				return &v.state
@@ -8095,7 +10334,7 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DisableAPIKeyResponse); i {
 			case 0:
 				return &v.state
@@ -8107,7 +10346,7 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListAPIKeysRequest); i {
 			case 0:
 				return &v.state
@@ -8119,7 +10358,7 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListAPIKeysResponse); i {
 			case 0:
 				return &v.state
@@ -8131,7 +10370,7 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetInsightDataRequest); i {
 			case 0:
 				return &v.state
@@ -8143,7 +10382,7 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetInsightDataResponse); i {
 			case 0:
 				return &v.state
@@ -8155,7 +10394,7 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetInsightApplicationCountRequest); i {
 			case 0:
 				return &v.state
@@ -8167,7 +10406,7 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetInsightApplicationCountResponse); i {
 			case 0:
 				return &v.state
@@ -8179,7 +10418,7 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListDeploymentChainsRequest); i {
 			case 0:
 				return &v.state
@@ -8191,7 +10430,7 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListDeploymentChainsResponse); i {
 			case 0:
 				return &v.state
@@ -8203,7 +10442,7 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetDeploymentChainRequest); i {
 			case 0:
 				return &v.state
@@ -8215,7 +10454,7 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetDeploymentChainResponse); i {
 			case 0:
 				return &v.state
@@ -8227,7 +10466,31 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelDeploymentChainRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelDeploymentChainResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListEventsRequest); i {
 			case 0:
 				return &v.state
@@ -8239,7 +10502,7 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListEventsResponse); i {
 			case 0:
 				return &v.state
@@ -8251,7 +10514,7 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListPipedsRequest_Options); i {
 			case 0:
 				return &v.state
@@ -8263,7 +10526,7 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListApplicationsRequest_Options); i {
 			case 0:
 				return &v.state
@@ -8275,7 +10538,7 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListDeploymentsRequest_Options); i {
 			case 0:
 				return &v.state
@@ -8287,7 +10550,19 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchStageLogsResponse_Match); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListDeploymentTracesRequest_Options); i {
 			case 0:
 				return &v.state
@@ -8299,7 +10574,7 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListDeploymentTracesResponse_DeploymentTraceRes); i {
 			case 0:
 				return &v.state
@@ -8311,7 +10586,7 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListAPIKeysRequest_Options); i {
 			case 0:
 				return &v.state
@@ -8323,7 +10598,7 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListDeploymentChainsRequest_Options); i {
 			case 0:
 				return &v.state
@@ -8335,7 +10610,7 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_app_server_service_webservice_service_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_app_server_service_webservice_service_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListEventsRequest_Options); i {
 			case 0:
 				return &v.state
@@ -8354,7 +10629,7 @@ func file_pkg_app_server_service_webservice_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_app_server_service_webservice_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   117,
+			NumMessages:   148,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
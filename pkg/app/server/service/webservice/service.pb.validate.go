@@ -4483,6 +4483,243 @@ var _ interface {
 	ErrorName() string
 } = SyncApplicationResponseValidationError{}
 
+// Validate checks the field values on SyncApplicationBypassingFreezeRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SyncApplicationBypassingFreezeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SyncApplicationBypassingFreezeRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SyncApplicationBypassingFreezeRequestMultiError, or nil if none found.
+func (m *SyncApplicationBypassingFreezeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncApplicationBypassingFreezeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetApplicationId()) < 1 {
+		err := SyncApplicationBypassingFreezeRequestValidationError{
+			field:  "ApplicationId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SyncStrategy
+
+	if utf8.RuneCountInString(m.GetJustification()) < 1 {
+		err := SyncApplicationBypassingFreezeRequestValidationError{
+			field:  "Justification",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SyncApplicationBypassingFreezeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SyncApplicationBypassingFreezeRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// SyncApplicationBypassingFreezeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SyncApplicationBypassingFreezeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SyncApplicationBypassingFreezeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SyncApplicationBypassingFreezeRequestMultiError) AllErrors() []error { return m }
+
+// SyncApplicationBypassingFreezeRequestValidationError is the validation error
+// returned by SyncApplicationBypassingFreezeRequest.Validate if the
+// designated constraints aren't met.
+type SyncApplicationBypassingFreezeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncApplicationBypassingFreezeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncApplicationBypassingFreezeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncApplicationBypassingFreezeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncApplicationBypassingFreezeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncApplicationBypassingFreezeRequestValidationError) ErrorName() string {
+	return "SyncApplicationBypassingFreezeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SyncApplicationBypassingFreezeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncApplicationBypassingFreezeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncApplicationBypassingFreezeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncApplicationBypassingFreezeRequestValidationError{}
+
+// Validate checks the field values on SyncApplicationBypassingFreezeResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SyncApplicationBypassingFreezeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// SyncApplicationBypassingFreezeResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// SyncApplicationBypassingFreezeResponseMultiError, or nil if none found.
+func (m *SyncApplicationBypassingFreezeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncApplicationBypassingFreezeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CommandId
+
+	if len(errors) > 0 {
+		return SyncApplicationBypassingFreezeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SyncApplicationBypassingFreezeResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// SyncApplicationBypassingFreezeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SyncApplicationBypassingFreezeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SyncApplicationBypassingFreezeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SyncApplicationBypassingFreezeResponseMultiError) AllErrors() []error { return m }
+
+// SyncApplicationBypassingFreezeResponseValidationError is the validation
+// error returned by SyncApplicationBypassingFreezeResponse.Validate if the
+// designated constraints aren't met.
+type SyncApplicationBypassingFreezeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncApplicationBypassingFreezeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncApplicationBypassingFreezeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncApplicationBypassingFreezeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncApplicationBypassingFreezeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncApplicationBypassingFreezeResponseValidationError) ErrorName() string {
+	return "SyncApplicationBypassingFreezeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SyncApplicationBypassingFreezeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncApplicationBypassingFreezeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncApplicationBypassingFreezeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncApplicationBypassingFreezeResponseValidationError{}
+
 // Validate checks the field values on GetApplicationRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -5785,6 +6022,8 @@ func (m *GetStageLogRequest) validate(all bool) error {
 
 	// no validation rules for OffsetIndex
 
+	// no validation rules for Verbose
+
 	if len(errors) > 0 {
 		return GetStageLogRequestMultiError(errors)
 	}
@@ -6003,31 +6242,31 @@ var _ interface {
 	ErrorName() string
 } = GetStageLogResponseValidationError{}
 
-// Validate checks the field values on CancelDeploymentRequest with the rules
+// Validate checks the field values on SearchStageLogsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelDeploymentRequest) Validate() error {
+func (m *SearchStageLogsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelDeploymentRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SearchStageLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelDeploymentRequestMultiError, or nil if none found.
-func (m *CancelDeploymentRequest) ValidateAll() error {
+// SearchStageLogsRequestMultiError, or nil if none found.
+func (m *SearchStageLogsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelDeploymentRequest) validate(all bool) error {
+func (m *SearchStageLogsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
-		err := CancelDeploymentRequestValidationError{
-			field:  "DeploymentId",
+	if utf8.RuneCountInString(m.GetApplicationId()) < 1 {
+		err := SearchStageLogsRequestValidationError{
+			field:  "ApplicationId",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -6036,24 +6275,71 @@ func (m *CancelDeploymentRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for ForceRollback
+	if m.GetFrom() < 0 {
+		err := SearchStageLogsRequestValidationError{
+			field:  "From",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for ForceNoRollback
+	if m.GetTo() < 0 {
+		err := SearchStageLogsRequestValidationError{
+			field:  "To",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetSeverities() {
+		_, _ = idx, item
+
+		if _, ok := model.LogSeverity_name[int32(item)]; !ok {
+			err := SearchStageLogsRequestValidationError{
+				field:  fmt.Sprintf("Severities[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Query
+
+	if m.GetLimit() < 0 {
+		err := SearchStageLogsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return CancelDeploymentRequestMultiError(errors)
+		return SearchStageLogsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelDeploymentRequestMultiError is an error wrapping multiple validation
-// errors returned by CancelDeploymentRequest.ValidateAll() if the designated
+// SearchStageLogsRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchStageLogsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CancelDeploymentRequestMultiError []error
+type SearchStageLogsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelDeploymentRequestMultiError) Error() string {
+func (m SearchStageLogsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6062,11 +6348,11 @@ func (m CancelDeploymentRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelDeploymentRequestMultiError) AllErrors() []error { return m }
+func (m SearchStageLogsRequestMultiError) AllErrors() []error { return m }
 
-// CancelDeploymentRequestValidationError is the validation error returned by
-// CancelDeploymentRequest.Validate if the designated constraints aren't met.
-type CancelDeploymentRequestValidationError struct {
+// SearchStageLogsRequestValidationError is the validation error returned by
+// SearchStageLogsRequest.Validate if the designated constraints aren't met.
+type SearchStageLogsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6074,24 +6360,24 @@ type CancelDeploymentRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelDeploymentRequestValidationError) Field() string { return e.field }
+func (e SearchStageLogsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelDeploymentRequestValidationError) Reason() string { return e.reason }
+func (e SearchStageLogsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelDeploymentRequestValidationError) Cause() error { return e.cause }
+func (e SearchStageLogsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelDeploymentRequestValidationError) Key() bool { return e.key }
+func (e SearchStageLogsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelDeploymentRequestValidationError) ErrorName() string {
-	return "CancelDeploymentRequestValidationError"
+func (e SearchStageLogsRequestValidationError) ErrorName() string {
+	return "SearchStageLogsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CancelDeploymentRequestValidationError) Error() string {
+func (e SearchStageLogsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6103,14 +6389,14 @@ func (e CancelDeploymentRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelDeploymentRequest.%s: %s%s",
+		"invalid %sSearchStageLogsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelDeploymentRequestValidationError{}
+var _ error = SearchStageLogsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6118,34 +6404,287 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelDeploymentRequestValidationError{}
+} = SearchStageLogsRequestValidationError{}
 
-// Validate checks the field values on CancelDeploymentResponse with the rules
+// Validate checks the field values on SearchStageLogsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelDeploymentResponse) Validate() error {
+func (m *SearchStageLogsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelDeploymentResponse with the
+// ValidateAll checks the field values on SearchStageLogsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelDeploymentResponseMultiError, or nil if none found.
-func (m *CancelDeploymentResponse) ValidateAll() error {
+// SearchStageLogsResponseMultiError, or nil if none found.
+func (m *SearchStageLogsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelDeploymentResponse) validate(all bool) error {
+func (m *SearchStageLogsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CommandId
+	for idx, item := range m.GetMatches() {
+		_, _ = idx, item
 
-	if len(errors) > 0 {
-		return CancelDeploymentResponseMultiError(errors)
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchStageLogsResponseValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchStageLogsResponseValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchStageLogsResponseValidationError{
+					field:  fmt.Sprintf("Matches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchStageLogsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchStageLogsResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchStageLogsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchStageLogsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchStageLogsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchStageLogsResponseMultiError) AllErrors() []error { return m }
+
+// SearchStageLogsResponseValidationError is the validation error returned by
+// SearchStageLogsResponse.Validate if the designated constraints aren't met.
+type SearchStageLogsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchStageLogsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchStageLogsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchStageLogsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchStageLogsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchStageLogsResponseValidationError) ErrorName() string {
+	return "SearchStageLogsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchStageLogsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchStageLogsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchStageLogsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchStageLogsResponseValidationError{}
+
+// Validate checks the field values on CancelDeploymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelDeploymentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelDeploymentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelDeploymentRequestMultiError, or nil if none found.
+func (m *CancelDeploymentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelDeploymentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := CancelDeploymentRequestValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ForceRollback
+
+	// no validation rules for ForceNoRollback
+
+	if len(errors) > 0 {
+		return CancelDeploymentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelDeploymentRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelDeploymentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CancelDeploymentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelDeploymentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelDeploymentRequestMultiError) AllErrors() []error { return m }
+
+// CancelDeploymentRequestValidationError is the validation error returned by
+// CancelDeploymentRequest.Validate if the designated constraints aren't met.
+type CancelDeploymentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelDeploymentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelDeploymentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelDeploymentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelDeploymentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelDeploymentRequestValidationError) ErrorName() string {
+	return "CancelDeploymentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelDeploymentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelDeploymentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelDeploymentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelDeploymentRequestValidationError{}
+
+// Validate checks the field values on CancelDeploymentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelDeploymentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelDeploymentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelDeploymentResponseMultiError, or nil if none found.
+func (m *CancelDeploymentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelDeploymentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CommandId
+
+	if len(errors) > 0 {
+		return CancelDeploymentResponseMultiError(errors)
 	}
 
 	return nil
@@ -6450,22 +6989,22 @@ var _ interface {
 	ErrorName() string
 } = SkipStageResponseValidationError{}
 
-// Validate checks the field values on ApproveStageRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ApproveStageRequest) Validate() error {
+// Validate checks the field values on RetryStageRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RetryStageRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ApproveStageRequest with the rules
+// ValidateAll checks the field values on RetryStageRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ApproveStageRequestMultiError, or nil if none found.
-func (m *ApproveStageRequest) ValidateAll() error {
+// RetryStageRequestMultiError, or nil if none found.
+func (m *RetryStageRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ApproveStageRequest) validate(all bool) error {
+func (m *RetryStageRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6473,7 +7012,7 @@ func (m *ApproveStageRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
-		err := ApproveStageRequestValidationError{
+		err := RetryStageRequestValidationError{
 			field:  "DeploymentId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -6484,7 +7023,7 @@ func (m *ApproveStageRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetStageId()) < 1 {
-		err := ApproveStageRequestValidationError{
+		err := RetryStageRequestValidationError{
 			field:  "StageId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -6495,19 +7034,19 @@ func (m *ApproveStageRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ApproveStageRequestMultiError(errors)
+		return RetryStageRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ApproveStageRequestMultiError is an error wrapping multiple validation
-// errors returned by ApproveStageRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ApproveStageRequestMultiError []error
+// RetryStageRequestMultiError is an error wrapping multiple validation errors
+// returned by RetryStageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RetryStageRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ApproveStageRequestMultiError) Error() string {
+func (m RetryStageRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6516,11 +7055,11 @@ func (m ApproveStageRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ApproveStageRequestMultiError) AllErrors() []error { return m }
+func (m RetryStageRequestMultiError) AllErrors() []error { return m }
 
-// ApproveStageRequestValidationError is the validation error returned by
-// ApproveStageRequest.Validate if the designated constraints aren't met.
-type ApproveStageRequestValidationError struct {
+// RetryStageRequestValidationError is the validation error returned by
+// RetryStageRequest.Validate if the designated constraints aren't met.
+type RetryStageRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6528,16 +7067,244 @@ type ApproveStageRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ApproveStageRequestValidationError) Field() string { return e.field }
+func (e RetryStageRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ApproveStageRequestValidationError) Reason() string { return e.reason }
+func (e RetryStageRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ApproveStageRequestValidationError) Cause() error { return e.cause }
+func (e RetryStageRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ApproveStageRequestValidationError) Key() bool { return e.key }
+func (e RetryStageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetryStageRequestValidationError) ErrorName() string {
+	return "RetryStageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RetryStageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetryStageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetryStageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetryStageRequestValidationError{}
+
+// Validate checks the field values on RetryStageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RetryStageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetryStageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RetryStageResponseMultiError, or nil if none found.
+func (m *RetryStageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetryStageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CommandId
+
+	if len(errors) > 0 {
+		return RetryStageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetryStageResponseMultiError is an error wrapping multiple validation errors
+// returned by RetryStageResponse.ValidateAll() if the designated constraints
+// aren't met.
+type RetryStageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetryStageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetryStageResponseMultiError) AllErrors() []error { return m }
+
+// RetryStageResponseValidationError is the validation error returned by
+// RetryStageResponse.Validate if the designated constraints aren't met.
+type RetryStageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetryStageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetryStageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetryStageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetryStageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetryStageResponseValidationError) ErrorName() string {
+	return "RetryStageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RetryStageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetryStageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetryStageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetryStageResponseValidationError{}
+
+// Validate checks the field values on ApproveStageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveStageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveStageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveStageRequestMultiError, or nil if none found.
+func (m *ApproveStageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveStageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := ApproveStageRequestValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStageId()) < 1 {
+		err := ApproveStageRequestValidationError{
+			field:  "StageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ApproveStageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveStageRequestMultiError is an error wrapping multiple validation
+// errors returned by ApproveStageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveStageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveStageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveStageRequestMultiError) AllErrors() []error { return m }
+
+// ApproveStageRequestValidationError is the validation error returned by
+// ApproveStageRequest.Validate if the designated constraints aren't met.
+type ApproveStageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveStageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveStageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveStageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveStageRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
 func (e ApproveStageRequestValidationError) ErrorName() string {
@@ -9108,19 +9875,2531 @@ func (m *UpdateProjectRBACRoleRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateProjectRBACRoleRequestMultiError(errors)
+		return UpdateProjectRBACRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateProjectRBACRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateProjectRBACRoleRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateProjectRBACRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateProjectRBACRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateProjectRBACRoleRequestMultiError) AllErrors() []error { return m }
+
+// UpdateProjectRBACRoleRequestValidationError is the validation error returned
+// by UpdateProjectRBACRoleRequest.Validate if the designated constraints
+// aren't met.
+type UpdateProjectRBACRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateProjectRBACRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateProjectRBACRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateProjectRBACRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateProjectRBACRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateProjectRBACRoleRequestValidationError) ErrorName() string {
+	return "UpdateProjectRBACRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateProjectRBACRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateProjectRBACRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateProjectRBACRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateProjectRBACRoleRequestValidationError{}
+
+// Validate checks the field values on UpdateProjectRBACRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateProjectRBACRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateProjectRBACRoleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateProjectRBACRoleResponseMultiError, or nil if none found.
+func (m *UpdateProjectRBACRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateProjectRBACRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateProjectRBACRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateProjectRBACRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateProjectRBACRoleResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateProjectRBACRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateProjectRBACRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateProjectRBACRoleResponseMultiError) AllErrors() []error { return m }
+
+// UpdateProjectRBACRoleResponseValidationError is the validation error
+// returned by UpdateProjectRBACRoleResponse.Validate if the designated
+// constraints aren't met.
+type UpdateProjectRBACRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateProjectRBACRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateProjectRBACRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateProjectRBACRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateProjectRBACRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateProjectRBACRoleResponseValidationError) ErrorName() string {
+	return "UpdateProjectRBACRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateProjectRBACRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateProjectRBACRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateProjectRBACRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateProjectRBACRoleResponseValidationError{}
+
+// Validate checks the field values on DeleteProjectRBACRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteProjectRBACRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteProjectRBACRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteProjectRBACRoleRequestMultiError, or nil if none found.
+func (m *DeleteProjectRBACRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteProjectRBACRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := DeleteProjectRBACRoleRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteProjectRBACRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteProjectRBACRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteProjectRBACRoleRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteProjectRBACRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteProjectRBACRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteProjectRBACRoleRequestMultiError) AllErrors() []error { return m }
+
+// DeleteProjectRBACRoleRequestValidationError is the validation error returned
+// by DeleteProjectRBACRoleRequest.Validate if the designated constraints
+// aren't met.
+type DeleteProjectRBACRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteProjectRBACRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteProjectRBACRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteProjectRBACRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteProjectRBACRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteProjectRBACRoleRequestValidationError) ErrorName() string {
+	return "DeleteProjectRBACRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteProjectRBACRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteProjectRBACRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteProjectRBACRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteProjectRBACRoleRequestValidationError{}
+
+// Validate checks the field values on DeleteProjectRBACRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteProjectRBACRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteProjectRBACRoleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteProjectRBACRoleResponseMultiError, or nil if none found.
+func (m *DeleteProjectRBACRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteProjectRBACRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteProjectRBACRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteProjectRBACRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteProjectRBACRoleResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteProjectRBACRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteProjectRBACRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteProjectRBACRoleResponseMultiError) AllErrors() []error { return m }
+
+// DeleteProjectRBACRoleResponseValidationError is the validation error
+// returned by DeleteProjectRBACRoleResponse.Validate if the designated
+// constraints aren't met.
+type DeleteProjectRBACRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteProjectRBACRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteProjectRBACRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteProjectRBACRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteProjectRBACRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteProjectRBACRoleResponseValidationError) ErrorName() string {
+	return "DeleteProjectRBACRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteProjectRBACRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteProjectRBACRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteProjectRBACRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteProjectRBACRoleResponseValidationError{}
+
+// Validate checks the field values on AddProjectUserGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddProjectUserGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddProjectUserGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddProjectUserGroupRequestMultiError, or nil if none found.
+func (m *AddProjectUserGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddProjectUserGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSsoGroup()) < 1 {
+		err := AddProjectUserGroupRequestValidationError{
+			field:  "SsoGroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := AddProjectUserGroupRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddProjectUserGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddProjectUserGroupRequestMultiError is an error wrapping multiple
+// validation errors returned by AddProjectUserGroupRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AddProjectUserGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddProjectUserGroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddProjectUserGroupRequestMultiError) AllErrors() []error { return m }
+
+// AddProjectUserGroupRequestValidationError is the validation error returned
+// by AddProjectUserGroupRequest.Validate if the designated constraints aren't met.
+type AddProjectUserGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddProjectUserGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddProjectUserGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddProjectUserGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddProjectUserGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddProjectUserGroupRequestValidationError) ErrorName() string {
+	return "AddProjectUserGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddProjectUserGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddProjectUserGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddProjectUserGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddProjectUserGroupRequestValidationError{}
+
+// Validate checks the field values on AddProjectUserGroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddProjectUserGroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddProjectUserGroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddProjectUserGroupResponseMultiError, or nil if none found.
+func (m *AddProjectUserGroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddProjectUserGroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AddProjectUserGroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddProjectUserGroupResponseMultiError is an error wrapping multiple
+// validation errors returned by AddProjectUserGroupResponse.ValidateAll() if
+// the designated constraints aren't met.
+type AddProjectUserGroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddProjectUserGroupResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddProjectUserGroupResponseMultiError) AllErrors() []error { return m }
+
+// AddProjectUserGroupResponseValidationError is the validation error returned
+// by AddProjectUserGroupResponse.Validate if the designated constraints
+// aren't met.
+type AddProjectUserGroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddProjectUserGroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddProjectUserGroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddProjectUserGroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddProjectUserGroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddProjectUserGroupResponseValidationError) ErrorName() string {
+	return "AddProjectUserGroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddProjectUserGroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddProjectUserGroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddProjectUserGroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddProjectUserGroupResponseValidationError{}
+
+// Validate checks the field values on DeleteProjectUserGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteProjectUserGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteProjectUserGroupRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteProjectUserGroupRequestMultiError, or nil if none found.
+func (m *DeleteProjectUserGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteProjectUserGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSsoGroup()) < 1 {
+		err := DeleteProjectUserGroupRequestValidationError{
+			field:  "SsoGroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteProjectUserGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteProjectUserGroupRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteProjectUserGroupRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteProjectUserGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteProjectUserGroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteProjectUserGroupRequestMultiError) AllErrors() []error { return m }
+
+// DeleteProjectUserGroupRequestValidationError is the validation error
+// returned by DeleteProjectUserGroupRequest.Validate if the designated
+// constraints aren't met.
+type DeleteProjectUserGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteProjectUserGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteProjectUserGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteProjectUserGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteProjectUserGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteProjectUserGroupRequestValidationError) ErrorName() string {
+	return "DeleteProjectUserGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteProjectUserGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteProjectUserGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteProjectUserGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteProjectUserGroupRequestValidationError{}
+
+// Validate checks the field values on DeleteProjectUserGroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteProjectUserGroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteProjectUserGroupResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteProjectUserGroupResponseMultiError, or nil if none found.
+func (m *DeleteProjectUserGroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteProjectUserGroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteProjectUserGroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteProjectUserGroupResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteProjectUserGroupResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteProjectUserGroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteProjectUserGroupResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteProjectUserGroupResponseMultiError) AllErrors() []error { return m }
+
+// DeleteProjectUserGroupResponseValidationError is the validation error
+// returned by DeleteProjectUserGroupResponse.Validate if the designated
+// constraints aren't met.
+type DeleteProjectUserGroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteProjectUserGroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteProjectUserGroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteProjectUserGroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteProjectUserGroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteProjectUserGroupResponseValidationError) ErrorName() string {
+	return "DeleteProjectUserGroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteProjectUserGroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteProjectUserGroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteProjectUserGroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteProjectUserGroupResponseValidationError{}
+
+// Validate checks the field values on AddProjectDeploymentFreezeWindowRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AddProjectDeploymentFreezeWindowRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// AddProjectDeploymentFreezeWindowRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// AddProjectDeploymentFreezeWindowRequestMultiError, or nil if none found.
+func (m *AddProjectDeploymentFreezeWindowRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddProjectDeploymentFreezeWindowRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetWindow() == nil {
+		err := AddProjectDeploymentFreezeWindowRequestValidationError{
+			field:  "Window",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetWindow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddProjectDeploymentFreezeWindowRequestValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddProjectDeploymentFreezeWindowRequestValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWindow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddProjectDeploymentFreezeWindowRequestValidationError{
+				field:  "Window",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddProjectDeploymentFreezeWindowRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddProjectDeploymentFreezeWindowRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// AddProjectDeploymentFreezeWindowRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddProjectDeploymentFreezeWindowRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddProjectDeploymentFreezeWindowRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddProjectDeploymentFreezeWindowRequestMultiError) AllErrors() []error { return m }
+
+// AddProjectDeploymentFreezeWindowRequestValidationError is the validation
+// error returned by AddProjectDeploymentFreezeWindowRequest.Validate if the
+// designated constraints aren't met.
+type AddProjectDeploymentFreezeWindowRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddProjectDeploymentFreezeWindowRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddProjectDeploymentFreezeWindowRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddProjectDeploymentFreezeWindowRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddProjectDeploymentFreezeWindowRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddProjectDeploymentFreezeWindowRequestValidationError) ErrorName() string {
+	return "AddProjectDeploymentFreezeWindowRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddProjectDeploymentFreezeWindowRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddProjectDeploymentFreezeWindowRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddProjectDeploymentFreezeWindowRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddProjectDeploymentFreezeWindowRequestValidationError{}
+
+// Validate checks the field values on AddProjectDeploymentFreezeWindowResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AddProjectDeploymentFreezeWindowResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// AddProjectDeploymentFreezeWindowResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// AddProjectDeploymentFreezeWindowResponseMultiError, or nil if none found.
+func (m *AddProjectDeploymentFreezeWindowResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddProjectDeploymentFreezeWindowResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AddProjectDeploymentFreezeWindowResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddProjectDeploymentFreezeWindowResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// AddProjectDeploymentFreezeWindowResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddProjectDeploymentFreezeWindowResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddProjectDeploymentFreezeWindowResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddProjectDeploymentFreezeWindowResponseMultiError) AllErrors() []error { return m }
+
+// AddProjectDeploymentFreezeWindowResponseValidationError is the validation
+// error returned by AddProjectDeploymentFreezeWindowResponse.Validate if the
+// designated constraints aren't met.
+type AddProjectDeploymentFreezeWindowResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddProjectDeploymentFreezeWindowResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddProjectDeploymentFreezeWindowResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddProjectDeploymentFreezeWindowResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddProjectDeploymentFreezeWindowResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddProjectDeploymentFreezeWindowResponseValidationError) ErrorName() string {
+	return "AddProjectDeploymentFreezeWindowResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddProjectDeploymentFreezeWindowResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddProjectDeploymentFreezeWindowResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddProjectDeploymentFreezeWindowResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddProjectDeploymentFreezeWindowResponseValidationError{}
+
+// Validate checks the field values on
+// DeleteProjectDeploymentFreezeWindowRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeleteProjectDeploymentFreezeWindowRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// DeleteProjectDeploymentFreezeWindowRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// DeleteProjectDeploymentFreezeWindowRequestMultiError, or nil if none found.
+func (m *DeleteProjectDeploymentFreezeWindowRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteProjectDeploymentFreezeWindowRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := DeleteProjectDeploymentFreezeWindowRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteProjectDeploymentFreezeWindowRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteProjectDeploymentFreezeWindowRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// DeleteProjectDeploymentFreezeWindowRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteProjectDeploymentFreezeWindowRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteProjectDeploymentFreezeWindowRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteProjectDeploymentFreezeWindowRequestMultiError) AllErrors() []error { return m }
+
+// DeleteProjectDeploymentFreezeWindowRequestValidationError is the validation
+// error returned by DeleteProjectDeploymentFreezeWindowRequest.Validate if
+// the designated constraints aren't met.
+type DeleteProjectDeploymentFreezeWindowRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteProjectDeploymentFreezeWindowRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteProjectDeploymentFreezeWindowRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteProjectDeploymentFreezeWindowRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteProjectDeploymentFreezeWindowRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteProjectDeploymentFreezeWindowRequestValidationError) ErrorName() string {
+	return "DeleteProjectDeploymentFreezeWindowRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteProjectDeploymentFreezeWindowRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteProjectDeploymentFreezeWindowRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteProjectDeploymentFreezeWindowRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteProjectDeploymentFreezeWindowRequestValidationError{}
+
+// Validate checks the field values on
+// DeleteProjectDeploymentFreezeWindowResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeleteProjectDeploymentFreezeWindowResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// DeleteProjectDeploymentFreezeWindowResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// DeleteProjectDeploymentFreezeWindowResponseMultiError, or nil if none found.
+func (m *DeleteProjectDeploymentFreezeWindowResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteProjectDeploymentFreezeWindowResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteProjectDeploymentFreezeWindowResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteProjectDeploymentFreezeWindowResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// DeleteProjectDeploymentFreezeWindowResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteProjectDeploymentFreezeWindowResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteProjectDeploymentFreezeWindowResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteProjectDeploymentFreezeWindowResponseMultiError) AllErrors() []error { return m }
+
+// DeleteProjectDeploymentFreezeWindowResponseValidationError is the validation
+// error returned by DeleteProjectDeploymentFreezeWindowResponse.Validate if
+// the designated constraints aren't met.
+type DeleteProjectDeploymentFreezeWindowResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteProjectDeploymentFreezeWindowResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteProjectDeploymentFreezeWindowResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteProjectDeploymentFreezeWindowResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteProjectDeploymentFreezeWindowResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteProjectDeploymentFreezeWindowResponseValidationError) ErrorName() string {
+	return "DeleteProjectDeploymentFreezeWindowResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteProjectDeploymentFreezeWindowResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteProjectDeploymentFreezeWindowResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteProjectDeploymentFreezeWindowResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteProjectDeploymentFreezeWindowResponseValidationError{}
+
+// Validate checks the field values on ListStaticUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStaticUsersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStaticUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStaticUsersRequestMultiError, or nil if none found.
+func (m *ListStaticUsersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStaticUsersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListStaticUsersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStaticUsersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListStaticUsersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListStaticUsersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStaticUsersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStaticUsersRequestMultiError) AllErrors() []error { return m }
+
+// ListStaticUsersRequestValidationError is the validation error returned by
+// ListStaticUsersRequest.Validate if the designated constraints aren't met.
+type ListStaticUsersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStaticUsersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStaticUsersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStaticUsersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStaticUsersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStaticUsersRequestValidationError) ErrorName() string {
+	return "ListStaticUsersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStaticUsersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStaticUsersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStaticUsersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStaticUsersRequestValidationError{}
+
+// Validate checks the field values on ListStaticUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStaticUsersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStaticUsersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStaticUsersResponseMultiError, or nil if none found.
+func (m *ListStaticUsersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStaticUsersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListStaticUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListStaticUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListStaticUsersResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListStaticUsersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStaticUsersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListStaticUsersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListStaticUsersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStaticUsersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStaticUsersResponseMultiError) AllErrors() []error { return m }
+
+// ListStaticUsersResponseValidationError is the validation error returned by
+// ListStaticUsersResponse.Validate if the designated constraints aren't met.
+type ListStaticUsersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStaticUsersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStaticUsersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStaticUsersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStaticUsersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStaticUsersResponseValidationError) ErrorName() string {
+	return "ListStaticUsersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStaticUsersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStaticUsersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStaticUsersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStaticUsersResponseValidationError{}
+
+// Validate checks the field values on AddStaticUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddStaticUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddStaticUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddStaticUserRequestMultiError, or nil if none found.
+func (m *AddStaticUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddStaticUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUsername()) < 1 {
+		err := AddStaticUserRequestValidationError{
+			field:  "Username",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPassword()) < 1 {
+		err := AddStaticUserRequestValidationError{
+			field:  "Password",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := AddStaticUserRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddStaticUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddStaticUserRequestMultiError is an error wrapping multiple validation
+// errors returned by AddStaticUserRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddStaticUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddStaticUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddStaticUserRequestMultiError) AllErrors() []error { return m }
+
+// AddStaticUserRequestValidationError is the validation error returned by
+// AddStaticUserRequest.Validate if the designated constraints aren't met.
+type AddStaticUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddStaticUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddStaticUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddStaticUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddStaticUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddStaticUserRequestValidationError) ErrorName() string {
+	return "AddStaticUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddStaticUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddStaticUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddStaticUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddStaticUserRequestValidationError{}
+
+// Validate checks the field values on AddStaticUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddStaticUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddStaticUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddStaticUserResponseMultiError, or nil if none found.
+func (m *AddStaticUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddStaticUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AddStaticUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddStaticUserResponseMultiError is an error wrapping multiple validation
+// errors returned by AddStaticUserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddStaticUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddStaticUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddStaticUserResponseMultiError) AllErrors() []error { return m }
+
+// AddStaticUserResponseValidationError is the validation error returned by
+// AddStaticUserResponse.Validate if the designated constraints aren't met.
+type AddStaticUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddStaticUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddStaticUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddStaticUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddStaticUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddStaticUserResponseValidationError) ErrorName() string {
+	return "AddStaticUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddStaticUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddStaticUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddStaticUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddStaticUserResponseValidationError{}
+
+// Validate checks the field values on DeleteStaticUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteStaticUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteStaticUserRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteStaticUserRequestMultiError, or nil if none found.
+func (m *DeleteStaticUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteStaticUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUsername()) < 1 {
+		err := DeleteStaticUserRequestValidationError{
+			field:  "Username",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteStaticUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteStaticUserRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteStaticUserRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteStaticUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteStaticUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteStaticUserRequestMultiError) AllErrors() []error { return m }
+
+// DeleteStaticUserRequestValidationError is the validation error returned by
+// DeleteStaticUserRequest.Validate if the designated constraints aren't met.
+type DeleteStaticUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteStaticUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteStaticUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteStaticUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteStaticUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteStaticUserRequestValidationError) ErrorName() string {
+	return "DeleteStaticUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteStaticUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteStaticUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteStaticUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteStaticUserRequestValidationError{}
+
+// Validate checks the field values on DeleteStaticUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteStaticUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteStaticUserResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteStaticUserResponseMultiError, or nil if none found.
+func (m *DeleteStaticUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteStaticUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteStaticUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteStaticUserResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteStaticUserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteStaticUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteStaticUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteStaticUserResponseMultiError) AllErrors() []error { return m }
+
+// DeleteStaticUserResponseValidationError is the validation error returned by
+// DeleteStaticUserResponse.Validate if the designated constraints aren't met.
+type DeleteStaticUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteStaticUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteStaticUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteStaticUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteStaticUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteStaticUserResponseValidationError) ErrorName() string {
+	return "DeleteStaticUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteStaticUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteStaticUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteStaticUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteStaticUserResponseValidationError{}
+
+// Validate checks the field values on UpdateStaticUserRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateStaticUserRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateStaticUserRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateStaticUserRoleRequestMultiError, or nil if none found.
+func (m *UpdateStaticUserRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateStaticUserRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUsername()) < 1 {
+		err := UpdateStaticUserRoleRequestValidationError{
+			field:  "Username",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := UpdateStaticUserRoleRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateStaticUserRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateStaticUserRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateStaticUserRoleRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateStaticUserRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateStaticUserRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateStaticUserRoleRequestMultiError) AllErrors() []error { return m }
+
+// UpdateStaticUserRoleRequestValidationError is the validation error returned
+// by UpdateStaticUserRoleRequest.Validate if the designated constraints
+// aren't met.
+type UpdateStaticUserRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateStaticUserRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateStaticUserRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateStaticUserRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateStaticUserRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateStaticUserRoleRequestValidationError) ErrorName() string {
+	return "UpdateStaticUserRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateStaticUserRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateStaticUserRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateStaticUserRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateStaticUserRoleRequestValidationError{}
+
+// Validate checks the field values on UpdateStaticUserRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateStaticUserRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateStaticUserRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateStaticUserRoleResponseMultiError, or nil if none found.
+func (m *UpdateStaticUserRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateStaticUserRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateStaticUserRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateStaticUserRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateStaticUserRoleResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateStaticUserRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateStaticUserRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateStaticUserRoleResponseMultiError) AllErrors() []error { return m }
+
+// UpdateStaticUserRoleResponseValidationError is the validation error returned
+// by UpdateStaticUserRoleResponse.Validate if the designated constraints
+// aren't met.
+type UpdateStaticUserRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateStaticUserRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateStaticUserRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateStaticUserRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateStaticUserRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateStaticUserRoleResponseValidationError) ErrorName() string {
+	return "UpdateStaticUserRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateStaticUserRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateStaticUserRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateStaticUserRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateStaticUserRoleResponseValidationError{}
+
+// Validate checks the field values on ResetStaticUserPasswordRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResetStaticUserPasswordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetStaticUserPasswordRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ResetStaticUserPasswordRequestMultiError, or nil if none found.
+func (m *ResetStaticUserPasswordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetStaticUserPasswordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUsername()) < 1 {
+		err := ResetStaticUserPasswordRequestValidationError{
+			field:  "Username",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPassword()) < 1 {
+		err := ResetStaticUserPasswordRequestValidationError{
+			field:  "Password",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ResetTotp
+
+	if len(errors) > 0 {
+		return ResetStaticUserPasswordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetStaticUserPasswordRequestMultiError is an error wrapping multiple
+// validation errors returned by ResetStaticUserPasswordRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ResetStaticUserPasswordRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetStaticUserPasswordRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetStaticUserPasswordRequestMultiError) AllErrors() []error { return m }
+
+// ResetStaticUserPasswordRequestValidationError is the validation error
+// returned by ResetStaticUserPasswordRequest.Validate if the designated
+// constraints aren't met.
+type ResetStaticUserPasswordRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetStaticUserPasswordRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetStaticUserPasswordRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetStaticUserPasswordRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetStaticUserPasswordRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetStaticUserPasswordRequestValidationError) ErrorName() string {
+	return "ResetStaticUserPasswordRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResetStaticUserPasswordRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetStaticUserPasswordRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetStaticUserPasswordRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetStaticUserPasswordRequestValidationError{}
+
+// Validate checks the field values on ResetStaticUserPasswordResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResetStaticUserPasswordResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetStaticUserPasswordResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ResetStaticUserPasswordResponseMultiError, or nil if none found.
+func (m *ResetStaticUserPasswordResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetStaticUserPasswordResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ResetStaticUserPasswordResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetStaticUserPasswordResponseMultiError is an error wrapping multiple
+// validation errors returned by ResetStaticUserPasswordResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ResetStaticUserPasswordResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetStaticUserPasswordResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetStaticUserPasswordResponseMultiError) AllErrors() []error { return m }
+
+// ResetStaticUserPasswordResponseValidationError is the validation error
+// returned by ResetStaticUserPasswordResponse.Validate if the designated
+// constraints aren't met.
+type ResetStaticUserPasswordResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetStaticUserPasswordResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetStaticUserPasswordResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetStaticUserPasswordResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetStaticUserPasswordResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetStaticUserPasswordResponseValidationError) ErrorName() string {
+	return "ResetStaticUserPasswordResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResetStaticUserPasswordResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetStaticUserPasswordResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetStaticUserPasswordResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetStaticUserPasswordResponseValidationError{}
+
+// Validate checks the field values on ChangeStaticUserPasswordRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangeStaticUserPasswordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangeStaticUserPasswordRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ChangeStaticUserPasswordRequestMultiError, or nil if none found.
+func (m *ChangeStaticUserPasswordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangeStaticUserPasswordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCurrentPassword()) < 1 {
+		err := ChangeStaticUserPasswordRequestValidationError{
+			field:  "CurrentPassword",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNewPassword()) < 1 {
+		err := ChangeStaticUserPasswordRequestValidationError{
+			field:  "NewPassword",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ChangeStaticUserPasswordRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateProjectRBACRoleRequestMultiError is an error wrapping multiple
-// validation errors returned by UpdateProjectRBACRoleRequest.ValidateAll() if
-// the designated constraints aren't met.
-type UpdateProjectRBACRoleRequestMultiError []error
+// ChangeStaticUserPasswordRequestMultiError is an error wrapping multiple
+// validation errors returned by ChangeStaticUserPasswordRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ChangeStaticUserPasswordRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateProjectRBACRoleRequestMultiError) Error() string {
+func (m ChangeStaticUserPasswordRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9129,12 +12408,12 @@ func (m UpdateProjectRBACRoleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateProjectRBACRoleRequestMultiError) AllErrors() []error { return m }
+func (m ChangeStaticUserPasswordRequestMultiError) AllErrors() []error { return m }
 
-// UpdateProjectRBACRoleRequestValidationError is the validation error returned
-// by UpdateProjectRBACRoleRequest.Validate if the designated constraints
-// aren't met.
-type UpdateProjectRBACRoleRequestValidationError struct {
+// ChangeStaticUserPasswordRequestValidationError is the validation error
+// returned by ChangeStaticUserPasswordRequest.Validate if the designated
+// constraints aren't met.
+type ChangeStaticUserPasswordRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9142,24 +12421,24 @@ type UpdateProjectRBACRoleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateProjectRBACRoleRequestValidationError) Field() string { return e.field }
+func (e ChangeStaticUserPasswordRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateProjectRBACRoleRequestValidationError) Reason() string { return e.reason }
+func (e ChangeStaticUserPasswordRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateProjectRBACRoleRequestValidationError) Cause() error { return e.cause }
+func (e ChangeStaticUserPasswordRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateProjectRBACRoleRequestValidationError) Key() bool { return e.key }
+func (e ChangeStaticUserPasswordRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateProjectRBACRoleRequestValidationError) ErrorName() string {
-	return "UpdateProjectRBACRoleRequestValidationError"
+func (e ChangeStaticUserPasswordRequestValidationError) ErrorName() string {
+	return "ChangeStaticUserPasswordRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateProjectRBACRoleRequestValidationError) Error() string {
+func (e ChangeStaticUserPasswordRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9171,14 +12450,14 @@ func (e UpdateProjectRBACRoleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateProjectRBACRoleRequest.%s: %s%s",
+		"invalid %sChangeStaticUserPasswordRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateProjectRBACRoleRequestValidationError{}
+var _ error = ChangeStaticUserPasswordRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9186,24 +12465,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateProjectRBACRoleRequestValidationError{}
+} = ChangeStaticUserPasswordRequestValidationError{}
 
-// Validate checks the field values on UpdateProjectRBACRoleResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateProjectRBACRoleResponse) Validate() error {
+// Validate checks the field values on ChangeStaticUserPasswordResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ChangeStaticUserPasswordResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateProjectRBACRoleResponse with
+// ValidateAll checks the field values on ChangeStaticUserPasswordResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// UpdateProjectRBACRoleResponseMultiError, or nil if none found.
-func (m *UpdateProjectRBACRoleResponse) ValidateAll() error {
+// ChangeStaticUserPasswordResponseMultiError, or nil if none found.
+func (m *ChangeStaticUserPasswordResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateProjectRBACRoleResponse) validate(all bool) error {
+func (m *ChangeStaticUserPasswordResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9211,19 +12491,20 @@ func (m *UpdateProjectRBACRoleResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return UpdateProjectRBACRoleResponseMultiError(errors)
+		return ChangeStaticUserPasswordResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateProjectRBACRoleResponseMultiError is an error wrapping multiple
-// validation errors returned by UpdateProjectRBACRoleResponse.ValidateAll()
-// if the designated constraints aren't met.
-type UpdateProjectRBACRoleResponseMultiError []error
+// ChangeStaticUserPasswordResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ChangeStaticUserPasswordResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ChangeStaticUserPasswordResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateProjectRBACRoleResponseMultiError) Error() string {
+func (m ChangeStaticUserPasswordResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9232,12 +12513,12 @@ func (m UpdateProjectRBACRoleResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateProjectRBACRoleResponseMultiError) AllErrors() []error { return m }
+func (m ChangeStaticUserPasswordResponseMultiError) AllErrors() []error { return m }
 
-// UpdateProjectRBACRoleResponseValidationError is the validation error
-// returned by UpdateProjectRBACRoleResponse.Validate if the designated
+// ChangeStaticUserPasswordResponseValidationError is the validation error
+// returned by ChangeStaticUserPasswordResponse.Validate if the designated
 // constraints aren't met.
-type UpdateProjectRBACRoleResponseValidationError struct {
+type ChangeStaticUserPasswordResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9245,24 +12526,24 @@ type UpdateProjectRBACRoleResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateProjectRBACRoleResponseValidationError) Field() string { return e.field }
+func (e ChangeStaticUserPasswordResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateProjectRBACRoleResponseValidationError) Reason() string { return e.reason }
+func (e ChangeStaticUserPasswordResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateProjectRBACRoleResponseValidationError) Cause() error { return e.cause }
+func (e ChangeStaticUserPasswordResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateProjectRBACRoleResponseValidationError) Key() bool { return e.key }
+func (e ChangeStaticUserPasswordResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateProjectRBACRoleResponseValidationError) ErrorName() string {
-	return "UpdateProjectRBACRoleResponseValidationError"
+func (e ChangeStaticUserPasswordResponseValidationError) ErrorName() string {
+	return "ChangeStaticUserPasswordResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateProjectRBACRoleResponseValidationError) Error() string {
+func (e ChangeStaticUserPasswordResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9274,14 +12555,14 @@ func (e UpdateProjectRBACRoleResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateProjectRBACRoleResponse.%s: %s%s",
+		"invalid %sChangeStaticUserPasswordResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateProjectRBACRoleResponseValidationError{}
+var _ error = ChangeStaticUserPasswordResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9289,55 +12570,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateProjectRBACRoleResponseValidationError{}
+} = ChangeStaticUserPasswordResponseValidationError{}
 
-// Validate checks the field values on DeleteProjectRBACRoleRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteProjectRBACRoleRequest) Validate() error {
+// Validate checks the field values on GenerateStaticUserTOTPSecretRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GenerateStaticUserTOTPSecretRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteProjectRBACRoleRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteProjectRBACRoleRequestMultiError, or nil if none found.
-func (m *DeleteProjectRBACRoleRequest) ValidateAll() error {
+// ValidateAll checks the field values on GenerateStaticUserTOTPSecretRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GenerateStaticUserTOTPSecretRequestMultiError, or nil if none found.
+func (m *GenerateStaticUserTOTPSecretRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteProjectRBACRoleRequest) validate(all bool) error {
+func (m *GenerateStaticUserTOTPSecretRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := DeleteProjectRBACRoleRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return DeleteProjectRBACRoleRequestMultiError(errors)
+		return GenerateStaticUserTOTPSecretRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteProjectRBACRoleRequestMultiError is an error wrapping multiple
-// validation errors returned by DeleteProjectRBACRoleRequest.ValidateAll() if
-// the designated constraints aren't met.
-type DeleteProjectRBACRoleRequestMultiError []error
+// GenerateStaticUserTOTPSecretRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GenerateStaticUserTOTPSecretRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateStaticUserTOTPSecretRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteProjectRBACRoleRequestMultiError) Error() string {
+func (m GenerateStaticUserTOTPSecretRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9346,12 +12618,12 @@ func (m DeleteProjectRBACRoleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteProjectRBACRoleRequestMultiError) AllErrors() []error { return m }
+func (m GenerateStaticUserTOTPSecretRequestMultiError) AllErrors() []error { return m }
 
-// DeleteProjectRBACRoleRequestValidationError is the validation error returned
-// by DeleteProjectRBACRoleRequest.Validate if the designated constraints
-// aren't met.
-type DeleteProjectRBACRoleRequestValidationError struct {
+// GenerateStaticUserTOTPSecretRequestValidationError is the validation error
+// returned by GenerateStaticUserTOTPSecretRequest.Validate if the designated
+// constraints aren't met.
+type GenerateStaticUserTOTPSecretRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9359,24 +12631,24 @@ type DeleteProjectRBACRoleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteProjectRBACRoleRequestValidationError) Field() string { return e.field }
+func (e GenerateStaticUserTOTPSecretRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteProjectRBACRoleRequestValidationError) Reason() string { return e.reason }
+func (e GenerateStaticUserTOTPSecretRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteProjectRBACRoleRequestValidationError) Cause() error { return e.cause }
+func (e GenerateStaticUserTOTPSecretRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteProjectRBACRoleRequestValidationError) Key() bool { return e.key }
+func (e GenerateStaticUserTOTPSecretRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteProjectRBACRoleRequestValidationError) ErrorName() string {
-	return "DeleteProjectRBACRoleRequestValidationError"
+func (e GenerateStaticUserTOTPSecretRequestValidationError) ErrorName() string {
+	return "GenerateStaticUserTOTPSecretRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteProjectRBACRoleRequestValidationError) Error() string {
+func (e GenerateStaticUserTOTPSecretRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9388,14 +12660,14 @@ func (e DeleteProjectRBACRoleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteProjectRBACRoleRequest.%s: %s%s",
+		"invalid %sGenerateStaticUserTOTPSecretRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteProjectRBACRoleRequestValidationError{}
+var _ error = GenerateStaticUserTOTPSecretRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9403,44 +12675,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteProjectRBACRoleRequestValidationError{}
+} = GenerateStaticUserTOTPSecretRequestValidationError{}
 
-// Validate checks the field values on DeleteProjectRBACRoleResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteProjectRBACRoleResponse) Validate() error {
+// Validate checks the field values on GenerateStaticUserTOTPSecretResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GenerateStaticUserTOTPSecretResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteProjectRBACRoleResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// DeleteProjectRBACRoleResponseMultiError, or nil if none found.
-func (m *DeleteProjectRBACRoleResponse) ValidateAll() error {
+// ValidateAll checks the field values on GenerateStaticUserTOTPSecretResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GenerateStaticUserTOTPSecretResponseMultiError, or nil if none found.
+func (m *GenerateStaticUserTOTPSecretResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteProjectRBACRoleResponse) validate(all bool) error {
+func (m *GenerateStaticUserTOTPSecretResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Secret
+
+	// no validation rules for Url
+
 	if len(errors) > 0 {
-		return DeleteProjectRBACRoleResponseMultiError(errors)
+		return GenerateStaticUserTOTPSecretResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteProjectRBACRoleResponseMultiError is an error wrapping multiple
-// validation errors returned by DeleteProjectRBACRoleResponse.ValidateAll()
-// if the designated constraints aren't met.
-type DeleteProjectRBACRoleResponseMultiError []error
+// GenerateStaticUserTOTPSecretResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GenerateStaticUserTOTPSecretResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateStaticUserTOTPSecretResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteProjectRBACRoleResponseMultiError) Error() string {
+func (m GenerateStaticUserTOTPSecretResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9449,12 +12727,12 @@ func (m DeleteProjectRBACRoleResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteProjectRBACRoleResponseMultiError) AllErrors() []error { return m }
+func (m GenerateStaticUserTOTPSecretResponseMultiError) AllErrors() []error { return m }
 
-// DeleteProjectRBACRoleResponseValidationError is the validation error
-// returned by DeleteProjectRBACRoleResponse.Validate if the designated
+// GenerateStaticUserTOTPSecretResponseValidationError is the validation error
+// returned by GenerateStaticUserTOTPSecretResponse.Validate if the designated
 // constraints aren't met.
-type DeleteProjectRBACRoleResponseValidationError struct {
+type GenerateStaticUserTOTPSecretResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9462,24 +12740,24 @@ type DeleteProjectRBACRoleResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteProjectRBACRoleResponseValidationError) Field() string { return e.field }
+func (e GenerateStaticUserTOTPSecretResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteProjectRBACRoleResponseValidationError) Reason() string { return e.reason }
+func (e GenerateStaticUserTOTPSecretResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteProjectRBACRoleResponseValidationError) Cause() error { return e.cause }
+func (e GenerateStaticUserTOTPSecretResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteProjectRBACRoleResponseValidationError) Key() bool { return e.key }
+func (e GenerateStaticUserTOTPSecretResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteProjectRBACRoleResponseValidationError) ErrorName() string {
-	return "DeleteProjectRBACRoleResponseValidationError"
+func (e GenerateStaticUserTOTPSecretResponseValidationError) ErrorName() string {
+	return "GenerateStaticUserTOTPSecretResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteProjectRBACRoleResponseValidationError) Error() string {
+func (e GenerateStaticUserTOTPSecretResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9491,14 +12769,14 @@ func (e DeleteProjectRBACRoleResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteProjectRBACRoleResponse.%s: %s%s",
+		"invalid %sGenerateStaticUserTOTPSecretResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteProjectRBACRoleResponseValidationError{}
+var _ error = GenerateStaticUserTOTPSecretResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9506,33 +12784,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteProjectRBACRoleResponseValidationError{}
+} = GenerateStaticUserTOTPSecretResponseValidationError{}
 
-// Validate checks the field values on AddProjectUserGroupRequest with the
+// Validate checks the field values on EnableStaticUserTOTPRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddProjectUserGroupRequest) Validate() error {
+func (m *EnableStaticUserTOTPRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddProjectUserGroupRequest with the
+// ValidateAll checks the field values on EnableStaticUserTOTPRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AddProjectUserGroupRequestMultiError, or nil if none found.
-func (m *AddProjectUserGroupRequest) ValidateAll() error {
+// EnableStaticUserTOTPRequestMultiError, or nil if none found.
+func (m *EnableStaticUserTOTPRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddProjectUserGroupRequest) validate(all bool) error {
+func (m *EnableStaticUserTOTPRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetSsoGroup()) < 1 {
-		err := AddProjectUserGroupRequestValidationError{
-			field:  "SsoGroup",
+	if utf8.RuneCountInString(m.GetPassword()) < 1 {
+		err := EnableStaticUserTOTPRequestValidationError{
+			field:  "Password",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -9541,9 +12819,20 @@ func (m *AddProjectUserGroupRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := AddProjectUserGroupRequestValidationError{
-			field:  "Role",
+	if utf8.RuneCountInString(m.GetSecret()) < 1 {
+		err := EnableStaticUserTOTPRequestValidationError{
+			field:  "Secret",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPasscode()) < 1 {
+		err := EnableStaticUserTOTPRequestValidationError{
+			field:  "Passcode",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -9553,19 +12842,19 @@ func (m *AddProjectUserGroupRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AddProjectUserGroupRequestMultiError(errors)
+		return EnableStaticUserTOTPRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddProjectUserGroupRequestMultiError is an error wrapping multiple
-// validation errors returned by AddProjectUserGroupRequest.ValidateAll() if
+// EnableStaticUserTOTPRequestMultiError is an error wrapping multiple
+// validation errors returned by EnableStaticUserTOTPRequest.ValidateAll() if
 // the designated constraints aren't met.
-type AddProjectUserGroupRequestMultiError []error
+type EnableStaticUserTOTPRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddProjectUserGroupRequestMultiError) Error() string {
+func (m EnableStaticUserTOTPRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9574,11 +12863,12 @@ func (m AddProjectUserGroupRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddProjectUserGroupRequestMultiError) AllErrors() []error { return m }
+func (m EnableStaticUserTOTPRequestMultiError) AllErrors() []error { return m }
 
-// AddProjectUserGroupRequestValidationError is the validation error returned
-// by AddProjectUserGroupRequest.Validate if the designated constraints aren't met.
-type AddProjectUserGroupRequestValidationError struct {
+// EnableStaticUserTOTPRequestValidationError is the validation error returned
+// by EnableStaticUserTOTPRequest.Validate if the designated constraints
+// aren't met.
+type EnableStaticUserTOTPRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9586,24 +12876,24 @@ type AddProjectUserGroupRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddProjectUserGroupRequestValidationError) Field() string { return e.field }
+func (e EnableStaticUserTOTPRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddProjectUserGroupRequestValidationError) Reason() string { return e.reason }
+func (e EnableStaticUserTOTPRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddProjectUserGroupRequestValidationError) Cause() error { return e.cause }
+func (e EnableStaticUserTOTPRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddProjectUserGroupRequestValidationError) Key() bool { return e.key }
+func (e EnableStaticUserTOTPRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddProjectUserGroupRequestValidationError) ErrorName() string {
-	return "AddProjectUserGroupRequestValidationError"
+func (e EnableStaticUserTOTPRequestValidationError) ErrorName() string {
+	return "EnableStaticUserTOTPRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddProjectUserGroupRequestValidationError) Error() string {
+func (e EnableStaticUserTOTPRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9615,14 +12905,14 @@ func (e AddProjectUserGroupRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddProjectUserGroupRequest.%s: %s%s",
+		"invalid %sEnableStaticUserTOTPRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddProjectUserGroupRequestValidationError{}
+var _ error = EnableStaticUserTOTPRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9630,24 +12920,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddProjectUserGroupRequestValidationError{}
+} = EnableStaticUserTOTPRequestValidationError{}
 
-// Validate checks the field values on AddProjectUserGroupResponse with the
+// Validate checks the field values on EnableStaticUserTOTPResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddProjectUserGroupResponse) Validate() error {
+func (m *EnableStaticUserTOTPResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddProjectUserGroupResponse with the
+// ValidateAll checks the field values on EnableStaticUserTOTPResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AddProjectUserGroupResponseMultiError, or nil if none found.
-func (m *AddProjectUserGroupResponse) ValidateAll() error {
+// EnableStaticUserTOTPResponseMultiError, or nil if none found.
+func (m *EnableStaticUserTOTPResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddProjectUserGroupResponse) validate(all bool) error {
+func (m *EnableStaticUserTOTPResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9655,19 +12945,19 @@ func (m *AddProjectUserGroupResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return AddProjectUserGroupResponseMultiError(errors)
+		return EnableStaticUserTOTPResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddProjectUserGroupResponseMultiError is an error wrapping multiple
-// validation errors returned by AddProjectUserGroupResponse.ValidateAll() if
+// EnableStaticUserTOTPResponseMultiError is an error wrapping multiple
+// validation errors returned by EnableStaticUserTOTPResponse.ValidateAll() if
 // the designated constraints aren't met.
-type AddProjectUserGroupResponseMultiError []error
+type EnableStaticUserTOTPResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddProjectUserGroupResponseMultiError) Error() string {
+func (m EnableStaticUserTOTPResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9676,12 +12966,12 @@ func (m AddProjectUserGroupResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddProjectUserGroupResponseMultiError) AllErrors() []error { return m }
+func (m EnableStaticUserTOTPResponseMultiError) AllErrors() []error { return m }
 
-// AddProjectUserGroupResponseValidationError is the validation error returned
-// by AddProjectUserGroupResponse.Validate if the designated constraints
+// EnableStaticUserTOTPResponseValidationError is the validation error returned
+// by EnableStaticUserTOTPResponse.Validate if the designated constraints
 // aren't met.
-type AddProjectUserGroupResponseValidationError struct {
+type EnableStaticUserTOTPResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9689,24 +12979,24 @@ type AddProjectUserGroupResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddProjectUserGroupResponseValidationError) Field() string { return e.field }
+func (e EnableStaticUserTOTPResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddProjectUserGroupResponseValidationError) Reason() string { return e.reason }
+func (e EnableStaticUserTOTPResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddProjectUserGroupResponseValidationError) Cause() error { return e.cause }
+func (e EnableStaticUserTOTPResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddProjectUserGroupResponseValidationError) Key() bool { return e.key }
+func (e EnableStaticUserTOTPResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddProjectUserGroupResponseValidationError) ErrorName() string {
-	return "AddProjectUserGroupResponseValidationError"
+func (e EnableStaticUserTOTPResponseValidationError) ErrorName() string {
+	return "EnableStaticUserTOTPResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddProjectUserGroupResponseValidationError) Error() string {
+func (e EnableStaticUserTOTPResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9718,14 +13008,14 @@ func (e AddProjectUserGroupResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddProjectUserGroupResponse.%s: %s%s",
+		"invalid %sEnableStaticUserTOTPResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddProjectUserGroupResponseValidationError{}
+var _ error = EnableStaticUserTOTPResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9733,33 +13023,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddProjectUserGroupResponseValidationError{}
+} = EnableStaticUserTOTPResponseValidationError{}
 
-// Validate checks the field values on DeleteProjectUserGroupRequest with the
+// Validate checks the field values on DisableStaticUserTOTPRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteProjectUserGroupRequest) Validate() error {
+func (m *DisableStaticUserTOTPRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteProjectUserGroupRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// DeleteProjectUserGroupRequestMultiError, or nil if none found.
-func (m *DeleteProjectUserGroupRequest) ValidateAll() error {
+// ValidateAll checks the field values on DisableStaticUserTOTPRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableStaticUserTOTPRequestMultiError, or nil if none found.
+func (m *DisableStaticUserTOTPRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteProjectUserGroupRequest) validate(all bool) error {
+func (m *DisableStaticUserTOTPRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetSsoGroup()) < 1 {
-		err := DeleteProjectUserGroupRequestValidationError{
-			field:  "SsoGroup",
+	if utf8.RuneCountInString(m.GetPassword()) < 1 {
+		err := DisableStaticUserTOTPRequestValidationError{
+			field:  "Password",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -9769,19 +13059,19 @@ func (m *DeleteProjectUserGroupRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DeleteProjectUserGroupRequestMultiError(errors)
+		return DisableStaticUserTOTPRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteProjectUserGroupRequestMultiError is an error wrapping multiple
-// validation errors returned by DeleteProjectUserGroupRequest.ValidateAll()
-// if the designated constraints aren't met.
-type DeleteProjectUserGroupRequestMultiError []error
+// DisableStaticUserTOTPRequestMultiError is an error wrapping multiple
+// validation errors returned by DisableStaticUserTOTPRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DisableStaticUserTOTPRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteProjectUserGroupRequestMultiError) Error() string {
+func (m DisableStaticUserTOTPRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9790,12 +13080,12 @@ func (m DeleteProjectUserGroupRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteProjectUserGroupRequestMultiError) AllErrors() []error { return m }
+func (m DisableStaticUserTOTPRequestMultiError) AllErrors() []error { return m }
 
-// DeleteProjectUserGroupRequestValidationError is the validation error
-// returned by DeleteProjectUserGroupRequest.Validate if the designated
-// constraints aren't met.
-type DeleteProjectUserGroupRequestValidationError struct {
+// DisableStaticUserTOTPRequestValidationError is the validation error returned
+// by DisableStaticUserTOTPRequest.Validate if the designated constraints
+// aren't met.
+type DisableStaticUserTOTPRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9803,24 +13093,24 @@ type DeleteProjectUserGroupRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteProjectUserGroupRequestValidationError) Field() string { return e.field }
+func (e DisableStaticUserTOTPRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteProjectUserGroupRequestValidationError) Reason() string { return e.reason }
+func (e DisableStaticUserTOTPRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteProjectUserGroupRequestValidationError) Cause() error { return e.cause }
+func (e DisableStaticUserTOTPRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteProjectUserGroupRequestValidationError) Key() bool { return e.key }
+func (e DisableStaticUserTOTPRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteProjectUserGroupRequestValidationError) ErrorName() string {
-	return "DeleteProjectUserGroupRequestValidationError"
+func (e DisableStaticUserTOTPRequestValidationError) ErrorName() string {
+	return "DisableStaticUserTOTPRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteProjectUserGroupRequestValidationError) Error() string {
+func (e DisableStaticUserTOTPRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9832,14 +13122,14 @@ func (e DeleteProjectUserGroupRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteProjectUserGroupRequest.%s: %s%s",
+		"invalid %sDisableStaticUserTOTPRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteProjectUserGroupRequestValidationError{}
+var _ error = DisableStaticUserTOTPRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9847,24 +13137,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteProjectUserGroupRequestValidationError{}
+} = DisableStaticUserTOTPRequestValidationError{}
 
-// Validate checks the field values on DeleteProjectUserGroupResponse with the
+// Validate checks the field values on DisableStaticUserTOTPResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteProjectUserGroupResponse) Validate() error {
+func (m *DisableStaticUserTOTPResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteProjectUserGroupResponse with
+// ValidateAll checks the field values on DisableStaticUserTOTPResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// DeleteProjectUserGroupResponseMultiError, or nil if none found.
-func (m *DeleteProjectUserGroupResponse) ValidateAll() error {
+// DisableStaticUserTOTPResponseMultiError, or nil if none found.
+func (m *DisableStaticUserTOTPResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteProjectUserGroupResponse) validate(all bool) error {
+func (m *DisableStaticUserTOTPResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9872,19 +13162,19 @@ func (m *DeleteProjectUserGroupResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return DeleteProjectUserGroupResponseMultiError(errors)
+		return DisableStaticUserTOTPResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteProjectUserGroupResponseMultiError is an error wrapping multiple
-// validation errors returned by DeleteProjectUserGroupResponse.ValidateAll()
+// DisableStaticUserTOTPResponseMultiError is an error wrapping multiple
+// validation errors returned by DisableStaticUserTOTPResponse.ValidateAll()
 // if the designated constraints aren't met.
-type DeleteProjectUserGroupResponseMultiError []error
+type DisableStaticUserTOTPResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteProjectUserGroupResponseMultiError) Error() string {
+func (m DisableStaticUserTOTPResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9893,12 +13183,12 @@ func (m DeleteProjectUserGroupResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteProjectUserGroupResponseMultiError) AllErrors() []error { return m }
+func (m DisableStaticUserTOTPResponseMultiError) AllErrors() []error { return m }
 
-// DeleteProjectUserGroupResponseValidationError is the validation error
-// returned by DeleteProjectUserGroupResponse.Validate if the designated
+// DisableStaticUserTOTPResponseValidationError is the validation error
+// returned by DisableStaticUserTOTPResponse.Validate if the designated
 // constraints aren't met.
-type DeleteProjectUserGroupResponseValidationError struct {
+type DisableStaticUserTOTPResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9906,24 +13196,24 @@ type DeleteProjectUserGroupResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteProjectUserGroupResponseValidationError) Field() string { return e.field }
+func (e DisableStaticUserTOTPResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteProjectUserGroupResponseValidationError) Reason() string { return e.reason }
+func (e DisableStaticUserTOTPResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteProjectUserGroupResponseValidationError) Cause() error { return e.cause }
+func (e DisableStaticUserTOTPResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteProjectUserGroupResponseValidationError) Key() bool { return e.key }
+func (e DisableStaticUserTOTPResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteProjectUserGroupResponseValidationError) ErrorName() string {
-	return "DeleteProjectUserGroupResponseValidationError"
+func (e DisableStaticUserTOTPResponseValidationError) ErrorName() string {
+	return "DisableStaticUserTOTPResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteProjectUserGroupResponseValidationError) Error() string {
+func (e DisableStaticUserTOTPResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9935,14 +13225,14 @@ func (e DeleteProjectUserGroupResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteProjectUserGroupResponse.%s: %s%s",
+		"invalid %sDisableStaticUserTOTPResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteProjectUserGroupResponseValidationError{}
+var _ error = DisableStaticUserTOTPResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9950,7 +13240,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteProjectUserGroupResponseValidationError{}
+} = DisableStaticUserTOTPResponseValidationError{}
 
 // Validate checks the field values on GetCommandRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
@@ -11658,34 +14948,279 @@ func (m *ListDeploymentChainsResponse) validate(all bool) error {
 					})
 				}
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeploymentChainsResponseValidationError{
+					field:  fmt.Sprintf("DeploymentChains[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Cursor
+
+	if len(errors) > 0 {
+		return ListDeploymentChainsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeploymentChainsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListDeploymentChainsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListDeploymentChainsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeploymentChainsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeploymentChainsResponseMultiError) AllErrors() []error { return m }
+
+// ListDeploymentChainsResponseValidationError is the validation error returned
+// by ListDeploymentChainsResponse.Validate if the designated constraints
+// aren't met.
+type ListDeploymentChainsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeploymentChainsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeploymentChainsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeploymentChainsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeploymentChainsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeploymentChainsResponseValidationError) ErrorName() string {
+	return "ListDeploymentChainsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeploymentChainsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeploymentChainsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeploymentChainsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeploymentChainsResponseValidationError{}
+
+// Validate checks the field values on GetDeploymentChainRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDeploymentChainRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeploymentChainRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDeploymentChainRequestMultiError, or nil if none found.
+func (m *GetDeploymentChainRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeploymentChainRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentChainId()) < 1 {
+		err := GetDeploymentChainRequestValidationError{
+			field:  "DeploymentChainId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetDeploymentChainRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDeploymentChainRequestMultiError is an error wrapping multiple validation
+// errors returned by GetDeploymentChainRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetDeploymentChainRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDeploymentChainRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDeploymentChainRequestMultiError) AllErrors() []error { return m }
+
+// GetDeploymentChainRequestValidationError is the validation error returned by
+// GetDeploymentChainRequest.Validate if the designated constraints aren't met.
+type GetDeploymentChainRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeploymentChainRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeploymentChainRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeploymentChainRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeploymentChainRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeploymentChainRequestValidationError) ErrorName() string {
+	return "GetDeploymentChainRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeploymentChainRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeploymentChainRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeploymentChainRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeploymentChainRequestValidationError{}
+
+// Validate checks the field values on GetDeploymentChainResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDeploymentChainResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeploymentChainResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDeploymentChainResponseMultiError, or nil if none found.
+func (m *GetDeploymentChainResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeploymentChainResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDeploymentChain()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDeploymentChainResponseValidationError{
+					field:  "DeploymentChain",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListDeploymentChainsResponseValidationError{
-					field:  fmt.Sprintf("DeploymentChains[%v]", idx),
+				errors = append(errors, GetDeploymentChainResponseValidationError{
+					field:  "DeploymentChain",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeploymentChain()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDeploymentChainResponseValidationError{
+				field:  "DeploymentChain",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for Cursor
-
 	if len(errors) > 0 {
-		return ListDeploymentChainsResponseMultiError(errors)
+		return GetDeploymentChainResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListDeploymentChainsResponseMultiError is an error wrapping multiple
-// validation errors returned by ListDeploymentChainsResponse.ValidateAll() if
+// GetDeploymentChainResponseMultiError is an error wrapping multiple
+// validation errors returned by GetDeploymentChainResponse.ValidateAll() if
 // the designated constraints aren't met.
-type ListDeploymentChainsResponseMultiError []error
+type GetDeploymentChainResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListDeploymentChainsResponseMultiError) Error() string {
+func (m GetDeploymentChainResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11694,12 +15229,11 @@ func (m ListDeploymentChainsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListDeploymentChainsResponseMultiError) AllErrors() []error { return m }
+func (m GetDeploymentChainResponseMultiError) AllErrors() []error { return m }
 
-// ListDeploymentChainsResponseValidationError is the validation error returned
-// by ListDeploymentChainsResponse.Validate if the designated constraints
-// aren't met.
-type ListDeploymentChainsResponseValidationError struct {
+// GetDeploymentChainResponseValidationError is the validation error returned
+// by GetDeploymentChainResponse.Validate if the designated constraints aren't met.
+type GetDeploymentChainResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11707,24 +15241,24 @@ type ListDeploymentChainsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListDeploymentChainsResponseValidationError) Field() string { return e.field }
+func (e GetDeploymentChainResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListDeploymentChainsResponseValidationError) Reason() string { return e.reason }
+func (e GetDeploymentChainResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListDeploymentChainsResponseValidationError) Cause() error { return e.cause }
+func (e GetDeploymentChainResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListDeploymentChainsResponseValidationError) Key() bool { return e.key }
+func (e GetDeploymentChainResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListDeploymentChainsResponseValidationError) ErrorName() string {
-	return "ListDeploymentChainsResponseValidationError"
+func (e GetDeploymentChainResponseValidationError) ErrorName() string {
+	return "GetDeploymentChainResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListDeploymentChainsResponseValidationError) Error() string {
+func (e GetDeploymentChainResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11736,14 +15270,14 @@ func (e ListDeploymentChainsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListDeploymentChainsResponse.%s: %s%s",
+		"invalid %sGetDeploymentChainResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListDeploymentChainsResponseValidationError{}
+var _ error = GetDeploymentChainResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11751,24 +15285,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListDeploymentChainsResponseValidationError{}
+} = GetDeploymentChainResponseValidationError{}
 
-// Validate checks the field values on GetDeploymentChainRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CancelDeploymentChainRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDeploymentChainRequest) Validate() error {
+func (m *CancelDeploymentChainRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDeploymentChainRequest with the
+// ValidateAll checks the field values on CancelDeploymentChainRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetDeploymentChainRequestMultiError, or nil if none found.
-func (m *GetDeploymentChainRequest) ValidateAll() error {
+// CancelDeploymentChainRequestMultiError, or nil if none found.
+func (m *CancelDeploymentChainRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDeploymentChainRequest) validate(all bool) error {
+func (m *CancelDeploymentChainRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -11776,7 +15310,7 @@ func (m *GetDeploymentChainRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetDeploymentChainId()) < 1 {
-		err := GetDeploymentChainRequestValidationError{
+		err := CancelDeploymentChainRequestValidationError{
 			field:  "DeploymentChainId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -11787,19 +15321,19 @@ func (m *GetDeploymentChainRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetDeploymentChainRequestMultiError(errors)
+		return CancelDeploymentChainRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDeploymentChainRequestMultiError is an error wrapping multiple validation
-// errors returned by GetDeploymentChainRequest.ValidateAll() if the
-// designated constraints aren't met.
-type GetDeploymentChainRequestMultiError []error
+// CancelDeploymentChainRequestMultiError is an error wrapping multiple
+// validation errors returned by CancelDeploymentChainRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CancelDeploymentChainRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDeploymentChainRequestMultiError) Error() string {
+func (m CancelDeploymentChainRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11808,11 +15342,12 @@ func (m GetDeploymentChainRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDeploymentChainRequestMultiError) AllErrors() []error { return m }
+func (m CancelDeploymentChainRequestMultiError) AllErrors() []error { return m }
 
-// GetDeploymentChainRequestValidationError is the validation error returned by
-// GetDeploymentChainRequest.Validate if the designated constraints aren't met.
-type GetDeploymentChainRequestValidationError struct {
+// CancelDeploymentChainRequestValidationError is the validation error returned
+// by CancelDeploymentChainRequest.Validate if the designated constraints
+// aren't met.
+type CancelDeploymentChainRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11820,24 +15355,24 @@ type GetDeploymentChainRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDeploymentChainRequestValidationError) Field() string { return e.field }
+func (e CancelDeploymentChainRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDeploymentChainRequestValidationError) Reason() string { return e.reason }
+func (e CancelDeploymentChainRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDeploymentChainRequestValidationError) Cause() error { return e.cause }
+func (e CancelDeploymentChainRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDeploymentChainRequestValidationError) Key() bool { return e.key }
+func (e CancelDeploymentChainRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDeploymentChainRequestValidationError) ErrorName() string {
-	return "GetDeploymentChainRequestValidationError"
+func (e CancelDeploymentChainRequestValidationError) ErrorName() string {
+	return "CancelDeploymentChainRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDeploymentChainRequestValidationError) Error() string {
+func (e CancelDeploymentChainRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11849,14 +15384,14 @@ func (e GetDeploymentChainRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDeploymentChainRequest.%s: %s%s",
+		"invalid %sCancelDeploymentChainRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDeploymentChainRequestValidationError{}
+var _ error = CancelDeploymentChainRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11864,73 +15399,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDeploymentChainRequestValidationError{}
+} = CancelDeploymentChainRequestValidationError{}
 
-// Validate checks the field values on GetDeploymentChainResponse with the
+// Validate checks the field values on CancelDeploymentChainResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDeploymentChainResponse) Validate() error {
+func (m *CancelDeploymentChainResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDeploymentChainResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetDeploymentChainResponseMultiError, or nil if none found.
-func (m *GetDeploymentChainResponse) ValidateAll() error {
+// ValidateAll checks the field values on CancelDeploymentChainResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CancelDeploymentChainResponseMultiError, or nil if none found.
+func (m *CancelDeploymentChainResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDeploymentChainResponse) validate(all bool) error {
+func (m *CancelDeploymentChainResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetDeploymentChain()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetDeploymentChainResponseValidationError{
-					field:  "DeploymentChain",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetDeploymentChainResponseValidationError{
-					field:  "DeploymentChain",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDeploymentChain()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetDeploymentChainResponseValidationError{
-				field:  "DeploymentChain",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return GetDeploymentChainResponseMultiError(errors)
+		return CancelDeploymentChainResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDeploymentChainResponseMultiError is an error wrapping multiple
-// validation errors returned by GetDeploymentChainResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetDeploymentChainResponseMultiError []error
+// CancelDeploymentChainResponseMultiError is an error wrapping multiple
+// validation errors returned by CancelDeploymentChainResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CancelDeploymentChainResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDeploymentChainResponseMultiError) Error() string {
+func (m CancelDeploymentChainResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11939,11 +15445,12 @@ func (m GetDeploymentChainResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDeploymentChainResponseMultiError) AllErrors() []error { return m }
+func (m CancelDeploymentChainResponseMultiError) AllErrors() []error { return m }
 
-// GetDeploymentChainResponseValidationError is the validation error returned
-// by GetDeploymentChainResponse.Validate if the designated constraints aren't met.
-type GetDeploymentChainResponseValidationError struct {
+// CancelDeploymentChainResponseValidationError is the validation error
+// returned by CancelDeploymentChainResponse.Validate if the designated
+// constraints aren't met.
+type CancelDeploymentChainResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11951,24 +15458,24 @@ type GetDeploymentChainResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDeploymentChainResponseValidationError) Field() string { return e.field }
+func (e CancelDeploymentChainResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDeploymentChainResponseValidationError) Reason() string { return e.reason }
+func (e CancelDeploymentChainResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDeploymentChainResponseValidationError) Cause() error { return e.cause }
+func (e CancelDeploymentChainResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDeploymentChainResponseValidationError) Key() bool { return e.key }
+func (e CancelDeploymentChainResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDeploymentChainResponseValidationError) ErrorName() string {
-	return "GetDeploymentChainResponseValidationError"
+func (e CancelDeploymentChainResponseValidationError) ErrorName() string {
+	return "CancelDeploymentChainResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDeploymentChainResponseValidationError) Error() string {
+func (e CancelDeploymentChainResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11980,14 +15487,14 @@ func (e GetDeploymentChainResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDeploymentChainResponse.%s: %s%s",
+		"invalid %sCancelDeploymentChainResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDeploymentChainResponseValidationError{}
+var _ error = CancelDeploymentChainResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11995,7 +15502,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDeploymentChainResponseValidationError{}
+} = CancelDeploymentChainResponseValidationError{}
 
 // Validate checks the field values on ListEventsRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
@@ -12648,6 +16155,144 @@ var _ interface {
 	ErrorName() string
 } = ListDeploymentsRequest_OptionsValidationError{}
 
+// Validate checks the field values on SearchStageLogsResponse_Match with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchStageLogsResponse_Match) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchStageLogsResponse_Match with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SearchStageLogsResponse_MatchMultiError, or nil if none found.
+func (m *SearchStageLogsResponse_Match) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchStageLogsResponse_Match) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeploymentId
+
+	// no validation rules for StageId
+
+	// no validation rules for RetriedCount
+
+	if all {
+		switch v := interface{}(m.GetBlock()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchStageLogsResponse_MatchValidationError{
+					field:  "Block",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchStageLogsResponse_MatchValidationError{
+					field:  "Block",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBlock()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchStageLogsResponse_MatchValidationError{
+				field:  "Block",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SearchStageLogsResponse_MatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchStageLogsResponse_MatchMultiError is an error wrapping multiple
+// validation errors returned by SearchStageLogsResponse_Match.ValidateAll()
+// if the designated constraints aren't met.
+type SearchStageLogsResponse_MatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchStageLogsResponse_MatchMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchStageLogsResponse_MatchMultiError) AllErrors() []error { return m }
+
+// SearchStageLogsResponse_MatchValidationError is the validation error
+// returned by SearchStageLogsResponse_Match.Validate if the designated
+// constraints aren't met.
+type SearchStageLogsResponse_MatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchStageLogsResponse_MatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchStageLogsResponse_MatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchStageLogsResponse_MatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchStageLogsResponse_MatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchStageLogsResponse_MatchValidationError) ErrorName() string {
+	return "SearchStageLogsResponse_MatchValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchStageLogsResponse_MatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchStageLogsResponse_Match.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchStageLogsResponse_MatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchStageLogsResponse_MatchValidationError{}
+
 // Validate checks the field values on ListDeploymentTracesRequest_Options with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the first error encountered is returned, or nil if there are
@@ -43,6 +43,9 @@ type WebServiceClient interface {
 	DeleteApplication(ctx context.Context, in *DeleteApplicationRequest, opts ...grpc.CallOption) (*DeleteApplicationResponse, error)
 	ListApplications(ctx context.Context, in *ListApplicationsRequest, opts ...grpc.CallOption) (*ListApplicationsResponse, error)
 	SyncApplication(ctx context.Context, in *SyncApplicationRequest, opts ...grpc.CallOption) (*SyncApplicationResponse, error)
+	// SyncApplicationBypassingFreeze syncs the application even while it is in a deployment freeze window.
+	// Only users who can update the project are allowed to do this.
+	SyncApplicationBypassingFreeze(ctx context.Context, in *SyncApplicationBypassingFreezeRequest, opts ...grpc.CallOption) (*SyncApplicationBypassingFreezeResponse, error)
 	GetApplication(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*GetApplicationResponse, error)
 	GenerateApplicationSealedSecret(ctx context.Context, in *GenerateApplicationSealedSecretRequest, opts ...grpc.CallOption) (*GenerateApplicationSealedSecretResponse, error)
 	ListUnregisteredApplications(ctx context.Context, in *ListUnregisteredApplicationsRequest, opts ...grpc.CallOption) (*ListUnregisteredApplicationsResponse, error)
@@ -50,8 +53,10 @@ type WebServiceClient interface {
 	ListDeployments(ctx context.Context, in *ListDeploymentsRequest, opts ...grpc.CallOption) (*ListDeploymentsResponse, error)
 	GetDeployment(ctx context.Context, in *GetDeploymentRequest, opts ...grpc.CallOption) (*GetDeploymentResponse, error)
 	GetStageLog(ctx context.Context, in *GetStageLogRequest, opts ...grpc.CallOption) (*GetStageLogResponse, error)
+	SearchStageLogs(ctx context.Context, in *SearchStageLogsRequest, opts ...grpc.CallOption) (*SearchStageLogsResponse, error)
 	CancelDeployment(ctx context.Context, in *CancelDeploymentRequest, opts ...grpc.CallOption) (*CancelDeploymentResponse, error)
 	SkipStage(ctx context.Context, in *SkipStageRequest, opts ...grpc.CallOption) (*SkipStageResponse, error)
+	RetryStage(ctx context.Context, in *RetryStageRequest, opts ...grpc.CallOption) (*RetryStageResponse, error)
 	ApproveStage(ctx context.Context, in *ApproveStageRequest, opts ...grpc.CallOption) (*ApproveStageResponse, error)
 	// Deployment tracing
 	ListDeploymentTraces(ctx context.Context, in *ListDeploymentTracesRequest, opts ...grpc.CallOption) (*ListDeploymentTracesResponse, error)
@@ -70,6 +75,18 @@ type WebServiceClient interface {
 	DeleteProjectRBACRole(ctx context.Context, in *DeleteProjectRBACRoleRequest, opts ...grpc.CallOption) (*DeleteProjectRBACRoleResponse, error)
 	AddProjectUserGroup(ctx context.Context, in *AddProjectUserGroupRequest, opts ...grpc.CallOption) (*AddProjectUserGroupResponse, error)
 	DeleteProjectUserGroup(ctx context.Context, in *DeleteProjectUserGroupRequest, opts ...grpc.CallOption) (*DeleteProjectUserGroupResponse, error)
+	AddProjectDeploymentFreezeWindow(ctx context.Context, in *AddProjectDeploymentFreezeWindowRequest, opts ...grpc.CallOption) (*AddProjectDeploymentFreezeWindowResponse, error)
+	DeleteProjectDeploymentFreezeWindow(ctx context.Context, in *DeleteProjectDeploymentFreezeWindowRequest, opts ...grpc.CallOption) (*DeleteProjectDeploymentFreezeWindowResponse, error)
+	ListStaticUsers(ctx context.Context, in *ListStaticUsersRequest, opts ...grpc.CallOption) (*ListStaticUsersResponse, error)
+	AddStaticUser(ctx context.Context, in *AddStaticUserRequest, opts ...grpc.CallOption) (*AddStaticUserResponse, error)
+	DeleteStaticUser(ctx context.Context, in *DeleteStaticUserRequest, opts ...grpc.CallOption) (*DeleteStaticUserResponse, error)
+	UpdateStaticUserRole(ctx context.Context, in *UpdateStaticUserRoleRequest, opts ...grpc.CallOption) (*UpdateStaticUserRoleResponse, error)
+	ResetStaticUserPassword(ctx context.Context, in *ResetStaticUserPasswordRequest, opts ...grpc.CallOption) (*ResetStaticUserPasswordResponse, error)
+	// The following RPCs are used by the signed in static user to manage their own account.
+	ChangeStaticUserPassword(ctx context.Context, in *ChangeStaticUserPasswordRequest, opts ...grpc.CallOption) (*ChangeStaticUserPasswordResponse, error)
+	GenerateStaticUserTOTPSecret(ctx context.Context, in *GenerateStaticUserTOTPSecretRequest, opts ...grpc.CallOption) (*GenerateStaticUserTOTPSecretResponse, error)
+	EnableStaticUserTOTP(ctx context.Context, in *EnableStaticUserTOTPRequest, opts ...grpc.CallOption) (*EnableStaticUserTOTPResponse, error)
+	DisableStaticUserTOTP(ctx context.Context, in *DisableStaticUserTOTPRequest, opts ...grpc.CallOption) (*DisableStaticUserTOTPResponse, error)
 	// Command
 	GetCommand(ctx context.Context, in *GetCommandRequest, opts ...grpc.CallOption) (*GetCommandResponse, error)
 	// API Key
@@ -82,6 +99,7 @@ type WebServiceClient interface {
 	// DeploymentChain
 	ListDeploymentChains(ctx context.Context, in *ListDeploymentChainsRequest, opts ...grpc.CallOption) (*ListDeploymentChainsResponse, error)
 	GetDeploymentChain(ctx context.Context, in *GetDeploymentChainRequest, opts ...grpc.CallOption) (*GetDeploymentChainResponse, error)
+	CancelDeploymentChain(ctx context.Context, in *CancelDeploymentChainRequest, opts ...grpc.CallOption) (*CancelDeploymentChainResponse, error)
 	// Events
 	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
 }
@@ -265,6 +283,15 @@ func (c *webServiceClient) SyncApplication(ctx context.Context, in *SyncApplicat
 	return out, nil
 }
 
+func (c *webServiceClient) SyncApplicationBypassingFreeze(ctx context.Context, in *SyncApplicationBypassingFreezeRequest, opts ...grpc.CallOption) (*SyncApplicationBypassingFreezeResponse, error) {
+	out := new(SyncApplicationBypassingFreezeResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/SyncApplicationBypassingFreeze", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *webServiceClient) GetApplication(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*GetApplicationResponse, error) {
 	out := new(GetApplicationResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/GetApplication", in, out, opts...)
@@ -319,6 +346,15 @@ func (c *webServiceClient) GetStageLog(ctx context.Context, in *GetStageLogReque
 	return out, nil
 }
 
+func (c *webServiceClient) SearchStageLogs(ctx context.Context, in *SearchStageLogsRequest, opts ...grpc.CallOption) (*SearchStageLogsResponse, error) {
+	out := new(SearchStageLogsResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/SearchStageLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *webServiceClient) CancelDeployment(ctx context.Context, in *CancelDeploymentRequest, opts ...grpc.CallOption) (*CancelDeploymentResponse, error) {
 	out := new(CancelDeploymentResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/CancelDeployment", in, out, opts...)
@@ -337,6 +373,15 @@ func (c *webServiceClient) SkipStage(ctx context.Context, in *SkipStageRequest,
 	return out, nil
 }
 
+func (c *webServiceClient) RetryStage(ctx context.Context, in *RetryStageRequest, opts ...grpc.CallOption) (*RetryStageResponse, error) {
+	out := new(RetryStageResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/RetryStage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *webServiceClient) ApproveStage(ctx context.Context, in *ApproveStageRequest, opts ...grpc.CallOption) (*ApproveStageResponse, error) {
 	out := new(ApproveStageResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/ApproveStage", in, out, opts...)
@@ -472,6 +517,105 @@ func (c *webServiceClient) DeleteProjectUserGroup(ctx context.Context, in *Delet
 	return out, nil
 }
 
+func (c *webServiceClient) AddProjectDeploymentFreezeWindow(ctx context.Context, in *AddProjectDeploymentFreezeWindowRequest, opts ...grpc.CallOption) (*AddProjectDeploymentFreezeWindowResponse, error) {
+	out := new(AddProjectDeploymentFreezeWindowResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/AddProjectDeploymentFreezeWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webServiceClient) DeleteProjectDeploymentFreezeWindow(ctx context.Context, in *DeleteProjectDeploymentFreezeWindowRequest, opts ...grpc.CallOption) (*DeleteProjectDeploymentFreezeWindowResponse, error) {
+	out := new(DeleteProjectDeploymentFreezeWindowResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/DeleteProjectDeploymentFreezeWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webServiceClient) ListStaticUsers(ctx context.Context, in *ListStaticUsersRequest, opts ...grpc.CallOption) (*ListStaticUsersResponse, error) {
+	out := new(ListStaticUsersResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/ListStaticUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webServiceClient) AddStaticUser(ctx context.Context, in *AddStaticUserRequest, opts ...grpc.CallOption) (*AddStaticUserResponse, error) {
+	out := new(AddStaticUserResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/AddStaticUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webServiceClient) DeleteStaticUser(ctx context.Context, in *DeleteStaticUserRequest, opts ...grpc.CallOption) (*DeleteStaticUserResponse, error) {
+	out := new(DeleteStaticUserResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/DeleteStaticUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webServiceClient) UpdateStaticUserRole(ctx context.Context, in *UpdateStaticUserRoleRequest, opts ...grpc.CallOption) (*UpdateStaticUserRoleResponse, error) {
+	out := new(UpdateStaticUserRoleResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/UpdateStaticUserRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webServiceClient) ResetStaticUserPassword(ctx context.Context, in *ResetStaticUserPasswordRequest, opts ...grpc.CallOption) (*ResetStaticUserPasswordResponse, error) {
+	out := new(ResetStaticUserPasswordResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/ResetStaticUserPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webServiceClient) ChangeStaticUserPassword(ctx context.Context, in *ChangeStaticUserPasswordRequest, opts ...grpc.CallOption) (*ChangeStaticUserPasswordResponse, error) {
+	out := new(ChangeStaticUserPasswordResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/ChangeStaticUserPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webServiceClient) GenerateStaticUserTOTPSecret(ctx context.Context, in *GenerateStaticUserTOTPSecretRequest, opts ...grpc.CallOption) (*GenerateStaticUserTOTPSecretResponse, error) {
+	out := new(GenerateStaticUserTOTPSecretResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/GenerateStaticUserTOTPSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webServiceClient) EnableStaticUserTOTP(ctx context.Context, in *EnableStaticUserTOTPRequest, opts ...grpc.CallOption) (*EnableStaticUserTOTPResponse, error) {
+	out := new(EnableStaticUserTOTPResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/EnableStaticUserTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webServiceClient) DisableStaticUserTOTP(ctx context.Context, in *DisableStaticUserTOTPRequest, opts ...grpc.CallOption) (*DisableStaticUserTOTPResponse, error) {
+	out := new(DisableStaticUserTOTPResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/DisableStaticUserTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *webServiceClient) GetCommand(ctx context.Context, in *GetCommandRequest, opts ...grpc.CallOption) (*GetCommandResponse, error) {
 	out := new(GetCommandResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/GetCommand", in, out, opts...)
@@ -544,6 +688,15 @@ func (c *webServiceClient) GetDeploymentChain(ctx context.Context, in *GetDeploy
 	return out, nil
 }
 
+func (c *webServiceClient) CancelDeploymentChain(ctx context.Context, in *CancelDeploymentChainRequest, opts ...grpc.CallOption) (*CancelDeploymentChainResponse, error) {
+	out := new(CancelDeploymentChainResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/CancelDeploymentChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *webServiceClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
 	out := new(ListEventsResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/ListEvents", in, out, opts...)
@@ -578,6 +731,9 @@ type WebServiceServer interface {
 	DeleteApplication(context.Context, *DeleteApplicationRequest) (*DeleteApplicationResponse, error)
 	ListApplications(context.Context, *ListApplicationsRequest) (*ListApplicationsResponse, error)
 	SyncApplication(context.Context, *SyncApplicationRequest) (*SyncApplicationResponse, error)
+	// SyncApplicationBypassingFreeze syncs the application even while it is in a deployment freeze window.
+	// Only users who can update the project are allowed to do this.
+	SyncApplicationBypassingFreeze(context.Context, *SyncApplicationBypassingFreezeRequest) (*SyncApplicationBypassingFreezeResponse, error)
 	GetApplication(context.Context, *GetApplicationRequest) (*GetApplicationResponse, error)
 	GenerateApplicationSealedSecret(context.Context, *GenerateApplicationSealedSecretRequest) (*GenerateApplicationSealedSecretResponse, error)
 	ListUnregisteredApplications(context.Context, *ListUnregisteredApplicationsRequest) (*ListUnregisteredApplicationsResponse, error)
@@ -585,8 +741,10 @@ type WebServiceServer interface {
 	ListDeployments(context.Context, *ListDeploymentsRequest) (*ListDeploymentsResponse, error)
 	GetDeployment(context.Context, *GetDeploymentRequest) (*GetDeploymentResponse, error)
 	GetStageLog(context.Context, *GetStageLogRequest) (*GetStageLogResponse, error)
+	SearchStageLogs(context.Context, *SearchStageLogsRequest) (*SearchStageLogsResponse, error)
 	CancelDeployment(context.Context, *CancelDeploymentRequest) (*CancelDeploymentResponse, error)
 	SkipStage(context.Context, *SkipStageRequest) (*SkipStageResponse, error)
+	RetryStage(context.Context, *RetryStageRequest) (*RetryStageResponse, error)
 	ApproveStage(context.Context, *ApproveStageRequest) (*ApproveStageResponse, error)
 	// Deployment tracing
 	ListDeploymentTraces(context.Context, *ListDeploymentTracesRequest) (*ListDeploymentTracesResponse, error)
@@ -605,6 +763,18 @@ type WebServiceServer interface {
 	DeleteProjectRBACRole(context.Context, *DeleteProjectRBACRoleRequest) (*DeleteProjectRBACRoleResponse, error)
 	AddProjectUserGroup(context.Context, *AddProjectUserGroupRequest) (*AddProjectUserGroupResponse, error)
 	DeleteProjectUserGroup(context.Context, *DeleteProjectUserGroupRequest) (*DeleteProjectUserGroupResponse, error)
+	AddProjectDeploymentFreezeWindow(context.Context, *AddProjectDeploymentFreezeWindowRequest) (*AddProjectDeploymentFreezeWindowResponse, error)
+	DeleteProjectDeploymentFreezeWindow(context.Context, *DeleteProjectDeploymentFreezeWindowRequest) (*DeleteProjectDeploymentFreezeWindowResponse, error)
+	ListStaticUsers(context.Context, *ListStaticUsersRequest) (*ListStaticUsersResponse, error)
+	AddStaticUser(context.Context, *AddStaticUserRequest) (*AddStaticUserResponse, error)
+	DeleteStaticUser(context.Context, *DeleteStaticUserRequest) (*DeleteStaticUserResponse, error)
+	UpdateStaticUserRole(context.Context, *UpdateStaticUserRoleRequest) (*UpdateStaticUserRoleResponse, error)
+	ResetStaticUserPassword(context.Context, *ResetStaticUserPasswordRequest) (*ResetStaticUserPasswordResponse, error)
+	// The following RPCs are used by the signed in static user to manage their own account.
+	ChangeStaticUserPassword(context.Context, *ChangeStaticUserPasswordRequest) (*ChangeStaticUserPasswordResponse, error)
+	GenerateStaticUserTOTPSecret(context.Context, *GenerateStaticUserTOTPSecretRequest) (*GenerateStaticUserTOTPSecretResponse, error)
+	EnableStaticUserTOTP(context.Context, *EnableStaticUserTOTPRequest) (*EnableStaticUserTOTPResponse, error)
+	DisableStaticUserTOTP(context.Context, *DisableStaticUserTOTPRequest) (*DisableStaticUserTOTPResponse, error)
 	// Command
 	GetCommand(context.Context, *GetCommandRequest) (*GetCommandResponse, error)
 	// API Key
@@ -617,6 +787,7 @@ type WebServiceServer interface {
 	// DeploymentChain
 	ListDeploymentChains(context.Context, *ListDeploymentChainsRequest) (*ListDeploymentChainsResponse, error)
 	GetDeploymentChain(context.Context, *GetDeploymentChainRequest) (*GetDeploymentChainResponse, error)
+	CancelDeploymentChain(context.Context, *CancelDeploymentChainRequest) (*CancelDeploymentChainResponse, error)
 	// Events
 	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
 	mustEmbedUnimplementedWebServiceServer()
@@ -683,6 +854,9 @@ func (UnimplementedWebServiceServer) ListApplications(context.Context, *ListAppl
 func (UnimplementedWebServiceServer) SyncApplication(context.Context, *SyncApplicationRequest) (*SyncApplicationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncApplication not implemented")
 }
+func (UnimplementedWebServiceServer) SyncApplicationBypassingFreeze(context.Context, *SyncApplicationBypassingFreezeRequest) (*SyncApplicationBypassingFreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncApplicationBypassingFreeze not implemented")
+}
 func (UnimplementedWebServiceServer) GetApplication(context.Context, *GetApplicationRequest) (*GetApplicationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetApplication not implemented")
 }
@@ -701,12 +875,18 @@ func (UnimplementedWebServiceServer) GetDeployment(context.Context, *GetDeployme
 func (UnimplementedWebServiceServer) GetStageLog(context.Context, *GetStageLogRequest) (*GetStageLogResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStageLog not implemented")
 }
+func (UnimplementedWebServiceServer) SearchStageLogs(context.Context, *SearchStageLogsRequest) (*SearchStageLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchStageLogs not implemented")
+}
 func (UnimplementedWebServiceServer) CancelDeployment(context.Context, *CancelDeploymentRequest) (*CancelDeploymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelDeployment not implemented")
 }
 func (UnimplementedWebServiceServer) SkipStage(context.Context, *SkipStageRequest) (*SkipStageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SkipStage not implemented")
 }
+func (UnimplementedWebServiceServer) RetryStage(context.Context, *RetryStageRequest) (*RetryStageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryStage not implemented")
+}
 func (UnimplementedWebServiceServer) ApproveStage(context.Context, *ApproveStageRequest) (*ApproveStageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApproveStage not implemented")
 }
@@ -752,6 +932,39 @@ func (UnimplementedWebServiceServer) AddProjectUserGroup(context.Context, *AddPr
 func (UnimplementedWebServiceServer) DeleteProjectUserGroup(context.Context, *DeleteProjectUserGroupRequest) (*DeleteProjectUserGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProjectUserGroup not implemented")
 }
+func (UnimplementedWebServiceServer) AddProjectDeploymentFreezeWindow(context.Context, *AddProjectDeploymentFreezeWindowRequest) (*AddProjectDeploymentFreezeWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProjectDeploymentFreezeWindow not implemented")
+}
+func (UnimplementedWebServiceServer) DeleteProjectDeploymentFreezeWindow(context.Context, *DeleteProjectDeploymentFreezeWindowRequest) (*DeleteProjectDeploymentFreezeWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProjectDeploymentFreezeWindow not implemented")
+}
+func (UnimplementedWebServiceServer) ListStaticUsers(context.Context, *ListStaticUsersRequest) (*ListStaticUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStaticUsers not implemented")
+}
+func (UnimplementedWebServiceServer) AddStaticUser(context.Context, *AddStaticUserRequest) (*AddStaticUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStaticUser not implemented")
+}
+func (UnimplementedWebServiceServer) DeleteStaticUser(context.Context, *DeleteStaticUserRequest) (*DeleteStaticUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStaticUser not implemented")
+}
+func (UnimplementedWebServiceServer) UpdateStaticUserRole(context.Context, *UpdateStaticUserRoleRequest) (*UpdateStaticUserRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStaticUserRole not implemented")
+}
+func (UnimplementedWebServiceServer) ResetStaticUserPassword(context.Context, *ResetStaticUserPasswordRequest) (*ResetStaticUserPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetStaticUserPassword not implemented")
+}
+func (UnimplementedWebServiceServer) ChangeStaticUserPassword(context.Context, *ChangeStaticUserPasswordRequest) (*ChangeStaticUserPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeStaticUserPassword not implemented")
+}
+func (UnimplementedWebServiceServer) GenerateStaticUserTOTPSecret(context.Context, *GenerateStaticUserTOTPSecretRequest) (*GenerateStaticUserTOTPSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateStaticUserTOTPSecret not implemented")
+}
+func (UnimplementedWebServiceServer) EnableStaticUserTOTP(context.Context, *EnableStaticUserTOTPRequest) (*EnableStaticUserTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableStaticUserTOTP not implemented")
+}
+func (UnimplementedWebServiceServer) DisableStaticUserTOTP(context.Context, *DisableStaticUserTOTPRequest) (*DisableStaticUserTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableStaticUserTOTP not implemented")
+}
 func (UnimplementedWebServiceServer) GetCommand(context.Context, *GetCommandRequest) (*GetCommandResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCommand not implemented")
 }
@@ -776,6 +989,9 @@ func (UnimplementedWebServiceServer) ListDeploymentChains(context.Context, *List
 func (UnimplementedWebServiceServer) GetDeploymentChain(context.Context, *GetDeploymentChainRequest) (*GetDeploymentChainResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDeploymentChain not implemented")
 }
+func (UnimplementedWebServiceServer) CancelDeploymentChain(context.Context, *CancelDeploymentChainRequest) (*CancelDeploymentChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDeploymentChain not implemented")
+}
 func (UnimplementedWebServiceServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
 }
@@ -1134,6 +1350,24 @@ func _WebService_SyncApplication_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WebService_SyncApplicationBypassingFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncApplicationBypassingFreezeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServiceServer).SyncApplicationBypassingFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.webservice.WebService/SyncApplicationBypassingFreeze",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServiceServer).SyncApplicationBypassingFreeze(ctx, req.(*SyncApplicationBypassingFreezeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WebService_GetApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetApplicationRequest)
 	if err := dec(in); err != nil {
@@ -1242,6 +1476,24 @@ func _WebService_GetStageLog_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WebService_SearchStageLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchStageLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServiceServer).SearchStageLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.webservice.WebService/SearchStageLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServiceServer).SearchStageLogs(ctx, req.(*SearchStageLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WebService_CancelDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CancelDeploymentRequest)
 	if err := dec(in); err != nil {
@@ -1278,6 +1530,24 @@ func _WebService_SkipStage_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WebService_RetryStage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryStageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServiceServer).RetryStage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.webservice.WebService/RetryStage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServiceServer).RetryStage(ctx, req.(*RetryStageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WebService_ApproveStage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApproveStageRequest)
 	if err := dec(in); err != nil {
@@ -1548,6 +1818,204 @@ func _WebService_DeleteProjectUserGroup_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WebService_AddProjectDeploymentFreezeWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProjectDeploymentFreezeWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServiceServer).AddProjectDeploymentFreezeWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.webservice.WebService/AddProjectDeploymentFreezeWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServiceServer).AddProjectDeploymentFreezeWindow(ctx, req.(*AddProjectDeploymentFreezeWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebService_DeleteProjectDeploymentFreezeWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectDeploymentFreezeWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServiceServer).DeleteProjectDeploymentFreezeWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.webservice.WebService/DeleteProjectDeploymentFreezeWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServiceServer).DeleteProjectDeploymentFreezeWindow(ctx, req.(*DeleteProjectDeploymentFreezeWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebService_ListStaticUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStaticUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServiceServer).ListStaticUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.webservice.WebService/ListStaticUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServiceServer).ListStaticUsers(ctx, req.(*ListStaticUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebService_AddStaticUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddStaticUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServiceServer).AddStaticUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.webservice.WebService/AddStaticUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServiceServer).AddStaticUser(ctx, req.(*AddStaticUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebService_DeleteStaticUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStaticUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServiceServer).DeleteStaticUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.webservice.WebService/DeleteStaticUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServiceServer).DeleteStaticUser(ctx, req.(*DeleteStaticUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebService_UpdateStaticUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStaticUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServiceServer).UpdateStaticUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.webservice.WebService/UpdateStaticUserRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServiceServer).UpdateStaticUserRole(ctx, req.(*UpdateStaticUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebService_ResetStaticUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetStaticUserPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServiceServer).ResetStaticUserPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.webservice.WebService/ResetStaticUserPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServiceServer).ResetStaticUserPassword(ctx, req.(*ResetStaticUserPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebService_ChangeStaticUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeStaticUserPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServiceServer).ChangeStaticUserPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.webservice.WebService/ChangeStaticUserPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServiceServer).ChangeStaticUserPassword(ctx, req.(*ChangeStaticUserPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebService_GenerateStaticUserTOTPSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateStaticUserTOTPSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServiceServer).GenerateStaticUserTOTPSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.webservice.WebService/GenerateStaticUserTOTPSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServiceServer).GenerateStaticUserTOTPSecret(ctx, req.(*GenerateStaticUserTOTPSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebService_EnableStaticUserTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableStaticUserTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServiceServer).EnableStaticUserTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.webservice.WebService/EnableStaticUserTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServiceServer).EnableStaticUserTOTP(ctx, req.(*EnableStaticUserTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebService_DisableStaticUserTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableStaticUserTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServiceServer).DisableStaticUserTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.webservice.WebService/DisableStaticUserTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServiceServer).DisableStaticUserTOTP(ctx, req.(*DisableStaticUserTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WebService_GetCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCommandRequest)
 	if err := dec(in); err != nil {
@@ -1692,6 +2160,24 @@ func _WebService_GetDeploymentChain_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WebService_CancelDeploymentChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelDeploymentChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServiceServer).CancelDeploymentChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.webservice.WebService/CancelDeploymentChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServiceServer).CancelDeploymentChain(ctx, req.(*CancelDeploymentChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WebService_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListEventsRequest)
 	if err := dec(in); err != nil {
@@ -1793,6 +2279,10 @@ var WebService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SyncApplication",
 			Handler:    _WebService_SyncApplication_Handler,
 		},
+		{
+			MethodName: "SyncApplicationBypassingFreeze",
+			Handler:    _WebService_SyncApplicationBypassingFreeze_Handler,
+		},
 		{
 			MethodName: "GetApplication",
 			Handler:    _WebService_GetApplication_Handler,
@@ -1817,6 +2307,10 @@ var WebService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStageLog",
 			Handler:    _WebService_GetStageLog_Handler,
 		},
+		{
+			MethodName: "SearchStageLogs",
+			Handler:    _WebService_SearchStageLogs_Handler,
+		},
 		{
 			MethodName: "CancelDeployment",
 			Handler:    _WebService_CancelDeployment_Handler,
@@ -1825,6 +2319,10 @@ var WebService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SkipStage",
 			Handler:    _WebService_SkipStage_Handler,
 		},
+		{
+			MethodName: "RetryStage",
+			Handler:    _WebService_RetryStage_Handler,
+		},
 		{
 			MethodName: "ApproveStage",
 			Handler:    _WebService_ApproveStage_Handler,
@@ -1885,6 +2383,50 @@ var WebService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProjectUserGroup",
 			Handler:    _WebService_DeleteProjectUserGroup_Handler,
 		},
+		{
+			MethodName: "AddProjectDeploymentFreezeWindow",
+			Handler:    _WebService_AddProjectDeploymentFreezeWindow_Handler,
+		},
+		{
+			MethodName: "DeleteProjectDeploymentFreezeWindow",
+			Handler:    _WebService_DeleteProjectDeploymentFreezeWindow_Handler,
+		},
+		{
+			MethodName: "ListStaticUsers",
+			Handler:    _WebService_ListStaticUsers_Handler,
+		},
+		{
+			MethodName: "AddStaticUser",
+			Handler:    _WebService_AddStaticUser_Handler,
+		},
+		{
+			MethodName: "DeleteStaticUser",
+			Handler:    _WebService_DeleteStaticUser_Handler,
+		},
+		{
+			MethodName: "UpdateStaticUserRole",
+			Handler:    _WebService_UpdateStaticUserRole_Handler,
+		},
+		{
+			MethodName: "ResetStaticUserPassword",
+			Handler:    _WebService_ResetStaticUserPassword_Handler,
+		},
+		{
+			MethodName: "ChangeStaticUserPassword",
+			Handler:    _WebService_ChangeStaticUserPassword_Handler,
+		},
+		{
+			MethodName: "GenerateStaticUserTOTPSecret",
+			Handler:    _WebService_GenerateStaticUserTOTPSecret_Handler,
+		},
+		{
+			MethodName: "EnableStaticUserTOTP",
+			Handler:    _WebService_EnableStaticUserTOTP_Handler,
+		},
+		{
+			MethodName: "DisableStaticUserTOTP",
+			Handler:    _WebService_DisableStaticUserTOTP_Handler,
+		},
 		{
 			MethodName: "GetCommand",
 			Handler:    _WebService_GetCommand_Handler,
@@ -1917,6 +2459,10 @@ var WebService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDeploymentChain",
 			Handler:    _WebService_GetDeploymentChain_Handler,
 		},
+		{
+			MethodName: "CancelDeploymentChain",
+			Handler:    _WebService_CancelDeploymentChain_Handler,
+		},
 		{
 			MethodName: "ListEvents",
 			Handler:    _WebService_ListEvents_Handler,
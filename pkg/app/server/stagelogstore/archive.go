@@ -0,0 +1,134 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stagelogstore
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/pipe-cd/pipecd/pkg/filestore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	stageLogPrefix         = "log/"
+	archivedStageLogPrefix = "log-archive/"
+	stageLogExt            = ".txt"
+	compressedStageLogExt  = ".txt.gz"
+)
+
+// LogObject represents a stage log object stored in the primary prefix of the filestore.
+type LogObject struct {
+	DeploymentID string
+	StageID      string
+	RetriedCount int32
+	// Whether the stage log was already compressed by the archiver.
+	Compressed bool
+}
+
+// ReadLogObject returns the log blocks of the given stage log object
+// and whether all logs of the stage were already written.
+func ReadLogObject(ctx context.Context, fs filestore.Getter, obj LogObject) ([]*model.LogBlock, bool, error) {
+	data, err := fs.Get(ctx, obj.path())
+	if err != nil {
+		return nil, false, err
+	}
+
+	var lf logFragment
+	if obj.Compressed {
+		lf, err = decompressLogFragment(data)
+	} else {
+		lf, err = parseLogFragment(bytes.NewReader(data))
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return lf.Blocks, lf.Completed, nil
+}
+
+// CompressLogObject replaces the given uncompressed stage log object with its gzip compressed one.
+func CompressLogObject(ctx context.Context, fs filestore.Store, obj LogObject) error {
+	if obj.Compressed {
+		return errors.New("stage log was already compressed")
+	}
+	data, err := fs.Get(ctx, obj.path())
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	// The uncompressed one is deleted after writing the compressed one,
+	// so the stage log is always readable even if this fails in the middle.
+	if err := fs.Put(ctx, compressedStageLogPath(obj.DeploymentID, obj.StageID, obj.RetriedCount), buf.Bytes()); err != nil {
+		return err
+	}
+	return fs.Delete(ctx, obj.path())
+}
+
+// ArchiveLogObject moves the given compressed stage log object to the archive prefix.
+func ArchiveLogObject(ctx context.Context, fs filestore.Store, obj LogObject) error {
+	if !obj.Compressed {
+		return errors.New("stage log must be compressed before archiving")
+	}
+	data, err := fs.Get(ctx, obj.path())
+	if err != nil {
+		return err
+	}
+	if err := fs.Put(ctx, archivedStageLogPath(obj.DeploymentID, obj.StageID, obj.RetriedCount), data); err != nil {
+		return err
+	}
+	return fs.Delete(ctx, obj.path())
+}
+
+func (o LogObject) path() string {
+	if o.Compressed {
+		return compressedStageLogPath(o.DeploymentID, o.StageID, o.RetriedCount)
+	}
+	return stageLogPath(o.DeploymentID, o.StageID, o.RetriedCount)
+}
+
+func decompressLogFragment(data []byte) (logFragment, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return logFragment{}, err
+	}
+	defer zr.Close()
+
+	raw, err := io.ReadAll(zr)
+	if err != nil {
+		return logFragment{}, err
+	}
+	return parseLogFragment(bytes.NewReader(raw))
+}
+
+func compressedStageLogPath(deploymentID, stageID string, retriedCount int32) string {
+	return fmt.Sprintf("%s%s/%s/%d%s", stageLogPrefix, deploymentID, stageID, retriedCount, compressedStageLogExt)
+}
+
+func archivedStageLogPath(deploymentID, stageID string, retriedCount int32) string {
+	return fmt.Sprintf("%s%s/%s/%d%s", archivedStageLogPrefix, deploymentID, stageID, retriedCount, compressedStageLogExt)
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stagelogstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/filestore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestCompressAndArchiveLogObject(t *testing.T) {
+	ctx := context.Background()
+	fs := newFakeFileStore()
+	backend := &stageLogFileStore{filestore: fs}
+
+	lf := logFragment{
+		Blocks: []*model.LogBlock{
+			{Index: 1, Log: "start", Severity: model.LogSeverity_INFO, CreatedAt: 100},
+			{Index: 2, Log: "done", Severity: model.LogSeverity_SUCCESS, CreatedAt: 110, Fields: map[string]string{"kind": "Deployment"}},
+		},
+		Completed: true,
+	}
+	require.NoError(t, backend.Put(ctx, "deployment-1", "stage-1", 0, &lf))
+
+	obj := LogObject{DeploymentID: "deployment-1", StageID: "stage-1", RetriedCount: 0}
+	blocks, completed, err := ReadLogObject(ctx, fs, obj)
+	require.NoError(t, err)
+	assert.True(t, completed)
+	assert.Len(t, blocks, 2)
+
+	// Archiving an uncompressed stage log is not allowed.
+	require.Error(t, ArchiveLogObject(ctx, fs, obj))
+
+	require.NoError(t, CompressLogObject(ctx, fs, obj))
+	_, err = fs.Get(ctx, stageLogPath("deployment-1", "stage-1", 0))
+	require.ErrorIs(t, err, filestore.ErrNotFound)
+
+	obj.Compressed = true
+	blocks, completed, err = ReadLogObject(ctx, fs, obj)
+	require.NoError(t, err)
+	assert.True(t, completed)
+	assert.Len(t, blocks, 2)
+
+	got, err := backend.Get(ctx, "deployment-1", "stage-1", 0)
+	require.NoError(t, err)
+	assert.Equal(t, lf.Completed, got.Completed)
+	assert.Equal(t, "done", got.Blocks[1].Log)
+	assert.Equal(t, map[string]string{"kind": "Deployment"}, got.Blocks[1].Fields)
+
+	require.NoError(t, ArchiveLogObject(ctx, fs, obj))
+	_, err = fs.Get(ctx, compressedStageLogPath("deployment-1", "stage-1", 0))
+	require.ErrorIs(t, err, filestore.ErrNotFound)
+
+	// The archived stage log is still readable transparently.
+	got, err = backend.Get(ctx, "deployment-1", "stage-1", 0)
+	require.NoError(t, err)
+	assert.True(t, got.Completed)
+	assert.Len(t, got.Blocks, 2)
+}
@@ -19,7 +19,9 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 
 	"github.com/pipe-cd/pipecd/pkg/filestore"
 	"github.com/pipe-cd/pipecd/pkg/model"
@@ -33,15 +35,37 @@ type stageLogFileStore struct {
 	filestore filestore.Store
 }
 
+// Get returns the stage log at the primary path.
+// When it was already compressed or archived by the archiver, the compressed one is returned instead.
 func (f *stageLogFileStore) Get(ctx context.Context, deploymentID, stageID string, retriedCount int32) (logFragment, error) {
 	path := stageLogPath(deploymentID, stageID, retriedCount)
-	lf := logFragment{}
 	reader, err := f.filestore.GetReader(ctx, path)
-	if err != nil {
-		return lf, err
+	if err == nil {
+		defer reader.Close()
+		return parseLogFragment(reader)
+	}
+	if !errors.Is(err, filestore.ErrNotFound) {
+		return logFragment{}, err
 	}
-	defer reader.Close()
 
+	for _, path := range []string{
+		compressedStageLogPath(deploymentID, stageID, retriedCount),
+		archivedStageLogPath(deploymentID, stageID, retriedCount),
+	} {
+		data, err := f.filestore.Get(ctx, path)
+		if errors.Is(err, filestore.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return logFragment{}, err
+		}
+		return decompressLogFragment(data)
+	}
+	return logFragment{}, filestore.ErrNotFound
+}
+
+func parseLogFragment(reader io.Reader) (logFragment, error) {
+	lf := logFragment{}
 	blocks := make([]*model.LogBlock, 0)
 	scanner := bufio.NewScanner(reader)
 
@@ -69,12 +93,20 @@ func (f *stageLogFileStore) Get(ctx context.Context, deploymentID, stageID strin
 
 func (f *stageLogFileStore) Put(ctx context.Context, deploymentID, stageID string, retriedCount int32, lf *logFragment) error {
 	path := stageLogPath(deploymentID, stageID, retriedCount)
+	data, err := marshalLogFragment(lf)
+	if err != nil {
+		return err
+	}
+	return f.filestore.Put(ctx, path, data)
+}
+
+func marshalLogFragment(lf *logFragment) ([]byte, error) {
 	var buf bytes.Buffer
 	for _, lb := range lf.Blocks {
 		// TODO: Reduce the number of marshaling log blocks for improving performance
 		raw, err := json.Marshal(lb)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		buf.Write(raw)
 		buf.WriteString("\n")
@@ -83,7 +115,7 @@ func (f *stageLogFileStore) Put(ctx context.Context, deploymentID, stageID strin
 	if lf.Completed {
 		buf.Write(eol)
 	}
-	return f.filestore.Put(ctx, path, buf.Bytes())
+	return buf.Bytes(), nil
 }
 
 func stageLogPath(deploymentID, stageID string, retriedCount int32) string {
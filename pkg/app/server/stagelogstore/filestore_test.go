@@ -107,6 +107,10 @@ EOL`,
 			path := stageLogPath(tc.deploymentID, tc.stageID, tc.retriedCount)
 			reader := io.NopCloser(strings.NewReader(tc.content))
 			store.EXPECT().GetReader(context.TODO(), path).Return(reader, tc.readerErr)
+			if tc.readerErr == filestore.ErrNotFound {
+				store.EXPECT().Get(context.TODO(), compressedStageLogPath(tc.deploymentID, tc.stageID, tc.retriedCount)).Return(nil, filestore.ErrNotFound)
+				store.EXPECT().Get(context.TODO(), archivedStageLogPath(tc.deploymentID, tc.stageID, tc.retriedCount)).Return(nil, filestore.ErrNotFound)
+			}
 			lf, err := fs.Get(context.TODO(), tc.deploymentID, tc.stageID, tc.retriedCount)
 			if err != nil {
 				if tc.expectedErr == nil {
@@ -0,0 +1,156 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stagelogstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/pipe-cd/pipecd/pkg/filestore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// maxIndexTerms is the maximum number of terms kept in the index of a stage log.
+// When a stage log contains more distinct terms than this, the index is marked
+// as truncated and the stage log is always scanned while searching.
+const maxIndexTerms = 10000
+
+// logIndex summarizes the log blocks of a stage log,
+// so that the search can skip the stage logs that can not match without reading them.
+type logIndex struct {
+	StageID        string              `json:"stageId"`
+	RetriedCount   int32               `json:"retriedCount"`
+	FirstCreatedAt int64               `json:"firstCreatedAt"`
+	LastCreatedAt  int64               `json:"lastCreatedAt"`
+	Severities     []model.LogSeverity `json:"severities"`
+	Terms          []string            `json:"terms"`
+	Truncated      bool                `json:"truncated"`
+	// Completed is true when the index was built from the completed stage log,
+	// so it covers all of the log blocks.
+	Completed bool `json:"completed"`
+}
+
+// add updates the index with the given log blocks.
+func (idx *logIndex) add(blocks []*model.LogBlock) {
+	terms := make(map[string]struct{}, len(idx.Terms))
+	for _, t := range idx.Terms {
+		terms[t] = struct{}{}
+	}
+
+	for _, lb := range blocks {
+		if idx.FirstCreatedAt == 0 || lb.CreatedAt < idx.FirstCreatedAt {
+			idx.FirstCreatedAt = lb.CreatedAt
+		}
+		if lb.CreatedAt > idx.LastCreatedAt {
+			idx.LastCreatedAt = lb.CreatedAt
+		}
+		if !slices.Contains(idx.Severities, lb.Severity) {
+			idx.Severities = append(idx.Severities, lb.Severity)
+		}
+		if idx.Truncated {
+			continue
+		}
+		for _, t := range blockTerms(lb) {
+			if len(terms) >= maxIndexTerms {
+				idx.Truncated = true
+				break
+			}
+			terms[t] = struct{}{}
+		}
+	}
+
+	slices.Sort(idx.Severities)
+	if idx.Truncated {
+		idx.Terms = nil
+		return
+	}
+	idx.Terms = make([]string, 0, len(terms))
+	for t := range terms {
+		idx.Terms = append(idx.Terms, t)
+	}
+	slices.Sort(idx.Terms)
+}
+
+// mayMatch reports whether the stage log summarized by this index
+// may contain a log block matching the given options.
+func (idx *logIndex) mayMatch(opts SearchOptions, queryTerms []string) bool {
+	if opts.From > 0 && idx.LastCreatedAt < opts.From {
+		return false
+	}
+	if opts.To > 0 && idx.FirstCreatedAt > opts.To {
+		return false
+	}
+	if len(opts.Severities) > 0 && !slices.ContainsFunc(opts.Severities, func(s model.LogSeverity) bool {
+		return slices.Contains(idx.Severities, s)
+	}) {
+		return false
+	}
+	if idx.Truncated {
+		return true
+	}
+	for _, t := range queryTerms {
+		if _, ok := slices.BinarySearch(idx.Terms, t); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// tokenize splits the given text into lower-cased terms.
+// Terms are the sequences of letters and digits.
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// blockTerms returns the terms contained in the log and the fields of the given log block.
+func blockTerms(lb *model.LogBlock) []string {
+	terms := tokenize(lb.Log)
+	for k, v := range lb.Fields {
+		terms = append(terms, tokenize(k)...)
+		terms = append(terms, tokenize(v)...)
+	}
+	return terms
+}
+
+type stageLogIndexStore struct {
+	filestore filestore.Store
+}
+
+func (f *stageLogIndexStore) Get(ctx context.Context, deploymentID, stageID string, retriedCount int32) (logIndex, error) {
+	var idx logIndex
+	data, err := f.filestore.Get(ctx, stageLogIndexPath(deploymentID, stageID, retriedCount))
+	if err != nil {
+		return idx, err
+	}
+	return idx, json.Unmarshal(data, &idx)
+}
+
+func (f *stageLogIndexStore) Put(ctx context.Context, deploymentID string, idx *logIndex) error {
+	data, err := json.Marshal(idx)
+	if err != nil {
+		return err
+	}
+	return f.filestore.Put(ctx, stageLogIndexPath(deploymentID, idx.StageID, idx.RetriedCount), data)
+}
+
+func stageLogIndexPath(deploymentID, stageID string, retriedCount int32) string {
+	return fmt.Sprintf("log-index/%s/%s/%d.json", deploymentID, stageID, retriedCount)
+}
@@ -0,0 +1,333 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stagelogstore
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/cache/memorycache"
+	"github.com/pipe-cd/pipecd/pkg/filestore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeFileStore struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func newFakeFileStore() *fakeFileStore {
+	return &fakeFileStore{objects: make(map[string][]byte)}
+}
+
+func (f *fakeFileStore) Get(_ context.Context, path string) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	data, ok := f.objects[path]
+	if !ok {
+		return nil, filestore.ErrNotFound
+	}
+	return data, nil
+}
+
+func (f *fakeFileStore) GetReader(ctx context.Context, path string) (io.ReadCloser, error) {
+	data, err := f.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (f *fakeFileStore) Put(_ context.Context, path string, content []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.objects[path] = content
+	return nil
+}
+
+func (f *fakeFileStore) Delete(_ context.Context, path string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.objects, path)
+	return nil
+}
+
+func (f *fakeFileStore) List(_ context.Context, prefix string) ([]filestore.ObjectAttrs, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var objects []filestore.ObjectAttrs
+	for path, data := range f.objects {
+		if strings.HasPrefix(path, prefix) {
+			objects = append(objects, filestore.ObjectAttrs{Path: path, Size: int64(len(data))})
+		}
+	}
+	return objects, nil
+}
+
+func (f *fakeFileStore) Close() error {
+	return nil
+}
+
+func TestTokenize(t *testing.T) {
+	testcases := []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{
+			name:     "empty",
+			text:     "",
+			expected: []string{},
+		},
+		{
+			name:     "lower-cased and split by non alphanumeric characters",
+			text:     "dial tcp 10.0.0.1:443: Connection Refused",
+			expected: []string{"dial", "tcp", "10", "0", "0", "1", "443", "connection", "refused"},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ElementsMatch(t, tc.expected, tokenize(tc.text))
+		})
+	}
+}
+
+func TestLogIndexAdd(t *testing.T) {
+	idx := logIndex{}
+	idx.add([]*model.LogBlock{
+		{Index: 1, Log: "Start applying", Severity: model.LogSeverity_INFO, CreatedAt: 200},
+		{Index: 2, Log: "connection refused", Severity: model.LogSeverity_ERROR, CreatedAt: 100, Fields: map[string]string{"Host": "db"}},
+	})
+	idx.add([]*model.LogBlock{
+		{Index: 3, Log: "start retrying", Severity: model.LogSeverity_WARN, CreatedAt: 300},
+	})
+
+	assert.Equal(t, int64(100), idx.FirstCreatedAt)
+	assert.Equal(t, int64(300), idx.LastCreatedAt)
+	assert.Equal(t, []model.LogSeverity{model.LogSeverity_INFO, model.LogSeverity_ERROR, model.LogSeverity_WARN}, idx.Severities)
+	assert.Equal(t, []string{"applying", "connection", "db", "host", "refused", "retrying", "start"}, idx.Terms)
+	assert.False(t, idx.Truncated)
+}
+
+func TestLogIndexAdd_Truncated(t *testing.T) {
+	blocks := make([]*model.LogBlock, 0, maxIndexTerms+1)
+	for i := 0; i <= maxIndexTerms; i++ {
+		blocks = append(blocks, &model.LogBlock{Index: int64(i), Log: "term" + strings.Repeat("x", i), CreatedAt: 100})
+	}
+	idx := logIndex{}
+	idx.add(blocks)
+
+	assert.True(t, idx.Truncated)
+	assert.Empty(t, idx.Terms)
+	assert.True(t, idx.mayMatch(SearchOptions{}, []string{"anything"}))
+}
+
+func TestLogIndexMayMatch(t *testing.T) {
+	idx := logIndex{
+		FirstCreatedAt: 100,
+		LastCreatedAt:  200,
+		Severities:     []model.LogSeverity{model.LogSeverity_INFO, model.LogSeverity_ERROR},
+		Terms:          []string{"connection", "refused", "start"},
+	}
+	testcases := []struct {
+		name     string
+		opts     SearchOptions
+		expected bool
+	}{
+		{
+			name:     "no condition",
+			opts:     SearchOptions{},
+			expected: true,
+		},
+		{
+			name:     "time range overlaps",
+			opts:     SearchOptions{From: 150, To: 300},
+			expected: true,
+		},
+		{
+			name:     "logs are older than the range",
+			opts:     SearchOptions{From: 201},
+			expected: false,
+		},
+		{
+			name:     "logs are newer than the range",
+			opts:     SearchOptions{To: 99},
+			expected: false,
+		},
+		{
+			name:     "one of the severities is contained",
+			opts:     SearchOptions{Severities: []model.LogSeverity{model.LogSeverity_WARN, model.LogSeverity_ERROR}},
+			expected: true,
+		},
+		{
+			name:     "none of the severities is contained",
+			opts:     SearchOptions{Severities: []model.LogSeverity{model.LogSeverity_DEBUG}},
+			expected: false,
+		},
+		{
+			name:     "all query terms are contained",
+			opts:     SearchOptions{Query: "Connection refused"},
+			expected: true,
+		},
+		{
+			name:     "one of query terms is not contained",
+			opts:     SearchOptions{Query: "connection reset"},
+			expected: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, idx.mayMatch(tc.opts, tokenize(tc.opts.Query)))
+		})
+	}
+}
+
+func TestStoreSearchLogs(t *testing.T) {
+	ctx := context.Background()
+	fs := newFakeFileStore()
+	s := NewStore(fs, memorycache.NewCache(), zap.NewNop())
+
+	// The running stage log is only in the cache and has no index yet.
+	require.NoError(t, s.AppendLogs(ctx, "deployment-1", "stage-1", 0, []*model.LogBlock{
+		{Index: 1, Log: "start applying manifests", Severity: model.LogSeverity_INFO, CreatedAt: 100},
+		{Index: 2, Log: "dial tcp: connection refused", Severity: model.LogSeverity_ERROR, CreatedAt: 110},
+	}))
+	require.NoError(t, s.AppendLogs(ctx, "deployment-1", "stage-1", 0, []*model.LogBlock{
+		{Index: 3, Log: "retrying", Severity: model.LogSeverity_WARN, CreatedAt: 120, Fields: map[string]string{"attempt": "2"}},
+	}))
+	require.NoError(t, s.AppendLogsFromLastCheckpoint(ctx, "deployment-2", "stage-1", 1, []*model.LogBlock{
+		{Index: 1, Log: "Connection refused by the server", Severity: model.LogSeverity_ERROR, CreatedAt: 300},
+		{Index: 2, Log: "resolved endpoint", Severity: model.LogSeverity_DEBUG, CreatedAt: 300},
+	}, true))
+	// The old stage log was written before the index was introduced.
+	require.NoError(t, (&stageLogFileStore{filestore: fs}).Put(ctx, "deployment-3", "stage-1", 0, &logFragment{
+		Blocks: []*model.LogBlock{
+			{Index: 1, Log: "old connection refused", Severity: model.LogSeverity_ERROR, CreatedAt: 50},
+		},
+		Completed: true,
+	}))
+
+	deployment1 := &model.Deployment{Id: "deployment-1", Stages: []*model.PipelineStage{{Id: "stage-1"}}}
+	deployment2 := &model.Deployment{Id: "deployment-2", Stages: []*model.PipelineStage{{Id: "stage-1", RetriedCount: 1}}}
+	deployment3 := &model.Deployment{Id: "deployment-3", Stages: []*model.PipelineStage{{Id: "stage-1"}}}
+	deployment4 := &model.Deployment{Id: "deployment-4", Stages: []*model.PipelineStage{{Id: "stage-1"}}}
+
+	testcases := []struct {
+		name        string
+		deployments []*model.Deployment
+		opts        SearchOptions
+		expected    []string
+	}{
+		{
+			name:        "query across deployments",
+			deployments: []*model.Deployment{deployment1, deployment2},
+			opts:        SearchOptions{Query: "connection refused"},
+			expected:    []string{"dial tcp: connection refused", "Connection refused by the server"},
+		},
+		{
+			name:        "filtered by time range",
+			deployments: []*model.Deployment{deployment1, deployment2},
+			opts:        SearchOptions{From: 200, Query: "connection refused"},
+			expected:    []string{"Connection refused by the server"},
+		},
+		{
+			name:        "filtered by severity",
+			deployments: []*model.Deployment{deployment1, deployment2},
+			opts:        SearchOptions{Severities: []model.LogSeverity{model.LogSeverity_WARN, model.LogSeverity_DEBUG}},
+			expected:    []string{"retrying", "resolved endpoint"},
+		},
+		{
+			name:        "matched by fields",
+			deployments: []*model.Deployment{deployment1},
+			opts:        SearchOptions{Query: "attempt"},
+			expected:    []string{"retrying"},
+		},
+		{
+			name:        "limited",
+			deployments: []*model.Deployment{deployment1, deployment2},
+			opts:        SearchOptions{Limit: 2},
+			expected:    []string{"start applying manifests", "dial tcp: connection refused"},
+		},
+		{
+			name:        "partial terms do not match",
+			deployments: []*model.Deployment{deployment1, deployment2},
+			opts:        SearchOptions{Query: "refuse"},
+			expected:    []string{},
+		},
+		{
+			name:        "unindexed stage log is scanned",
+			deployments: []*model.Deployment{deployment3},
+			opts:        SearchOptions{Query: "connection refused"},
+			expected:    []string{"old connection refused"},
+		},
+		{
+			name:        "deployment without logs",
+			deployments: []*model.Deployment{deployment4},
+			opts:        SearchOptions{},
+			expected:    []string{},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			matches, err := s.SearchLogs(ctx, tc.deployments, tc.opts)
+			require.NoError(t, err)
+
+			logs := make([]string, 0, len(matches))
+			for _, m := range matches {
+				logs = append(logs, m.Block.Log)
+			}
+			assert.Equal(t, tc.expected, logs)
+		})
+	}
+}
+
+func TestStoreIndexIsUpdatedAtCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	fs := newFakeFileStore()
+	s := NewStore(fs, memorycache.NewCache(), zap.NewNop())
+	indexPath := stageLogIndexPath("deployment-1", "stage-1", 0)
+
+	// Appending to the cache must not touch the filestore.
+	require.NoError(t, s.AppendLogs(ctx, "deployment-1", "stage-1", 0, []*model.LogBlock{
+		{Index: 1, Log: "start applying", Severity: model.LogSeverity_INFO, CreatedAt: 100},
+	}))
+	_, err := fs.Get(ctx, indexPath)
+	require.ErrorIs(t, err, filestore.ErrNotFound)
+
+	require.NoError(t, s.AppendLogsFromLastCheckpoint(ctx, "deployment-1", "stage-1", 0, []*model.LogBlock{
+		{Index: 1, Log: "start applying", Severity: model.LogSeverity_INFO, CreatedAt: 100},
+	}, false))
+	idx, err := (&stageLogIndexStore{filestore: fs}).Get(ctx, "deployment-1", "stage-1", 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"applying", "start"}, idx.Terms)
+	assert.False(t, idx.Completed)
+
+	require.NoError(t, s.AppendLogsFromLastCheckpoint(ctx, "deployment-1", "stage-1", 0, []*model.LogBlock{
+		{Index: 2, Log: "done", Severity: model.LogSeverity_SUCCESS, CreatedAt: 200},
+	}, true))
+	idx, err = (&stageLogIndexStore{filestore: fs}).Get(ctx, "deployment-1", "stage-1", 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"applying", "done", "start"}, idx.Terms)
+	assert.Equal(t, int64(200), idx.LastCreatedAt)
+	assert.True(t, idx.Completed)
+}
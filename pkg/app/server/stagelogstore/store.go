@@ -17,6 +17,7 @@ package stagelogstore
 import (
 	"context"
 	"errors"
+	"slices"
 
 	"go.uber.org/zap"
 
@@ -43,11 +44,41 @@ type Store interface {
 	// AppendLogsFromLastCheckpoint appends the stage logs. The stage logs are deduplicated with index value.
 	// If completed is true, flush all the logs to that point and cannot append it after this.
 	AppendLogsFromLastCheckpoint(ctx context.Context, deploymentID, stageID string, retriedCount int32, newBlocks []*model.LogBlock, completed bool) error
+	// SearchLogs finds the log blocks of the stages of the given deployments which match the given options.
+	// The stage logs which have no complete index, such as the running ones or the ones written
+	// before the index was introduced, are always scanned.
+	SearchLogs(ctx context.Context, deployments []*model.Deployment, opts SearchOptions) ([]*LogMatch, error)
+}
+
+// SearchOptions specifies the conditions of the log blocks to search for.
+type SearchOptions struct {
+	// From and To are the unix time range of the log blocks.
+	// Zero means no bound.
+	From int64
+	To   int64
+	// Severities filters the log blocks by severity.
+	// Empty means all severities.
+	Severities []model.LogSeverity
+	// Query contains the terms that must all be contained in the log block.
+	// The matching is case-insensitive.
+	Query string
+	// Limit is the maximum number of log blocks to return.
+	// Zero means no limit.
+	Limit int
+}
+
+// LogMatch is a log block matched by a search.
+type LogMatch struct {
+	DeploymentID string
+	StageID      string
+	RetriedCount int32
+	Block        *model.LogBlock
 }
 
 type store struct {
 	backend *stageLogFileStore
 	cache   *stageLogCache
+	index   *stageLogIndexStore
 	logger  *zap.Logger
 }
 
@@ -59,6 +90,9 @@ func NewStore(fs filestore.Store, c cache.Cache, logger *zap.Logger) Store {
 		cache: &stageLogCache{
 			cache: c,
 		},
+		index: &stageLogIndexStore{
+			filestore: fs,
+		},
 		logger: logger.Named("stage-log-store"),
 	}
 }
@@ -133,9 +167,104 @@ func (s *store) AppendLogsFromLastCheckpoint(ctx context.Context, deploymentID,
 	if err := s.cache.Put(deploymentID, stageID, retriedCount, &lf); err != nil {
 		s.logger.Error("failed to put stage log to cache", zap.Error(err))
 	}
+	s.updateIndex(ctx, deploymentID, stageID, retriedCount, &lf)
 	return nil
 }
 
+func (s *store) SearchLogs(ctx context.Context, deployments []*model.Deployment, opts SearchOptions) ([]*LogMatch, error) {
+	queryTerms := tokenize(opts.Query)
+	matches := make([]*LogMatch, 0)
+
+	for _, d := range deployments {
+		for _, stage := range d.Stages {
+			for retriedCount := int32(0); retriedCount <= stage.RetriedCount; retriedCount++ {
+				if !s.mayMatch(ctx, d.Id, stage.Id, retriedCount, opts, queryTerms) {
+					continue
+				}
+				blocks, _, err := s.FetchLogs(ctx, d.Id, stage.Id, retriedCount, 0)
+				if errors.Is(err, ErrNotFound) {
+					continue
+				}
+				if err != nil {
+					return nil, err
+				}
+				for _, lb := range blocks {
+					if !matchLogBlock(lb, opts, queryTerms) {
+						continue
+					}
+					matches = append(matches, &LogMatch{
+						DeploymentID: d.Id,
+						StageID:      stage.Id,
+						RetriedCount: retriedCount,
+						Block:        lb,
+					})
+					if opts.Limit > 0 && len(matches) >= opts.Limit {
+						return matches, nil
+					}
+				}
+			}
+		}
+	}
+	return matches, nil
+}
+
+// mayMatch reports whether the given stage log may contain a log block matching the given options.
+// Only a complete index can tell that there is no match, so it fails open when the index is missing,
+// can not be read or was written before the stage log was completed.
+func (s *store) mayMatch(ctx context.Context, deploymentID, stageID string, retriedCount int32, opts SearchOptions, queryTerms []string) bool {
+	idx, err := s.index.Get(ctx, deploymentID, stageID, retriedCount)
+	if err != nil {
+		if !errors.Is(err, filestore.ErrNotFound) {
+			s.logger.Warn("failed to get stage log index from filestore, the stage log will be scanned", zap.Error(err))
+		}
+		return true
+	}
+	if !idx.Completed {
+		return true
+	}
+	return idx.mayMatch(opts, queryTerms)
+}
+
+// updateIndex rebuilds the index of the stage log from the given log fragment.
+// It is called only when the stage log is checkpointed to the filestore, instead of on every append,
+// so the index of a running stage log can be behind and is not used to skip it while searching.
+// Since the index is only used for searching, failures are logged without being returned.
+func (s *store) updateIndex(ctx context.Context, deploymentID, stageID string, retriedCount int32, lf *logFragment) {
+	idx := logIndex{
+		StageID:      stageID,
+		RetriedCount: retriedCount,
+		Completed:    lf.Completed,
+	}
+	idx.add(lf.Blocks)
+
+	if err := s.index.Put(ctx, deploymentID, &idx); err != nil {
+		s.logger.Error("failed to put stage log index to filestore", zap.Error(err))
+	}
+}
+
+// matchLogBlock reports whether the given log block matches the search options.
+func matchLogBlock(lb *model.LogBlock, opts SearchOptions, queryTerms []string) bool {
+	if opts.From > 0 && lb.CreatedAt < opts.From {
+		return false
+	}
+	if opts.To > 0 && lb.CreatedAt > opts.To {
+		return false
+	}
+	if len(opts.Severities) > 0 && !slices.Contains(opts.Severities, lb.Severity) {
+		return false
+	}
+	if len(queryTerms) == 0 {
+		return true
+	}
+	terms := blockTerms(lb)
+	for _, t := range queryTerms {
+		if !slices.Contains(terms, t) {
+			return false
+		}
+	}
+	return true
+}
+
 func mergeBlocks(prevs, news []*model.LogBlock) []*model.LogBlock {
 	m := make(map[int64]*model.LogBlock, len(prevs))
 	for _, lb := range prevs {
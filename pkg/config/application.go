@@ -20,11 +20,19 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/pipe-cd/pipecd/pkg/filematcher"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
-const allEventsSymbol = "*"
+const (
+	allEventsSymbol = "*"
+	// maxStageRetryBackoff is the upper limit of the duration to wait before retrying a failed stage.
+	maxStageRetryBackoff = 10 * time.Minute
+	// defaultStageRetryBackoff is used when no backoff was configured for the stage retries.
+	defaultStageRetryBackoff = 10 * time.Second
+)
 
 type GenericApplicationSpec struct {
 	// The application name.
@@ -50,6 +58,8 @@ type GenericApplicationSpec struct {
 	Timeout Duration `json:"timeout,omitempty" default:"6h"`
 	// List of encrypted secrets and targets that should be decoded before using.
 	Encryption *SecretEncryption `json:"encryption"`
+	// List of files which refer secrets stored in the secret providers configured in piped.
+	ExternalSecrets *ExternalSecrets `json:"externalSecrets,omitempty"`
 	// List of files that should be attached to application manifests before using.
 	Attachment *Attachment `json:"attachment"`
 	// Additional configuration used while sending notification to external services.
@@ -58,6 +68,11 @@ type GenericApplicationSpec struct {
 	EventWatcher []EventWatcherConfig `json:"eventWatcher"`
 	// Configuration for drift detection
 	DriftDetection *DriftDetection `json:"driftDetection"`
+	// List of windows during which the application must not be deployed.
+	DeploymentFreezeWindows []DeploymentFreezeWindow `json:"deploymentFreezeWindows"`
+	// Where the application manifests are loaded from.
+	// Empty means they are stored in the same Git directory as this configuration file.
+	Source *ApplicationSource `json:"source,omitempty"`
 
 	// This is a workaround not to raise unknown-field error when the application config file contains the plugins field.
 	Plugins any `json:"plugins"`
@@ -127,6 +142,9 @@ type OnChain struct {
 func (s *GenericApplicationSpec) Validate() error {
 	if s.Pipeline != nil {
 		for _, stage := range s.Pipeline.Stages {
+			if err := stage.Retries.Validate(); err != nil {
+				return err
+			}
 			if stage.AnalysisStageOptions != nil {
 				if err := stage.AnalysisStageOptions.Validate(); err != nil {
 					return err
@@ -157,6 +175,12 @@ func (s *GenericApplicationSpec) Validate() error {
 		}
 	}
 
+	if es := s.ExternalSecrets; es != nil {
+		if err := es.Validate(); err != nil {
+			return err
+		}
+	}
+
 	if am := s.Attachment; am != nil {
 		if err := am.Validate(); err != nil {
 			return err
@@ -177,9 +201,30 @@ func (s *GenericApplicationSpec) Validate() error {
 		}
 	}
 
+	for _, w := range s.DeploymentFreezeWindows {
+		if err := w.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if src := s.Source; src != nil {
+		if err := src.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// OCISource returns the OCI artifact source of the application
+// or nil if the manifests are loaded from Git.
+func (s GenericApplicationSpec) OCISource() *OCISource {
+	if s.Source == nil {
+		return nil
+	}
+	return s.Source.OCI
+}
+
 func (s GenericApplicationSpec) GetStage(index int32) (PipelineStage, bool) {
 	if s.Pipeline == nil {
 		return PipelineStage{}, false
@@ -226,6 +271,7 @@ type PipelineStage struct {
 	Name    model.Stage
 	Desc    string
 	Timeout Duration
+	Retries StageRetryOptions
 	With    json.RawMessage
 
 	CustomSyncOptions        *CustomSyncOptions
@@ -260,11 +306,12 @@ type PipelineStage struct {
 }
 
 type genericPipelineStage struct {
-	ID      string          `json:"id"`
-	Name    model.Stage     `json:"name"`
-	Desc    string          `json:"desc,omitempty"`
-	Timeout Duration        `json:"timeout"`
-	With    json.RawMessage `json:"with"`
+	ID      string            `json:"id"`
+	Name    model.Stage       `json:"name"`
+	Desc    string            `json:"desc,omitempty"`
+	Timeout Duration          `json:"timeout"`
+	Retries StageRetryOptions `json:"retries"`
+	With    json.RawMessage   `json:"with"`
 }
 
 func (s *PipelineStage) UnmarshalJSON(data []byte) error {
@@ -277,6 +324,7 @@ func (s *PipelineStage) UnmarshalJSON(data []byte) error {
 	s.Name = gs.Name
 	s.Desc = gs.Desc
 	s.Timeout = gs.Timeout
+	s.Retries = gs.Retries
 	s.With = gs.With
 
 	switch s.Name {
@@ -412,6 +460,49 @@ func (s *PipelineStage) UnmarshalJSON(data []byte) error {
 	return err
 }
 
+// StageRetryOptions contains all configurable values for retrying a failed stage in place.
+type StageRetryOptions struct {
+	// The maximum number of times the stage is retried automatically after it failed.
+	// Default is 0, which means the stage is not retried automatically.
+	Limit int `json:"limit"`
+	// The duration to wait before the first automatic retry.
+	// It is doubled for each following retry.
+	// Default is 10s.
+	Backoff Duration `json:"backoff"`
+	// How long to wait for a RETRY_STAGE command from users once the stage failed
+	// and all automatic retries were used up. The deployment fails right after the stage failure
+	// if this is not set.
+	ManualRetryTimeout Duration `json:"manualRetryTimeout"`
+}
+
+func (r *StageRetryOptions) Validate() error {
+	if r.Limit < 0 {
+		return fmt.Errorf("retries.limit must be greater than or equal to 0")
+	}
+	if r.Backoff < 0 {
+		return fmt.Errorf("retries.backoff must be greater than or equal to 0")
+	}
+	if r.ManualRetryTimeout < 0 {
+		return fmt.Errorf("retries.manualRetryTimeout must be greater than or equal to 0")
+	}
+	return nil
+}
+
+// BackoffFor returns the duration to wait before the retry following the given number of retries.
+func (r *StageRetryOptions) BackoffFor(retriedCount int32) time.Duration {
+	backoff := r.Backoff.Duration()
+	if backoff == 0 {
+		backoff = defaultStageRetryBackoff
+	}
+	for i := int32(0); i < retriedCount && backoff < maxStageRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxStageRetryBackoff {
+		backoff = maxStageRetryBackoff
+	}
+	return backoff
+}
+
 // SkipOptions contains all configurable values for skipping a stage.
 type SkipOptions struct {
 	CommitMessagePrefixes []string `json:"commitMessagePrefixes,omitempty"`
@@ -578,6 +669,56 @@ func (e *SecretEncryption) Validate() error {
 	return nil
 }
 
+// ExternalSecrets represents the files which refer secrets stored in
+// the secret providers such as HashiCorp Vault, e.g. {{ .vault "kv/data/app#password" }}.
+type ExternalSecrets struct {
+	// List of files to be rendered with the resolved secrets before using.
+	Targets []string `json:"targets"`
+}
+
+func (e *ExternalSecrets) Validate() error {
+	if len(e.Targets) == 0 {
+		return fmt.Errorf("externalSecrets.targets must not be empty")
+	}
+	for _, t := range e.Targets {
+		if t == "" {
+			return fmt.Errorf("externalSecrets.targets must not contain an empty path")
+		}
+	}
+	return nil
+}
+
+// ApplicationSource represents where the application manifests are loaded from.
+type ApplicationSource struct {
+	// OCI artifact which contains the application manifests.
+	OCI *OCISource `json:"oci,omitempty"`
+}
+
+func (s *ApplicationSource) Validate() error {
+	if s.OCI != nil {
+		if err := s.OCI.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OCISource represents an OCI artifact containing the application manifests.
+type OCISource struct {
+	// The URL of the artifact, e.g. oci://registry.example.com/manifests/app:v1.0.0
+	URL string `json:"url"`
+}
+
+func (s *OCISource) Validate() error {
+	if s.URL == "" {
+		return fmt.Errorf("source.oci.url must not be empty")
+	}
+	if !strings.HasPrefix(s.URL, "oci://") {
+		return fmt.Errorf("source.oci.url must start with oci://")
+	}
+	return nil
+}
+
 type Attachment struct {
 	// Map of name to refer with the file path which contain embedding source data.
 	Sources map[string]string `json:"sources"`
@@ -710,8 +851,7 @@ type DeploymentChain struct {
 	// the first applications in the chain trigger a whole new deployment chain or not.
 	// If this field is not set, always trigger a whole new deployment chain when the current
 	// application is triggered.
-	// TODO: Add conditions to deployment chain configuration.
-	// Conditions *DeploymentChainTriggerCondition `json:"conditions,omitempty"`
+	Conditions *DeploymentChainTriggerCondition `json:"conditions,omitempty"`
 }
 
 func (dc *DeploymentChain) Validate() error {
@@ -725,11 +865,11 @@ func (dc *DeploymentChain) Validate() error {
 		}
 	}
 
-	// if cc := dc.Conditions; cc != nil {
-	// 	if err := cc.Validate(); err != nil {
-	// 		return err
-	// 	}
-	// }
+	if cc := dc.Conditions; cc != nil {
+		if err := cc.Validate(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -740,6 +880,18 @@ type ChainApplicationMatcher struct {
 	Name   string            `json:"name"`
 	Kind   string            `json:"kind"`
 	Labels map[string]string `json:"labels"`
+	// Whether the applications of the later matchers should still be deployed
+	// when any deployment of the applications found by this matcher failed.
+	// Default is false.
+	ContinueOnFailure bool `json:"continueOnFailure"`
+	// Whether the running deployments of the applications found by this matcher
+	// should be cancelled with rollback when the chain failed or was cancelled.
+	// Default is false.
+	CancelOnChainFailure bool `json:"cancelOnChainFailure"`
+	// Whether the successfully finished deployments of the applications found by this matcher
+	// should be rolled back to their previously running commits when the chain failed or was cancelled.
+	// Default is false.
+	RollbackOnChainFailure bool `json:"rollbackOnChainFailure"`
 }
 
 func (m *ChainApplicationMatcher) Validate() error {
@@ -751,18 +903,55 @@ func (m *ChainApplicationMatcher) Validate() error {
 	return nil
 }
 
+// DeploymentChainTriggerCondition provides the conditions a deployment has to satisfy to trigger
+// a whole new deployment chain. All of the specified conditions must be satisfied.
 type DeploymentChainTriggerCondition struct {
+	// The prefix the message of the deploying commit must start with.
 	CommitPrefix string `json:"commitPrefix"`
+	// List of file path patterns. At least one of the files changed since
+	// the previously triggered commit must match one of them.
+	Paths []string `json:"paths"`
+	// The labels the triggered application must have.
+	Labels map[string]string `json:"labels"`
 }
 
 func (c *DeploymentChainTriggerCondition) Validate() error {
-	hasCond := c.CommitPrefix != ""
+	hasCond := c.CommitPrefix != "" || len(c.Paths) != 0 || len(c.Labels) != 0
 	if !hasCond {
-		return fmt.Errorf("missing commitPrefix configuration as deployment chain trigger condition")
+		return fmt.Errorf("at least one of \"commitPrefix\", \"paths\" or \"labels\" must be set as deployment chain trigger condition")
+	}
+	if len(c.Paths) != 0 {
+		if _, err := filematcher.NewPatternMatcher(c.Paths); err != nil {
+			return fmt.Errorf("invalid paths of deployment chain trigger condition: %w", err)
+		}
 	}
 	return nil
 }
 
+// Match reports whether a deployment of the given commit and application satisfies the conditions.
+// Nil changedFiles means the changes are unknown, e.g. there is no previously triggered commit,
+// so the paths condition is considered as satisfied.
+func (c *DeploymentChainTriggerCondition) Match(commitMessage string, appLabels map[string]string, changedFiles []string) (bool, error) {
+	if c.CommitPrefix != "" && !strings.HasPrefix(commitMessage, c.CommitPrefix) {
+		return false, nil
+	}
+	for k, v := range c.Labels {
+		if appLabels[k] != v {
+			return false, nil
+		}
+	}
+	if len(c.Paths) != 0 && changedFiles != nil {
+		matcher, err := filematcher.NewPatternMatcher(c.Paths)
+		if err != nil {
+			return false, err
+		}
+		if !matcher.MatchesAny(changedFiles) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 type DriftDetection struct {
 	// IgnoreFields are a list of 'apiVersion:kind:namespace:name#fieldPath'
 	IgnoreFields []string `json:"ignoreFields"`
@@ -778,6 +967,61 @@ func (dd *DriftDetection) Validate() error {
 	return nil
 }
 
+// DeploymentFreezeWindow represents a period of time while the application must not be deployed.
+// It must be either one-off (startAt and endAt) or recurring (cron and duration).
+type DeploymentFreezeWindow struct {
+	// The unique name of the window.
+	Name string `json:"name"`
+	// The reason of the freeze. It is shown as the reason why the deployment was held.
+	Description string `json:"description"`
+	// The IANA time zone name used to interpret the cron expression, e.g. "Asia/Tokyo".
+	// Default is UTC.
+	TimeZone string `json:"timeZone"`
+	// The time when the one-off window starts in RFC3339 format.
+	StartAt *time.Time `json:"startAt,omitempty"`
+	// The time when the one-off window ends in RFC3339 format.
+	EndAt *time.Time `json:"endAt,omitempty"`
+	// The cron expression describing when the recurring window starts, e.g. "0 18 * * FRI".
+	Cron string `json:"cron"`
+	// How long the recurring window lasts, e.g. "62h".
+	Duration Duration `json:"duration"`
+}
+
+func (w DeploymentFreezeWindow) Validate() error {
+	if w.Name == "" {
+		return fmt.Errorf("name of deployment freeze window must be set")
+	}
+	return w.ToModel().ValidateSchedule()
+}
+
+// ToModel converts the window into the model used to determine whether a deployment is frozen.
+func (w DeploymentFreezeWindow) ToModel() *model.DeploymentFreezeWindow {
+	m := &model.DeploymentFreezeWindow{
+		Name:        w.Name,
+		Description: w.Description,
+		TimeZone:    w.TimeZone,
+		Cron:        w.Cron,
+		Duration:    int64(w.Duration.Duration().Seconds()),
+	}
+	if w.StartAt != nil {
+		m.StartAt = w.StartAt.Unix()
+	}
+	if w.EndAt != nil {
+		m.EndAt = w.EndAt.Unix()
+	}
+	return m
+}
+
+// FindActiveDeploymentFreezeWindow returns the window that freezes the application at the given time.
+// Nil is returned when the application can be deployed.
+func (s GenericApplicationSpec) FindActiveDeploymentFreezeWindow(t time.Time) (*model.DeploymentFreezeWindow, error) {
+	windows := make([]*model.DeploymentFreezeWindow, 0, len(s.DeploymentFreezeWindows))
+	for _, w := range s.DeploymentFreezeWindows {
+		windows = append(windows, w.ToModel())
+	}
+	return model.FindActiveDeploymentFreezeWindow(windows, nil, t)
+}
+
 func LoadApplication(repoPath, configRelPath string, appKind model.ApplicationKind) (*GenericApplicationSpec, error) {
 	absPath := filepath.Join(repoPath, configRelPath)
 
@@ -16,6 +16,8 @@ package config
 
 import (
 	"fmt"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
 const (
@@ -42,9 +44,44 @@ func (s *ECSApplicationSpec) Validate() error {
 		return err
 	}
 
+	if s.Input.IsCodeDeploy() && s.Pipeline != nil {
+		// The listeners are controlled by CodeDeploy, so a part of traffic is shifted by a canary
+		// deployment configuration of CodeDeploy, which shifts the rest of traffic after its interval.
+		partial := 0
+		for _, stage := range s.Pipeline.Stages {
+			if stage.ECSTrafficRoutingStageOptions == nil {
+				continue
+			}
+			if _, canary := stage.ECSTrafficRoutingStageOptions.Percentage(); canary != 0 && canary != 100 {
+				partial++
+			}
+		}
+		if partial > 1 {
+			return fmt.Errorf("only one %s stage can route a part of traffic to canary when codeDeploy is set", model.StageECSTrafficRouting)
+		}
+	}
+
 	return nil
 }
 
+// CodeDeployCanaryPercentage returns the percentage of traffic routed to canary
+// by the ECS_TRAFFIC_ROUTING stage which routes only a part of traffic.
+// Zero means no stage routes a part of traffic.
+func (s *ECSApplicationSpec) CodeDeployCanaryPercentage() int {
+	if s.Pipeline == nil {
+		return 0
+	}
+	for _, stage := range s.Pipeline.Stages {
+		if stage.ECSTrafficRoutingStageOptions == nil {
+			continue
+		}
+		if _, canary := stage.ECSTrafficRoutingStageOptions.Percentage(); canary != 0 && canary != 100 {
+			return canary
+		}
+	}
+	return 0
+}
+
 type ECSDeploymentInput struct {
 	// The Amazon Resource Name (ARN) that identifies the cluster.
 	ClusterArn string `json:"clusterArn,omitempty"`
@@ -75,6 +112,9 @@ type ECSDeploymentInput struct {
 	//  - SERVICE_DISCOVERY -  The service is accessed via ECS Service Discovery.
 	// Default is ELB.
 	AccessType string `json:"accessType,omitempty" default:"ELB"`
+	// Configuration for deploying the service via AWS CodeDeploy blue/green deployments.
+	// This must be set when the service uses the CODE_DEPLOY deployment controller.
+	CodeDeploy *ECSCodeDeployConfig `json:"codeDeploy,omitempty"`
 }
 
 func (in *ECSDeploymentInput) IsStandaloneTask() bool {
@@ -85,12 +125,34 @@ func (in *ECSDeploymentInput) IsAccessedViaELB() bool {
 	return in.AccessType == AccessTypeELB
 }
 
+// IsCodeDeploy returns true if the service is deployed via AWS CodeDeploy.
+func (in *ECSDeploymentInput) IsCodeDeploy() bool {
+	return in.CodeDeploy != nil
+}
+
 type ECSVpcConfiguration struct {
 	Subnets        []string `json:"subnets,omitempty"`
 	AssignPublicIP string   `json:"assignPublicIp,omitempty"`
 	SecurityGroups []string `json:"securityGroups,omitempty"`
 }
 
+// ECSCodeDeployConfig contains the CodeDeploy resources used to run blue/green deployments.
+type ECSCodeDeployConfig struct {
+	// The name of the CodeDeploy application.
+	ApplicationName string `json:"applicationName"`
+	// The name of the CodeDeploy deployment group of the ECS service.
+	DeploymentGroupName string `json:"deploymentGroupName"`
+	// The name of the deployment configuration used when the whole traffic is shifted at once,
+	// e.g. on ECS_SYNC stage.
+	// Default is CodeDeployDefault.ECSAllAtOnce.
+	DeploymentConfigName string `json:"deploymentConfigName,omitempty" default:"CodeDeployDefault.ECSAllAtOnce"`
+	// The number of minutes CodeDeploy keeps a part of traffic on canary
+	// before shifting the rest, when an ECS_TRAFFIC_ROUTING stage routes a part of traffic to canary.
+	// The following stages, e.g. ANALYSIS and WAIT_APPROVAL, should complete within this time.
+	// Default is 60.
+	CanaryInterval int32 `json:"canaryInterval,omitempty" default:"60"`
+}
+
 type ECSTargetGroups struct {
 	Primary *ECSTargetGroup `json:"primary,omitempty"`
 	Canary  *ECSTargetGroup `json:"canary,omitempty"`
@@ -158,5 +220,23 @@ func (in *ECSDeploymentInput) validate() error {
 	default:
 		return fmt.Errorf("invalid accessType: %s", in.AccessType)
 	}
+	if in.CodeDeploy != nil {
+		if in.CodeDeploy.ApplicationName == "" {
+			return fmt.Errorf("codeDeploy.applicationName is required")
+		}
+		if in.CodeDeploy.DeploymentGroupName == "" {
+			return fmt.Errorf("codeDeploy.deploymentGroupName is required")
+		}
+		// CodeDeploy shifts traffic between the target groups of the deployment group.
+		if in.AccessType != AccessTypeELB {
+			return fmt.Errorf("accessType must be %s when codeDeploy is set", AccessTypeELB)
+		}
+		if in.TargetGroups.Primary == nil {
+			return fmt.Errorf("targetGroups.primary is required when codeDeploy is set")
+		}
+		if in.CodeDeploy.CanaryInterval <= 0 {
+			return fmt.Errorf("codeDeploy.canaryInterval must be greater than 0")
+		}
+	}
 	return nil
 }
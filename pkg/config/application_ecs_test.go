@@ -21,6 +21,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
 func TestECSApplicationConfig(t *testing.T) {
@@ -150,6 +152,68 @@ func TestECSApplicationConfig(t *testing.T) {
 			},
 			expectedError: fmt.Errorf("invalid accessType: XXX"),
 		},
+		{
+			fileName:           "testdata/application/ecs-app-codedeploy.yaml",
+			expectedKind:       KindECSApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &ECSApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnCommit: OnCommit{
+							Disabled: false,
+						},
+						OnCommand: OnCommand{
+							Disabled: false,
+						},
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+					Planner: DeploymentPlanner{
+						AutoRollback: newBoolPointer(true),
+					},
+				},
+				Input: ECSDeploymentInput{
+					ServiceDefinitionFile: "/path/to/servicedef.yaml",
+					TaskDefinitionFile:    "/path/to/taskdef.yaml",
+					TargetGroups: ECSTargetGroups{
+						Primary: &ECSTargetGroup{
+							TargetGroupArn: "arn:aws:elasticloadbalancing:xyz",
+							ContainerName:  "web",
+							ContainerPort:  80,
+						},
+					},
+					LaunchType:        "FARGATE",
+					AutoRollback:      newBoolPointer(true),
+					RunStandaloneTask: newBoolPointer(true),
+					AccessType:        "ELB",
+					CodeDeploy: &ECSCodeDeployConfig{
+						ApplicationName:      "app",
+						DeploymentGroupName:  "group",
+						DeploymentConfigName: "CodeDeployDefault.ECSAllAtOnce",
+						CanaryInterval:       30,
+					},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/ecs-app-codedeploy-missing-group.yaml",
+			expectedKind:       KindECSApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedError:      fmt.Errorf("codeDeploy.deploymentGroupName is required"),
+		},
+		{
+			fileName:           "testdata/application/ecs-app-codedeploy-multiple-partial-traffic.yaml",
+			expectedKind:       KindECSApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedError:      fmt.Errorf("only one ECS_TRAFFIC_ROUTING stage can route a part of traffic to canary when codeDeploy is set"),
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.fileName, func(t *testing.T) {
@@ -163,3 +227,46 @@ func TestECSApplicationConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestECSApplicationSpec_CodeDeployCanaryPercentage(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		pipeline *DeploymentPipeline
+		expected int
+	}{
+		{
+			name:     "no pipeline",
+			expected: 0,
+		},
+		{
+			name: "all traffic is routed at once",
+			pipeline: &DeploymentPipeline{
+				Stages: []PipelineStage{
+					{Name: model.StageECSCanaryRollout, ECSCanaryRolloutStageOptions: &ECSCanaryRolloutStageOptions{}},
+					{Name: model.StageECSTrafficRouting, ECSTrafficRoutingStageOptions: &ECSTrafficRoutingStageOptions{Canary: Percentage{Number: 100}}},
+				},
+			},
+			expected: 0,
+		},
+		{
+			name: "a part of traffic is routed",
+			pipeline: &DeploymentPipeline{
+				Stages: []PipelineStage{
+					{Name: model.StageECSCanaryRollout, ECSCanaryRolloutStageOptions: &ECSCanaryRolloutStageOptions{}},
+					{Name: model.StageECSTrafficRouting, ECSTrafficRoutingStageOptions: &ECSTrafficRoutingStageOptions{Primary: Percentage{Number: 80}}},
+					{Name: model.StageECSTrafficRouting, ECSTrafficRoutingStageOptions: &ECSTrafficRoutingStageOptions{Canary: Percentage{Number: 100}}},
+				},
+			},
+			expected: 20,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			s := &ECSApplicationSpec{GenericApplicationSpec: GenericApplicationSpec{Pipeline: tc.pipeline}}
+			assert.Equal(t, tc.expected, s.CodeDeployCanaryPercentage())
+		})
+	}
+}
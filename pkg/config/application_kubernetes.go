@@ -233,17 +233,34 @@ type K8sResourcePatchOpName string
 
 const (
 	K8sResourcePatchOpYAMLReplace = "yaml-replace"
+	// The RFC 6902 JSON Patch operations.
+	K8sResourcePatchOpJSONAdd     = "json-add"
+	K8sResourcePatchOpJSONRemove  = "json-remove"
+	K8sResourcePatchOpJSONReplace = "json-replace"
+	K8sResourcePatchOpJSONMove    = "json-move"
+	K8sResourcePatchOpJSONCopy    = "json-copy"
+	K8sResourcePatchOpJSONTest    = "json-test"
+	// The strategic merge patch for the built-in resources.
+	// It falls back to the RFC 7386 JSON Merge Patch for the other documents.
+	K8sResourcePatchOpStrategicMerge = "strategic-merge"
 )
 
 type K8sResourcePatchOp struct {
 	// The operation type.
-	// This must be one of "yaml-replace", "yaml-add", "yaml-remove", "json-replace" or "text-regex".
+	// This must be one of "yaml-replace", "json-add", "json-remove", "json-replace",
+	// "json-move", "json-copy", "json-test" or "strategic-merge".
 	// Default is "yaml-replace".
 	Op K8sResourcePatchOpName `json:"op" default:"yaml-replace"`
 	// The path string pointing to the manipulated field.
-	// E.g. "$.spec.foos[0].bar"
+	// E.g. "$.spec.foos[0].bar" for yaml-replace
+	// and a JSON Pointer like "/spec/foos/0/bar" for the JSON Patch operations.
+	// This is not used by strategic-merge.
 	Path string `json:"path"`
+	// The JSON Pointer to the source field of json-move and json-copy.
+	From string `json:"from"`
 	// The value string whose content will be used as new value for the field.
+	// It is parsed as a YAML value for the JSON Patch operations
+	// and as a YAML document of the patch for strategic-merge.
 	Value string `json:"value"`
 }
 
@@ -14,6 +14,15 @@
 
 package config
 
+import "fmt"
+
+const (
+	// TerraformBinaryTerraform uses HashiCorp Terraform.
+	TerraformBinaryTerraform = "terraform"
+	// TerraformBinaryOpenTofu uses OpenTofu.
+	TerraformBinaryOpenTofu = "tofu"
+)
+
 // TerraformApplicationSpec represents an application configuration for Terraform application.
 type TerraformApplicationSpec struct {
 	GenericApplicationSpec
@@ -28,6 +37,9 @@ func (s *TerraformApplicationSpec) Validate() error {
 	if err := s.GenericApplicationSpec.Validate(); err != nil {
 		return err
 	}
+	if err := s.Input.Validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -35,7 +47,12 @@ type TerraformDeploymentInput struct {
 	// The terraform workspace name.
 	// Empty means "default" workpsace.
 	Workspace string `json:"workspace,omitempty"`
+	// The binary used to execute the terraform commands.
+	// Available values are "terraform" and "tofu" to use OpenTofu.
+	// Empty means "terraform".
+	Binary string `json:"binary,omitempty"`
 	// The version of terraform should be used.
+	// When the binary is "tofu", this is the version of OpenTofu.
 	// Empty means the pre-installed version will be used.
 	TerraformVersion string `json:"terraformVersion,omitempty"`
 	// List of variables that will be set directly on terraform commands with "-var" flag.
@@ -57,6 +74,16 @@ type TerraformDeploymentInput struct {
 	CommandEnvs TerraformCommandEnvs `json:"commandEnvs"`
 }
 
+// Validate returns an error if any wrong configuration value was found.
+func (in *TerraformDeploymentInput) Validate() error {
+	switch in.Binary {
+	case "", TerraformBinaryTerraform, TerraformBinaryOpenTofu:
+		return nil
+	default:
+		return fmt.Errorf("unsupported terraform binary %q, it must be one of %q and %q", in.Binary, TerraformBinaryTerraform, TerraformBinaryOpenTofu)
+	}
+}
+
 // TerraformSyncStageOptions contains all configurable values for a TERRAFORM_SYNC stage.
 type TerraformSyncStageOptions struct {
 }
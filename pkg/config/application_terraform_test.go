@@ -248,6 +248,39 @@ func TestTerraformApplicationtConfig(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			fileName:           "testdata/application/terraform-app-opentofu.yaml",
+			expectedKind:       KindTerraformApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &TerraformApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnCommit: OnCommit{
+							Disabled: false,
+						},
+						OnCommand: OnCommand{
+							Disabled: false,
+						},
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+					Planner: DeploymentPlanner{
+						AutoRollback: newBoolPointer(true),
+					},
+				},
+				Input: TerraformDeploymentInput{
+					Binary:           TerraformBinaryOpenTofu,
+					TerraformVersion: "1.6.2",
+				},
+			},
+			expectedError: nil,
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.fileName, func(t *testing.T) {
@@ -261,3 +294,39 @@ func TestTerraformApplicationtConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestTerraformDeploymentInputValidate(t *testing.T) {
+	testcases := []struct {
+		name    string
+		binary  string
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			binary:  "",
+			wantErr: false,
+		},
+		{
+			name:    "terraform",
+			binary:  TerraformBinaryTerraform,
+			wantErr: false,
+		},
+		{
+			name:    "opentofu",
+			binary:  TerraformBinaryOpenTofu,
+			wantErr: false,
+		},
+		{
+			name:    "unsupported",
+			binary:  "terragrunt",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			in := TerraformDeploymentInput{Binary: tc.binary}
+			err := in.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
@@ -353,6 +353,38 @@ func TestValidateAttachment(t *testing.T) {
 	}
 }
 
+func TestValidateExternalSecrets(t *testing.T) {
+	testcases := []struct {
+		name    string
+		targets []string
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			targets: []string{"deployment.yaml"},
+			wantErr: false,
+		},
+		{
+			name:    "no target files specified",
+			wantErr: true,
+		},
+		{
+			name:    "empty target file",
+			targets: []string{""},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := &ExternalSecrets{
+				Targets: tc.targets,
+			}
+			err := e.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
 func TestValidateMentions(t *testing.T) {
 	testcases := []struct {
 		name    string
@@ -397,6 +429,90 @@ func TestValidateMentions(t *testing.T) {
 	}
 }
 
+func TestStageRetryOptionsValidate(t *testing.T) {
+	testcases := []struct {
+		name    string
+		opts    StageRetryOptions
+		wantErr bool
+	}{
+		{
+			name:    "valid with empty options",
+			opts:    StageRetryOptions{},
+			wantErr: false,
+		},
+		{
+			name: "valid",
+			opts: StageRetryOptions{
+				Limit:              3,
+				Backoff:            Duration(30 * time.Second),
+				ManualRetryTimeout: Duration(time.Hour),
+			},
+			wantErr: false,
+		},
+		{
+			name:    "invalid because of negative limit",
+			opts:    StageRetryOptions{Limit: -1},
+			wantErr: true,
+		},
+		{
+			name:    "invalid because of negative backoff",
+			opts:    StageRetryOptions{Backoff: Duration(-time.Second)},
+			wantErr: true,
+		},
+		{
+			name:    "invalid because of negative manual retry timeout",
+			opts:    StageRetryOptions{ManualRetryTimeout: Duration(-time.Second)},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestStageRetryOptionsBackoffFor(t *testing.T) {
+	testcases := []struct {
+		name         string
+		opts         StageRetryOptions
+		retriedCount int32
+		expected     time.Duration
+	}{
+		{
+			name:         "default backoff for the first retry",
+			opts:         StageRetryOptions{},
+			retriedCount: 0,
+			expected:     10 * time.Second,
+		},
+		{
+			name:         "configured backoff for the first retry",
+			opts:         StageRetryOptions{Backoff: Duration(time.Second)},
+			retriedCount: 0,
+			expected:     time.Second,
+		},
+		{
+			name:         "doubled for each following retry",
+			opts:         StageRetryOptions{Backoff: Duration(time.Second)},
+			retriedCount: 3,
+			expected:     8 * time.Second,
+		},
+		{
+			name:         "capped by the max backoff",
+			opts:         StageRetryOptions{Backoff: Duration(time.Minute)},
+			retriedCount: 10,
+			expected:     10 * time.Minute,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.opts.BackoffFor(tc.retriedCount)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
 func TestGenericTriggerConfiguration(t *testing.T) {
 	testcases := []struct {
 		fileName           string
@@ -457,6 +573,134 @@ func TestGenericTriggerConfiguration(t *testing.T) {
 	}
 }
 
+func TestDeploymentFreezeWindowsConfiguration(t *testing.T) {
+	cfg, err := LoadFromYAML("testdata/application/generic-deployment-freeze.yaml")
+	require.NoError(t, err)
+
+	spec, ok := cfg.GetGenericApplication()
+	require.True(t, ok)
+	require.Len(t, spec.DeploymentFreezeWindows, 2)
+
+	jst := time.FixedZone("JST", 9*60*60)
+	testcases := []struct {
+		name     string
+		now      time.Time
+		expected string
+	}{
+		{
+			name: "weekday",
+			now:  time.Date(2024, 3, 6, 12, 0, 0, 0, jst),
+		},
+		{
+			name:     "weekend",
+			now:      time.Date(2024, 3, 9, 12, 0, 0, 0, jst),
+			expected: "weekend",
+		},
+		{
+			name:     "year-end holidays",
+			now:      time.Date(2024, 12, 31, 12, 0, 0, 0, jst),
+			expected: "year-end",
+		},
+		{
+			name: "after year-end holidays",
+			now:  time.Date(2025, 1, 6, 12, 0, 0, 0, jst),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			w, err := spec.FindActiveDeploymentFreezeWindow(tc.now)
+			require.NoError(t, err)
+			if tc.expected == "" {
+				assert.Nil(t, w)
+				return
+			}
+			require.NotNil(t, w)
+			assert.Equal(t, tc.expected, w.Name)
+		})
+	}
+}
+
+func TestOCISourceConfiguration(t *testing.T) {
+	cfg, err := LoadFromYAML("testdata/application/generic-oci-source.yaml")
+	require.NoError(t, err)
+
+	spec, ok := cfg.GetGenericApplication()
+	require.True(t, ok)
+	require.NotNil(t, spec.OCISource())
+	assert.Equal(t, "oci://localhost:5000/manifests/helloworld:latest", spec.OCISource().URL)
+}
+
+func TestOCISourceValidate(t *testing.T) {
+	testcases := []struct {
+		name    string
+		url     string
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			url:     "oci://registry.example.com/manifests/app:v1.0.0",
+			wantErr: false,
+		},
+		{
+			name:    "empty url",
+			url:     "",
+			wantErr: true,
+		},
+		{
+			name:    "missing scheme",
+			url:     "registry.example.com/manifests/app:v1.0.0",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &OCISource{URL: tc.url}
+			err := s.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestDeploymentFreezeWindowValidate(t *testing.T) {
+	start := time.Now()
+	end := start.Add(time.Hour)
+	testcases := []struct {
+		name    string
+		window  DeploymentFreezeWindow
+		wantErr bool
+	}{
+		{
+			name:   "valid one-off window",
+			window: DeploymentFreezeWindow{Name: "w", StartAt: &start, EndAt: &end},
+		},
+		{
+			name:    "end before start",
+			window:  DeploymentFreezeWindow{Name: "w", StartAt: &end, EndAt: &start},
+			wantErr: true,
+		},
+		{
+			name:   "valid recurring window",
+			window: DeploymentFreezeWindow{Name: "w", Cron: "0 0 * * SAT", Duration: Duration(48 * time.Hour)},
+		},
+		{
+			name:    "missing name",
+			window:  DeploymentFreezeWindow{Cron: "0 0 * * SAT", Duration: Duration(48 * time.Hour)},
+			wantErr: true,
+		},
+		{
+			name:    "missing schedule",
+			window:  DeploymentFreezeWindow{Name: "w"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.window.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
 func TestTrueByDefaultBoolConfiguration(t *testing.T) {
 	testcases := []struct {
 		fileName           string
@@ -633,6 +877,60 @@ func TestGenericPostSyncConfiguration(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			fileName:           "testdata/application/generic-postsync-conditions.yaml",
+			expectedKind:       KindKubernetesApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &KubernetesApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+					Planner: DeploymentPlanner{
+						AutoRollback: newBoolPointer(true),
+					},
+					PostSync: &PostSync{
+						DeploymentChain: &DeploymentChain{
+							ApplicationMatchers: []ChainApplicationMatcher{
+								{
+									Name:              "app-1",
+									ContinueOnFailure: true,
+								},
+								{
+									Kind:                   "ECSApp",
+									CancelOnChainFailure:   true,
+									RollbackOnChainFailure: true,
+								},
+							},
+							Conditions: &DeploymentChainTriggerCondition{
+								CommitPrefix: "release:",
+								Paths:        []string{"shared/**"},
+								Labels: map[string]string{
+									"env": "prod",
+								},
+							},
+						},
+					},
+				},
+				Input: KubernetesDeploymentInput{
+					AutoRollback: newBoolPointer(true),
+				},
+				VariantLabel: KubernetesVariantLabel{
+					Key:           "pipecd.dev/variant",
+					PrimaryValue:  "primary",
+					BaselineValue: "baseline",
+					CanaryValue:   "canary",
+				},
+			},
+			expectedError: nil,
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.fileName, func(t *testing.T) {
@@ -647,6 +945,80 @@ func TestGenericPostSyncConfiguration(t *testing.T) {
 	}
 }
 
+func TestDeploymentChainTriggerCondition_Match(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name          string
+		condition     DeploymentChainTriggerCondition
+		commitMessage string
+		appLabels     map[string]string
+		changedFiles  []string
+		expected      bool
+	}{
+		{
+			name:          "commit prefix matched",
+			condition:     DeploymentChainTriggerCondition{CommitPrefix: "release:"},
+			commitMessage: "release: v1.0.0",
+			expected:      true,
+		},
+		{
+			name:          "commit prefix not matched",
+			condition:     DeploymentChainTriggerCondition{CommitPrefix: "release:"},
+			commitMessage: "fix: typo",
+			expected:      false,
+		},
+		{
+			name:      "labels matched",
+			condition: DeploymentChainTriggerCondition{Labels: map[string]string{"env": "prod"}},
+			appLabels: map[string]string{"env": "prod", "team": "a"},
+			expected:  true,
+		},
+		{
+			name:      "labels not matched",
+			condition: DeploymentChainTriggerCondition{Labels: map[string]string{"env": "prod"}},
+			appLabels: map[string]string{"env": "dev"},
+			expected:  false,
+		},
+		{
+			name:         "paths matched",
+			condition:    DeploymentChainTriggerCondition{Paths: []string{"shared/**"}},
+			changedFiles: []string{"app/deployment.yaml", "shared/config.yaml"},
+			expected:     true,
+		},
+		{
+			name:         "paths not matched",
+			condition:    DeploymentChainTriggerCondition{Paths: []string{"shared/**"}},
+			changedFiles: []string{"app/deployment.yaml"},
+			expected:     false,
+		},
+		{
+			name:      "unknown changed files are considered as matched",
+			condition: DeploymentChainTriggerCondition{Paths: []string{"shared/**"}},
+			expected:  true,
+		},
+		{
+			name: "all conditions must be satisfied",
+			condition: DeploymentChainTriggerCondition{
+				CommitPrefix: "release:",
+				Paths:        []string{"shared/**"},
+			},
+			commitMessage: "release: v1.0.0",
+			changedFiles:  []string{"app/deployment.yaml"},
+			expected:      false,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tc.condition.Match(tc.commitMessage, tc.appLabels, tc.changedFiles)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
 func TestGenericAnalysisConfiguration(t *testing.T) {
 	testcases := []struct {
 		fileName           string
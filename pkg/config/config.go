@@ -67,6 +67,9 @@ const (
 	KindAnalysisTemplate Kind = "AnalysisTemplate"
 	// KindEventWatcher represents configuration for Event Watcher.
 	KindEventWatcher Kind = "EventWatcher"
+	// KindPolicy represents policy rules enforced before applying any changes.
+	// These configuration files should be placed in the directory configured in the piped configuration.
+	KindPolicy Kind = "Policy"
 )
 
 var (
@@ -90,6 +93,7 @@ type Config struct {
 	ControlPlaneSpec     *ControlPlaneSpec
 	AnalysisTemplateSpec *AnalysisTemplateSpec
 	EventWatcherSpec     *EventWatcherSpec
+	PolicySpec           *PolicySpec
 }
 
 type genericConfig struct {
@@ -139,6 +143,10 @@ func (c *Config) init(kind Kind, apiVersion string) error {
 		c.EventWatcherSpec = &EventWatcherSpec{}
 		c.spec = c.EventWatcherSpec
 
+	case KindPolicy:
+		c.PolicySpec = &PolicySpec{}
+		c.spec = c.PolicySpec
+
 	default:
 		return fmt.Errorf("unsupported kind: %s", c.Kind)
 	}
@@ -16,7 +16,11 @@ package config
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/url"
+	"path"
+	"text/template"
 	"time"
 
 	"github.com/golang/protobuf/jsonpb"
@@ -39,14 +43,139 @@ type ControlPlaneSpec struct {
 	Cache ControlPlaneCache `json:"cache"`
 	// The configuration of insight collector.
 	InsightCollector ControlPlaneInsightCollector `json:"insightCollector"`
+	// The configuration of stage log archiver.
+	StageLogArchiver ControlPlaneStageLogArchiver `json:"stageLogArchiver"`
+	// The configuration of the secrets encrypted by the control plane.
+	SecretEncryption ControlPlaneSecretEncryption `json:"secretEncryption"`
 	// List of debugging/quickstart projects defined in Control Plane configuration.
 	// Please note that do not use this to configure the projects running in the production.
 	Projects []ControlPlaneProject `json:"projects"`
 	// List of shared SSO configurations that can be used by any projects.
 	SharedSSOConfigs []SharedSSOConfig `json:"sharedSSOConfigs"`
+	// List of container registry webhook endpoints.
+	// Each endpoint registers events for EventWatcher when new images are pushed.
+	RegistryWebhooks []ControlPlaneRegistryWebhook `json:"registryWebhooks"`
 }
 
 func (s *ControlPlaneSpec) Validate() error {
+	names := make(map[string]struct{}, len(s.RegistryWebhooks))
+	for i := range s.RegistryWebhooks {
+		w := &s.RegistryWebhooks[i]
+		if err := w.Validate(); err != nil {
+			return fmt.Errorf("invalid registry webhook %q: %w", w.Name, err)
+		}
+		if _, ok := names[w.Name]; ok {
+			return fmt.Errorf("duplicate registry webhook name %q", w.Name)
+		}
+		names[w.Name] = struct{}{}
+	}
+	if err := s.StageLogArchiver.Validate(); err != nil {
+		return fmt.Errorf("invalid stage log archiver: %w", err)
+	}
+	return nil
+}
+
+type RegistryWebhookProvider string
+
+const (
+	RegistryWebhookProviderDockerHub   RegistryWebhookProvider = "DOCKER_HUB"
+	RegistryWebhookProviderHarbor      RegistryWebhookProvider = "HARBOR"
+	RegistryWebhookProviderGitHub      RegistryWebhookProvider = "GITHUB"
+	RegistryWebhookProviderGitLab      RegistryWebhookProvider = "GITLAB"
+	RegistryWebhookProviderCloudEvents RegistryWebhookProvider = "CLOUDEVENTS"
+)
+
+// ControlPlaneRegistryWebhook represents an endpoint receiving push notifications
+// from a container registry at /webhooks/registry/{name}.
+type ControlPlaneRegistryWebhook struct {
+	// The unique name of the endpoint. It is used as the last element of the webhook path.
+	Name string `json:"name"`
+	// The registry sending the webhooks.
+	// Supported values: DOCKER_HUB, HARBOR, GITHUB, GITLAB, CLOUDEVENTS.
+	Provider RegistryWebhookProvider `json:"provider"`
+	// The ID of the API key tied to this endpoint.
+	// The key must have the READ_WRITE role and events are registered into its project.
+	APIKeyID string `json:"apiKeyID"`
+	// The secret shared with the registry to authenticate incoming requests.
+	Secret string `json:"secret"`
+	// List of rules to map the pushed images to events.
+	// An event is registered for every rule matching the pushed image.
+	Rules []RegistryWebhookRule `json:"rules"`
+}
+
+func (w *ControlPlaneRegistryWebhook) Validate() error {
+	if w.Name == "" {
+		return errors.New("name is required")
+	}
+	if url.PathEscape(w.Name) != w.Name {
+		return errors.New("name must be usable as a path element")
+	}
+	switch w.Provider {
+	case RegistryWebhookProviderDockerHub,
+		RegistryWebhookProviderHarbor,
+		RegistryWebhookProviderGitHub,
+		RegistryWebhookProviderGitLab,
+		RegistryWebhookProviderCloudEvents:
+	default:
+		return fmt.Errorf("unsupported provider %q", w.Provider)
+	}
+	if w.APIKeyID == "" {
+		return errors.New("apiKeyID is required")
+	}
+	if w.Secret == "" {
+		return errors.New("secret is required")
+	}
+	// Docker Hub sends the secret as the last element of the webhook path.
+	if w.Provider == RegistryWebhookProviderDockerHub && url.PathEscape(w.Secret) != w.Secret {
+		return errors.New("secret of DOCKER_HUB must be usable as a path element")
+	}
+	if len(w.Rules) == 0 {
+		return errors.New("at least one rule is required")
+	}
+	for i := range w.Rules {
+		if err := w.Rules[i].Validate(); err != nil {
+			return fmt.Errorf("invalid rule %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// RegistryWebhookRule maps a pushed image to an event.
+// The data and label values are Go templates evaluated with the following fields:
+// .Image, .Registry, .Repository, .Tag and .Digest.
+type RegistryWebhookRule struct {
+	// Glob pattern matched against the repository name, e.g. "my-org/*".
+	// Empty means matching all repositories.
+	Repository string `json:"repository"`
+	// Glob pattern matched against the pushed tag, e.g. "v*".
+	// Empty means matching all tags.
+	Tag string `json:"tag"`
+	// The name of the event to register.
+	EventName string `json:"eventName"`
+	// The labels of the event to register.
+	Labels map[string]string `json:"labels"`
+	// The template of the event data.
+	// Default is "{{ .Image }}" which gives the full image reference.
+	Data string `json:"data"`
+}
+
+func (r *RegistryWebhookRule) Validate() error {
+	if r.EventName == "" {
+		return errors.New("eventName is required")
+	}
+	for _, p := range []string{r.Repository, r.Tag} {
+		if _, err := path.Match(p, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", p, err)
+		}
+	}
+	if _, err := template.New("data").Parse(r.Data); err != nil {
+		return fmt.Errorf("invalid data template: %w", err)
+	}
+	for k, v := range r.Labels {
+		if _, err := template.New(k).Parse(v); err != nil {
+			return fmt.Errorf("invalid template for label %s: %w", k, err)
+		}
+	}
 	return nil
 }
 
@@ -196,6 +325,76 @@ type InsightCollectorDeployment struct {
 	ChunkMaxCount int    `json:"chunkMaxCount" default:"1000"`
 }
 
+// ControlPlaneSecretEncryption configures how the control plane encrypts
+// the secrets with the public keys of pipeds.
+type ControlPlaneSecretEncryption struct {
+	// Whether to embed the ID of the public key into the encrypted secrets
+	// in the form of "v2.<key ID>.<ciphertext>".
+	// Enable this only after all pipeds were upgraded to the version supporting it,
+	// because the older pipeds cannot decrypt the secrets in that form.
+	EmbedKeyID bool `json:"embedKeyID"`
+}
+
+// ControlPlaneStageLogArchiver configures the archiver which compresses the stage logs
+// of completed deployments and moves them to the archive prefix of the filestore.
+type ControlPlaneStageLogArchiver struct {
+	Enabled bool `json:"enabled"`
+	// Default is running at 03:00 every day.
+	Schedule string `json:"schedule" default:"0 3 * * *"`
+	// How long after the completion of a deployment its compressed stage logs
+	// are moved to the archive prefix.
+	// Default is 30 days.
+	ArchiveAfter Duration `json:"archiveAfter" default:"720h"`
+	// The external sink to which the stage logs are exported when they are compressed.
+	// Empty means not exporting.
+	Exporter *StageLogExporter `json:"exporter"`
+}
+
+func (a *ControlPlaneStageLogArchiver) Validate() error {
+	if a.ArchiveAfter < 0 {
+		return errors.New("archiveAfter must not be negative")
+	}
+	if a.Exporter != nil {
+		if err := a.Exporter.Validate(); err != nil {
+			return fmt.Errorf("invalid exporter: %w", err)
+		}
+	}
+	return nil
+}
+
+type StageLogExporterType string
+
+const (
+	StageLogExporterOTLP StageLogExporterType = "OTLP"
+	StageLogExporterLoki StageLogExporterType = "LOKI"
+)
+
+// StageLogExporter represents an external sink receiving the stage logs.
+type StageLogExporter struct {
+	// The type of the sink.
+	// Supported values: OTLP, LOKI.
+	Type StageLogExporterType `json:"type"`
+	// The URL to send the logs to.
+	// e.g. http://otel-collector:4318/v1/logs for OTLP or http://loki:3100/loki/api/v1/push for Loki.
+	URL string `json:"url"`
+	// Additional HTTP headers sent with every request, e.g. for authentication.
+	Headers map[string]string `json:"headers"`
+	// The timeout of each request.
+	Timeout Duration `json:"timeout" default:"30s"`
+}
+
+func (e *StageLogExporter) Validate() error {
+	switch e.Type {
+	case StageLogExporterOTLP, StageLogExporterLoki:
+	default:
+		return fmt.Errorf("unsupported type %q", e.Type)
+	}
+	if e.URL == "" {
+		return errors.New("url is required")
+	}
+	return nil
+}
+
 func (c ControlPlaneCache) TTLDuration() time.Duration {
 	const defaultTTL = 5 * time.Minute
 
@@ -91,6 +91,37 @@ func TestControlPlaneConfig(t *testing.T) {
 						ChunkMaxCount: 1000,
 					},
 				},
+				StageLogArchiver: ControlPlaneStageLogArchiver{
+					Enabled:      true,
+					Schedule:     "0 3 * * *",
+					ArchiveAfter: Duration(168 * time.Hour),
+					Exporter: &StageLogExporter{
+						Type: StageLogExporterLoki,
+						URL:  "http://loki:3100/loki/api/v1/push",
+						Headers: map[string]string{
+							"X-Scope-OrgID": "pipecd",
+						},
+						Timeout: Duration(30 * time.Second),
+					},
+				},
+				RegistryWebhooks: []ControlPlaneRegistryWebhook{
+					{
+						Name:     "dockerhub",
+						Provider: RegistryWebhookProviderDockerHub,
+						APIKeyID: "api-key-id",
+						Secret:   "webhook-secret",
+						Rules: []RegistryWebhookRule{
+							{
+								Repository: "my-org/*",
+								Tag:        "v*",
+								EventName:  "image-update",
+								Labels: map[string]string{
+									"env": "dev",
+								},
+							},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -114,3 +145,161 @@ func TestControlPlaneConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestControlPlaneRegistryWebhookValidate(t *testing.T) {
+	t.Parallel()
+
+	validRules := []RegistryWebhookRule{{EventName: "image-update"}}
+	testcases := []struct {
+		name    string
+		webhook ControlPlaneRegistryWebhook
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			webhook: ControlPlaneRegistryWebhook{
+				Name:     "harbor",
+				Provider: RegistryWebhookProviderHarbor,
+				APIKeyID: "key",
+				Secret:   "secret",
+				Rules: []RegistryWebhookRule{
+					{
+						Repository: "library/*",
+						EventName:  "image-update",
+						Labels:     map[string]string{"repo": "{{ .Repository }}"},
+						Data:       "{{ .Repository }}:{{ .Tag }}",
+					},
+				},
+			},
+		},
+		{
+			name: "missing name",
+			webhook: ControlPlaneRegistryWebhook{
+				Provider: RegistryWebhookProviderHarbor,
+				APIKeyID: "key",
+				Secret:   "secret",
+				Rules:    validRules,
+			},
+			wantErr: true,
+		},
+		{
+			name: "unsupported provider",
+			webhook: ControlPlaneRegistryWebhook{
+				Name:     "quay",
+				Provider: "QUAY",
+				APIKeyID: "key",
+				Secret:   "secret",
+				Rules:    validRules,
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing secret",
+			webhook: ControlPlaneRegistryWebhook{
+				Name:     "gitlab",
+				Provider: RegistryWebhookProviderGitLab,
+				APIKeyID: "key",
+				Rules:    validRules,
+			},
+			wantErr: true,
+		},
+		{
+			name: "docker hub secret not usable in path",
+			webhook: ControlPlaneRegistryWebhook{
+				Name:     "dockerhub",
+				Provider: RegistryWebhookProviderDockerHub,
+				APIKeyID: "key",
+				Secret:   "se/cret",
+				Rules:    validRules,
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing rules",
+			webhook: ControlPlaneRegistryWebhook{
+				Name:     "gitlab",
+				Provider: RegistryWebhookProviderGitLab,
+				APIKeyID: "key",
+				Secret:   "secret",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid pattern",
+			webhook: ControlPlaneRegistryWebhook{
+				Name:     "github",
+				Provider: RegistryWebhookProviderGitHub,
+				APIKeyID: "key",
+				Secret:   "secret",
+				Rules:    []RegistryWebhookRule{{Repository: "[", EventName: "image-update"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid data template",
+			webhook: ControlPlaneRegistryWebhook{
+				Name:     "cloudevents",
+				Provider: RegistryWebhookProviderCloudEvents,
+				APIKeyID: "key",
+				Secret:   "secret",
+				Rules:    []RegistryWebhookRule{{EventName: "image-update", Data: "{{ .Image"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.webhook.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestControlPlaneStageLogArchiverValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		archiver ControlPlaneStageLogArchiver
+		wantErr  bool
+	}{
+		{
+			name:     "valid without exporter",
+			archiver: ControlPlaneStageLogArchiver{Enabled: true, ArchiveAfter: Duration(time.Hour)},
+		},
+		{
+			name: "valid with exporter",
+			archiver: ControlPlaneStageLogArchiver{
+				Enabled:  true,
+				Exporter: &StageLogExporter{Type: StageLogExporterOTLP, URL: "http://otel-collector:4318/v1/logs"},
+			},
+		},
+		{
+			name:     "negative archiveAfter",
+			archiver: ControlPlaneStageLogArchiver{ArchiveAfter: Duration(-time.Hour)},
+			wantErr:  true,
+		},
+		{
+			name: "unsupported exporter type",
+			archiver: ControlPlaneStageLogArchiver{
+				Exporter: &StageLogExporter{Type: "SPLUNK", URL: "http://splunk"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing exporter url",
+			archiver: ControlPlaneStageLogArchiver{
+				Exporter: &StageLogExporter{Type: StageLogExporterLoki},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.archiver.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
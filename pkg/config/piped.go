@@ -20,7 +20,11 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
+	"text/template"
+
+	"github.com/Masterminds/semver/v3"
 
 	configv1 "github.com/pipe-cd/pipecd/pkg/configv1"
 	"github.com/pipe-cd/pipecd/pkg/model"
@@ -66,6 +70,8 @@ type PipedSpec struct {
 	ChartRepositories []HelmChartRepository `json:"chartRepositories,omitempty"`
 	// List of helm chart registries that should be logged in while starting up.
 	ChartRegistries []HelmChartRegistry `json:"chartRegistries,omitempty"`
+	// List of OCI registries that host application manifests as OCI artifacts.
+	OCIRegistries []PipedOCIRegistry `json:"ociRegistries,omitempty"`
 	// List of cloud providers can be used by this piped.
 	// Deprecated: use PlatformProvider instead.
 	CloudProviders []PipedPlatformProvider `json:"cloudProviders,omitempty"`
@@ -77,10 +83,14 @@ type PipedSpec struct {
 	Notifications Notifications `json:"notifications"`
 	// What secret management method should be used.
 	SecretManagement *SecretManagement `json:"secretManagement,omitempty"`
+	// External secret providers used to resolve the secrets referred in application manifests.
+	SecretProviders *PipedSecretProviders `json:"secretProviders,omitempty"`
 	// Optional settings for event watcher.
 	EventWatcher PipedEventWatcher `json:"eventWatcher"`
 	// List of labels to filter all applications this piped will handle.
 	AppSelector map[string]string `json:"appSelector,omitempty"`
+	// Where to load the policies enforced before applying any changes.
+	Policy *PipedPolicy `json:"policy,omitempty"`
 }
 
 func (s *PipedSpec) UnmarshalJSON(data []byte) error {
@@ -133,11 +143,21 @@ func (s *PipedSpec) Validate() error {
 			return err
 		}
 	}
+	for _, r := range s.OCIRegistries {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+	}
 	if s.SecretManagement != nil {
 		if err := s.SecretManagement.Validate(); err != nil {
 			return err
 		}
 	}
+	if s.SecretProviders != nil {
+		if err := s.SecretProviders.Validate(); err != nil {
+			return err
+		}
+	}
 	if err := s.EventWatcher.Validate(); err != nil {
 		return err
 	}
@@ -153,6 +173,14 @@ func (s *PipedSpec) Validate() error {
 			return err
 		}
 	}
+	if s.Policy != nil {
+		if err := s.Policy.Validate(); err != nil {
+			return err
+		}
+		if _, ok := s.GetRepository(s.Policy.RepoID); !ok {
+			return fmt.Errorf("repository %s specified in policy was not found", s.Policy.RepoID)
+		}
+	}
 	return nil
 }
 
@@ -186,6 +214,9 @@ func (s *PipedSpec) Mask() {
 	for i := 0; i < len(s.ChartRegistries); i++ {
 		s.ChartRegistries[i].Mask()
 	}
+	for i := 0; i < len(s.OCIRegistries); i++ {
+		s.OCIRegistries[i].Mask()
+	}
 	for _, p := range s.PlatformProviders {
 		p.Mask()
 	}
@@ -450,6 +481,41 @@ func (r *HelmChartRegistry) Mask() {
 	}
 }
 
+// PipedOCIRegistry represents an OCI registry from which application manifests are pulled.
+type PipedOCIRegistry struct {
+	// The address of the registry, e.g. registry.example.com or localhost:5000.
+	Address string `json:"address"`
+	// Username used for the registry authentication.
+	Username string `json:"username,omitempty"`
+	// Password used for the registry authentication.
+	Password string `json:"password,omitempty"`
+	// Whether to connect to the registry over plain HTTP.
+	Insecure bool `json:"insecure,omitempty"`
+}
+
+func (r *PipedOCIRegistry) Validate() error {
+	if r.Address == "" {
+		return errors.New("address must be set")
+	}
+	return nil
+}
+
+func (r *PipedOCIRegistry) Mask() {
+	if len(r.Password) != 0 {
+		r.Password = maskString
+	}
+}
+
+// FindOCIRegistry finds the OCI registry configuration by its address.
+func (s *PipedSpec) FindOCIRegistry(address string) (PipedOCIRegistry, bool) {
+	for _, r := range s.OCIRegistries {
+		if r.Address == address {
+			return r, true
+		}
+	}
+	return PipedOCIRegistry{}, false
+}
+
 type PipedPlatformProvider struct {
 	Name   string                     `json:"name"`
 	Type   model.PlatformProviderType `json:"type"`
@@ -1084,6 +1150,87 @@ func (s *SecretManagement) Validate() error {
 	}
 }
 
+// PipedSecretProviders represents the external secret providers
+// from which the secrets referred in application manifests are resolved at deploy time.
+type PipedSecretProviders struct {
+	// HashiCorp Vault configuration.
+	Vault *SecretProviderVault `json:"vault,omitempty"`
+}
+
+func (s *PipedSecretProviders) Validate() error {
+	if s.Vault != nil {
+		if err := s.Vault.Validate(); err != nil {
+			return fmt.Errorf("secretProviders.vault: %w", err)
+		}
+	}
+	return nil
+}
+
+type VaultAuthMethod string
+
+const (
+	VaultAuthMethodToken      VaultAuthMethod = "TOKEN"
+	VaultAuthMethodAppRole    VaultAuthMethod = "APPROLE"
+	VaultAuthMethodKubernetes VaultAuthMethod = "KUBERNETES"
+)
+
+type SecretProviderVault struct {
+	// The address of the Vault server, e.g. https://vault.example.com:8200
+	Address string `json:"address"`
+	// The Vault Enterprise namespace.
+	Namespace string `json:"namespace,omitempty"`
+	// How to authenticate to Vault.
+	Auth VaultAuth `json:"auth"`
+}
+
+func (s *SecretProviderVault) Validate() error {
+	if s.Address == "" {
+		return errors.New("address must be set")
+	}
+	return s.Auth.Validate()
+}
+
+type VaultAuth struct {
+	// The authentication method.
+	// Available values: TOKEN, APPROLE, KUBERNETES
+	Method VaultAuthMethod `json:"method"`
+	// The path where the auth method is mounted.
+	// Default is "approle" for APPROLE and "kubernetes" for KUBERNETES.
+	MountPath string `json:"mountPath,omitempty"`
+	// The path to the file containing the Vault token.
+	// Required when the method is TOKEN.
+	TokenFile string `json:"tokenFile,omitempty"`
+	// The role ID used for APPROLE.
+	RoleID string `json:"roleID,omitempty"`
+	// The path to the file containing the secret ID used for APPROLE.
+	SecretIDFile string `json:"secretIDFile,omitempty"`
+	// The role used for KUBERNETES.
+	Role string `json:"role,omitempty"`
+	// The path to the service account token used for KUBERNETES.
+	// Default is /var/run/secrets/kubernetes.io/serviceaccount/token.
+	ServiceAccountTokenFile string `json:"serviceAccountTokenFile,omitempty"`
+}
+
+func (a *VaultAuth) Validate() error {
+	switch a.Method {
+	case VaultAuthMethodToken:
+		if a.TokenFile == "" {
+			return errors.New("auth.tokenFile must be set for TOKEN method")
+		}
+	case VaultAuthMethodAppRole:
+		if a.RoleID == "" || a.SecretIDFile == "" {
+			return errors.New("auth.roleID and auth.secretIDFile must be set for APPROLE method")
+		}
+	case VaultAuthMethodKubernetes:
+		if a.Role == "" {
+			return errors.New("auth.role must be set for KUBERNETES method")
+		}
+	default:
+		return fmt.Errorf("unsupported auth method %q", a.Method)
+	}
+	return nil
+}
+
 type SecretManagementKeyPair struct {
 	// The path to the private RSA key file.
 	PrivateKeyFile string `json:"privateKeyFile"`
@@ -1093,6 +1240,10 @@ type SecretManagementKeyPair struct {
 	PublicKeyFile string `json:"publicKeyFile"`
 	// Base64 encoded string of public key.
 	PublicKeyData string `json:"publicKeyData,omitempty"`
+	// The private keys used before the current one.
+	// They are kept to decrypt the secrets that have not been re-encrypted
+	// with the current key yet during a key rotation.
+	PreviousKeys []SecretManagementPreviousKey `json:"previousKeys,omitempty"`
 }
 
 func (s *SecretManagementKeyPair) Validate() error {
@@ -1108,6 +1259,11 @@ func (s *SecretManagementKeyPair) Validate() error {
 	if s.PublicKeyFile != "" && s.PublicKeyData != "" {
 		return errors.New("only publicKeyFile or publicKeyData can be set")
 	}
+	for i := range s.PreviousKeys {
+		if err := s.PreviousKeys[i].Validate(); err != nil {
+			return fmt.Errorf("previousKeys[%d]: %w", i, err)
+		}
+	}
 	return nil
 }
 
@@ -1118,6 +1274,9 @@ func (s *SecretManagementKeyPair) Mask() {
 	if len(s.PrivateKeyData) != 0 {
 		s.PrivateKeyData = maskString
 	}
+	for i := range s.PreviousKeys {
+		s.PreviousKeys[i].Mask()
+	}
 }
 
 func (s *SecretManagementKeyPair) LoadPrivateKey() ([]byte, error) {
@@ -1140,6 +1299,52 @@ func (s *SecretManagementKeyPair) LoadPublicKey() ([]byte, error) {
 	return nil, errors.New("either publicKeyFile or publicKeyData must be set")
 }
 
+// LoadPreviousPrivateKeys loads all previous private keys in the configured order.
+func (s *SecretManagementKeyPair) LoadPreviousPrivateKeys() ([][]byte, error) {
+	keys := make([][]byte, 0, len(s.PreviousKeys))
+	for i := range s.PreviousKeys {
+		key, err := s.PreviousKeys[i].LoadPrivateKey()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load previousKeys[%d]: %w", i, err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+type SecretManagementPreviousKey struct {
+	// The path to the private RSA key file.
+	PrivateKeyFile string `json:"privateKeyFile,omitempty"`
+	// Base64 encoded string of private key.
+	PrivateKeyData string `json:"privateKeyData,omitempty"`
+}
+
+func (s *SecretManagementPreviousKey) Validate() error {
+	if s.PrivateKeyFile == "" && s.PrivateKeyData == "" {
+		return errors.New("either privateKeyFile or privateKeyData must be set")
+	}
+	if s.PrivateKeyFile != "" && s.PrivateKeyData != "" {
+		return errors.New("only privateKeyFile or privateKeyData can be set")
+	}
+	return nil
+}
+
+func (s *SecretManagementPreviousKey) Mask() {
+	if len(s.PrivateKeyFile) != 0 {
+		s.PrivateKeyFile = maskString
+	}
+	if len(s.PrivateKeyData) != 0 {
+		s.PrivateKeyData = maskString
+	}
+}
+
+func (s *SecretManagementPreviousKey) LoadPrivateKey() ([]byte, error) {
+	if s.PrivateKeyData != "" {
+		return base64.StdEncoding.DecodeString(s.PrivateKeyData)
+	}
+	return os.ReadFile(s.PrivateKeyFile)
+}
+
 type SecretManagementGCPKMS struct {
 	// Configurable fields when using Google Cloud KMS.
 	// The key name used for decrypting the sealed secret.
@@ -1178,6 +1383,10 @@ type PipedEventWatcher struct {
 	// The configuration list of git repositories to be observed.
 	// Only the repositories in this list will be observed by Piped.
 	GitRepos []PipedEventWatcherGitRepo `json:"gitRepos,omitempty"`
+	// The list of container image repositories to be polled by Piped.
+	// The image chosen by the policy is handled in the same way as
+	// the data of the latest event with the given name and labels.
+	ImagePolicies []PipedEventWatcherImagePolicy `json:"imagePolicies,omitempty"`
 }
 
 func (p *PipedEventWatcher) Validate() error {
@@ -1193,6 +1402,141 @@ func (p *PipedEventWatcher) Validate() error {
 		}
 		seen[repo.RepoID] = struct{}{}
 	}
+	for i := range p.ImagePolicies {
+		if err := p.ImagePolicies[i].Validate(); err != nil {
+			return fmt.Errorf("invalid image policy at index %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+type ImagePolicySortType string
+
+const (
+	ImagePolicySortAlphabetical ImagePolicySortType = "ALPHABETICAL"
+	ImagePolicySortNumerical    ImagePolicySortType = "NUMERICAL"
+)
+
+type ImagePolicyOrder string
+
+const (
+	ImagePolicyOrderAsc  ImagePolicyOrder = "ASC"
+	ImagePolicyOrderDesc ImagePolicyOrder = "DESC"
+)
+
+// PipedEventWatcherImagePolicy represents a container image repository polled by Piped
+// and the policy to choose the image to be deployed from its tags.
+type PipedEventWatcherImagePolicy struct {
+	// The name of the event matched by the EventWatcher configuration.
+	EventName string `json:"eventName"`
+	// The labels of the event matched by the EventWatcher configuration.
+	Labels map[string]string `json:"labels,omitempty"`
+	// The image repository to poll including the registry address, e.g. ghcr.io/pipe-cd/helloworld.
+	// The credentials are taken from the ociRegistries having the same address.
+	Image string `json:"image"`
+	// Interval to list the tags of the repository.
+	// Default is 5m.
+	Interval Duration `json:"interval,omitempty" default:"5m"`
+	// The policy to choose the image from the tags.
+	Policy ImagePolicy `json:"policy"`
+	// Go template of the event data. .Image, .Tag and .Digest are available.
+	// Default is "{{ .Image }}:{{ .Tag }}", or "{{ .Image }}@{{ .Digest }}" for policy.tag.
+	Data string `json:"data,omitempty"`
+}
+
+func (p *PipedEventWatcherImagePolicy) Validate() error {
+	if p.EventName == "" {
+		return errors.New("eventName must be set")
+	}
+	if p.Image == "" {
+		return errors.New("image must be set")
+	}
+	if strings.Contains(p.Image, "://") || !strings.Contains(p.Image, "/") {
+		return fmt.Errorf("image must be a repository including the registry address: %s", p.Image)
+	}
+	if p.Data != "" {
+		if _, err := template.New("data").Parse(p.Data); err != nil {
+			return fmt.Errorf("invalid data template: %w", err)
+		}
+	}
+	return p.Policy.Validate()
+}
+
+// ImagePolicy specifies how to choose an image from the tags of a repository.
+// Exactly one of semver, regex and tag must be set.
+type ImagePolicy struct {
+	// The semver range the tag must satisfy, e.g. ">=1.0.0 <2.0.0".
+	// The highest version in the range is chosen.
+	Semver string `json:"semver,omitempty"`
+	// The regular expression the tag must match, e.g. "^main-[a-f0-9]+-(\d+)$".
+	// The matching tags are sorted by the first capturing group if exists, otherwise by the whole tag.
+	Regex string `json:"regex,omitempty"`
+	// How to sort the tags matching the regex. ALPHABETICAL or NUMERICAL.
+	// Default is ALPHABETICAL.
+	Sort ImagePolicySortType `json:"sort,omitempty" default:"ALPHABETICAL"`
+	// The order of the tags matching the regex. ASC chooses the last one and DESC chooses the first one.
+	// Default is ASC.
+	Order ImagePolicyOrder `json:"order,omitempty" default:"ASC"`
+	// The moving tag, e.g. latest. The digest currently pointed by the tag is chosen.
+	Tag string `json:"tag,omitempty"`
+}
+
+func (p *ImagePolicy) Validate() error {
+	var count int
+	for _, v := range []string{p.Semver, p.Regex, p.Tag} {
+		if v != "" {
+			count++
+		}
+	}
+	if count != 1 {
+		return errors.New("exactly one of policy.semver, policy.regex and policy.tag must be set")
+	}
+	if p.Semver != "" {
+		if _, err := semver.NewConstraint(p.Semver); err != nil {
+			return fmt.Errorf("invalid semver range %q: %w", p.Semver, err)
+		}
+	}
+	if p.Regex != "" {
+		if _, err := regexp.Compile(p.Regex); err != nil {
+			return fmt.Errorf("invalid regex %q: %w", p.Regex, err)
+		}
+	}
+	switch p.Sort {
+	case "", ImagePolicySortAlphabetical, ImagePolicySortNumerical:
+	default:
+		return fmt.Errorf("unsupported policy.sort %q", p.Sort)
+	}
+	switch p.Order {
+	case "", ImagePolicyOrderAsc, ImagePolicyOrderDesc:
+	default:
+		return fmt.Errorf("unsupported policy.order %q", p.Order)
+	}
+	return nil
+}
+
+// RegistryAddress returns the address of the registry hosting the image.
+func (p *PipedEventWatcherImagePolicy) RegistryAddress() string {
+	address, _, _ := strings.Cut(p.Image, "/")
+	return address
+}
+
+// PipedPolicy specifies where the policy files are placed.
+type PipedPolicy struct {
+	// The ID of the git repository containing the policy files.
+	// This must be one of the repositories configured in this piped.
+	// The policies are always loaded from the configured branch of the repository.
+	RepoID string `json:"repoId"`
+	// The relative path to the directory containing the policy files in the repository.
+	Path string `json:"path"`
+}
+
+func (p *PipedPolicy) Validate() error {
+	if p.RepoID == "" {
+		return errors.New("policy.repoId must be set")
+	}
+	if p.Path == "" {
+		return errors.New("policy.path must be set")
+	}
 	return nil
 }
 
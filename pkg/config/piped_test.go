@@ -85,6 +85,12 @@ func TestPipedConfig(t *testing.T) {
 						Password: "sample-password",
 					},
 				},
+				OCIRegistries: []PipedOCIRegistry{
+					{
+						Address:  "localhost:5000",
+						Insecure: true,
+					},
+				},
 				PlatformProviders: []PipedPlatformProvider{
 					{
 						Name: "kubernetes-default",
@@ -461,6 +467,335 @@ func TestPipedEventWatcherValidate(t *testing.T) {
 	}
 }
 
+func TestPipedEventWatcherImagePolicyValidate(t *testing.T) {
+	testcases := []struct {
+		name    string
+		policy  PipedEventWatcherImagePolicy
+		wantErr bool
+	}{
+		{
+			name: "valid semver policy",
+			policy: PipedEventWatcherImagePolicy{
+				EventName: "image-update",
+				Image:     "ghcr.io/pipe-cd/helloworld",
+				Policy:    ImagePolicy{Semver: ">=1.0.0 <2.0.0"},
+			},
+		},
+		{
+			name: "valid regex policy",
+			policy: PipedEventWatcherImagePolicy{
+				EventName: "image-update",
+				Image:     "ghcr.io/pipe-cd/helloworld",
+				Policy:    ImagePolicy{Regex: `^main-(\d+)$`, Sort: ImagePolicySortNumerical, Order: ImagePolicyOrderAsc},
+				Data:      "{{ .Tag }}",
+			},
+		},
+		{
+			name: "valid tag policy",
+			policy: PipedEventWatcherImagePolicy{
+				EventName: "image-update",
+				Image:     "ghcr.io/pipe-cd/helloworld",
+				Policy:    ImagePolicy{Tag: "latest"},
+			},
+		},
+		{
+			name: "missing event name",
+			policy: PipedEventWatcherImagePolicy{
+				Image:  "ghcr.io/pipe-cd/helloworld",
+				Policy: ImagePolicy{Tag: "latest"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "image without registry",
+			policy: PipedEventWatcherImagePolicy{
+				EventName: "image-update",
+				Image:     "helloworld",
+				Policy:    ImagePolicy{Tag: "latest"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "multiple policies",
+			policy: PipedEventWatcherImagePolicy{
+				EventName: "image-update",
+				Image:     "ghcr.io/pipe-cd/helloworld",
+				Policy:    ImagePolicy{Semver: "^1.0.0", Tag: "latest"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no policy",
+			policy: PipedEventWatcherImagePolicy{
+				EventName: "image-update",
+				Image:     "ghcr.io/pipe-cd/helloworld",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid semver range",
+			policy: PipedEventWatcherImagePolicy{
+				EventName: "image-update",
+				Image:     "ghcr.io/pipe-cd/helloworld",
+				Policy:    ImagePolicy{Semver: "not a range"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid regex",
+			policy: PipedEventWatcherImagePolicy{
+				EventName: "image-update",
+				Image:     "ghcr.io/pipe-cd/helloworld",
+				Policy:    ImagePolicy{Regex: "("},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unsupported sort",
+			policy: PipedEventWatcherImagePolicy{
+				EventName: "image-update",
+				Image:     "ghcr.io/pipe-cd/helloworld",
+				Policy:    ImagePolicy{Regex: ".*", Sort: "RANDOM"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestPipedOCIRegistryValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		registry PipedOCIRegistry
+		wantErr  bool
+	}{
+		{
+			name: "valid",
+			registry: PipedOCIRegistry{
+				Address:  "registry.example.com",
+				Username: "user",
+				Password: "pass",
+			},
+			wantErr: false,
+		},
+		{
+			name:     "missing address",
+			registry: PipedOCIRegistry{},
+			wantErr:  true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.registry.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestSecretManagementKeyPairValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		keyPair SecretManagementKeyPair
+		wantErr bool
+	}{
+		{
+			name: "without previous keys",
+			keyPair: SecretManagementKeyPair{
+				PrivateKeyFile: "/etc/piped-secret/pair-private-key",
+				PublicKeyFile:  "/etc/piped-secret/pair-public-key",
+			},
+			wantErr: false,
+		},
+		{
+			name: "with previous keys",
+			keyPair: SecretManagementKeyPair{
+				PrivateKeyFile: "/etc/piped-secret/pair-private-key",
+				PublicKeyFile:  "/etc/piped-secret/pair-public-key",
+				PreviousKeys: []SecretManagementPreviousKey{
+					{PrivateKeyFile: "/etc/piped-secret/old-pair-private-key"},
+					{PrivateKeyData: "b2xkLWtleQ=="},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "previous key without private key",
+			keyPair: SecretManagementKeyPair{
+				PrivateKeyFile: "/etc/piped-secret/pair-private-key",
+				PublicKeyFile:  "/etc/piped-secret/pair-public-key",
+				PreviousKeys: []SecretManagementPreviousKey{
+					{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "previous key with both file and data",
+			keyPair: SecretManagementKeyPair{
+				PrivateKeyFile: "/etc/piped-secret/pair-private-key",
+				PublicKeyFile:  "/etc/piped-secret/pair-public-key",
+				PreviousKeys: []SecretManagementPreviousKey{
+					{
+						PrivateKeyFile: "/etc/piped-secret/old-pair-private-key",
+						PrivateKeyData: "b2xkLWtleQ==",
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.keyPair.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestSecretManagementKeyPairLoadPreviousPrivateKeys(t *testing.T) {
+	t.Parallel()
+
+	keyPair := SecretManagementKeyPair{
+		PreviousKeys: []SecretManagementPreviousKey{
+			{PrivateKeyData: "b2xkLWtleQ=="},
+			{PrivateKeyData: "b2xkZXIta2V5"},
+		},
+	}
+	keys, err := keyPair.LoadPreviousPrivateKeys()
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("old-key"), []byte("older-key")}, keys)
+
+	keyPair.PreviousKeys = append(keyPair.PreviousKeys, SecretManagementPreviousKey{
+		PrivateKeyFile: "testdata/not-found-private-key",
+	})
+	_, err = keyPair.LoadPreviousPrivateKeys()
+	assert.Error(t, err)
+}
+
+func TestSecretProviderVaultValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		vault   SecretProviderVault
+		wantErr bool
+	}{
+		{
+			name: "token auth",
+			vault: SecretProviderVault{
+				Address: "https://vault.example.com:8200",
+				Auth: VaultAuth{
+					Method:    VaultAuthMethodToken,
+					TokenFile: "/etc/piped-secret/vault-token",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "approle auth",
+			vault: SecretProviderVault{
+				Address: "https://vault.example.com:8200",
+				Auth: VaultAuth{
+					Method:       VaultAuthMethodAppRole,
+					RoleID:       "role-id",
+					SecretIDFile: "/etc/piped-secret/vault-secret-id",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "kubernetes auth",
+			vault: SecretProviderVault{
+				Address: "https://vault.example.com:8200",
+				Auth: VaultAuth{
+					Method: VaultAuthMethodKubernetes,
+					Role:   "piped",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "missing address",
+			vault: SecretProviderVault{
+				Auth: VaultAuth{
+					Method:    VaultAuthMethodToken,
+					TokenFile: "/etc/piped-secret/vault-token",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing secret ID file for approle auth",
+			vault: SecretProviderVault{
+				Address: "https://vault.example.com:8200",
+				Auth: VaultAuth{
+					Method: VaultAuthMethodAppRole,
+					RoleID: "role-id",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unsupported auth method",
+			vault: SecretProviderVault{
+				Address: "https://vault.example.com:8200",
+				Auth: VaultAuth{
+					Method: "USERPASS",
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.vault.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestPipedPolicyValidate(t *testing.T) {
+	testcases := []struct {
+		name    string
+		policy  PipedPolicy
+		wantErr bool
+	}{
+		{
+			name:    "missing repo id",
+			policy:  PipedPolicy{Path: "policies"},
+			wantErr: true,
+		},
+		{
+			name:    "missing path",
+			policy:  PipedPolicy{RepoID: "foo"},
+			wantErr: true,
+		},
+		{
+			name:    "valid",
+			policy:  PipedPolicy{RepoID: "foo", Path: "policies"},
+			wantErr: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
 func TestPipedSlackNotificationValidate(t *testing.T) {
 	testcases := []struct {
 		name                 string
@@ -733,6 +1068,10 @@ func TestPipedConfigMask(t *testing.T) {
 						PrivateKeyData: "foo",
 						PublicKeyFile:  "foo",
 						PublicKeyData:  "foo",
+						PreviousKeys: []SecretManagementPreviousKey{
+							{PrivateKeyFile: "foo"},
+							{PrivateKeyData: "foo"},
+						},
 					},
 					GCPKMS: &SecretManagementGCPKMS{
 						KeyName:                   "foo",
@@ -897,6 +1236,10 @@ func TestPipedConfigMask(t *testing.T) {
 						PrivateKeyData: maskString,
 						PublicKeyFile:  "foo",
 						PublicKeyData:  "foo",
+						PreviousKeys: []SecretManagementPreviousKey{
+							{PrivateKeyFile: maskString},
+							{PrivateKeyData: maskString},
+						},
 					},
 					GCPKMS: &SecretManagementGCPKMS{
 						KeyName:                   "foo",
@@ -0,0 +1,166 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// PolicySpec contains a set of rules which are enforced before applying any changes.
+type PolicySpec struct {
+	Rules []PolicyRule `json:"rules"`
+}
+
+// PolicyRule represents a rule written as a CEL expression.
+type PolicyRule struct {
+	// The unique name of the rule.
+	Name string `json:"name"`
+	// The description about the rule.
+	Description string `json:"description,omitempty"`
+	// Which applications the rule is applied to.
+	// The rule is applied to all applications when this is empty.
+	Match PolicyMatch `json:"match,omitempty"`
+	// The CEL expression which must be evaluated to true for compliant applications.
+	// The following variables are available:
+	// - app: the application containing its name, kind and labels
+	// - manifests: the list of rendered manifests
+	// - plan: the result of the dry-run such as terraform plan
+	// - strategy: the sync strategy decided for the deployment
+	// - stages: the list of resolved pipeline stages
+	Expression string `json:"expression"`
+	// The message shown when the rule is violated.
+	// The name of the rule is used if this is empty.
+	Message string `json:"message,omitempty"`
+	// What to do when the rule is violated.
+	// Defaults to DENY.
+	Action PolicyAction `json:"action,omitempty" default:"DENY"`
+	// Whether the rule is evaluated only while building plan-preview results.
+	// Rules referring to the plan must set this since the dry-run is not executed
+	// while planning deployments.
+	PlanPreviewOnly bool `json:"planPreviewOnly,omitempty"`
+}
+
+// PolicyMatch decides which applications a policy rule is applied to.
+type PolicyMatch struct {
+	// The application kinds. e.g. KUBERNETES, TERRAFORM
+	Kinds []string `json:"kinds,omitempty"`
+	// The labels the application must have.
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// PolicyAction represents what happens to the deployment which violates a policy rule.
+type PolicyAction string
+
+const (
+	// PolicyActionDeny fails the deployment.
+	PolicyActionDeny PolicyAction = "DENY"
+	// PolicyActionRequireApproval adds a WAIT_APPROVAL stage at the beginning of the pipeline.
+	PolicyActionRequireApproval PolicyAction = "REQUIRE_APPROVAL"
+)
+
+func (s *PolicySpec) Validate() error {
+	names := make(map[string]struct{}, len(s.Rules))
+	for i, r := range s.Rules {
+		if r.Name == "" {
+			return fmt.Errorf("missing name of policy rule at index %d", i)
+		}
+		if _, ok := names[r.Name]; ok {
+			return fmt.Errorf("duplicated policy rule name %s", r.Name)
+		}
+		names[r.Name] = struct{}{}
+		if r.Expression == "" {
+			return fmt.Errorf("missing expression of policy rule %s", r.Name)
+		}
+		if r.Action != PolicyActionDeny && r.Action != PolicyActionRequireApproval {
+			return fmt.Errorf("unsupported action %q of policy rule %s", r.Action, r.Name)
+		}
+	}
+	return nil
+}
+
+// Matches returns whether the rule is applied to the application
+// which has the given kind and labels.
+func (r *PolicyRule) Matches(kind string, labels map[string]string) bool {
+	if len(r.Match.Kinds) > 0 {
+		found := false
+		for _, k := range r.Match.Kinds {
+			if k == kind {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for k, v := range r.Match.Labels {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// LoadPolicies loads and merges all policy files placed under the given directory.
+// Every YAML or JSON file under the directory must be a Policy configuration.
+// ErrNotFound is returned if the directory does not exist.
+func LoadPolicies(dir string) (*PolicySpec, error) {
+	files := make([]string, 0)
+	err := filepath.Walk(dir,
+		func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				return nil
+			}
+			switch filepath.Ext(path) {
+			case ".yaml", ".yml", ".json":
+				files = append(files, path)
+			}
+			return nil
+		},
+	)
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+	sort.Strings(files)
+
+	spec := &PolicySpec{
+		Rules: make([]PolicyRule, 0),
+	}
+	for _, path := range files {
+		cfg, err := LoadFromYAML(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load policy file %s: %w", path, err)
+		}
+		if cfg.Kind != KindPolicy {
+			return nil, fmt.Errorf("file %s must be a %s configuration but got %s", path, KindPolicy, cfg.Kind)
+		}
+		spec.Rules = append(spec.Rules, cfg.PolicySpec.Rules...)
+	}
+
+	// Rules from all files must have unique names.
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
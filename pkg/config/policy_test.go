@@ -0,0 +1,176 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadPolicies(t *testing.T) {
+	testcases := []struct {
+		name          string
+		dir           string
+		expectedSpec  *PolicySpec
+		expectedError error
+		wantErr       bool
+	}{
+		{
+			name: "load policies from all files",
+			dir:  "testdata/policy/valid",
+			expectedSpec: &PolicySpec{
+				Rules: []PolicyRule{
+					{
+						Name:        "no-host-network",
+						Description: "Pods must not use the host network.",
+						Match: PolicyMatch{
+							Kinds: []string{"KUBERNETES"},
+						},
+						Expression: "manifests.all(m, !has(m.spec.template) || !has(m.spec.template.spec.hostNetwork) || m.spec.template.spec.hostNetwork == false)\n",
+						Message:    "hostNetwork must not be enabled",
+						Action:     PolicyActionDeny,
+					},
+					{
+						Name: "prod-needs-approval",
+						Match: PolicyMatch{
+							Labels: map[string]string{"env": "prod"},
+						},
+						Expression: `stages.exists(s, s.name == "WAIT_APPROVAL")`,
+						Action:     PolicyActionRequireApproval,
+					},
+					{
+						Name: "no-rds-destroy",
+						Match: PolicyMatch{
+							Kinds: []string{"TERRAFORM"},
+						},
+						Expression:      "!plan.resourceChanges.exists(c, c.type == \"aws_db_instance\" && c.action in [\"delete\", \"replace\"])\n",
+						Action:          PolicyActionDeny,
+						PlanPreviewOnly: true,
+					},
+				},
+			},
+		},
+		{
+			name:    "duplicated rule names across files",
+			dir:     "testdata/policy/invalid",
+			wantErr: true,
+		},
+		{
+			name:          "missing directory",
+			dir:           "testdata/policy/not-found",
+			expectedError: ErrNotFound,
+			wantErr:       true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			spec, err := LoadPolicies(tc.dir)
+			require.Equal(t, tc.wantErr, err != nil)
+			if tc.expectedError != nil {
+				assert.Equal(t, tc.expectedError, err)
+			}
+			if err == nil {
+				assert.Equal(t, tc.expectedSpec, spec)
+			}
+		})
+	}
+}
+
+func TestPolicyRuleMatches(t *testing.T) {
+	rule := PolicyRule{
+		Match: PolicyMatch{
+			Kinds:  []string{"KUBERNETES", "TERRAFORM"},
+			Labels: map[string]string{"env": "prod"},
+		},
+	}
+	testcases := []struct {
+		name     string
+		rule     PolicyRule
+		kind     string
+		labels   map[string]string
+		expected bool
+	}{
+		{
+			name:     "empty match is applied to all applications",
+			kind:     "ECS",
+			expected: true,
+		},
+		{
+			name:     "matched",
+			rule:     rule,
+			kind:     "TERRAFORM",
+			labels:   map[string]string{"env": "prod", "team": "foo"},
+			expected: true,
+		},
+		{
+			name:     "unmatched kind",
+			rule:     rule,
+			kind:     "ECS",
+			labels:   map[string]string{"env": "prod"},
+			expected: false,
+		},
+		{
+			name:     "unmatched label",
+			rule:     rule,
+			kind:     "KUBERNETES",
+			labels:   map[string]string{"env": "dev"},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.rule.Matches(tc.kind, tc.labels))
+		})
+	}
+}
+
+func TestPolicySpecValidate(t *testing.T) {
+	testcases := []struct {
+		name    string
+		spec    PolicySpec
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			spec: PolicySpec{Rules: []PolicyRule{{Name: "a", Expression: "true", Action: PolicyActionDeny}}},
+		},
+		{
+			name:    "missing name",
+			spec:    PolicySpec{Rules: []PolicyRule{{Expression: "true", Action: PolicyActionDeny}}},
+			wantErr: true,
+		},
+		{
+			name:    "missing expression",
+			spec:    PolicySpec{Rules: []PolicyRule{{Name: "a", Action: PolicyActionDeny}}},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported action",
+			spec:    PolicySpec{Rules: []PolicyRule{{Name: "a", Expression: "true", Action: "WARN"}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.spec.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
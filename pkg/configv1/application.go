@@ -20,11 +20,19 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/pipe-cd/pipecd/pkg/filematcher"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
-const allEventsSymbol = "*"
+const (
+	allEventsSymbol = "*"
+	// maxStageRetryBackoff is the upper limit of the duration to wait before retrying a failed stage.
+	maxStageRetryBackoff = 10 * time.Minute
+	// defaultStageRetryBackoff is used when no backoff was configured for the stage retries.
+	defaultStageRetryBackoff = 10 * time.Second
+)
 
 type GenericApplicationSpec struct {
 	// The application name.
@@ -58,6 +66,8 @@ type GenericApplicationSpec struct {
 	EventWatcher []EventWatcherConfig `json:"eventWatcher"`
 	// Configuration for drift detection
 	DriftDetection *DriftDetection `json:"driftDetection"`
+	// List of windows during which the application must not be deployed.
+	DeploymentFreezeWindows []DeploymentFreezeWindow `json:"deploymentFreezeWindows"`
 	// List of the configuration for plugin
 	// This field is plugin-specific, so intentionally restrict the access for the actual value here and decode it on the SDK side.
 	Plugins map[string]struct{} `json:"plugins"`
@@ -125,6 +135,14 @@ type OnChain struct {
 }
 
 func (s *GenericApplicationSpec) Validate() error {
+	if s.Pipeline != nil {
+		for _, stage := range s.Pipeline.Stages {
+			if err := stage.Retries.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
 	if ps := s.PostSync; ps != nil {
 		if err := ps.Validate(); err != nil {
 			return err
@@ -157,6 +175,12 @@ func (s *GenericApplicationSpec) Validate() error {
 		}
 	}
 
+	for _, w := range s.DeploymentFreezeWindows {
+		if err := w.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -213,11 +237,55 @@ type DeploymentPipeline struct {
 // PipelineStage represents a single stage of a pipeline.
 // This is used as a generic struct for all stage type.
 type PipelineStage struct {
-	Name    model.Stage     `json:"name"`
-	Desc    string          `json:"desc,omitempty"`
-	Timeout Duration        `json:"timeout" default:"6h"`
-	With    json.RawMessage `json:"with" default:"{}"`
-	SkipOn  SkipOptions     `json:"skipOn,omitempty"`
+	Name    model.Stage       `json:"name"`
+	Desc    string            `json:"desc,omitempty"`
+	Timeout Duration          `json:"timeout" default:"6h"`
+	Retries StageRetryOptions `json:"retries,omitempty"`
+	With    json.RawMessage   `json:"with" default:"{}"`
+	SkipOn  SkipOptions       `json:"skipOn,omitempty"`
+}
+
+// StageRetryOptions contains all configurable values for retrying a failed stage in place.
+type StageRetryOptions struct {
+	// The maximum number of times the stage is retried automatically after it failed.
+	// Default is 0, which means the stage is not retried automatically.
+	Limit int `json:"limit"`
+	// The duration to wait before the first automatic retry.
+	// It is doubled for each following retry.
+	// Default is 10s.
+	Backoff Duration `json:"backoff"`
+	// How long to wait for a RETRY_STAGE command from users once the stage failed
+	// and all automatic retries were used up. The deployment fails right after the stage failure
+	// if this is not set.
+	ManualRetryTimeout Duration `json:"manualRetryTimeout"`
+}
+
+func (r *StageRetryOptions) Validate() error {
+	if r.Limit < 0 {
+		return fmt.Errorf("retries.limit must be greater than or equal to 0")
+	}
+	if r.Backoff < 0 {
+		return fmt.Errorf("retries.backoff must be greater than or equal to 0")
+	}
+	if r.ManualRetryTimeout < 0 {
+		return fmt.Errorf("retries.manualRetryTimeout must be greater than or equal to 0")
+	}
+	return nil
+}
+
+// BackoffFor returns the duration to wait before the retry following the given number of retries.
+func (r *StageRetryOptions) BackoffFor(retriedCount int32) time.Duration {
+	backoff := r.Backoff.Duration()
+	if backoff == 0 {
+		backoff = defaultStageRetryBackoff
+	}
+	for i := int32(0); i < retriedCount && backoff < maxStageRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxStageRetryBackoff {
+		backoff = maxStageRetryBackoff
+	}
+	return backoff
 }
 
 // SkipOptions contains all configurable values for skipping a stage.
@@ -410,8 +478,7 @@ type DeploymentChain struct {
 	// the first applications in the chain trigger a whole new deployment chain or not.
 	// If this field is not set, always trigger a whole new deployment chain when the current
 	// application is triggered.
-	// TODO: Add conditions to deployment chain configuration.
-	// Conditions *DeploymentChainTriggerCondition `json:"conditions,omitempty"`
+	Conditions *DeploymentChainTriggerCondition `json:"conditions,omitempty"`
 }
 
 func (dc *DeploymentChain) Validate() error {
@@ -425,11 +492,11 @@ func (dc *DeploymentChain) Validate() error {
 		}
 	}
 
-	// if cc := dc.Conditions; cc != nil {
-	// 	if err := cc.Validate(); err != nil {
-	// 		return err
-	// 	}
-	// }
+	if cc := dc.Conditions; cc != nil {
+		if err := cc.Validate(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -440,6 +507,18 @@ type ChainApplicationMatcher struct {
 	Name   string            `json:"name"`
 	Kind   string            `json:"kind"`
 	Labels map[string]string `json:"labels"`
+	// Whether the applications of the later matchers should still be deployed
+	// when any deployment of the applications found by this matcher failed.
+	// Default is false.
+	ContinueOnFailure bool `json:"continueOnFailure"`
+	// Whether the running deployments of the applications found by this matcher
+	// should be cancelled with rollback when the chain failed or was cancelled.
+	// Default is false.
+	CancelOnChainFailure bool `json:"cancelOnChainFailure"`
+	// Whether the successfully finished deployments of the applications found by this matcher
+	// should be rolled back to their previously running commits when the chain failed or was cancelled.
+	// Default is false.
+	RollbackOnChainFailure bool `json:"rollbackOnChainFailure"`
 }
 
 func (m *ChainApplicationMatcher) Validate() error {
@@ -451,18 +530,55 @@ func (m *ChainApplicationMatcher) Validate() error {
 	return nil
 }
 
+// DeploymentChainTriggerCondition provides the conditions a deployment has to satisfy to trigger
+// a whole new deployment chain. All of the specified conditions must be satisfied.
 type DeploymentChainTriggerCondition struct {
+	// The prefix the message of the deploying commit must start with.
 	CommitPrefix string `json:"commitPrefix"`
+	// List of file path patterns. At least one of the files changed since
+	// the previously triggered commit must match one of them.
+	Paths []string `json:"paths"`
+	// The labels the triggered application must have.
+	Labels map[string]string `json:"labels"`
 }
 
 func (c *DeploymentChainTriggerCondition) Validate() error {
-	hasCond := c.CommitPrefix != ""
+	hasCond := c.CommitPrefix != "" || len(c.Paths) != 0 || len(c.Labels) != 0
 	if !hasCond {
-		return fmt.Errorf("missing commitPrefix configuration as deployment chain trigger condition")
+		return fmt.Errorf("at least one of \"commitPrefix\", \"paths\" or \"labels\" must be set as deployment chain trigger condition")
+	}
+	if len(c.Paths) != 0 {
+		if _, err := filematcher.NewPatternMatcher(c.Paths); err != nil {
+			return fmt.Errorf("invalid paths of deployment chain trigger condition: %w", err)
+		}
 	}
 	return nil
 }
 
+// Match reports whether a deployment of the given commit and application satisfies the conditions.
+// Nil changedFiles means the changes are unknown, e.g. there is no previously triggered commit,
+// so the paths condition is considered as satisfied.
+func (c *DeploymentChainTriggerCondition) Match(commitMessage string, appLabels map[string]string, changedFiles []string) (bool, error) {
+	if c.CommitPrefix != "" && !strings.HasPrefix(commitMessage, c.CommitPrefix) {
+		return false, nil
+	}
+	for k, v := range c.Labels {
+		if appLabels[k] != v {
+			return false, nil
+		}
+	}
+	if len(c.Paths) != 0 && changedFiles != nil {
+		matcher, err := filematcher.NewPatternMatcher(c.Paths)
+		if err != nil {
+			return false, err
+		}
+		if !matcher.MatchesAny(changedFiles) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 type DriftDetection struct {
 	// IgnoreFields are a list of 'apiVersion:kind:namespace:name#fieldPath'
 	IgnoreFields []string `json:"ignoreFields"`
@@ -478,6 +594,61 @@ func (dd *DriftDetection) Validate() error {
 	return nil
 }
 
+// DeploymentFreezeWindow represents a period of time while the application must not be deployed.
+// It must be either one-off (startAt and endAt) or recurring (cron and duration).
+type DeploymentFreezeWindow struct {
+	// The unique name of the window.
+	Name string `json:"name"`
+	// The reason of the freeze. It is shown as the reason why the deployment was held.
+	Description string `json:"description"`
+	// The IANA time zone name used to interpret the cron expression, e.g. "Asia/Tokyo".
+	// Default is UTC.
+	TimeZone string `json:"timeZone"`
+	// The time when the one-off window starts in RFC3339 format.
+	StartAt *time.Time `json:"startAt,omitempty"`
+	// The time when the one-off window ends in RFC3339 format.
+	EndAt *time.Time `json:"endAt,omitempty"`
+	// The cron expression describing when the recurring window starts, e.g. "0 18 * * FRI".
+	Cron string `json:"cron"`
+	// How long the recurring window lasts, e.g. "62h".
+	Duration Duration `json:"duration"`
+}
+
+func (w DeploymentFreezeWindow) Validate() error {
+	if w.Name == "" {
+		return fmt.Errorf("name of deployment freeze window must be set")
+	}
+	return w.ToModel().ValidateSchedule()
+}
+
+// ToModel converts the window into the model used to determine whether a deployment is frozen.
+func (w DeploymentFreezeWindow) ToModel() *model.DeploymentFreezeWindow {
+	m := &model.DeploymentFreezeWindow{
+		Name:        w.Name,
+		Description: w.Description,
+		TimeZone:    w.TimeZone,
+		Cron:        w.Cron,
+		Duration:    int64(w.Duration.Duration().Seconds()),
+	}
+	if w.StartAt != nil {
+		m.StartAt = w.StartAt.Unix()
+	}
+	if w.EndAt != nil {
+		m.EndAt = w.EndAt.Unix()
+	}
+	return m
+}
+
+// FindActiveDeploymentFreezeWindow returns the window that freezes the application at the given time.
+// Nil is returned when the application can be deployed.
+func (s GenericApplicationSpec) FindActiveDeploymentFreezeWindow(t time.Time) (*model.DeploymentFreezeWindow, error) {
+	windows := make([]*model.DeploymentFreezeWindow, 0, len(s.DeploymentFreezeWindows))
+	for _, w := range s.DeploymentFreezeWindows {
+		windows = append(windows, w.ToModel())
+	}
+	return model.FindActiveDeploymentFreezeWindow(windows, nil, t)
+}
+
 func LoadApplication(repoPath, configRelPath string) (*GenericApplicationSpec, error) {
 	absPath := filepath.Join(repoPath, configRelPath)
 
@@ -345,6 +345,90 @@ func TestValidateMentions(t *testing.T) {
 	}
 }
 
+func TestStageRetryOptionsValidate(t *testing.T) {
+	testcases := []struct {
+		name    string
+		opts    StageRetryOptions
+		wantErr bool
+	}{
+		{
+			name:    "valid with empty options",
+			opts:    StageRetryOptions{},
+			wantErr: false,
+		},
+		{
+			name: "valid",
+			opts: StageRetryOptions{
+				Limit:              3,
+				Backoff:            Duration(30 * time.Second),
+				ManualRetryTimeout: Duration(time.Hour),
+			},
+			wantErr: false,
+		},
+		{
+			name:    "invalid because of negative limit",
+			opts:    StageRetryOptions{Limit: -1},
+			wantErr: true,
+		},
+		{
+			name:    "invalid because of negative backoff",
+			opts:    StageRetryOptions{Backoff: Duration(-time.Second)},
+			wantErr: true,
+		},
+		{
+			name:    "invalid because of negative manual retry timeout",
+			opts:    StageRetryOptions{ManualRetryTimeout: Duration(-time.Second)},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestStageRetryOptionsBackoffFor(t *testing.T) {
+	testcases := []struct {
+		name         string
+		opts         StageRetryOptions
+		retriedCount int32
+		expected     time.Duration
+	}{
+		{
+			name:         "default backoff for the first retry",
+			opts:         StageRetryOptions{},
+			retriedCount: 0,
+			expected:     10 * time.Second,
+		},
+		{
+			name:         "configured backoff for the first retry",
+			opts:         StageRetryOptions{Backoff: Duration(time.Second)},
+			retriedCount: 0,
+			expected:     time.Second,
+		},
+		{
+			name:         "doubled for each following retry",
+			opts:         StageRetryOptions{Backoff: Duration(time.Second)},
+			retriedCount: 3,
+			expected:     8 * time.Second,
+		},
+		{
+			name:         "capped by the max backoff",
+			opts:         StageRetryOptions{Backoff: Duration(time.Minute)},
+			retriedCount: 10,
+			expected:     10 * time.Minute,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.opts.BackoffFor(tc.retriedCount)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
 func TestGenericTriggerConfiguration(t *testing.T) {
 	testcases := []struct {
 		fileName           string
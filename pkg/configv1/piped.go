@@ -119,6 +119,14 @@ func (s *PipedSpec) Validate() error {
 				return err
 			}
 		}
+		if n.Plugin != nil {
+			if err := n.Plugin.Validate(); err != nil {
+				return fmt.Errorf("receiver %s: %w", n.Name, err)
+			}
+			if s.FindPlugin(n.Plugin.Name) == nil {
+				return fmt.Errorf("receiver %s: plugin %s is not defined in plugins", n.Name, n.Plugin.Name)
+			}
+		}
 	}
 	return nil
 }
@@ -174,6 +182,16 @@ func (s *PipedSpec) GetRepository(id string) (PipedRepository, bool) {
 	return PipedRepository{}, false
 }
 
+// FindPlugin finds the plugin with the given name.
+func (s *PipedSpec) FindPlugin(name string) *PipedPlugin {
+	for i := range s.Plugins {
+		if s.Plugins[i].Name == name {
+			return &s.Plugins[i]
+		}
+	}
+	return nil
+}
+
 func (s *PipedSpec) LoadPipedKey() ([]byte, error) {
 	if s.PipedKeyData != "" {
 		return base64.StdEncoding.DecodeString(s.PipedKeyData)
@@ -311,6 +329,7 @@ type NotificationReceiver struct {
 	Name    string                       `json:"name"`
 	Slack   *NotificationReceiverSlack   `json:"slack,omitempty"`
 	Webhook *NotificationReceiverWebhook `json:"webhook,omitempty"`
+	Plugin  *NotificationReceiverPlugin  `json:"plugin,omitempty"`
 }
 
 func (n *NotificationReceiver) Mask() {
@@ -320,6 +339,32 @@ func (n *NotificationReceiver) Mask() {
 	if n.Webhook != nil {
 		n.Webhook.Mask()
 	}
+	if n.Plugin != nil {
+		n.Plugin.Mask()
+	}
+}
+
+// NotificationReceiverPlugin sends the notifications through a notification plugin.
+type NotificationReceiverPlugin struct {
+	// The name of the plugin defined in the plugins field.
+	Name string `json:"name"`
+	// The receiver specific configuration passed to the plugin.
+	// e.g. the channel or the URL to send the notifications to.
+	Config json.RawMessage `json:"config,omitempty"`
+}
+
+func (n *NotificationReceiverPlugin) Validate() error {
+	if n.Name == "" {
+		return errors.New("plugin name must be set")
+	}
+	return nil
+}
+
+func (n *NotificationReceiverPlugin) Mask() {
+	// The config may contain credentials such as tokens or URLs with secrets.
+	if len(n.Config) != 0 {
+		n.Config = json.RawMessage(`"` + maskString + `"`)
+	}
 }
 
 type NotificationReceiverSlack struct {
@@ -513,6 +558,10 @@ type SecretManagementKeyPair struct {
 	PublicKeyFile string `json:"publicKeyFile"`
 	// Base64 encoded string of public key.
 	PublicKeyData string `json:"publicKeyData,omitempty"`
+	// The private keys used before the current one.
+	// They are kept to decrypt the secrets that have not been re-encrypted
+	// with the current key yet during a key rotation.
+	PreviousKeys []SecretManagementPreviousKey `json:"previousKeys,omitempty"`
 }
 
 func (s *SecretManagementKeyPair) Validate() error {
@@ -528,6 +577,11 @@ func (s *SecretManagementKeyPair) Validate() error {
 	if s.PublicKeyFile != "" && s.PublicKeyData != "" {
 		return errors.New("only publicKeyFile or publicKeyData can be set")
 	}
+	for i := range s.PreviousKeys {
+		if err := s.PreviousKeys[i].Validate(); err != nil {
+			return fmt.Errorf("previousKeys[%d]: %w", i, err)
+		}
+	}
 	return nil
 }
 
@@ -538,6 +592,9 @@ func (s *SecretManagementKeyPair) Mask() {
 	if len(s.PrivateKeyData) != 0 {
 		s.PrivateKeyData = maskString
 	}
+	for i := range s.PreviousKeys {
+		s.PreviousKeys[i].Mask()
+	}
 }
 
 func (s *SecretManagementKeyPair) LoadPrivateKey() ([]byte, error) {
@@ -560,6 +617,52 @@ func (s *SecretManagementKeyPair) LoadPublicKey() ([]byte, error) {
 	return nil, errors.New("either publicKeyFile or publicKeyData must be set")
 }
 
+// LoadPreviousPrivateKeys loads all previous private keys in the configured order.
+func (s *SecretManagementKeyPair) LoadPreviousPrivateKeys() ([][]byte, error) {
+	keys := make([][]byte, 0, len(s.PreviousKeys))
+	for i := range s.PreviousKeys {
+		key, err := s.PreviousKeys[i].LoadPrivateKey()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load previousKeys[%d]: %w", i, err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+type SecretManagementPreviousKey struct {
+	// The path to the private RSA key file.
+	PrivateKeyFile string `json:"privateKeyFile,omitempty"`
+	// Base64 encoded string of private key.
+	PrivateKeyData string `json:"privateKeyData,omitempty"`
+}
+
+func (s *SecretManagementPreviousKey) Validate() error {
+	if s.PrivateKeyFile == "" && s.PrivateKeyData == "" {
+		return errors.New("either privateKeyFile or privateKeyData must be set")
+	}
+	if s.PrivateKeyFile != "" && s.PrivateKeyData != "" {
+		return errors.New("only privateKeyFile or privateKeyData can be set")
+	}
+	return nil
+}
+
+func (s *SecretManagementPreviousKey) Mask() {
+	if len(s.PrivateKeyFile) != 0 {
+		s.PrivateKeyFile = maskString
+	}
+	if len(s.PrivateKeyData) != 0 {
+		s.PrivateKeyData = maskString
+	}
+}
+
+func (s *SecretManagementPreviousKey) LoadPrivateKey() ([]byte, error) {
+	if s.PrivateKeyData != "" {
+		return base64.StdEncoding.DecodeString(s.PrivateKeyData)
+	}
+	return os.ReadFile(s.PrivateKeyFile)
+}
+
 type SecretManagementGCPKMS struct {
 	// Configurable fields when using Google Cloud KMS.
 	// The key name used for decrypting the sealed secret.
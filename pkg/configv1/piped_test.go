@@ -15,6 +15,7 @@
 package config
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
@@ -423,6 +424,55 @@ func TestPipedSlackNotificationValidate(t *testing.T) {
 	}
 }
 
+func TestPipedPluginNotificationValidate(t *testing.T) {
+	testcases := []struct {
+		name     string
+		receiver *NotificationReceiverPlugin
+		wantErr  bool
+	}{
+		{
+			name: "valid plugin receiver",
+			receiver: &NotificationReceiverPlugin{
+				Name:   "slack",
+				Config: json.RawMessage(`{"channelID":"testid"}`),
+			},
+			wantErr: false,
+		},
+		{
+			name:     "plugin name is empty",
+			receiver: &NotificationReceiverPlugin{},
+			wantErr:  true,
+		},
+		{
+			name: "plugin is not defined",
+			receiver: &NotificationReceiverPlugin{
+				Name: "teams",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := &PipedSpec{
+				ProjectID:    "test-project",
+				PipedID:      "test-piped",
+				PipedKeyFile: "etc/piped/key",
+				APIAddress:   "your-pipecd.domain",
+				Plugins: []PipedPlugin{
+					{Name: "slack", URL: "file:///path/to/slack"},
+				},
+				Notifications: Notifications{
+					Receivers: []NotificationReceiver{
+						{Name: "receiver", Plugin: tc.receiver},
+					},
+				},
+			}
+			err := spec.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
 func TestNotificationReceiverWebhook_LoadSignatureValue(t *testing.T) {
 	testcase := []struct {
 		name    string
@@ -20,17 +20,49 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/sha256"
+	"crypto/x509"
 	"encoding/base64"
 	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 	"io"
+	"strings"
 )
 
+// hybridV2Prefix is the prefix of the versioned ciphertexts.
+// They are in the form of "v2.<key ID>.<base64 encoded ciphertext>" so that
+// the decrypter can pick the right key while multiple keys are in use.
+// Since the standard base64 encoding never contains ".", the legacy ciphertexts
+// which have no key ID can be distinguished from them.
+const hybridV2Prefix = "v2."
+
+// KeyID returns the identifier of the given RSA public key.
+// It is the first 8 bytes of the SHA-256 digest of the DER-encoded key in hex.
+func KeyID(key *rsa.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+// CiphertextKeyID returns the ID of the key used to encrypt the given ciphertext.
+// It returns false for the legacy ciphertexts which have no key ID.
+func CiphertextKeyID(ciphertext string) (string, bool) {
+	if !strings.HasPrefix(ciphertext, hybridV2Prefix) {
+		return "", false
+	}
+	id, _, ok := strings.Cut(strings.TrimPrefix(ciphertext, hybridV2Prefix), ".")
+	return id, ok
+}
+
 // HybridEncrypter uses RSA to encrypt a randomly generated key for a symmetric AES-GCM.
 // RSA is able to encrypt only a very limited amount of data. In order
 // to encrypt reasonable amounts of data a hybrid scheme is commonly used.
 type HybridEncrypter struct {
-	key *rsa.PublicKey
+	key   *rsa.PublicKey
+	keyID string
 }
 
 func NewHybridEncrypter(key []byte) (*HybridEncrypter, error) {
@@ -38,13 +70,23 @@ func NewHybridEncrypter(key []byte) (*HybridEncrypter, error) {
 	if err != nil {
 		return nil, err
 	}
+	id, err := KeyID(k)
+	if err != nil {
+		return nil, err
+	}
 	return &HybridEncrypter{
-		key: k,
+		key:   k,
+		keyID: id,
 	}, nil
 }
 
+// KeyID returns the ID of the key used by this encrypter.
+func (e *HybridEncrypter) KeyID() string {
+	return e.keyID
+}
+
 // Encrypt performs a regular AES-GCM + RSA-OAEP encryption.
-// The output string is:
+// The output string is the base64 encoded ciphertext which is:
 //
 //	RSA ciphertext length || RSA ciphertext || AES ciphertext
 //
@@ -88,25 +130,80 @@ func (e *HybridEncrypter) Encrypt(text string) (string, error) {
 	return base64.StdEncoding.EncodeToString(ciphertext), nil
 }
 
+// EncryptWithKeyID works the same as Encrypt but embeds the key ID into the output string
+// in the form of "v2.<key ID>.<base64 encoded ciphertext>".
+// Since the decrypters older than this format cannot decrypt it,
+// it must be used only after all decrypters were upgraded.
+func (e *HybridEncrypter) EncryptWithKeyID(text string) (string, error) {
+	ciphertext, err := e.Encrypt(text)
+	if err != nil {
+		return "", err
+	}
+	return hybridV2Prefix + e.keyID + "." + ciphertext, nil
+}
+
+// HybridDecrypter decrypts the ciphertexts encrypted by HybridEncrypter.
+// It can hold multiple keys to keep decrypting the secrets encrypted
+// with the previous keys while rotating them.
 type HybridDecrypter struct {
-	key *rsa.PrivateKey
+	keys   []*rsa.PrivateKey
+	keyIDs map[string]*rsa.PrivateKey
 }
 
-func NewHybridDecrypter(key []byte) (*HybridDecrypter, error) {
-	k, err := ParseRSAPrivateKeyFromPem(key)
-	if err != nil {
-		return nil, err
+// NewHybridDecrypter returns a decrypter using the given key and the additional keys.
+// The additional keys are typically the previous ones which are being rotated.
+func NewHybridDecrypter(key []byte, additionalKeys ...[]byte) (*HybridDecrypter, error) {
+	d := &HybridDecrypter{
+		keys:   make([]*rsa.PrivateKey, 0, len(additionalKeys)+1),
+		keyIDs: make(map[string]*rsa.PrivateKey, len(additionalKeys)+1),
 	}
-	return &HybridDecrypter{
-		key: k,
-	}, nil
+	for _, key := range append([][]byte{key}, additionalKeys...) {
+		k, err := ParseRSAPrivateKeyFromPem(key)
+		if err != nil {
+			return nil, err
+		}
+		id, err := KeyID(&k.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		d.keys = append(d.keys, k)
+		d.keyIDs[id] = k
+	}
+	return d, nil
 }
 
-// Decrypt performs a regular AES-GCM + RSA-OAEP decryption.
+// Decrypt decrypts the given ciphertext.
+// The versioned ciphertexts are decrypted with the key having the embedded key ID
+// while the legacy ones are tried with all keys in order.
+func (d *HybridDecrypter) Decrypt(encryptedText string) (string, error) {
+	if !strings.HasPrefix(encryptedText, hybridV2Prefix) {
+		var lastErr error
+		for _, k := range d.keys {
+			text, err := decryptHybrid(k, encryptedText)
+			if err == nil {
+				return text, nil
+			}
+			lastErr = err
+		}
+		return "", lastErr
+	}
+
+	id, ok := CiphertextKeyID(encryptedText)
+	if !ok {
+		return "", fmt.Errorf("malformed ciphertext")
+	}
+	k, ok := d.keyIDs[id]
+	if !ok {
+		return "", fmt.Errorf("no decryption key was found for key ID %s", id)
+	}
+	return decryptHybrid(k, strings.TrimPrefix(encryptedText, hybridV2Prefix+id+"."))
+}
+
+// decryptHybrid performs a regular AES-GCM + RSA-OAEP decryption.
 //
 // The implementation of this function was brought from well known Bitnami's SealedSecret library.
 // https://github.com/bitnami-labs/sealed-secrets/blob/master/pkg/crypto/crypto.go#L86
-func (d *HybridDecrypter) Decrypt(encryptedText string) (string, error) {
+func decryptHybrid(key *rsa.PrivateKey, encryptedText string) (string, error) {
 	ciphertext, err := base64.StdEncoding.DecodeString(encryptedText)
 	if err != nil {
 		return "", err
@@ -123,7 +220,7 @@ func (d *HybridDecrypter) Decrypt(encryptedText string) (string, error) {
 	rsaCiphertext := ciphertext[2 : rsaLen+2]
 	aesCiphertext := ciphertext[rsaLen+2:]
 
-	symKey, err := rsa.DecryptOAEP(sha256.New(), rand.Reader, d.key, rsaCiphertext, nil)
+	symKey, err := rsa.DecryptOAEP(sha256.New(), rand.Reader, key, rsaCiphertext, nil)
 	if err != nil {
 		return "", err
 	}
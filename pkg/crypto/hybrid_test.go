@@ -55,3 +55,94 @@ data:
 	require.NoError(t, err)
 	assert.Equal(t, text, decryptedText)
 }
+
+func TestHybridDecryptWithMultipleKeys(t *testing.T) {
+	t.Parallel()
+
+	readFile := func(name string) []byte {
+		data, err := os.ReadFile(name)
+		require.NoError(t, err)
+		return data
+	}
+	var (
+		oldPrivateKey = readFile("testdata/private-rsa-pem")
+		oldPublicKey  = readFile("testdata/public-rsa-pem")
+		newPrivateKey = readFile("testdata/private-rsa-pem-2")
+		newPublicKey  = readFile("testdata/public-rsa-pem-2")
+	)
+
+	oldEncrypter, err := NewHybridEncrypter(oldPublicKey)
+	require.NoError(t, err)
+	newEncrypter, err := NewHybridEncrypter(newPublicKey)
+	require.NoError(t, err)
+	require.NotEqual(t, oldEncrypter.KeyID(), newEncrypter.KeyID())
+
+	encryptedByOld, err := oldEncrypter.EncryptWithKeyID("old-secret")
+	require.NoError(t, err)
+	encryptedByNew, err := newEncrypter.EncryptWithKeyID("new-secret")
+	require.NoError(t, err)
+	// The ciphertext without the key ID.
+	legacyEncryptedByOld, err := oldEncrypter.Encrypt("old-secret")
+	require.NoError(t, err)
+
+	id, ok := CiphertextKeyID(encryptedByNew)
+	require.True(t, ok)
+	assert.Equal(t, newEncrypter.KeyID(), id)
+	_, ok = CiphertextKeyID(legacyEncryptedByOld)
+	assert.False(t, ok)
+
+	testcases := []struct {
+		name       string
+		keys       [][]byte
+		ciphertext string
+		expected   string
+		wantErr    bool
+	}{
+		{
+			name:       "new key only",
+			keys:       [][]byte{newPrivateKey},
+			ciphertext: encryptedByNew,
+			expected:   "new-secret",
+		},
+		{
+			name:       "previous key is used for the old ciphertext",
+			keys:       [][]byte{newPrivateKey, oldPrivateKey},
+			ciphertext: encryptedByOld,
+			expected:   "old-secret",
+		},
+		{
+			name:       "all keys are tried for the legacy ciphertext",
+			keys:       [][]byte{newPrivateKey, oldPrivateKey},
+			ciphertext: legacyEncryptedByOld,
+			expected:   "old-secret",
+		},
+		{
+			name:       "no key for the embedded key ID",
+			keys:       [][]byte{newPrivateKey},
+			ciphertext: encryptedByOld,
+			wantErr:    true,
+		},
+		{
+			name:       "no key can decrypt the legacy ciphertext",
+			keys:       [][]byte{newPrivateKey},
+			ciphertext: legacyEncryptedByOld,
+			wantErr:    true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			decrypter, err := NewHybridDecrypter(tc.keys[0], tc.keys[1:]...)
+			require.NoError(t, err)
+
+			text, err := decrypter.Decrypt(tc.ciphertext)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, text)
+		})
+	}
+}
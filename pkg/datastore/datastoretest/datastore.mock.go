@@ -57,6 +57,20 @@ func (mr *MockProjectStoreMockRecorder) Add(ctx, proj any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockProjectStore)(nil).Add), ctx, proj)
 }
 
+// AddProjectDeploymentFreezeWindow mocks base method.
+func (m *MockProjectStore) AddProjectDeploymentFreezeWindow(ctx context.Context, id string, window *model.DeploymentFreezeWindow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddProjectDeploymentFreezeWindow", ctx, id, window)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddProjectDeploymentFreezeWindow indicates an expected call of AddProjectDeploymentFreezeWindow.
+func (mr *MockProjectStoreMockRecorder) AddProjectDeploymentFreezeWindow(ctx, id, window any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProjectDeploymentFreezeWindow", reflect.TypeOf((*MockProjectStore)(nil).AddProjectDeploymentFreezeWindow), ctx, id, window)
+}
+
 // AddProjectRBACRole mocks base method.
 func (m *MockProjectStore) AddProjectRBACRole(ctx context.Context, id, name string, policies []*model.ProjectRBACPolicy) error {
 	m.ctrl.T.Helper()
@@ -71,6 +85,20 @@ func (mr *MockProjectStoreMockRecorder) AddProjectRBACRole(ctx, id, name, polici
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProjectRBACRole", reflect.TypeOf((*MockProjectStore)(nil).AddProjectRBACRole), ctx, id, name, policies)
 }
 
+// AddProjectStaticUser mocks base method.
+func (m *MockProjectStore) AddProjectStaticUser(ctx context.Context, id, username, password, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddProjectStaticUser", ctx, id, username, password, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddProjectStaticUser indicates an expected call of AddProjectStaticUser.
+func (mr *MockProjectStoreMockRecorder) AddProjectStaticUser(ctx, id, username, password, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProjectStaticUser", reflect.TypeOf((*MockProjectStore)(nil).AddProjectStaticUser), ctx, id, username, password, role)
+}
+
 // AddProjectUserGroup mocks base method.
 func (m *MockProjectStore) AddProjectUserGroup(ctx context.Context, id, sso, role string) error {
 	m.ctrl.T.Helper()
@@ -85,6 +113,20 @@ func (mr *MockProjectStoreMockRecorder) AddProjectUserGroup(ctx, id, sso, role a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProjectUserGroup", reflect.TypeOf((*MockProjectStore)(nil).AddProjectUserGroup), ctx, id, sso, role)
 }
 
+// DeleteProjectDeploymentFreezeWindow mocks base method.
+func (m *MockProjectStore) DeleteProjectDeploymentFreezeWindow(ctx context.Context, id, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectDeploymentFreezeWindow", ctx, id, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProjectDeploymentFreezeWindow indicates an expected call of DeleteProjectDeploymentFreezeWindow.
+func (mr *MockProjectStoreMockRecorder) DeleteProjectDeploymentFreezeWindow(ctx, id, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectDeploymentFreezeWindow", reflect.TypeOf((*MockProjectStore)(nil).DeleteProjectDeploymentFreezeWindow), ctx, id, name)
+}
+
 // DeleteProjectRBACRole mocks base method.
 func (m *MockProjectStore) DeleteProjectRBACRole(ctx context.Context, id, name string) error {
 	m.ctrl.T.Helper()
@@ -99,6 +141,20 @@ func (mr *MockProjectStoreMockRecorder) DeleteProjectRBACRole(ctx, id, name any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectRBACRole", reflect.TypeOf((*MockProjectStore)(nil).DeleteProjectRBACRole), ctx, id, name)
 }
 
+// DeleteProjectStaticUser mocks base method.
+func (m *MockProjectStore) DeleteProjectStaticUser(ctx context.Context, id, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectStaticUser", ctx, id, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProjectStaticUser indicates an expected call of DeleteProjectStaticUser.
+func (mr *MockProjectStoreMockRecorder) DeleteProjectStaticUser(ctx, id, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectStaticUser", reflect.TypeOf((*MockProjectStore)(nil).DeleteProjectStaticUser), ctx, id, username)
+}
+
 // DeleteProjectUserGroup mocks base method.
 func (m *MockProjectStore) DeleteProjectUserGroup(ctx context.Context, id, sso string) error {
 	m.ctrl.T.Helper()
@@ -227,6 +283,48 @@ func (mr *MockProjectStoreMockRecorder) UpdateProjectStaticAdmin(ctx, id, userna
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectStaticAdmin", reflect.TypeOf((*MockProjectStore)(nil).UpdateProjectStaticAdmin), ctx, id, username, password)
 }
 
+// UpdateProjectStaticUserPassword mocks base method.
+func (m *MockProjectStore) UpdateProjectStaticUserPassword(ctx context.Context, id, username, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProjectStaticUserPassword", ctx, id, username, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProjectStaticUserPassword indicates an expected call of UpdateProjectStaticUserPassword.
+func (mr *MockProjectStoreMockRecorder) UpdateProjectStaticUserPassword(ctx, id, username, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectStaticUserPassword", reflect.TypeOf((*MockProjectStore)(nil).UpdateProjectStaticUserPassword), ctx, id, username, password)
+}
+
+// UpdateProjectStaticUserRole mocks base method.
+func (m *MockProjectStore) UpdateProjectStaticUserRole(ctx context.Context, id, username, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProjectStaticUserRole", ctx, id, username, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProjectStaticUserRole indicates an expected call of UpdateProjectStaticUserRole.
+func (mr *MockProjectStoreMockRecorder) UpdateProjectStaticUserRole(ctx, id, username, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectStaticUserRole", reflect.TypeOf((*MockProjectStore)(nil).UpdateProjectStaticUserRole), ctx, id, username, role)
+}
+
+// UpdateProjectStaticUserTOTP mocks base method.
+func (m *MockProjectStore) UpdateProjectStaticUserTOTP(ctx context.Context, id, username, encryptedSecret string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProjectStaticUserTOTP", ctx, id, username, encryptedSecret)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProjectStaticUserTOTP indicates an expected call of UpdateProjectStaticUserTOTP.
+func (mr *MockProjectStoreMockRecorder) UpdateProjectStaticUserTOTP(ctx, id, username, encryptedSecret any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectStaticUserTOTP", reflect.TypeOf((*MockProjectStore)(nil).UpdateProjectStaticUserTOTP), ctx, id, username, encryptedSecret)
+}
+
 // MockPipedStore is a mock of PipedStore interface.
 type MockPipedStore struct {
 	ctrl     *gomock.Controller
@@ -784,17 +882,17 @@ func (mr *MockDeploymentStoreMockRecorder) UpdateToCompleted(ctx, id, status, st
 }
 
 // UpdateToPlanned mocks base method.
-func (m *MockDeploymentStore) UpdateToPlanned(ctx context.Context, id, summary, reason, runningCommitHash, runningConfigFilename string, syncStrategy model.SyncStrategy, versions []*model.ArtifactVersion, stages []*model.PipelineStage) error {
+func (m *MockDeploymentStore) UpdateToPlanned(ctx context.Context, id, summary, reason, runningCommitHash, runningConfigFilename, runningArtifactDigest string, syncStrategy model.SyncStrategy, versions []*model.ArtifactVersion, stages []*model.PipelineStage) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateToPlanned", ctx, id, summary, reason, runningCommitHash, runningConfigFilename, syncStrategy, versions, stages)
+	ret := m.ctrl.Call(m, "UpdateToPlanned", ctx, id, summary, reason, runningCommitHash, runningConfigFilename, runningArtifactDigest, syncStrategy, versions, stages)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateToPlanned indicates an expected call of UpdateToPlanned.
-func (mr *MockDeploymentStoreMockRecorder) UpdateToPlanned(ctx, id, summary, reason, runningCommitHash, runningConfigFilename, syncStrategy, versions, stages any) *gomock.Call {
+func (mr *MockDeploymentStoreMockRecorder) UpdateToPlanned(ctx, id, summary, reason, runningCommitHash, runningConfigFilename, runningArtifactDigest, syncStrategy, versions, stages any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateToPlanned", reflect.TypeOf((*MockDeploymentStore)(nil).UpdateToPlanned), ctx, id, summary, reason, runningCommitHash, runningConfigFilename, syncStrategy, versions, stages)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateToPlanned", reflect.TypeOf((*MockDeploymentStore)(nil).UpdateToPlanned), ctx, id, summary, reason, runningCommitHash, runningConfigFilename, runningArtifactDigest, syncStrategy, versions, stages)
 }
 
 // MockCommandStore is a mock of CommandStore interface.
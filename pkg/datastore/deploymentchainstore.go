@@ -97,6 +97,16 @@ var (
 			return nil
 		}
 	}
+
+	cancelDeploymentChainUpdateFunc = func(now int64) func(*model.DeploymentChain) error {
+		return func(dc *model.DeploymentChain) error {
+			if dc.IsCompleted() {
+				return fmt.Errorf("%w: deployment chain %s was already completed with %s status", ErrInvalidArgument, dc.Id, dc.Status)
+			}
+			dc.Cancel(now)
+			return nil
+		}
+	}
 )
 
 type DeploymentChainStore interface {
@@ -105,6 +115,7 @@ type DeploymentChainStore interface {
 	List(ctx context.Context, opts ListOptions) ([]*model.DeploymentChain, string, error)
 	AddNodeDeployment(ctx context.Context, chainID string, deployment *model.Deployment) error
 	UpdateNodeDeploymentStatus(ctx context.Context, chainID string, blockIndex uint32, deploymentID string, status model.DeploymentStatus, reason string) error
+	Cancel(ctx context.Context, chainID string) error
 }
 
 type deploymentChainStore struct {
@@ -194,3 +205,8 @@ func (s *deploymentChainStore) UpdateNodeDeploymentStatus(ctx context.Context, c
 	updater := nodeDeploymentStatusUpdateFunc(blockIndex, deploymentID, status, reason)
 	return s.update(ctx, chainID, updater)
 }
+
+func (s *deploymentChainStore) Cancel(ctx context.Context, chainID string) error {
+	updater := cancelDeploymentChainUpdateFunc(s.nowFunc().Unix())
+	return s.update(ctx, chainID, updater)
+}
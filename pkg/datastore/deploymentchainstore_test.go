@@ -21,6 +21,7 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/model"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestDeploymentChainNodeDeploymentStatusUpdater(t *testing.T) {
@@ -307,6 +308,42 @@ func TestDeploymentChainNodeDeploymentStatusUpdater(t *testing.T) {
 			expectedBlockStatus: model.ChainBlockStatus_DEPLOYMENT_BLOCK_RUNNING,
 			expectedChainStatus: model.ChainStatus_DEPLOYMENT_CHAIN_RUNNING,
 		},
+		{
+			name: "chain keeps RUNNING status when a block allowing to continue on failure fails",
+			deploymentChain: model.DeploymentChain{
+				Status: model.ChainStatus_DEPLOYMENT_CHAIN_RUNNING,
+				Blocks: []*model.ChainBlock{
+					{
+						Nodes: []*model.ChainNode{
+							{
+								DeploymentRef: &model.ChainDeploymentRef{
+									DeploymentId: "deploy-0",
+									Status:       model.DeploymentStatus_DEPLOYMENT_RUNNING,
+								},
+							},
+						},
+						Status:            model.ChainBlockStatus_DEPLOYMENT_BLOCK_RUNNING,
+						ContinueOnFailure: true,
+					},
+					{
+						Nodes: []*model.ChainNode{
+							{
+								DeploymentRef: &model.ChainDeploymentRef{
+									DeploymentId: "deploy-1",
+									Status:       model.DeploymentStatus_DEPLOYMENT_PENDING,
+								},
+							},
+						},
+						Status: model.ChainBlockStatus_DEPLOYMENT_BLOCK_PENDING,
+					},
+				},
+			},
+			blockIndex:          0,
+			deploymentID:        "deploy-0",
+			deploymentStatus:    model.DeploymentStatus_DEPLOYMENT_FAILURE,
+			expectedBlockStatus: model.ChainBlockStatus_DEPLOYMENT_BLOCK_FAILURE,
+			expectedChainStatus: model.ChainStatus_DEPLOYMENT_CHAIN_RUNNING,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -326,3 +363,29 @@ func TestDeploymentChainNodeDeploymentStatusUpdater(t *testing.T) {
 		})
 	}
 }
+
+func TestDeploymentChainCancelUpdater(t *testing.T) {
+	dc := &model.DeploymentChain{
+		Id:     "chain-1",
+		Status: model.ChainStatus_DEPLOYMENT_CHAIN_RUNNING,
+		Blocks: []*model.ChainBlock{
+			{Status: model.ChainBlockStatus_DEPLOYMENT_BLOCK_SUCCESS, CompletedAt: 1},
+			{Status: model.ChainBlockStatus_DEPLOYMENT_BLOCK_RUNNING},
+			{Status: model.ChainBlockStatus_DEPLOYMENT_BLOCK_PENDING},
+		},
+	}
+
+	err := cancelDeploymentChainUpdateFunc(100)(dc)
+	require.NoError(t, err)
+	assert.Equal(t, model.ChainStatus_DEPLOYMENT_CHAIN_CANCELLED, dc.Status)
+	assert.Equal(t, int64(100), dc.CompletedAt)
+	assert.Equal(t, model.ChainBlockStatus_DEPLOYMENT_BLOCK_SUCCESS, dc.Blocks[0].Status)
+	assert.Equal(t, int64(1), dc.Blocks[0].CompletedAt)
+	assert.Equal(t, model.ChainBlockStatus_DEPLOYMENT_BLOCK_CANCELLED, dc.Blocks[1].Status)
+	assert.Equal(t, model.ChainBlockStatus_DEPLOYMENT_BLOCK_CANCELLED, dc.Blocks[2].Status)
+
+	// Cancelling a completed chain is not allowed.
+	err = cancelDeploymentChainUpdateFunc(200)(dc)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Equal(t, int64(100), dc.CompletedAt)
+}
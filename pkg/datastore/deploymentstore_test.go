@@ -35,6 +35,7 @@ func TestDeploymentToPlannedUpdater(t *testing.T) {
 		expectedSyncStrategy          = model.SyncStrategy_QUICK_SYNC
 		expectedRunningCommitHash     = "update-running-commit-hash"
 		expectedRunningConfigFilename = "update-running-config-filename"
+		expectedRunningArtifactDigest = "sha256:running"
 		expectedVersions              = []*model.ArtifactVersion{
 			{
 				Kind:    model.ArtifactVersion_CONTAINER_IMAGE,
@@ -73,6 +74,7 @@ func TestDeploymentToPlannedUpdater(t *testing.T) {
 			expectedStatusDesc,
 			expectedRunningCommitHash,
 			expectedRunningConfigFilename,
+			expectedRunningArtifactDigest,
 			expectedSyncStrategy,
 			expectedVersions,
 			expectedStages,
@@ -86,6 +88,7 @@ func TestDeploymentToPlannedUpdater(t *testing.T) {
 	assert.Equal(t, expectedStatusDesc, d.StatusReason)
 	assert.Equal(t, expectedRunningCommitHash, d.RunningCommitHash)
 	assert.Equal(t, expectedRunningConfigFilename, d.RunningConfigFilename)
+	assert.Equal(t, expectedRunningArtifactDigest, d.RunningArtifactDigest)
 	assert.Equal(t, expectedSyncStrategy, d.Trigger.SyncStrategy)
 	assert.Equal(t, expectedVersions, d.Versions)
 	assert.Equal(t, expectedStages, d.Stages)
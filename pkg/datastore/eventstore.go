@@ -36,7 +36,7 @@ func (e *eventCollection) Factory() Factory {
 }
 
 type EventStore interface {
-	Add(ctx context.Context, e model.Event) error
+	Add(ctx context.Context, e *model.Event) error
 	List(ctx context.Context, opts ListOptions) ([]*model.Event, string, error)
 	UpdateStatus(ctx context.Context, eventID string, status model.EventStatus, statusDescription string) error
 }
@@ -56,7 +56,7 @@ func NewEventStore(ds DataStore) EventStore {
 	}
 }
 
-func (s *eventStore) Add(ctx context.Context, e model.Event) error {
+func (s *eventStore) Add(ctx context.Context, e *model.Event) error {
 	now := s.nowFunc().Unix()
 	if e.CreatedAt == 0 {
 		e.CreatedAt = now
@@ -67,7 +67,7 @@ func (s *eventStore) Add(ctx context.Context, e model.Event) error {
 	if err := e.Validate(); err != nil {
 		return fmt.Errorf("failed to validate event: %w: %w", ErrInvalidArgument, err)
 	}
-	return s.ds.Create(ctx, s.col, e.Id, &e)
+	return s.ds.Create(ctx, s.col, e.Id, e)
 }
 
 func (s *eventStore) List(ctx context.Context, opts ListOptions) ([]*model.Event, string, error) {
@@ -28,7 +28,7 @@ func TestAddEvent(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	event := model.Event{
+	event := &model.Event{
 		Id:        "id",
 		Name:      "name",
 		Data:      "data",
@@ -40,13 +40,13 @@ func TestAddEvent(t *testing.T) {
 
 	testcases := []struct {
 		name    string
-		event   model.Event
+		event   *model.Event
 		ds      DataStore
 		wantErr bool
 	}{
 		{
 			name:  "Invalid event",
-			event: model.Event{},
+			event: &model.Event{},
 			ds: func() DataStore {
 				return NewMockDataStore(ctrl)
 			}(),
@@ -58,7 +58,7 @@ func TestAddEvent(t *testing.T) {
 			ds: func() DataStore {
 				ds := NewMockDataStore(ctrl)
 				ds.EXPECT().
-					Create(gomock.Any(), gomock.Any(), event.Id, &event).
+					Create(gomock.Any(), gomock.Any(), event.Id, event).
 					Return(nil)
 				return ds
 			}(),
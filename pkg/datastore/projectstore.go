@@ -16,6 +16,7 @@ package datastore
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/pipe-cd/pipecd/pkg/model"
@@ -48,6 +49,13 @@ type ProjectStore interface {
 	DeleteProjectRBACRole(ctx context.Context, id, name string) error
 	AddProjectUserGroup(ctx context.Context, id, sso, role string) error
 	DeleteProjectUserGroup(ctx context.Context, id, sso string) error
+	AddProjectDeploymentFreezeWindow(ctx context.Context, id string, window *model.DeploymentFreezeWindow) error
+	DeleteProjectDeploymentFreezeWindow(ctx context.Context, id, name string) error
+	AddProjectStaticUser(ctx context.Context, id, username, password, role string) error
+	DeleteProjectStaticUser(ctx context.Context, id, username string) error
+	UpdateProjectStaticUserRole(ctx context.Context, id, username, role string) error
+	UpdateProjectStaticUserPassword(ctx context.Context, id, username, password string) error
+	UpdateProjectStaticUserTOTP(ctx context.Context, id, username, encryptedSecret string) error
 }
 
 type projectStore struct {
@@ -200,3 +208,66 @@ func (s *projectStore) DeleteProjectUserGroup(ctx context.Context, id, sso strin
 		return p.DeleteUserGroup(sso)
 	})
 }
+
+// AddProjectDeploymentFreezeWindow adds the deployment freeze window.
+func (s *projectStore) AddProjectDeploymentFreezeWindow(ctx context.Context, id string, window *model.DeploymentFreezeWindow) error {
+	return s.update(ctx, id, func(p *model.Project) error {
+		return p.AddDeploymentFreezeWindow(window)
+	})
+}
+
+// DeleteProjectDeploymentFreezeWindow deletes the deployment freeze window.
+func (s *projectStore) DeleteProjectDeploymentFreezeWindow(ctx context.Context, id, name string) error {
+	return s.update(ctx, id, func(p *model.Project) error {
+		return p.DeleteDeploymentFreezeWindow(name)
+	})
+}
+
+// AddProjectStaticUser adds a static user.
+func (s *projectStore) AddProjectStaticUser(ctx context.Context, id, username, password, role string) error {
+	return s.update(ctx, id, func(p *model.Project) error {
+		return p.AddStaticUser(username, password, role)
+	})
+}
+
+// DeleteProjectStaticUser deletes a static user.
+func (s *projectStore) DeleteProjectStaticUser(ctx context.Context, id, username string) error {
+	return s.update(ctx, id, func(p *model.Project) error {
+		return p.DeleteStaticUser(username)
+	})
+}
+
+// UpdateProjectStaticUserRole changes the role of a static user.
+func (s *projectStore) UpdateProjectStaticUserRole(ctx context.Context, id, username, role string) error {
+	return s.update(ctx, id, func(p *model.Project) error {
+		return p.UpdateStaticUserRole(username, role)
+	})
+}
+
+// UpdateProjectStaticUserPassword changes the password of the static admin or a static user.
+func (s *projectStore) UpdateProjectStaticUserPassword(ctx context.Context, id, username, password string) error {
+	return s.update(ctx, id, func(p *model.Project) error {
+		u, ok := p.FindStaticUser(username)
+		if !ok {
+			return fmt.Errorf("%s static user does not exist", username)
+		}
+		if password == "" {
+			return fmt.Errorf("password is empty")
+		}
+		return u.Update("", password)
+	})
+}
+
+// UpdateProjectStaticUserTOTP sets the encrypted TOTP secret of the static admin or a static user.
+// The two-factor authentication is disabled when the given secret is empty.
+func (s *projectStore) UpdateProjectStaticUserTOTP(ctx context.Context, id, username, encryptedSecret string) error {
+	return s.update(ctx, id, func(p *model.Project) error {
+		u, ok := p.FindStaticUser(username)
+		if !ok {
+			return fmt.Errorf("%s static user does not exist", username)
+		}
+		u.TotpSecret = encryptedSecret
+		u.TotpEnabled = encryptedSecret != ""
+		return nil
+	})
+}
@@ -346,6 +346,35 @@ func (m *Command) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetRetryStage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommandValidationError{
+					field:  "RetryStage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommandValidationError{
+					field:  "RetryStage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetryStage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommandValidationError{
+				field:  "RetryStage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.GetCreatedAt() <= 0 {
 		err := CommandValidationError{
 			field:  "CreatedAt",
@@ -480,6 +509,8 @@ func (m *Command_SyncApplication) validate(all bool) error {
 
 	// no validation rules for SyncStrategy
 
+	// no validation rules for TargetCommit
+
 	if len(errors) > 0 {
 		return Command_SyncApplicationMultiError(errors)
 	}
@@ -1450,3 +1481,127 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = Command_RestartPipedValidationError{}
+
+// Validate checks the field values on Command_RetryStage with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Command_RetryStage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Command_RetryStage with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Command_RetryStageMultiError, or nil if none found.
+func (m *Command_RetryStage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Command_RetryStage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := Command_RetryStageValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStageId()) < 1 {
+		err := Command_RetryStageValidationError{
+			field:  "StageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return Command_RetryStageMultiError(errors)
+	}
+
+	return nil
+}
+
+// Command_RetryStageMultiError is an error wrapping multiple validation errors
+// returned by Command_RetryStage.ValidateAll() if the designated constraints
+// aren't met.
+type Command_RetryStageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Command_RetryStageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Command_RetryStageMultiError) AllErrors() []error { return m }
+
+// Command_RetryStageValidationError is the validation error returned by
+// Command_RetryStage.Validate if the designated constraints aren't met.
+type Command_RetryStageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Command_RetryStageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Command_RetryStageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Command_RetryStageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Command_RetryStageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Command_RetryStageValidationError) ErrorName() string {
+	return "Command_RetryStageValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Command_RetryStageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommand_RetryStage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Command_RetryStageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Command_RetryStageValidationError{}
@@ -295,6 +295,8 @@ func (m *Deployment) validate(all bool) error {
 
 	// no validation rules for RunningConfigFilename
 
+	// no validation rules for RunningArtifactDigest
+
 	if _, ok := DeploymentStatus_name[int32(m.GetStatus())]; !ok {
 		err := DeploymentValidationError{
 			field:  "Status",
@@ -668,6 +670,8 @@ func (m *DeploymentTrigger) validate(all bool) error {
 
 	// no validation rules for StrategySummary
 
+	// no validation rules for ArtifactDigest
+
 	if len(errors) > 0 {
 		return DeploymentTriggerMultiError(errors)
 	}
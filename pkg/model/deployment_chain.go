@@ -42,6 +42,7 @@ func (dc *DeploymentChain) DesiredStatus() ChainStatus {
 	var (
 		successBlockCtn   int
 		failedBlockCtn    int
+		toleratedBlockCtn int
 		cancelledBlockCtn int
 		runningBlockCtn   int
 	)
@@ -50,6 +51,10 @@ func (dc *DeploymentChain) DesiredStatus() ChainStatus {
 		case ChainBlockStatus_DEPLOYMENT_BLOCK_SUCCESS:
 			successBlockCtn++
 		case ChainBlockStatus_DEPLOYMENT_BLOCK_FAILURE:
+			if block.ContinueOnFailure {
+				toleratedBlockCtn++
+				continue
+			}
 			failedBlockCtn++
 		case ChainBlockStatus_DEPLOYMENT_BLOCK_CANCELLED:
 			cancelledBlockCtn++
@@ -63,7 +68,8 @@ func (dc *DeploymentChain) DesiredStatus() ChainStatus {
 	if successBlockCtn == len(dc.Blocks) {
 		return ChainStatus_DEPLOYMENT_CHAIN_SUCCESS
 	}
-	// If one of the chain's blocks has finished with FAILURE status, the chain counted as FAILURE.
+	// If one of the chain's blocks which does not allow continuing on failure has finished
+	// with FAILURE status, the chain counted as FAILURE.
 	if failedBlockCtn > 0 {
 		return ChainStatus_DEPLOYMENT_CHAIN_FAILURE
 	}
@@ -71,6 +77,11 @@ func (dc *DeploymentChain) DesiredStatus() ChainStatus {
 	if cancelledBlockCtn > 0 {
 		return ChainStatus_DEPLOYMENT_CHAIN_CANCELLED
 	}
+	// If all blocks have finished but some of them failed while allowing the chain to continue,
+	// the chain counted as FAILURE after all later blocks were deployed.
+	if toleratedBlockCtn > 0 && successBlockCtn+toleratedBlockCtn == len(dc.Blocks) {
+		return ChainStatus_DEPLOYMENT_CHAIN_FAILURE
+	}
 	// If one of the chain's blocks is at RUNNING status, the chain counted as RUNNING.
 	if runningBlockCtn > 0 {
 		return ChainStatus_DEPLOYMENT_CHAIN_RUNNING
@@ -79,6 +90,34 @@ func (dc *DeploymentChain) DesiredStatus() ChainStatus {
 	return dc.Status
 }
 
+// Cancel marks the chain and all of its not yet completed blocks as CANCELLED.
+func (dc *DeploymentChain) Cancel(now int64) {
+	for _, block := range dc.Blocks {
+		if block.IsCompleted() {
+			continue
+		}
+		block.Status = ChainBlockStatus_DEPLOYMENT_BLOCK_CANCELLED
+		block.CompletedAt = now
+	}
+	dc.Status = ChainStatus_DEPLOYMENT_CHAIN_CANCELLED
+	dc.CompletedAt = now
+}
+
+// ListNotCompletedDeployments returns the references to in-chain deployments which have not finished yet
+// along with the block each of them belongs to.
+func (dc *DeploymentChain) ListNotCompletedDeployments() map[*ChainDeploymentRef]*ChainBlock {
+	refs := make(map[*ChainDeploymentRef]*ChainBlock)
+	for _, block := range dc.Blocks {
+		for _, node := range block.Nodes {
+			if node.DeploymentRef == nil || node.DeploymentRef.Status.IsCompleted() {
+				continue
+			}
+			refs[node.DeploymentRef] = block
+		}
+	}
+	return refs
+}
+
 func (dc *DeploymentChain) ListAllInChainApplicationDeploymentsMap() map[string]*ChainDeploymentRef {
 	deployments := make(map[string]*ChainDeploymentRef, 0)
 	for _, block := range dc.Blocks {
@@ -711,6 +711,12 @@ func (m *ChainBlock) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for ContinueOnFailure
+
+	// no validation rules for CancelOnChainFailure
+
+	// no validation rules for RollbackOnChainFailure
+
 	if m.GetStartedAt() < 0 {
 		err := ChainBlockValidationError{
 			field:  "StartedAt",
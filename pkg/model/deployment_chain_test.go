@@ -102,6 +102,38 @@ func TestDeploymentChainDesireStatus(t *testing.T) {
 			},
 			expectedDesireStatus: ChainStatus_DEPLOYMENT_CHAIN_RUNNING,
 		},
+		{
+			name: "FAILURE block allowing to continue does not finish the chain",
+			deploymentChain: DeploymentChain{
+				Status: ChainStatus_DEPLOYMENT_CHAIN_RUNNING,
+				Blocks: []*ChainBlock{
+					{
+						Status:            ChainBlockStatus_DEPLOYMENT_BLOCK_FAILURE,
+						ContinueOnFailure: true,
+					},
+					{
+						Status: ChainBlockStatus_DEPLOYMENT_BLOCK_RUNNING,
+					},
+				},
+			},
+			expectedDesireStatus: ChainStatus_DEPLOYMENT_CHAIN_RUNNING,
+		},
+		{
+			name: "chain with FAILURE block allowing to continue is FAILURE after all blocks finished",
+			deploymentChain: DeploymentChain{
+				Status: ChainStatus_DEPLOYMENT_CHAIN_RUNNING,
+				Blocks: []*ChainBlock{
+					{
+						Status:            ChainBlockStatus_DEPLOYMENT_BLOCK_FAILURE,
+						ContinueOnFailure: true,
+					},
+					{
+						Status: ChainBlockStatus_DEPLOYMENT_BLOCK_SUCCESS,
+					},
+				},
+			},
+			expectedDesireStatus: ChainStatus_DEPLOYMENT_CHAIN_FAILURE,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -360,3 +392,55 @@ func TestGetNodeByDeploymentID(t *testing.T) {
 		})
 	}
 }
+
+func TestDeploymentChainCancel(t *testing.T) {
+	t.Parallel()
+	dc := &DeploymentChain{
+		Status: ChainStatus_DEPLOYMENT_CHAIN_RUNNING,
+		Blocks: []*ChainBlock{
+			{Status: ChainBlockStatus_DEPLOYMENT_BLOCK_SUCCESS},
+			{Status: ChainBlockStatus_DEPLOYMENT_BLOCK_RUNNING},
+			{Status: ChainBlockStatus_DEPLOYMENT_BLOCK_PENDING},
+		},
+	}
+	dc.Cancel(10)
+
+	assert.Equal(t, ChainStatus_DEPLOYMENT_CHAIN_CANCELLED, dc.Status)
+	assert.Equal(t, int64(10), dc.CompletedAt)
+	assert.Equal(t, ChainBlockStatus_DEPLOYMENT_BLOCK_SUCCESS, dc.Blocks[0].Status)
+	assert.Equal(t, int64(0), dc.Blocks[0].CompletedAt)
+	assert.Equal(t, ChainBlockStatus_DEPLOYMENT_BLOCK_CANCELLED, dc.Blocks[1].Status)
+	assert.Equal(t, ChainBlockStatus_DEPLOYMENT_BLOCK_CANCELLED, dc.Blocks[2].Status)
+	assert.Equal(t, int64(10), dc.Blocks[2].CompletedAt)
+}
+
+func TestListNotCompletedDeployments(t *testing.T) {
+	t.Parallel()
+	running := &ChainDeploymentRef{DeploymentId: "dep2", Status: DeploymentStatus_DEPLOYMENT_RUNNING}
+	pending := &ChainDeploymentRef{DeploymentId: "dep3", Status: DeploymentStatus_DEPLOYMENT_PENDING}
+	dc := &DeploymentChain{
+		Blocks: []*ChainBlock{
+			{
+				Nodes: []*ChainNode{
+					{DeploymentRef: &ChainDeploymentRef{DeploymentId: "dep1", Status: DeploymentStatus_DEPLOYMENT_SUCCESS}},
+				},
+			},
+			{
+				Nodes: []*ChainNode{
+					{DeploymentRef: running},
+					{DeploymentRef: nil},
+				},
+			},
+			{
+				Nodes: []*ChainNode{
+					{DeploymentRef: pending},
+				},
+			},
+		},
+	}
+
+	refs := dc.ListNotCompletedDeployments()
+	assert.Len(t, refs, 2)
+	assert.Equal(t, dc.Blocks[1], refs[running])
+	assert.Equal(t, dc.Blocks[2], refs[pending])
+}
@@ -0,0 +1,133 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	// MetadataKeyDeploymentFreezeBypassJustification is the key of the command and deployment metadata
+	// storing the reason why a deployment freeze window was bypassed.
+	MetadataKeyDeploymentFreezeBypassJustification = "pipecd/deployment-freeze-bypass-justification"
+)
+
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// ValidateSchedule checks whether the time range of the window is well-formed or not.
+func (w *DeploymentFreezeWindow) ValidateSchedule() error {
+	if _, err := w.location(); err != nil {
+		return err
+	}
+	oneOff := w.StartAt != 0 || w.EndAt != 0
+	recurring := w.Cron != "" || w.Duration != 0
+	switch {
+	case oneOff && recurring:
+		return fmt.Errorf("window %s must be either one-off (startAt, endAt) or recurring (cron, duration)", w.Name)
+	case oneOff:
+		if w.EndAt <= w.StartAt {
+			return fmt.Errorf("endAt of window %s must be after its startAt", w.Name)
+		}
+	case recurring:
+		if _, err := cronParser.Parse(w.Cron); err != nil {
+			return fmt.Errorf("invalid cron expression of window %s: %w", w.Name, err)
+		}
+		if w.Duration <= 0 {
+			return fmt.Errorf("duration of window %s must be greater than zero", w.Name)
+		}
+	default:
+		return fmt.Errorf("window %s must specify either startAt and endAt, or cron and duration", w.Name)
+	}
+	return nil
+}
+
+// IsActive reports whether the given time falls inside the window.
+func (w *DeploymentFreezeWindow) IsActive(t time.Time) (bool, error) {
+	if w.Cron == "" {
+		return w.StartAt <= t.Unix() && t.Unix() < w.EndAt, nil
+	}
+
+	loc, err := w.location()
+	if err != nil {
+		return false, err
+	}
+	sched, err := cronParser.Parse(w.Cron)
+	if err != nil {
+		return false, err
+	}
+	// The window is active when its latest start is within the duration before t.
+	duration := time.Duration(w.Duration) * time.Second
+	start := sched.Next(t.In(loc).Add(-duration))
+	return !start.After(t), nil
+}
+
+// MatchLabels reports whether the given labels contain all labels of the window.
+func (w *DeploymentFreezeWindow) MatchLabels(labels map[string]string) bool {
+	for k, v := range w.Labels {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// Reason returns the human readable message describing why a deployment was frozen.
+func (w *DeploymentFreezeWindow) Reason() string {
+	if w.Description != "" {
+		return fmt.Sprintf("Deployments are frozen by window %q: %s", w.Name, w.Description)
+	}
+	return fmt.Sprintf("Deployments are frozen by window %q", w.Name)
+}
+
+func (w *DeploymentFreezeWindow) location() (*time.Location, error) {
+	if w.TimeZone == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone %s of window %s: %w", w.TimeZone, w.Name, err)
+	}
+	return loc, nil
+}
+
+// FindActiveDeploymentFreezeWindow returns the first window that matches the given labels
+// and is active at the given time. Nil is returned when no window freezes the deployment.
+func FindActiveDeploymentFreezeWindow(windows []*DeploymentFreezeWindow, labels map[string]string, t time.Time) (*DeploymentFreezeWindow, error) {
+	for _, w := range windows {
+		if !w.MatchLabels(labels) {
+			continue
+		}
+		active, err := w.IsActive(t)
+		if err != nil {
+			return nil, err
+		}
+		if active {
+			return w, nil
+		}
+	}
+	return nil, nil
+}
+
+// DeploymentFreezeBypassJustification returns the recorded reason why deployment freeze windows
+// were bypassed for this deployment. Empty string means the deployment is subject to the freeze.
+func (d *Deployment) DeploymentFreezeBypassJustification() string {
+	if v := d.Metadata[MetadataKeyDeploymentFreezeBypassJustification]; v != "" {
+		return v
+	}
+	return d.GetMetadataV2().GetShared().GetKeyValues()[MetadataKeyDeploymentFreezeBypassJustification]
+}
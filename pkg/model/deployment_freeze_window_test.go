@@ -0,0 +1,200 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentFreezeWindow_ValidateSchedule(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		window  *DeploymentFreezeWindow
+		wantErr bool
+	}{
+		{
+			name:   "valid one-off window",
+			window: &DeploymentFreezeWindow{Name: "w", StartAt: 100, EndAt: 200},
+		},
+		{
+			name:   "valid recurring window",
+			window: &DeploymentFreezeWindow{Name: "w", Cron: "0 18 * * FRI", Duration: 3600, TimeZone: "Asia/Tokyo"},
+		},
+		{
+			name:    "end before start",
+			window:  &DeploymentFreezeWindow{Name: "w", StartAt: 200, EndAt: 100},
+			wantErr: true,
+		},
+		{
+			name:    "both one-off and recurring",
+			window:  &DeploymentFreezeWindow{Name: "w", StartAt: 100, EndAt: 200, Cron: "0 18 * * FRI", Duration: 3600},
+			wantErr: true,
+		},
+		{
+			name:    "invalid cron",
+			window:  &DeploymentFreezeWindow{Name: "w", Cron: "every friday", Duration: 3600},
+			wantErr: true,
+		},
+		{
+			name:    "missing duration",
+			window:  &DeploymentFreezeWindow{Name: "w", Cron: "0 18 * * FRI"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid time zone",
+			window:  &DeploymentFreezeWindow{Name: "w", StartAt: 100, EndAt: 200, TimeZone: "Mars/Olympus"},
+			wantErr: true,
+		},
+		{
+			name:    "empty schedule",
+			window:  &DeploymentFreezeWindow{Name: "w"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.window.ValidateSchedule()
+			assert.Equal(t, tc.wantErr, err != nil, err)
+		})
+	}
+}
+
+func TestDeploymentFreezeWindow_IsActive(t *testing.T) {
+	t.Parallel()
+
+	// Friday 18:00 JST lasting for the whole weekend.
+	weekend := &DeploymentFreezeWindow{
+		Name:     "weekend",
+		TimeZone: "Asia/Tokyo",
+		Cron:     "0 18 * * FRI",
+		Duration: int64((62 * time.Hour).Seconds()),
+	}
+	jst := time.FixedZone("JST", 9*60*60)
+
+	testcases := []struct {
+		name   string
+		window *DeploymentFreezeWindow
+		now    time.Time
+		want   bool
+	}{
+		{
+			name:   "one-off: before start",
+			window: &DeploymentFreezeWindow{StartAt: 100, EndAt: 200},
+			now:    time.Unix(99, 0),
+			want:   false,
+		},
+		{
+			name:   "one-off: at start",
+			window: &DeploymentFreezeWindow{StartAt: 100, EndAt: 200},
+			now:    time.Unix(100, 0),
+			want:   true,
+		},
+		{
+			name:   "one-off: at end",
+			window: &DeploymentFreezeWindow{StartAt: 100, EndAt: 200},
+			now:    time.Unix(200, 0),
+			want:   false,
+		},
+		{
+			name:   "recurring: friday afternoon",
+			window: weekend,
+			now:    time.Date(2024, 3, 1, 17, 59, 0, 0, jst),
+			want:   false,
+		},
+		{
+			name:   "recurring: friday evening",
+			window: weekend,
+			now:    time.Date(2024, 3, 1, 18, 0, 0, 0, jst),
+			want:   true,
+		},
+		{
+			name:   "recurring: friday evening in UTC",
+			window: weekend,
+			now:    time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC),
+			want:   true,
+		},
+		{
+			name:   "recurring: sunday",
+			window: weekend,
+			now:    time.Date(2024, 3, 3, 12, 0, 0, 0, jst),
+			want:   true,
+		},
+		{
+			name:   "recurring: monday morning",
+			window: weekend,
+			now:    time.Date(2024, 3, 4, 8, 0, 0, 0, jst),
+			want:   false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tc.window.IsActive(tc.now)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestFindActiveDeploymentFreezeWindow(t *testing.T) {
+	t.Parallel()
+
+	windows := []*DeploymentFreezeWindow{
+		{Name: "prod-only", Labels: map[string]string{"env": "prod"}, StartAt: 100, EndAt: 200},
+		{Name: "everything", StartAt: 300, EndAt: 400},
+	}
+
+	w, err := FindActiveDeploymentFreezeWindow(windows, map[string]string{"env": "prod", "team": "a"}, time.Unix(150, 0))
+	require.NoError(t, err)
+	require.NotNil(t, w)
+	assert.Equal(t, "prod-only", w.Name)
+
+	w, err = FindActiveDeploymentFreezeWindow(windows, map[string]string{"env": "dev"}, time.Unix(150, 0))
+	require.NoError(t, err)
+	assert.Nil(t, w)
+
+	w, err = FindActiveDeploymentFreezeWindow(windows, nil, time.Unix(350, 0))
+	require.NoError(t, err)
+	require.NotNil(t, w)
+	assert.Equal(t, "everything", w.Name)
+}
+
+func TestDeployment_DeploymentFreezeBypassJustification(t *testing.T) {
+	t.Parallel()
+
+	d := &Deployment{}
+	assert.Equal(t, "", d.DeploymentFreezeBypassJustification())
+
+	d = &Deployment{
+		Metadata: map[string]string{MetadataKeyDeploymentFreezeBypassJustification: "hotfix"},
+	}
+	assert.Equal(t, "hotfix", d.DeploymentFreezeBypassJustification())
+
+	d = &Deployment{
+		MetadataV2: &DeploymentMetadata{
+			Shared: &DeploymentMetadata_KeyValues{
+				KeyValues: map[string]string{MetadataKeyDeploymentFreezeBypassJustification: "hotfix"},
+			},
+		},
+	}
+	assert.Equal(t, "hotfix", d.DeploymentFreezeBypassJustification())
+}
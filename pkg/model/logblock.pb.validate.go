@@ -81,6 +81,8 @@ func (m *LogBlock) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Fields
+
 	if m.GetCreatedAt() <= 0 {
 		err := LogBlockValidationError{
 			field:  "CreatedAt",
@@ -35,6 +35,54 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PolicyViolation_Action int32
+
+const (
+	// The deployment fails.
+	PolicyViolation_DENY PolicyViolation_Action = 0
+	// The deployment requires an approval before being applied.
+	PolicyViolation_REQUIRE_APPROVAL PolicyViolation_Action = 1
+)
+
+// Enum value maps for PolicyViolation_Action.
+var (
+	PolicyViolation_Action_name = map[int32]string{
+		0: "DENY",
+		1: "REQUIRE_APPROVAL",
+	}
+	PolicyViolation_Action_value = map[string]int32{
+		"DENY":             0,
+		"REQUIRE_APPROVAL": 1,
+	}
+)
+
+func (x PolicyViolation_Action) Enum() *PolicyViolation_Action {
+	p := new(PolicyViolation_Action)
+	*p = x
+	return p
+}
+
+func (x PolicyViolation_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PolicyViolation_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_model_planpreview_proto_enumTypes[0].Descriptor()
+}
+
+func (PolicyViolation_Action) Type() protoreflect.EnumType {
+	return &file_pkg_model_planpreview_proto_enumTypes[0]
+}
+
+func (x PolicyViolation_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PolicyViolation_Action.Descriptor instead.
+func (PolicyViolation_Action) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_model_planpreview_proto_rawDescGZIP(), []int{3, 0}
+}
+
 type PlanPreviewCommandResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -163,6 +211,8 @@ type ApplicationPlanPreviewResult struct {
 	PluginPlanResults []*PluginPlanPreviewResult `protobuf:"bytes,34,rep,name=plugin_plan_results,json=pluginPlanResults,proto3" json:"plugin_plan_results,omitempty"`
 	// Note: In pipedv1, this will not be empty. It will be "<unknown>" if plugins are not successfully loaded.
 	PluginNames []string `protobuf:"bytes,35,rep,name=plugin_names,json=pluginNames,proto3" json:"plugin_names,omitempty"`
+	// Violations of the policies configured in piped.
+	PolicyViolations []*PolicyViolation `protobuf:"bytes,36,rep,name=policy_violations,json=policyViolations,proto3" json:"policy_violations,omitempty"`
 	// Error while building planpreview result.
 	Error     string `protobuf:"bytes,40,opt,name=error,proto3" json:"error,omitempty"`
 	CreatedAt int64  `protobuf:"varint,90,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
@@ -315,6 +365,13 @@ func (x *ApplicationPlanPreviewResult) GetPluginNames() []string {
 	return nil
 }
 
+func (x *ApplicationPlanPreviewResult) GetPolicyViolations() []*PolicyViolation {
+	if x != nil {
+		return x.PolicyViolations
+	}
+	return nil
+}
+
 func (x *ApplicationPlanPreviewResult) GetError() string {
 	if x != nil {
 		return x.Error
@@ -411,6 +468,70 @@ func (x *PluginPlanPreviewResult) GetDiffLanguage() string {
 	return ""
 }
 
+type PolicyViolation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the violated rule.
+	Rule    string                 `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Action  PolicyViolation_Action `protobuf:"varint,3,opt,name=action,proto3,enum=model.PolicyViolation_Action" json:"action,omitempty"`
+}
+
+func (x *PolicyViolation) Reset() {
+	*x = PolicyViolation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_model_planpreview_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PolicyViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyViolation) ProtoMessage() {}
+
+func (x *PolicyViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_model_planpreview_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyViolation.ProtoReflect.Descriptor instead.
+func (*PolicyViolation) Descriptor() ([]byte, []int) {
+	return file_pkg_model_planpreview_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PolicyViolation) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *PolicyViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PolicyViolation) GetAction() PolicyViolation_Action {
+	if x != nil {
+		return x.Action
+	}
+	return PolicyViolation_DENY
+}
+
 var File_pkg_model_planpreview_proto protoreflect.FileDescriptor
 
 var file_pkg_model_planpreview_proto_rawDesc = []byte{
@@ -435,7 +556,7 @@ var file_pkg_model_planpreview_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
 	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x69, 0x70, 0x65, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x69, 0x70, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22,
-	0xb8, 0x07, 0x0a, 0x1c, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0xfd, 0x07, 0x0a, 0x1c, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
 	0x6c, 0x61, 0x6e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
 	0x12, 0x2e, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
@@ -486,31 +607,47 @@ var file_pkg_model_planpreview_proto_rawDesc = []byte{
 	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x11, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x50, 0x6c, 0x61,
 	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x75, 0x67,
 	0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x23, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
-	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x5a, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62,
-	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x09, 0x22, 0xd3, 0x01, 0x0a, 0x17, 0x50,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x28, 0x0a, 0x0b, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x72, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x73, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x6c, 0x61,
-	0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x6e,
-	0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b,
-	0x70, 0x6c, 0x61, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64,
-	0x69, 0x66, 0x66, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x66, 0x66, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
-	0x42, 0x25, 0x5a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
-	0x69, 0x70, 0x65, 0x2d, 0x63, 0x64, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x63, 0x64, 0x2f, 0x70, 0x6b,
-	0x67, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x11, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x24, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22,
+	0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x39,
+	0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x09, 0x22,
+	0xd3, 0x01, 0x0a, 0x17, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x28, 0x0a, 0x0b, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c,
+	0x61, 0x6e, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0b, 0x70, 0x6c, 0x61, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x21, 0x0a,
+	0x0c, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x66, 0x66, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x66, 0x66, 0x4c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0xb3, 0x01, 0x0a, 0x0f, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x04, 0x72, 0x75, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x3f, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56,
+	0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x28, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x44,
+	0x45, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45,
+	0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x10, 0x01, 0x42, 0x25, 0x5a, 0x23, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x2d, 0x63,
+	0x64, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x63, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -525,26 +662,31 @@ func file_pkg_model_planpreview_proto_rawDescGZIP() []byte {
 	return file_pkg_model_planpreview_proto_rawDescData
 }
 
-var file_pkg_model_planpreview_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_pkg_model_planpreview_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pkg_model_planpreview_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_pkg_model_planpreview_proto_goTypes = []interface{}{
-	(*PlanPreviewCommandResult)(nil),     // 0: model.PlanPreviewCommandResult
-	(*ApplicationPlanPreviewResult)(nil), // 1: model.ApplicationPlanPreviewResult
-	(*PluginPlanPreviewResult)(nil),      // 2: model.PluginPlanPreviewResult
-	nil,                                  // 3: model.ApplicationPlanPreviewResult.LabelsEntry
-	(ApplicationKind)(0),                 // 4: model.ApplicationKind
-	(SyncStrategy)(0),                    // 5: model.SyncStrategy
+	(PolicyViolation_Action)(0),          // 0: model.PolicyViolation.Action
+	(*PlanPreviewCommandResult)(nil),     // 1: model.PlanPreviewCommandResult
+	(*ApplicationPlanPreviewResult)(nil), // 2: model.ApplicationPlanPreviewResult
+	(*PluginPlanPreviewResult)(nil),      // 3: model.PluginPlanPreviewResult
+	(*PolicyViolation)(nil),              // 4: model.PolicyViolation
+	nil,                                  // 5: model.ApplicationPlanPreviewResult.LabelsEntry
+	(ApplicationKind)(0),                 // 6: model.ApplicationKind
+	(SyncStrategy)(0),                    // 7: model.SyncStrategy
 }
 var file_pkg_model_planpreview_proto_depIdxs = []int32{
-	1, // 0: model.PlanPreviewCommandResult.results:type_name -> model.ApplicationPlanPreviewResult
-	4, // 1: model.ApplicationPlanPreviewResult.application_kind:type_name -> model.ApplicationKind
-	3, // 2: model.ApplicationPlanPreviewResult.labels:type_name -> model.ApplicationPlanPreviewResult.LabelsEntry
-	5, // 3: model.ApplicationPlanPreviewResult.sync_strategy:type_name -> model.SyncStrategy
-	2, // 4: model.ApplicationPlanPreviewResult.plugin_plan_results:type_name -> model.PluginPlanPreviewResult
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	2, // 0: model.PlanPreviewCommandResult.results:type_name -> model.ApplicationPlanPreviewResult
+	6, // 1: model.ApplicationPlanPreviewResult.application_kind:type_name -> model.ApplicationKind
+	5, // 2: model.ApplicationPlanPreviewResult.labels:type_name -> model.ApplicationPlanPreviewResult.LabelsEntry
+	7, // 3: model.ApplicationPlanPreviewResult.sync_strategy:type_name -> model.SyncStrategy
+	3, // 4: model.ApplicationPlanPreviewResult.plugin_plan_results:type_name -> model.PluginPlanPreviewResult
+	4, // 5: model.ApplicationPlanPreviewResult.policy_violations:type_name -> model.PolicyViolation
+	0, // 6: model.PolicyViolation.action:type_name -> model.PolicyViolation.Action
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_pkg_model_planpreview_proto_init() }
@@ -590,19 +732,32 @@ func file_pkg_model_planpreview_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_model_planpreview_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyViolation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_model_planpreview_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      1,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_pkg_model_planpreview_proto_goTypes,
 		DependencyIndexes: file_pkg_model_planpreview_proto_depIdxs,
+		EnumInfos:         file_pkg_model_planpreview_proto_enumTypes,
 		MessageInfos:      file_pkg_model_planpreview_proto_msgTypes,
 	}.Build()
 	File_pkg_model_planpreview_proto = out.File
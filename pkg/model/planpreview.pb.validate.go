@@ -355,6 +355,40 @@ func (m *ApplicationPlanPreviewResult) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetPolicyViolations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ApplicationPlanPreviewResultValidationError{
+						field:  fmt.Sprintf("PolicyViolations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ApplicationPlanPreviewResultValidationError{
+						field:  fmt.Sprintf("PolicyViolations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ApplicationPlanPreviewResultValidationError{
+					field:  fmt.Sprintf("PolicyViolations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	// no validation rules for Error
 
 	if m.GetCreatedAt() <= 0 {
@@ -569,3 +603,127 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = PluginPlanPreviewResultValidationError{}
+
+// Validate checks the field values on PolicyViolation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PolicyViolation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyViolation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PolicyViolationMultiError, or nil if none found.
+func (m *PolicyViolation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyViolation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRule()) < 1 {
+		err := PolicyViolationValidationError{
+			field:  "Rule",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Message
+
+	if _, ok := PolicyViolation_Action_name[int32(m.GetAction())]; !ok {
+		err := PolicyViolationValidationError{
+			field:  "Action",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PolicyViolationMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyViolationMultiError is an error wrapping multiple validation errors
+// returned by PolicyViolation.ValidateAll() if the designated constraints
+// aren't met.
+type PolicyViolationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyViolationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyViolationMultiError) AllErrors() []error { return m }
+
+// PolicyViolationValidationError is the validation error returned by
+// PolicyViolation.Validate if the designated constraints aren't met.
+type PolicyViolationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyViolationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyViolationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyViolationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyViolationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyViolationValidationError) ErrorName() string { return "PolicyViolationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PolicyViolationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyViolation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyViolationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyViolationValidationError{}
@@ -19,15 +19,18 @@ import (
 	"crypto/subtle"
 	"fmt"
 	"net/url"
+	"slices"
 
 	"github.com/coreos/go-oidc/v3/oidc"
 	"golang.org/x/crypto/bcrypt"
 	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/endpoints"
 	"golang.org/x/oauth2/github"
 )
 
 var (
 	githubScopes = []string{"read:org"}
+	googleScopes = []string{oidc.ScopeOpenID, "email", "profile"}
 
 	builtinAdminRBACRole = &ProjectRBACRole{
 		Name:      BuiltinRBACRoleAdmin.String(),
@@ -172,6 +175,9 @@ func (p *Project) RedactSensitiveData() {
 	if p.StaticAdmin != nil {
 		p.StaticAdmin.RedactSensitiveData()
 	}
+	for _, u := range p.StaticUsers {
+		u.RedactSensitiveData()
+	}
 	if p.Sso != nil {
 		p.Sso.RedactSensitiveData()
 	}
@@ -180,6 +186,9 @@ func (p *Project) RedactSensitiveData() {
 // RedactSensitiveData redacts sensitive data.
 func (p *ProjectStaticUser) RedactSensitiveData() {
 	p.PasswordHash = redactedMessage
+	if p.TotpSecret != "" {
+		p.TotpSecret = redactedMessage
+	}
 }
 
 // Update updates ProjectStaticUser with given data.
@@ -216,39 +225,64 @@ func (p *ProjectStaticUser) Auth(username, password string) error {
 
 // RedactSensitiveData redacts sensitive data.
 func (p *ProjectSSOConfig) RedactSensitiveData() {
-	if p.Github == nil {
-		return
+	if p.Github != nil {
+		p.Github.RedactSensitiveData()
+	}
+	if p.Google != nil {
+		p.Google.RedactSensitiveData()
 	}
-	p.Github.RedactSensitiveData()
 }
 
 // Update updates ProjectSSOConfig with given data.
 func (p *ProjectSSOConfig) Update(sso *ProjectSSOConfig) error {
 	p.Provider = sso.Provider
-	if sso.Github == nil {
-		return nil
+	if sso.Github != nil {
+		if p.Github == nil {
+			p.Github = &ProjectSSOConfig_GitHub{}
+		}
+		if err := p.Github.Update(sso.Github); err != nil {
+			return err
+		}
 	}
-
-	if p.Github == nil {
-		p.Github = &ProjectSSOConfig_GitHub{}
+	if sso.Google != nil {
+		if p.Google == nil {
+			p.Google = &ProjectSSOConfig_Google{}
+		}
+		if err := p.Google.Update(sso.Google); err != nil {
+			return err
+		}
 	}
-	return p.Github.Update(sso.Github)
+	return nil
 }
 
 // Encrypt encrypts sensitive data in ProjectSSOConfig.
 func (p *ProjectSSOConfig) Encrypt(encrypter encrypter) error {
-	if p.Github == nil {
-		return nil
+	if p.Github != nil {
+		if err := p.Github.Encrypt(encrypter); err != nil {
+			return err
+		}
 	}
-	return p.Github.Encrypt(encrypter)
+	if p.Google != nil {
+		if err := p.Google.Encrypt(encrypter); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Decrypt decrypts encrypted data in ProjectSSOConfig.
 func (p *ProjectSSOConfig) Decrypt(decrypter decrypter) error {
-	if p.Github == nil {
-		return nil
+	if p.Github != nil {
+		if err := p.Github.Decrypt(decrypter); err != nil {
+			return err
+		}
 	}
-	return p.Github.Decrypt(decrypter)
+	if p.Google != nil {
+		if err := p.Google.Decrypt(decrypter); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // GenerateAuthCodeURL generates an auth URL for the specified configuration.
@@ -259,6 +293,11 @@ func (p *ProjectSSOConfig) GenerateAuthCodeURL(project, callbackURL, state strin
 			return "", fmt.Errorf("missing GitHub oauth in the SSO configuration")
 		}
 		return p.Github.GenerateAuthCodeURL(project, callbackURL, state)
+	case ProjectSSOConfig_GOOGLE:
+		if p.Google == nil {
+			return "", fmt.Errorf("missing Google oauth in the SSO configuration")
+		}
+		return p.Google.GenerateAuthCodeURL(project, callbackURL, state)
 	case ProjectSSOConfig_OIDC:
 		if p.Oidc == nil {
 			return "", fmt.Errorf("missing OIDC oauth in the SSO configuration")
@@ -351,6 +390,90 @@ func (p *ProjectSSOConfig_GitHub) GenerateAuthCodeURL(project, callbackURL, stat
 	return authURL, nil
 }
 
+// RedactSensitiveData redacts sensitive data.
+func (p *ProjectSSOConfig_Google) RedactSensitiveData() {
+	p.ClientId = redactedMessage
+	p.ClientSecret = redactedMessage
+	if p.ServiceAccountKey != "" {
+		p.ServiceAccountKey = redactedMessage
+	}
+}
+
+// Update updates ProjectSSOConfig with given data.
+func (p *ProjectSSOConfig_Google) Update(input *ProjectSSOConfig_Google) error {
+	if input.ClientId != "" {
+		p.ClientId = input.ClientId
+	}
+	if input.ClientSecret != "" {
+		p.ClientSecret = input.ClientSecret
+	}
+	if input.ProxyUrl != "" {
+		p.ProxyUrl = input.ProxyUrl
+	}
+	if input.HostedDomain != "" {
+		p.HostedDomain = input.HostedDomain
+	}
+	if input.ServiceAccountKey != "" {
+		p.ServiceAccountKey = input.ServiceAccountKey
+	}
+	if input.AdminEmail != "" {
+		p.AdminEmail = input.AdminEmail
+	}
+	return nil
+}
+
+// Encrypt encrypts sensitive data in ProjectSSOConfig.
+func (p *ProjectSSOConfig_Google) Encrypt(encrypter encrypter) error {
+	for _, v := range []*string{&p.ClientId, &p.ClientSecret, &p.ServiceAccountKey} {
+		if *v == "" {
+			continue
+		}
+		encrypted, err := encrypter.Encrypt(*v)
+		if err != nil {
+			return err
+		}
+		*v = encrypted
+	}
+	return nil
+}
+
+// Decrypt decrypts ProjectSSOConfig.
+func (p *ProjectSSOConfig_Google) Decrypt(decrypter decrypter) error {
+	for _, v := range []*string{&p.ClientId, &p.ClientSecret, &p.ServiceAccountKey} {
+		if *v == "" {
+			continue
+		}
+		decrypted, err := decrypter.Decrypt(*v)
+		if err != nil {
+			return err
+		}
+		*v = decrypted
+	}
+	return nil
+}
+
+// GenerateAuthCodeURL generates an auth URL for the specified configuration.
+// Since Google requires the redirect URL to exactly match the registered one,
+// the project is passed through the state instead of the query parameters.
+func (p *ProjectSSOConfig_Google) GenerateAuthCodeURL(project, callbackURL, state string) (string, error) {
+	cfg := oauth2.Config{
+		ClientID:    p.ClientId,
+		Endpoint:    endpoints.Google,
+		Scopes:      googleScopes,
+		RedirectURL: callbackURL,
+	}
+
+	opts := []oauth2.AuthCodeOption{oauth2.ApprovalForce, oauth2.AccessTypeOnline}
+	if p.HostedDomain != "" {
+		opts = append(opts, oauth2.SetAuthURLParam("hd", p.HostedDomain))
+	}
+
+	state = fmt.Sprintf("%s:%s", state, project)
+	authURL := cfg.AuthCodeURL(state, opts...)
+
+	return authURL, nil
+}
+
 // GenerateAuthCodeURL generates an auth URL for the specified configuration.
 func (p *ProjectSSOConfig_Oidc) GenerateAuthCodeURL(project, state string) (string, error) {
 	ctx := context.Background()
@@ -476,6 +599,100 @@ func (p *Project) DeleteUserGroup(sso string) error {
 	return fmt.Errorf("%s user group does not exist", sso)
 }
 
+// AddDeploymentFreezeWindow adds a deployment freeze window.
+func (p *Project) AddDeploymentFreezeWindow(w *DeploymentFreezeWindow) error {
+	if err := w.Validate(); err != nil {
+		return err
+	}
+	if err := w.ValidateSchedule(); err != nil {
+		return err
+	}
+	for _, v := range p.DeploymentFreezeWindows {
+		if v.Name == w.Name {
+			return fmt.Errorf("%s is already being used. The window name must be unique", w.Name)
+		}
+	}
+	p.DeploymentFreezeWindows = append(p.DeploymentFreezeWindows, w)
+	return nil
+}
+
+// DeleteDeploymentFreezeWindow deletes a deployment freeze window.
+func (p *Project) DeleteDeploymentFreezeWindow(name string) error {
+	for i, v := range p.DeploymentFreezeWindows {
+		if v.Name == name {
+			c := copy(p.DeploymentFreezeWindows[i:], p.DeploymentFreezeWindows[i+1:])
+			p.DeploymentFreezeWindows = p.DeploymentFreezeWindows[:i+c]
+			return nil
+		}
+	}
+	return fmt.Errorf("%s deployment freeze window does not exist", name)
+}
+
+// FindStaticUser returns the static admin or the static user who has the given username.
+func (p *Project) FindStaticUser(username string) (*ProjectStaticUser, bool) {
+	if p.IsStaticAdmin(username) {
+		return p.StaticAdmin, true
+	}
+	for _, u := range p.StaticUsers {
+		if u.Username == username {
+			return u, true
+		}
+	}
+	return nil, false
+}
+
+// IsStaticAdmin reports whether the given username is the one of the static admin.
+func (p *Project) IsStaticAdmin(username string) bool {
+	return p.StaticAdmin != nil && p.StaticAdmin.Username == username
+}
+
+// AddStaticUser adds a static user who has the given role.
+func (p *Project) AddStaticUser(username, password, role string) error {
+	if username == "" || password == "" {
+		return fmt.Errorf("username and password are required")
+	}
+	if _, ok := p.FindStaticUser(username); ok {
+		return fmt.Errorf("%s is already being used. The username must be unique", username)
+	}
+	if !p.HasRBACRole(role) && !isBuiltinRBACRole(role) {
+		return fmt.Errorf("%s role does not exist", role)
+	}
+	u := &ProjectStaticUser{Role: role}
+	if err := u.Update(username, password); err != nil {
+		return err
+	}
+	p.StaticUsers = append(p.StaticUsers, u)
+	return nil
+}
+
+// DeleteStaticUser deletes a static user.
+// The static admin cannot be deleted by this.
+func (p *Project) DeleteStaticUser(username string) error {
+	for i, u := range p.StaticUsers {
+		if u.Username == username {
+			c := copy(p.StaticUsers[i:], p.StaticUsers[i+1:])
+			p.StaticUsers = p.StaticUsers[:i+c]
+			return nil
+		}
+	}
+	return fmt.Errorf("%s static user does not exist", username)
+}
+
+// UpdateStaticUserRole changes the role of a static user.
+// The static admin always has the Admin role so it cannot be changed.
+func (p *Project) UpdateStaticUserRole(username, role string) error {
+	if !p.HasRBACRole(role) && !isBuiltinRBACRole(role) {
+		return fmt.Errorf("%s role does not exist", role)
+	}
+	for _, u := range p.StaticUsers {
+		if u.Username == username {
+			u.Role = role
+			return nil
+		}
+	}
+	return fmt.Errorf("%s static user does not exist", username)
+}
+
 // SetBuiltinRBACRoles sets built-in roles.
 func (p *Project) SetBuiltinRBACRoles() {
 	builtin := []*ProjectRBACRole{
@@ -534,6 +751,11 @@ func (p *Project) DeleteRBACRole(name string) error {
 	if isBuiltinRBACRole(name) {
 		return fmt.Errorf("built-in role cannot be deleted")
 	}
+	for _, u := range p.StaticUsers {
+		if u.Role == name {
+			return fmt.Errorf("%s role is being used by static user %s", name, u.Username)
+		}
+	}
 	for i, v := range p.RbacRoles {
 		if v.Name == name {
 			c := copy(p.RbacRoles[i:], p.RbacRoles[i+1:])
@@ -544,6 +766,19 @@ func (p *Project) DeleteRBACRole(name string) error {
 	return fmt.Errorf("%s role does nott exist", name)
 }
 
+// HasRBACPermission reports whether any of the given roles allows the action on the resource.
+func (p *Project) HasRBACPermission(roles []string, typ ProjectRBACResource_ResourceType, action ProjectRBACPolicy_Action) bool {
+	for _, r := range p.RbacRoles {
+		if !slices.Contains(roles, r.Name) {
+			continue
+		}
+		if r.HasPermission(typ, action) {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *ProjectRBACRole) HasPermission(typ ProjectRBACResource_ResourceType, action ProjectRBACPolicy_Action) bool {
 	for _, v := range p.Policies {
 		if v.HasPermission(typ, action) {
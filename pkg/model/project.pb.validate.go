@@ -241,6 +241,74 @@ func (m *Project) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetDeploymentFreezeWindows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProjectValidationError{
+						field:  fmt.Sprintf("DeploymentFreezeWindows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProjectValidationError{
+						field:  fmt.Sprintf("DeploymentFreezeWindows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProjectValidationError{
+					field:  fmt.Sprintf("DeploymentFreezeWindows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetStaticUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProjectValidationError{
+						field:  fmt.Sprintf("StaticUsers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProjectValidationError{
+						field:  fmt.Sprintf("StaticUsers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProjectValidationError{
+					field:  fmt.Sprintf("StaticUsers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if m.GetCreatedAt() <= 0 {
 		err := ProjectValidationError{
 			field:  "CreatedAt",
@@ -384,6 +452,12 @@ func (m *ProjectStaticUser) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Role
+
+	// no validation rules for TotpSecret
+
+	// no validation rules for TotpEnabled
+
 	if len(errors) > 0 {
 		return ProjectStaticUserMultiError(errors)
 	}
@@ -903,6 +977,160 @@ var _ interface {
 	ErrorName() string
 } = ProjectUserGroupValidationError{}
 
+// Validate checks the field values on DeploymentFreezeWindow with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeploymentFreezeWindow) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeploymentFreezeWindow with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeploymentFreezeWindowMultiError, or nil if none found.
+func (m *DeploymentFreezeWindow) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeploymentFreezeWindow) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := DeploymentFreezeWindowValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	// no validation rules for Labels
+
+	// no validation rules for TimeZone
+
+	if m.GetStartAt() < 0 {
+		err := DeploymentFreezeWindowValidationError{
+			field:  "StartAt",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEndAt() < 0 {
+		err := DeploymentFreezeWindowValidationError{
+			field:  "EndAt",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Cron
+
+	if m.GetDuration() < 0 {
+		err := DeploymentFreezeWindowValidationError{
+			field:  "Duration",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeploymentFreezeWindowMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeploymentFreezeWindowMultiError is an error wrapping multiple validation
+// errors returned by DeploymentFreezeWindow.ValidateAll() if the designated
+// constraints aren't met.
+type DeploymentFreezeWindowMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeploymentFreezeWindowMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeploymentFreezeWindowMultiError) AllErrors() []error { return m }
+
+// DeploymentFreezeWindowValidationError is the validation error returned by
+// DeploymentFreezeWindow.Validate if the designated constraints aren't met.
+type DeploymentFreezeWindowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeploymentFreezeWindowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeploymentFreezeWindowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeploymentFreezeWindowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeploymentFreezeWindowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeploymentFreezeWindowValidationError) ErrorName() string {
+	return "DeploymentFreezeWindowValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeploymentFreezeWindowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeploymentFreezeWindow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeploymentFreezeWindowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeploymentFreezeWindowValidationError{}
+
 // Validate checks the field values on ProjectRBACRole with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -1559,6 +1787,14 @@ func (m *ProjectSSOConfig_Google) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for ProxyUrl
+
+	// no validation rules for HostedDomain
+
+	// no validation rules for ServiceAccountKey
+
+	// no validation rules for AdminEmail
+
 	if len(errors) > 0 {
 		return ProjectSSOConfig_GoogleMultiError(errors)
 	}
@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -47,6 +48,13 @@ func TestRedactSensitiveData(t *testing.T) {
 				StaticAdmin: &ProjectStaticUser{
 					PasswordHash: "raw",
 				},
+				StaticUsers: []*ProjectStaticUser{
+					{
+						PasswordHash: "raw",
+						TotpSecret:   "raw",
+						TotpEnabled:  true,
+					},
+				},
 				Sso: &ProjectSSOConfig{
 					Github: &ProjectSSOConfig_GitHub{
 						ClientId:     "raw",
@@ -58,6 +66,13 @@ func TestRedactSensitiveData(t *testing.T) {
 				StaticAdmin: &ProjectStaticUser{
 					PasswordHash: "redacted",
 				},
+				StaticUsers: []*ProjectStaticUser{
+					{
+						PasswordHash: "redacted",
+						TotpSecret:   "redacted",
+						TotpEnabled:  true,
+					},
+				},
 				Sso: &ProjectSSOConfig{
 					Github: &ProjectSSOConfig_GitHub{
 						ClientId:     "redacted",
@@ -133,6 +148,29 @@ func TestUpdateProjectSSOConfig(t *testing.T) {
 				Google: nil,
 			},
 		},
+		{
+			name: "update google",
+			sso: &ProjectSSOConfig{
+				Provider: ProjectSSOConfig_GOOGLE,
+				Google: &ProjectSSOConfig_Google{
+					ClientId:          "updated-client-id",
+					ClientSecret:      "updated-client-secret",
+					HostedDomain:      "example.com",
+					ServiceAccountKey: "updated-service-account-key",
+					AdminEmail:        "admin@example.com",
+				},
+			},
+			expect: &ProjectSSOConfig{
+				Provider: ProjectSSOConfig_GOOGLE,
+				Google: &ProjectSSOConfig_Google{
+					ClientId:          "updated-client-id",
+					ClientSecret:      "updated-client-secret",
+					HostedDomain:      "example.com",
+					ServiceAccountKey: "updated-service-account-key",
+					AdminEmail:        "admin@example.com",
+				},
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -173,6 +211,27 @@ func TestEncrypt(t *testing.T) {
 				Google: nil,
 			},
 		},
+		{
+			name: "encrypt google",
+			sso: &ProjectSSOConfig{
+				Provider: ProjectSSOConfig_GOOGLE,
+				Google: &ProjectSSOConfig_Google{
+					ClientId:          "client-id",
+					ClientSecret:      "client-secret",
+					HostedDomain:      "example.com",
+					ServiceAccountKey: "service-account-key",
+				},
+			},
+			expect: &ProjectSSOConfig{
+				Provider: ProjectSSOConfig_GOOGLE,
+				Google: &ProjectSSOConfig_Google{
+					ClientId:          "encrypted-client-id",
+					ClientSecret:      "encrypted-client-secret",
+					HostedDomain:      "example.com",
+					ServiceAccountKey: "encrypted-service-account-key",
+				},
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -214,6 +273,25 @@ func TestDecrypt(t *testing.T) {
 				Google: nil,
 			},
 		},
+		{
+			name: "decrypt google",
+			sso: &ProjectSSOConfig{
+				Provider: ProjectSSOConfig_GOOGLE,
+				Google: &ProjectSSOConfig_Google{
+					ClientId:     "client-id",
+					ClientSecret: "client-secret",
+					HostedDomain: "example.com",
+				},
+			},
+			expect: &ProjectSSOConfig{
+				Provider: ProjectSSOConfig_GOOGLE,
+				Google: &ProjectSSOConfig_Google{
+					ClientId:     "decrypted-client-id",
+					ClientSecret: "decrypted-client-secret",
+					HostedDomain: "example.com",
+				},
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -536,6 +614,169 @@ func TestProject_DeleteUserGroup(t *testing.T) {
 	}
 }
 
+func TestProject_AddStaticUser(t *testing.T) {
+	testcases := []struct {
+		name     string
+		username string
+		password string
+		role     string
+		project  *Project
+		wantErr  bool
+	}{
+		{
+			name:     "ok",
+			username: "alice",
+			password: "password",
+			role:     "Editor",
+			project: &Project{
+				StaticAdmin: &ProjectStaticUser{Username: "admin"},
+			},
+		},
+		{
+			name:     "the username is used by the static admin",
+			username: "admin",
+			password: "password",
+			role:     "Editor",
+			project: &Project{
+				StaticAdmin: &ProjectStaticUser{Username: "admin"},
+			},
+			wantErr: true,
+		},
+		{
+			name:     "the username is used by another static user",
+			username: "alice",
+			password: "password",
+			role:     "Editor",
+			project: &Project{
+				StaticUsers: []*ProjectStaticUser{
+					{Username: "alice", Role: "Viewer"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:     "the role doesn't exist",
+			username: "alice",
+			password: "password",
+			role:     "Tester",
+			project:  &Project{},
+			wantErr:  true,
+		},
+		{
+			name:     "missing password",
+			username: "alice",
+			role:     "Editor",
+			project:  &Project{},
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.project.AddStaticUser(tc.username, tc.password, tc.role)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if err != nil {
+				return
+			}
+			u, ok := tc.project.FindStaticUser(tc.username)
+			require.True(t, ok)
+			assert.Equal(t, tc.role, u.Role)
+			assert.NoError(t, u.Auth(tc.username, tc.password))
+		})
+	}
+}
+
+func TestProject_DeleteStaticUser(t *testing.T) {
+	testcases := []struct {
+		name     string
+		username string
+		project  *Project
+		expected []*ProjectStaticUser
+		wantErr  bool
+	}{
+		{
+			name:     "ok",
+			username: "alice",
+			project: &Project{
+				StaticUsers: []*ProjectStaticUser{
+					{Username: "alice", Role: "Viewer"},
+					{Username: "bob", Role: "Editor"},
+				},
+			},
+			expected: []*ProjectStaticUser{
+				{Username: "bob", Role: "Editor"},
+			},
+		},
+		{
+			name:     "the static admin cannot be deleted",
+			username: "admin",
+			project: &Project{
+				StaticAdmin: &ProjectStaticUser{Username: "admin"},
+			},
+			wantErr: true,
+		},
+		{
+			name:     "the user doesn't exist",
+			username: "alice",
+			project:  &Project{},
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.project.DeleteStaticUser(tc.username)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if err == nil {
+				assert.Equal(t, tc.expected, tc.project.StaticUsers)
+			}
+		})
+	}
+}
+
+func TestProject_UpdateStaticUserRole(t *testing.T) {
+	testcases := []struct {
+		name     string
+		username string
+		role     string
+		wantErr  bool
+	}{
+		{
+			name:     "ok",
+			username: "alice",
+			role:     "Admin",
+		},
+		{
+			name:     "the role doesn't exist",
+			username: "alice",
+			role:     "Tester",
+			wantErr:  true,
+		},
+		{
+			name:     "the static admin role cannot be changed",
+			username: "admin",
+			role:     "Viewer",
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &Project{
+				StaticAdmin: &ProjectStaticUser{Username: "admin"},
+				StaticUsers: []*ProjectStaticUser{
+					{Username: "alice", Role: "Viewer"},
+				},
+			}
+			err := p.UpdateStaticUserRole(tc.username, tc.role)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if err == nil {
+				assert.Equal(t, tc.role, p.StaticUsers[0].Role)
+			}
+		})
+	}
+}
+
 func TestProject_SetBuiltinRBACRoles(t *testing.T) {
 	testcases := []struct {
 		name    string
@@ -854,6 +1095,47 @@ func TestProject_DeleteRBACRole(t *testing.T) {
 	}
 }
 
+func TestGenerateAuthCodeURL_Google(t *testing.T) {
+	tests := []struct {
+		name                string
+		config              ProjectSSOConfig_Google
+		project             string
+		callbackURL         string
+		state               string
+		expectedAuthCodeURL string
+	}{
+		{
+			name: "without hosted domain",
+			config: ProjectSSOConfig_Google{
+				ClientId: "test-client-id",
+			},
+			project:             "test-project",
+			callbackURL:         "https://example.com/auth/callback",
+			state:               "test-state",
+			expectedAuthCodeURL: "https://accounts.google.com/o/oauth2/auth?access_type=online&client_id=test-client-id&prompt=consent&redirect_uri=https%3A%2F%2Fexample.com%2Fauth%2Fcallback&response_type=code&scope=openid+email+profile&state=test-state%3Atest-project",
+		},
+		{
+			name: "with hosted domain",
+			config: ProjectSSOConfig_Google{
+				ClientId:     "test-client-id",
+				HostedDomain: "example.com",
+			},
+			project:             "test-project",
+			callbackURL:         "https://example.com/auth/callback",
+			state:               "test-state",
+			expectedAuthCodeURL: "https://accounts.google.com/o/oauth2/auth?access_type=online&client_id=test-client-id&hd=example.com&prompt=consent&redirect_uri=https%3A%2F%2Fexample.com%2Fauth%2Fcallback&response_type=code&scope=openid+email+profile&state=test-state%3Atest-project",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authURL, err := tt.config.GenerateAuthCodeURL(tt.project, tt.callbackURL, tt.state)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedAuthCodeURL, authURL)
+		})
+	}
+}
+
 func TestGenerateAuthCodeURL_Oidc(t *testing.T) {
 	tests := []struct {
 		name                string
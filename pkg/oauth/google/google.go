@@ -0,0 +1,258 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/endpoints"
+	oauth2google "golang.org/x/oauth2/google"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	directoryGroupScope = "https://www.googleapis.com/auth/admin.directory.group.readonly"
+	listPerPage         = 200
+)
+
+// apiEndpoints contains the addresses of Google APIs used by the client.
+type apiEndpoints struct {
+	Token     string
+	UserInfo  string
+	Directory string
+}
+
+var defaultAPIEndpoints = apiEndpoints{
+	Token:     endpoints.Google.TokenURL,
+	UserInfo:  "https://openidconnect.googleapis.com/v1/userinfo",
+	Directory: "https://admin.googleapis.com/admin/directory/v1/groups",
+}
+
+// OAuthClient is a oauth client for Google.
+type OAuthClient struct {
+	// The client authorized with the token of the signed in user.
+	userClient *http.Client
+	// The client used to request the token for the service account.
+	baseClient *http.Client
+
+	sso       *model.ProjectSSOConfig_Google
+	project   *model.Project
+	endpoints apiEndpoints
+}
+
+type userInfo struct {
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name"`
+	Picture       string `json:"picture"`
+	HostedDomain  string `json:"hd"`
+}
+
+type directoryGroups struct {
+	Groups []struct {
+		Email string `json:"email"`
+	} `json:"groups"`
+	NextPageToken string `json:"nextPageToken"`
+}
+
+// NewOAuthClient creates a new oauth client for Google.
+func NewOAuthClient(ctx context.Context,
+	sso *model.ProjectSSOConfig_Google,
+	project *model.Project,
+	callbackURL string,
+	code string,
+) (*OAuthClient, error) {
+	return newOAuthClient(ctx, sso, project, callbackURL, code, defaultAPIEndpoints)
+}
+
+func newOAuthClient(ctx context.Context,
+	sso *model.ProjectSSOConfig_Google,
+	project *model.Project,
+	callbackURL string,
+	code string,
+	apis apiEndpoints,
+) (*OAuthClient, error) {
+	c := &OAuthClient{
+		baseClient: http.DefaultClient,
+		sso:        sso,
+		project:    project,
+		endpoints:  apis,
+	}
+	cfg := oauth2.Config{
+		ClientID:     sso.ClientId,
+		ClientSecret: sso.ClientSecret,
+		RedirectURL:  callbackURL,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  endpoints.Google.AuthURL,
+			TokenURL: apis.Token,
+		},
+	}
+
+	if sso.ProxyUrl != "" {
+		proxyURL, err := url.Parse(sso.ProxyUrl)
+		if err != nil {
+			return nil, err
+		}
+
+		t := http.DefaultTransport.(*http.Transport).Clone()
+		t.Proxy = http.ProxyURL(proxyURL)
+		c.baseClient = &http.Client{Transport: t}
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, c.baseClient)
+	}
+
+	token, err := cfg.Exchange(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	c.userClient = cfg.Client(ctx, token)
+	return c, nil
+}
+
+// GetUser returns a user model.
+func (c *OAuthClient) GetUser(ctx context.Context) (*model.User, error) {
+	var info userInfo
+	if err := getJSON(ctx, c.userClient, c.endpoints.UserInfo, &info); err != nil {
+		return nil, fmt.Errorf("failed to get user info: %w", err)
+	}
+	if info.Email == "" || !info.EmailVerified {
+		return nil, fmt.Errorf("user does not have any verified email")
+	}
+	if c.sso.HostedDomain != "" && info.HostedDomain != c.sso.HostedDomain {
+		return nil, fmt.Errorf("user (%s) does not belong to the domain %s", info.Email, c.sso.HostedDomain)
+	}
+
+	groups := make([]string, 0)
+	if info.HostedDomain != "" {
+		groups = append(groups, info.HostedDomain)
+	}
+	if c.sso.ServiceAccountKey != "" && c.sso.AdminEmail != "" {
+		gs, err := c.listUserGroups(ctx, info.Email)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list groups of user %s: %w", info.Email, err)
+		}
+		groups = append(groups, gs...)
+	}
+
+	role, err := c.decideRole(info.Email, groups)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.User{
+		Username:  info.Email,
+		AvatarUrl: info.Picture,
+		Role:      role,
+	}, nil
+}
+
+// listUserGroups returns the emails of all Google Workspace groups the given user belongs to.
+// The service account impersonates the configured admin to read the directory.
+func (c *OAuthClient) listUserGroups(ctx context.Context, email string) ([]string, error) {
+	cfg, err := oauth2google.JWTConfigFromJSON([]byte(c.sso.ServiceAccountKey), directoryGroupScope)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Subject = c.sso.AdminEmail
+
+	cli := cfg.Client(context.WithValue(ctx, oauth2.HTTPClient, c.baseClient))
+
+	groups := make([]string, 0)
+	pageToken := ""
+	for {
+		q := url.Values{}
+		q.Set("userKey", email)
+		q.Set("maxResults", fmt.Sprintf("%d", listPerPage))
+		if pageToken != "" {
+			q.Set("pageToken", pageToken)
+		}
+
+		var resp directoryGroups
+		if err := getJSON(ctx, cli, c.endpoints.Directory+"?"+q.Encode(), &resp); err != nil {
+			return nil, err
+		}
+		for _, g := range resp.Groups {
+			groups = append(groups, g.Email)
+		}
+
+		if resp.NextPageToken == "" {
+			return groups, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+func (c *OAuthClient) decideRole(user string, groups []string) (role *model.Role, err error) {
+	role = &model.Role{
+		ProjectId:        c.project.Id,
+		ProjectRbacRoles: make([]string, 0, len(groups)),
+	}
+	userGroups := c.project.UserGroups
+	roles := make(map[string]string, len(userGroups))
+	for _, g := range userGroups {
+		roles[g.SsoGroup] = g.Role
+	}
+
+	// The groups can be either the domain of the user or the emails of Google Workspace groups.
+	for _, g := range groups {
+		if v, ok := roles[g]; ok {
+			role.ProjectRbacRoles = append(role.ProjectRbacRoles, v)
+		}
+	}
+
+	if len(role.ProjectRbacRoles) != 0 {
+		return
+	}
+
+	// In case the current user does not belong to any registered
+	// groups, if AllowStrayAsViewer option is set, assign Viewer role
+	// as user's role.
+	if c.project.AllowStrayAsViewer {
+		role.ProjectRbacRoles = []string{model.BuiltinRBACRoleViewer.String()}
+		return
+	}
+
+	err = fmt.Errorf("user (%s) not found in any of the %d project groups", user, len(groups))
+	return
+}
+
+func getJSON(ctx context.Context, cli *http.Client, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := cli.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", resp.Status, body)
+	}
+	return json.Unmarshal(body, v)
+}
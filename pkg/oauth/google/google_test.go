@@ -0,0 +1,319 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestDecideRole(t *testing.T) {
+	userGroups := []*model.ProjectUserGroup{
+		{
+			SsoGroup: "example.com",
+			Role:     "Viewer",
+		},
+		{
+			SsoGroup: "admins@example.com",
+			Role:     "Admin",
+		},
+		{
+			SsoGroup: "editors@example.com",
+			Role:     "Editor",
+		},
+	}
+
+	cases := []struct {
+		name               string
+		groups             []string
+		allowStrayAsViewer bool
+		role               *model.Role
+		wantErr            bool
+	}{
+		{
+			name:    "nothing",
+			groups:  []string{},
+			wantErr: true,
+		},
+		{
+			name:   "domain only",
+			groups: []string{"example.com"},
+			role: &model.Role{
+				ProjectId:        "id",
+				ProjectRbacRoles: []string{"Viewer"},
+			},
+		},
+		{
+			name:   "domain and groups",
+			groups: []string{"example.com", "admins@example.com", "unregistered@example.com"},
+			role: &model.Role{
+				ProjectId:        "id",
+				ProjectRbacRoles: []string{"Viewer", "Admin"},
+			},
+		},
+		{
+			name:               "stray user as viewer",
+			groups:             []string{"other.com"},
+			allowStrayAsViewer: true,
+			role: &model.Role{
+				ProjectId:        "id",
+				ProjectRbacRoles: []string{model.BuiltinRBACRoleViewer.String()},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &OAuthClient{
+				project: &model.Project{
+					Id:                 "id",
+					UserGroups:         userGroups,
+					AllowStrayAsViewer: tc.allowStrayAsViewer,
+				},
+			}
+
+			role, err := c.decideRole("user@example.com", tc.groups)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if err == nil {
+				assert.Equal(t, tc.role, role)
+			}
+		})
+	}
+}
+
+// fakeGoogle is a local stand-in for the Google OAuth and Directory APIs.
+type fakeGoogle struct {
+	*httptest.Server
+	userInfo map[string]interface{}
+	groups   map[string][]string
+}
+
+func newFakeGoogle(t *testing.T) *fakeGoogle {
+	f := &fakeGoogle{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		if r.FormValue("code") != "valid-code" {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"user-token","token_type":"Bearer","expires_in":3600}`))
+	})
+	mux.HandleFunc("/sa-token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		if r.FormValue("assertion") == "" {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"sa-token","token_type":"Bearer","expires_in":3600}`))
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer user-token" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(f.userInfo)
+	})
+	mux.HandleFunc("/groups", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer sa-token" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		// Return one group per page to exercise the pagination.
+		groups := f.groups[r.URL.Query().Get("userKey")]
+		page := 0
+		if token := r.URL.Query().Get("pageToken"); token != "" {
+			json.Unmarshal([]byte(token), &page)
+		}
+		resp := map[string]interface{}{}
+		if page < len(groups) {
+			resp["groups"] = []map[string]string{{"email": groups[page]}}
+		}
+		if page+1 < len(groups) {
+			next, _ := json.Marshal(page + 1)
+			resp["nextPageToken"] = string(next)
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+	f.Server = httptest.NewServer(mux)
+	t.Cleanup(f.Close)
+	return f
+}
+
+func (f *fakeGoogle) apiEndpoints() apiEndpoints {
+	return apiEndpoints{
+		Token:     f.URL + "/token",
+		UserInfo:  f.URL + "/userinfo",
+		Directory: f.URL + "/groups",
+	}
+}
+
+func (f *fakeGoogle) serviceAccountKey(t *testing.T) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(map[string]string{
+		"type":           "service_account",
+		"client_email":   "pipecd@project.iam.gserviceaccount.com",
+		"private_key_id": "key-id",
+		"private_key":    string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		"token_uri":      f.URL + "/sa-token",
+	})
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestGetUser(t *testing.T) {
+	f := newFakeGoogle(t)
+	f.groups = map[string][]string{
+		"foo@example.com": {"editors@example.com", "admins@example.com"},
+	}
+	saKey := f.serviceAccountKey(t)
+
+	project := &model.Project{
+		Id: "id",
+		UserGroups: []*model.ProjectUserGroup{
+			{SsoGroup: "example.com", Role: "Viewer"},
+			{SsoGroup: "admins@example.com", Role: "Admin"},
+		},
+	}
+
+	cases := []struct {
+		name     string
+		sso      *model.ProjectSSOConfig_Google
+		code     string
+		userInfo map[string]interface{}
+		expected *model.User
+		wantErr  bool
+	}{
+		{
+			name: "mapped by the hosted domain",
+			sso: &model.ProjectSSOConfig_Google{
+				ClientId:     "client-id",
+				ClientSecret: "client-secret",
+				HostedDomain: "example.com",
+			},
+			code: "valid-code",
+			userInfo: map[string]interface{}{
+				"email":          "foo@example.com",
+				"email_verified": true,
+				"picture":        "https://example.com/foo.png",
+				"hd":             "example.com",
+			},
+			expected: &model.User{
+				Username:  "foo@example.com",
+				AvatarUrl: "https://example.com/foo.png",
+				Role: &model.Role{
+					ProjectId:        "id",
+					ProjectRbacRoles: []string{"Viewer"},
+				},
+			},
+		},
+		{
+			name: "mapped by the directory groups",
+			sso: &model.ProjectSSOConfig_Google{
+				ClientId:          "client-id",
+				ClientSecret:      "client-secret",
+				ServiceAccountKey: saKey,
+				AdminEmail:        "admin@example.com",
+			},
+			code: "valid-code",
+			userInfo: map[string]interface{}{
+				"email":          "foo@example.com",
+				"email_verified": true,
+				"hd":             "example.com",
+			},
+			expected: &model.User{
+				Username: "foo@example.com",
+				Role: &model.Role{
+					ProjectId:        "id",
+					ProjectRbacRoles: []string{"Viewer", "Admin"},
+				},
+			},
+		},
+		{
+			name: "user outside the hosted domain",
+			sso: &model.ProjectSSOConfig_Google{
+				ClientId:     "client-id",
+				ClientSecret: "client-secret",
+				HostedDomain: "example.com",
+			},
+			code: "valid-code",
+			userInfo: map[string]interface{}{
+				"email":          "bar@gmail.com",
+				"email_verified": true,
+			},
+			wantErr: true,
+		},
+		{
+			name: "unverified email",
+			sso: &model.ProjectSSOConfig_Google{
+				ClientId:     "client-id",
+				ClientSecret: "client-secret",
+			},
+			code: "valid-code",
+			userInfo: map[string]interface{}{
+				"email":          "foo@example.com",
+				"email_verified": false,
+				"hd":             "example.com",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid code",
+			sso: &model.ProjectSSOConfig_Google{
+				ClientId:     "client-id",
+				ClientSecret: "client-secret",
+			},
+			code:    "invalid-code",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			f.userInfo = tc.userInfo
+			ctx := context.Background()
+
+			c, err := newOAuthClient(ctx, tc.sso, project, "https://pipecd.dev/auth/callback", tc.code, f.apiEndpoints())
+			if err != nil {
+				assert.True(t, tc.wantErr, "unexpected error: %v", err)
+				return
+			}
+
+			user, err := c.GetUser(ctx)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if err == nil {
+				assert.Equal(t, tc.expected, user)
+			}
+		})
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	oras "oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/content/file"
+)
+
+// ResolveDigest returns the digest of the manifest referenced by the given OCI URL.
+// This is used to detect the new artifacts pushed with the same tag.
+func ResolveDigest(ctx context.Context, sourceURL string, opts ...PullOption) (string, error) {
+	options := &PullOptions{}
+	for _, opt := range opts {
+		opt.applyPullOption(options)
+	}
+
+	repo, ref, err := parseOCIURL(sourceURL)
+	if err != nil {
+		return "", fmt.Errorf("could not parse OCI URL %s (%w)", sourceURL, err)
+	}
+
+	r, err := newPullRepository(repo, options)
+	if err != nil {
+		return "", err
+	}
+
+	desc, err := r.Resolve(ctx, ref)
+	if err != nil {
+		return "", fmt.Errorf("could not resolve %s (%w)", sourceURL, err)
+	}
+	return desc.Digest.String(), nil
+}
+
+// ListTags returns all tags in the repository referenced by the given OCI URL.
+// The reference part of the URL is ignored.
+func ListTags(ctx context.Context, sourceURL string, opts ...PullOption) ([]string, error) {
+	options := &PullOptions{}
+	for _, opt := range opts {
+		opt.applyPullOption(options)
+	}
+
+	repo, _, err := parseOCIURL(sourceURL)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse OCI URL %s (%w)", sourceURL, err)
+	}
+
+	r, err := newPullRepository(repo, options)
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []string
+	if err := r.Tags(ctx, "", func(page []string) error {
+		tags = append(tags, page...)
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("could not list tags of %s (%w)", repo, err)
+	}
+	return tags, nil
+}
+
+// DigestURL returns the OCI URL referencing the given digest in the repository of the given OCI URL.
+func DigestURL(sourceURL, digest string) (string, error) {
+	repo, _, err := parseOCIURL(sourceURL)
+	if err != nil {
+		return "", fmt.Errorf("could not parse OCI URL %s (%w)", sourceURL, err)
+	}
+	return fmt.Sprintf("oci://%s@%s", repo, digest), nil
+}
+
+// PullArtifactToDirectory pulls all files of the artifact referenced by the given OCI URL into the destination directory.
+// The layers annotated with their file names, such as the ones pushed by oras, are written as files
+// and the annotated directories are unpacked.
+// The other gzipped tarball layers, such as Helm charts, are extracted into the destination directory.
+func PullArtifactToDirectory(ctx context.Context, destDir, sourceURL string, opts ...PullOption) error {
+	options := &PullOptions{}
+	for _, opt := range opts {
+		opt.applyPullOption(options)
+	}
+
+	repo, ref, err := parseOCIURL(sourceURL)
+	if err != nil {
+		return fmt.Errorf("could not parse OCI URL %s (%w)", sourceURL, err)
+	}
+
+	r, err := newPullRepository(repo, options)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(destDir, 0700); err != nil {
+		return fmt.Errorf("could not create destination directory (%w)", err)
+	}
+
+	store, err := file.New(destDir)
+	if err != nil {
+		return fmt.Errorf("could not create file system (%w)", err)
+	}
+	defer store.Close()
+
+	store.AllowPathTraversalOnWrite = false
+
+	desc, err := oras.Copy(ctx, r, ref, store, "", oras.DefaultCopyOptions)
+	if err != nil {
+		return fmt.Errorf("could not copy OCI artifact (%w)", err)
+	}
+
+	return extractUnnamedLayers(ctx, destDir, desc, store, options.targetOS, options.targetArch)
+}
+
+// extractUnnamedLayers extracts the gzipped tarball layers which were not written by the file store
+// because they have no file name annotation.
+func extractUnnamedLayers(ctx context.Context, destDir string, desc ocispec.Descriptor, fetcher content.Fetcher, targetOS, targetArch string) error {
+	switch desc.MediaType {
+	case ocispec.MediaTypeImageIndex:
+		r, err := fetcher.Fetch(ctx, desc)
+		if err != nil {
+			return fmt.Errorf("could not fetch OCI image index (%w)", err)
+		}
+		defer r.Close()
+
+		var idx ocispec.Index
+		if err := json.NewDecoder(r).Decode(&idx); err != nil {
+			return fmt.Errorf("could not decode OCI image index (%w)", err)
+		}
+
+		for _, m := range idx.Manifests {
+			if m.Platform != nil && targetOS != "" && targetOS != m.Platform.OS {
+				continue
+			}
+			if m.Platform != nil && targetArch != "" && targetArch != m.Platform.Architecture {
+				continue
+			}
+			return extractUnnamedLayers(ctx, destDir, m, fetcher, targetOS, targetArch)
+		}
+
+		return fmt.Errorf("no matching manifest found")
+
+	case ocispec.MediaTypeImageManifest:
+		r, err := fetcher.Fetch(ctx, desc)
+		if err != nil {
+			return fmt.Errorf("could not fetch OCI image manifest (%w)", err)
+		}
+		defer r.Close()
+
+		var manifest ocispec.Manifest
+		if err := json.NewDecoder(r).Decode(&manifest); err != nil {
+			return fmt.Errorf("could not decode OCI image manifest (%w)", err)
+		}
+
+		for _, layer := range manifest.Layers {
+			if layer.Annotations[ocispec.AnnotationTitle] != "" {
+				continue
+			}
+			if !strings.HasSuffix(layer.MediaType, "tar+gzip") {
+				continue
+			}
+			if err := extractLayer(ctx, destDir, layer, fetcher); err != nil {
+				return err
+			}
+		}
+
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported media type %s", desc.MediaType)
+	}
+}
+
+func extractLayer(ctx context.Context, destDir string, layer ocispec.Descriptor, fetcher content.Fetcher) error {
+	r, err := fetcher.Fetch(ctx, layer)
+	if err != nil {
+		return fmt.Errorf("could not fetch OCI layer (%w)", err)
+	}
+	defer r.Close()
+
+	if err := extractTarGz(r, destDir); err != nil {
+		return fmt.Errorf("could not extract OCI layer %s (%w)", layer.Digest, err)
+	}
+	return nil
+}
+
+// extractTarGz extracts the regular files and directories in the given gzipped tarball into the destination directory.
+func extractTarGz(r io.Reader, destDir string) error {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer gr.Close()
+
+	tr := tar.NewReader(gr)
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		name := filepath.Clean(h.Name)
+		if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("invalid file path %s in the archive", h.Name)
+		}
+		path := filepath.Join(destDir, name)
+
+		switch h.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(path, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, os.FileMode(h.Mode)&0755|0600)
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(f, tr); err != nil {
+				f.Close()
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOCIURL(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		url     string
+		repo    string
+		ref     string
+		wantErr bool
+	}{
+		{
+			name: "tag",
+			url:  "oci://example.com/manifests/app:v1",
+			repo: "example.com/manifests/app",
+			ref:  "v1",
+		},
+		{
+			name: "no tag",
+			url:  "oci://example.com/manifests/app",
+			repo: "example.com/manifests/app",
+			ref:  "latest",
+		},
+		{
+			name: "digest",
+			url:  "oci://localhost:5000/app@sha256:1234567890",
+			repo: "localhost:5000/app",
+			ref:  "sha256:1234567890",
+		},
+		{
+			name: "tag and digest",
+			url:  "oci://example.com/app:v1@sha256:1234567890",
+			repo: "example.com/app",
+			ref:  "v1@sha256:1234567890",
+		},
+		{
+			name:    "unsupported scheme",
+			url:     "https://example.com/app:v1",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo, ref, err := parseOCIURL(tc.url)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.repo, repo)
+			assert.Equal(t, tc.ref, ref)
+		})
+	}
+}
+
+func TestDigestURL(t *testing.T) {
+	t.Parallel()
+
+	got, err := DigestURL("oci://localhost:5000/manifests/app:prod", "sha256:1234567890")
+	require.NoError(t, err)
+	assert.Equal(t, "oci://localhost:5000/manifests/app@sha256:1234567890", got)
+}
+
+func makeTarGz(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	for name, data := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0644,
+			Size:     int64(len(data)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := tw.Write([]byte(data))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+	return buf.Bytes()
+}
+
+func TestExtractTarGz(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		files    map[string]string
+		expected map[string]string
+		wantErr  bool
+	}{
+		{
+			name: "helm chart",
+			files: map[string]string{
+				"mychart/Chart.yaml":                "name: mychart",
+				"mychart/templates/deployment.yaml": "kind: Deployment",
+			},
+			expected: map[string]string{
+				"mychart/Chart.yaml":                "name: mychart",
+				"mychart/templates/deployment.yaml": "kind: Deployment",
+			},
+		},
+		{
+			name: "path traversal",
+			files: map[string]string{
+				"../evil.yaml": "kind: Secret",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			err := extractTarGz(bytes.NewReader(makeTarGz(t, tc.files)), dir)
+			require.Equal(t, tc.wantErr, err != nil)
+
+			for name, data := range tc.expected {
+				got, err := os.ReadFile(filepath.Join(dir, name))
+				require.NoError(t, err)
+				assert.Equal(t, data, string(got))
+			}
+		})
+	}
+}
+
+func TestPullArtifactToDirectory(t *testing.T) {
+	t.Parallel()
+
+	// OCI_REGISTRY_HOST is set by TestMain in main_test.go
+	ociURL := fmt.Sprintf("oci://%s/test-pull-artifact:v1", os.Getenv("OCI_REGISTRY_HOST"))
+	opts := []PullOption{WithInsecure(), WithUsername("testuser"), WithPassword("testpassword")}
+
+	contents := pushTestFiles(t, t.TempDir(), ociURL)
+
+	digest, err := ResolveDigest(t.Context(), ociURL, opts...)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(digest, "sha256:"))
+
+	tags, err := ListTags(t.Context(), ociURL, opts...)
+	require.NoError(t, err)
+	assert.Contains(t, tags, "v1")
+
+	digestURL, err := DigestURL(ociURL, digest)
+	require.NoError(t, err)
+
+	for platform, content := range contents {
+		t.Run(fmt.Sprintf("platform=%s", platform), func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			err := PullArtifactToDirectory(t.Context(), dir, digestURL, append(opts, WithTargetOS(platform.OS), WithTargetArch(platform.Arch))...)
+			require.NoError(t, err)
+
+			entries, err := os.ReadDir(dir)
+			require.NoError(t, err)
+
+			var found bool
+			for _, e := range entries {
+				if !strings.HasPrefix(e.Name(), fmt.Sprintf("%s-%s.txt", platform.OS, platform.Arch)) {
+					continue
+				}
+				got, err := os.ReadFile(filepath.Join(dir, e.Name()))
+				require.NoError(t, err)
+				assert.Equal(t, content, string(got))
+				found = true
+			}
+			assert.True(t, found)
+		})
+	}
+}
@@ -48,26 +48,9 @@ func PullFileFromRegistry(ctx context.Context, workdir string, dst io.Writer, so
 		return fmt.Errorf("could not parse OCI URL %s (%w)", sourceURL, err)
 	}
 
-	r, err := remote.NewRepository(repo)
+	r, err := newPullRepository(repo, options)
 	if err != nil {
-		return fmt.Errorf("could not create repository (%w)", err)
-	}
-
-	r.PlainHTTP = options.insecure
-
-	if options.username != "" || options.password != "" {
-		r.Client = &auth.Client{
-			Client: retry.DefaultClient,
-			Header: http.Header{
-				"User-Agent": {"oras-go"},
-			},
-			Credential: func(_ context.Context, _ string) (auth.Credential, error) {
-				return auth.Credential{
-					Username: options.username,
-					Password: options.password,
-				}, nil
-			},
-		}
+		return err
 	}
 
 	d, err := os.MkdirTemp(workdir, "oci-pull")
@@ -93,6 +76,32 @@ func PullFileFromRegistry(ctx context.Context, workdir string, dst io.Writer, so
 	return copyOCIArtifact(ctx, dst, desc, store, options.targetOS, options.targetArch, options.mediaType, options.artifactType)
 }
 
+// newPullRepository creates a client of the given repository to pull artifacts from.
+func newPullRepository(repo string, options *PullOptions) (*remote.Repository, error) {
+	r, err := remote.NewRepository(repo)
+	if err != nil {
+		return nil, fmt.Errorf("could not create repository (%w)", err)
+	}
+
+	r.PlainHTTP = options.insecure
+
+	if options.username != "" || options.password != "" {
+		r.Client = &auth.Client{
+			Client: retry.DefaultClient,
+			Header: http.Header{
+				"User-Agent": {"oras-go"},
+			},
+			Credential: func(_ context.Context, _ string) (auth.Credential, error) {
+				return auth.Credential{
+					Username: options.username,
+					Password: options.password,
+				}, nil
+			},
+		}
+	}
+	return r, nil
+}
+
 // parseOCIURL parses an OCI URL and returns the repository and reference parts.
 func parseOCIURL(sourceURL string) (repo string, ref string, _ error) {
 	u, err := url.Parse(sourceURL)
@@ -120,6 +129,9 @@ func parseOCIURL(sourceURL string) (repo string, ref string, _ error) {
 	if ok {
 		// some OCI URLs are like oci://example.com/test:v1@sha256:1234567890
 		repo, tag, _ := strings.Cut(repo, ":")
+		if tag == "" {
+			return u.Host + repo, ref, nil
+		}
 		return u.Host + repo, tag + "@" + ref, nil
 	}
 
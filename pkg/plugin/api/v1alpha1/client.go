@@ -21,6 +21,7 @@ import (
 
 	"github.com/pipe-cd/pipecd/pkg/plugin/api/v1alpha1/deployment"
 	"github.com/pipe-cd/pipecd/pkg/plugin/api/v1alpha1/livestate"
+	"github.com/pipe-cd/pipecd/pkg/plugin/api/v1alpha1/notification"
 	"github.com/pipe-cd/pipecd/pkg/plugin/api/v1alpha1/planpreview"
 	"github.com/pipe-cd/pipecd/pkg/rpc/rpcclient"
 )
@@ -29,6 +30,7 @@ type PluginClient interface {
 	deployment.DeploymentServiceClient
 	livestate.LivestateServiceClient
 	planpreview.PlanPreviewServiceClient
+	notification.NotificationServiceClient
 	Close() error
 	Name() string
 }
@@ -37,6 +39,7 @@ type client struct {
 	deployment.DeploymentServiceClient
 	livestate.LivestateServiceClient
 	planpreview.PlanPreviewServiceClient
+	notification.NotificationServiceClient
 	conn *grpc.ClientConn
 
 	name string
@@ -49,11 +52,12 @@ func NewClient(ctx context.Context, name string, address string, opts ...rpcclie
 	}
 
 	return &client{
-		DeploymentServiceClient:  deployment.NewDeploymentServiceClient(conn),
-		LivestateServiceClient:   livestate.NewLivestateServiceClient(conn),
-		PlanPreviewServiceClient: planpreview.NewPlanPreviewServiceClient(conn),
-		conn:                     conn,
-		name:                     name,
+		DeploymentServiceClient:   deployment.NewDeploymentServiceClient(conn),
+		LivestateServiceClient:    livestate.NewLivestateServiceClient(conn),
+		PlanPreviewServiceClient:  planpreview.NewPlanPreviewServiceClient(conn),
+		NotificationServiceClient: notification.NewNotificationServiceClient(conn),
+		conn:                      conn,
+		name:                      name,
 	}, nil
 }
 
@@ -171,7 +171,7 @@ func (s *DeploymentPluginServiceServer[Config, DeployTargetConfig, ApplicationCo
 	return newQuickSyncStagesResponse(time.Now(), response), nil
 }
 func (s *DeploymentPluginServiceServer[Config, DeployTargetConfig, ApplicationConfigSpec]) ExecuteStage(ctx context.Context, request *deployment.ExecuteStageRequest) (response *deployment.ExecuteStageResponse, _ error) {
-	slp := s.logPersister.StageLogPersister(request.GetInput().GetDeployment().GetId(), request.GetInput().GetStage().GetId())
+	slp := s.logPersister.RetriedStageLogPersister(request.GetInput().GetDeployment().GetId(), request.GetInput().GetStage().GetId(), request.GetInput().GetStage().GetRetriedCount())
 	defer func() {
 		// When termination signal received and the stage is not completed yet, we should not mark the log persister as completed.
 		// This can occur when the piped is shutting down while the stage is still running.
@@ -241,7 +241,7 @@ func (s *StagePluginServiceServer[Config, DeployTargetConfig, ApplicationConfigS
 	return &deployment.BuildQuickSyncStagesResponse{}, nil
 }
 func (s *StagePluginServiceServer[Config, DeployTargetConfig, ApplicationConfigSpec]) ExecuteStage(ctx context.Context, request *deployment.ExecuteStageRequest) (response *deployment.ExecuteStageResponse, _ error) {
-	slp := s.logPersister.StageLogPersister(request.GetInput().GetDeployment().GetId(), request.GetInput().GetStage().GetId())
+	slp := s.logPersister.RetriedStageLogPersister(request.GetInput().GetDeployment().GetId(), request.GetInput().GetStage().GetId(), request.GetInput().GetStage().GetRetriedCount())
 	defer func() {
 		// When termination signal received and the stage is not completed yet, we should not mark the log persister as completed.
 		// This can occur when the piped is shutting down while the stage is still running.
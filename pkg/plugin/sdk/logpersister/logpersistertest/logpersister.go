@@ -35,6 +35,10 @@ func (lp TestLogPersister) StageLogPersister(deploymentID, stageID string) logpe
 	return lp
 }
 
+func (lp TestLogPersister) RetriedStageLogPersister(deploymentID, stageID string, retriedCount int32) logpersister.StageLogPersister {
+	return lp
+}
+
 func (lp TestLogPersister) Write(log []byte) (int, error) {
 	// Write the log to the test logger
 	lp.t.Log(string(log))
@@ -40,6 +40,7 @@ type apiClient interface {
 type Persister interface {
 	Run(ctx context.Context) error
 	StageLogPersister(deploymentID, stageID string) StageLogPersister
+	RetriedStageLogPersister(deploymentID, stageID string, retriedCount int32) StageLogPersister
 }
 
 // StageLogPersister is a child persister instance for a specific stage.
@@ -58,6 +59,7 @@ type StageLogPersister interface {
 type key struct {
 	DeploymentID string
 	StageID      string
+	RetriedCount int32
 }
 
 type persister struct {
@@ -111,15 +113,23 @@ func (p *persister) shutdown() {
 	p.logger.Info("log persister has been stopped")
 }
 
-// StageLogPersister creates a child persister instance for a specific stage.
+// StageLogPersister creates a child persister instance for the first run of a specific stage.
 func (p *persister) StageLogPersister(deploymentID, stageID string) StageLogPersister {
+	return p.RetriedStageLogPersister(deploymentID, stageID, 0)
+}
+
+// RetriedStageLogPersister creates a child persister instance for a specific run of a stage.
+// The logs of each retry are stored separately from the ones of the previous runs.
+func (p *persister) RetriedStageLogPersister(deploymentID, stageID string, retriedCount int32) StageLogPersister {
 	k := key{
 		DeploymentID: deploymentID,
 		StageID:      stageID,
+		RetriedCount: retriedCount,
 	}
 	logger := p.logger.With(
 		zap.String("deployment-id", deploymentID),
 		zap.String("stage-id", stageID),
+		zap.Int32("retried-count", retriedCount),
 	)
 	sp := &stageLogPersister{
 		key:                     k,
@@ -180,6 +190,7 @@ func (p *persister) reportStageLogs(ctx context.Context, k key, blocks []*model.
 	req := &service.ReportStageLogsRequest{
 		DeploymentId: k.DeploymentID,
 		StageId:      k.StageID,
+		RetriedCount: k.RetriedCount,
 		Blocks:       blocks,
 	}
 	if _, err := p.apiClient.ReportStageLogs(ctx, req); err != nil {
@@ -196,6 +207,7 @@ func (p *persister) reportStageLogsFromLastCheckpoint(ctx context.Context, k key
 	req := &service.ReportStageLogsFromLastCheckpointRequest{
 		DeploymentId: k.DeploymentID,
 		StageId:      k.StageID,
+		RetriedCount: k.RetriedCount,
 		Blocks:       blocks,
 		Completed:    completed,
 	}
@@ -69,7 +69,8 @@ func TestPersister(t *testing.T) {
 	assert.Equal(t, 0, num)
 
 	sp1 := p.StageLogPersister("deployment-1", "stage-1")
-	p.StageLogPersister("deployment-2", "stage-2")
+	// The retry of the same stage is persisted separately.
+	p.RetriedStageLogPersister("deployment-1", "stage-1", 1)
 
 	num = p.flushAll(context.TODO())
 	require.Equal(t, 0, apiClient.NumberOfReportStageLogs())
@@ -0,0 +1,118 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+	"github.com/pipe-cd/pipecd/pkg/plugin/api/v1alpha1/notification"
+)
+
+// NotificationPlugin is the interface that must be implemented by a Notification plugin.
+// It receives the notification events matched by the routes of the piped config and sends them to the external services.
+// The Config and DeployTargetConfig are the plugin's config defined in piped's config.
+type NotificationPlugin[Config, DeployTargetConfig, ApplicationConfigSpec any] interface {
+	// Notify sends the given event to the receiver.
+	Notify(context.Context, *Config, *NotifyInput) error
+}
+
+// NotificationPluginServer is a wrapper for NotificationPlugin to satisfy the NotificationServiceServer interface.
+// It is used to register the plugin to the gRPC server.
+type NotificationPluginServer[Config, DeployTargetConfig, ApplicationConfigSpec any] struct {
+	notification.UnimplementedNotificationServiceServer
+	commonFields[Config, DeployTargetConfig]
+
+	base NotificationPlugin[Config, DeployTargetConfig, ApplicationConfigSpec]
+}
+
+// Register registers the plugin to the gRPC server.
+func (s *NotificationPluginServer[Config, DeployTargetConfig, ApplicationConfigSpec]) Register(server *grpc.Server) {
+	notification.RegisterNotificationServiceServer(server, s)
+}
+
+// Notify sends the given event to the receiver.
+func (s *NotificationPluginServer[Config, DeployTargetConfig, ApplicationConfigSpec]) Notify(ctx context.Context, request *notification.NotifyRequest) (*notification.NotifyResponse, error) {
+	metadata, err := request.GetEventMetadata().UnmarshalNew()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal the event metadata: %v", err)
+	}
+
+	client := &Client{
+		base:         s.client,
+		pluginName:   s.name,
+		toolRegistry: s.toolRegistry,
+	}
+
+	if err := s.base.Notify(ctx, s.pluginConfig, &NotifyInput{
+		Request: NotifyRequest{
+			Receiver:       request.GetReceiver(),
+			ReceiverConfig: request.GetReceiverConfig(),
+			Event: model.NotificationEvent{
+				Type:     request.GetEventType(),
+				Metadata: metadata,
+			},
+			WebURL: request.GetWebUrl(),
+		},
+		Client: client,
+		Logger: s.logger.With(zap.String("receiver", request.GetReceiver())),
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to send the notification: %v", err)
+	}
+
+	return &notification.NotifyResponse{}, nil
+}
+
+// NotifyInput is the input for the Notify method.
+type NotifyInput struct {
+	// Request is the request for sending the notification.
+	Request NotifyRequest
+	// Client is the client for accessing the piped API.
+	Client *Client
+	// Logger is the logger for logging.
+	Logger *zap.Logger
+}
+
+// NotifyRequest is the request for the Notify method.
+type NotifyRequest struct {
+	// Receiver is the name of the receiver defined in the piped config.
+	Receiver string
+	// ReceiverConfig is the receiver specific configuration defined in the piped config.
+	// Use ParseReceiverConfig to decode it into the plugin's own type.
+	ReceiverConfig json.RawMessage
+	// Event is the notification event to be sent.
+	// The Metadata is one of the model.NotificationEventXXX messages depending on the Type.
+	Event model.NotificationEvent
+	// WebURL is the base URL of the web console.
+	WebURL string
+}
+
+// ParseReceiverConfig decodes the receiver specific configuration into the given type.
+func ParseReceiverConfig[ReceiverConfig any](r NotifyRequest) (*ReceiverConfig, error) {
+	var cfg ReceiverConfig
+	if len(r.ReceiverConfig) == 0 {
+		return &cfg, nil
+	}
+	if err := json.Unmarshal(r.ReceiverConfig, &cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
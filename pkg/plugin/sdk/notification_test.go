@@ -0,0 +1,169 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	config "github.com/pipe-cd/pipecd/pkg/configv1"
+	"github.com/pipe-cd/pipecd/pkg/model"
+	"github.com/pipe-cd/pipecd/pkg/plugin/api/v1alpha1/notification"
+)
+
+type mockNotificationPlugin struct {
+	input *NotifyInput
+	err   error
+}
+
+func (m *mockNotificationPlugin) Notify(ctx context.Context, config ConfigNone, input *NotifyInput) error {
+	m.input = input
+	return m.err
+}
+
+func newTestNotificationPluginServer(t *testing.T, plugin *mockNotificationPlugin) *NotificationPluginServer[struct{}, struct{}, struct{}] {
+	return &NotificationPluginServer[struct{}, struct{}, struct{}]{
+		base: plugin,
+		commonFields: commonFields[struct{}, struct{}]{
+			logger: zaptest.NewLogger(t),
+			config: &config.PipedPlugin{
+				Name: "mockNotificationPlugin",
+			},
+		},
+	}
+}
+
+func TestNotificationPluginServer_Notify(t *testing.T) {
+	t.Parallel()
+
+	metadata, err := anypb.New(&model.NotificationEventDeploymentSucceeded{
+		Deployment: &model.Deployment{Id: "deployment-id"},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		request        *notification.NotifyRequest
+		err            error
+		expectedStatus codes.Code
+		expectErr      bool
+	}{
+		{
+			name: "success",
+			request: &notification.NotifyRequest{
+				Receiver:       "dev-slack",
+				ReceiverConfig: []byte(`{"channelID":"testid"}`),
+				EventType:      model.NotificationEventType_EVENT_DEPLOYMENT_SUCCEEDED,
+				EventMetadata:  metadata,
+				WebUrl:         "https://pipecd.dev",
+			},
+			expectedStatus: codes.OK,
+		},
+		{
+			name: "invalid metadata",
+			request: &notification.NotifyRequest{
+				Receiver:      "dev-slack",
+				EventType:     model.NotificationEventType_EVENT_DEPLOYMENT_SUCCEEDED,
+				EventMetadata: &anypb.Any{TypeUrl: "type.googleapis.com/unknown.Message"},
+			},
+			expectedStatus: codes.InvalidArgument,
+			expectErr:      true,
+		},
+		{
+			name: "plugin returns error",
+			request: &notification.NotifyRequest{
+				Receiver:      "dev-slack",
+				EventType:     model.NotificationEventType_EVENT_DEPLOYMENT_SUCCEEDED,
+				EventMetadata: metadata,
+			},
+			err:            errors.New("failed to send"),
+			expectedStatus: codes.Internal,
+			expectErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			plugin := &mockNotificationPlugin{err: tt.err}
+			server := newTestNotificationPluginServer(t, plugin)
+
+			_, err := server.Notify(context.Background(), tt.request)
+			assert.Equal(t, tt.expectedStatus, status.Code(err))
+			if tt.expectErr {
+				return
+			}
+
+			require.NotNil(t, plugin.input)
+			req := plugin.input.Request
+			assert.Equal(t, "dev-slack", req.Receiver)
+			assert.Equal(t, "https://pipecd.dev", req.WebURL)
+			assert.Equal(t, model.NotificationEventType_EVENT_DEPLOYMENT_SUCCEEDED, req.Event.Type)
+			md, ok := req.Event.Metadata.(*model.NotificationEventDeploymentSucceeded)
+			require.True(t, ok)
+			assert.Equal(t, "deployment-id", md.Deployment.Id)
+		})
+	}
+}
+
+func TestParseReceiverConfig(t *testing.T) {
+	t.Parallel()
+
+	type receiverConfig struct {
+		ChannelID string `json:"channelID"`
+	}
+
+	tests := []struct {
+		name      string
+		config    json.RawMessage
+		expected  *receiverConfig
+		expectErr bool
+	}{
+		{
+			name:     "empty config",
+			expected: &receiverConfig{},
+		},
+		{
+			name:     "valid config",
+			config:   json.RawMessage(`{"channelID":"testid"}`),
+			expected: &receiverConfig{ChannelID: "testid"},
+		},
+		{
+			name:      "invalid config",
+			config:    json.RawMessage(`{"channelID":`),
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseReceiverConfig[receiverConfig](NotifyRequest{ReceiverConfig: tt.config})
+			assert.Equal(t, tt.expectErr, err != nil)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -88,7 +88,7 @@ type commonFields[Config, DeployTargetConfig any] struct {
 }
 
 type logPersister interface {
-	StageLogPersister(deploymentID, stageID string) logpersister.StageLogPersister
+	RetriedStageLogPersister(deploymentID, stageID string, retriedCount int32) logpersister.StageLogPersister
 }
 
 // withLogger copies the commonFields and sets the logger to the given one.
@@ -138,6 +138,13 @@ func WithPlanPreviewPlugin[Config, DeployTargetConfig, ApplicationConfigSpec any
 	}
 }
 
+// WithNotificationPlugin is a function that sets the notification plugin.
+func WithNotificationPlugin[Config, DeployTargetConfig, ApplicationConfigSpec any](notificationPlugin NotificationPlugin[Config, DeployTargetConfig, ApplicationConfigSpec]) PluginOption[Config, DeployTargetConfig, ApplicationConfigSpec] {
+	return func(plugin *Plugin[Config, DeployTargetConfig, ApplicationConfigSpec]) {
+		plugin.notificationPlugin = notificationPlugin
+	}
+}
+
 // Plugin is a wrapper for the plugin.
 // It provides a way to run the plugin with the given config and deploy target config.
 type Plugin[Config, DeployTargetConfig, ApplicationConfigSpec any] struct {
@@ -151,10 +158,11 @@ type Plugin[Config, DeployTargetConfig, ApplicationConfigSpec any] struct {
 	initializers []Initializer[Config, DeployTargetConfig]
 
 	// plugin implementations
-	stagePlugin       StagePlugin[Config, DeployTargetConfig, ApplicationConfigSpec]
-	deploymentPlugin  DeploymentPlugin[Config, DeployTargetConfig, ApplicationConfigSpec]
-	livestatePlugin   LivestatePlugin[Config, DeployTargetConfig, ApplicationConfigSpec]
-	planPreviewPlugin PlanPreviewPlugin[Config, DeployTargetConfig, ApplicationConfigSpec]
+	stagePlugin        StagePlugin[Config, DeployTargetConfig, ApplicationConfigSpec]
+	deploymentPlugin   DeploymentPlugin[Config, DeployTargetConfig, ApplicationConfigSpec]
+	livestatePlugin    LivestatePlugin[Config, DeployTargetConfig, ApplicationConfigSpec]
+	planPreviewPlugin  PlanPreviewPlugin[Config, DeployTargetConfig, ApplicationConfigSpec]
+	notificationPlugin NotificationPlugin[Config, DeployTargetConfig, ApplicationConfigSpec]
 
 	// command line options
 	pipedPluginService   string
@@ -179,7 +187,7 @@ func NewPlugin[Config, DeployTargetConfig, ApplicationConfigSpec any](version st
 		option(plugin)
 	}
 
-	if plugin.stagePlugin == nil && plugin.deploymentPlugin == nil && plugin.livestatePlugin == nil {
+	if plugin.stagePlugin == nil && plugin.deploymentPlugin == nil && plugin.livestatePlugin == nil && plugin.notificationPlugin == nil {
 		return nil, fmt.Errorf("at least one plugin must be registered")
 	}
 
@@ -305,135 +313,11 @@ func (p *Plugin[Config, DeployTargetConfig, ApplicationConfigSpec]) run(ctx cont
 
 	// Start a gRPC server for handling external API requests.
 	{
-		commonFields := commonFields[Config, DeployTargetConfig]{
-			name:         cfg.Name,
-			version:      p.version,
-			config:       cfg,
-			logPersister: persister,
-			client:       pipedPluginServiceClient,
-			pluginConfig: new(Config),
-			toolRegistry: toolregistry.NewToolRegistry(pipedPluginServiceClient),
-		}
-
-		if len(cfg.Config) == 0 {
-			// It is necessary to prepare config with default value when users don't set any config,
-			// or when plugin developers implement custom unmarshalling logic.
-			cfg.Config = []byte("{}")
-		}
-
-		if err := json.Unmarshal(cfg.Config, commonFields.pluginConfig); err != nil {
-			logger.Fatal("failed to unmarshal the plugin config", zap.Error(err))
+		services, err := p.newServices(ctx, cfg, pipedPluginServiceClient, persister, logger)
+		if err != nil {
 			return err
 		}
 
-		commonFields.deployTargets = make(map[string]*DeployTarget[DeployTargetConfig], len(cfg.DeployTargets))
-		for _, dt := range cfg.DeployTargets {
-			var sdkDt DeployTargetConfig
-			if err := json.Unmarshal(dt.Config, &sdkDt); err != nil {
-				logger.Fatal("failed to unmarshal deploy target config", zap.Error(err))
-				return err
-			}
-			commonFields.deployTargets[dt.Name] = &DeployTarget[DeployTargetConfig]{
-				Name:   dt.Name,
-				Labels: dt.Labels,
-				Config: sdkDt,
-			}
-		}
-
-		client := &Client{
-			base:         commonFields.client,
-			pluginName:   commonFields.name,
-			toolRegistry: commonFields.toolRegistry,
-			// These fields are not available at initializing state.
-			applicationID:     "",
-			deploymentID:      "",
-			stageID:           "",
-			stageLogPersister: nil,
-		}
-
-		initializeInput := &InitializeInput[Config, DeployTargetConfig]{
-			Config:        commonFields.pluginConfig,
-			DeployTargets: commonFields.deployTargets,
-			Client:        client,
-			Logger:        logger.Named("plugin-initializer"),
-		}
-
-		for _, initializer := range p.initializers {
-			if err := initializer.Initialize(ctx, initializeInput); err != nil {
-				logger.Error("failed to initialize plugin", zap.Error(err))
-				return err
-			}
-		}
-
-		var services []rpc.Service
-
-		if p.stagePlugin != nil {
-			if initializer, ok := p.stagePlugin.(Initializer[Config, DeployTargetConfig]); ok {
-				if err := initializer.Initialize(ctx, initializeInput); err != nil {
-					logger.Error("failed to initialize stage plugin", zap.Error(err))
-					return err
-				}
-			}
-			stagePluginServiceServer := &StagePluginServiceServer[Config, DeployTargetConfig, ApplicationConfigSpec]{
-				base:         p.stagePlugin,
-				commonFields: commonFields.withLogger(logger.Named("stage-service")),
-			}
-			services = append(services, stagePluginServiceServer)
-		}
-
-		if p.deploymentPlugin != nil {
-			if initializer, ok := p.deploymentPlugin.(Initializer[Config, DeployTargetConfig]); ok {
-				if err := initializer.Initialize(ctx, initializeInput); err != nil {
-					logger.Error("failed to initialize deployment plugin", zap.Error(err))
-					return err
-				}
-			}
-			deploymentPluginServiceServer := &DeploymentPluginServiceServer[Config, DeployTargetConfig, ApplicationConfigSpec]{
-				base:         p.deploymentPlugin,
-				commonFields: commonFields.withLogger(logger.Named("deployment-service")),
-			}
-			services = append(services, deploymentPluginServiceServer)
-		}
-
-		if p.livestatePlugin != nil {
-			if initializer, ok := p.livestatePlugin.(Initializer[Config, DeployTargetConfig]); ok {
-				if err := initializer.Initialize(ctx, initializeInput); err != nil {
-					logger.Error("failed to initialize livestate plugin", zap.Error(err))
-					return err
-				}
-			}
-			livestatePluginServiceServer := &LivestatePluginServer[Config, DeployTargetConfig, ApplicationConfigSpec]{
-				base:         p.livestatePlugin,
-				commonFields: commonFields.withLogger(logger.Named("livestate-service")),
-			}
-			services = append(services, livestatePluginServiceServer)
-		}
-
-		if p.planPreviewPlugin != nil {
-			if initializer, ok := p.planPreviewPlugin.(Initializer[Config, DeployTargetConfig]); ok {
-				if err := initializer.Initialize(ctx, initializeInput); err != nil {
-					logger.Error("failed to initialize plan-preview plugin", zap.Error(err))
-					return err
-				}
-			}
-			planPreviewPluginServiceServer := &PlanPreviewPluginServer[Config, DeployTargetConfig, ApplicationConfigSpec]{
-				base:         p.planPreviewPlugin,
-				commonFields: commonFields.withLogger(logger.Named("plan-preview-service")),
-			}
-			services = append(services, planPreviewPluginServiceServer)
-		}
-
-		if len(services) == 0 {
-			// This is promised in the NewPlugin function.
-			// When this happens, it means that *Plugin was initialized without using NewPlugin.
-			logger.Error(
-				"no plugin is registered, plugin implementation must use NewPlugin to initialize the plugin",
-				zap.String("name", p.name),
-				zap.String("version", p.version),
-			)
-			return fmt.Errorf("no plugin is registered, plugin implementation must use NewPlugin to initialize the plugin")
-		}
-
 		var (
 			opts = []rpc.Option{
 				rpc.WithPort(cfg.Port),
@@ -472,3 +356,151 @@ func (p *Plugin[Config, DeployTargetConfig, ApplicationConfigSpec]) run(ctx cont
 	}
 	return nil
 }
+
+// newServices initializes the registered plugins and returns the gRPC services serving them.
+func (p *Plugin[Config, DeployTargetConfig, ApplicationConfigSpec]) newServices(ctx context.Context, cfg *config.PipedPlugin, serviceClient *pluginServiceClient, persister logPersister, logger *zap.Logger) ([]rpc.Service, error) {
+	commonFields := commonFields[Config, DeployTargetConfig]{
+		name:         cfg.Name,
+		version:      p.version,
+		config:       cfg,
+		logPersister: persister,
+		client:       serviceClient,
+		pluginConfig: new(Config),
+		toolRegistry: toolregistry.NewToolRegistry(serviceClient),
+	}
+
+	if len(cfg.Config) == 0 {
+		// It is necessary to prepare config with default value when users don't set any config,
+		// or when plugin developers implement custom unmarshalling logic.
+		cfg.Config = []byte("{}")
+	}
+
+	if err := json.Unmarshal(cfg.Config, commonFields.pluginConfig); err != nil {
+		logger.Fatal("failed to unmarshal the plugin config", zap.Error(err))
+		return nil, err
+	}
+
+	commonFields.deployTargets = make(map[string]*DeployTarget[DeployTargetConfig], len(cfg.DeployTargets))
+	for _, dt := range cfg.DeployTargets {
+		var sdkDt DeployTargetConfig
+		if err := json.Unmarshal(dt.Config, &sdkDt); err != nil {
+			logger.Fatal("failed to unmarshal deploy target config", zap.Error(err))
+			return nil, err
+		}
+		commonFields.deployTargets[dt.Name] = &DeployTarget[DeployTargetConfig]{
+			Name:   dt.Name,
+			Labels: dt.Labels,
+			Config: sdkDt,
+		}
+	}
+
+	client := &Client{
+		base:         commonFields.client,
+		pluginName:   commonFields.name,
+		toolRegistry: commonFields.toolRegistry,
+		// These fields are not available at initializing state.
+		applicationID:     "",
+		deploymentID:      "",
+		stageID:           "",
+		stageLogPersister: nil,
+	}
+
+	initializeInput := &InitializeInput[Config, DeployTargetConfig]{
+		Config:        commonFields.pluginConfig,
+		DeployTargets: commonFields.deployTargets,
+		Client:        client,
+		Logger:        logger.Named("plugin-initializer"),
+	}
+
+	for _, initializer := range p.initializers {
+		if err := initializer.Initialize(ctx, initializeInput); err != nil {
+			logger.Error("failed to initialize plugin", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	var services []rpc.Service
+
+	if p.stagePlugin != nil {
+		if initializer, ok := p.stagePlugin.(Initializer[Config, DeployTargetConfig]); ok {
+			if err := initializer.Initialize(ctx, initializeInput); err != nil {
+				logger.Error("failed to initialize stage plugin", zap.Error(err))
+				return nil, err
+			}
+		}
+		stagePluginServiceServer := &StagePluginServiceServer[Config, DeployTargetConfig, ApplicationConfigSpec]{
+			base:         p.stagePlugin,
+			commonFields: commonFields.withLogger(logger.Named("stage-service")),
+		}
+		services = append(services, stagePluginServiceServer)
+	}
+
+	if p.deploymentPlugin != nil {
+		if initializer, ok := p.deploymentPlugin.(Initializer[Config, DeployTargetConfig]); ok {
+			if err := initializer.Initialize(ctx, initializeInput); err != nil {
+				logger.Error("failed to initialize deployment plugin", zap.Error(err))
+				return nil, err
+			}
+		}
+		deploymentPluginServiceServer := &DeploymentPluginServiceServer[Config, DeployTargetConfig, ApplicationConfigSpec]{
+			base:         p.deploymentPlugin,
+			commonFields: commonFields.withLogger(logger.Named("deployment-service")),
+		}
+		services = append(services, deploymentPluginServiceServer)
+	}
+
+	if p.livestatePlugin != nil {
+		if initializer, ok := p.livestatePlugin.(Initializer[Config, DeployTargetConfig]); ok {
+			if err := initializer.Initialize(ctx, initializeInput); err != nil {
+				logger.Error("failed to initialize livestate plugin", zap.Error(err))
+				return nil, err
+			}
+		}
+		livestatePluginServiceServer := &LivestatePluginServer[Config, DeployTargetConfig, ApplicationConfigSpec]{
+			base:         p.livestatePlugin,
+			commonFields: commonFields.withLogger(logger.Named("livestate-service")),
+		}
+		services = append(services, livestatePluginServiceServer)
+	}
+
+	if p.planPreviewPlugin != nil {
+		if initializer, ok := p.planPreviewPlugin.(Initializer[Config, DeployTargetConfig]); ok {
+			if err := initializer.Initialize(ctx, initializeInput); err != nil {
+				logger.Error("failed to initialize plan-preview plugin", zap.Error(err))
+				return nil, err
+			}
+		}
+		planPreviewPluginServiceServer := &PlanPreviewPluginServer[Config, DeployTargetConfig, ApplicationConfigSpec]{
+			base:         p.planPreviewPlugin,
+			commonFields: commonFields.withLogger(logger.Named("plan-preview-service")),
+		}
+		services = append(services, planPreviewPluginServiceServer)
+	}
+
+	if p.notificationPlugin != nil {
+		if initializer, ok := p.notificationPlugin.(Initializer[Config, DeployTargetConfig]); ok {
+			if err := initializer.Initialize(ctx, initializeInput); err != nil {
+				logger.Error("failed to initialize notification plugin", zap.Error(err))
+				return nil, err
+			}
+		}
+		notificationPluginServiceServer := &NotificationPluginServer[Config, DeployTargetConfig, ApplicationConfigSpec]{
+			base:         p.notificationPlugin,
+			commonFields: commonFields.withLogger(logger.Named("notification-service")),
+		}
+		services = append(services, notificationPluginServiceServer)
+	}
+
+	if len(services) == 0 {
+		// This is promised in the NewPlugin function.
+		// When this happens, it means that *Plugin was initialized without using NewPlugin.
+		logger.Error(
+			"no plugin is registered, plugin implementation must use NewPlugin to initialize the plugin",
+			zap.String("name", p.name),
+			zap.String("version", p.version),
+		)
+		return nil, fmt.Errorf("no plugin is registered, plugin implementation must use NewPlugin to initialize the plugin")
+	}
+
+	return services, nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdktest
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+
+	"github.com/pipe-cd/piped-plugin-sdk-go/logpersister"
+)
+
+// LogBlock is a log line written by a stage.
+type LogBlock struct {
+	Severity model.LogSeverity
+	Log      string
+}
+
+// logStore keeps the stage logs in memory keyed by stage ID and retried count.
+type logStore struct {
+	mu     sync.Mutex
+	blocks map[string][]LogBlock
+}
+
+func newLogStore() *logStore {
+	return &logStore{
+		blocks: make(map[string][]LogBlock),
+	}
+}
+
+func logKey(stageID string, retriedCount int32) string {
+	return fmt.Sprintf("%s#%d", stageID, retriedCount)
+}
+
+func (s *logStore) RetriedStageLogPersister(_, stageID string, retriedCount int32) logpersister.StageLogPersister {
+	return &stageLogPersister{
+		store: s,
+		key:   logKey(stageID, retriedCount),
+	}
+}
+
+func (s *logStore) append(key string, severity model.LogSeverity, log string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.blocks[key] = append(s.blocks[key], LogBlock{Severity: severity, Log: log})
+}
+
+func (s *logStore) get(stageID string, retriedCount int32) []LogBlock {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	blocks := s.blocks[logKey(stageID, retriedCount)]
+	out := make([]LogBlock, len(blocks))
+	copy(out, blocks)
+	return out
+}
+
+// stageLogPersister writes the stage logs into the logStore synchronously.
+type stageLogPersister struct {
+	store *logStore
+	key   string
+}
+
+func (p *stageLogPersister) Write(log []byte) (int, error) {
+	p.store.append(p.key, model.LogSeverity_INFO, strings.TrimSuffix(string(log), "\n"))
+	return len(log), nil
+}
+
+func (p *stageLogPersister) Info(log string) {
+	p.store.append(p.key, model.LogSeverity_INFO, log)
+}
+
+func (p *stageLogPersister) Infof(format string, a ...interface{}) {
+	p.Info(fmt.Sprintf(format, a...))
+}
+
+func (p *stageLogPersister) Success(log string) {
+	p.store.append(p.key, model.LogSeverity_SUCCESS, log)
+}
+
+func (p *stageLogPersister) Successf(format string, a ...interface{}) {
+	p.Success(fmt.Sprintf(format, a...))
+}
+
+func (p *stageLogPersister) Error(log string) {
+	p.store.append(p.key, model.LogSeverity_ERROR, log)
+}
+
+func (p *stageLogPersister) Errorf(format string, a ...interface{}) {
+	p.Error(fmt.Sprintf(format, a...))
+}
+
+func (p *stageLogPersister) Complete(time.Duration) error {
+	return nil
+}
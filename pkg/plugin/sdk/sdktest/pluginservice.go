@@ -0,0 +1,233 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdktest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+	"github.com/pipe-cd/pipecd/pkg/plugin/pipedservice"
+)
+
+// pluginService is a fake piped plugin service.
+// It keeps all metadata, objects and commands in memory so that they can be asserted in tests.
+type pluginService struct {
+	pipedservice.UnimplementedPluginServiceServer
+
+	mu sync.Mutex
+	// stageMetadata is keyed by deployment ID and stage ID.
+	stageMetadata map[string]map[string]map[string]string
+	// deploymentPluginMetadata is keyed by deployment ID and plugin name.
+	deploymentPluginMetadata map[string]map[string]map[string]string
+	// deploymentSharedMetadata is keyed by deployment ID.
+	deploymentSharedMetadata map[string]map[string]string
+	// applicationSharedObjects is keyed by application ID, plugin name and object key.
+	applicationSharedObjects map[string][]byte
+
+	// stageNames is used to find the queued commands of a stage.
+	stageNames map[string]string
+	// queuedCommands are the commands which are not bound to any stage yet, keyed by stage name.
+	queuedCommands map[string][]*model.Command
+	// stageCommands are the commands bound to the stage, keyed by stage ID.
+	stageCommands map[string][]*model.Command
+	// handledCommands are the commands of completed stages.
+	handledCommands []*model.Command
+	commandCount    int
+}
+
+func newPluginService() *pluginService {
+	return &pluginService{
+		stageMetadata:            make(map[string]map[string]map[string]string),
+		deploymentPluginMetadata: make(map[string]map[string]map[string]string),
+		deploymentSharedMetadata: make(map[string]map[string]string),
+		applicationSharedObjects: make(map[string][]byte),
+		stageNames:               make(map[string]string),
+		queuedCommands:           make(map[string][]*model.Command),
+		stageCommands:            make(map[string][]*model.Command),
+	}
+}
+
+func applicationSharedObjectKey(applicationID, pluginName, key string) string {
+	return applicationID + "/" + pluginName + "/" + key
+}
+
+func putMetadata(m map[string]string, metadata map[string]string) {
+	for k, v := range metadata {
+		m[k] = v
+	}
+}
+
+func (s *pluginService) stageMetadataOf(deploymentID, stageID string) map[string]string {
+	if _, ok := s.stageMetadata[deploymentID]; !ok {
+		s.stageMetadata[deploymentID] = make(map[string]map[string]string)
+	}
+	if _, ok := s.stageMetadata[deploymentID][stageID]; !ok {
+		s.stageMetadata[deploymentID][stageID] = make(map[string]string)
+	}
+	return s.stageMetadata[deploymentID][stageID]
+}
+
+func (s *pluginService) deploymentPluginMetadataOf(deploymentID, pluginName string) map[string]string {
+	if _, ok := s.deploymentPluginMetadata[deploymentID]; !ok {
+		s.deploymentPluginMetadata[deploymentID] = make(map[string]map[string]string)
+	}
+	if _, ok := s.deploymentPluginMetadata[deploymentID][pluginName]; !ok {
+		s.deploymentPluginMetadata[deploymentID][pluginName] = make(map[string]string)
+	}
+	return s.deploymentPluginMetadata[deploymentID][pluginName]
+}
+
+func (s *pluginService) InstallTool(context.Context, *pipedservice.InstallToolRequest) (*pipedservice.InstallToolResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "installing tools is not supported by sdktest, use toolregistrytest instead")
+}
+
+func (s *pluginService) ReportStageLogs(context.Context, *pipedservice.ReportStageLogsRequest) (*pipedservice.ReportStageLogsResponse, error) {
+	// The stage logs are captured by the harness directly.
+	return &pipedservice.ReportStageLogsResponse{}, nil
+}
+
+func (s *pluginService) ReportStageLogsFromLastCheckpoint(context.Context, *pipedservice.ReportStageLogsFromLastCheckpointRequest) (*pipedservice.ReportStageLogsFromLastCheckpointResponse, error) {
+	// The stage logs are captured by the harness directly.
+	return &pipedservice.ReportStageLogsFromLastCheckpointResponse{}, nil
+}
+
+func (s *pluginService) GetStageMetadata(_ context.Context, req *pipedservice.GetStageMetadataRequest) (*pipedservice.GetStageMetadataResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, found := s.stageMetadataOf(req.DeploymentId, req.StageId)[req.Key]
+	return &pipedservice.GetStageMetadataResponse{Value: value, Found: found}, nil
+}
+
+func (s *pluginService) PutStageMetadata(_ context.Context, req *pipedservice.PutStageMetadataRequest) (*pipedservice.PutStageMetadataResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stageMetadataOf(req.DeploymentId, req.StageId)[req.Key] = req.Value
+	return &pipedservice.PutStageMetadataResponse{}, nil
+}
+
+func (s *pluginService) PutStageMetadataMulti(_ context.Context, req *pipedservice.PutStageMetadataMultiRequest) (*pipedservice.PutStageMetadataMultiResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	putMetadata(s.stageMetadataOf(req.DeploymentId, req.StageId), req.Metadata)
+	return &pipedservice.PutStageMetadataMultiResponse{}, nil
+}
+
+func (s *pluginService) GetDeploymentPluginMetadata(_ context.Context, req *pipedservice.GetDeploymentPluginMetadataRequest) (*pipedservice.GetDeploymentPluginMetadataResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, found := s.deploymentPluginMetadataOf(req.DeploymentId, req.PluginName)[req.Key]
+	return &pipedservice.GetDeploymentPluginMetadataResponse{Value: value, Found: found}, nil
+}
+
+func (s *pluginService) PutDeploymentPluginMetadata(_ context.Context, req *pipedservice.PutDeploymentPluginMetadataRequest) (*pipedservice.PutDeploymentPluginMetadataResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deploymentPluginMetadataOf(req.DeploymentId, req.PluginName)[req.Key] = req.Value
+	return &pipedservice.PutDeploymentPluginMetadataResponse{}, nil
+}
+
+func (s *pluginService) PutDeploymentPluginMetadataMulti(_ context.Context, req *pipedservice.PutDeploymentPluginMetadataMultiRequest) (*pipedservice.PutDeploymentPluginMetadataMultiResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	putMetadata(s.deploymentPluginMetadataOf(req.DeploymentId, req.PluginName), req.Metadata)
+	return &pipedservice.PutDeploymentPluginMetadataMultiResponse{}, nil
+}
+
+func (s *pluginService) GetDeploymentSharedMetadata(_ context.Context, req *pipedservice.GetDeploymentSharedMetadataRequest) (*pipedservice.GetDeploymentSharedMetadataResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, found := s.deploymentSharedMetadata[req.DeploymentId][req.Key]
+	return &pipedservice.GetDeploymentSharedMetadataResponse{Value: value, Found: found}, nil
+}
+
+func (s *pluginService) GetApplicationSharedObject(_ context.Context, req *pipedservice.GetApplicationSharedObjectRequest) (*pipedservice.GetApplicationSharedObjectResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj, ok := s.applicationSharedObjects[applicationSharedObjectKey(req.ApplicationId, req.PluginName, req.Key)]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "object was not found")
+	}
+	return &pipedservice.GetApplicationSharedObjectResponse{Object: obj}, nil
+}
+
+func (s *pluginService) PutApplicationSharedObject(_ context.Context, req *pipedservice.PutApplicationSharedObjectRequest) (*pipedservice.PutApplicationSharedObjectResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.applicationSharedObjects[applicationSharedObjectKey(req.ApplicationId, req.PluginName, req.Key)] = req.Object
+	return &pipedservice.PutApplicationSharedObjectResponse{}, nil
+}
+
+// ListStageCommands returns the commands of the given stage.
+// The commands queued for the stage name are bound to the first stage listing them.
+func (s *pluginService) ListStageCommands(_ context.Context, req *pipedservice.ListStageCommandsRequest) (*pipedservice.ListStageCommandsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if name, ok := s.stageNames[req.StageId]; ok {
+		for _, cmd := range s.queuedCommands[name] {
+			cmd.DeploymentId = req.DeploymentId
+			cmd.StageId = req.StageId
+			s.stageCommands[req.StageId] = append(s.stageCommands[req.StageId], cmd)
+		}
+		delete(s.queuedCommands, name)
+	}
+	return &pipedservice.ListStageCommandsResponse{Commands: s.stageCommands[req.StageId]}, nil
+}
+
+// queueCommand queues the command for the next stage which has the given name.
+func (s *pluginService) queueCommand(stageName string, typ model.Command_Type, commander string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.commandCount++
+	s.queuedCommands[stageName] = append(s.queuedCommands[stageName], &model.Command{
+		Id:        fmt.Sprintf("command-%d", s.commandCount),
+		Type:      typ,
+		Commander: commander,
+	})
+}
+
+// registerStage makes the queued commands for the stage name available to the stage.
+func (s *pluginService) registerStage(stageID, stageName string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stageNames[stageID] = stageName
+}
+
+// markStageCommandsHandled marks all commands of the stage as handled
+// as piped does after the stage is completed.
+func (s *pluginService) markStageCommandsHandled(stageID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.handledCommands = append(s.handledCommands, s.stageCommands[stageID]...)
+	delete(s.stageCommands, stageID)
+	delete(s.stageNames, stageID)
+}
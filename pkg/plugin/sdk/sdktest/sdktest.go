@@ -0,0 +1,507 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sdktest provides a harness to test a plugin end-to-end without a real piped.
+// It starts the plugin's gRPC services in-process with a fake piped plugin service,
+// drives a whole deployment from an application config file like piped does,
+// and records the metadata, logs and commands so that they can be asserted in tests.
+package sdktest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	config "github.com/pipe-cd/pipecd/pkg/configv1"
+	"github.com/pipe-cd/pipecd/pkg/model"
+	"github.com/pipe-cd/pipecd/pkg/plugin/api/v1alpha1/common"
+	"github.com/pipe-cd/pipecd/pkg/plugin/api/v1alpha1/deployment"
+	"github.com/pipe-cd/pipecd/pkg/plugin/pipedservice"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+const (
+	defaultApplicationID = "test-application"
+	defaultPipedID       = "test-piped"
+	defaultProjectID     = "test-project"
+)
+
+// Option configures the Harness.
+type Option func(*options)
+
+type options struct {
+	pluginConfig  json.RawMessage
+	deployTargets []config.PipedDeployTarget
+	logger        *zap.Logger
+}
+
+// WithPluginConfig sets the plugin config which is usually defined in the piped config.
+// The given value is marshaled into JSON.
+func WithPluginConfig(cfg any) Option {
+	return func(o *options) {
+		o.pluginConfig = mustMarshalJSON(cfg)
+	}
+}
+
+// WithDeployTarget adds a deploy target with the given config.
+// The given config is marshaled into JSON.
+func WithDeployTarget(name string, cfg any) Option {
+	return func(o *options) {
+		o.deployTargets = append(o.deployTargets, config.PipedDeployTarget{
+			Name:   name,
+			Config: mustMarshalJSON(cfg),
+		})
+	}
+}
+
+// WithLogger sets the logger passed to the plugin.
+// The zaptest logger is used by default.
+func WithLogger(logger *zap.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+func mustMarshalJSON(v any) json.RawMessage {
+	if raw, ok := v.(json.RawMessage); ok {
+		return raw
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(fmt.Sprintf("failed to marshal %v into JSON: %v", v, err))
+	}
+	return data
+}
+
+// Harness runs a plugin in-process and drives deployments against it.
+type Harness struct {
+	t             *testing.T
+	pluginName    string
+	deployTargets []string
+
+	service *pluginService
+	logs    *logStore
+	client  deployment.DeploymentServiceClient
+
+	deploymentCount atomic.Int64
+}
+
+// NewHarness starts the given plugin in-process with a fake piped plugin service.
+// Everything started by the harness is stopped when the test finishes.
+// When an error occurs, it will call t.Fatal/t.Fatalf and stop the test.
+func NewHarness[Config, DeployTargetConfig, ApplicationConfigSpec any](t *testing.T, pluginName string, plugin *sdk.Plugin[Config, DeployTargetConfig, ApplicationConfigSpec], opts ...Option) *Harness {
+	t.Helper()
+
+	o := &options{
+		logger: zaptest.NewLogger(t),
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	h := &Harness{
+		t:          t,
+		pluginName: pluginName,
+		service:    newPluginService(),
+		logs:       newLogStore(),
+	}
+	for _, dt := range o.deployTargets {
+		h.deployTargets = append(h.deployTargets, dt.Name)
+	}
+
+	// Start the fake piped plugin service.
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %s", err)
+	}
+	server := grpc.NewServer()
+	pipedservice.RegisterPluginServiceServer(server, h.service)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	// Start the plugin.
+	cfg := &config.PipedPlugin{
+		Name:          pluginName,
+		Config:        o.pluginConfig,
+		DeployTargets: o.deployTargets,
+	}
+	address, err := plugin.StartForTest(ctx, lis.Addr().String(), cfg, h.logs, o.logger)
+	if err != nil {
+		t.Fatalf("failed to start plugin: %s", err)
+	}
+
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("failed to connect to plugin: %s", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	h.client = deployment.NewDeploymentServiceClient(conn)
+
+	return h
+}
+
+// Client returns the raw gRPC client of the plugin's deployment service.
+// Use this to call the APIs which are not covered by the harness.
+func (h *Harness) Client() deployment.DeploymentServiceClient {
+	return h.client
+}
+
+// ApproveStage queues an APPROVE_STAGE command which will be delivered to
+// the next executed stage with the given name.
+func (h *Harness) ApproveStage(stageName, commander string) {
+	h.service.queueCommand(stageName, model.Command_APPROVE_STAGE, commander)
+}
+
+// SkipStage queues a SKIP_STAGE command which will be delivered to
+// the next executed stage with the given name.
+func (h *Harness) SkipStage(stageName, commander string) {
+	h.service.queueCommand(stageName, model.Command_SKIP_STAGE, commander)
+}
+
+// PutDeploymentSharedMetadata sets the metadata which is shared among piped and plugins.
+// Plugins can only read it.
+func (h *Harness) PutDeploymentSharedMetadata(deploymentID string, metadata map[string]string) {
+	h.service.mu.Lock()
+	defer h.service.mu.Unlock()
+
+	if _, ok := h.service.deploymentSharedMetadata[deploymentID]; !ok {
+		h.service.deploymentSharedMetadata[deploymentID] = make(map[string]string)
+	}
+	putMetadata(h.service.deploymentSharedMetadata[deploymentID], metadata)
+}
+
+// ApplicationSharedObject returns the application object stored by the plugin.
+func (h *Harness) ApplicationSharedObject(applicationID, key string) ([]byte, bool) {
+	h.service.mu.Lock()
+	defer h.service.mu.Unlock()
+
+	obj, ok := h.service.applicationSharedObjects[applicationSharedObjectKey(applicationID, h.pluginName, key)]
+	return obj, ok
+}
+
+// HandledCommands returns the commands of the completed stages.
+func (h *Harness) HandledCommands() []*model.Command {
+	h.service.mu.Lock()
+	defer h.service.mu.Unlock()
+
+	return slices.Clone(h.service.handledCommands)
+}
+
+// DeploymentInput is the input to run a deployment.
+type DeploymentInput struct {
+	// ID is the deployment ID.
+	// A unique ID is generated when it is empty.
+	ID string
+	// ApplicationID is the application ID.
+	// Default is "test-application".
+	ApplicationID string
+	// AppConfigFile is the path to the application config file of the target deployment source.
+	// Its directory is used as the application directory.
+	AppConfigFile string
+	// RunningAppConfigFile is the path to the application config file of the running deployment source.
+	// Leave it empty to simulate the first deployment.
+	RunningAppConfigFile string
+	// CommitHash is the commit hash of the target deployment source.
+	CommitHash string
+	// RunningCommitHash is the commit hash of the running deployment source.
+	RunningCommitHash string
+	// DeployTargets is the list of the deploy target names used by the deployment.
+	// All deploy targets given by WithDeployTarget are used when it is empty.
+	DeployTargets []string
+	// QuickSync forces to deploy by the quick sync stages even when the pipeline is defined.
+	QuickSync bool
+}
+
+// DeploymentResult is the result of a deployment.
+type DeploymentResult struct {
+	// Deployment is the deployment passed to the plugin.
+	Deployment *model.Deployment
+	// Status is the final status of the deployment.
+	Status model.DeploymentStatus
+	// Stages are the executed stages in the execution order, including the rollback stages.
+	Stages []*StageResult
+
+	harness *Harness
+}
+
+// StageResult is the result of a stage.
+type StageResult struct {
+	// Stage is the stage built by the plugin.
+	Stage *model.PipelineStage
+	// Status is the final status of the stage.
+	Status model.StageStatus
+	// Err is the error returned by ExecuteStage.
+	Err error
+}
+
+// Stage returns the first executed stage with the given name.
+func (r *DeploymentResult) Stage(name string) (*StageResult, bool) {
+	for _, s := range r.Stages {
+		if s.Stage.Name == name {
+			return s, true
+		}
+	}
+	return nil, false
+}
+
+// StageLogs returns the logs written by the given stage.
+func (r *DeploymentResult) StageLogs(stage *StageResult) []LogBlock {
+	return r.harness.logs.get(stage.Stage.Id, stage.Stage.RetriedCount)
+}
+
+// StageMetadata returns the metadata stored for the given stage.
+func (r *DeploymentResult) StageMetadata(stage *StageResult) map[string]string {
+	r.harness.service.mu.Lock()
+	defer r.harness.service.mu.Unlock()
+
+	return cloneMap(r.harness.service.stageMetadata[r.Deployment.Id][stage.Stage.Id])
+}
+
+// PluginMetadata returns the deployment metadata stored by the plugin.
+func (r *DeploymentResult) PluginMetadata() map[string]string {
+	r.harness.service.mu.Lock()
+	defer r.harness.service.mu.Unlock()
+
+	return cloneMap(r.harness.service.deploymentPluginMetadata[r.Deployment.Id][r.harness.pluginName])
+}
+
+func cloneMap(m map[string]string) map[string]string {
+	out := make(map[string]string, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
+
+// RunDeployment drives a whole deployment like piped does.
+// It builds the stages by BuildPipelineSyncStages or BuildQuickSyncStages,
+// executes them in order by ExecuteStage and executes the rollback stages when a stage failed.
+// When an error occurs before executing stages, it will call t.Fatal/t.Fatalf and stop the test.
+func (h *Harness) RunDeployment(ctx context.Context, in DeploymentInput) *DeploymentResult {
+	h.t.Helper()
+
+	target, spec := h.loadDeploymentSource(in.AppConfigFile, in.CommitHash)
+	var running *common.DeploymentSource
+	if in.RunningAppConfigFile != "" {
+		running, _ = h.loadDeploymentSource(in.RunningAppConfigFile, in.RunningCommitHash)
+	}
+
+	var (
+		d         = h.newDeployment(in, spec)
+		quickSync = in.QuickSync || len(spec.Pipeline.Stages) == 0
+		stages    = h.buildStages(ctx, spec, quickSync)
+	)
+
+	result := &DeploymentResult{
+		Deployment: d,
+		Status:     model.DeploymentStatus_DEPLOYMENT_SUCCESS,
+		harness:    h,
+	}
+
+	// Execute the non-rollback stages in order.
+	var rollbackStages []*model.PipelineStage
+	for _, s := range stages {
+		if s.Rollback {
+			rollbackStages = append(rollbackStages, s)
+			continue
+		}
+		if result.Status != model.DeploymentStatus_DEPLOYMENT_SUCCESS || isExited(result) {
+			continue
+		}
+
+		sr := h.executeStage(ctx, d, s, spec, quickSync, running, target)
+		result.Stages = append(result.Stages, sr)
+		switch sr.Status {
+		case model.StageStatus_STAGE_FAILURE:
+			result.Status = model.DeploymentStatus_DEPLOYMENT_FAILURE
+		case model.StageStatus_STAGE_CANCELLED:
+			result.Status = model.DeploymentStatus_DEPLOYMENT_CANCELLED
+		}
+	}
+
+	// Execute the rollback stages when the deployment did not succeed.
+	if result.Status != model.DeploymentStatus_DEPLOYMENT_SUCCESS {
+		for _, s := range rollbackStages {
+			result.Stages = append(result.Stages, h.executeStage(ctx, d, s, spec, quickSync, running, target))
+		}
+	}
+
+	d.Status = result.Status
+	return result
+}
+
+func isExited(r *DeploymentResult) bool {
+	return len(r.Stages) > 0 && r.Stages[len(r.Stages)-1].Status == model.StageStatus_STAGE_EXITED
+}
+
+func (h *Harness) loadDeploymentSource(appConfigFile, commitHash string) (*common.DeploymentSource, *config.GenericApplicationSpec) {
+	h.t.Helper()
+
+	data, err := os.ReadFile(appConfigFile)
+	if err != nil {
+		h.t.Fatalf("failed to read application config: %s", err)
+	}
+	cfg, err := config.DecodeYAML[*config.GenericApplicationSpec](data)
+	if err != nil {
+		h.t.Fatalf("failed to decode application config: %s", err)
+	}
+	if cfg.Spec == nil {
+		h.t.Fatal("application config is not set")
+	}
+
+	dir, err := filepath.Abs(filepath.Dir(appConfigFile))
+	if err != nil {
+		h.t.Fatalf("failed to get application directory: %s", err)
+	}
+	return &common.DeploymentSource{
+		ApplicationDirectory:      dir,
+		CommitHash:                commitHash,
+		ApplicationConfig:         data,
+		ApplicationConfigFilename: filepath.Base(appConfigFile),
+	}, cfg.Spec
+}
+
+func (h *Harness) newDeployment(in DeploymentInput, spec *config.GenericApplicationSpec) *model.Deployment {
+	id := in.ID
+	if id == "" {
+		id = fmt.Sprintf("test-deployment-%d", h.deploymentCount.Add(1))
+	}
+	applicationID := in.ApplicationID
+	if applicationID == "" {
+		applicationID = defaultApplicationID
+	}
+	deployTargets := in.DeployTargets
+	if len(deployTargets) == 0 {
+		deployTargets = h.deployTargets
+	}
+
+	now := time.Now().Unix()
+	return &model.Deployment{
+		Id:              id,
+		ApplicationId:   applicationID,
+		ApplicationName: spec.Name,
+		PipedId:         defaultPipedID,
+		ProjectId:       defaultProjectID,
+		Labels:          spec.Labels,
+		DeployTargetsByPlugin: map[string]*model.DeployTargets{
+			h.pluginName: {DeployTargets: deployTargets},
+		},
+		Trigger: &model.DeploymentTrigger{
+			Commit: &model.Commit{
+				Hash:      in.CommitHash,
+				CreatedAt: now,
+			},
+			Timestamp: now,
+		},
+		Status:    model.DeploymentStatus_DEPLOYMENT_RUNNING,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// buildStages builds the stages by the plugin and sorts them like the planner of piped does.
+func (h *Harness) buildStages(ctx context.Context, spec *config.GenericApplicationSpec, quickSync bool) []*model.PipelineStage {
+	h.t.Helper()
+
+	rollback := spec.Planner.AutoRollback == nil || *spec.Planner.AutoRollback
+
+	var built []*model.PipelineStage
+	if quickSync {
+		resp, err := h.client.BuildQuickSyncStages(ctx, &deployment.BuildQuickSyncStagesRequest{Rollback: rollback})
+		if err != nil {
+			h.t.Fatalf("failed to build quick sync stages: %s", err)
+		}
+		built = resp.Stages
+	} else {
+		defined, err := h.client.FetchDefinedStages(ctx, &deployment.FetchDefinedStagesRequest{})
+		if err != nil {
+			h.t.Fatalf("failed to fetch defined stages: %s", err)
+		}
+		req := &deployment.BuildPipelineSyncStagesRequest{Rollback: rollback}
+		for i, s := range spec.Pipeline.Stages {
+			if !slices.Contains(defined.Stages, s.Name.String()) {
+				continue
+			}
+			req.Stages = append(req.Stages, &deployment.BuildPipelineSyncStagesRequest_StageConfig{
+				Name:   s.Name.String(),
+				Desc:   s.Desc,
+				Index:  int32(i),
+				Config: s.With,
+			})
+		}
+		resp, err := h.client.BuildPipelineSyncStages(ctx, req)
+		if err != nil {
+			h.t.Fatalf("failed to build pipeline sync stages: %s", err)
+		}
+		built = resp.Stages
+	}
+
+	var stages, rollbackStages []*model.PipelineStage
+	for i, s := range built {
+		s.Id = fmt.Sprintf("stage-%d", i)
+		if s.Rollback {
+			rollbackStages = append(rollbackStages, s)
+		} else {
+			stages = append(stages, s)
+		}
+	}
+	sort.Sort(model.PipelineStages(stages))
+	sort.Sort(model.PipelineStages(rollbackStages))
+	return append(stages, rollbackStages...)
+}
+
+func (h *Harness) executeStage(ctx context.Context, d *model.Deployment, s *model.PipelineStage, spec *config.GenericApplicationSpec, quickSync bool, running, target *common.DeploymentSource) *StageResult {
+	h.service.registerStage(s.Id, s.Name)
+	defer h.service.markStageCommandsHandled(s.Id)
+
+	// The quick sync stages have no stage config.
+	var stageConfig []byte
+	if !quickSync {
+		stageConfig, _ = spec.GetStageConfigByte(s.Index)
+	}
+
+	d.Stages = append(d.Stages, s)
+	resp, err := h.client.ExecuteStage(ctx, &deployment.ExecuteStageRequest{
+		Input: &deployment.ExecutePluginInput{
+			Deployment:              d,
+			Stage:                   s,
+			StageConfig:             stageConfig,
+			RunningDeploymentSource: running,
+			TargetDeploymentSource:  target,
+		},
+	})
+	if err != nil {
+		s.Status = model.StageStatus_STAGE_FAILURE
+		return &StageResult{Stage: s, Status: s.Status, Err: err}
+	}
+	s.Status = resp.Status
+	return &StageResult{Stage: s, Status: s.Status}
+}
@@ -0,0 +1,210 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdktest
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+type examplePluginConfig struct {
+	Prefix string `json:"prefix"`
+}
+
+type exampleApplicationSpec struct {
+	Message string `json:"message"`
+}
+
+type exampleStageConfig struct {
+	Fail bool `json:"fail"`
+}
+
+type examplePlugin struct{}
+
+func (examplePlugin) FetchDefinedStages() []string {
+	return []string{"EXAMPLE_APPLY", "EXAMPLE_APPROVAL", "EXAMPLE_ROLLBACK"}
+}
+
+func (examplePlugin) BuildPipelineSyncStages(_ context.Context, _ *examplePluginConfig, input *sdk.BuildPipelineSyncStagesInput) (*sdk.BuildPipelineSyncStagesResponse, error) {
+	stages := make([]sdk.PipelineStage, 0, len(input.Request.Stages)+1)
+	for _, s := range input.Request.Stages {
+		stages = append(stages, sdk.PipelineStage{
+			Index: s.Index,
+			Name:  s.Name,
+		})
+	}
+	if input.Request.Rollback && len(input.Request.Stages) > 0 {
+		stages = append(stages, sdk.PipelineStage{
+			Index:    input.Request.Stages[0].Index,
+			Name:     "EXAMPLE_ROLLBACK",
+			Rollback: true,
+		})
+	}
+	return &sdk.BuildPipelineSyncStagesResponse{Stages: stages}, nil
+}
+
+func (examplePlugin) ExecuteStage(ctx context.Context, cfg *examplePluginConfig, _ sdk.DeployTargetsNone, input *sdk.ExecuteStageInput[exampleApplicationSpec]) (*sdk.ExecuteStageResponse, error) {
+	lp, err := input.Client.StageLogPersister()
+	if err != nil {
+		return nil, err
+	}
+
+	switch input.Request.StageName {
+	case "EXAMPLE_APPLY":
+		var stageCfg exampleStageConfig
+		if err := json.Unmarshal(input.Request.StageConfig, &stageCfg); err != nil {
+			return nil, err
+		}
+		appCfg, err := input.Request.TargetDeploymentSource.AppConfig()
+		if err != nil {
+			return nil, err
+		}
+		lp.Infof("%s %s", cfg.Prefix, appCfg.Spec.Message)
+		if err := input.Client.PutStageMetadata(ctx, "applied", "true"); err != nil {
+			return nil, err
+		}
+		if err := input.Client.PutDeploymentPluginMetadata(ctx, "last-applied-stage", input.Request.StageName); err != nil {
+			return nil, err
+		}
+		if stageCfg.Fail {
+			lp.Error("failed to apply")
+			return &sdk.ExecuteStageResponse{Status: sdk.StageStatusFailure}, nil
+		}
+		lp.Success("applied")
+		return &sdk.ExecuteStageResponse{Status: sdk.StageStatusSuccess}, nil
+
+	case "EXAMPLE_APPROVAL":
+		for cmd, err := range input.Client.ListStageCommands(ctx, sdk.CommandTypeApproveStage, sdk.CommandTypeSkipStage) {
+			if err != nil {
+				return nil, err
+			}
+			if cmd.Type == sdk.CommandTypeSkipStage {
+				lp.Infof("skipped by %s", cmd.Commander)
+				return &sdk.ExecuteStageResponse{Status: sdk.StageStatusSkipped}, nil
+			}
+			lp.Successf("approved by %s", cmd.Commander)
+			return &sdk.ExecuteStageResponse{Status: sdk.StageStatusSuccess}, nil
+		}
+		return &sdk.ExecuteStageResponse{Status: sdk.StageStatusFailure}, nil
+
+	case "EXAMPLE_ROLLBACK":
+		lp.Info("rolled back")
+		return &sdk.ExecuteStageResponse{Status: sdk.StageStatusSuccess}, nil
+	}
+	return &sdk.ExecuteStageResponse{Status: sdk.StageStatusFailure}, nil
+}
+
+func newExampleHarness(t *testing.T) *Harness {
+	plugin, err := sdk.NewPlugin("v0.0.1", sdk.WithStagePlugin[examplePluginConfig, struct{}, exampleApplicationSpec](examplePlugin{}))
+	require.NoError(t, err)
+	return NewHarness(t, "example", plugin, WithPluginConfig(examplePluginConfig{Prefix: "message:"}))
+}
+
+func TestHarness_RunDeployment(t *testing.T) {
+	t.Parallel()
+
+	h := newExampleHarness(t)
+	h.ApproveStage("EXAMPLE_APPROVAL", "user-1")
+
+	result := h.RunDeployment(context.Background(), DeploymentInput{
+		AppConfigFile: "testdata/app.pipecd.yaml",
+		CommitHash:    "0123456789",
+	})
+
+	assert.Equal(t, model.DeploymentStatus_DEPLOYMENT_SUCCESS, result.Status)
+	assert.Equal(t, "example", result.Deployment.ApplicationName)
+	assert.Equal(t, map[string]string{"env": "test"}, result.Deployment.Labels)
+
+	require.Len(t, result.Stages, 3)
+	for i, name := range []string{"EXAMPLE_APPLY", "EXAMPLE_APPROVAL", "EXAMPLE_APPLY"} {
+		assert.Equal(t, name, result.Stages[i].Stage.Name)
+		assert.Equal(t, model.StageStatus_STAGE_SUCCESS, result.Stages[i].Status)
+		assert.NoError(t, result.Stages[i].Err)
+	}
+
+	apply, ok := result.Stage("EXAMPLE_APPLY")
+	require.True(t, ok)
+	assert.Equal(t, []LogBlock{
+		{Severity: model.LogSeverity_INFO, Log: "message: hello"},
+		{Severity: model.LogSeverity_SUCCESS, Log: "applied"},
+	}, result.StageLogs(apply))
+	assert.Equal(t, map[string]string{"applied": "true"}, result.StageMetadata(apply))
+	assert.Equal(t, map[string]string{"last-applied-stage": "EXAMPLE_APPLY"}, result.PluginMetadata())
+
+	approval, ok := result.Stage("EXAMPLE_APPROVAL")
+	require.True(t, ok)
+	assert.Equal(t, []LogBlock{
+		{Severity: model.LogSeverity_SUCCESS, Log: "approved by user-1"},
+	}, result.StageLogs(approval))
+
+	commands := h.HandledCommands()
+	require.Len(t, commands, 1)
+	assert.Equal(t, model.Command_APPROVE_STAGE, commands[0].Type)
+	assert.Equal(t, result.Deployment.Id, commands[0].DeploymentId)
+	assert.Equal(t, approval.Stage.Id, commands[0].StageId)
+}
+
+func TestHarness_RunDeployment_SkipStage(t *testing.T) {
+	t.Parallel()
+
+	h := newExampleHarness(t)
+	h.SkipStage("EXAMPLE_APPROVAL", "user-1")
+
+	result := h.RunDeployment(context.Background(), DeploymentInput{
+		AppConfigFile: "testdata/app.pipecd.yaml",
+	})
+
+	assert.Equal(t, model.DeploymentStatus_DEPLOYMENT_SUCCESS, result.Status)
+	approval, ok := result.Stage("EXAMPLE_APPROVAL")
+	require.True(t, ok)
+	assert.Equal(t, model.StageStatus_STAGE_SKIPPED, approval.Status)
+}
+
+func TestHarness_RunDeployment_Rollback(t *testing.T) {
+	t.Parallel()
+
+	h := newExampleHarness(t)
+
+	result := h.RunDeployment(context.Background(), DeploymentInput{
+		AppConfigFile:        "testdata/app-failure.pipecd.yaml",
+		RunningAppConfigFile: "testdata/app.pipecd.yaml",
+	})
+
+	assert.Equal(t, model.DeploymentStatus_DEPLOYMENT_FAILURE, result.Status)
+
+	// The stages after the failed one are not executed and the rollback stage is executed instead.
+	require.Len(t, result.Stages, 3)
+	assert.Equal(t, "EXAMPLE_APPLY", result.Stages[0].Stage.Name)
+	assert.Equal(t, model.StageStatus_STAGE_SUCCESS, result.Stages[0].Status)
+	assert.Equal(t, "EXAMPLE_APPLY", result.Stages[1].Stage.Name)
+	assert.Equal(t, model.StageStatus_STAGE_FAILURE, result.Stages[1].Status)
+	assert.Equal(t, "EXAMPLE_ROLLBACK", result.Stages[2].Stage.Name)
+	assert.True(t, result.Stages[2].Stage.Rollback)
+	assert.Equal(t, model.StageStatus_STAGE_SUCCESS, result.Stages[2].Status)
+	assert.Equal(t, []LogBlock{
+		{Severity: model.LogSeverity_INFO, Log: "rolled back"},
+	}, result.StageLogs(result.Stages[2]))
+
+	_, ok := result.Stage("EXAMPLE_APPROVAL")
+	assert.False(t, ok)
+}
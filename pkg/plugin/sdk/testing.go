@@ -0,0 +1,75 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	config "github.com/pipe-cd/pipecd/pkg/configv1"
+
+	"github.com/pipe-cd/piped-plugin-sdk-go/logpersister"
+)
+
+// StageLogPersisterProvider provides the stage log persister for each stage.
+// It is used by StartForTest to capture the stage logs in memory.
+type StageLogPersisterProvider interface {
+	RetriedStageLogPersister(deploymentID, stageID string, retriedCount int32) logpersister.StageLogPersister
+}
+
+// StartForTest starts the gRPC services of the plugin in-process and returns the address they listen on.
+// The plugin connects to the piped plugin service at the given address, and the stage logs are
+// persisted by the given provider instead of being sent to the piped plugin service.
+// The services are stopped when the given context is done.
+//
+// DO NOT USE this function except in tests. Use the sdktest package instead.
+//
+// NOTE: we want to put this function under package for testing like sdktest, but we can't do that
+// because the plugin implementations and the services serving them are private fields.
+func (p *Plugin[Config, DeployTargetConfig, ApplicationConfigSpec]) StartForTest(ctx context.Context, pipedPluginService string, cfg *config.PipedPlugin, persister StageLogPersisterProvider, logger *zap.Logger) (string, error) {
+	client, err := newPluginServiceClient(ctx, pipedPluginService)
+	if err != nil {
+		return "", fmt.Errorf("failed to create piped plugin service client: %w", err)
+	}
+
+	services, err := p.newServices(ctx, cfg, client, persister, logger)
+	if err != nil {
+		client.Close()
+		return "", err
+	}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		client.Close()
+		return "", fmt.Errorf("failed to listen: %w", err)
+	}
+
+	server := grpc.NewServer()
+	for _, s := range services {
+		s.Register(server)
+	}
+	go server.Serve(lis)
+	go func() {
+		<-ctx.Done()
+		server.Stop()
+		client.Close()
+	}()
+
+	return lis.Addr().String(), nil
+}
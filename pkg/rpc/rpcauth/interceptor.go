@@ -168,6 +168,31 @@ func APIKeyUnaryServerInterceptor(verifier APIKeyVerifier, logger *zap.Logger) g
 	}
 }
 
+// APIKeyStreamServerInterceptor validates the API key in the stream context.
+func APIKeyStreamServerInterceptor(verifier APIKeyVerifier, logger *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := stream.Context()
+		creds, err := extractCredentials(ctx)
+		if err != nil {
+			return err
+		}
+		if creds.Type != APIKeyCredentials {
+			logger.Warn("wrong credentials type for APIKeyCredentials", zap.Any("credentials", creds))
+			return errUnauthenticated
+		}
+		apiKey, err := verifier.Verify(ctx, creds.Data)
+		if err != nil {
+			logger.Warn("unable to verify api key", zap.Error(err))
+			return errUnauthenticated
+		}
+		wrappedStream := &wrappedServerStream{
+			ServerStream: stream,
+			ctx:          ContextWithAPIKey(ctx, apiKey),
+		}
+		return handler(srv, wrappedStream)
+	}
+}
+
 // ContextWithAPIKey returns a new context in which the given API key was attached.
 func ContextWithAPIKey(ctx context.Context, k *model.APIKey) context.Context {
 	return context.WithValue(ctx, apiKeyKey, k)
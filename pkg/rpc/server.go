@@ -51,6 +51,7 @@ type Server struct {
 	pipedKeyAuthUnaryInterceptor      grpc.UnaryServerInterceptor
 	pipedKeyAuthStreamInterceptor     grpc.StreamServerInterceptor
 	apiKeyAuthUnaryInterceptor        grpc.UnaryServerInterceptor
+	apiKeyAuthStreamInterceptor       grpc.StreamServerInterceptor
 	jwtAuthUnaryInterceptor           grpc.UnaryServerInterceptor
 	requestValidationUnaryInterceptor grpc.UnaryServerInterceptor
 	logUnaryInterceptor               grpc.UnaryServerInterceptor
@@ -89,6 +90,13 @@ func WithAPIKeyAuthUnaryInterceptor(verifier rpcauth.APIKeyVerifier, logger *zap
 	}
 }
 
+// WithAPIKeyAuthStreamInterceptor sets an interceptor for validating API key.
+func WithAPIKeyAuthStreamInterceptor(verifier rpcauth.APIKeyVerifier, logger *zap.Logger) Option {
+	return func(s *Server) {
+		s.apiKeyAuthStreamInterceptor = rpcauth.APIKeyStreamServerInterceptor(verifier, logger)
+	}
+}
+
 // WithJWTAuthUnaryInterceptor sets an interceprot for checking JWT token.
 func WithJWTAuthUnaryInterceptor(verifier jwt.Verifier, authorizer rpcauth.RBACAuthorizer, logger *zap.Logger) Option {
 	return func(s *Server) {
@@ -235,8 +243,15 @@ func (s *Server) init() error {
 		c := ChainUnaryServerInterceptors(unaryInterceptors...)
 		opts = append(opts, grpc.UnaryInterceptor(c))
 	}
+	var streamInterceptors []grpc.StreamServerInterceptor
 	if s.pipedKeyAuthStreamInterceptor != nil {
-		opts = append(opts, grpc.StreamInterceptor(s.pipedKeyAuthStreamInterceptor))
+		streamInterceptors = append(streamInterceptors, s.pipedKeyAuthStreamInterceptor)
+	}
+	if s.apiKeyAuthStreamInterceptor != nil {
+		streamInterceptors = append(streamInterceptors, s.apiKeyAuthStreamInterceptor)
+	}
+	if len(streamInterceptors) > 0 {
+		opts = append(opts, grpc.ChainStreamInterceptor(streamInterceptors...))
 	}
 	if s.maxRecvMsgSize != 0 {
 		opts = append(opts, grpc.MaxRecvMsgSize(s.maxRecvMsgSize))
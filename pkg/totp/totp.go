@@ -0,0 +1,177 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package totp implements the time-based one-time password algorithm
+// described in RFC 6238 with the parameters used by common authenticator apps.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	secretSize = 20
+	digits     = 6
+	period     = 30 * time.Second
+	// The number of periods before and after the current one
+	// in which a passcode is still accepted to tolerate clock drift.
+	skew = 1
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a new random secret encoded in base32.
+func GenerateSecret() (string, error) {
+	b := make([]byte, secretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return encoding.EncodeToString(b), nil
+}
+
+// URL returns the otpauth URL which can be imported into authenticator apps.
+func URL(issuer, account, secret string) string {
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", issuer)
+	v.Set("digits", fmt.Sprintf("%d", digits))
+	v.Set("period", fmt.Sprintf("%d", int(period.Seconds())))
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: v.Encode(),
+	}
+	return u.String()
+}
+
+// Generate returns the passcode of the given secret at the given time.
+func Generate(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return generate(key, counter(t)), nil
+}
+
+// Validate checks whether the given passcode is valid for the secret at the given time.
+func Validate(secret, passcode string, t time.Time) error {
+	_, err := validate(secret, passcode, t)
+	return err
+}
+
+// validate checks the given passcode and returns the counter of the period it was generated for.
+func validate(secret, passcode string, t time.Time) (uint64, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, err
+	}
+	passcode = strings.TrimSpace(passcode)
+	if len(passcode) != digits {
+		return 0, fmt.Errorf("passcode must be %d digits", digits)
+	}
+
+	c := counter(t)
+	for i := -skew; i <= skew; i++ {
+		cur := uint64(int64(c) + int64(i))
+		expected := generate(key, cur)
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(passcode)) == 1 {
+			return cur, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid passcode")
+}
+
+// ReplayGuard validates passcodes while rejecting the ones which were already accepted
+// for the same account, as required by RFC 6238 section 5.2.
+// The accepted periods are kept in memory only while their passcodes are still valid.
+type ReplayGuard struct {
+	mu sync.Mutex
+	// The counter of the last accepted period of each account.
+	used map[string]uint64
+}
+
+// NewReplayGuard returns a new empty ReplayGuard.
+func NewReplayGuard() *ReplayGuard {
+	return &ReplayGuard{
+		used: make(map[string]uint64),
+	}
+}
+
+// Validate checks whether the given passcode is valid for the secret at the given time
+// and was not generated for the same or an earlier period than the last accepted one of the account.
+func (g *ReplayGuard) Validate(account, secret, passcode string, t time.Time) error {
+	c, err := validate(secret, passcode, t)
+	if err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	// Forget the periods whose passcodes are no longer accepted anyway.
+	now := counter(t)
+	for k, v := range g.used {
+		if v+skew < now {
+			delete(g.used, k)
+		}
+	}
+	if last, ok := g.used[account]; ok && c <= last {
+		return fmt.Errorf("passcode was already used")
+	}
+	g.used[account] = c
+	return nil
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	key, err := encoding.DecodeString(strings.TrimRight(secret, "="))
+	if err != nil {
+		return nil, fmt.Errorf("invalid secret: %w", err)
+	}
+	return key, nil
+}
+
+func counter(t time.Time) uint64 {
+	return uint64(t.Unix() / int64(period.Seconds()))
+}
+
+func generate(key []byte, counter uint64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation as described in RFC 4226 section 5.3.
+	offset := sum[len(sum)-1] & 0x0f
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, code%mod)
+}
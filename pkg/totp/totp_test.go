@@ -0,0 +1,148 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package totp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The base32 encoded form of the "12345678901234567890" secret used by the RFC 6238 test vectors.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestGenerate(t *testing.T) {
+	// The expected values are the last 6 digits of the SHA1 test vectors in RFC 6238 Appendix B.
+	testcases := []struct {
+		unix     int64
+		expected string
+	}{
+		{unix: 59, expected: "287082"},
+		{unix: 1111111109, expected: "081804"},
+		{unix: 1111111111, expected: "050471"},
+		{unix: 1234567890, expected: "005924"},
+		{unix: 2000000000, expected: "279037"},
+		{unix: 20000000000, expected: "353130"},
+	}
+	for _, tc := range testcases {
+		code, err := Generate(rfcSecret, time.Unix(tc.unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, code, "unix time %d", tc.unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+
+	testcases := []struct {
+		name     string
+		secret   string
+		passcode string
+		wantErr  bool
+	}{
+		{
+			name:     "current passcode",
+			secret:   rfcSecret,
+			passcode: "005924",
+		},
+		{
+			name:     "passcode of the previous period",
+			secret:   rfcSecret,
+			passcode: mustGenerate(t, rfcSecret, now.Add(-period)),
+		},
+		{
+			name:     "passcode of the next period",
+			secret:   rfcSecret,
+			passcode: mustGenerate(t, rfcSecret, now.Add(period)),
+		},
+		{
+			name:     "expired passcode",
+			secret:   rfcSecret,
+			passcode: mustGenerate(t, rfcSecret, now.Add(-3*period)),
+			wantErr:  true,
+		},
+		{
+			name:     "wrong length",
+			secret:   rfcSecret,
+			passcode: "12345",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid secret",
+			secret:   "not-base32!",
+			passcode: "005924",
+			wantErr:  true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Validate(tc.secret, tc.passcode, now)
+			assert.Equal(t, tc.wantErr, err != nil, "%v", err)
+		})
+	}
+}
+
+func TestReplayGuard(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	current := mustGenerate(t, rfcSecret, now)
+	previous := mustGenerate(t, rfcSecret, now.Add(-period))
+	next := mustGenerate(t, rfcSecret, now.Add(period))
+
+	g := NewReplayGuard()
+	require.NoError(t, g.Validate("alice", rfcSecret, current, now))
+
+	// The same passcode cannot be used twice.
+	assert.Error(t, g.Validate("alice", rfcSecret, current, now))
+	// The passcode of an earlier period cannot be used after a later one was accepted.
+	assert.Error(t, g.Validate("alice", rfcSecret, previous, now))
+	// The other accounts are not affected.
+	assert.NoError(t, g.Validate("bob", rfcSecret, current, now))
+	// The passcode of a later period is still accepted.
+	assert.NoError(t, g.Validate("alice", rfcSecret, next, now.Add(period)))
+	// Invalid passcodes are rejected as usual.
+	assert.Error(t, g.Validate("carol", rfcSecret, "000000", now))
+
+	// The accepted periods are forgotten once their passcodes expired.
+	later := now.Add(10 * period)
+	require.NoError(t, g.Validate("dave", rfcSecret, mustGenerate(t, rfcSecret, later), later))
+	assert.Len(t, g.used, 1)
+}
+
+func TestGenerateSecret(t *testing.T) {
+	s1, err := GenerateSecret()
+	require.NoError(t, err)
+	s2, err := GenerateSecret()
+	require.NoError(t, err)
+
+	assert.Len(t, s1, 32)
+	assert.NotEqual(t, s1, s2)
+
+	code, err := Generate(s1, time.Now())
+	require.NoError(t, err)
+	assert.NoError(t, Validate(s1, code, time.Now()))
+}
+
+func TestURL(t *testing.T) {
+	got := URL("PipeCD", "alice", rfcSecret)
+	assert.Equal(t, "otpauth://totp/PipeCD:alice?digits=6&issuer=PipeCD&period=30&secret="+rfcSecret, got)
+}
+
+func mustGenerate(t *testing.T, secret string, at time.Time) string {
+	code, err := Generate(secret, at)
+	require.NoError(t, err)
+	return code
+}
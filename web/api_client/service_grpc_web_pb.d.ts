@@ -141,6 +141,13 @@ export class WebServiceClient {
                response: pkg_app_server_service_webservice_service_pb.SyncApplicationResponse) => void
   ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.SyncApplicationResponse>;
 
+  syncApplicationBypassingFreeze(
+    request: pkg_app_server_service_webservice_service_pb.SyncApplicationBypassingFreezeRequest,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: pkg_app_server_service_webservice_service_pb.SyncApplicationBypassingFreezeResponse) => void
+  ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.SyncApplicationBypassingFreezeResponse>;
+
   getApplication(
     request: pkg_app_server_service_webservice_service_pb.GetApplicationRequest,
     metadata: grpcWeb.Metadata | undefined,
@@ -183,6 +190,13 @@ export class WebServiceClient {
                response: pkg_app_server_service_webservice_service_pb.GetStageLogResponse) => void
   ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.GetStageLogResponse>;
 
+  searchStageLogs(
+    request: pkg_app_server_service_webservice_service_pb.SearchStageLogsRequest,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: pkg_app_server_service_webservice_service_pb.SearchStageLogsResponse) => void
+  ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.SearchStageLogsResponse>;
+
   cancelDeployment(
     request: pkg_app_server_service_webservice_service_pb.CancelDeploymentRequest,
     metadata: grpcWeb.Metadata | undefined,
@@ -197,6 +211,13 @@ export class WebServiceClient {
                response: pkg_app_server_service_webservice_service_pb.SkipStageResponse) => void
   ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.SkipStageResponse>;
 
+  retryStage(
+    request: pkg_app_server_service_webservice_service_pb.RetryStageRequest,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: pkg_app_server_service_webservice_service_pb.RetryStageResponse) => void
+  ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.RetryStageResponse>;
+
   approveStage(
     request: pkg_app_server_service_webservice_service_pb.ApproveStageRequest,
     metadata: grpcWeb.Metadata | undefined,
@@ -302,6 +323,83 @@ export class WebServiceClient {
                response: pkg_app_server_service_webservice_service_pb.DeleteProjectUserGroupResponse) => void
   ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.DeleteProjectUserGroupResponse>;
 
+  addProjectDeploymentFreezeWindow(
+    request: pkg_app_server_service_webservice_service_pb.AddProjectDeploymentFreezeWindowRequest,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: pkg_app_server_service_webservice_service_pb.AddProjectDeploymentFreezeWindowResponse) => void
+  ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.AddProjectDeploymentFreezeWindowResponse>;
+
+  deleteProjectDeploymentFreezeWindow(
+    request: pkg_app_server_service_webservice_service_pb.DeleteProjectDeploymentFreezeWindowRequest,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: pkg_app_server_service_webservice_service_pb.DeleteProjectDeploymentFreezeWindowResponse) => void
+  ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.DeleteProjectDeploymentFreezeWindowResponse>;
+
+  listStaticUsers(
+    request: pkg_app_server_service_webservice_service_pb.ListStaticUsersRequest,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: pkg_app_server_service_webservice_service_pb.ListStaticUsersResponse) => void
+  ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.ListStaticUsersResponse>;
+
+  addStaticUser(
+    request: pkg_app_server_service_webservice_service_pb.AddStaticUserRequest,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: pkg_app_server_service_webservice_service_pb.AddStaticUserResponse) => void
+  ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.AddStaticUserResponse>;
+
+  deleteStaticUser(
+    request: pkg_app_server_service_webservice_service_pb.DeleteStaticUserRequest,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: pkg_app_server_service_webservice_service_pb.DeleteStaticUserResponse) => void
+  ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.DeleteStaticUserResponse>;
+
+  updateStaticUserRole(
+    request: pkg_app_server_service_webservice_service_pb.UpdateStaticUserRoleRequest,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: pkg_app_server_service_webservice_service_pb.UpdateStaticUserRoleResponse) => void
+  ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.UpdateStaticUserRoleResponse>;
+
+  resetStaticUserPassword(
+    request: pkg_app_server_service_webservice_service_pb.ResetStaticUserPasswordRequest,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: pkg_app_server_service_webservice_service_pb.ResetStaticUserPasswordResponse) => void
+  ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.ResetStaticUserPasswordResponse>;
+
+  changeStaticUserPassword(
+    request: pkg_app_server_service_webservice_service_pb.ChangeStaticUserPasswordRequest,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: pkg_app_server_service_webservice_service_pb.ChangeStaticUserPasswordResponse) => void
+  ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.ChangeStaticUserPasswordResponse>;
+
+  generateStaticUserTOTPSecret(
+    request: pkg_app_server_service_webservice_service_pb.GenerateStaticUserTOTPSecretRequest,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: pkg_app_server_service_webservice_service_pb.GenerateStaticUserTOTPSecretResponse) => void
+  ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.GenerateStaticUserTOTPSecretResponse>;
+
+  enableStaticUserTOTP(
+    request: pkg_app_server_service_webservice_service_pb.EnableStaticUserTOTPRequest,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: pkg_app_server_service_webservice_service_pb.EnableStaticUserTOTPResponse) => void
+  ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.EnableStaticUserTOTPResponse>;
+
+  disableStaticUserTOTP(
+    request: pkg_app_server_service_webservice_service_pb.DisableStaticUserTOTPRequest,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: pkg_app_server_service_webservice_service_pb.DisableStaticUserTOTPResponse) => void
+  ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.DisableStaticUserTOTPResponse>;
+
   getCommand(
     request: pkg_app_server_service_webservice_service_pb.GetCommandRequest,
     metadata: grpcWeb.Metadata | undefined,
@@ -358,6 +456,13 @@ export class WebServiceClient {
                response: pkg_app_server_service_webservice_service_pb.GetDeploymentChainResponse) => void
   ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.GetDeploymentChainResponse>;
 
+  cancelDeploymentChain(
+    request: pkg_app_server_service_webservice_service_pb.CancelDeploymentChainRequest,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: pkg_app_server_service_webservice_service_pb.CancelDeploymentChainResponse) => void
+  ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.CancelDeploymentChainResponse>;
+
   listEvents(
     request: pkg_app_server_service_webservice_service_pb.ListEventsRequest,
     metadata: grpcWeb.Metadata | undefined,
@@ -467,6 +572,11 @@ export class WebServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<pkg_app_server_service_webservice_service_pb.SyncApplicationResponse>;
 
+  syncApplicationBypassingFreeze(
+    request: pkg_app_server_service_webservice_service_pb.SyncApplicationBypassingFreezeRequest,
+    metadata?: grpcWeb.Metadata
+  ): Promise<pkg_app_server_service_webservice_service_pb.SyncApplicationBypassingFreezeResponse>;
+
   getApplication(
     request: pkg_app_server_service_webservice_service_pb.GetApplicationRequest,
     metadata?: grpcWeb.Metadata
@@ -497,6 +607,11 @@ export class WebServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<pkg_app_server_service_webservice_service_pb.GetStageLogResponse>;
 
+  searchStageLogs(
+    request: pkg_app_server_service_webservice_service_pb.SearchStageLogsRequest,
+    metadata?: grpcWeb.Metadata
+  ): Promise<pkg_app_server_service_webservice_service_pb.SearchStageLogsResponse>;
+
   cancelDeployment(
     request: pkg_app_server_service_webservice_service_pb.CancelDeploymentRequest,
     metadata?: grpcWeb.Metadata
@@ -507,6 +622,11 @@ export class WebServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<pkg_app_server_service_webservice_service_pb.SkipStageResponse>;
 
+  retryStage(
+    request: pkg_app_server_service_webservice_service_pb.RetryStageRequest,
+    metadata?: grpcWeb.Metadata
+  ): Promise<pkg_app_server_service_webservice_service_pb.RetryStageResponse>;
+
   approveStage(
     request: pkg_app_server_service_webservice_service_pb.ApproveStageRequest,
     metadata?: grpcWeb.Metadata
@@ -582,6 +702,61 @@ export class WebServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<pkg_app_server_service_webservice_service_pb.DeleteProjectUserGroupResponse>;
 
+  addProjectDeploymentFreezeWindow(
+    request: pkg_app_server_service_webservice_service_pb.AddProjectDeploymentFreezeWindowRequest,
+    metadata?: grpcWeb.Metadata
+  ): Promise<pkg_app_server_service_webservice_service_pb.AddProjectDeploymentFreezeWindowResponse>;
+
+  deleteProjectDeploymentFreezeWindow(
+    request: pkg_app_server_service_webservice_service_pb.DeleteProjectDeploymentFreezeWindowRequest,
+    metadata?: grpcWeb.Metadata
+  ): Promise<pkg_app_server_service_webservice_service_pb.DeleteProjectDeploymentFreezeWindowResponse>;
+
+  listStaticUsers(
+    request: pkg_app_server_service_webservice_service_pb.ListStaticUsersRequest,
+    metadata?: grpcWeb.Metadata
+  ): Promise<pkg_app_server_service_webservice_service_pb.ListStaticUsersResponse>;
+
+  addStaticUser(
+    request: pkg_app_server_service_webservice_service_pb.AddStaticUserRequest,
+    metadata?: grpcWeb.Metadata
+  ): Promise<pkg_app_server_service_webservice_service_pb.AddStaticUserResponse>;
+
+  deleteStaticUser(
+    request: pkg_app_server_service_webservice_service_pb.DeleteStaticUserRequest,
+    metadata?: grpcWeb.Metadata
+  ): Promise<pkg_app_server_service_webservice_service_pb.DeleteStaticUserResponse>;
+
+  updateStaticUserRole(
+    request: pkg_app_server_service_webservice_service_pb.UpdateStaticUserRoleRequest,
+    metadata?: grpcWeb.Metadata
+  ): Promise<pkg_app_server_service_webservice_service_pb.UpdateStaticUserRoleResponse>;
+
+  resetStaticUserPassword(
+    request: pkg_app_server_service_webservice_service_pb.ResetStaticUserPasswordRequest,
+    metadata?: grpcWeb.Metadata
+  ): Promise<pkg_app_server_service_webservice_service_pb.ResetStaticUserPasswordResponse>;
+
+  changeStaticUserPassword(
+    request: pkg_app_server_service_webservice_service_pb.ChangeStaticUserPasswordRequest,
+    metadata?: grpcWeb.Metadata
+  ): Promise<pkg_app_server_service_webservice_service_pb.ChangeStaticUserPasswordResponse>;
+
+  generateStaticUserTOTPSecret(
+    request: pkg_app_server_service_webservice_service_pb.GenerateStaticUserTOTPSecretRequest,
+    metadata?: grpcWeb.Metadata
+  ): Promise<pkg_app_server_service_webservice_service_pb.GenerateStaticUserTOTPSecretResponse>;
+
+  enableStaticUserTOTP(
+    request: pkg_app_server_service_webservice_service_pb.EnableStaticUserTOTPRequest,
+    metadata?: grpcWeb.Metadata
+  ): Promise<pkg_app_server_service_webservice_service_pb.EnableStaticUserTOTPResponse>;
+
+  disableStaticUserTOTP(
+    request: pkg_app_server_service_webservice_service_pb.DisableStaticUserTOTPRequest,
+    metadata?: grpcWeb.Metadata
+  ): Promise<pkg_app_server_service_webservice_service_pb.DisableStaticUserTOTPResponse>;
+
   getCommand(
     request: pkg_app_server_service_webservice_service_pb.GetCommandRequest,
     metadata?: grpcWeb.Metadata
@@ -622,6 +797,11 @@ export class WebServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<pkg_app_server_service_webservice_service_pb.GetDeploymentChainResponse>;
 
+  cancelDeploymentChain(
+    request: pkg_app_server_service_webservice_service_pb.CancelDeploymentChainRequest,
+    metadata?: grpcWeb.Metadata
+  ): Promise<pkg_app_server_service_webservice_service_pb.CancelDeploymentChainResponse>;
+
   listEvents(
     request: pkg_app_server_service_webservice_service_pb.ListEventsRequest,
     metadata?: grpcWeb.Metadata
@@ -1265,6 +1265,67 @@ proto.grpc.service.webservice.WebServicePromiseClient.prototype.syncApplication
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest,
+ *   !proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse>}
+ */
+const methodDescriptor_WebService_SyncApplicationBypassingFreeze = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/SyncApplicationBypassingFreeze',
+  grpc.web.MethodType.UNARY,
+  proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest,
+  proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse,
+  /**
+   * @param {!proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.grpc.service.webservice.WebServiceClient.prototype.syncApplicationBypassingFreeze =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/SyncApplicationBypassingFreeze',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_SyncApplicationBypassingFreeze,
+      callback);
+};
+
+
+/**
+ * @param {!proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse>}
+ *     Promise that resolves to the response
+ */
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.syncApplicationBypassingFreeze =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/SyncApplicationBypassingFreeze',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_SyncApplicationBypassingFreeze);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -1631,6 +1692,67 @@ proto.grpc.service.webservice.WebServicePromiseClient.prototype.getStageLog =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.grpc.service.webservice.SearchStageLogsRequest,
+ *   !proto.grpc.service.webservice.SearchStageLogsResponse>}
+ */
+const methodDescriptor_WebService_SearchStageLogs = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/SearchStageLogs',
+  grpc.web.MethodType.UNARY,
+  proto.grpc.service.webservice.SearchStageLogsRequest,
+  proto.grpc.service.webservice.SearchStageLogsResponse,
+  /**
+   * @param {!proto.grpc.service.webservice.SearchStageLogsRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.grpc.service.webservice.SearchStageLogsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.grpc.service.webservice.SearchStageLogsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.SearchStageLogsResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.SearchStageLogsResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.grpc.service.webservice.WebServiceClient.prototype.searchStageLogs =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/SearchStageLogs',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_SearchStageLogs,
+      callback);
+};
+
+
+/**
+ * @param {!proto.grpc.service.webservice.SearchStageLogsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.grpc.service.webservice.SearchStageLogsResponse>}
+ *     Promise that resolves to the response
+ */
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.searchStageLogs =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/SearchStageLogs',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_SearchStageLogs);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -1753,6 +1875,67 @@ proto.grpc.service.webservice.WebServicePromiseClient.prototype.skipStage =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.grpc.service.webservice.RetryStageRequest,
+ *   !proto.grpc.service.webservice.RetryStageResponse>}
+ */
+const methodDescriptor_WebService_RetryStage = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/RetryStage',
+  grpc.web.MethodType.UNARY,
+  proto.grpc.service.webservice.RetryStageRequest,
+  proto.grpc.service.webservice.RetryStageResponse,
+  /**
+   * @param {!proto.grpc.service.webservice.RetryStageRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.grpc.service.webservice.RetryStageResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.grpc.service.webservice.RetryStageRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.RetryStageResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.RetryStageResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.grpc.service.webservice.WebServiceClient.prototype.retryStage =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/RetryStage',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_RetryStage,
+      callback);
+};
+
+
+/**
+ * @param {!proto.grpc.service.webservice.RetryStageRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.grpc.service.webservice.RetryStageResponse>}
+ *     Promise that resolves to the response
+ */
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.retryStage =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/RetryStage',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_RetryStage);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -2671,418 +2854,1089 @@ proto.grpc.service.webservice.WebServicePromiseClient.prototype.deleteProjectUse
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
- *   !proto.grpc.service.webservice.GetCommandRequest,
- *   !proto.grpc.service.webservice.GetCommandResponse>}
+ *   !proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest,
+ *   !proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse>}
  */
-const methodDescriptor_WebService_GetCommand = new grpc.web.MethodDescriptor(
-  '/grpc.service.webservice.WebService/GetCommand',
+const methodDescriptor_WebService_AddProjectDeploymentFreezeWindow = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/AddProjectDeploymentFreezeWindow',
   grpc.web.MethodType.UNARY,
-  proto.grpc.service.webservice.GetCommandRequest,
-  proto.grpc.service.webservice.GetCommandResponse,
+  proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest,
+  proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse,
   /**
-   * @param {!proto.grpc.service.webservice.GetCommandRequest} request
+   * @param {!proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest} request
    * @return {!Uint8Array}
    */
   function(request) {
     return request.serializeBinary();
   },
-  proto.grpc.service.webservice.GetCommandResponse.deserializeBinary
+  proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse.deserializeBinary
 );
 
 
 /**
- * @param {!proto.grpc.service.webservice.GetCommandRequest} request The
+ * @param {!proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest} request The
  *     request proto
  * @param {?Object<string, string>} metadata User defined
  *     call metadata
- * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.GetCommandResponse)}
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse)}
  *     callback The callback function(error, response)
- * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.GetCommandResponse>|undefined}
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse>|undefined}
  *     The XHR Node Readable Stream
  */
-proto.grpc.service.webservice.WebServiceClient.prototype.getCommand =
+proto.grpc.service.webservice.WebServiceClient.prototype.addProjectDeploymentFreezeWindow =
     function(request, metadata, callback) {
   return this.client_.rpcCall(this.hostname_ +
-      '/grpc.service.webservice.WebService/GetCommand',
+      '/grpc.service.webservice.WebService/AddProjectDeploymentFreezeWindow',
       request,
       metadata || {},
-      methodDescriptor_WebService_GetCommand,
+      methodDescriptor_WebService_AddProjectDeploymentFreezeWindow,
       callback);
 };
 
 
 /**
- * @param {!proto.grpc.service.webservice.GetCommandRequest} request The
+ * @param {!proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest} request The
  *     request proto
  * @param {?Object<string, string>=} metadata User defined
  *     call metadata
- * @return {!Promise<!proto.grpc.service.webservice.GetCommandResponse>}
+ * @return {!Promise<!proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse>}
  *     Promise that resolves to the response
  */
-proto.grpc.service.webservice.WebServicePromiseClient.prototype.getCommand =
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.addProjectDeploymentFreezeWindow =
     function(request, metadata) {
   return this.client_.unaryCall(this.hostname_ +
-      '/grpc.service.webservice.WebService/GetCommand',
+      '/grpc.service.webservice.WebService/AddProjectDeploymentFreezeWindow',
       request,
       metadata || {},
-      methodDescriptor_WebService_GetCommand);
+      methodDescriptor_WebService_AddProjectDeploymentFreezeWindow);
 };
 
 
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
- *   !proto.grpc.service.webservice.GenerateAPIKeyRequest,
- *   !proto.grpc.service.webservice.GenerateAPIKeyResponse>}
+ *   !proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest,
+ *   !proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse>}
  */
-const methodDescriptor_WebService_GenerateAPIKey = new grpc.web.MethodDescriptor(
-  '/grpc.service.webservice.WebService/GenerateAPIKey',
+const methodDescriptor_WebService_DeleteProjectDeploymentFreezeWindow = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/DeleteProjectDeploymentFreezeWindow',
   grpc.web.MethodType.UNARY,
-  proto.grpc.service.webservice.GenerateAPIKeyRequest,
-  proto.grpc.service.webservice.GenerateAPIKeyResponse,
+  proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest,
+  proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse,
   /**
-   * @param {!proto.grpc.service.webservice.GenerateAPIKeyRequest} request
+   * @param {!proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest} request
    * @return {!Uint8Array}
    */
   function(request) {
     return request.serializeBinary();
   },
-  proto.grpc.service.webservice.GenerateAPIKeyResponse.deserializeBinary
+  proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse.deserializeBinary
 );
 
 
 /**
- * @param {!proto.grpc.service.webservice.GenerateAPIKeyRequest} request The
+ * @param {!proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest} request The
  *     request proto
  * @param {?Object<string, string>} metadata User defined
  *     call metadata
- * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.GenerateAPIKeyResponse)}
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse)}
  *     callback The callback function(error, response)
- * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.GenerateAPIKeyResponse>|undefined}
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse>|undefined}
  *     The XHR Node Readable Stream
  */
-proto.grpc.service.webservice.WebServiceClient.prototype.generateAPIKey =
+proto.grpc.service.webservice.WebServiceClient.prototype.deleteProjectDeploymentFreezeWindow =
     function(request, metadata, callback) {
   return this.client_.rpcCall(this.hostname_ +
-      '/grpc.service.webservice.WebService/GenerateAPIKey',
+      '/grpc.service.webservice.WebService/DeleteProjectDeploymentFreezeWindow',
       request,
       metadata || {},
-      methodDescriptor_WebService_GenerateAPIKey,
+      methodDescriptor_WebService_DeleteProjectDeploymentFreezeWindow,
       callback);
 };
 
 
 /**
- * @param {!proto.grpc.service.webservice.GenerateAPIKeyRequest} request The
+ * @param {!proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest} request The
  *     request proto
  * @param {?Object<string, string>=} metadata User defined
  *     call metadata
- * @return {!Promise<!proto.grpc.service.webservice.GenerateAPIKeyResponse>}
+ * @return {!Promise<!proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse>}
  *     Promise that resolves to the response
  */
-proto.grpc.service.webservice.WebServicePromiseClient.prototype.generateAPIKey =
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.deleteProjectDeploymentFreezeWindow =
     function(request, metadata) {
   return this.client_.unaryCall(this.hostname_ +
-      '/grpc.service.webservice.WebService/GenerateAPIKey',
+      '/grpc.service.webservice.WebService/DeleteProjectDeploymentFreezeWindow',
       request,
       metadata || {},
-      methodDescriptor_WebService_GenerateAPIKey);
+      methodDescriptor_WebService_DeleteProjectDeploymentFreezeWindow);
 };
 
 
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
- *   !proto.grpc.service.webservice.DisableAPIKeyRequest,
- *   !proto.grpc.service.webservice.DisableAPIKeyResponse>}
+ *   !proto.grpc.service.webservice.ListStaticUsersRequest,
+ *   !proto.grpc.service.webservice.ListStaticUsersResponse>}
  */
-const methodDescriptor_WebService_DisableAPIKey = new grpc.web.MethodDescriptor(
-  '/grpc.service.webservice.WebService/DisableAPIKey',
+const methodDescriptor_WebService_ListStaticUsers = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/ListStaticUsers',
   grpc.web.MethodType.UNARY,
-  proto.grpc.service.webservice.DisableAPIKeyRequest,
-  proto.grpc.service.webservice.DisableAPIKeyResponse,
+  proto.grpc.service.webservice.ListStaticUsersRequest,
+  proto.grpc.service.webservice.ListStaticUsersResponse,
   /**
-   * @param {!proto.grpc.service.webservice.DisableAPIKeyRequest} request
+   * @param {!proto.grpc.service.webservice.ListStaticUsersRequest} request
    * @return {!Uint8Array}
    */
   function(request) {
     return request.serializeBinary();
   },
-  proto.grpc.service.webservice.DisableAPIKeyResponse.deserializeBinary
+  proto.grpc.service.webservice.ListStaticUsersResponse.deserializeBinary
 );
 
 
 /**
- * @param {!proto.grpc.service.webservice.DisableAPIKeyRequest} request The
+ * @param {!proto.grpc.service.webservice.ListStaticUsersRequest} request The
  *     request proto
  * @param {?Object<string, string>} metadata User defined
  *     call metadata
- * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.DisableAPIKeyResponse)}
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.ListStaticUsersResponse)}
  *     callback The callback function(error, response)
- * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.DisableAPIKeyResponse>|undefined}
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.ListStaticUsersResponse>|undefined}
  *     The XHR Node Readable Stream
  */
-proto.grpc.service.webservice.WebServiceClient.prototype.disableAPIKey =
+proto.grpc.service.webservice.WebServiceClient.prototype.listStaticUsers =
     function(request, metadata, callback) {
   return this.client_.rpcCall(this.hostname_ +
-      '/grpc.service.webservice.WebService/DisableAPIKey',
+      '/grpc.service.webservice.WebService/ListStaticUsers',
       request,
       metadata || {},
-      methodDescriptor_WebService_DisableAPIKey,
+      methodDescriptor_WebService_ListStaticUsers,
       callback);
 };
 
 
 /**
- * @param {!proto.grpc.service.webservice.DisableAPIKeyRequest} request The
+ * @param {!proto.grpc.service.webservice.ListStaticUsersRequest} request The
  *     request proto
  * @param {?Object<string, string>=} metadata User defined
  *     call metadata
- * @return {!Promise<!proto.grpc.service.webservice.DisableAPIKeyResponse>}
+ * @return {!Promise<!proto.grpc.service.webservice.ListStaticUsersResponse>}
  *     Promise that resolves to the response
  */
-proto.grpc.service.webservice.WebServicePromiseClient.prototype.disableAPIKey =
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.listStaticUsers =
     function(request, metadata) {
   return this.client_.unaryCall(this.hostname_ +
-      '/grpc.service.webservice.WebService/DisableAPIKey',
+      '/grpc.service.webservice.WebService/ListStaticUsers',
       request,
       metadata || {},
-      methodDescriptor_WebService_DisableAPIKey);
+      methodDescriptor_WebService_ListStaticUsers);
 };
 
 
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
- *   !proto.grpc.service.webservice.ListAPIKeysRequest,
- *   !proto.grpc.service.webservice.ListAPIKeysResponse>}
+ *   !proto.grpc.service.webservice.AddStaticUserRequest,
+ *   !proto.grpc.service.webservice.AddStaticUserResponse>}
  */
-const methodDescriptor_WebService_ListAPIKeys = new grpc.web.MethodDescriptor(
-  '/grpc.service.webservice.WebService/ListAPIKeys',
+const methodDescriptor_WebService_AddStaticUser = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/AddStaticUser',
   grpc.web.MethodType.UNARY,
-  proto.grpc.service.webservice.ListAPIKeysRequest,
-  proto.grpc.service.webservice.ListAPIKeysResponse,
+  proto.grpc.service.webservice.AddStaticUserRequest,
+  proto.grpc.service.webservice.AddStaticUserResponse,
   /**
-   * @param {!proto.grpc.service.webservice.ListAPIKeysRequest} request
+   * @param {!proto.grpc.service.webservice.AddStaticUserRequest} request
    * @return {!Uint8Array}
    */
   function(request) {
     return request.serializeBinary();
   },
-  proto.grpc.service.webservice.ListAPIKeysResponse.deserializeBinary
+  proto.grpc.service.webservice.AddStaticUserResponse.deserializeBinary
 );
 
 
 /**
- * @param {!proto.grpc.service.webservice.ListAPIKeysRequest} request The
+ * @param {!proto.grpc.service.webservice.AddStaticUserRequest} request The
  *     request proto
  * @param {?Object<string, string>} metadata User defined
  *     call metadata
- * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.ListAPIKeysResponse)}
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.AddStaticUserResponse)}
  *     callback The callback function(error, response)
- * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.ListAPIKeysResponse>|undefined}
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.AddStaticUserResponse>|undefined}
  *     The XHR Node Readable Stream
  */
-proto.grpc.service.webservice.WebServiceClient.prototype.listAPIKeys =
+proto.grpc.service.webservice.WebServiceClient.prototype.addStaticUser =
     function(request, metadata, callback) {
   return this.client_.rpcCall(this.hostname_ +
-      '/grpc.service.webservice.WebService/ListAPIKeys',
+      '/grpc.service.webservice.WebService/AddStaticUser',
       request,
       metadata || {},
-      methodDescriptor_WebService_ListAPIKeys,
+      methodDescriptor_WebService_AddStaticUser,
       callback);
 };
 
 
 /**
- * @param {!proto.grpc.service.webservice.ListAPIKeysRequest} request The
+ * @param {!proto.grpc.service.webservice.AddStaticUserRequest} request The
  *     request proto
  * @param {?Object<string, string>=} metadata User defined
  *     call metadata
- * @return {!Promise<!proto.grpc.service.webservice.ListAPIKeysResponse>}
+ * @return {!Promise<!proto.grpc.service.webservice.AddStaticUserResponse>}
  *     Promise that resolves to the response
  */
-proto.grpc.service.webservice.WebServicePromiseClient.prototype.listAPIKeys =
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.addStaticUser =
     function(request, metadata) {
   return this.client_.unaryCall(this.hostname_ +
-      '/grpc.service.webservice.WebService/ListAPIKeys',
+      '/grpc.service.webservice.WebService/AddStaticUser',
       request,
       metadata || {},
-      methodDescriptor_WebService_ListAPIKeys);
+      methodDescriptor_WebService_AddStaticUser);
 };
 
 
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
- *   !proto.grpc.service.webservice.GetInsightDataRequest,
- *   !proto.grpc.service.webservice.GetInsightDataResponse>}
+ *   !proto.grpc.service.webservice.DeleteStaticUserRequest,
+ *   !proto.grpc.service.webservice.DeleteStaticUserResponse>}
  */
-const methodDescriptor_WebService_GetInsightData = new grpc.web.MethodDescriptor(
-  '/grpc.service.webservice.WebService/GetInsightData',
+const methodDescriptor_WebService_DeleteStaticUser = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/DeleteStaticUser',
   grpc.web.MethodType.UNARY,
-  proto.grpc.service.webservice.GetInsightDataRequest,
-  proto.grpc.service.webservice.GetInsightDataResponse,
+  proto.grpc.service.webservice.DeleteStaticUserRequest,
+  proto.grpc.service.webservice.DeleteStaticUserResponse,
   /**
-   * @param {!proto.grpc.service.webservice.GetInsightDataRequest} request
+   * @param {!proto.grpc.service.webservice.DeleteStaticUserRequest} request
    * @return {!Uint8Array}
    */
   function(request) {
     return request.serializeBinary();
   },
-  proto.grpc.service.webservice.GetInsightDataResponse.deserializeBinary
+  proto.grpc.service.webservice.DeleteStaticUserResponse.deserializeBinary
 );
 
 
 /**
- * @param {!proto.grpc.service.webservice.GetInsightDataRequest} request The
+ * @param {!proto.grpc.service.webservice.DeleteStaticUserRequest} request The
  *     request proto
  * @param {?Object<string, string>} metadata User defined
  *     call metadata
- * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.GetInsightDataResponse)}
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.DeleteStaticUserResponse)}
  *     callback The callback function(error, response)
- * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.GetInsightDataResponse>|undefined}
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.DeleteStaticUserResponse>|undefined}
  *     The XHR Node Readable Stream
  */
-proto.grpc.service.webservice.WebServiceClient.prototype.getInsightData =
+proto.grpc.service.webservice.WebServiceClient.prototype.deleteStaticUser =
     function(request, metadata, callback) {
   return this.client_.rpcCall(this.hostname_ +
-      '/grpc.service.webservice.WebService/GetInsightData',
+      '/grpc.service.webservice.WebService/DeleteStaticUser',
       request,
       metadata || {},
-      methodDescriptor_WebService_GetInsightData,
+      methodDescriptor_WebService_DeleteStaticUser,
       callback);
 };
 
 
 /**
- * @param {!proto.grpc.service.webservice.GetInsightDataRequest} request The
+ * @param {!proto.grpc.service.webservice.DeleteStaticUserRequest} request The
  *     request proto
  * @param {?Object<string, string>=} metadata User defined
  *     call metadata
- * @return {!Promise<!proto.grpc.service.webservice.GetInsightDataResponse>}
+ * @return {!Promise<!proto.grpc.service.webservice.DeleteStaticUserResponse>}
  *     Promise that resolves to the response
  */
-proto.grpc.service.webservice.WebServicePromiseClient.prototype.getInsightData =
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.deleteStaticUser =
     function(request, metadata) {
   return this.client_.unaryCall(this.hostname_ +
-      '/grpc.service.webservice.WebService/GetInsightData',
+      '/grpc.service.webservice.WebService/DeleteStaticUser',
       request,
       metadata || {},
-      methodDescriptor_WebService_GetInsightData);
+      methodDescriptor_WebService_DeleteStaticUser);
 };
 
 
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
- *   !proto.grpc.service.webservice.GetInsightApplicationCountRequest,
- *   !proto.grpc.service.webservice.GetInsightApplicationCountResponse>}
+ *   !proto.grpc.service.webservice.UpdateStaticUserRoleRequest,
+ *   !proto.grpc.service.webservice.UpdateStaticUserRoleResponse>}
  */
-const methodDescriptor_WebService_GetInsightApplicationCount = new grpc.web.MethodDescriptor(
-  '/grpc.service.webservice.WebService/GetInsightApplicationCount',
+const methodDescriptor_WebService_UpdateStaticUserRole = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/UpdateStaticUserRole',
   grpc.web.MethodType.UNARY,
-  proto.grpc.service.webservice.GetInsightApplicationCountRequest,
-  proto.grpc.service.webservice.GetInsightApplicationCountResponse,
+  proto.grpc.service.webservice.UpdateStaticUserRoleRequest,
+  proto.grpc.service.webservice.UpdateStaticUserRoleResponse,
   /**
-   * @param {!proto.grpc.service.webservice.GetInsightApplicationCountRequest} request
+   * @param {!proto.grpc.service.webservice.UpdateStaticUserRoleRequest} request
    * @return {!Uint8Array}
    */
   function(request) {
     return request.serializeBinary();
   },
-  proto.grpc.service.webservice.GetInsightApplicationCountResponse.deserializeBinary
+  proto.grpc.service.webservice.UpdateStaticUserRoleResponse.deserializeBinary
 );
 
 
 /**
- * @param {!proto.grpc.service.webservice.GetInsightApplicationCountRequest} request The
+ * @param {!proto.grpc.service.webservice.UpdateStaticUserRoleRequest} request The
  *     request proto
  * @param {?Object<string, string>} metadata User defined
  *     call metadata
- * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.GetInsightApplicationCountResponse)}
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.UpdateStaticUserRoleResponse)}
  *     callback The callback function(error, response)
- * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.GetInsightApplicationCountResponse>|undefined}
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.UpdateStaticUserRoleResponse>|undefined}
  *     The XHR Node Readable Stream
  */
-proto.grpc.service.webservice.WebServiceClient.prototype.getInsightApplicationCount =
+proto.grpc.service.webservice.WebServiceClient.prototype.updateStaticUserRole =
     function(request, metadata, callback) {
   return this.client_.rpcCall(this.hostname_ +
-      '/grpc.service.webservice.WebService/GetInsightApplicationCount',
+      '/grpc.service.webservice.WebService/UpdateStaticUserRole',
       request,
       metadata || {},
-      methodDescriptor_WebService_GetInsightApplicationCount,
+      methodDescriptor_WebService_UpdateStaticUserRole,
       callback);
 };
 
 
 /**
- * @param {!proto.grpc.service.webservice.GetInsightApplicationCountRequest} request The
+ * @param {!proto.grpc.service.webservice.UpdateStaticUserRoleRequest} request The
  *     request proto
  * @param {?Object<string, string>=} metadata User defined
  *     call metadata
- * @return {!Promise<!proto.grpc.service.webservice.GetInsightApplicationCountResponse>}
+ * @return {!Promise<!proto.grpc.service.webservice.UpdateStaticUserRoleResponse>}
  *     Promise that resolves to the response
  */
-proto.grpc.service.webservice.WebServicePromiseClient.prototype.getInsightApplicationCount =
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.updateStaticUserRole =
     function(request, metadata) {
   return this.client_.unaryCall(this.hostname_ +
-      '/grpc.service.webservice.WebService/GetInsightApplicationCount',
+      '/grpc.service.webservice.WebService/UpdateStaticUserRole',
       request,
       metadata || {},
-      methodDescriptor_WebService_GetInsightApplicationCount);
+      methodDescriptor_WebService_UpdateStaticUserRole);
 };
 
 
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
- *   !proto.grpc.service.webservice.ListDeploymentChainsRequest,
- *   !proto.grpc.service.webservice.ListDeploymentChainsResponse>}
+ *   !proto.grpc.service.webservice.ResetStaticUserPasswordRequest,
+ *   !proto.grpc.service.webservice.ResetStaticUserPasswordResponse>}
  */
-const methodDescriptor_WebService_ListDeploymentChains = new grpc.web.MethodDescriptor(
-  '/grpc.service.webservice.WebService/ListDeploymentChains',
+const methodDescriptor_WebService_ResetStaticUserPassword = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/ResetStaticUserPassword',
   grpc.web.MethodType.UNARY,
-  proto.grpc.service.webservice.ListDeploymentChainsRequest,
-  proto.grpc.service.webservice.ListDeploymentChainsResponse,
+  proto.grpc.service.webservice.ResetStaticUserPasswordRequest,
+  proto.grpc.service.webservice.ResetStaticUserPasswordResponse,
   /**
-   * @param {!proto.grpc.service.webservice.ListDeploymentChainsRequest} request
+   * @param {!proto.grpc.service.webservice.ResetStaticUserPasswordRequest} request
    * @return {!Uint8Array}
    */
   function(request) {
     return request.serializeBinary();
   },
-  proto.grpc.service.webservice.ListDeploymentChainsResponse.deserializeBinary
+  proto.grpc.service.webservice.ResetStaticUserPasswordResponse.deserializeBinary
 );
 
 
 /**
- * @param {!proto.grpc.service.webservice.ListDeploymentChainsRequest} request The
+ * @param {!proto.grpc.service.webservice.ResetStaticUserPasswordRequest} request The
  *     request proto
  * @param {?Object<string, string>} metadata User defined
  *     call metadata
- * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.ListDeploymentChainsResponse)}
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.ResetStaticUserPasswordResponse)}
  *     callback The callback function(error, response)
- * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.ListDeploymentChainsResponse>|undefined}
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.ResetStaticUserPasswordResponse>|undefined}
  *     The XHR Node Readable Stream
  */
-proto.grpc.service.webservice.WebServiceClient.prototype.listDeploymentChains =
+proto.grpc.service.webservice.WebServiceClient.prototype.resetStaticUserPassword =
     function(request, metadata, callback) {
   return this.client_.rpcCall(this.hostname_ +
-      '/grpc.service.webservice.WebService/ListDeploymentChains',
+      '/grpc.service.webservice.WebService/ResetStaticUserPassword',
       request,
       metadata || {},
-      methodDescriptor_WebService_ListDeploymentChains,
+      methodDescriptor_WebService_ResetStaticUserPassword,
       callback);
 };
 
 
 /**
- * @param {!proto.grpc.service.webservice.ListDeploymentChainsRequest} request The
+ * @param {!proto.grpc.service.webservice.ResetStaticUserPasswordRequest} request The
  *     request proto
  * @param {?Object<string, string>=} metadata User defined
  *     call metadata
- * @return {!Promise<!proto.grpc.service.webservice.ListDeploymentChainsResponse>}
+ * @return {!Promise<!proto.grpc.service.webservice.ResetStaticUserPasswordResponse>}
+ *     Promise that resolves to the response
+ */
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.resetStaticUserPassword =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/ResetStaticUserPassword',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_ResetStaticUserPassword);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.grpc.service.webservice.ChangeStaticUserPasswordRequest,
+ *   !proto.grpc.service.webservice.ChangeStaticUserPasswordResponse>}
+ */
+const methodDescriptor_WebService_ChangeStaticUserPassword = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/ChangeStaticUserPassword',
+  grpc.web.MethodType.UNARY,
+  proto.grpc.service.webservice.ChangeStaticUserPasswordRequest,
+  proto.grpc.service.webservice.ChangeStaticUserPasswordResponse,
+  /**
+   * @param {!proto.grpc.service.webservice.ChangeStaticUserPasswordRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.grpc.service.webservice.ChangeStaticUserPasswordResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.grpc.service.webservice.ChangeStaticUserPasswordRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.ChangeStaticUserPasswordResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.ChangeStaticUserPasswordResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.grpc.service.webservice.WebServiceClient.prototype.changeStaticUserPassword =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/ChangeStaticUserPassword',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_ChangeStaticUserPassword,
+      callback);
+};
+
+
+/**
+ * @param {!proto.grpc.service.webservice.ChangeStaticUserPasswordRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.grpc.service.webservice.ChangeStaticUserPasswordResponse>}
+ *     Promise that resolves to the response
+ */
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.changeStaticUserPassword =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/ChangeStaticUserPassword',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_ChangeStaticUserPassword);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.grpc.service.webservice.GenerateStaticUserTOTPSecretRequest,
+ *   !proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse>}
+ */
+const methodDescriptor_WebService_GenerateStaticUserTOTPSecret = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/GenerateStaticUserTOTPSecret',
+  grpc.web.MethodType.UNARY,
+  proto.grpc.service.webservice.GenerateStaticUserTOTPSecretRequest,
+  proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse,
+  /**
+   * @param {!proto.grpc.service.webservice.GenerateStaticUserTOTPSecretRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.grpc.service.webservice.GenerateStaticUserTOTPSecretRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.grpc.service.webservice.WebServiceClient.prototype.generateStaticUserTOTPSecret =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/GenerateStaticUserTOTPSecret',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_GenerateStaticUserTOTPSecret,
+      callback);
+};
+
+
+/**
+ * @param {!proto.grpc.service.webservice.GenerateStaticUserTOTPSecretRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse>}
+ *     Promise that resolves to the response
+ */
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.generateStaticUserTOTPSecret =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/GenerateStaticUserTOTPSecret',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_GenerateStaticUserTOTPSecret);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.grpc.service.webservice.EnableStaticUserTOTPRequest,
+ *   !proto.grpc.service.webservice.EnableStaticUserTOTPResponse>}
+ */
+const methodDescriptor_WebService_EnableStaticUserTOTP = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/EnableStaticUserTOTP',
+  grpc.web.MethodType.UNARY,
+  proto.grpc.service.webservice.EnableStaticUserTOTPRequest,
+  proto.grpc.service.webservice.EnableStaticUserTOTPResponse,
+  /**
+   * @param {!proto.grpc.service.webservice.EnableStaticUserTOTPRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.grpc.service.webservice.EnableStaticUserTOTPResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.grpc.service.webservice.EnableStaticUserTOTPRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.EnableStaticUserTOTPResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.EnableStaticUserTOTPResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.grpc.service.webservice.WebServiceClient.prototype.enableStaticUserTOTP =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/EnableStaticUserTOTP',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_EnableStaticUserTOTP,
+      callback);
+};
+
+
+/**
+ * @param {!proto.grpc.service.webservice.EnableStaticUserTOTPRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.grpc.service.webservice.EnableStaticUserTOTPResponse>}
+ *     Promise that resolves to the response
+ */
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.enableStaticUserTOTP =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/EnableStaticUserTOTP',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_EnableStaticUserTOTP);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.grpc.service.webservice.DisableStaticUserTOTPRequest,
+ *   !proto.grpc.service.webservice.DisableStaticUserTOTPResponse>}
+ */
+const methodDescriptor_WebService_DisableStaticUserTOTP = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/DisableStaticUserTOTP',
+  grpc.web.MethodType.UNARY,
+  proto.grpc.service.webservice.DisableStaticUserTOTPRequest,
+  proto.grpc.service.webservice.DisableStaticUserTOTPResponse,
+  /**
+   * @param {!proto.grpc.service.webservice.DisableStaticUserTOTPRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.grpc.service.webservice.DisableStaticUserTOTPResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.grpc.service.webservice.DisableStaticUserTOTPRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.DisableStaticUserTOTPResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.DisableStaticUserTOTPResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.grpc.service.webservice.WebServiceClient.prototype.disableStaticUserTOTP =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/DisableStaticUserTOTP',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_DisableStaticUserTOTP,
+      callback);
+};
+
+
+/**
+ * @param {!proto.grpc.service.webservice.DisableStaticUserTOTPRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.grpc.service.webservice.DisableStaticUserTOTPResponse>}
+ *     Promise that resolves to the response
+ */
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.disableStaticUserTOTP =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/DisableStaticUserTOTP',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_DisableStaticUserTOTP);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.grpc.service.webservice.GetCommandRequest,
+ *   !proto.grpc.service.webservice.GetCommandResponse>}
+ */
+const methodDescriptor_WebService_GetCommand = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/GetCommand',
+  grpc.web.MethodType.UNARY,
+  proto.grpc.service.webservice.GetCommandRequest,
+  proto.grpc.service.webservice.GetCommandResponse,
+  /**
+   * @param {!proto.grpc.service.webservice.GetCommandRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.grpc.service.webservice.GetCommandResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.grpc.service.webservice.GetCommandRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.GetCommandResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.GetCommandResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.grpc.service.webservice.WebServiceClient.prototype.getCommand =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/GetCommand',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_GetCommand,
+      callback);
+};
+
+
+/**
+ * @param {!proto.grpc.service.webservice.GetCommandRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.grpc.service.webservice.GetCommandResponse>}
+ *     Promise that resolves to the response
+ */
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.getCommand =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/GetCommand',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_GetCommand);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.grpc.service.webservice.GenerateAPIKeyRequest,
+ *   !proto.grpc.service.webservice.GenerateAPIKeyResponse>}
+ */
+const methodDescriptor_WebService_GenerateAPIKey = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/GenerateAPIKey',
+  grpc.web.MethodType.UNARY,
+  proto.grpc.service.webservice.GenerateAPIKeyRequest,
+  proto.grpc.service.webservice.GenerateAPIKeyResponse,
+  /**
+   * @param {!proto.grpc.service.webservice.GenerateAPIKeyRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.grpc.service.webservice.GenerateAPIKeyResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.grpc.service.webservice.GenerateAPIKeyRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.GenerateAPIKeyResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.GenerateAPIKeyResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.grpc.service.webservice.WebServiceClient.prototype.generateAPIKey =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/GenerateAPIKey',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_GenerateAPIKey,
+      callback);
+};
+
+
+/**
+ * @param {!proto.grpc.service.webservice.GenerateAPIKeyRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.grpc.service.webservice.GenerateAPIKeyResponse>}
+ *     Promise that resolves to the response
+ */
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.generateAPIKey =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/GenerateAPIKey',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_GenerateAPIKey);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.grpc.service.webservice.DisableAPIKeyRequest,
+ *   !proto.grpc.service.webservice.DisableAPIKeyResponse>}
+ */
+const methodDescriptor_WebService_DisableAPIKey = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/DisableAPIKey',
+  grpc.web.MethodType.UNARY,
+  proto.grpc.service.webservice.DisableAPIKeyRequest,
+  proto.grpc.service.webservice.DisableAPIKeyResponse,
+  /**
+   * @param {!proto.grpc.service.webservice.DisableAPIKeyRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.grpc.service.webservice.DisableAPIKeyResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.grpc.service.webservice.DisableAPIKeyRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.DisableAPIKeyResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.DisableAPIKeyResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.grpc.service.webservice.WebServiceClient.prototype.disableAPIKey =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/DisableAPIKey',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_DisableAPIKey,
+      callback);
+};
+
+
+/**
+ * @param {!proto.grpc.service.webservice.DisableAPIKeyRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.grpc.service.webservice.DisableAPIKeyResponse>}
+ *     Promise that resolves to the response
+ */
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.disableAPIKey =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/DisableAPIKey',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_DisableAPIKey);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.grpc.service.webservice.ListAPIKeysRequest,
+ *   !proto.grpc.service.webservice.ListAPIKeysResponse>}
+ */
+const methodDescriptor_WebService_ListAPIKeys = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/ListAPIKeys',
+  grpc.web.MethodType.UNARY,
+  proto.grpc.service.webservice.ListAPIKeysRequest,
+  proto.grpc.service.webservice.ListAPIKeysResponse,
+  /**
+   * @param {!proto.grpc.service.webservice.ListAPIKeysRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.grpc.service.webservice.ListAPIKeysResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.grpc.service.webservice.ListAPIKeysRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.ListAPIKeysResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.ListAPIKeysResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.grpc.service.webservice.WebServiceClient.prototype.listAPIKeys =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/ListAPIKeys',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_ListAPIKeys,
+      callback);
+};
+
+
+/**
+ * @param {!proto.grpc.service.webservice.ListAPIKeysRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.grpc.service.webservice.ListAPIKeysResponse>}
+ *     Promise that resolves to the response
+ */
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.listAPIKeys =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/ListAPIKeys',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_ListAPIKeys);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.grpc.service.webservice.GetInsightDataRequest,
+ *   !proto.grpc.service.webservice.GetInsightDataResponse>}
+ */
+const methodDescriptor_WebService_GetInsightData = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/GetInsightData',
+  grpc.web.MethodType.UNARY,
+  proto.grpc.service.webservice.GetInsightDataRequest,
+  proto.grpc.service.webservice.GetInsightDataResponse,
+  /**
+   * @param {!proto.grpc.service.webservice.GetInsightDataRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.grpc.service.webservice.GetInsightDataResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.grpc.service.webservice.GetInsightDataRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.GetInsightDataResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.GetInsightDataResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.grpc.service.webservice.WebServiceClient.prototype.getInsightData =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/GetInsightData',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_GetInsightData,
+      callback);
+};
+
+
+/**
+ * @param {!proto.grpc.service.webservice.GetInsightDataRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.grpc.service.webservice.GetInsightDataResponse>}
+ *     Promise that resolves to the response
+ */
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.getInsightData =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/GetInsightData',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_GetInsightData);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.grpc.service.webservice.GetInsightApplicationCountRequest,
+ *   !proto.grpc.service.webservice.GetInsightApplicationCountResponse>}
+ */
+const methodDescriptor_WebService_GetInsightApplicationCount = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/GetInsightApplicationCount',
+  grpc.web.MethodType.UNARY,
+  proto.grpc.service.webservice.GetInsightApplicationCountRequest,
+  proto.grpc.service.webservice.GetInsightApplicationCountResponse,
+  /**
+   * @param {!proto.grpc.service.webservice.GetInsightApplicationCountRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.grpc.service.webservice.GetInsightApplicationCountResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.grpc.service.webservice.GetInsightApplicationCountRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.GetInsightApplicationCountResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.GetInsightApplicationCountResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.grpc.service.webservice.WebServiceClient.prototype.getInsightApplicationCount =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/GetInsightApplicationCount',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_GetInsightApplicationCount,
+      callback);
+};
+
+
+/**
+ * @param {!proto.grpc.service.webservice.GetInsightApplicationCountRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.grpc.service.webservice.GetInsightApplicationCountResponse>}
+ *     Promise that resolves to the response
+ */
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.getInsightApplicationCount =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/GetInsightApplicationCount',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_GetInsightApplicationCount);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.grpc.service.webservice.ListDeploymentChainsRequest,
+ *   !proto.grpc.service.webservice.ListDeploymentChainsResponse>}
+ */
+const methodDescriptor_WebService_ListDeploymentChains = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/ListDeploymentChains',
+  grpc.web.MethodType.UNARY,
+  proto.grpc.service.webservice.ListDeploymentChainsRequest,
+  proto.grpc.service.webservice.ListDeploymentChainsResponse,
+  /**
+   * @param {!proto.grpc.service.webservice.ListDeploymentChainsRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.grpc.service.webservice.ListDeploymentChainsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.grpc.service.webservice.ListDeploymentChainsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.ListDeploymentChainsResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.ListDeploymentChainsResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.grpc.service.webservice.WebServiceClient.prototype.listDeploymentChains =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/ListDeploymentChains',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_ListDeploymentChains,
+      callback);
+};
+
+
+/**
+ * @param {!proto.grpc.service.webservice.ListDeploymentChainsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.grpc.service.webservice.ListDeploymentChainsResponse>}
  *     Promise that resolves to the response
  */
 proto.grpc.service.webservice.WebServicePromiseClient.prototype.listDeploymentChains =
@@ -3156,6 +4010,67 @@ proto.grpc.service.webservice.WebServicePromiseClient.prototype.getDeploymentCha
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.grpc.service.webservice.CancelDeploymentChainRequest,
+ *   !proto.grpc.service.webservice.CancelDeploymentChainResponse>}
+ */
+const methodDescriptor_WebService_CancelDeploymentChain = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/CancelDeploymentChain',
+  grpc.web.MethodType.UNARY,
+  proto.grpc.service.webservice.CancelDeploymentChainRequest,
+  proto.grpc.service.webservice.CancelDeploymentChainResponse,
+  /**
+   * @param {!proto.grpc.service.webservice.CancelDeploymentChainRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.grpc.service.webservice.CancelDeploymentChainResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.grpc.service.webservice.CancelDeploymentChainRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.CancelDeploymentChainResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.CancelDeploymentChainResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.grpc.service.webservice.WebServiceClient.prototype.cancelDeploymentChain =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/CancelDeploymentChain',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_CancelDeploymentChain,
+      callback);
+};
+
+
+/**
+ * @param {!proto.grpc.service.webservice.CancelDeploymentChainRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.grpc.service.webservice.CancelDeploymentChainResponse>}
+ *     Promise that resolves to the response
+ */
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.cancelDeploymentChain =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/CancelDeploymentChain',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_CancelDeploymentChain);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -825,6 +825,50 @@ export namespace SyncApplicationResponse {
   }
 }
 
+export class SyncApplicationBypassingFreezeRequest extends jspb.Message {
+  getApplicationId(): string;
+  setApplicationId(value: string): SyncApplicationBypassingFreezeRequest;
+
+  getSyncStrategy(): pkg_model_common_pb.SyncStrategy;
+  setSyncStrategy(value: pkg_model_common_pb.SyncStrategy): SyncApplicationBypassingFreezeRequest;
+
+  getJustification(): string;
+  setJustification(value: string): SyncApplicationBypassingFreezeRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SyncApplicationBypassingFreezeRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SyncApplicationBypassingFreezeRequest): SyncApplicationBypassingFreezeRequest.AsObject;
+  static serializeBinaryToWriter(message: SyncApplicationBypassingFreezeRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SyncApplicationBypassingFreezeRequest;
+  static deserializeBinaryFromReader(message: SyncApplicationBypassingFreezeRequest, reader: jspb.BinaryReader): SyncApplicationBypassingFreezeRequest;
+}
+
+export namespace SyncApplicationBypassingFreezeRequest {
+  export type AsObject = {
+    applicationId: string,
+    syncStrategy: pkg_model_common_pb.SyncStrategy,
+    justification: string,
+  }
+}
+
+export class SyncApplicationBypassingFreezeResponse extends jspb.Message {
+  getCommandId(): string;
+  setCommandId(value: string): SyncApplicationBypassingFreezeResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SyncApplicationBypassingFreezeResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SyncApplicationBypassingFreezeResponse): SyncApplicationBypassingFreezeResponse.AsObject;
+  static serializeBinaryToWriter(message: SyncApplicationBypassingFreezeResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SyncApplicationBypassingFreezeResponse;
+  static deserializeBinaryFromReader(message: SyncApplicationBypassingFreezeResponse, reader: jspb.BinaryReader): SyncApplicationBypassingFreezeResponse;
+}
+
+export namespace SyncApplicationBypassingFreezeResponse {
+  export type AsObject = {
+    commandId: string,
+  }
+}
+
 export class GetApplicationRequest extends jspb.Message {
   getApplicationId(): string;
   setApplicationId(value: string): GetApplicationRequest;
@@ -1089,6 +1133,9 @@ export class GetStageLogRequest extends jspb.Message {
   getOffsetIndex(): number;
   setOffsetIndex(value: number): GetStageLogRequest;
 
+  getVerbose(): boolean;
+  setVerbose(value: boolean): GetStageLogRequest;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): GetStageLogRequest.AsObject;
   static toObject(includeInstance: boolean, msg: GetStageLogRequest): GetStageLogRequest.AsObject;
@@ -1103,6 +1150,7 @@ export namespace GetStageLogRequest {
     stageId: string,
     retriedCount: number,
     offsetIndex: number,
+    verbose: boolean,
   }
 }
 
@@ -1130,6 +1178,99 @@ export namespace GetStageLogResponse {
   }
 }
 
+export class SearchStageLogsRequest extends jspb.Message {
+  getApplicationId(): string;
+  setApplicationId(value: string): SearchStageLogsRequest;
+
+  getFrom(): number;
+  setFrom(value: number): SearchStageLogsRequest;
+
+  getTo(): number;
+  setTo(value: number): SearchStageLogsRequest;
+
+  getSeveritiesList(): Array<pkg_model_logblock_pb.LogSeverity>;
+  setSeveritiesList(value: Array<pkg_model_logblock_pb.LogSeverity>): SearchStageLogsRequest;
+  clearSeveritiesList(): SearchStageLogsRequest;
+  addSeverities(value: pkg_model_logblock_pb.LogSeverity, index?: number): SearchStageLogsRequest;
+
+  getQuery(): string;
+  setQuery(value: string): SearchStageLogsRequest;
+
+  getLimit(): number;
+  setLimit(value: number): SearchStageLogsRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SearchStageLogsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SearchStageLogsRequest): SearchStageLogsRequest.AsObject;
+  static serializeBinaryToWriter(message: SearchStageLogsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SearchStageLogsRequest;
+  static deserializeBinaryFromReader(message: SearchStageLogsRequest, reader: jspb.BinaryReader): SearchStageLogsRequest;
+}
+
+export namespace SearchStageLogsRequest {
+  export type AsObject = {
+    applicationId: string,
+    from: number,
+    to: number,
+    severitiesList: Array<pkg_model_logblock_pb.LogSeverity>,
+    query: string,
+    limit: number,
+  }
+}
+
+export class SearchStageLogsResponse extends jspb.Message {
+  getMatchesList(): Array<SearchStageLogsResponse.Match>;
+  setMatchesList(value: Array<SearchStageLogsResponse.Match>): SearchStageLogsResponse;
+  clearMatchesList(): SearchStageLogsResponse;
+  addMatches(value?: SearchStageLogsResponse.Match, index?: number): SearchStageLogsResponse.Match;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SearchStageLogsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SearchStageLogsResponse): SearchStageLogsResponse.AsObject;
+  static serializeBinaryToWriter(message: SearchStageLogsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SearchStageLogsResponse;
+  static deserializeBinaryFromReader(message: SearchStageLogsResponse, reader: jspb.BinaryReader): SearchStageLogsResponse;
+}
+
+export namespace SearchStageLogsResponse {
+  export type AsObject = {
+    matchesList: Array<SearchStageLogsResponse.Match.AsObject>,
+  }
+
+  export class Match extends jspb.Message {
+    getDeploymentId(): string;
+    setDeploymentId(value: string): Match;
+
+    getStageId(): string;
+    setStageId(value: string): Match;
+
+    getRetriedCount(): number;
+    setRetriedCount(value: number): Match;
+
+    getBlock(): pkg_model_logblock_pb.LogBlock | undefined;
+    setBlock(value?: pkg_model_logblock_pb.LogBlock): Match;
+    hasBlock(): boolean;
+    clearBlock(): Match;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): Match.AsObject;
+    static toObject(includeInstance: boolean, msg: Match): Match.AsObject;
+    static serializeBinaryToWriter(message: Match, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): Match;
+    static deserializeBinaryFromReader(message: Match, reader: jspb.BinaryReader): Match;
+  }
+
+  export namespace Match {
+    export type AsObject = {
+      deploymentId: string,
+      stageId: string,
+      retriedCount: number,
+      block?: pkg_model_logblock_pb.LogBlock.AsObject,
+    }
+  }
+
+}
+
 export class CancelDeploymentRequest extends jspb.Message {
   getDeploymentId(): string;
   setDeploymentId(value: string): CancelDeploymentRequest;
@@ -1214,6 +1355,46 @@ export namespace SkipStageResponse {
   }
 }
 
+export class RetryStageRequest extends jspb.Message {
+  getDeploymentId(): string;
+  setDeploymentId(value: string): RetryStageRequest;
+
+  getStageId(): string;
+  setStageId(value: string): RetryStageRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RetryStageRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: RetryStageRequest): RetryStageRequest.AsObject;
+  static serializeBinaryToWriter(message: RetryStageRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RetryStageRequest;
+  static deserializeBinaryFromReader(message: RetryStageRequest, reader: jspb.BinaryReader): RetryStageRequest;
+}
+
+export namespace RetryStageRequest {
+  export type AsObject = {
+    deploymentId: string,
+    stageId: string,
+  }
+}
+
+export class RetryStageResponse extends jspb.Message {
+  getCommandId(): string;
+  setCommandId(value: string): RetryStageResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RetryStageResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RetryStageResponse): RetryStageResponse.AsObject;
+  static serializeBinaryToWriter(message: RetryStageResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RetryStageResponse;
+  static deserializeBinaryFromReader(message: RetryStageResponse, reader: jspb.BinaryReader): RetryStageResponse;
+}
+
+export namespace RetryStageResponse {
+  export type AsObject = {
+    commandId: string,
+  }
+}
+
 export class ApproveStageRequest extends jspb.Message {
   getDeploymentId(): string;
   setDeploymentId(value: string): ApproveStageRequest;
@@ -1804,6 +1985,398 @@ export namespace DeleteProjectUserGroupResponse {
   }
 }
 
+export class AddProjectDeploymentFreezeWindowRequest extends jspb.Message {
+  getWindow(): pkg_model_project_pb.DeploymentFreezeWindow | undefined;
+  setWindow(value?: pkg_model_project_pb.DeploymentFreezeWindow): AddProjectDeploymentFreezeWindowRequest;
+  hasWindow(): boolean;
+  clearWindow(): AddProjectDeploymentFreezeWindowRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AddProjectDeploymentFreezeWindowRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: AddProjectDeploymentFreezeWindowRequest): AddProjectDeploymentFreezeWindowRequest.AsObject;
+  static serializeBinaryToWriter(message: AddProjectDeploymentFreezeWindowRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AddProjectDeploymentFreezeWindowRequest;
+  static deserializeBinaryFromReader(message: AddProjectDeploymentFreezeWindowRequest, reader: jspb.BinaryReader): AddProjectDeploymentFreezeWindowRequest;
+}
+
+export namespace AddProjectDeploymentFreezeWindowRequest {
+  export type AsObject = {
+    window?: pkg_model_project_pb.DeploymentFreezeWindow.AsObject,
+  }
+}
+
+export class AddProjectDeploymentFreezeWindowResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AddProjectDeploymentFreezeWindowResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: AddProjectDeploymentFreezeWindowResponse): AddProjectDeploymentFreezeWindowResponse.AsObject;
+  static serializeBinaryToWriter(message: AddProjectDeploymentFreezeWindowResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AddProjectDeploymentFreezeWindowResponse;
+  static deserializeBinaryFromReader(message: AddProjectDeploymentFreezeWindowResponse, reader: jspb.BinaryReader): AddProjectDeploymentFreezeWindowResponse;
+}
+
+export namespace AddProjectDeploymentFreezeWindowResponse {
+  export type AsObject = {
+  }
+}
+
+export class DeleteProjectDeploymentFreezeWindowRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): DeleteProjectDeploymentFreezeWindowRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): DeleteProjectDeploymentFreezeWindowRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: DeleteProjectDeploymentFreezeWindowRequest): DeleteProjectDeploymentFreezeWindowRequest.AsObject;
+  static serializeBinaryToWriter(message: DeleteProjectDeploymentFreezeWindowRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): DeleteProjectDeploymentFreezeWindowRequest;
+  static deserializeBinaryFromReader(message: DeleteProjectDeploymentFreezeWindowRequest, reader: jspb.BinaryReader): DeleteProjectDeploymentFreezeWindowRequest;
+}
+
+export namespace DeleteProjectDeploymentFreezeWindowRequest {
+  export type AsObject = {
+    name: string,
+  }
+}
+
+export class DeleteProjectDeploymentFreezeWindowResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): DeleteProjectDeploymentFreezeWindowResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: DeleteProjectDeploymentFreezeWindowResponse): DeleteProjectDeploymentFreezeWindowResponse.AsObject;
+  static serializeBinaryToWriter(message: DeleteProjectDeploymentFreezeWindowResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): DeleteProjectDeploymentFreezeWindowResponse;
+  static deserializeBinaryFromReader(message: DeleteProjectDeploymentFreezeWindowResponse, reader: jspb.BinaryReader): DeleteProjectDeploymentFreezeWindowResponse;
+}
+
+export namespace DeleteProjectDeploymentFreezeWindowResponse {
+  export type AsObject = {
+  }
+}
+
+export class ListStaticUsersRequest extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ListStaticUsersRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ListStaticUsersRequest): ListStaticUsersRequest.AsObject;
+  static serializeBinaryToWriter(message: ListStaticUsersRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ListStaticUsersRequest;
+  static deserializeBinaryFromReader(message: ListStaticUsersRequest, reader: jspb.BinaryReader): ListStaticUsersRequest;
+}
+
+export namespace ListStaticUsersRequest {
+  export type AsObject = {
+  }
+}
+
+export class ListStaticUsersResponse extends jspb.Message {
+  getUsersList(): Array<pkg_model_project_pb.ProjectStaticUser>;
+  setUsersList(value: Array<pkg_model_project_pb.ProjectStaticUser>): ListStaticUsersResponse;
+  clearUsersList(): ListStaticUsersResponse;
+  addUsers(value?: pkg_model_project_pb.ProjectStaticUser, index?: number): pkg_model_project_pb.ProjectStaticUser;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ListStaticUsersResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ListStaticUsersResponse): ListStaticUsersResponse.AsObject;
+  static serializeBinaryToWriter(message: ListStaticUsersResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ListStaticUsersResponse;
+  static deserializeBinaryFromReader(message: ListStaticUsersResponse, reader: jspb.BinaryReader): ListStaticUsersResponse;
+}
+
+export namespace ListStaticUsersResponse {
+  export type AsObject = {
+    usersList: Array<pkg_model_project_pb.ProjectStaticUser.AsObject>,
+  }
+}
+
+export class AddStaticUserRequest extends jspb.Message {
+  getUsername(): string;
+  setUsername(value: string): AddStaticUserRequest;
+
+  getPassword(): string;
+  setPassword(value: string): AddStaticUserRequest;
+
+  getRole(): string;
+  setRole(value: string): AddStaticUserRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AddStaticUserRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: AddStaticUserRequest): AddStaticUserRequest.AsObject;
+  static serializeBinaryToWriter(message: AddStaticUserRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AddStaticUserRequest;
+  static deserializeBinaryFromReader(message: AddStaticUserRequest, reader: jspb.BinaryReader): AddStaticUserRequest;
+}
+
+export namespace AddStaticUserRequest {
+  export type AsObject = {
+    username: string,
+    password: string,
+    role: string,
+  }
+}
+
+export class AddStaticUserResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AddStaticUserResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: AddStaticUserResponse): AddStaticUserResponse.AsObject;
+  static serializeBinaryToWriter(message: AddStaticUserResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AddStaticUserResponse;
+  static deserializeBinaryFromReader(message: AddStaticUserResponse, reader: jspb.BinaryReader): AddStaticUserResponse;
+}
+
+export namespace AddStaticUserResponse {
+  export type AsObject = {
+  }
+}
+
+export class DeleteStaticUserRequest extends jspb.Message {
+  getUsername(): string;
+  setUsername(value: string): DeleteStaticUserRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): DeleteStaticUserRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: DeleteStaticUserRequest): DeleteStaticUserRequest.AsObject;
+  static serializeBinaryToWriter(message: DeleteStaticUserRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): DeleteStaticUserRequest;
+  static deserializeBinaryFromReader(message: DeleteStaticUserRequest, reader: jspb.BinaryReader): DeleteStaticUserRequest;
+}
+
+export namespace DeleteStaticUserRequest {
+  export type AsObject = {
+    username: string,
+  }
+}
+
+export class DeleteStaticUserResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): DeleteStaticUserResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: DeleteStaticUserResponse): DeleteStaticUserResponse.AsObject;
+  static serializeBinaryToWriter(message: DeleteStaticUserResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): DeleteStaticUserResponse;
+  static deserializeBinaryFromReader(message: DeleteStaticUserResponse, reader: jspb.BinaryReader): DeleteStaticUserResponse;
+}
+
+export namespace DeleteStaticUserResponse {
+  export type AsObject = {
+  }
+}
+
+export class UpdateStaticUserRoleRequest extends jspb.Message {
+  getUsername(): string;
+  setUsername(value: string): UpdateStaticUserRoleRequest;
+
+  getRole(): string;
+  setRole(value: string): UpdateStaticUserRoleRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UpdateStaticUserRoleRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: UpdateStaticUserRoleRequest): UpdateStaticUserRoleRequest.AsObject;
+  static serializeBinaryToWriter(message: UpdateStaticUserRoleRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UpdateStaticUserRoleRequest;
+  static deserializeBinaryFromReader(message: UpdateStaticUserRoleRequest, reader: jspb.BinaryReader): UpdateStaticUserRoleRequest;
+}
+
+export namespace UpdateStaticUserRoleRequest {
+  export type AsObject = {
+    username: string,
+    role: string,
+  }
+}
+
+export class UpdateStaticUserRoleResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UpdateStaticUserRoleResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: UpdateStaticUserRoleResponse): UpdateStaticUserRoleResponse.AsObject;
+  static serializeBinaryToWriter(message: UpdateStaticUserRoleResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UpdateStaticUserRoleResponse;
+  static deserializeBinaryFromReader(message: UpdateStaticUserRoleResponse, reader: jspb.BinaryReader): UpdateStaticUserRoleResponse;
+}
+
+export namespace UpdateStaticUserRoleResponse {
+  export type AsObject = {
+  }
+}
+
+export class ResetStaticUserPasswordRequest extends jspb.Message {
+  getUsername(): string;
+  setUsername(value: string): ResetStaticUserPasswordRequest;
+
+  getPassword(): string;
+  setPassword(value: string): ResetStaticUserPasswordRequest;
+
+  getResetTotp(): boolean;
+  setResetTotp(value: boolean): ResetStaticUserPasswordRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ResetStaticUserPasswordRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ResetStaticUserPasswordRequest): ResetStaticUserPasswordRequest.AsObject;
+  static serializeBinaryToWriter(message: ResetStaticUserPasswordRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ResetStaticUserPasswordRequest;
+  static deserializeBinaryFromReader(message: ResetStaticUserPasswordRequest, reader: jspb.BinaryReader): ResetStaticUserPasswordRequest;
+}
+
+export namespace ResetStaticUserPasswordRequest {
+  export type AsObject = {
+    username: string,
+    password: string,
+    resetTotp: boolean,
+  }
+}
+
+export class ResetStaticUserPasswordResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ResetStaticUserPasswordResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ResetStaticUserPasswordResponse): ResetStaticUserPasswordResponse.AsObject;
+  static serializeBinaryToWriter(message: ResetStaticUserPasswordResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ResetStaticUserPasswordResponse;
+  static deserializeBinaryFromReader(message: ResetStaticUserPasswordResponse, reader: jspb.BinaryReader): ResetStaticUserPasswordResponse;
+}
+
+export namespace ResetStaticUserPasswordResponse {
+  export type AsObject = {
+  }
+}
+
+export class ChangeStaticUserPasswordRequest extends jspb.Message {
+  getCurrentPassword(): string;
+  setCurrentPassword(value: string): ChangeStaticUserPasswordRequest;
+
+  getNewPassword(): string;
+  setNewPassword(value: string): ChangeStaticUserPasswordRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ChangeStaticUserPasswordRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ChangeStaticUserPasswordRequest): ChangeStaticUserPasswordRequest.AsObject;
+  static serializeBinaryToWriter(message: ChangeStaticUserPasswordRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ChangeStaticUserPasswordRequest;
+  static deserializeBinaryFromReader(message: ChangeStaticUserPasswordRequest, reader: jspb.BinaryReader): ChangeStaticUserPasswordRequest;
+}
+
+export namespace ChangeStaticUserPasswordRequest {
+  export type AsObject = {
+    currentPassword: string,
+    newPassword: string,
+  }
+}
+
+export class ChangeStaticUserPasswordResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ChangeStaticUserPasswordResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ChangeStaticUserPasswordResponse): ChangeStaticUserPasswordResponse.AsObject;
+  static serializeBinaryToWriter(message: ChangeStaticUserPasswordResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ChangeStaticUserPasswordResponse;
+  static deserializeBinaryFromReader(message: ChangeStaticUserPasswordResponse, reader: jspb.BinaryReader): ChangeStaticUserPasswordResponse;
+}
+
+export namespace ChangeStaticUserPasswordResponse {
+  export type AsObject = {
+  }
+}
+
+export class GenerateStaticUserTOTPSecretRequest extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GenerateStaticUserTOTPSecretRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GenerateStaticUserTOTPSecretRequest): GenerateStaticUserTOTPSecretRequest.AsObject;
+  static serializeBinaryToWriter(message: GenerateStaticUserTOTPSecretRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GenerateStaticUserTOTPSecretRequest;
+  static deserializeBinaryFromReader(message: GenerateStaticUserTOTPSecretRequest, reader: jspb.BinaryReader): GenerateStaticUserTOTPSecretRequest;
+}
+
+export namespace GenerateStaticUserTOTPSecretRequest {
+  export type AsObject = {
+  }
+}
+
+export class GenerateStaticUserTOTPSecretResponse extends jspb.Message {
+  getSecret(): string;
+  setSecret(value: string): GenerateStaticUserTOTPSecretResponse;
+
+  getUrl(): string;
+  setUrl(value: string): GenerateStaticUserTOTPSecretResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GenerateStaticUserTOTPSecretResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GenerateStaticUserTOTPSecretResponse): GenerateStaticUserTOTPSecretResponse.AsObject;
+  static serializeBinaryToWriter(message: GenerateStaticUserTOTPSecretResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GenerateStaticUserTOTPSecretResponse;
+  static deserializeBinaryFromReader(message: GenerateStaticUserTOTPSecretResponse, reader: jspb.BinaryReader): GenerateStaticUserTOTPSecretResponse;
+}
+
+export namespace GenerateStaticUserTOTPSecretResponse {
+  export type AsObject = {
+    secret: string,
+    url: string,
+  }
+}
+
+export class EnableStaticUserTOTPRequest extends jspb.Message {
+  getPassword(): string;
+  setPassword(value: string): EnableStaticUserTOTPRequest;
+
+  getSecret(): string;
+  setSecret(value: string): EnableStaticUserTOTPRequest;
+
+  getPasscode(): string;
+  setPasscode(value: string): EnableStaticUserTOTPRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnableStaticUserTOTPRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: EnableStaticUserTOTPRequest): EnableStaticUserTOTPRequest.AsObject;
+  static serializeBinaryToWriter(message: EnableStaticUserTOTPRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnableStaticUserTOTPRequest;
+  static deserializeBinaryFromReader(message: EnableStaticUserTOTPRequest, reader: jspb.BinaryReader): EnableStaticUserTOTPRequest;
+}
+
+export namespace EnableStaticUserTOTPRequest {
+  export type AsObject = {
+    password: string,
+    secret: string,
+    passcode: string,
+  }
+}
+
+export class EnableStaticUserTOTPResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnableStaticUserTOTPResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: EnableStaticUserTOTPResponse): EnableStaticUserTOTPResponse.AsObject;
+  static serializeBinaryToWriter(message: EnableStaticUserTOTPResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnableStaticUserTOTPResponse;
+  static deserializeBinaryFromReader(message: EnableStaticUserTOTPResponse, reader: jspb.BinaryReader): EnableStaticUserTOTPResponse;
+}
+
+export namespace EnableStaticUserTOTPResponse {
+  export type AsObject = {
+  }
+}
+
+export class DisableStaticUserTOTPRequest extends jspb.Message {
+  getPassword(): string;
+  setPassword(value: string): DisableStaticUserTOTPRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): DisableStaticUserTOTPRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: DisableStaticUserTOTPRequest): DisableStaticUserTOTPRequest.AsObject;
+  static serializeBinaryToWriter(message: DisableStaticUserTOTPRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): DisableStaticUserTOTPRequest;
+  static deserializeBinaryFromReader(message: DisableStaticUserTOTPRequest, reader: jspb.BinaryReader): DisableStaticUserTOTPRequest;
+}
+
+export namespace DisableStaticUserTOTPRequest {
+  export type AsObject = {
+    password: string,
+  }
+}
+
+export class DisableStaticUserTOTPResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): DisableStaticUserTOTPResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: DisableStaticUserTOTPResponse): DisableStaticUserTOTPResponse.AsObject;
+  static serializeBinaryToWriter(message: DisableStaticUserTOTPResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): DisableStaticUserTOTPResponse;
+  static deserializeBinaryFromReader(message: DisableStaticUserTOTPResponse, reader: jspb.BinaryReader): DisableStaticUserTOTPResponse;
+}
+
+export namespace DisableStaticUserTOTPResponse {
+  export type AsObject = {
+  }
+}
+
 export class GetCommandRequest extends jspb.Message {
   getCommandId(): string;
   setCommandId(value: string): GetCommandRequest;
@@ -2194,6 +2767,44 @@ export namespace GetDeploymentChainResponse {
   }
 }
 
+export class CancelDeploymentChainRequest extends jspb.Message {
+  getDeploymentChainId(): string;
+  setDeploymentChainId(value: string): CancelDeploymentChainRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CancelDeploymentChainRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: CancelDeploymentChainRequest): CancelDeploymentChainRequest.AsObject;
+  static serializeBinaryToWriter(message: CancelDeploymentChainRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CancelDeploymentChainRequest;
+  static deserializeBinaryFromReader(message: CancelDeploymentChainRequest, reader: jspb.BinaryReader): CancelDeploymentChainRequest;
+}
+
+export namespace CancelDeploymentChainRequest {
+  export type AsObject = {
+    deploymentChainId: string,
+  }
+}
+
+export class CancelDeploymentChainResponse extends jspb.Message {
+  getCommandIdsList(): Array<string>;
+  setCommandIdsList(value: Array<string>): CancelDeploymentChainResponse;
+  clearCommandIdsList(): CancelDeploymentChainResponse;
+  addCommandIds(value: string, index?: number): CancelDeploymentChainResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CancelDeploymentChainResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: CancelDeploymentChainResponse): CancelDeploymentChainResponse.AsObject;
+  static serializeBinaryToWriter(message: CancelDeploymentChainResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CancelDeploymentChainResponse;
+  static deserializeBinaryFromReader(message: CancelDeploymentChainResponse, reader: jspb.BinaryReader): CancelDeploymentChainResponse;
+}
+
+export namespace CancelDeploymentChainResponse {
+  export type AsObject = {
+    commandIdsList: Array<string>,
+  }
+}
+
 export class ListEventsRequest extends jspb.Message {
   getOptions(): ListEventsRequest.Options | undefined;
   setOptions(value?: ListEventsRequest.Options): ListEventsRequest;
@@ -57,22 +57,34 @@ var google_protobuf_descriptor_pb = require('google-protobuf/google/protobuf/des
 goog.object.extend(proto, google_protobuf_descriptor_pb);
 goog.exportSymbol('proto.grpc.service.webservice.AddApplicationRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.AddApplicationResponse', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.AddProjectRBACRoleRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.AddProjectRBACRoleResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.AddProjectUserGroupRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.AddProjectUserGroupResponse', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.AddStaticUserRequest', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.AddStaticUserResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.ApproveStageRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.ApproveStageResponse', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.CancelDeploymentChainRequest', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.CancelDeploymentChainResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.CancelDeploymentRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.CancelDeploymentResponse', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.ChangeStaticUserPasswordRequest', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.ChangeStaticUserPasswordResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.DeleteApplicationRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.DeleteApplicationResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.DeleteOldPipedKeysRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.DeleteOldPipedKeysResponse', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.DeleteProjectRBACRoleRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.DeleteProjectRBACRoleResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.DeleteProjectUserGroupRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.DeleteProjectUserGroupResponse', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.DeleteStaticUserRequest', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.DeleteStaticUserResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.DisableAPIKeyRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.DisableAPIKeyResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.DisableApplicationRequest', null, global);
@@ -81,16 +93,22 @@ goog.exportSymbol('proto.grpc.service.webservice.DisablePipedRequest', null, glo
 goog.exportSymbol('proto.grpc.service.webservice.DisablePipedResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.DisableStaticAdminRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.DisableStaticAdminResponse', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.DisableStaticUserTOTPRequest', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.DisableStaticUserTOTPResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.EnableApplicationRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.EnableApplicationResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.EnablePipedRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.EnablePipedResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.EnableStaticAdminRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.EnableStaticAdminResponse', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.EnableStaticUserTOTPRequest', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.EnableStaticUserTOTPResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.GenerateAPIKeyRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.GenerateAPIKeyResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.GenerateStaticUserTOTPSecretRequest', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.GetApplicationLiveStateRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.GetApplicationLiveStateResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.GetApplicationRequest', null, global);
@@ -139,16 +157,27 @@ goog.exportSymbol('proto.grpc.service.webservice.ListPipedsRequest.Options', nul
 goog.exportSymbol('proto.grpc.service.webservice.ListPipedsResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.ListReleasedVersionsRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.ListReleasedVersionsResponse', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.ListStaticUsersRequest', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.ListStaticUsersResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.ListUnregisteredApplicationsRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.ListUnregisteredApplicationsResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.RecreatePipedKeyRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.RecreatePipedKeyResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.RegisterPipedRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.RegisterPipedResponse', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.ResetStaticUserPasswordRequest', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.ResetStaticUserPasswordResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.RestartPipedRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.RestartPipedResponse', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.RetryStageRequest', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.RetryStageResponse', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.SearchStageLogsRequest', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.SearchStageLogsResponse', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.SearchStageLogsResponse.Match', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.SkipStageRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.SkipStageResponse', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.SyncApplicationRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.SyncApplicationResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.UpdateApplicationRequest', null, global);
@@ -165,6 +194,8 @@ goog.exportSymbol('proto.grpc.service.webservice.UpdateProjectSSOConfigRequest',
 goog.exportSymbol('proto.grpc.service.webservice.UpdateProjectSSOConfigResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.UpdateProjectStaticAdminRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.UpdateProjectStaticAdminResponse', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.UpdateStaticUserRoleRequest', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.UpdateStaticUserRoleResponse', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -1005,6 +1036,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.grpc.service.webservice.SyncApplicationResponse.displayName = 'proto.grpc.service.webservice.SyncApplicationResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest.displayName = 'proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse.displayName = 'proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -1278,6 +1351,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.grpc.service.webservice.GetStageLogResponse.displayName = 'proto.grpc.service.webservice.GetStageLogResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.SearchStageLogsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.grpc.service.webservice.SearchStageLogsRequest.repeatedFields_, null);
+};
+goog.inherits(proto.grpc.service.webservice.SearchStageLogsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.SearchStageLogsRequest.displayName = 'proto.grpc.service.webservice.SearchStageLogsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.SearchStageLogsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.grpc.service.webservice.SearchStageLogsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.grpc.service.webservice.SearchStageLogsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.SearchStageLogsResponse.displayName = 'proto.grpc.service.webservice.SearchStageLogsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.SearchStageLogsResponse.Match = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.SearchStageLogsResponse.Match, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.SearchStageLogsResponse.Match.displayName = 'proto.grpc.service.webservice.SearchStageLogsResponse.Match';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -1362,6 +1498,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.grpc.service.webservice.SkipStageResponse.displayName = 'proto.grpc.service.webservice.SkipStageResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.RetryStageRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.RetryStageRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.RetryStageRequest.displayName = 'proto.grpc.service.webservice.RetryStageRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.RetryStageResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.RetryStageResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.RetryStageResponse.displayName = 'proto.grpc.service.webservice.RetryStageResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -2044,16 +2222,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.grpc.service.webservice.GetCommandRequest = function(opt_data) {
+proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.grpc.service.webservice.GetCommandRequest, jspb.Message);
+goog.inherits(proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.grpc.service.webservice.GetCommandRequest.displayName = 'proto.grpc.service.webservice.GetCommandRequest';
+  proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest.displayName = 'proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -2065,16 +2243,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.grpc.service.webservice.GetCommandResponse = function(opt_data) {
+proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.grpc.service.webservice.GetCommandResponse, jspb.Message);
+goog.inherits(proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.grpc.service.webservice.GetCommandResponse.displayName = 'proto.grpc.service.webservice.GetCommandResponse';
+  proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse.displayName = 'proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -2086,16 +2264,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.grpc.service.webservice.GenerateAPIKeyRequest = function(opt_data) {
+proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.grpc.service.webservice.GenerateAPIKeyRequest, jspb.Message);
+goog.inherits(proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.grpc.service.webservice.GenerateAPIKeyRequest.displayName = 'proto.grpc.service.webservice.GenerateAPIKeyRequest';
+  proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest.displayName = 'proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -2107,16 +2285,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.grpc.service.webservice.GenerateAPIKeyResponse = function(opt_data) {
+proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.grpc.service.webservice.GenerateAPIKeyResponse, jspb.Message);
+goog.inherits(proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.grpc.service.webservice.GenerateAPIKeyResponse.displayName = 'proto.grpc.service.webservice.GenerateAPIKeyResponse';
+  proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse.displayName = 'proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -2128,16 +2306,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.grpc.service.webservice.DisableAPIKeyRequest = function(opt_data) {
+proto.grpc.service.webservice.ListStaticUsersRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.grpc.service.webservice.DisableAPIKeyRequest, jspb.Message);
+goog.inherits(proto.grpc.service.webservice.ListStaticUsersRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.grpc.service.webservice.DisableAPIKeyRequest.displayName = 'proto.grpc.service.webservice.DisableAPIKeyRequest';
+  proto.grpc.service.webservice.ListStaticUsersRequest.displayName = 'proto.grpc.service.webservice.ListStaticUsersRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -2149,16 +2327,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.grpc.service.webservice.DisableAPIKeyResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.grpc.service.webservice.ListStaticUsersResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.grpc.service.webservice.ListStaticUsersResponse.repeatedFields_, null);
 };
-goog.inherits(proto.grpc.service.webservice.DisableAPIKeyResponse, jspb.Message);
+goog.inherits(proto.grpc.service.webservice.ListStaticUsersResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.grpc.service.webservice.DisableAPIKeyResponse.displayName = 'proto.grpc.service.webservice.DisableAPIKeyResponse';
+  proto.grpc.service.webservice.ListStaticUsersResponse.displayName = 'proto.grpc.service.webservice.ListStaticUsersResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -2170,16 +2348,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.grpc.service.webservice.ListAPIKeysRequest = function(opt_data) {
+proto.grpc.service.webservice.AddStaticUserRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.grpc.service.webservice.ListAPIKeysRequest, jspb.Message);
+goog.inherits(proto.grpc.service.webservice.AddStaticUserRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.grpc.service.webservice.ListAPIKeysRequest.displayName = 'proto.grpc.service.webservice.ListAPIKeysRequest';
+  proto.grpc.service.webservice.AddStaticUserRequest.displayName = 'proto.grpc.service.webservice.AddStaticUserRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -2191,16 +2369,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.grpc.service.webservice.ListAPIKeysRequest.Options = function(opt_data) {
+proto.grpc.service.webservice.AddStaticUserResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.grpc.service.webservice.ListAPIKeysRequest.Options, jspb.Message);
+goog.inherits(proto.grpc.service.webservice.AddStaticUserResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.grpc.service.webservice.ListAPIKeysRequest.Options.displayName = 'proto.grpc.service.webservice.ListAPIKeysRequest.Options';
+  proto.grpc.service.webservice.AddStaticUserResponse.displayName = 'proto.grpc.service.webservice.AddStaticUserResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -2212,16 +2390,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.grpc.service.webservice.ListAPIKeysResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.grpc.service.webservice.ListAPIKeysResponse.repeatedFields_, null);
+proto.grpc.service.webservice.DeleteStaticUserRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.grpc.service.webservice.ListAPIKeysResponse, jspb.Message);
+goog.inherits(proto.grpc.service.webservice.DeleteStaticUserRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.grpc.service.webservice.ListAPIKeysResponse.displayName = 'proto.grpc.service.webservice.ListAPIKeysResponse';
+  proto.grpc.service.webservice.DeleteStaticUserRequest.displayName = 'proto.grpc.service.webservice.DeleteStaticUserRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -2233,16 +2411,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.grpc.service.webservice.GetInsightDataRequest = function(opt_data) {
+proto.grpc.service.webservice.DeleteStaticUserResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.grpc.service.webservice.GetInsightDataRequest, jspb.Message);
+goog.inherits(proto.grpc.service.webservice.DeleteStaticUserResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.grpc.service.webservice.GetInsightDataRequest.displayName = 'proto.grpc.service.webservice.GetInsightDataRequest';
+  proto.grpc.service.webservice.DeleteStaticUserResponse.displayName = 'proto.grpc.service.webservice.DeleteStaticUserResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -2254,16 +2432,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.grpc.service.webservice.GetInsightDataResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.grpc.service.webservice.GetInsightDataResponse.repeatedFields_, null);
+proto.grpc.service.webservice.UpdateStaticUserRoleRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.grpc.service.webservice.GetInsightDataResponse, jspb.Message);
+goog.inherits(proto.grpc.service.webservice.UpdateStaticUserRoleRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.grpc.service.webservice.GetInsightDataResponse.displayName = 'proto.grpc.service.webservice.GetInsightDataResponse';
+  proto.grpc.service.webservice.UpdateStaticUserRoleRequest.displayName = 'proto.grpc.service.webservice.UpdateStaticUserRoleRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -2275,16 +2453,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.grpc.service.webservice.GetInsightApplicationCountRequest = function(opt_data) {
+proto.grpc.service.webservice.UpdateStaticUserRoleResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.grpc.service.webservice.GetInsightApplicationCountRequest, jspb.Message);
+goog.inherits(proto.grpc.service.webservice.UpdateStaticUserRoleResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.grpc.service.webservice.GetInsightApplicationCountRequest.displayName = 'proto.grpc.service.webservice.GetInsightApplicationCountRequest';
+  proto.grpc.service.webservice.UpdateStaticUserRoleResponse.displayName = 'proto.grpc.service.webservice.UpdateStaticUserRoleResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -2296,16 +2474,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.grpc.service.webservice.GetInsightApplicationCountResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.grpc.service.webservice.GetInsightApplicationCountResponse.repeatedFields_, null);
+proto.grpc.service.webservice.ResetStaticUserPasswordRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.grpc.service.webservice.GetInsightApplicationCountResponse, jspb.Message);
+goog.inherits(proto.grpc.service.webservice.ResetStaticUserPasswordRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.grpc.service.webservice.GetInsightApplicationCountResponse.displayName = 'proto.grpc.service.webservice.GetInsightApplicationCountResponse';
+  proto.grpc.service.webservice.ResetStaticUserPasswordRequest.displayName = 'proto.grpc.service.webservice.ResetStaticUserPasswordRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -2317,16 +2495,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.grpc.service.webservice.ListDeploymentChainsRequest = function(opt_data) {
+proto.grpc.service.webservice.ResetStaticUserPasswordResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.grpc.service.webservice.ListDeploymentChainsRequest, jspb.Message);
+goog.inherits(proto.grpc.service.webservice.ResetStaticUserPasswordResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.grpc.service.webservice.ListDeploymentChainsRequest.displayName = 'proto.grpc.service.webservice.ListDeploymentChainsRequest';
+  proto.grpc.service.webservice.ResetStaticUserPasswordResponse.displayName = 'proto.grpc.service.webservice.ResetStaticUserPasswordResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -2338,16 +2516,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.grpc.service.webservice.ListDeploymentChainsRequest.Options = function(opt_data) {
+proto.grpc.service.webservice.ChangeStaticUserPasswordRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.grpc.service.webservice.ListDeploymentChainsRequest.Options, jspb.Message);
+goog.inherits(proto.grpc.service.webservice.ChangeStaticUserPasswordRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.displayName = 'proto.grpc.service.webservice.ListDeploymentChainsRequest.Options';
+  proto.grpc.service.webservice.ChangeStaticUserPasswordRequest.displayName = 'proto.grpc.service.webservice.ChangeStaticUserPasswordRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -2359,16 +2537,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.grpc.service.webservice.ListDeploymentChainsResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.grpc.service.webservice.ListDeploymentChainsResponse.repeatedFields_, null);
+proto.grpc.service.webservice.ChangeStaticUserPasswordResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.grpc.service.webservice.ListDeploymentChainsResponse, jspb.Message);
+goog.inherits(proto.grpc.service.webservice.ChangeStaticUserPasswordResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.grpc.service.webservice.ListDeploymentChainsResponse.displayName = 'proto.grpc.service.webservice.ListDeploymentChainsResponse';
+  proto.grpc.service.webservice.ChangeStaticUserPasswordResponse.displayName = 'proto.grpc.service.webservice.ChangeStaticUserPasswordResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -2380,16 +2558,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.grpc.service.webservice.GetDeploymentChainRequest = function(opt_data) {
+proto.grpc.service.webservice.GenerateStaticUserTOTPSecretRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.grpc.service.webservice.GetDeploymentChainRequest, jspb.Message);
+goog.inherits(proto.grpc.service.webservice.GenerateStaticUserTOTPSecretRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.grpc.service.webservice.GetDeploymentChainRequest.displayName = 'proto.grpc.service.webservice.GetDeploymentChainRequest';
+  proto.grpc.service.webservice.GenerateStaticUserTOTPSecretRequest.displayName = 'proto.grpc.service.webservice.GenerateStaticUserTOTPSecretRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -2401,16 +2579,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.grpc.service.webservice.GetDeploymentChainResponse = function(opt_data) {
+proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.grpc.service.webservice.GetDeploymentChainResponse, jspb.Message);
+goog.inherits(proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.grpc.service.webservice.GetDeploymentChainResponse.displayName = 'proto.grpc.service.webservice.GetDeploymentChainResponse';
+  proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse.displayName = 'proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -2422,16 +2600,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.grpc.service.webservice.ListEventsRequest = function(opt_data) {
+proto.grpc.service.webservice.EnableStaticUserTOTPRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.grpc.service.webservice.ListEventsRequest, jspb.Message);
+goog.inherits(proto.grpc.service.webservice.EnableStaticUserTOTPRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.grpc.service.webservice.ListEventsRequest.displayName = 'proto.grpc.service.webservice.ListEventsRequest';
+  proto.grpc.service.webservice.EnableStaticUserTOTPRequest.displayName = 'proto.grpc.service.webservice.EnableStaticUserTOTPRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -2443,16 +2621,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.grpc.service.webservice.ListEventsRequest.Options = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.grpc.service.webservice.ListEventsRequest.Options.repeatedFields_, null);
+proto.grpc.service.webservice.EnableStaticUserTOTPResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.grpc.service.webservice.ListEventsRequest.Options, jspb.Message);
+goog.inherits(proto.grpc.service.webservice.EnableStaticUserTOTPResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.grpc.service.webservice.ListEventsRequest.Options.displayName = 'proto.grpc.service.webservice.ListEventsRequest.Options';
+  proto.grpc.service.webservice.EnableStaticUserTOTPResponse.displayName = 'proto.grpc.service.webservice.EnableStaticUserTOTPResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -2464,95 +2642,4804 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.grpc.service.webservice.ListEventsResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.grpc.service.webservice.ListEventsResponse.repeatedFields_, null);
+proto.grpc.service.webservice.DisableStaticUserTOTPRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.grpc.service.webservice.ListEventsResponse, jspb.Message);
+goog.inherits(proto.grpc.service.webservice.DisableStaticUserTOTPRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.grpc.service.webservice.ListEventsResponse.displayName = 'proto.grpc.service.webservice.ListEventsResponse';
+  proto.grpc.service.webservice.DisableStaticUserTOTPRequest.displayName = 'proto.grpc.service.webservice.DisableStaticUserTOTPRequest';
 }
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
-/**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
- */
-proto.grpc.service.webservice.RegisterPipedRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.RegisterPipedRequest.toObject(opt_includeInstance, this);
-};
-
-
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.RegisterPipedRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.grpc.service.webservice.RegisterPipedRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    desc: jspb.Message.getFieldWithDefault(msg, 2, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.grpc.service.webservice.DisableStaticUserTOTPResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
+goog.inherits(proto.grpc.service.webservice.DisableStaticUserTOTPResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.DisableStaticUserTOTPResponse.displayName = 'proto.grpc.service.webservice.DisableStaticUserTOTPResponse';
 }
-
-
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.GetCommandRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.GetCommandRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.GetCommandRequest.displayName = 'proto.grpc.service.webservice.GetCommandRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.GetCommandResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.GetCommandResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.GetCommandResponse.displayName = 'proto.grpc.service.webservice.GetCommandResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.GenerateAPIKeyRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.GenerateAPIKeyRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.GenerateAPIKeyRequest.displayName = 'proto.grpc.service.webservice.GenerateAPIKeyRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.GenerateAPIKeyResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.GenerateAPIKeyResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.GenerateAPIKeyResponse.displayName = 'proto.grpc.service.webservice.GenerateAPIKeyResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.DisableAPIKeyRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.DisableAPIKeyRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.DisableAPIKeyRequest.displayName = 'proto.grpc.service.webservice.DisableAPIKeyRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.DisableAPIKeyResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.DisableAPIKeyResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.DisableAPIKeyResponse.displayName = 'proto.grpc.service.webservice.DisableAPIKeyResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.ListAPIKeysRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.ListAPIKeysRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.ListAPIKeysRequest.displayName = 'proto.grpc.service.webservice.ListAPIKeysRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.ListAPIKeysRequest.Options = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.ListAPIKeysRequest.Options, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.ListAPIKeysRequest.Options.displayName = 'proto.grpc.service.webservice.ListAPIKeysRequest.Options';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.ListAPIKeysResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.grpc.service.webservice.ListAPIKeysResponse.repeatedFields_, null);
+};
+goog.inherits(proto.grpc.service.webservice.ListAPIKeysResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.ListAPIKeysResponse.displayName = 'proto.grpc.service.webservice.ListAPIKeysResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.GetInsightDataRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.GetInsightDataRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.GetInsightDataRequest.displayName = 'proto.grpc.service.webservice.GetInsightDataRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.GetInsightDataResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.grpc.service.webservice.GetInsightDataResponse.repeatedFields_, null);
+};
+goog.inherits(proto.grpc.service.webservice.GetInsightDataResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.GetInsightDataResponse.displayName = 'proto.grpc.service.webservice.GetInsightDataResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.GetInsightApplicationCountRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.GetInsightApplicationCountRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.GetInsightApplicationCountRequest.displayName = 'proto.grpc.service.webservice.GetInsightApplicationCountRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.GetInsightApplicationCountResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.grpc.service.webservice.GetInsightApplicationCountResponse.repeatedFields_, null);
+};
+goog.inherits(proto.grpc.service.webservice.GetInsightApplicationCountResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.GetInsightApplicationCountResponse.displayName = 'proto.grpc.service.webservice.GetInsightApplicationCountResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.ListDeploymentChainsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.ListDeploymentChainsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.ListDeploymentChainsRequest.displayName = 'proto.grpc.service.webservice.ListDeploymentChainsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.ListDeploymentChainsRequest.Options = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.ListDeploymentChainsRequest.Options, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.displayName = 'proto.grpc.service.webservice.ListDeploymentChainsRequest.Options';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.ListDeploymentChainsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.grpc.service.webservice.ListDeploymentChainsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.grpc.service.webservice.ListDeploymentChainsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.ListDeploymentChainsResponse.displayName = 'proto.grpc.service.webservice.ListDeploymentChainsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.GetDeploymentChainRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.GetDeploymentChainRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.GetDeploymentChainRequest.displayName = 'proto.grpc.service.webservice.GetDeploymentChainRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.GetDeploymentChainResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.GetDeploymentChainResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.GetDeploymentChainResponse.displayName = 'proto.grpc.service.webservice.GetDeploymentChainResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.CancelDeploymentChainRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.CancelDeploymentChainRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.CancelDeploymentChainRequest.displayName = 'proto.grpc.service.webservice.CancelDeploymentChainRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.CancelDeploymentChainResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.grpc.service.webservice.CancelDeploymentChainResponse.repeatedFields_, null);
+};
+goog.inherits(proto.grpc.service.webservice.CancelDeploymentChainResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.CancelDeploymentChainResponse.displayName = 'proto.grpc.service.webservice.CancelDeploymentChainResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.ListEventsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.ListEventsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.ListEventsRequest.displayName = 'proto.grpc.service.webservice.ListEventsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.ListEventsRequest.Options = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.grpc.service.webservice.ListEventsRequest.Options.repeatedFields_, null);
+};
+goog.inherits(proto.grpc.service.webservice.ListEventsRequest.Options, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.ListEventsRequest.Options.displayName = 'proto.grpc.service.webservice.ListEventsRequest.Options';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.ListEventsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.grpc.service.webservice.ListEventsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.grpc.service.webservice.ListEventsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.ListEventsResponse.displayName = 'proto.grpc.service.webservice.ListEventsResponse';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.RegisterPipedRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.RegisterPipedRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.RegisterPipedRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.RegisterPipedRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    desc: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.RegisterPipedRequest}
+ */
+proto.grpc.service.webservice.RegisterPipedRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.RegisterPipedRequest;
+  return proto.grpc.service.webservice.RegisterPipedRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.RegisterPipedRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
  * @return {!proto.grpc.service.webservice.RegisterPipedRequest}
  */
-proto.grpc.service.webservice.RegisterPipedRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.RegisterPipedRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDesc(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.RegisterPipedRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.RegisterPipedRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.RegisterPipedRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.RegisterPipedRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDesc();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.RegisterPipedRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.RegisterPipedRequest} returns this
+ */
+proto.grpc.service.webservice.RegisterPipedRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string desc = 2;
+ * @return {string}
+ */
+proto.grpc.service.webservice.RegisterPipedRequest.prototype.getDesc = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.RegisterPipedRequest} returns this
+ */
+proto.grpc.service.webservice.RegisterPipedRequest.prototype.setDesc = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.RegisterPipedResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.RegisterPipedResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.RegisterPipedResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.RegisterPipedResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    key: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.RegisterPipedResponse}
+ */
+proto.grpc.service.webservice.RegisterPipedResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.RegisterPipedResponse;
+  return proto.grpc.service.webservice.RegisterPipedResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.RegisterPipedResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.RegisterPipedResponse}
+ */
+proto.grpc.service.webservice.RegisterPipedResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setKey(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.RegisterPipedResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.RegisterPipedResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.RegisterPipedResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.RegisterPipedResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getKey();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.RegisterPipedResponse.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.RegisterPipedResponse} returns this
+ */
+proto.grpc.service.webservice.RegisterPipedResponse.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string key = 2;
+ * @return {string}
+ */
+proto.grpc.service.webservice.RegisterPipedResponse.prototype.getKey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.RegisterPipedResponse} returns this
+ */
+proto.grpc.service.webservice.RegisterPipedResponse.prototype.setKey = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.UpdatePipedRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.UpdatePipedRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.UpdatePipedRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.UpdatePipedRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pipedId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    desc: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.UpdatePipedRequest}
+ */
+proto.grpc.service.webservice.UpdatePipedRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.UpdatePipedRequest;
+  return proto.grpc.service.webservice.UpdatePipedRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.UpdatePipedRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.UpdatePipedRequest}
+ */
+proto.grpc.service.webservice.UpdatePipedRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPipedId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDesc(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.UpdatePipedRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.UpdatePipedRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.UpdatePipedRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.UpdatePipedRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPipedId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getDesc();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string piped_id = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.UpdatePipedRequest.prototype.getPipedId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.UpdatePipedRequest} returns this
+ */
+proto.grpc.service.webservice.UpdatePipedRequest.prototype.setPipedId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.grpc.service.webservice.UpdatePipedRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.UpdatePipedRequest} returns this
+ */
+proto.grpc.service.webservice.UpdatePipedRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string desc = 3;
+ * @return {string}
+ */
+proto.grpc.service.webservice.UpdatePipedRequest.prototype.getDesc = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.UpdatePipedRequest} returns this
+ */
+proto.grpc.service.webservice.UpdatePipedRequest.prototype.setDesc = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.UpdatePipedResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.UpdatePipedResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.UpdatePipedResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.UpdatePipedResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.UpdatePipedResponse}
+ */
+proto.grpc.service.webservice.UpdatePipedResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.UpdatePipedResponse;
+  return proto.grpc.service.webservice.UpdatePipedResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.UpdatePipedResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.UpdatePipedResponse}
+ */
+proto.grpc.service.webservice.UpdatePipedResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.UpdatePipedResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.UpdatePipedResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.UpdatePipedResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.UpdatePipedResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.RecreatePipedKeyRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.RecreatePipedKeyRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.RecreatePipedKeyRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.RecreatePipedKeyRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.RecreatePipedKeyRequest}
+ */
+proto.grpc.service.webservice.RecreatePipedKeyRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.RecreatePipedKeyRequest;
+  return proto.grpc.service.webservice.RecreatePipedKeyRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.RecreatePipedKeyRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.RecreatePipedKeyRequest}
+ */
+proto.grpc.service.webservice.RecreatePipedKeyRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.RecreatePipedKeyRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.RecreatePipedKeyRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.RecreatePipedKeyRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.RecreatePipedKeyRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.RecreatePipedKeyRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.RecreatePipedKeyRequest} returns this
+ */
+proto.grpc.service.webservice.RecreatePipedKeyRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.RecreatePipedKeyResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.RecreatePipedKeyResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.RecreatePipedKeyResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.RecreatePipedKeyResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    key: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.RecreatePipedKeyResponse}
+ */
+proto.grpc.service.webservice.RecreatePipedKeyResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.RecreatePipedKeyResponse;
+  return proto.grpc.service.webservice.RecreatePipedKeyResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.RecreatePipedKeyResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.RecreatePipedKeyResponse}
+ */
+proto.grpc.service.webservice.RecreatePipedKeyResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setKey(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.RecreatePipedKeyResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.RecreatePipedKeyResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.RecreatePipedKeyResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.RecreatePipedKeyResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getKey();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string key = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.RecreatePipedKeyResponse.prototype.getKey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.RecreatePipedKeyResponse} returns this
+ */
+proto.grpc.service.webservice.RecreatePipedKeyResponse.prototype.setKey = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.DeleteOldPipedKeysRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.DeleteOldPipedKeysRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.DeleteOldPipedKeysRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.DeleteOldPipedKeysRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pipedId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.DeleteOldPipedKeysRequest}
+ */
+proto.grpc.service.webservice.DeleteOldPipedKeysRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.DeleteOldPipedKeysRequest;
+  return proto.grpc.service.webservice.DeleteOldPipedKeysRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.DeleteOldPipedKeysRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.DeleteOldPipedKeysRequest}
+ */
+proto.grpc.service.webservice.DeleteOldPipedKeysRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPipedId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.DeleteOldPipedKeysRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.DeleteOldPipedKeysRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.DeleteOldPipedKeysRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.DeleteOldPipedKeysRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPipedId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string piped_id = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.DeleteOldPipedKeysRequest.prototype.getPipedId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.DeleteOldPipedKeysRequest} returns this
+ */
+proto.grpc.service.webservice.DeleteOldPipedKeysRequest.prototype.setPipedId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.DeleteOldPipedKeysResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.DeleteOldPipedKeysResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.DeleteOldPipedKeysResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.DeleteOldPipedKeysResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.DeleteOldPipedKeysResponse}
+ */
+proto.grpc.service.webservice.DeleteOldPipedKeysResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.DeleteOldPipedKeysResponse;
+  return proto.grpc.service.webservice.DeleteOldPipedKeysResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.DeleteOldPipedKeysResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.DeleteOldPipedKeysResponse}
+ */
+proto.grpc.service.webservice.DeleteOldPipedKeysResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.DeleteOldPipedKeysResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.DeleteOldPipedKeysResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.DeleteOldPipedKeysResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.DeleteOldPipedKeysResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.EnablePipedRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.EnablePipedRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.EnablePipedRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.EnablePipedRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pipedId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.EnablePipedRequest}
+ */
+proto.grpc.service.webservice.EnablePipedRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.EnablePipedRequest;
+  return proto.grpc.service.webservice.EnablePipedRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.EnablePipedRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.EnablePipedRequest}
+ */
+proto.grpc.service.webservice.EnablePipedRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPipedId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.EnablePipedRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.EnablePipedRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.EnablePipedRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.EnablePipedRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPipedId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string piped_id = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.EnablePipedRequest.prototype.getPipedId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.EnablePipedRequest} returns this
+ */
+proto.grpc.service.webservice.EnablePipedRequest.prototype.setPipedId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.EnablePipedResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.EnablePipedResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.EnablePipedResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.EnablePipedResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.EnablePipedResponse}
+ */
+proto.grpc.service.webservice.EnablePipedResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.EnablePipedResponse;
+  return proto.grpc.service.webservice.EnablePipedResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.EnablePipedResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.EnablePipedResponse}
+ */
+proto.grpc.service.webservice.EnablePipedResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.EnablePipedResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.EnablePipedResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.EnablePipedResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.EnablePipedResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.DisablePipedRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.DisablePipedRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.DisablePipedRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.DisablePipedRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pipedId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.DisablePipedRequest}
+ */
+proto.grpc.service.webservice.DisablePipedRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.DisablePipedRequest;
+  return proto.grpc.service.webservice.DisablePipedRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.DisablePipedRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.DisablePipedRequest}
+ */
+proto.grpc.service.webservice.DisablePipedRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPipedId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.DisablePipedRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.DisablePipedRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.DisablePipedRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.DisablePipedRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPipedId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string piped_id = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.DisablePipedRequest.prototype.getPipedId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.DisablePipedRequest} returns this
+ */
+proto.grpc.service.webservice.DisablePipedRequest.prototype.setPipedId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.DisablePipedResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.DisablePipedResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.DisablePipedResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.DisablePipedResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.DisablePipedResponse}
+ */
+proto.grpc.service.webservice.DisablePipedResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.DisablePipedResponse;
+  return proto.grpc.service.webservice.DisablePipedResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.DisablePipedResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.DisablePipedResponse}
+ */
+proto.grpc.service.webservice.DisablePipedResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.DisablePipedResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.DisablePipedResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.DisablePipedResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.DisablePipedResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.ListPipedsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListPipedsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.ListPipedsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.ListPipedsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    withStatus: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    options: (f = msg.getOptions()) && proto.grpc.service.webservice.ListPipedsRequest.Options.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.ListPipedsRequest}
+ */
+proto.grpc.service.webservice.ListPipedsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.ListPipedsRequest;
+  return proto.grpc.service.webservice.ListPipedsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.ListPipedsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.ListPipedsRequest}
+ */
+proto.grpc.service.webservice.ListPipedsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setWithStatus(value);
+      break;
+    case 2:
+      var value = new proto.grpc.service.webservice.ListPipedsRequest.Options;
+      reader.readMessage(value,proto.grpc.service.webservice.ListPipedsRequest.Options.deserializeBinaryFromReader);
+      msg.setOptions(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.ListPipedsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.ListPipedsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.ListPipedsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.ListPipedsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getWithStatus();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getOptions();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.grpc.service.webservice.ListPipedsRequest.Options.serializeBinaryToWriter
+    );
+  }
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.ListPipedsRequest.Options.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListPipedsRequest.Options.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.ListPipedsRequest.Options} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.ListPipedsRequest.Options.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enabled: (f = msg.getEnabled()) && google_protobuf_wrappers_pb.BoolValue.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.ListPipedsRequest.Options}
+ */
+proto.grpc.service.webservice.ListPipedsRequest.Options.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.ListPipedsRequest.Options;
+  return proto.grpc.service.webservice.ListPipedsRequest.Options.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.ListPipedsRequest.Options} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.ListPipedsRequest.Options}
+ */
+proto.grpc.service.webservice.ListPipedsRequest.Options.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new google_protobuf_wrappers_pb.BoolValue;
+      reader.readMessage(value,google_protobuf_wrappers_pb.BoolValue.deserializeBinaryFromReader);
+      msg.setEnabled(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.ListPipedsRequest.Options.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.ListPipedsRequest.Options.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.ListPipedsRequest.Options} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.ListPipedsRequest.Options.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnabled();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      google_protobuf_wrappers_pb.BoolValue.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional google.protobuf.BoolValue enabled = 1;
+ * @return {?proto.google.protobuf.BoolValue}
+ */
+proto.grpc.service.webservice.ListPipedsRequest.Options.prototype.getEnabled = function() {
+  return /** @type{?proto.google.protobuf.BoolValue} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_wrappers_pb.BoolValue, 1));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.BoolValue|undefined} value
+ * @return {!proto.grpc.service.webservice.ListPipedsRequest.Options} returns this
+*/
+proto.grpc.service.webservice.ListPipedsRequest.Options.prototype.setEnabled = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.grpc.service.webservice.ListPipedsRequest.Options} returns this
+ */
+proto.grpc.service.webservice.ListPipedsRequest.Options.prototype.clearEnabled = function() {
+  return this.setEnabled(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.grpc.service.webservice.ListPipedsRequest.Options.prototype.hasEnabled = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional bool with_status = 1;
+ * @return {boolean}
+ */
+proto.grpc.service.webservice.ListPipedsRequest.prototype.getWithStatus = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.grpc.service.webservice.ListPipedsRequest} returns this
+ */
+proto.grpc.service.webservice.ListPipedsRequest.prototype.setWithStatus = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+/**
+ * optional Options options = 2;
+ * @return {?proto.grpc.service.webservice.ListPipedsRequest.Options}
+ */
+proto.grpc.service.webservice.ListPipedsRequest.prototype.getOptions = function() {
+  return /** @type{?proto.grpc.service.webservice.ListPipedsRequest.Options} */ (
+    jspb.Message.getWrapperField(this, proto.grpc.service.webservice.ListPipedsRequest.Options, 2));
+};
+
+
+/**
+ * @param {?proto.grpc.service.webservice.ListPipedsRequest.Options|undefined} value
+ * @return {!proto.grpc.service.webservice.ListPipedsRequest} returns this
+*/
+proto.grpc.service.webservice.ListPipedsRequest.prototype.setOptions = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.grpc.service.webservice.ListPipedsRequest} returns this
+ */
+proto.grpc.service.webservice.ListPipedsRequest.prototype.clearOptions = function() {
+  return this.setOptions(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.grpc.service.webservice.ListPipedsRequest.prototype.hasOptions = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.grpc.service.webservice.ListPipedsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.ListPipedsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListPipedsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.ListPipedsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.ListPipedsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pipedsList: jspb.Message.toObjectList(msg.getPipedsList(),
+    pkg_model_piped_pb.Piped.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.ListPipedsResponse}
+ */
+proto.grpc.service.webservice.ListPipedsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.ListPipedsResponse;
+  return proto.grpc.service.webservice.ListPipedsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.ListPipedsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.ListPipedsResponse}
+ */
+proto.grpc.service.webservice.ListPipedsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new pkg_model_piped_pb.Piped;
+      reader.readMessage(value,pkg_model_piped_pb.Piped.deserializeBinaryFromReader);
+      msg.addPipeds(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.ListPipedsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.ListPipedsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.ListPipedsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.ListPipedsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPipedsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      pkg_model_piped_pb.Piped.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated model.Piped pipeds = 1;
+ * @return {!Array<!proto.model.Piped>}
+ */
+proto.grpc.service.webservice.ListPipedsResponse.prototype.getPipedsList = function() {
+  return /** @type{!Array<!proto.model.Piped>} */ (
+    jspb.Message.getRepeatedWrapperField(this, pkg_model_piped_pb.Piped, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.model.Piped>} value
+ * @return {!proto.grpc.service.webservice.ListPipedsResponse} returns this
+*/
+proto.grpc.service.webservice.ListPipedsResponse.prototype.setPipedsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.model.Piped=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.Piped}
+ */
+proto.grpc.service.webservice.ListPipedsResponse.prototype.addPipeds = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.Piped, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.ListPipedsResponse} returns this
+ */
+proto.grpc.service.webservice.ListPipedsResponse.prototype.clearPipedsList = function() {
+  return this.setPipedsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.GetPipedRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GetPipedRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.GetPipedRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.GetPipedRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pipedId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.GetPipedRequest}
+ */
+proto.grpc.service.webservice.GetPipedRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.GetPipedRequest;
+  return proto.grpc.service.webservice.GetPipedRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.GetPipedRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.GetPipedRequest}
+ */
+proto.grpc.service.webservice.GetPipedRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPipedId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.GetPipedRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.GetPipedRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.GetPipedRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.GetPipedRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPipedId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string piped_id = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.GetPipedRequest.prototype.getPipedId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.GetPipedRequest} returns this
+ */
+proto.grpc.service.webservice.GetPipedRequest.prototype.setPipedId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.GetPipedResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GetPipedResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.GetPipedResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.GetPipedResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    piped: (f = msg.getPiped()) && pkg_model_piped_pb.Piped.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.GetPipedResponse}
+ */
+proto.grpc.service.webservice.GetPipedResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.GetPipedResponse;
+  return proto.grpc.service.webservice.GetPipedResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.GetPipedResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.GetPipedResponse}
+ */
+proto.grpc.service.webservice.GetPipedResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new pkg_model_piped_pb.Piped;
+      reader.readMessage(value,pkg_model_piped_pb.Piped.deserializeBinaryFromReader);
+      msg.setPiped(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.GetPipedResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.GetPipedResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.GetPipedResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.GetPipedResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPiped();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      pkg_model_piped_pb.Piped.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional model.Piped piped = 1;
+ * @return {?proto.model.Piped}
+ */
+proto.grpc.service.webservice.GetPipedResponse.prototype.getPiped = function() {
+  return /** @type{?proto.model.Piped} */ (
+    jspb.Message.getWrapperField(this, pkg_model_piped_pb.Piped, 1));
+};
+
+
+/**
+ * @param {?proto.model.Piped|undefined} value
+ * @return {!proto.grpc.service.webservice.GetPipedResponse} returns this
+*/
+proto.grpc.service.webservice.GetPipedResponse.prototype.setPiped = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.grpc.service.webservice.GetPipedResponse} returns this
+ */
+proto.grpc.service.webservice.GetPipedResponse.prototype.clearPiped = function() {
+  return this.setPiped(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.grpc.service.webservice.GetPipedResponse.prototype.hasPiped = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    version: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    pipedIdsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest}
+ */
+proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest;
+  return proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest}
+ */
+proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setVersion(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addPipedIds(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getVersion();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPipedIdsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string version = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.prototype.getVersion = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest} returns this
+ */
+proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.prototype.setVersion = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated string piped_ids = 2;
+ * @return {!Array<string>}
+ */
+proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.prototype.getPipedIdsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest} returns this
+ */
+proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.prototype.setPipedIdsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest} returns this
+ */
+proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.prototype.addPipedIds = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest} returns this
+ */
+proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.prototype.clearPipedIdsList = function() {
+  return this.setPipedIdsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse}
+ */
+proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse;
+  return proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse}
+ */
+proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.RestartPipedRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.RestartPipedRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.RestartPipedRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.RestartPipedRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pipedId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.RestartPipedRequest}
+ */
+proto.grpc.service.webservice.RestartPipedRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.RestartPipedRequest;
+  return proto.grpc.service.webservice.RestartPipedRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.RestartPipedRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.RestartPipedRequest}
+ */
+proto.grpc.service.webservice.RestartPipedRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPipedId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.RestartPipedRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.RestartPipedRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.RestartPipedRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.RestartPipedRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPipedId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string piped_id = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.RestartPipedRequest.prototype.getPipedId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.RestartPipedRequest} returns this
+ */
+proto.grpc.service.webservice.RestartPipedRequest.prototype.setPipedId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.RestartPipedResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.RestartPipedResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.RestartPipedResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.RestartPipedResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    commandId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.RestartPipedResponse}
+ */
+proto.grpc.service.webservice.RestartPipedResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.RestartPipedResponse;
+  return proto.grpc.service.webservice.RestartPipedResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.RestartPipedResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.RestartPipedResponse}
+ */
+proto.grpc.service.webservice.RestartPipedResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommandId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.RestartPipedResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.RestartPipedResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.RestartPipedResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.RestartPipedResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCommandId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string command_id = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.RestartPipedResponse.prototype.getCommandId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.RestartPipedResponse} returns this
+ */
+proto.grpc.service.webservice.RestartPipedResponse.prototype.setCommandId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.ListReleasedVersionsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListReleasedVersionsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.ListReleasedVersionsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.ListReleasedVersionsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.ListReleasedVersionsRequest}
+ */
+proto.grpc.service.webservice.ListReleasedVersionsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.ListReleasedVersionsRequest;
+  return proto.grpc.service.webservice.ListReleasedVersionsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.ListReleasedVersionsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.ListReleasedVersionsRequest}
+ */
+proto.grpc.service.webservice.ListReleasedVersionsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.ListReleasedVersionsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.ListReleasedVersionsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.ListReleasedVersionsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.ListReleasedVersionsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.grpc.service.webservice.ListReleasedVersionsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.ListReleasedVersionsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListReleasedVersionsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.ListReleasedVersionsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.ListReleasedVersionsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    versionsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.ListReleasedVersionsResponse}
+ */
+proto.grpc.service.webservice.ListReleasedVersionsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.ListReleasedVersionsResponse;
+  return proto.grpc.service.webservice.ListReleasedVersionsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.ListReleasedVersionsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.ListReleasedVersionsResponse}
+ */
+proto.grpc.service.webservice.ListReleasedVersionsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addVersions(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.ListReleasedVersionsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.ListReleasedVersionsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.ListReleasedVersionsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.ListReleasedVersionsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getVersionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string versions = 1;
+ * @return {!Array<string>}
+ */
+proto.grpc.service.webservice.ListReleasedVersionsResponse.prototype.getVersionsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.grpc.service.webservice.ListReleasedVersionsResponse} returns this
+ */
+proto.grpc.service.webservice.ListReleasedVersionsResponse.prototype.setVersionsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.grpc.service.webservice.ListReleasedVersionsResponse} returns this
+ */
+proto.grpc.service.webservice.ListReleasedVersionsResponse.prototype.addVersions = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.ListReleasedVersionsResponse} returns this
+ */
+proto.grpc.service.webservice.ListReleasedVersionsResponse.prototype.clearVersionsList = function() {
+  return this.setVersionsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.ListDeprecatedNotesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListDeprecatedNotesRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.ListDeprecatedNotesRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.ListDeprecatedNotesRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    projectId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.ListDeprecatedNotesRequest}
+ */
+proto.grpc.service.webservice.ListDeprecatedNotesRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.ListDeprecatedNotesRequest;
+  return proto.grpc.service.webservice.ListDeprecatedNotesRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.ListDeprecatedNotesRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.ListDeprecatedNotesRequest}
+ */
+proto.grpc.service.webservice.ListDeprecatedNotesRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProjectId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.ListDeprecatedNotesRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.ListDeprecatedNotesRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.ListDeprecatedNotesRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.ListDeprecatedNotesRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getProjectId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string project_id = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.ListDeprecatedNotesRequest.prototype.getProjectId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.ListDeprecatedNotesRequest} returns this
+ */
+proto.grpc.service.webservice.ListDeprecatedNotesRequest.prototype.setProjectId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.ListDeprecatedNotesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListDeprecatedNotesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.ListDeprecatedNotesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.ListDeprecatedNotesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    notes: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.ListDeprecatedNotesResponse}
+ */
+proto.grpc.service.webservice.ListDeprecatedNotesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.ListDeprecatedNotesResponse;
+  return proto.grpc.service.webservice.ListDeprecatedNotesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.ListDeprecatedNotesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.ListDeprecatedNotesResponse}
+ */
+proto.grpc.service.webservice.ListDeprecatedNotesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNotes(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.ListDeprecatedNotesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.ListDeprecatedNotesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.ListDeprecatedNotesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.ListDeprecatedNotesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNotes();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string notes = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.ListDeprecatedNotesResponse.prototype.getNotes = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.ListDeprecatedNotesResponse} returns this
+ */
+proto.grpc.service.webservice.ListDeprecatedNotesResponse.prototype.setNotes = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.AddApplicationRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.AddApplicationRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.AddApplicationRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.AddApplicationRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    pipedId: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    gitPath: (f = msg.getGitPath()) && pkg_model_common_pb.ApplicationGitPath.toObject(includeInstance, f),
+    kind: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    platformProvider: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    deployTargetsByPluginMap: (f = msg.getDeployTargetsByPluginMap()) ? f.toObject(includeInstance, proto.model.DeployTargets.toObject) : [],
+    description: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.AddApplicationRequest}
+ */
+proto.grpc.service.webservice.AddApplicationRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.AddApplicationRequest;
+  return proto.grpc.service.webservice.AddApplicationRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.AddApplicationRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.AddApplicationRequest}
+ */
+proto.grpc.service.webservice.AddApplicationRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPipedId(value);
+      break;
+    case 4:
+      var value = new pkg_model_common_pb.ApplicationGitPath;
+      reader.readMessage(value,pkg_model_common_pb.ApplicationGitPath.deserializeBinaryFromReader);
+      msg.setGitPath(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.model.ApplicationKind} */ (reader.readEnum());
+      msg.setKind(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPlatformProvider(value);
+      break;
+    case 10:
+      var value = msg.getDeployTargetsByPluginMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.model.DeployTargets.deserializeBinaryFromReader, "", new proto.model.DeployTargets());
+         });
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDescription(value);
+      break;
+    case 8:
+      var value = msg.getLabelsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.AddApplicationRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.AddApplicationRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.AddApplicationRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.AddApplicationRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPipedId();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getGitPath();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      pkg_model_common_pb.ApplicationGitPath.serializeBinaryToWriter
+    );
+  }
+  f = message.getKind();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+  f = message.getPlatformProvider();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getDeployTargetsByPluginMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(10, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.model.DeployTargets.serializeBinaryToWriter);
+  }
+  f = message.getDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getLabelsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(8, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.AddApplicationRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.AddApplicationRequest} returns this
+ */
+proto.grpc.service.webservice.AddApplicationRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string piped_id = 3;
+ * @return {string}
+ */
+proto.grpc.service.webservice.AddApplicationRequest.prototype.getPipedId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.AddApplicationRequest} returns this
+ */
+proto.grpc.service.webservice.AddApplicationRequest.prototype.setPipedId = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional model.ApplicationGitPath git_path = 4;
+ * @return {?proto.model.ApplicationGitPath}
+ */
+proto.grpc.service.webservice.AddApplicationRequest.prototype.getGitPath = function() {
+  return /** @type{?proto.model.ApplicationGitPath} */ (
+    jspb.Message.getWrapperField(this, pkg_model_common_pb.ApplicationGitPath, 4));
+};
+
+
+/**
+ * @param {?proto.model.ApplicationGitPath|undefined} value
+ * @return {!proto.grpc.service.webservice.AddApplicationRequest} returns this
+*/
+proto.grpc.service.webservice.AddApplicationRequest.prototype.setGitPath = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.grpc.service.webservice.AddApplicationRequest} returns this
+ */
+proto.grpc.service.webservice.AddApplicationRequest.prototype.clearGitPath = function() {
+  return this.setGitPath(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.grpc.service.webservice.AddApplicationRequest.prototype.hasGitPath = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional model.ApplicationKind kind = 5;
+ * @return {!proto.model.ApplicationKind}
+ */
+proto.grpc.service.webservice.AddApplicationRequest.prototype.getKind = function() {
+  return /** @type {!proto.model.ApplicationKind} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {!proto.model.ApplicationKind} value
+ * @return {!proto.grpc.service.webservice.AddApplicationRequest} returns this
+ */
+proto.grpc.service.webservice.AddApplicationRequest.prototype.setKind = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
+};
+
+
+/**
+ * optional string platform_provider = 9;
+ * @return {string}
+ */
+proto.grpc.service.webservice.AddApplicationRequest.prototype.getPlatformProvider = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.AddApplicationRequest} returns this
+ */
+proto.grpc.service.webservice.AddApplicationRequest.prototype.setPlatformProvider = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
+};
+
+
+/**
+ * map<string, model.DeployTargets> deploy_targets_by_plugin = 10;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.model.DeployTargets>}
+ */
+proto.grpc.service.webservice.AddApplicationRequest.prototype.getDeployTargetsByPluginMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.model.DeployTargets>} */ (
+      jspb.Message.getMapField(this, 10, opt_noLazyCreate,
+      proto.model.DeployTargets));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.grpc.service.webservice.AddApplicationRequest} returns this
+ */
+proto.grpc.service.webservice.AddApplicationRequest.prototype.clearDeployTargetsByPluginMap = function() {
+  this.getDeployTargetsByPluginMap().clear();
+  return this;
+};
+
+
+/**
+ * optional string description = 7;
+ * @return {string}
+ */
+proto.grpc.service.webservice.AddApplicationRequest.prototype.getDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.AddApplicationRequest} returns this
+ */
+proto.grpc.service.webservice.AddApplicationRequest.prototype.setDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * map<string, string> labels = 8;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.grpc.service.webservice.AddApplicationRequest.prototype.getLabelsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 8, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.grpc.service.webservice.AddApplicationRequest} returns this
+ */
+proto.grpc.service.webservice.AddApplicationRequest.prototype.clearLabelsMap = function() {
+  this.getLabelsMap().clear();
+  return this;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.AddApplicationResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.AddApplicationResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.AddApplicationResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.AddApplicationResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    applicationId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.AddApplicationResponse}
+ */
+proto.grpc.service.webservice.AddApplicationResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.AddApplicationResponse;
+  return proto.grpc.service.webservice.AddApplicationResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.AddApplicationResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.AddApplicationResponse}
+ */
+proto.grpc.service.webservice.AddApplicationResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApplicationId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.AddApplicationResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.AddApplicationResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.AddApplicationResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.AddApplicationResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getApplicationId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string application_id = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.AddApplicationResponse.prototype.getApplicationId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.AddApplicationResponse} returns this
+ */
+proto.grpc.service.webservice.AddApplicationResponse.prototype.setApplicationId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.UpdateApplicationRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.UpdateApplicationRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.UpdateApplicationRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.UpdateApplicationRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    applicationId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    pipedId: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    kind: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    platformProvider: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    deployTargetsByPluginMap: (f = msg.getDeployTargetsByPluginMap()) ? f.toObject(includeInstance, proto.model.DeployTargets.toObject) : [],
+    configFilename: jspb.Message.getFieldWithDefault(msg, 8, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.UpdateApplicationRequest}
+ */
+proto.grpc.service.webservice.UpdateApplicationRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.UpdateApplicationRequest;
+  return proto.grpc.service.webservice.UpdateApplicationRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.UpdateApplicationRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.UpdateApplicationRequest}
+ */
+proto.grpc.service.webservice.UpdateApplicationRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApplicationId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPipedId(value);
+      break;
+    case 6:
+      var value = /** @type {!proto.model.ApplicationKind} */ (reader.readEnum());
+      msg.setKind(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPlatformProvider(value);
+      break;
+    case 10:
+      var value = msg.getDeployTargetsByPluginMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.model.DeployTargets.deserializeBinaryFromReader, "", new proto.model.DeployTargets());
+         });
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setConfigFilename(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.UpdateApplicationRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.UpdateApplicationRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.UpdateApplicationRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.UpdateApplicationRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getApplicationId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPipedId();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getKind();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      6,
+      f
+    );
+  }
+  f = message.getPlatformProvider();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getDeployTargetsByPluginMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(10, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.model.DeployTargets.serializeBinaryToWriter);
+  }
+  f = message.getConfigFilename();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string application_id = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.UpdateApplicationRequest.prototype.getApplicationId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.UpdateApplicationRequest} returns this
+ */
+proto.grpc.service.webservice.UpdateApplicationRequest.prototype.setApplicationId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.grpc.service.webservice.UpdateApplicationRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.UpdateApplicationRequest} returns this
+ */
+proto.grpc.service.webservice.UpdateApplicationRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string piped_id = 4;
+ * @return {string}
+ */
+proto.grpc.service.webservice.UpdateApplicationRequest.prototype.getPipedId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.UpdateApplicationRequest} returns this
+ */
+proto.grpc.service.webservice.UpdateApplicationRequest.prototype.setPipedId = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional model.ApplicationKind kind = 6;
+ * @return {!proto.model.ApplicationKind}
+ */
+proto.grpc.service.webservice.UpdateApplicationRequest.prototype.getKind = function() {
+  return /** @type {!proto.model.ApplicationKind} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {!proto.model.ApplicationKind} value
+ * @return {!proto.grpc.service.webservice.UpdateApplicationRequest} returns this
+ */
+proto.grpc.service.webservice.UpdateApplicationRequest.prototype.setKind = function(value) {
+  return jspb.Message.setProto3EnumField(this, 6, value);
+};
+
+
+/**
+ * optional string platform_provider = 9;
+ * @return {string}
+ */
+proto.grpc.service.webservice.UpdateApplicationRequest.prototype.getPlatformProvider = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.UpdateApplicationRequest} returns this
+ */
+proto.grpc.service.webservice.UpdateApplicationRequest.prototype.setPlatformProvider = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
+};
+
+
+/**
+ * map<string, model.DeployTargets> deploy_targets_by_plugin = 10;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.model.DeployTargets>}
+ */
+proto.grpc.service.webservice.UpdateApplicationRequest.prototype.getDeployTargetsByPluginMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.model.DeployTargets>} */ (
+      jspb.Message.getMapField(this, 10, opt_noLazyCreate,
+      proto.model.DeployTargets));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.grpc.service.webservice.UpdateApplicationRequest} returns this
+ */
+proto.grpc.service.webservice.UpdateApplicationRequest.prototype.clearDeployTargetsByPluginMap = function() {
+  this.getDeployTargetsByPluginMap().clear();
+  return this;
+};
+
+
+/**
+ * optional string config_filename = 8;
+ * @return {string}
+ */
+proto.grpc.service.webservice.UpdateApplicationRequest.prototype.getConfigFilename = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.UpdateApplicationRequest} returns this
+ */
+proto.grpc.service.webservice.UpdateApplicationRequest.prototype.setConfigFilename = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.UpdateApplicationResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.UpdateApplicationResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.UpdateApplicationResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.UpdateApplicationResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.UpdateApplicationResponse}
+ */
+proto.grpc.service.webservice.UpdateApplicationResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.RegisterPipedRequest;
-  return proto.grpc.service.webservice.RegisterPipedRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.UpdateApplicationResponse;
+  return proto.grpc.service.webservice.UpdateApplicationResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.RegisterPipedRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.UpdateApplicationResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.RegisterPipedRequest}
+ * @return {!proto.grpc.service.webservice.UpdateApplicationResponse}
  */
-proto.grpc.service.webservice.RegisterPipedRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.UpdateApplicationResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDesc(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -2566,9 +7453,9 @@ proto.grpc.service.webservice.RegisterPipedRequest.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.RegisterPipedRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.UpdateApplicationResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.RegisterPipedRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.UpdateApplicationResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2576,62 +7463,12 @@ proto.grpc.service.webservice.RegisterPipedRequest.prototype.serializeBinary = f
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.RegisterPipedRequest} message
+ * @param {!proto.grpc.service.webservice.UpdateApplicationResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.RegisterPipedRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.UpdateApplicationResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getDesc();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string name = 1;
- * @return {string}
- */
-proto.grpc.service.webservice.RegisterPipedRequest.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.RegisterPipedRequest} returns this
- */
-proto.grpc.service.webservice.RegisterPipedRequest.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional string desc = 2;
- * @return {string}
- */
-proto.grpc.service.webservice.RegisterPipedRequest.prototype.getDesc = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.RegisterPipedRequest} returns this
- */
-proto.grpc.service.webservice.RegisterPipedRequest.prototype.setDesc = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -2651,8 +7488,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.RegisterPipedResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.RegisterPipedResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.EnableApplicationRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.EnableApplicationRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2661,14 +7498,13 @@ proto.grpc.service.webservice.RegisterPipedResponse.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.RegisterPipedResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.EnableApplicationRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.RegisterPipedResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.EnableApplicationRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    key: jspb.Message.getFieldWithDefault(msg, 2, "")
+    applicationId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -2682,23 +7518,23 @@ proto.grpc.service.webservice.RegisterPipedResponse.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.RegisterPipedResponse}
+ * @return {!proto.grpc.service.webservice.EnableApplicationRequest}
  */
-proto.grpc.service.webservice.RegisterPipedResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.EnableApplicationRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.RegisterPipedResponse;
-  return proto.grpc.service.webservice.RegisterPipedResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.EnableApplicationRequest;
+  return proto.grpc.service.webservice.EnableApplicationRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.RegisterPipedResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.EnableApplicationRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.RegisterPipedResponse}
+ * @return {!proto.grpc.service.webservice.EnableApplicationRequest}
  */
-proto.grpc.service.webservice.RegisterPipedResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.EnableApplicationRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2707,11 +7543,7 @@ proto.grpc.service.webservice.RegisterPipedResponse.deserializeBinaryFromReader
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setKey(value);
+      msg.setApplicationId(value);
       break;
     default:
       reader.skipField();
@@ -2726,9 +7558,9 @@ proto.grpc.service.webservice.RegisterPipedResponse.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.RegisterPipedResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.EnableApplicationRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.RegisterPipedResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.EnableApplicationRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2736,62 +7568,138 @@ proto.grpc.service.webservice.RegisterPipedResponse.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.RegisterPipedResponse} message
+ * @param {!proto.grpc.service.webservice.EnableApplicationRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.RegisterPipedResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.EnableApplicationRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getApplicationId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getKey();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string id = 1;
+ * optional string application_id = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.RegisterPipedResponse.prototype.getId = function() {
+proto.grpc.service.webservice.EnableApplicationRequest.prototype.getApplicationId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.RegisterPipedResponse} returns this
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.EnableApplicationRequest} returns this
+ */
+proto.grpc.service.webservice.EnableApplicationRequest.prototype.setApplicationId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.EnableApplicationResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.EnableApplicationResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.EnableApplicationResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.EnableApplicationResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.EnableApplicationResponse}
+ */
+proto.grpc.service.webservice.EnableApplicationResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.EnableApplicationResponse;
+  return proto.grpc.service.webservice.EnableApplicationResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.EnableApplicationResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.EnableApplicationResponse}
  */
-proto.grpc.service.webservice.RegisterPipedResponse.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.grpc.service.webservice.EnableApplicationResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional string key = 2;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.RegisterPipedResponse.prototype.getKey = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.grpc.service.webservice.EnableApplicationResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.EnableApplicationResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.RegisterPipedResponse} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.EnableApplicationResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.RegisterPipedResponse.prototype.setKey = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.grpc.service.webservice.EnableApplicationResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
 };
 
 
@@ -2811,8 +7719,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.UpdatePipedRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.UpdatePipedRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.DisableApplicationRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.DisableApplicationRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2821,15 +7729,13 @@ proto.grpc.service.webservice.UpdatePipedRequest.prototype.toObject = function(o
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.UpdatePipedRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.DisableApplicationRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdatePipedRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.DisableApplicationRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    pipedId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    desc: jspb.Message.getFieldWithDefault(msg, 3, "")
+    applicationId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -2843,23 +7749,23 @@ proto.grpc.service.webservice.UpdatePipedRequest.toObject = function(includeInst
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.UpdatePipedRequest}
+ * @return {!proto.grpc.service.webservice.DisableApplicationRequest}
  */
-proto.grpc.service.webservice.UpdatePipedRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.DisableApplicationRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.UpdatePipedRequest;
-  return proto.grpc.service.webservice.UpdatePipedRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.DisableApplicationRequest;
+  return proto.grpc.service.webservice.DisableApplicationRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.UpdatePipedRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.DisableApplicationRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.UpdatePipedRequest}
+ * @return {!proto.grpc.service.webservice.DisableApplicationRequest}
  */
-proto.grpc.service.webservice.UpdatePipedRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.DisableApplicationRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2868,15 +7774,7 @@ proto.grpc.service.webservice.UpdatePipedRequest.deserializeBinaryFromReader = f
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPipedId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDesc(value);
+      msg.setApplicationId(value);
       break;
     default:
       reader.skipField();
@@ -2891,9 +7789,9 @@ proto.grpc.service.webservice.UpdatePipedRequest.deserializeBinaryFromReader = f
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.UpdatePipedRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.DisableApplicationRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.UpdatePipedRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.DisableApplicationRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2901,90 +7799,40 @@ proto.grpc.service.webservice.UpdatePipedRequest.prototype.serializeBinary = fun
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.UpdatePipedRequest} message
+ * @param {!proto.grpc.service.webservice.DisableApplicationRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdatePipedRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.DisableApplicationRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPipedId();
+  f = message.getApplicationId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getDesc();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string piped_id = 1;
+ * optional string application_id = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.UpdatePipedRequest.prototype.getPipedId = function() {
+proto.grpc.service.webservice.DisableApplicationRequest.prototype.getApplicationId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.UpdatePipedRequest} returns this
+ * @return {!proto.grpc.service.webservice.DisableApplicationRequest} returns this
  */
-proto.grpc.service.webservice.UpdatePipedRequest.prototype.setPipedId = function(value) {
+proto.grpc.service.webservice.DisableApplicationRequest.prototype.setApplicationId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * optional string name = 2;
- * @return {string}
- */
-proto.grpc.service.webservice.UpdatePipedRequest.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.UpdatePipedRequest} returns this
- */
-proto.grpc.service.webservice.UpdatePipedRequest.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * optional string desc = 3;
- * @return {string}
- */
-proto.grpc.service.webservice.UpdatePipedRequest.prototype.getDesc = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.UpdatePipedRequest} returns this
- */
-proto.grpc.service.webservice.UpdatePipedRequest.prototype.setDesc = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
-};
-
-
 
 
 
@@ -3001,8 +7849,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.UpdatePipedResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.UpdatePipedResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.DisableApplicationResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.DisableApplicationResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3011,11 +7859,11 @@ proto.grpc.service.webservice.UpdatePipedResponse.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.UpdatePipedResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.DisableApplicationResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdatePipedResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.DisableApplicationResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
 
   };
@@ -3031,23 +7879,23 @@ proto.grpc.service.webservice.UpdatePipedResponse.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.UpdatePipedResponse}
+ * @return {!proto.grpc.service.webservice.DisableApplicationResponse}
  */
-proto.grpc.service.webservice.UpdatePipedResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.DisableApplicationResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.UpdatePipedResponse;
-  return proto.grpc.service.webservice.UpdatePipedResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.DisableApplicationResponse;
+  return proto.grpc.service.webservice.DisableApplicationResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.UpdatePipedResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.DisableApplicationResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.UpdatePipedResponse}
+ * @return {!proto.grpc.service.webservice.DisableApplicationResponse}
  */
-proto.grpc.service.webservice.UpdatePipedResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.DisableApplicationResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3067,9 +7915,9 @@ proto.grpc.service.webservice.UpdatePipedResponse.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.UpdatePipedResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.DisableApplicationResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.UpdatePipedResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.DisableApplicationResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3077,11 +7925,11 @@ proto.grpc.service.webservice.UpdatePipedResponse.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.UpdatePipedResponse} message
+ * @param {!proto.grpc.service.webservice.DisableApplicationResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdatePipedResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.DisableApplicationResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
 };
 
@@ -3102,8 +7950,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.RecreatePipedKeyRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.RecreatePipedKeyRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.DeleteApplicationRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.DeleteApplicationRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3112,13 +7960,13 @@ proto.grpc.service.webservice.RecreatePipedKeyRequest.prototype.toObject = funct
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.RecreatePipedKeyRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.DeleteApplicationRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.RecreatePipedKeyRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.DeleteApplicationRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+    applicationId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -3132,23 +7980,23 @@ proto.grpc.service.webservice.RecreatePipedKeyRequest.toObject = function(includ
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.RecreatePipedKeyRequest}
+ * @return {!proto.grpc.service.webservice.DeleteApplicationRequest}
  */
-proto.grpc.service.webservice.RecreatePipedKeyRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.DeleteApplicationRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.RecreatePipedKeyRequest;
-  return proto.grpc.service.webservice.RecreatePipedKeyRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.DeleteApplicationRequest;
+  return proto.grpc.service.webservice.DeleteApplicationRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.RecreatePipedKeyRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.DeleteApplicationRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.RecreatePipedKeyRequest}
+ * @return {!proto.grpc.service.webservice.DeleteApplicationRequest}
  */
-proto.grpc.service.webservice.RecreatePipedKeyRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.DeleteApplicationRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3157,7 +8005,7 @@ proto.grpc.service.webservice.RecreatePipedKeyRequest.deserializeBinaryFromReade
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.setApplicationId(value);
       break;
     default:
       reader.skipField();
@@ -3172,9 +8020,9 @@ proto.grpc.service.webservice.RecreatePipedKeyRequest.deserializeBinaryFromReade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.RecreatePipedKeyRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.DeleteApplicationRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.RecreatePipedKeyRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.DeleteApplicationRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3182,13 +8030,13 @@ proto.grpc.service.webservice.RecreatePipedKeyRequest.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.RecreatePipedKeyRequest} message
+ * @param {!proto.grpc.service.webservice.DeleteApplicationRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.RecreatePipedKeyRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.DeleteApplicationRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getApplicationId();
   if (f.length > 0) {
     writer.writeString(
       1,
@@ -3199,19 +8047,19 @@ proto.grpc.service.webservice.RecreatePipedKeyRequest.serializeBinaryToWriter =
 
 
 /**
- * optional string id = 1;
+ * optional string application_id = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.RecreatePipedKeyRequest.prototype.getId = function() {
+proto.grpc.service.webservice.DeleteApplicationRequest.prototype.getApplicationId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.RecreatePipedKeyRequest} returns this
+ * @return {!proto.grpc.service.webservice.DeleteApplicationRequest} returns this
  */
-proto.grpc.service.webservice.RecreatePipedKeyRequest.prototype.setId = function(value) {
+proto.grpc.service.webservice.DeleteApplicationRequest.prototype.setApplicationId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -3232,8 +8080,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.RecreatePipedKeyResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.RecreatePipedKeyResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.DeleteApplicationResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.DeleteApplicationResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3242,13 +8090,13 @@ proto.grpc.service.webservice.RecreatePipedKeyResponse.prototype.toObject = func
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.RecreatePipedKeyResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.DeleteApplicationResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.RecreatePipedKeyResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.DeleteApplicationResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    key: jspb.Message.getFieldWithDefault(msg, 1, "")
+
   };
 
   if (includeInstance) {
@@ -3262,33 +8110,29 @@ proto.grpc.service.webservice.RecreatePipedKeyResponse.toObject = function(inclu
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.RecreatePipedKeyResponse}
+ * @return {!proto.grpc.service.webservice.DeleteApplicationResponse}
  */
-proto.grpc.service.webservice.RecreatePipedKeyResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.DeleteApplicationResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.RecreatePipedKeyResponse;
-  return proto.grpc.service.webservice.RecreatePipedKeyResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.DeleteApplicationResponse;
+  return proto.grpc.service.webservice.DeleteApplicationResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.RecreatePipedKeyResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.DeleteApplicationResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.RecreatePipedKeyResponse}
+ * @return {!proto.grpc.service.webservice.DeleteApplicationResponse}
  */
-proto.grpc.service.webservice.RecreatePipedKeyResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.DeleteApplicationResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setKey(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -3302,9 +8146,9 @@ proto.grpc.service.webservice.RecreatePipedKeyResponse.deserializeBinaryFromRead
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.RecreatePipedKeyResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.DeleteApplicationResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.RecreatePipedKeyResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.DeleteApplicationResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3312,37 +8156,12 @@ proto.grpc.service.webservice.RecreatePipedKeyResponse.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.RecreatePipedKeyResponse} message
+ * @param {!proto.grpc.service.webservice.DeleteApplicationResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.RecreatePipedKeyResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.DeleteApplicationResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getKey();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string key = 1;
- * @return {string}
- */
-proto.grpc.service.webservice.RecreatePipedKeyResponse.prototype.getKey = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.RecreatePipedKeyResponse} returns this
- */
-proto.grpc.service.webservice.RecreatePipedKeyResponse.prototype.setKey = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -3362,8 +8181,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.DeleteOldPipedKeysRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.DeleteOldPipedKeysRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListApplicationsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListApplicationsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3372,13 +8191,13 @@ proto.grpc.service.webservice.DeleteOldPipedKeysRequest.prototype.toObject = fun
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.DeleteOldPipedKeysRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ListApplicationsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DeleteOldPipedKeysRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ListApplicationsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    pipedId: jspb.Message.getFieldWithDefault(msg, 1, "")
+    options: (f = msg.getOptions()) && proto.grpc.service.webservice.ListApplicationsRequest.Options.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3392,23 +8211,23 @@ proto.grpc.service.webservice.DeleteOldPipedKeysRequest.toObject = function(incl
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.DeleteOldPipedKeysRequest}
+ * @return {!proto.grpc.service.webservice.ListApplicationsRequest}
  */
-proto.grpc.service.webservice.DeleteOldPipedKeysRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ListApplicationsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.DeleteOldPipedKeysRequest;
-  return proto.grpc.service.webservice.DeleteOldPipedKeysRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ListApplicationsRequest;
+  return proto.grpc.service.webservice.ListApplicationsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.DeleteOldPipedKeysRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ListApplicationsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.DeleteOldPipedKeysRequest}
+ * @return {!proto.grpc.service.webservice.ListApplicationsRequest}
  */
-proto.grpc.service.webservice.DeleteOldPipedKeysRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ListApplicationsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3416,8 +8235,9 @@ proto.grpc.service.webservice.DeleteOldPipedKeysRequest.deserializeBinaryFromRea
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPipedId(value);
+      var value = new proto.grpc.service.webservice.ListApplicationsRequest.Options;
+      reader.readMessage(value,proto.grpc.service.webservice.ListApplicationsRequest.Options.deserializeBinaryFromReader);
+      msg.setOptions(value);
       break;
     default:
       reader.skipField();
@@ -3432,9 +8252,9 @@ proto.grpc.service.webservice.DeleteOldPipedKeysRequest.deserializeBinaryFromRea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.DeleteOldPipedKeysRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ListApplicationsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.DeleteOldPipedKeysRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ListApplicationsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3442,40 +8262,30 @@ proto.grpc.service.webservice.DeleteOldPipedKeysRequest.prototype.serializeBinar
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.DeleteOldPipedKeysRequest} message
+ * @param {!proto.grpc.service.webservice.ListApplicationsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DeleteOldPipedKeysRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ListApplicationsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPipedId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getOptions();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.grpc.service.webservice.ListApplicationsRequest.Options.serializeBinaryToWriter
     );
   }
 };
 
 
-/**
- * optional string piped_id = 1;
- * @return {string}
- */
-proto.grpc.service.webservice.DeleteOldPipedKeysRequest.prototype.getPipedId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
 
 /**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.DeleteOldPipedKeysRequest} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.grpc.service.webservice.DeleteOldPipedKeysRequest.prototype.setPipedId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
+proto.grpc.service.webservice.ListApplicationsRequest.Options.repeatedFields_ = [2,3];
 
 
 
@@ -3492,8 +8302,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.DeleteOldPipedKeysResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.DeleteOldPipedKeysResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListApplicationsRequest.Options.toObject(opt_includeInstance, this);
 };
 
 
@@ -3502,13 +8312,18 @@ proto.grpc.service.webservice.DeleteOldPipedKeysResponse.prototype.toObject = fu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.DeleteOldPipedKeysResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ListApplicationsRequest.Options} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DeleteOldPipedKeysResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ListApplicationsRequest.Options.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    enabled: (f = msg.getEnabled()) && google_protobuf_wrappers_pb.BoolValue.toObject(includeInstance, f),
+    kindsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    syncStatusesList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    name: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : [],
+    pipedId: jspb.Message.getFieldWithDefault(msg, 7, "")
   };
 
   if (includeInstance) {
@@ -3522,29 +8337,60 @@ proto.grpc.service.webservice.DeleteOldPipedKeysResponse.toObject = function(inc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.DeleteOldPipedKeysResponse}
+ * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options}
  */
-proto.grpc.service.webservice.DeleteOldPipedKeysResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ListApplicationsRequest.Options.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.DeleteOldPipedKeysResponse;
-  return proto.grpc.service.webservice.DeleteOldPipedKeysResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ListApplicationsRequest.Options;
+  return proto.grpc.service.webservice.ListApplicationsRequest.Options.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.DeleteOldPipedKeysResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ListApplicationsRequest.Options} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.DeleteOldPipedKeysResponse}
+ * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options}
  */
-proto.grpc.service.webservice.DeleteOldPipedKeysResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ListApplicationsRequest.Options.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = new google_protobuf_wrappers_pb.BoolValue;
+      reader.readMessage(value,google_protobuf_wrappers_pb.BoolValue.deserializeBinaryFromReader);
+      msg.setEnabled(value);
+      break;
+    case 2:
+      var values = /** @type {!Array<!proto.model.ApplicationKind>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addKinds(values[i]);
+      }
+      break;
+    case 3:
+      var values = /** @type {!Array<!proto.model.ApplicationSyncStatus>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addSyncStatuses(values[i]);
+      }
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 6:
+      var value = msg.getLabelsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPipedId(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -3558,257 +8404,280 @@ proto.grpc.service.webservice.DeleteOldPipedKeysResponse.deserializeBinaryFromRe
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.DeleteOldPipedKeysResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.DeleteOldPipedKeysResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ListApplicationsRequest.Options.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.DeleteOldPipedKeysResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.ListApplicationsRequest.Options} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.ListApplicationsRequest.Options.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnabled();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      google_protobuf_wrappers_pb.BoolValue.serializeBinaryToWriter
+    );
+  }
+  f = message.getKindsList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      2,
+      f
+    );
+  }
+  f = message.getSyncStatusesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      3,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getLabelsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(6, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getPipedId();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional google.protobuf.BoolValue enabled = 1;
+ * @return {?proto.google.protobuf.BoolValue}
+ */
+proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.getEnabled = function() {
+  return /** @type{?proto.google.protobuf.BoolValue} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_wrappers_pb.BoolValue, 1));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.BoolValue|undefined} value
+ * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options} returns this
+*/
+proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.setEnabled = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options} returns this
+ */
+proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.clearEnabled = function() {
+  return this.setEnabled(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.hasEnabled = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * repeated model.ApplicationKind kinds = 2;
+ * @return {!Array<!proto.model.ApplicationKind>}
+ */
+proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.getKindsList = function() {
+  return /** @type {!Array<!proto.model.ApplicationKind>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.model.ApplicationKind>} value
+ * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options} returns this
  */
-proto.grpc.service.webservice.DeleteOldPipedKeysResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
+proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.setKindsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {!proto.model.ApplicationKind} value
+ * @param {number=} opt_index
+ * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options} returns this
  */
-proto.grpc.service.webservice.EnablePipedRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.EnablePipedRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.addKinds = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.EnablePipedRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options} returns this
  */
-proto.grpc.service.webservice.EnablePipedRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    pipedId: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.clearKindsList = function() {
+  return this.setKindsList([]);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.EnablePipedRequest}
+ * repeated model.ApplicationSyncStatus sync_statuses = 3;
+ * @return {!Array<!proto.model.ApplicationSyncStatus>}
  */
-proto.grpc.service.webservice.EnablePipedRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.EnablePipedRequest;
-  return proto.grpc.service.webservice.EnablePipedRequest.deserializeBinaryFromReader(msg, reader);
+proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.getSyncStatusesList = function() {
+  return /** @type {!Array<!proto.model.ApplicationSyncStatus>} */ (jspb.Message.getRepeatedField(this, 3));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.EnablePipedRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.EnablePipedRequest}
+ * @param {!Array<!proto.model.ApplicationSyncStatus>} value
+ * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options} returns this
  */
-proto.grpc.service.webservice.EnablePipedRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPipedId(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.setSyncStatusesList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {!proto.model.ApplicationSyncStatus} value
+ * @param {number=} opt_index
+ * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options} returns this
  */
-proto.grpc.service.webservice.EnablePipedRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.EnablePipedRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.addSyncStatuses = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.EnablePipedRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options} returns this
  */
-proto.grpc.service.webservice.EnablePipedRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getPipedId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.clearSyncStatusesList = function() {
+  return this.setSyncStatusesList([]);
 };
 
 
 /**
- * optional string piped_id = 1;
+ * optional string name = 5;
  * @return {string}
  */
-proto.grpc.service.webservice.EnablePipedRequest.prototype.getPipedId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.EnablePipedRequest} returns this
+ * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options} returns this
  */
-proto.grpc.service.webservice.EnablePipedRequest.prototype.setPipedId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
+/**
+ * map<string, string> labels = 6;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.getLabelsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 6, opt_noLazyCreate,
+      null));
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options} returns this
  */
-proto.grpc.service.webservice.EnablePipedResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.EnablePipedResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.clearLabelsMap = function() {
+  this.getLabelsMap().clear();
+  return this;
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.EnablePipedResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional string piped_id = 7;
+ * @return {string}
  */
-proto.grpc.service.webservice.EnablePipedResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
+proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.getPipedId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
 
-  };
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options} returns this
+ */
+proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.setPipedId = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.EnablePipedResponse}
+ * optional Options options = 1;
+ * @return {?proto.grpc.service.webservice.ListApplicationsRequest.Options}
  */
-proto.grpc.service.webservice.EnablePipedResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.EnablePipedResponse;
-  return proto.grpc.service.webservice.EnablePipedResponse.deserializeBinaryFromReader(msg, reader);
+proto.grpc.service.webservice.ListApplicationsRequest.prototype.getOptions = function() {
+  return /** @type{?proto.grpc.service.webservice.ListApplicationsRequest.Options} */ (
+    jspb.Message.getWrapperField(this, proto.grpc.service.webservice.ListApplicationsRequest.Options, 1));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.EnablePipedResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.EnablePipedResponse}
- */
-proto.grpc.service.webservice.EnablePipedResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+ * @param {?proto.grpc.service.webservice.ListApplicationsRequest.Options|undefined} value
+ * @return {!proto.grpc.service.webservice.ListApplicationsRequest} returns this
+*/
+proto.grpc.service.webservice.ListApplicationsRequest.prototype.setOptions = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Clears the message field making it undefined.
+ * @return {!proto.grpc.service.webservice.ListApplicationsRequest} returns this
  */
-proto.grpc.service.webservice.EnablePipedResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.EnablePipedResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.grpc.service.webservice.ListApplicationsRequest.prototype.clearOptions = function() {
+  return this.setOptions(undefined);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.EnablePipedResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.grpc.service.webservice.EnablePipedResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
+proto.grpc.service.webservice.ListApplicationsRequest.prototype.hasOptions = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.grpc.service.webservice.ListApplicationsResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -3824,8 +8693,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.DisablePipedRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.DisablePipedRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListApplicationsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListApplicationsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3834,13 +8703,14 @@ proto.grpc.service.webservice.DisablePipedRequest.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.DisablePipedRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ListApplicationsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DisablePipedRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ListApplicationsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    pipedId: jspb.Message.getFieldWithDefault(msg, 1, "")
+    applicationsList: jspb.Message.toObjectList(msg.getApplicationsList(),
+    pkg_model_application_pb.Application.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3854,23 +8724,23 @@ proto.grpc.service.webservice.DisablePipedRequest.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.DisablePipedRequest}
+ * @return {!proto.grpc.service.webservice.ListApplicationsResponse}
  */
-proto.grpc.service.webservice.DisablePipedRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ListApplicationsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.DisablePipedRequest;
-  return proto.grpc.service.webservice.DisablePipedRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ListApplicationsResponse;
+  return proto.grpc.service.webservice.ListApplicationsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.DisablePipedRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ListApplicationsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.DisablePipedRequest}
+ * @return {!proto.grpc.service.webservice.ListApplicationsResponse}
  */
-proto.grpc.service.webservice.DisablePipedRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ListApplicationsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3878,8 +8748,9 @@ proto.grpc.service.webservice.DisablePipedRequest.deserializeBinaryFromReader =
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPipedId(value);
+      var value = new pkg_model_application_pb.Application;
+      reader.readMessage(value,pkg_model_application_pb.Application.deserializeBinaryFromReader);
+      msg.addApplications(value);
       break;
     default:
       reader.skipField();
@@ -3894,9 +8765,9 @@ proto.grpc.service.webservice.DisablePipedRequest.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.DisablePipedRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ListApplicationsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.DisablePipedRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ListApplicationsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3904,37 +8775,58 @@ proto.grpc.service.webservice.DisablePipedRequest.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.DisablePipedRequest} message
+ * @param {!proto.grpc.service.webservice.ListApplicationsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DisablePipedRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ListApplicationsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPipedId();
+  f = message.getApplicationsList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       1,
-      f
+      f,
+      pkg_model_application_pb.Application.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string piped_id = 1;
- * @return {string}
+ * repeated model.Application applications = 1;
+ * @return {!Array<!proto.model.Application>}
  */
-proto.grpc.service.webservice.DisablePipedRequest.prototype.getPipedId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.grpc.service.webservice.ListApplicationsResponse.prototype.getApplicationsList = function() {
+  return /** @type{!Array<!proto.model.Application>} */ (
+    jspb.Message.getRepeatedWrapperField(this, pkg_model_application_pb.Application, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.DisablePipedRequest} returns this
+ * @param {!Array<!proto.model.Application>} value
+ * @return {!proto.grpc.service.webservice.ListApplicationsResponse} returns this
+*/
+proto.grpc.service.webservice.ListApplicationsResponse.prototype.setApplicationsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.model.Application=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.Application}
  */
-proto.grpc.service.webservice.DisablePipedRequest.prototype.setPipedId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.grpc.service.webservice.ListApplicationsResponse.prototype.addApplications = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.Application, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.ListApplicationsResponse} returns this
+ */
+proto.grpc.service.webservice.ListApplicationsResponse.prototype.clearApplicationsList = function() {
+  return this.setApplicationsList([]);
 };
 
 
@@ -3954,8 +8846,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.DisablePipedResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.DisablePipedResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.SyncApplicationRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.SyncApplicationRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3964,13 +8856,14 @@ proto.grpc.service.webservice.DisablePipedResponse.prototype.toObject = function
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.DisablePipedResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.SyncApplicationRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DisablePipedResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.SyncApplicationRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    applicationId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    syncStrategy: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -3984,29 +8877,37 @@ proto.grpc.service.webservice.DisablePipedResponse.toObject = function(includeIn
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.DisablePipedResponse}
+ * @return {!proto.grpc.service.webservice.SyncApplicationRequest}
  */
-proto.grpc.service.webservice.DisablePipedResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.SyncApplicationRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.DisablePipedResponse;
-  return proto.grpc.service.webservice.DisablePipedResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.SyncApplicationRequest;
+  return proto.grpc.service.webservice.SyncApplicationRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.DisablePipedResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.SyncApplicationRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.DisablePipedResponse}
+ * @return {!proto.grpc.service.webservice.SyncApplicationRequest}
  */
-proto.grpc.service.webservice.DisablePipedResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.SyncApplicationRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApplicationId(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.model.SyncStrategy} */ (reader.readEnum());
+      msg.setSyncStrategy(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -4020,9 +8921,9 @@ proto.grpc.service.webservice.DisablePipedResponse.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.DisablePipedResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.SyncApplicationRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.DisablePipedResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.SyncApplicationRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4030,12 +8931,62 @@ proto.grpc.service.webservice.DisablePipedResponse.prototype.serializeBinary = f
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.DisablePipedResponse} message
+ * @param {!proto.grpc.service.webservice.SyncApplicationRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DisablePipedResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.SyncApplicationRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getApplicationId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSyncStrategy();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string application_id = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.SyncApplicationRequest.prototype.getApplicationId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.SyncApplicationRequest} returns this
+ */
+proto.grpc.service.webservice.SyncApplicationRequest.prototype.setApplicationId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional model.SyncStrategy sync_strategy = 2;
+ * @return {!proto.model.SyncStrategy}
+ */
+proto.grpc.service.webservice.SyncApplicationRequest.prototype.getSyncStrategy = function() {
+  return /** @type {!proto.model.SyncStrategy} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.model.SyncStrategy} value
+ * @return {!proto.grpc.service.webservice.SyncApplicationRequest} returns this
+ */
+proto.grpc.service.webservice.SyncApplicationRequest.prototype.setSyncStrategy = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
 };
 
 
@@ -4055,8 +9006,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ListPipedsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListPipedsRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.SyncApplicationResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.SyncApplicationResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -4065,14 +9016,13 @@ proto.grpc.service.webservice.ListPipedsRequest.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListPipedsRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.SyncApplicationResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListPipedsRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.SyncApplicationResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    withStatus: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
-    options: (f = msg.getOptions()) && proto.grpc.service.webservice.ListPipedsRequest.Options.toObject(includeInstance, f)
+    commandId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -4086,23 +9036,23 @@ proto.grpc.service.webservice.ListPipedsRequest.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListPipedsRequest}
+ * @return {!proto.grpc.service.webservice.SyncApplicationResponse}
  */
-proto.grpc.service.webservice.ListPipedsRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.SyncApplicationResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListPipedsRequest;
-  return proto.grpc.service.webservice.ListPipedsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.SyncApplicationResponse;
+  return proto.grpc.service.webservice.SyncApplicationResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListPipedsRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.SyncApplicationResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListPipedsRequest}
+ * @return {!proto.grpc.service.webservice.SyncApplicationResponse}
  */
-proto.grpc.service.webservice.ListPipedsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.SyncApplicationResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4110,13 +9060,8 @@ proto.grpc.service.webservice.ListPipedsRequest.deserializeBinaryFromReader = fu
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setWithStatus(value);
-      break;
-    case 2:
-      var value = new proto.grpc.service.webservice.ListPipedsRequest.Options;
-      reader.readMessage(value,proto.grpc.service.webservice.ListPipedsRequest.Options.deserializeBinaryFromReader);
-      msg.setOptions(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommandId(value);
       break;
     default:
       reader.skipField();
@@ -4131,9 +9076,9 @@ proto.grpc.service.webservice.ListPipedsRequest.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListPipedsRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.SyncApplicationResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListPipedsRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.SyncApplicationResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4141,27 +9086,37 @@ proto.grpc.service.webservice.ListPipedsRequest.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListPipedsRequest} message
+ * @param {!proto.grpc.service.webservice.SyncApplicationResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListPipedsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.SyncApplicationResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getWithStatus();
-  if (f) {
-    writer.writeBool(
+  f = message.getCommandId();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getOptions();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      proto.grpc.service.webservice.ListPipedsRequest.Options.serializeBinaryToWriter
-    );
-  }
+};
+
+
+/**
+ * optional string command_id = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.SyncApplicationResponse.prototype.getCommandId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.SyncApplicationResponse} returns this
+ */
+proto.grpc.service.webservice.SyncApplicationResponse.prototype.setCommandId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -4181,8 +9136,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ListPipedsRequest.Options.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListPipedsRequest.Options.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4191,13 +9146,15 @@ proto.grpc.service.webservice.ListPipedsRequest.Options.prototype.toObject = fun
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListPipedsRequest.Options} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListPipedsRequest.Options.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enabled: (f = msg.getEnabled()) && google_protobuf_wrappers_pb.BoolValue.toObject(includeInstance, f)
+    applicationId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    syncStrategy: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    justification: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -4211,23 +9168,23 @@ proto.grpc.service.webservice.ListPipedsRequest.Options.toObject = function(incl
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListPipedsRequest.Options}
+ * @return {!proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest}
  */
-proto.grpc.service.webservice.ListPipedsRequest.Options.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListPipedsRequest.Options;
-  return proto.grpc.service.webservice.ListPipedsRequest.Options.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest;
+  return proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListPipedsRequest.Options} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListPipedsRequest.Options}
+ * @return {!proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest}
  */
-proto.grpc.service.webservice.ListPipedsRequest.Options.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4235,9 +9192,16 @@ proto.grpc.service.webservice.ListPipedsRequest.Options.deserializeBinaryFromRea
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new google_protobuf_wrappers_pb.BoolValue;
-      reader.readMessage(value,google_protobuf_wrappers_pb.BoolValue.deserializeBinaryFromReader);
-      msg.setEnabled(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApplicationId(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.model.SyncStrategy} */ (reader.readEnum());
+      msg.setSyncStrategy(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setJustification(value);
       break;
     default:
       reader.skipField();
@@ -4252,9 +9216,9 @@ proto.grpc.service.webservice.ListPipedsRequest.Options.deserializeBinaryFromRea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListPipedsRequest.Options.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListPipedsRequest.Options.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4262,123 +9226,91 @@ proto.grpc.service.webservice.ListPipedsRequest.Options.prototype.serializeBinar
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListPipedsRequest.Options} message
+ * @param {!proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListPipedsRequest.Options.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnabled();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getApplicationId();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      google_protobuf_wrappers_pb.BoolValue.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getSyncStrategy();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getJustification();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
     );
   }
 };
 
 
 /**
- * optional google.protobuf.BoolValue enabled = 1;
- * @return {?proto.google.protobuf.BoolValue}
- */
-proto.grpc.service.webservice.ListPipedsRequest.Options.prototype.getEnabled = function() {
-  return /** @type{?proto.google.protobuf.BoolValue} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_wrappers_pb.BoolValue, 1));
-};
-
-
-/**
- * @param {?proto.google.protobuf.BoolValue|undefined} value
- * @return {!proto.grpc.service.webservice.ListPipedsRequest.Options} returns this
-*/
-proto.grpc.service.webservice.ListPipedsRequest.Options.prototype.setEnabled = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.grpc.service.webservice.ListPipedsRequest.Options} returns this
- */
-proto.grpc.service.webservice.ListPipedsRequest.Options.prototype.clearEnabled = function() {
-  return this.setEnabled(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional string application_id = 1;
+ * @return {string}
  */
-proto.grpc.service.webservice.ListPipedsRequest.Options.prototype.hasEnabled = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest.prototype.getApplicationId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * optional bool with_status = 1;
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest} returns this
  */
-proto.grpc.service.webservice.ListPipedsRequest.prototype.getWithStatus = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest.prototype.setApplicationId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.grpc.service.webservice.ListPipedsRequest} returns this
+ * optional model.SyncStrategy sync_strategy = 2;
+ * @return {!proto.model.SyncStrategy}
  */
-proto.grpc.service.webservice.ListPipedsRequest.prototype.setWithStatus = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest.prototype.getSyncStrategy = function() {
+  return /** @type {!proto.model.SyncStrategy} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * optional Options options = 2;
- * @return {?proto.grpc.service.webservice.ListPipedsRequest.Options}
+ * @param {!proto.model.SyncStrategy} value
+ * @return {!proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest} returns this
  */
-proto.grpc.service.webservice.ListPipedsRequest.prototype.getOptions = function() {
-  return /** @type{?proto.grpc.service.webservice.ListPipedsRequest.Options} */ (
-    jspb.Message.getWrapperField(this, proto.grpc.service.webservice.ListPipedsRequest.Options, 2));
-};
-
-
-/**
- * @param {?proto.grpc.service.webservice.ListPipedsRequest.Options|undefined} value
- * @return {!proto.grpc.service.webservice.ListPipedsRequest} returns this
-*/
-proto.grpc.service.webservice.ListPipedsRequest.prototype.setOptions = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest.prototype.setSyncStrategy = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.grpc.service.webservice.ListPipedsRequest} returns this
+ * optional string justification = 3;
+ * @return {string}
  */
-proto.grpc.service.webservice.ListPipedsRequest.prototype.clearOptions = function() {
-  return this.setOptions(undefined);
+proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest.prototype.getJustification = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest} returns this
  */
-proto.grpc.service.webservice.ListPipedsRequest.prototype.hasOptions = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.grpc.service.webservice.SyncApplicationBypassingFreezeRequest.prototype.setJustification = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.grpc.service.webservice.ListPipedsResponse.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -4394,8 +9326,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ListPipedsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListPipedsResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -4404,14 +9336,13 @@ proto.grpc.service.webservice.ListPipedsResponse.prototype.toObject = function(o
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListPipedsResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListPipedsResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    pipedsList: jspb.Message.toObjectList(msg.getPipedsList(),
-    pkg_model_piped_pb.Piped.toObject, includeInstance)
+    commandId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -4425,23 +9356,23 @@ proto.grpc.service.webservice.ListPipedsResponse.toObject = function(includeInst
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListPipedsResponse}
+ * @return {!proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse}
  */
-proto.grpc.service.webservice.ListPipedsResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListPipedsResponse;
-  return proto.grpc.service.webservice.ListPipedsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse;
+  return proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListPipedsResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListPipedsResponse}
+ * @return {!proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse}
  */
-proto.grpc.service.webservice.ListPipedsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4449,9 +9380,8 @@ proto.grpc.service.webservice.ListPipedsResponse.deserializeBinaryFromReader = f
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new pkg_model_piped_pb.Piped;
-      reader.readMessage(value,pkg_model_piped_pb.Piped.deserializeBinaryFromReader);
-      msg.addPipeds(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommandId(value);
       break;
     default:
       reader.skipField();
@@ -4466,9 +9396,9 @@ proto.grpc.service.webservice.ListPipedsResponse.deserializeBinaryFromReader = f
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListPipedsResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListPipedsResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4476,58 +9406,37 @@ proto.grpc.service.webservice.ListPipedsResponse.prototype.serializeBinary = fun
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListPipedsResponse} message
+ * @param {!proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListPipedsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPipedsList();
+  f = message.getCommandId();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      pkg_model_piped_pb.Piped.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * repeated model.Piped pipeds = 1;
- * @return {!Array<!proto.model.Piped>}
- */
-proto.grpc.service.webservice.ListPipedsResponse.prototype.getPipedsList = function() {
-  return /** @type{!Array<!proto.model.Piped>} */ (
-    jspb.Message.getRepeatedWrapperField(this, pkg_model_piped_pb.Piped, 1));
-};
-
-
-/**
- * @param {!Array<!proto.model.Piped>} value
- * @return {!proto.grpc.service.webservice.ListPipedsResponse} returns this
-*/
-proto.grpc.service.webservice.ListPipedsResponse.prototype.setPipedsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
-};
-
-
-/**
- * @param {!proto.model.Piped=} opt_value
- * @param {number=} opt_index
- * @return {!proto.model.Piped}
+ * optional string command_id = 1;
+ * @return {string}
  */
-proto.grpc.service.webservice.ListPipedsResponse.prototype.addPipeds = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.Piped, opt_index);
+proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse.prototype.getCommandId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.grpc.service.webservice.ListPipedsResponse} returns this
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse} returns this
  */
-proto.grpc.service.webservice.ListPipedsResponse.prototype.clearPipedsList = function() {
-  return this.setPipedsList([]);
+proto.grpc.service.webservice.SyncApplicationBypassingFreezeResponse.prototype.setCommandId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -4547,8 +9456,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.GetPipedRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GetPipedRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.GetApplicationRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GetApplicationRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4557,13 +9466,13 @@ proto.grpc.service.webservice.GetPipedRequest.prototype.toObject = function(opt_
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GetPipedRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.GetApplicationRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetPipedRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.GetApplicationRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    pipedId: jspb.Message.getFieldWithDefault(msg, 1, "")
+    applicationId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -4577,23 +9486,23 @@ proto.grpc.service.webservice.GetPipedRequest.toObject = function(includeInstanc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GetPipedRequest}
+ * @return {!proto.grpc.service.webservice.GetApplicationRequest}
  */
-proto.grpc.service.webservice.GetPipedRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.GetApplicationRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GetPipedRequest;
-  return proto.grpc.service.webservice.GetPipedRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.GetApplicationRequest;
+  return proto.grpc.service.webservice.GetApplicationRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GetPipedRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.GetApplicationRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GetPipedRequest}
+ * @return {!proto.grpc.service.webservice.GetApplicationRequest}
  */
-proto.grpc.service.webservice.GetPipedRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.GetApplicationRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4602,7 +9511,7 @@ proto.grpc.service.webservice.GetPipedRequest.deserializeBinaryFromReader = func
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPipedId(value);
+      msg.setApplicationId(value);
       break;
     default:
       reader.skipField();
@@ -4617,9 +9526,9 @@ proto.grpc.service.webservice.GetPipedRequest.deserializeBinaryFromReader = func
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GetPipedRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.GetApplicationRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GetPipedRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.GetApplicationRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4627,13 +9536,13 @@ proto.grpc.service.webservice.GetPipedRequest.prototype.serializeBinary = functi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GetPipedRequest} message
+ * @param {!proto.grpc.service.webservice.GetApplicationRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetPipedRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.GetApplicationRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPipedId();
+  f = message.getApplicationId();
   if (f.length > 0) {
     writer.writeString(
       1,
@@ -4644,19 +9553,19 @@ proto.grpc.service.webservice.GetPipedRequest.serializeBinaryToWriter = function
 
 
 /**
- * optional string piped_id = 1;
+ * optional string application_id = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.GetPipedRequest.prototype.getPipedId = function() {
+proto.grpc.service.webservice.GetApplicationRequest.prototype.getApplicationId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.GetPipedRequest} returns this
+ * @return {!proto.grpc.service.webservice.GetApplicationRequest} returns this
  */
-proto.grpc.service.webservice.GetPipedRequest.prototype.setPipedId = function(value) {
+proto.grpc.service.webservice.GetApplicationRequest.prototype.setApplicationId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -4677,8 +9586,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.GetPipedResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GetPipedResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.GetApplicationResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GetApplicationResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -4687,13 +9596,13 @@ proto.grpc.service.webservice.GetPipedResponse.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GetPipedResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.GetApplicationResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetPipedResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.GetApplicationResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    piped: (f = msg.getPiped()) && pkg_model_piped_pb.Piped.toObject(includeInstance, f)
+    application: (f = msg.getApplication()) && pkg_model_application_pb.Application.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -4707,33 +9616,33 @@ proto.grpc.service.webservice.GetPipedResponse.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GetPipedResponse}
+ * @return {!proto.grpc.service.webservice.GetApplicationResponse}
  */
-proto.grpc.service.webservice.GetPipedResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.GetApplicationResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GetPipedResponse;
-  return proto.grpc.service.webservice.GetPipedResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.GetApplicationResponse;
+  return proto.grpc.service.webservice.GetApplicationResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GetPipedResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.GetApplicationResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GetPipedResponse}
+ * @return {!proto.grpc.service.webservice.GetApplicationResponse}
  */
-proto.grpc.service.webservice.GetPipedResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.GetApplicationResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = new pkg_model_piped_pb.Piped;
-      reader.readMessage(value,pkg_model_piped_pb.Piped.deserializeBinaryFromReader);
-      msg.setPiped(value);
+    case 1:
+      var value = new pkg_model_application_pb.Application;
+      reader.readMessage(value,pkg_model_application_pb.Application.deserializeBinaryFromReader);
+      msg.setApplication(value);
       break;
     default:
       reader.skipField();
@@ -4748,9 +9657,9 @@ proto.grpc.service.webservice.GetPipedResponse.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GetPipedResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.GetApplicationResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GetPipedResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.GetApplicationResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4758,48 +9667,48 @@ proto.grpc.service.webservice.GetPipedResponse.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GetPipedResponse} message
+ * @param {!proto.grpc.service.webservice.GetApplicationResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetPipedResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.GetApplicationResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPiped();
+  f = message.getApplication();
   if (f != null) {
     writer.writeMessage(
       1,
       f,
-      pkg_model_piped_pb.Piped.serializeBinaryToWriter
+      pkg_model_application_pb.Application.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional model.Piped piped = 1;
- * @return {?proto.model.Piped}
+ * optional model.Application application = 1;
+ * @return {?proto.model.Application}
  */
-proto.grpc.service.webservice.GetPipedResponse.prototype.getPiped = function() {
-  return /** @type{?proto.model.Piped} */ (
-    jspb.Message.getWrapperField(this, pkg_model_piped_pb.Piped, 1));
+proto.grpc.service.webservice.GetApplicationResponse.prototype.getApplication = function() {
+  return /** @type{?proto.model.Application} */ (
+    jspb.Message.getWrapperField(this, pkg_model_application_pb.Application, 1));
 };
 
 
 /**
- * @param {?proto.model.Piped|undefined} value
- * @return {!proto.grpc.service.webservice.GetPipedResponse} returns this
+ * @param {?proto.model.Application|undefined} value
+ * @return {!proto.grpc.service.webservice.GetApplicationResponse} returns this
 */
-proto.grpc.service.webservice.GetPipedResponse.prototype.setPiped = function(value) {
+proto.grpc.service.webservice.GetApplicationResponse.prototype.setApplication = function(value) {
   return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.grpc.service.webservice.GetPipedResponse} returns this
+ * @return {!proto.grpc.service.webservice.GetApplicationResponse} returns this
  */
-proto.grpc.service.webservice.GetPipedResponse.prototype.clearPiped = function() {
-  return this.setPiped(undefined);
+proto.grpc.service.webservice.GetApplicationResponse.prototype.clearApplication = function() {
+  return this.setApplication(undefined);
 };
 
 
@@ -4807,19 +9716,12 @@ proto.grpc.service.webservice.GetPipedResponse.prototype.clearPiped = function()
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.grpc.service.webservice.GetPipedResponse.prototype.hasPiped = function() {
+proto.grpc.service.webservice.GetApplicationResponse.prototype.hasApplication = function() {
   return jspb.Message.getField(this, 1) != null;
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.repeatedFields_ = [2];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -4835,8 +9737,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4845,14 +9747,15 @@ proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.prototype.toObjec
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    version: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    pipedIdsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+    pipedId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    data: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    base64Encoding: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -4866,23 +9769,23 @@ proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.toObject = functi
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest}
+ * @return {!proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest}
  */
-proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest;
-  return proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest;
+  return proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest}
+ * @return {!proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest}
  */
-proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4891,11 +9794,15 @@ proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.deserializeBinary
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setVersion(value);
+      msg.setPipedId(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.addPipedIds(value);
+      msg.setData(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setBase64Encoding(value);
       break;
     default:
       reader.skipField();
@@ -4910,9 +9817,9 @@ proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.deserializeBinary
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4920,81 +9827,87 @@ proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.prototype.seriali
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest} message
+ * @param {!proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getVersion();
+  f = message.getPipedId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getPipedIdsList();
+  f = message.getData();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeString(
       2,
       f
     );
   }
+  f = message.getBase64Encoding();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string version = 1;
+ * optional string piped_id = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.prototype.getVersion = function() {
+proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.prototype.getPipedId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest} returns this
+ * @return {!proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest} returns this
  */
-proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.prototype.setVersion = function(value) {
+proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.prototype.setPipedId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * repeated string piped_ids = 2;
- * @return {!Array<string>}
+ * optional string data = 2;
+ * @return {string}
  */
-proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.prototype.getPipedIdsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.prototype.getData = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest} returns this
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest} returns this
  */
-proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.prototype.setPipedIdsList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
+proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.prototype.setData = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest} returns this
+ * optional bool base64_encoding = 3;
+ * @return {boolean}
  */
-proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.prototype.addPipedIds = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.prototype.getBase64Encoding = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest} returns this
+ * @param {boolean} value
+ * @return {!proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest} returns this
  */
-proto.grpc.service.webservice.UpdatePipedDesiredVersionRequest.prototype.clearPipedIdsList = function() {
-  return this.setPipedIdsList([]);
+proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.prototype.setBase64Encoding = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
 
@@ -5014,8 +9927,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -5024,13 +9937,13 @@ proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse.prototype.toObje
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    data: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -5044,29 +9957,33 @@ proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse.toObject = funct
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse}
+ * @return {!proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse}
  */
-proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse;
-  return proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse;
+  return proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse}
+ * @return {!proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse}
  */
-proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setData(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -5080,9 +9997,9 @@ proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse.deserializeBinar
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5090,12 +10007,37 @@ proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse.prototype.serial
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse} message
+ * @param {!proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getData();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string data = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.prototype.getData = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse} returns this
+ */
+proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.prototype.setData = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -5115,8 +10057,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.RestartPipedRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.RestartPipedRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListUnregisteredApplicationsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListUnregisteredApplicationsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -5125,13 +10067,13 @@ proto.grpc.service.webservice.RestartPipedRequest.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.RestartPipedRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ListUnregisteredApplicationsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.RestartPipedRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ListUnregisteredApplicationsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    pipedId: jspb.Message.getFieldWithDefault(msg, 1, "")
+
   };
 
   if (includeInstance) {
@@ -5145,33 +10087,29 @@ proto.grpc.service.webservice.RestartPipedRequest.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.RestartPipedRequest}
+ * @return {!proto.grpc.service.webservice.ListUnregisteredApplicationsRequest}
  */
-proto.grpc.service.webservice.RestartPipedRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ListUnregisteredApplicationsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.RestartPipedRequest;
-  return proto.grpc.service.webservice.RestartPipedRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ListUnregisteredApplicationsRequest;
+  return proto.grpc.service.webservice.ListUnregisteredApplicationsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.RestartPipedRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ListUnregisteredApplicationsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.RestartPipedRequest}
+ * @return {!proto.grpc.service.webservice.ListUnregisteredApplicationsRequest}
  */
-proto.grpc.service.webservice.RestartPipedRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ListUnregisteredApplicationsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPipedId(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -5185,50 +10123,32 @@ proto.grpc.service.webservice.RestartPipedRequest.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.RestartPipedRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.RestartPipedRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
-};
-
-
-/**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.RestartPipedRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.grpc.service.webservice.RestartPipedRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getPipedId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.grpc.service.webservice.ListUnregisteredApplicationsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.ListUnregisteredApplicationsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * optional string piped_id = 1;
- * @return {string}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.ListUnregisteredApplicationsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.RestartPipedRequest.prototype.getPipedId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.grpc.service.webservice.ListUnregisteredApplicationsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
 };
 
 
+
 /**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.RestartPipedRequest} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.grpc.service.webservice.RestartPipedRequest.prototype.setPipedId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
+proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.repeatedFields_ = [1];
 
 
 
@@ -5245,8 +10165,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.RestartPipedResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.RestartPipedResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -5255,13 +10175,14 @@ proto.grpc.service.webservice.RestartPipedResponse.prototype.toObject = function
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.RestartPipedResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ListUnregisteredApplicationsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.RestartPipedResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    commandId: jspb.Message.getFieldWithDefault(msg, 1, "")
+    applicationsList: jspb.Message.toObjectList(msg.getApplicationsList(),
+    pkg_model_common_pb.ApplicationInfo.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -5275,23 +10196,23 @@ proto.grpc.service.webservice.RestartPipedResponse.toObject = function(includeIn
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.RestartPipedResponse}
+ * @return {!proto.grpc.service.webservice.ListUnregisteredApplicationsResponse}
  */
-proto.grpc.service.webservice.RestartPipedResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.RestartPipedResponse;
-  return proto.grpc.service.webservice.RestartPipedResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ListUnregisteredApplicationsResponse;
+  return proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.RestartPipedResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ListUnregisteredApplicationsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.RestartPipedResponse}
+ * @return {!proto.grpc.service.webservice.ListUnregisteredApplicationsResponse}
  */
-proto.grpc.service.webservice.RestartPipedResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5299,8 +10220,9 @@ proto.grpc.service.webservice.RestartPipedResponse.deserializeBinaryFromReader =
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setCommandId(value);
+      var value = new pkg_model_common_pb.ApplicationInfo;
+      reader.readMessage(value,pkg_model_common_pb.ApplicationInfo.deserializeBinaryFromReader);
+      msg.addApplications(value);
       break;
     default:
       reader.skipField();
@@ -5315,9 +10237,9 @@ proto.grpc.service.webservice.RestartPipedResponse.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.RestartPipedResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.RestartPipedResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5325,37 +10247,58 @@ proto.grpc.service.webservice.RestartPipedResponse.prototype.serializeBinary = f
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.RestartPipedResponse} message
+ * @param {!proto.grpc.service.webservice.ListUnregisteredApplicationsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.RestartPipedResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCommandId();
+  f = message.getApplicationsList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       1,
-      f
+      f,
+      pkg_model_common_pb.ApplicationInfo.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string command_id = 1;
- * @return {string}
+ * repeated model.ApplicationInfo applications = 1;
+ * @return {!Array<!proto.model.ApplicationInfo>}
  */
-proto.grpc.service.webservice.RestartPipedResponse.prototype.getCommandId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.prototype.getApplicationsList = function() {
+  return /** @type{!Array<!proto.model.ApplicationInfo>} */ (
+    jspb.Message.getRepeatedWrapperField(this, pkg_model_common_pb.ApplicationInfo, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.RestartPipedResponse} returns this
+ * @param {!Array<!proto.model.ApplicationInfo>} value
+ * @return {!proto.grpc.service.webservice.ListUnregisteredApplicationsResponse} returns this
+*/
+proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.prototype.setApplicationsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.model.ApplicationInfo=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.ApplicationInfo}
  */
-proto.grpc.service.webservice.RestartPipedResponse.prototype.setCommandId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.prototype.addApplications = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.ApplicationInfo, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.ListUnregisteredApplicationsResponse} returns this
+ */
+proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.prototype.clearApplicationsList = function() {
+  return this.setApplicationsList([]);
 };
 
 
@@ -5375,8 +10318,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ListReleasedVersionsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListReleasedVersionsRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListDeploymentsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListDeploymentsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -5385,13 +10328,16 @@ proto.grpc.service.webservice.ListReleasedVersionsRequest.prototype.toObject = f
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListReleasedVersionsRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ListDeploymentsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListReleasedVersionsRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ListDeploymentsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    options: (f = msg.getOptions()) && proto.grpc.service.webservice.ListDeploymentsRequest.Options.toObject(includeInstance, f),
+    pageSize: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    cursor: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    pageMinUpdatedAt: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -5405,29 +10351,46 @@ proto.grpc.service.webservice.ListReleasedVersionsRequest.toObject = function(in
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListReleasedVersionsRequest}
+ * @return {!proto.grpc.service.webservice.ListDeploymentsRequest}
  */
-proto.grpc.service.webservice.ListReleasedVersionsRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ListDeploymentsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListReleasedVersionsRequest;
-  return proto.grpc.service.webservice.ListReleasedVersionsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ListDeploymentsRequest;
+  return proto.grpc.service.webservice.ListDeploymentsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListReleasedVersionsRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ListDeploymentsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListReleasedVersionsRequest}
+ * @return {!proto.grpc.service.webservice.ListDeploymentsRequest}
  */
-proto.grpc.service.webservice.ListReleasedVersionsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ListDeploymentsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = new proto.grpc.service.webservice.ListDeploymentsRequest.Options;
+      reader.readMessage(value,proto.grpc.service.webservice.ListDeploymentsRequest.Options.deserializeBinaryFromReader);
+      msg.setOptions(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPageSize(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCursor(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setPageMinUpdatedAt(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -5441,9 +10404,9 @@ proto.grpc.service.webservice.ListReleasedVersionsRequest.deserializeBinaryFromR
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListReleasedVersionsRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ListDeploymentsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListReleasedVersionsRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ListDeploymentsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5451,12 +10414,41 @@ proto.grpc.service.webservice.ListReleasedVersionsRequest.prototype.serializeBin
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListReleasedVersionsRequest} message
+ * @param {!proto.grpc.service.webservice.ListDeploymentsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListReleasedVersionsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ListDeploymentsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getOptions();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.grpc.service.webservice.ListDeploymentsRequest.Options.serializeBinaryToWriter
+    );
+  }
+  f = message.getPageSize();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getCursor();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getPageMinUpdatedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
 };
 
 
@@ -5466,7 +10458,7 @@ proto.grpc.service.webservice.ListReleasedVersionsRequest.serializeBinaryToWrite
  * @private {!Array<number>}
  * @const
  */
-proto.grpc.service.webservice.ListReleasedVersionsResponse.repeatedFields_ = [1];
+proto.grpc.service.webservice.ListDeploymentsRequest.Options.repeatedFields_ = [1,2,3];
 
 
 
@@ -5483,8 +10475,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ListReleasedVersionsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListReleasedVersionsResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListDeploymentsRequest.Options.toObject(opt_includeInstance, this);
 };
 
 
@@ -5493,13 +10485,17 @@ proto.grpc.service.webservice.ListReleasedVersionsResponse.prototype.toObject =
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListReleasedVersionsResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListReleasedVersionsResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ListDeploymentsRequest.Options.toObject = function(includeInstance, msg) {
   var f, obj = {
-    versionsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+    statusesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    kindsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    applicationIdsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    applicationName: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -5513,23 +10509,23 @@ proto.grpc.service.webservice.ListReleasedVersionsResponse.toObject = function(i
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListReleasedVersionsResponse}
+ * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options}
  */
-proto.grpc.service.webservice.ListReleasedVersionsResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ListDeploymentsRequest.Options.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListReleasedVersionsResponse;
-  return proto.grpc.service.webservice.ListReleasedVersionsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ListDeploymentsRequest.Options;
+  return proto.grpc.service.webservice.ListDeploymentsRequest.Options.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListReleasedVersionsResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListReleasedVersionsResponse}
+ * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options}
  */
-proto.grpc.service.webservice.ListReleasedVersionsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ListDeploymentsRequest.Options.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5537,8 +10533,30 @@ proto.grpc.service.webservice.ListReleasedVersionsResponse.deserializeBinaryFrom
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
+      var values = /** @type {!Array<!proto.model.DeploymentStatus>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addStatuses(values[i]);
+      }
+      break;
+    case 2:
+      var values = /** @type {!Array<!proto.model.ApplicationKind>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addKinds(values[i]);
+      }
+      break;
+    case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.addVersions(value);
+      msg.addApplicationIds(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApplicationName(value);
+      break;
+    case 6:
+      var value = msg.getLabelsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
       break;
     default:
       reader.skipField();
@@ -5553,9 +10571,9 @@ proto.grpc.service.webservice.ListReleasedVersionsResponse.deserializeBinaryFrom
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListReleasedVersionsResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListReleasedVersionsResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ListDeploymentsRequest.Options.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5563,320 +10581,298 @@ proto.grpc.service.webservice.ListReleasedVersionsResponse.prototype.serializeBi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListReleasedVersionsResponse} message
+ * @param {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListReleasedVersionsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ListDeploymentsRequest.Options.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getVersionsList();
+  f = message.getStatusesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      1,
+      f
+    );
+  }
+  f = message.getKindsList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      2,
+      f
+    );
+  }
+  f = message.getApplicationIdsList();
   if (f.length > 0) {
     writer.writeRepeatedString(
-      1,
+      3,
+      f
+    );
+  }
+  f = message.getApplicationName();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
       f
     );
   }
+  f = message.getLabelsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(6, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
 };
 
 
 /**
- * repeated string versions = 1;
- * @return {!Array<string>}
+ * repeated model.DeploymentStatus statuses = 1;
+ * @return {!Array<!proto.model.DeploymentStatus>}
  */
-proto.grpc.service.webservice.ListReleasedVersionsResponse.prototype.getVersionsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.getStatusesList = function() {
+  return /** @type {!Array<!proto.model.DeploymentStatus>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.grpc.service.webservice.ListReleasedVersionsResponse} returns this
+ * @param {!Array<!proto.model.DeploymentStatus>} value
+ * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} returns this
  */
-proto.grpc.service.webservice.ListReleasedVersionsResponse.prototype.setVersionsList = function(value) {
+proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.setStatusesList = function(value) {
   return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * @param {string} value
+ * @param {!proto.model.DeploymentStatus} value
  * @param {number=} opt_index
- * @return {!proto.grpc.service.webservice.ListReleasedVersionsResponse} returns this
+ * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} returns this
  */
-proto.grpc.service.webservice.ListReleasedVersionsResponse.prototype.addVersions = function(value, opt_index) {
+proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.addStatuses = function(value, opt_index) {
   return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.grpc.service.webservice.ListReleasedVersionsResponse} returns this
+ * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} returns this
  */
-proto.grpc.service.webservice.ListReleasedVersionsResponse.prototype.clearVersionsList = function() {
-  return this.setVersionsList([]);
+proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.clearStatusesList = function() {
+  return this.setStatusesList([]);
 };
 
 
+/**
+ * repeated model.ApplicationKind kinds = 2;
+ * @return {!Array<!proto.model.ApplicationKind>}
+ */
+proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.getKindsList = function() {
+  return /** @type {!Array<!proto.model.ApplicationKind>} */ (jspb.Message.getRepeatedField(this, 2));
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {!Array<!proto.model.ApplicationKind>} value
+ * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} returns this
  */
-proto.grpc.service.webservice.ListDeprecatedNotesRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListDeprecatedNotesRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.setKindsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListDeprecatedNotesRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {!proto.model.ApplicationKind} value
+ * @param {number=} opt_index
+ * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} returns this
  */
-proto.grpc.service.webservice.ListDeprecatedNotesRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    projectId: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
+proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.addKinds = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} returns this
+ */
+proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.clearKindsList = function() {
+  return this.setKindsList([]);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListDeprecatedNotesRequest}
+ * repeated string application_ids = 3;
+ * @return {!Array<string>}
  */
-proto.grpc.service.webservice.ListDeprecatedNotesRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListDeprecatedNotesRequest;
-  return proto.grpc.service.webservice.ListDeprecatedNotesRequest.deserializeBinaryFromReader(msg, reader);
+proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.getApplicationIdsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListDeprecatedNotesRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListDeprecatedNotesRequest}
+ * @param {!Array<string>} value
+ * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} returns this
  */
-proto.grpc.service.webservice.ListDeprecatedNotesRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setProjectId(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.setApplicationIdsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} returns this
  */
-proto.grpc.service.webservice.ListDeprecatedNotesRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListDeprecatedNotesRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.addApplicationIds = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListDeprecatedNotesRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} returns this
  */
-proto.grpc.service.webservice.ListDeprecatedNotesRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getProjectId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.clearApplicationIdsList = function() {
+  return this.setApplicationIdsList([]);
 };
 
 
 /**
- * optional string project_id = 1;
+ * optional string application_name = 5;
  * @return {string}
  */
-proto.grpc.service.webservice.ListDeprecatedNotesRequest.prototype.getProjectId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.getApplicationName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.ListDeprecatedNotesRequest} returns this
+ * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} returns this
  */
-proto.grpc.service.webservice.ListDeprecatedNotesRequest.prototype.setProjectId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.setApplicationName = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
+/**
+ * map<string, string> labels = 6;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.getLabelsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 6, opt_noLazyCreate,
+      null));
+};
+
 
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} returns this
+ */
+proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.clearLabelsMap = function() {
+  this.getLabelsMap().clear();
+  return this;
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional Options options = 1;
+ * @return {?proto.grpc.service.webservice.ListDeploymentsRequest.Options}
  */
-proto.grpc.service.webservice.ListDeprecatedNotesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListDeprecatedNotesResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListDeploymentsRequest.prototype.getOptions = function() {
+  return /** @type{?proto.grpc.service.webservice.ListDeploymentsRequest.Options} */ (
+    jspb.Message.getWrapperField(this, proto.grpc.service.webservice.ListDeploymentsRequest.Options, 1));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListDeprecatedNotesResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {?proto.grpc.service.webservice.ListDeploymentsRequest.Options|undefined} value
+ * @return {!proto.grpc.service.webservice.ListDeploymentsRequest} returns this
+*/
+proto.grpc.service.webservice.ListDeploymentsRequest.prototype.setOptions = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.grpc.service.webservice.ListDeploymentsRequest} returns this
  */
-proto.grpc.service.webservice.ListDeprecatedNotesResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    notes: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
+proto.grpc.service.webservice.ListDeploymentsRequest.prototype.clearOptions = function() {
+  return this.setOptions(undefined);
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.grpc.service.webservice.ListDeploymentsRequest.prototype.hasOptions = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListDeprecatedNotesResponse}
+ * optional int32 page_size = 2;
+ * @return {number}
  */
-proto.grpc.service.webservice.ListDeprecatedNotesResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListDeprecatedNotesResponse;
-  return proto.grpc.service.webservice.ListDeprecatedNotesResponse.deserializeBinaryFromReader(msg, reader);
+proto.grpc.service.webservice.ListDeploymentsRequest.prototype.getPageSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListDeprecatedNotesResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListDeprecatedNotesResponse}
+ * @param {number} value
+ * @return {!proto.grpc.service.webservice.ListDeploymentsRequest} returns this
  */
-proto.grpc.service.webservice.ListDeprecatedNotesResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setNotes(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.grpc.service.webservice.ListDeploymentsRequest.prototype.setPageSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional string cursor = 3;
+ * @return {string}
  */
-proto.grpc.service.webservice.ListDeprecatedNotesResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListDeprecatedNotesResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.grpc.service.webservice.ListDeploymentsRequest.prototype.getCursor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListDeprecatedNotesResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.ListDeploymentsRequest} returns this
  */
-proto.grpc.service.webservice.ListDeprecatedNotesResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getNotes();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.grpc.service.webservice.ListDeploymentsRequest.prototype.setCursor = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional string notes = 1;
- * @return {string}
+ * optional int64 page_min_updated_at = 4;
+ * @return {number}
  */
-proto.grpc.service.webservice.ListDeprecatedNotesResponse.prototype.getNotes = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.grpc.service.webservice.ListDeploymentsRequest.prototype.getPageMinUpdatedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.ListDeprecatedNotesResponse} returns this
+ * @param {number} value
+ * @return {!proto.grpc.service.webservice.ListDeploymentsRequest} returns this
  */
-proto.grpc.service.webservice.ListDeprecatedNotesResponse.prototype.setNotes = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.grpc.service.webservice.ListDeploymentsRequest.prototype.setPageMinUpdatedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.grpc.service.webservice.ListDeploymentsResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -5892,8 +10888,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.AddApplicationRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.AddApplicationRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListDeploymentsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListDeploymentsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -5902,20 +10898,15 @@ proto.grpc.service.webservice.AddApplicationRequest.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.AddApplicationRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ListDeploymentsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.AddApplicationRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ListDeploymentsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    pipedId: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    gitPath: (f = msg.getGitPath()) && pkg_model_common_pb.ApplicationGitPath.toObject(includeInstance, f),
-    kind: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    platformProvider: jspb.Message.getFieldWithDefault(msg, 9, ""),
-    deployTargetsByPluginMap: (f = msg.getDeployTargetsByPluginMap()) ? f.toObject(includeInstance, proto.model.DeployTargets.toObject) : [],
-    description: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : []
+    deploymentsList: jspb.Message.toObjectList(msg.getDeploymentsList(),
+    pkg_model_deployment_pb.Deployment.toObject, includeInstance),
+    cursor: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -5929,23 +10920,23 @@ proto.grpc.service.webservice.AddApplicationRequest.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.AddApplicationRequest}
+ * @return {!proto.grpc.service.webservice.ListDeploymentsResponse}
  */
-proto.grpc.service.webservice.AddApplicationRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ListDeploymentsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.AddApplicationRequest;
-  return proto.grpc.service.webservice.AddApplicationRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ListDeploymentsResponse;
+  return proto.grpc.service.webservice.ListDeploymentsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.AddApplicationRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ListDeploymentsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.AddApplicationRequest}
+ * @return {!proto.grpc.service.webservice.ListDeploymentsResponse}
  */
-proto.grpc.service.webservice.AddApplicationRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ListDeploymentsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5953,41 +10944,13 @@ proto.grpc.service.webservice.AddApplicationRequest.deserializeBinaryFromReader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPipedId(value);
-      break;
-    case 4:
-      var value = new pkg_model_common_pb.ApplicationGitPath;
-      reader.readMessage(value,pkg_model_common_pb.ApplicationGitPath.deserializeBinaryFromReader);
-      msg.setGitPath(value);
-      break;
-    case 5:
-      var value = /** @type {!proto.model.ApplicationKind} */ (reader.readEnum());
-      msg.setKind(value);
-      break;
-    case 9:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPlatformProvider(value);
-      break;
-    case 10:
-      var value = msg.getDeployTargetsByPluginMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.model.DeployTargets.deserializeBinaryFromReader, "", new proto.model.DeployTargets());
-         });
+      var value = new pkg_model_deployment_pb.Deployment;
+      reader.readMessage(value,pkg_model_deployment_pb.Deployment.deserializeBinaryFromReader);
+      msg.addDeployments(value);
       break;
-    case 7:
+    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setDescription(value);
-      break;
-    case 8:
-      var value = msg.getLabelsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
+      msg.setCursor(value);
       break;
     default:
       reader.skipField();
@@ -6002,9 +10965,9 @@ proto.grpc.service.webservice.AddApplicationRequest.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.AddApplicationRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ListDeploymentsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.AddApplicationRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ListDeploymentsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6012,236 +10975,213 @@ proto.grpc.service.webservice.AddApplicationRequest.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.AddApplicationRequest} message
+ * @param {!proto.grpc.service.webservice.ListDeploymentsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.AddApplicationRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ListDeploymentsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getPipedId();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getGitPath();
-  if (f != null) {
-    writer.writeMessage(
-      4,
-      f,
-      pkg_model_common_pb.ApplicationGitPath.serializeBinaryToWriter
-    );
-  }
-  f = message.getKind();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      5,
-      f
-    );
-  }
-  f = message.getPlatformProvider();
-  if (f.length > 0) {
-    writer.writeString(
-      9,
-      f
-    );
-  }
-  f = message.getDeployTargetsByPluginMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(10, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.model.DeployTargets.serializeBinaryToWriter);
-  }
-  f = message.getDescription();
-  if (f.length > 0) {
-    writer.writeString(
-      7,
-      f
-    );
-  }
-  f = message.getLabelsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(8, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
-  }
-};
-
-
-/**
- * optional string name = 1;
- * @return {string}
- */
-proto.grpc.service.webservice.AddApplicationRequest.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.AddApplicationRequest} returns this
- */
-proto.grpc.service.webservice.AddApplicationRequest.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional string piped_id = 3;
- * @return {string}
- */
-proto.grpc.service.webservice.AddApplicationRequest.prototype.getPipedId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.AddApplicationRequest} returns this
- */
-proto.grpc.service.webservice.AddApplicationRequest.prototype.setPipedId = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+  f = message.getDeploymentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      pkg_model_deployment_pb.Deployment.serializeBinaryToWriter
+    );
+  }
+  f = message.getCursor();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional model.ApplicationGitPath git_path = 4;
- * @return {?proto.model.ApplicationGitPath}
+ * repeated model.Deployment deployments = 1;
+ * @return {!Array<!proto.model.Deployment>}
  */
-proto.grpc.service.webservice.AddApplicationRequest.prototype.getGitPath = function() {
-  return /** @type{?proto.model.ApplicationGitPath} */ (
-    jspb.Message.getWrapperField(this, pkg_model_common_pb.ApplicationGitPath, 4));
+proto.grpc.service.webservice.ListDeploymentsResponse.prototype.getDeploymentsList = function() {
+  return /** @type{!Array<!proto.model.Deployment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, pkg_model_deployment_pb.Deployment, 1));
 };
 
 
 /**
- * @param {?proto.model.ApplicationGitPath|undefined} value
- * @return {!proto.grpc.service.webservice.AddApplicationRequest} returns this
+ * @param {!Array<!proto.model.Deployment>} value
+ * @return {!proto.grpc.service.webservice.ListDeploymentsResponse} returns this
 */
-proto.grpc.service.webservice.AddApplicationRequest.prototype.setGitPath = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+proto.grpc.service.webservice.ListDeploymentsResponse.prototype.setDeploymentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.grpc.service.webservice.AddApplicationRequest} returns this
+ * @param {!proto.model.Deployment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.Deployment}
  */
-proto.grpc.service.webservice.AddApplicationRequest.prototype.clearGitPath = function() {
-  return this.setGitPath(undefined);
+proto.grpc.service.webservice.ListDeploymentsResponse.prototype.addDeployments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.Deployment, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.ListDeploymentsResponse} returns this
  */
-proto.grpc.service.webservice.AddApplicationRequest.prototype.hasGitPath = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.grpc.service.webservice.ListDeploymentsResponse.prototype.clearDeploymentsList = function() {
+  return this.setDeploymentsList([]);
 };
 
 
 /**
- * optional model.ApplicationKind kind = 5;
- * @return {!proto.model.ApplicationKind}
+ * optional string cursor = 2;
+ * @return {string}
  */
-proto.grpc.service.webservice.AddApplicationRequest.prototype.getKind = function() {
-  return /** @type {!proto.model.ApplicationKind} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.grpc.service.webservice.ListDeploymentsResponse.prototype.getCursor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {!proto.model.ApplicationKind} value
- * @return {!proto.grpc.service.webservice.AddApplicationRequest} returns this
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.ListDeploymentsResponse} returns this
  */
-proto.grpc.service.webservice.AddApplicationRequest.prototype.setKind = function(value) {
-  return jspb.Message.setProto3EnumField(this, 5, value);
+proto.grpc.service.webservice.ListDeploymentsResponse.prototype.setCursor = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional string platform_provider = 9;
- * @return {string}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.grpc.service.webservice.AddApplicationRequest.prototype.getPlatformProvider = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+proto.grpc.service.webservice.GetDeploymentRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GetDeploymentRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.AddApplicationRequest} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.GetDeploymentRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.AddApplicationRequest.prototype.setPlatformProvider = function(value) {
-  return jspb.Message.setProto3StringField(this, 9, value);
+proto.grpc.service.webservice.GetDeploymentRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    deploymentId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * map<string, model.DeployTargets> deploy_targets_by_plugin = 10;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.model.DeployTargets>}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.GetDeploymentRequest}
  */
-proto.grpc.service.webservice.AddApplicationRequest.prototype.getDeployTargetsByPluginMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.model.DeployTargets>} */ (
-      jspb.Message.getMapField(this, 10, opt_noLazyCreate,
-      proto.model.DeployTargets));
+proto.grpc.service.webservice.GetDeploymentRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.GetDeploymentRequest;
+  return proto.grpc.service.webservice.GetDeploymentRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.grpc.service.webservice.AddApplicationRequest} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.GetDeploymentRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.GetDeploymentRequest}
  */
-proto.grpc.service.webservice.AddApplicationRequest.prototype.clearDeployTargetsByPluginMap = function() {
-  this.getDeployTargetsByPluginMap().clear();
-  return this;
+proto.grpc.service.webservice.GetDeploymentRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDeploymentId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional string description = 7;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.AddApplicationRequest.prototype.getDescription = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.grpc.service.webservice.GetDeploymentRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.GetDeploymentRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.AddApplicationRequest} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.GetDeploymentRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.AddApplicationRequest.prototype.setDescription = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
+proto.grpc.service.webservice.GetDeploymentRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDeploymentId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * map<string, string> labels = 8;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * optional string deployment_id = 1;
+ * @return {string}
  */
-proto.grpc.service.webservice.AddApplicationRequest.prototype.getLabelsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 8, opt_noLazyCreate,
-      null));
+proto.grpc.service.webservice.GetDeploymentRequest.prototype.getDeploymentId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.grpc.service.webservice.AddApplicationRequest} returns this
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.GetDeploymentRequest} returns this
  */
-proto.grpc.service.webservice.AddApplicationRequest.prototype.clearLabelsMap = function() {
-  this.getLabelsMap().clear();
-  return this;
+proto.grpc.service.webservice.GetDeploymentRequest.prototype.setDeploymentId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -6261,8 +11201,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.AddApplicationResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.AddApplicationResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.GetDeploymentResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GetDeploymentResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -6271,13 +11211,13 @@ proto.grpc.service.webservice.AddApplicationResponse.prototype.toObject = functi
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.AddApplicationResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.GetDeploymentResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.AddApplicationResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.GetDeploymentResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    applicationId: jspb.Message.getFieldWithDefault(msg, 1, "")
+    deployment: (f = msg.getDeployment()) && pkg_model_deployment_pb.Deployment.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -6291,23 +11231,23 @@ proto.grpc.service.webservice.AddApplicationResponse.toObject = function(include
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.AddApplicationResponse}
+ * @return {!proto.grpc.service.webservice.GetDeploymentResponse}
  */
-proto.grpc.service.webservice.AddApplicationResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.GetDeploymentResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.AddApplicationResponse;
-  return proto.grpc.service.webservice.AddApplicationResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.GetDeploymentResponse;
+  return proto.grpc.service.webservice.GetDeploymentResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.AddApplicationResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.GetDeploymentResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.AddApplicationResponse}
+ * @return {!proto.grpc.service.webservice.GetDeploymentResponse}
  */
-proto.grpc.service.webservice.AddApplicationResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.GetDeploymentResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6315,8 +11255,9 @@ proto.grpc.service.webservice.AddApplicationResponse.deserializeBinaryFromReader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setApplicationId(value);
+      var value = new pkg_model_deployment_pb.Deployment;
+      reader.readMessage(value,pkg_model_deployment_pb.Deployment.deserializeBinaryFromReader);
+      msg.setDeployment(value);
       break;
     default:
       reader.skipField();
@@ -6331,47 +11272,67 @@ proto.grpc.service.webservice.AddApplicationResponse.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.AddApplicationResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.GetDeploymentResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.AddApplicationResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.GetDeploymentResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.AddApplicationResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.grpc.service.webservice.AddApplicationResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getApplicationId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.GetDeploymentResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.GetDeploymentResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDeployment();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      pkg_model_deployment_pb.Deployment.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional model.Deployment deployment = 1;
+ * @return {?proto.model.Deployment}
+ */
+proto.grpc.service.webservice.GetDeploymentResponse.prototype.getDeployment = function() {
+  return /** @type{?proto.model.Deployment} */ (
+    jspb.Message.getWrapperField(this, pkg_model_deployment_pb.Deployment, 1));
+};
+
+
+/**
+ * @param {?proto.model.Deployment|undefined} value
+ * @return {!proto.grpc.service.webservice.GetDeploymentResponse} returns this
+*/
+proto.grpc.service.webservice.GetDeploymentResponse.prototype.setDeployment = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * optional string application_id = 1;
- * @return {string}
+ * Clears the message field making it undefined.
+ * @return {!proto.grpc.service.webservice.GetDeploymentResponse} returns this
  */
-proto.grpc.service.webservice.AddApplicationResponse.prototype.getApplicationId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.grpc.service.webservice.GetDeploymentResponse.prototype.clearDeployment = function() {
+  return this.setDeployment(undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.AddApplicationResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.grpc.service.webservice.AddApplicationResponse.prototype.setApplicationId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.grpc.service.webservice.GetDeploymentResponse.prototype.hasDeployment = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
@@ -6391,8 +11352,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.UpdateApplicationRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.UpdateApplicationRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.GetStageLogRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GetStageLogRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -6401,19 +11362,17 @@ proto.grpc.service.webservice.UpdateApplicationRequest.prototype.toObject = func
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.UpdateApplicationRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.GetStageLogRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdateApplicationRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.GetStageLogRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    applicationId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    pipedId: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    kind: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    platformProvider: jspb.Message.getFieldWithDefault(msg, 9, ""),
-    deployTargetsByPluginMap: (f = msg.getDeployTargetsByPluginMap()) ? f.toObject(includeInstance, proto.model.DeployTargets.toObject) : [],
-    configFilename: jspb.Message.getFieldWithDefault(msg, 8, "")
+    deploymentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    stageId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    retriedCount: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    offsetIndex: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    verbose: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
   };
 
   if (includeInstance) {
@@ -6427,23 +11386,23 @@ proto.grpc.service.webservice.UpdateApplicationRequest.toObject = function(inclu
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.UpdateApplicationRequest}
+ * @return {!proto.grpc.service.webservice.GetStageLogRequest}
  */
-proto.grpc.service.webservice.UpdateApplicationRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.GetStageLogRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.UpdateApplicationRequest;
-  return proto.grpc.service.webservice.UpdateApplicationRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.GetStageLogRequest;
+  return proto.grpc.service.webservice.GetStageLogRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.UpdateApplicationRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.GetStageLogRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.UpdateApplicationRequest}
+ * @return {!proto.grpc.service.webservice.GetStageLogRequest}
  */
-proto.grpc.service.webservice.UpdateApplicationRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.GetStageLogRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6452,33 +11411,23 @@ proto.grpc.service.webservice.UpdateApplicationRequest.deserializeBinaryFromRead
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setApplicationId(value);
+      msg.setDeploymentId(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPipedId(value);
-      break;
-    case 6:
-      var value = /** @type {!proto.model.ApplicationKind} */ (reader.readEnum());
-      msg.setKind(value);
+      msg.setStageId(value);
       break;
-    case 9:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPlatformProvider(value);
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRetriedCount(value);
       break;
-    case 10:
-      var value = msg.getDeployTargetsByPluginMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.model.DeployTargets.deserializeBinaryFromReader, "", new proto.model.DeployTargets());
-         });
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setOffsetIndex(value);
       break;
-    case 8:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setConfigFilename(value);
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setVerbose(value);
       break;
     default:
       reader.skipField();
@@ -6493,9 +11442,9 @@ proto.grpc.service.webservice.UpdateApplicationRequest.deserializeBinaryFromRead
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.UpdateApplicationRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.GetStageLogRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.UpdateApplicationRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.GetStageLogRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6503,55 +11452,44 @@ proto.grpc.service.webservice.UpdateApplicationRequest.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.UpdateApplicationRequest} message
+ * @param {!proto.grpc.service.webservice.GetStageLogRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdateApplicationRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.GetStageLogRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getApplicationId();
+  f = message.getDeploymentId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getName();
+  f = message.getStageId();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getPipedId();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getKind();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      6,
+  f = message.getRetriedCount();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
       f
     );
   }
-  f = message.getPlatformProvider();
-  if (f.length > 0) {
-    writer.writeString(
-      9,
+  f = message.getOffsetIndex();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
       f
     );
   }
-  f = message.getDeployTargetsByPluginMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(10, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.model.DeployTargets.serializeBinaryToWriter);
-  }
-  f = message.getConfigFilename();
-  if (f.length > 0) {
-    writer.writeString(
-      8,
+  f = message.getVerbose();
+  if (f) {
+    writer.writeBool(
+      5,
       f
     );
   }
@@ -6559,136 +11497,102 @@ proto.grpc.service.webservice.UpdateApplicationRequest.serializeBinaryToWriter =
 
 
 /**
- * optional string application_id = 1;
+ * optional string deployment_id = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.UpdateApplicationRequest.prototype.getApplicationId = function() {
+proto.grpc.service.webservice.GetStageLogRequest.prototype.getDeploymentId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.UpdateApplicationRequest} returns this
+ * @return {!proto.grpc.service.webservice.GetStageLogRequest} returns this
  */
-proto.grpc.service.webservice.UpdateApplicationRequest.prototype.setApplicationId = function(value) {
+proto.grpc.service.webservice.GetStageLogRequest.prototype.setDeploymentId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
+ * optional string stage_id = 2;
  * @return {string}
  */
-proto.grpc.service.webservice.UpdateApplicationRequest.prototype.getName = function() {
+proto.grpc.service.webservice.GetStageLogRequest.prototype.getStageId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.UpdateApplicationRequest} returns this
+ * @return {!proto.grpc.service.webservice.GetStageLogRequest} returns this
  */
-proto.grpc.service.webservice.UpdateApplicationRequest.prototype.setName = function(value) {
+proto.grpc.service.webservice.GetStageLogRequest.prototype.setStageId = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string piped_id = 4;
- * @return {string}
- */
-proto.grpc.service.webservice.UpdateApplicationRequest.prototype.getPipedId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.UpdateApplicationRequest} returns this
- */
-proto.grpc.service.webservice.UpdateApplicationRequest.prototype.setPipedId = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
-};
-
-
-/**
- * optional model.ApplicationKind kind = 6;
- * @return {!proto.model.ApplicationKind}
+ * optional int32 retried_count = 3;
+ * @return {number}
  */
-proto.grpc.service.webservice.UpdateApplicationRequest.prototype.getKind = function() {
-  return /** @type {!proto.model.ApplicationKind} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.grpc.service.webservice.GetStageLogRequest.prototype.getRetriedCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {!proto.model.ApplicationKind} value
- * @return {!proto.grpc.service.webservice.UpdateApplicationRequest} returns this
+ * @param {number} value
+ * @return {!proto.grpc.service.webservice.GetStageLogRequest} returns this
  */
-proto.grpc.service.webservice.UpdateApplicationRequest.prototype.setKind = function(value) {
-  return jspb.Message.setProto3EnumField(this, 6, value);
+proto.grpc.service.webservice.GetStageLogRequest.prototype.setRetriedCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional string platform_provider = 9;
- * @return {string}
+ * optional int64 offset_index = 4;
+ * @return {number}
  */
-proto.grpc.service.webservice.UpdateApplicationRequest.prototype.getPlatformProvider = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+proto.grpc.service.webservice.GetStageLogRequest.prototype.getOffsetIndex = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.UpdateApplicationRequest} returns this
+ * @param {number} value
+ * @return {!proto.grpc.service.webservice.GetStageLogRequest} returns this
  */
-proto.grpc.service.webservice.UpdateApplicationRequest.prototype.setPlatformProvider = function(value) {
-  return jspb.Message.setProto3StringField(this, 9, value);
+proto.grpc.service.webservice.GetStageLogRequest.prototype.setOffsetIndex = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * map<string, model.DeployTargets> deploy_targets_by_plugin = 10;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.model.DeployTargets>}
+ * optional bool verbose = 5;
+ * @return {boolean}
  */
-proto.grpc.service.webservice.UpdateApplicationRequest.prototype.getDeployTargetsByPluginMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.model.DeployTargets>} */ (
-      jspb.Message.getMapField(this, 10, opt_noLazyCreate,
-      proto.model.DeployTargets));
+proto.grpc.service.webservice.GetStageLogRequest.prototype.getVerbose = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.grpc.service.webservice.UpdateApplicationRequest} returns this
+ * @param {boolean} value
+ * @return {!proto.grpc.service.webservice.GetStageLogRequest} returns this
  */
-proto.grpc.service.webservice.UpdateApplicationRequest.prototype.clearDeployTargetsByPluginMap = function() {
-  this.getDeployTargetsByPluginMap().clear();
-  return this;
+proto.grpc.service.webservice.GetStageLogRequest.prototype.setVerbose = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
 };
 
 
-/**
- * optional string config_filename = 8;
- * @return {string}
- */
-proto.grpc.service.webservice.UpdateApplicationRequest.prototype.getConfigFilename = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
-};
-
 
 /**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.UpdateApplicationRequest} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.grpc.service.webservice.UpdateApplicationRequest.prototype.setConfigFilename = function(value) {
-  return jspb.Message.setProto3StringField(this, 8, value);
-};
-
-
+proto.grpc.service.webservice.GetStageLogResponse.repeatedFields_ = [1];
 
 
 
@@ -6705,8 +11609,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.UpdateApplicationResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.UpdateApplicationResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.GetStageLogResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GetStageLogResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -6715,13 +11619,15 @@ proto.grpc.service.webservice.UpdateApplicationResponse.prototype.toObject = fun
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.UpdateApplicationResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.GetStageLogResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdateApplicationResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.GetStageLogResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    blocksList: jspb.Message.toObjectList(msg.getBlocksList(),
+    pkg_model_logblock_pb.LogBlock.toObject, includeInstance),
+    completed: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
   };
 
   if (includeInstance) {
@@ -6735,29 +11641,38 @@ proto.grpc.service.webservice.UpdateApplicationResponse.toObject = function(incl
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.UpdateApplicationResponse}
+ * @return {!proto.grpc.service.webservice.GetStageLogResponse}
  */
-proto.grpc.service.webservice.UpdateApplicationResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.GetStageLogResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.UpdateApplicationResponse;
-  return proto.grpc.service.webservice.UpdateApplicationResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.GetStageLogResponse;
+  return proto.grpc.service.webservice.GetStageLogResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.UpdateApplicationResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.GetStageLogResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.UpdateApplicationResponse}
+ * @return {!proto.grpc.service.webservice.GetStageLogResponse}
  */
-proto.grpc.service.webservice.UpdateApplicationResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.GetStageLogResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = new pkg_model_logblock_pb.LogBlock;
+      reader.readMessage(value,pkg_model_logblock_pb.LogBlock.deserializeBinaryFromReader);
+      msg.addBlocks(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setCompleted(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -6771,9 +11686,9 @@ proto.grpc.service.webservice.UpdateApplicationResponse.deserializeBinaryFromRea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.UpdateApplicationResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.GetStageLogResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.UpdateApplicationResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.GetStageLogResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6781,16 +11696,94 @@ proto.grpc.service.webservice.UpdateApplicationResponse.prototype.serializeBinar
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.UpdateApplicationResponse} message
+ * @param {!proto.grpc.service.webservice.GetStageLogResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdateApplicationResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.GetStageLogResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getBlocksList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      pkg_model_logblock_pb.LogBlock.serializeBinaryToWriter
+    );
+  }
+  f = message.getCompleted();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated model.LogBlock blocks = 1;
+ * @return {!Array<!proto.model.LogBlock>}
+ */
+proto.grpc.service.webservice.GetStageLogResponse.prototype.getBlocksList = function() {
+  return /** @type{!Array<!proto.model.LogBlock>} */ (
+    jspb.Message.getRepeatedWrapperField(this, pkg_model_logblock_pb.LogBlock, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.model.LogBlock>} value
+ * @return {!proto.grpc.service.webservice.GetStageLogResponse} returns this
+*/
+proto.grpc.service.webservice.GetStageLogResponse.prototype.setBlocksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.model.LogBlock=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.LogBlock}
+ */
+proto.grpc.service.webservice.GetStageLogResponse.prototype.addBlocks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.LogBlock, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.GetStageLogResponse} returns this
+ */
+proto.grpc.service.webservice.GetStageLogResponse.prototype.clearBlocksList = function() {
+  return this.setBlocksList([]);
+};
+
+
+/**
+ * optional bool completed = 2;
+ * @return {boolean}
+ */
+proto.grpc.service.webservice.GetStageLogResponse.prototype.getCompleted = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.grpc.service.webservice.GetStageLogResponse} returns this
+ */
+proto.grpc.service.webservice.GetStageLogResponse.prototype.setCompleted = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.grpc.service.webservice.SearchStageLogsRequest.repeatedFields_ = [4];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -6806,8 +11799,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.EnableApplicationRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.EnableApplicationRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.SearchStageLogsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.SearchStageLogsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -6816,13 +11809,18 @@ proto.grpc.service.webservice.EnableApplicationRequest.prototype.toObject = func
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.EnableApplicationRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.SearchStageLogsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.EnableApplicationRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.SearchStageLogsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    applicationId: jspb.Message.getFieldWithDefault(msg, 1, "")
+    applicationId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    from: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    to: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    severitiesList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+    query: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    limit: jspb.Message.getFieldWithDefault(msg, 6, 0)
   };
 
   if (includeInstance) {
@@ -6836,23 +11834,23 @@ proto.grpc.service.webservice.EnableApplicationRequest.toObject = function(inclu
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.EnableApplicationRequest}
+ * @return {!proto.grpc.service.webservice.SearchStageLogsRequest}
  */
-proto.grpc.service.webservice.EnableApplicationRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.SearchStageLogsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.EnableApplicationRequest;
-  return proto.grpc.service.webservice.EnableApplicationRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.SearchStageLogsRequest;
+  return proto.grpc.service.webservice.SearchStageLogsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.EnableApplicationRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.SearchStageLogsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.EnableApplicationRequest}
+ * @return {!proto.grpc.service.webservice.SearchStageLogsRequest}
  */
-proto.grpc.service.webservice.EnableApplicationRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.SearchStageLogsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6863,6 +11861,28 @@ proto.grpc.service.webservice.EnableApplicationRequest.deserializeBinaryFromRead
       var value = /** @type {string} */ (reader.readString());
       msg.setApplicationId(value);
       break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setFrom(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTo(value);
+      break;
+    case 4:
+      var values = /** @type {!Array<!proto.model.LogSeverity>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addSeverities(values[i]);
+      }
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setQuery(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLimit(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -6876,9 +11896,9 @@ proto.grpc.service.webservice.EnableApplicationRequest.deserializeBinaryFromRead
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.EnableApplicationRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.SearchStageLogsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.EnableApplicationRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.SearchStageLogsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6886,11 +11906,11 @@ proto.grpc.service.webservice.EnableApplicationRequest.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.EnableApplicationRequest} message
+ * @param {!proto.grpc.service.webservice.SearchStageLogsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.EnableApplicationRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.SearchStageLogsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getApplicationId();
   if (f.length > 0) {
@@ -6899,6 +11919,41 @@ proto.grpc.service.webservice.EnableApplicationRequest.serializeBinaryToWriter =
       f
     );
   }
+  f = message.getFrom();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+  f = message.getTo();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+  f = message.getSeveritiesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      4,
+      f
+    );
+  }
+  f = message.getQuery();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getLimit();
+  if (f !== 0) {
+    writer.writeInt32(
+      6,
+      f
+    );
+  }
 };
 
 
@@ -6906,122 +11961,137 @@ proto.grpc.service.webservice.EnableApplicationRequest.serializeBinaryToWriter =
  * optional string application_id = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.EnableApplicationRequest.prototype.getApplicationId = function() {
+proto.grpc.service.webservice.SearchStageLogsRequest.prototype.getApplicationId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.EnableApplicationRequest} returns this
+ * @return {!proto.grpc.service.webservice.SearchStageLogsRequest} returns this
  */
-proto.grpc.service.webservice.EnableApplicationRequest.prototype.setApplicationId = function(value) {
+proto.grpc.service.webservice.SearchStageLogsRequest.prototype.setApplicationId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional int64 from = 2;
+ * @return {number}
+ */
+proto.grpc.service.webservice.SearchStageLogsRequest.prototype.getFrom = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
 
+/**
+ * @param {number} value
+ * @return {!proto.grpc.service.webservice.SearchStageLogsRequest} returns this
+ */
+proto.grpc.service.webservice.SearchStageLogsRequest.prototype.setFrom = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional int64 to = 3;
+ * @return {number}
  */
-proto.grpc.service.webservice.EnableApplicationResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.EnableApplicationResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.SearchStageLogsRequest.prototype.getTo = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.EnableApplicationResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {number} value
+ * @return {!proto.grpc.service.webservice.SearchStageLogsRequest} returns this
  */
-proto.grpc.service.webservice.EnableApplicationResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
+proto.grpc.service.webservice.SearchStageLogsRequest.prototype.setTo = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
 
-  };
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+/**
+ * repeated model.LogSeverity severities = 4;
+ * @return {!Array<!proto.model.LogSeverity>}
+ */
+proto.grpc.service.webservice.SearchStageLogsRequest.prototype.getSeveritiesList = function() {
+  return /** @type {!Array<!proto.model.LogSeverity>} */ (jspb.Message.getRepeatedField(this, 4));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.EnableApplicationResponse}
+ * @param {!Array<!proto.model.LogSeverity>} value
+ * @return {!proto.grpc.service.webservice.SearchStageLogsRequest} returns this
  */
-proto.grpc.service.webservice.EnableApplicationResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.EnableApplicationResponse;
-  return proto.grpc.service.webservice.EnableApplicationResponse.deserializeBinaryFromReader(msg, reader);
+proto.grpc.service.webservice.SearchStageLogsRequest.prototype.setSeveritiesList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.EnableApplicationResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.EnableApplicationResponse}
+ * @param {!proto.model.LogSeverity} value
+ * @param {number=} opt_index
+ * @return {!proto.grpc.service.webservice.SearchStageLogsRequest} returns this
+ */
+proto.grpc.service.webservice.SearchStageLogsRequest.prototype.addSeverities = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.SearchStageLogsRequest} returns this
+ */
+proto.grpc.service.webservice.SearchStageLogsRequest.prototype.clearSeveritiesList = function() {
+  return this.setSeveritiesList([]);
+};
+
+
+/**
+ * optional string query = 5;
+ * @return {string}
+ */
+proto.grpc.service.webservice.SearchStageLogsRequest.prototype.getQuery = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.SearchStageLogsRequest} returns this
  */
-proto.grpc.service.webservice.EnableApplicationResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.grpc.service.webservice.SearchStageLogsRequest.prototype.setQuery = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional int32 limit = 6;
+ * @return {number}
  */
-proto.grpc.service.webservice.EnableApplicationResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.EnableApplicationResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.grpc.service.webservice.SearchStageLogsRequest.prototype.getLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.EnableApplicationResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {number} value
+ * @return {!proto.grpc.service.webservice.SearchStageLogsRequest} returns this
  */
-proto.grpc.service.webservice.EnableApplicationResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
+proto.grpc.service.webservice.SearchStageLogsRequest.prototype.setLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.grpc.service.webservice.SearchStageLogsResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -7037,8 +12107,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.DisableApplicationRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.DisableApplicationRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.SearchStageLogsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.SearchStageLogsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -7047,13 +12117,14 @@ proto.grpc.service.webservice.DisableApplicationRequest.prototype.toObject = fun
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.DisableApplicationRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.SearchStageLogsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DisableApplicationRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.SearchStageLogsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    applicationId: jspb.Message.getFieldWithDefault(msg, 1, "")
+    matchesList: jspb.Message.toObjectList(msg.getMatchesList(),
+    proto.grpc.service.webservice.SearchStageLogsResponse.Match.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -7067,23 +12138,23 @@ proto.grpc.service.webservice.DisableApplicationRequest.toObject = function(incl
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.DisableApplicationRequest}
+ * @return {!proto.grpc.service.webservice.SearchStageLogsResponse}
  */
-proto.grpc.service.webservice.DisableApplicationRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.SearchStageLogsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.DisableApplicationRequest;
-  return proto.grpc.service.webservice.DisableApplicationRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.SearchStageLogsResponse;
+  return proto.grpc.service.webservice.SearchStageLogsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.DisableApplicationRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.SearchStageLogsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.DisableApplicationRequest}
+ * @return {!proto.grpc.service.webservice.SearchStageLogsResponse}
  */
-proto.grpc.service.webservice.DisableApplicationRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.SearchStageLogsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7091,8 +12162,9 @@ proto.grpc.service.webservice.DisableApplicationRequest.deserializeBinaryFromRea
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setApplicationId(value);
+      var value = new proto.grpc.service.webservice.SearchStageLogsResponse.Match;
+      reader.readMessage(value,proto.grpc.service.webservice.SearchStageLogsResponse.Match.deserializeBinaryFromReader);
+      msg.addMatches(value);
       break;
     default:
       reader.skipField();
@@ -7107,9 +12179,9 @@ proto.grpc.service.webservice.DisableApplicationRequest.deserializeBinaryFromRea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.DisableApplicationRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.SearchStageLogsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.DisableApplicationRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.SearchStageLogsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7117,40 +12189,23 @@ proto.grpc.service.webservice.DisableApplicationRequest.prototype.serializeBinar
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.DisableApplicationRequest} message
+ * @param {!proto.grpc.service.webservice.SearchStageLogsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DisableApplicationRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.SearchStageLogsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getApplicationId();
+  f = message.getMatchesList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       1,
-      f
+      f,
+      proto.grpc.service.webservice.SearchStageLogsResponse.Match.serializeBinaryToWriter
     );
   }
 };
 
 
-/**
- * optional string application_id = 1;
- * @return {string}
- */
-proto.grpc.service.webservice.DisableApplicationRequest.prototype.getApplicationId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.DisableApplicationRequest} returns this
- */
-proto.grpc.service.webservice.DisableApplicationRequest.prototype.setApplicationId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
 
 
 
@@ -7167,8 +12222,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.DisableApplicationResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.DisableApplicationResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.SearchStageLogsResponse.Match.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.SearchStageLogsResponse.Match.toObject(opt_includeInstance, this);
 };
 
 
@@ -7177,13 +12232,16 @@ proto.grpc.service.webservice.DisableApplicationResponse.prototype.toObject = fu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.DisableApplicationResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.SearchStageLogsResponse.Match} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DisableApplicationResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.SearchStageLogsResponse.Match.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    deploymentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    stageId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    retriedCount: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    block: (f = msg.getBlock()) && pkg_model_logblock_pb.LogBlock.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -7197,29 +12255,46 @@ proto.grpc.service.webservice.DisableApplicationResponse.toObject = function(inc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.DisableApplicationResponse}
+ * @return {!proto.grpc.service.webservice.SearchStageLogsResponse.Match}
  */
-proto.grpc.service.webservice.DisableApplicationResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.SearchStageLogsResponse.Match.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.DisableApplicationResponse;
-  return proto.grpc.service.webservice.DisableApplicationResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.SearchStageLogsResponse.Match;
+  return proto.grpc.service.webservice.SearchStageLogsResponse.Match.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.DisableApplicationResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.SearchStageLogsResponse.Match} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.DisableApplicationResponse}
+ * @return {!proto.grpc.service.webservice.SearchStageLogsResponse.Match}
  */
-proto.grpc.service.webservice.DisableApplicationResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.SearchStageLogsResponse.Match.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDeploymentId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStageId(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRetriedCount(value);
+      break;
+    case 4:
+      var value = new pkg_model_logblock_pb.LogBlock;
+      reader.readMessage(value,pkg_model_logblock_pb.LogBlock.deserializeBinaryFromReader);
+      msg.setBlock(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -7233,9 +12308,9 @@ proto.grpc.service.webservice.DisableApplicationResponse.deserializeBinaryFromRe
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.DisableApplicationResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.SearchStageLogsResponse.Match.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.DisableApplicationResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.SearchStageLogsResponse.Match.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7243,142 +12318,170 @@ proto.grpc.service.webservice.DisableApplicationResponse.prototype.serializeBina
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.DisableApplicationResponse} message
+ * @param {!proto.grpc.service.webservice.SearchStageLogsResponse.Match} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DisableApplicationResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.SearchStageLogsResponse.Match.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getDeploymentId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getStageId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getRetriedCount();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getBlock();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      pkg_model_logblock_pb.LogBlock.serializeBinaryToWriter
+    );
+  }
 };
 
 
+/**
+ * optional string deployment_id = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.SearchStageLogsResponse.Match.prototype.getDeploymentId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
 
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.SearchStageLogsResponse.Match} returns this
+ */
+proto.grpc.service.webservice.SearchStageLogsResponse.Match.prototype.setDeploymentId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional string stage_id = 2;
+ * @return {string}
  */
-proto.grpc.service.webservice.DeleteApplicationRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.DeleteApplicationRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.SearchStageLogsResponse.Match.prototype.getStageId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.DeleteApplicationRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.SearchStageLogsResponse.Match} returns this
  */
-proto.grpc.service.webservice.DeleteApplicationRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    applicationId: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
+proto.grpc.service.webservice.SearchStageLogsResponse.Match.prototype.setStageId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * optional int32 retried_count = 3;
+ * @return {number}
+ */
+proto.grpc.service.webservice.SearchStageLogsResponse.Match.prototype.getRetriedCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.DeleteApplicationRequest}
+ * @param {number} value
+ * @return {!proto.grpc.service.webservice.SearchStageLogsResponse.Match} returns this
  */
-proto.grpc.service.webservice.DeleteApplicationRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.DeleteApplicationRequest;
-  return proto.grpc.service.webservice.DeleteApplicationRequest.deserializeBinaryFromReader(msg, reader);
+proto.grpc.service.webservice.SearchStageLogsResponse.Match.prototype.setRetriedCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.DeleteApplicationRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.DeleteApplicationRequest}
+ * optional model.LogBlock block = 4;
+ * @return {?proto.model.LogBlock}
  */
-proto.grpc.service.webservice.DeleteApplicationRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setApplicationId(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.grpc.service.webservice.SearchStageLogsResponse.Match.prototype.getBlock = function() {
+  return /** @type{?proto.model.LogBlock} */ (
+    jspb.Message.getWrapperField(this, pkg_model_logblock_pb.LogBlock, 4));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {?proto.model.LogBlock|undefined} value
+ * @return {!proto.grpc.service.webservice.SearchStageLogsResponse.Match} returns this
+*/
+proto.grpc.service.webservice.SearchStageLogsResponse.Match.prototype.setBlock = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.grpc.service.webservice.SearchStageLogsResponse.Match} returns this
  */
-proto.grpc.service.webservice.DeleteApplicationRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.DeleteApplicationRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.grpc.service.webservice.SearchStageLogsResponse.Match.prototype.clearBlock = function() {
+  return this.setBlock(undefined);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.DeleteApplicationRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.grpc.service.webservice.DeleteApplicationRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getApplicationId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.grpc.service.webservice.SearchStageLogsResponse.Match.prototype.hasBlock = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * repeated Match matches = 1;
+ * @return {!Array<!proto.grpc.service.webservice.SearchStageLogsResponse.Match>}
+ */
+proto.grpc.service.webservice.SearchStageLogsResponse.prototype.getMatchesList = function() {
+  return /** @type{!Array<!proto.grpc.service.webservice.SearchStageLogsResponse.Match>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.grpc.service.webservice.SearchStageLogsResponse.Match, 1));
 };
 
 
 /**
- * optional string application_id = 1;
- * @return {string}
+ * @param {!Array<!proto.grpc.service.webservice.SearchStageLogsResponse.Match>} value
+ * @return {!proto.grpc.service.webservice.SearchStageLogsResponse} returns this
+*/
+proto.grpc.service.webservice.SearchStageLogsResponse.prototype.setMatchesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.grpc.service.webservice.SearchStageLogsResponse.Match=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.grpc.service.webservice.SearchStageLogsResponse.Match}
  */
-proto.grpc.service.webservice.DeleteApplicationRequest.prototype.getApplicationId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.grpc.service.webservice.SearchStageLogsResponse.prototype.addMatches = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.grpc.service.webservice.SearchStageLogsResponse.Match, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.DeleteApplicationRequest} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.SearchStageLogsResponse} returns this
  */
-proto.grpc.service.webservice.DeleteApplicationRequest.prototype.setApplicationId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.grpc.service.webservice.SearchStageLogsResponse.prototype.clearMatchesList = function() {
+  return this.setMatchesList([]);
 };
 
 
@@ -7398,8 +12501,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.DeleteApplicationResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.DeleteApplicationResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.CancelDeploymentRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.CancelDeploymentRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -7408,13 +12511,15 @@ proto.grpc.service.webservice.DeleteApplicationResponse.prototype.toObject = fun
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.DeleteApplicationResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.CancelDeploymentRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DeleteApplicationResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.CancelDeploymentRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    deploymentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    forceRollback: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    forceNoRollback: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -7428,29 +12533,41 @@ proto.grpc.service.webservice.DeleteApplicationResponse.toObject = function(incl
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.DeleteApplicationResponse}
+ * @return {!proto.grpc.service.webservice.CancelDeploymentRequest}
  */
-proto.grpc.service.webservice.DeleteApplicationResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.CancelDeploymentRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.DeleteApplicationResponse;
-  return proto.grpc.service.webservice.DeleteApplicationResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.CancelDeploymentRequest;
+  return proto.grpc.service.webservice.CancelDeploymentRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.DeleteApplicationResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.CancelDeploymentRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.DeleteApplicationResponse}
+ * @return {!proto.grpc.service.webservice.CancelDeploymentRequest}
  */
-proto.grpc.service.webservice.DeleteApplicationResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.CancelDeploymentRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDeploymentId(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setForceRollback(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setForceNoRollback(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -7464,9 +12581,9 @@ proto.grpc.service.webservice.DeleteApplicationResponse.deserializeBinaryFromRea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.DeleteApplicationResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.CancelDeploymentRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.DeleteApplicationResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.CancelDeploymentRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7474,12 +12591,87 @@ proto.grpc.service.webservice.DeleteApplicationResponse.prototype.serializeBinar
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.DeleteApplicationResponse} message
+ * @param {!proto.grpc.service.webservice.CancelDeploymentRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DeleteApplicationResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.CancelDeploymentRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getDeploymentId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getForceRollback();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getForceNoRollback();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string deployment_id = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.CancelDeploymentRequest.prototype.getDeploymentId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.CancelDeploymentRequest} returns this
+ */
+proto.grpc.service.webservice.CancelDeploymentRequest.prototype.setDeploymentId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bool force_rollback = 2;
+ * @return {boolean}
+ */
+proto.grpc.service.webservice.CancelDeploymentRequest.prototype.getForceRollback = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.grpc.service.webservice.CancelDeploymentRequest} returns this
+ */
+proto.grpc.service.webservice.CancelDeploymentRequest.prototype.setForceRollback = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional bool force_no_rollback = 3;
+ * @return {boolean}
+ */
+proto.grpc.service.webservice.CancelDeploymentRequest.prototype.getForceNoRollback = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.grpc.service.webservice.CancelDeploymentRequest} returns this
+ */
+proto.grpc.service.webservice.CancelDeploymentRequest.prototype.setForceNoRollback = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
 
@@ -7499,8 +12691,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ListApplicationsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListApplicationsRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.CancelDeploymentResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.CancelDeploymentResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -7509,13 +12701,13 @@ proto.grpc.service.webservice.ListApplicationsRequest.prototype.toObject = funct
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListApplicationsRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.CancelDeploymentResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListApplicationsRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.CancelDeploymentResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    options: (f = msg.getOptions()) && proto.grpc.service.webservice.ListApplicationsRequest.Options.toObject(includeInstance, f)
+    commandId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -7529,23 +12721,23 @@ proto.grpc.service.webservice.ListApplicationsRequest.toObject = function(includ
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListApplicationsRequest}
+ * @return {!proto.grpc.service.webservice.CancelDeploymentResponse}
  */
-proto.grpc.service.webservice.ListApplicationsRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.CancelDeploymentResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListApplicationsRequest;
-  return proto.grpc.service.webservice.ListApplicationsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.CancelDeploymentResponse;
+  return proto.grpc.service.webservice.CancelDeploymentResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListApplicationsRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.CancelDeploymentResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListApplicationsRequest}
+ * @return {!proto.grpc.service.webservice.CancelDeploymentResponse}
  */
-proto.grpc.service.webservice.ListApplicationsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.CancelDeploymentResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7553,9 +12745,8 @@ proto.grpc.service.webservice.ListApplicationsRequest.deserializeBinaryFromReade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.grpc.service.webservice.ListApplicationsRequest.Options;
-      reader.readMessage(value,proto.grpc.service.webservice.ListApplicationsRequest.Options.deserializeBinaryFromReader);
-      msg.setOptions(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommandId(value);
       break;
     default:
       reader.skipField();
@@ -7570,9 +12761,9 @@ proto.grpc.service.webservice.ListApplicationsRequest.deserializeBinaryFromReade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListApplicationsRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.CancelDeploymentResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListApplicationsRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.CancelDeploymentResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7580,30 +12771,40 @@ proto.grpc.service.webservice.ListApplicationsRequest.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListApplicationsRequest} message
+ * @param {!proto.grpc.service.webservice.CancelDeploymentResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListApplicationsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.CancelDeploymentResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getOptions();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getCommandId();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      proto.grpc.service.webservice.ListApplicationsRequest.Options.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
+/**
+ * optional string command_id = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.CancelDeploymentResponse.prototype.getCommandId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.CancelDeploymentResponse} returns this
  */
-proto.grpc.service.webservice.ListApplicationsRequest.Options.repeatedFields_ = [2,3];
+proto.grpc.service.webservice.CancelDeploymentResponse.prototype.setCommandId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
 
 
 
@@ -7620,8 +12821,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListApplicationsRequest.Options.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.SkipStageRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.SkipStageRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -7630,18 +12831,14 @@ proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.toObject
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListApplicationsRequest.Options} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.SkipStageRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListApplicationsRequest.Options.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.SkipStageRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enabled: (f = msg.getEnabled()) && google_protobuf_wrappers_pb.BoolValue.toObject(includeInstance, f),
-    kindsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
-    syncStatusesList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
-    name: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : [],
-    pipedId: jspb.Message.getFieldWithDefault(msg, 7, "")
+    deploymentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    stageId: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -7655,59 +12852,36 @@ proto.grpc.service.webservice.ListApplicationsRequest.Options.toObject = functio
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options}
+ * @return {!proto.grpc.service.webservice.SkipStageRequest}
  */
-proto.grpc.service.webservice.ListApplicationsRequest.Options.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.SkipStageRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListApplicationsRequest.Options;
-  return proto.grpc.service.webservice.ListApplicationsRequest.Options.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.SkipStageRequest;
+  return proto.grpc.service.webservice.SkipStageRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListApplicationsRequest.Options} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.SkipStageRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options}
+ * @return {!proto.grpc.service.webservice.SkipStageRequest}
  */
-proto.grpc.service.webservice.ListApplicationsRequest.Options.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.SkipStageRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = new google_protobuf_wrappers_pb.BoolValue;
-      reader.readMessage(value,google_protobuf_wrappers_pb.BoolValue.deserializeBinaryFromReader);
-      msg.setEnabled(value);
-      break;
-    case 2:
-      var values = /** @type {!Array<!proto.model.ApplicationKind>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addKinds(values[i]);
-      }
-      break;
-    case 3:
-      var values = /** @type {!Array<!proto.model.ApplicationSyncStatus>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addSyncStatuses(values[i]);
-      }
-      break;
-    case 5:
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 6:
-      var value = msg.getLabelsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
+      msg.setDeploymentId(value);
       break;
-    case 7:
+    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPipedId(value);
+      msg.setStageId(value);
       break;
     default:
       reader.skipField();
@@ -7722,9 +12896,9 @@ proto.grpc.service.webservice.ListApplicationsRequest.Options.deserializeBinaryF
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.SkipStageRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListApplicationsRequest.Options.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.SkipStageRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7732,49 +12906,23 @@ proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.serializ
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListApplicationsRequest.Options} message
+ * @param {!proto.grpc.service.webservice.SkipStageRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListApplicationsRequest.Options.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.SkipStageRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnabled();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      google_protobuf_wrappers_pb.BoolValue.serializeBinaryToWriter
-    );
-  }
-  f = message.getKindsList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
-      2,
-      f
-    );
-  }
-  f = message.getSyncStatusesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
-      3,
-      f
-    );
-  }
-  f = message.getName();
+  f = message.getDeploymentId();
   if (f.length > 0) {
     writer.writeString(
-      5,
+      1,
       f
     );
   }
-  f = message.getLabelsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(6, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
-  }
-  f = message.getPipedId();
+  f = message.getStageId();
   if (f.length > 0) {
     writer.writeString(
-      7,
+      2,
       f
     );
   }
@@ -7782,220 +12930,172 @@ proto.grpc.service.webservice.ListApplicationsRequest.Options.serializeBinaryToW
 
 
 /**
- * optional google.protobuf.BoolValue enabled = 1;
- * @return {?proto.google.protobuf.BoolValue}
- */
-proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.getEnabled = function() {
-  return /** @type{?proto.google.protobuf.BoolValue} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_wrappers_pb.BoolValue, 1));
-};
-
-
-/**
- * @param {?proto.google.protobuf.BoolValue|undefined} value
- * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options} returns this
-*/
-proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.setEnabled = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options} returns this
- */
-proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.clearEnabled = function() {
-  return this.setEnabled(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.hasEnabled = function() {
-  return jspb.Message.getField(this, 1) != null;
-};
-
-
-/**
- * repeated model.ApplicationKind kinds = 2;
- * @return {!Array<!proto.model.ApplicationKind>}
- */
-proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.getKindsList = function() {
-  return /** @type {!Array<!proto.model.ApplicationKind>} */ (jspb.Message.getRepeatedField(this, 2));
-};
-
-
-/**
- * @param {!Array<!proto.model.ApplicationKind>} value
- * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options} returns this
- */
-proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.setKindsList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
-};
-
-
-/**
- * @param {!proto.model.ApplicationKind} value
- * @param {number=} opt_index
- * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options} returns this
- */
-proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.addKinds = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options} returns this
+ * optional string deployment_id = 1;
+ * @return {string}
  */
-proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.clearKindsList = function() {
-  return this.setKindsList([]);
+proto.grpc.service.webservice.SkipStageRequest.prototype.getDeploymentId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * repeated model.ApplicationSyncStatus sync_statuses = 3;
- * @return {!Array<!proto.model.ApplicationSyncStatus>}
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.SkipStageRequest} returns this
  */
-proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.getSyncStatusesList = function() {
-  return /** @type {!Array<!proto.model.ApplicationSyncStatus>} */ (jspb.Message.getRepeatedField(this, 3));
+proto.grpc.service.webservice.SkipStageRequest.prototype.setDeploymentId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {!Array<!proto.model.ApplicationSyncStatus>} value
- * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options} returns this
+ * optional string stage_id = 2;
+ * @return {string}
  */
-proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.setSyncStatusesList = function(value) {
-  return jspb.Message.setField(this, 3, value || []);
+proto.grpc.service.webservice.SkipStageRequest.prototype.getStageId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {!proto.model.ApplicationSyncStatus} value
- * @param {number=} opt_index
- * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options} returns this
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.SkipStageRequest} returns this
  */
-proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.addSyncStatuses = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+proto.grpc.service.webservice.SkipStageRequest.prototype.setStageId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options} returns this
- */
-proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.clearSyncStatusesList = function() {
-  return this.setSyncStatusesList([]);
-};
-
 
-/**
- * optional string name = 5;
- * @return {string}
- */
-proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.grpc.service.webservice.SkipStageResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.SkipStageResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * map<string, string> labels = 6;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.SkipStageResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.getLabelsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 6, opt_noLazyCreate,
-      null));
-};
-
+proto.grpc.service.webservice.SkipStageResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    commandId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
 
-/**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options} returns this
- */
-proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.clearLabelsMap = function() {
-  this.getLabelsMap().clear();
-  return this;
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional string piped_id = 7;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.SkipStageResponse}
  */
-proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.getPipedId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.grpc.service.webservice.SkipStageResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.SkipStageResponse;
+  return proto.grpc.service.webservice.SkipStageResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.ListApplicationsRequest.Options} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.SkipStageResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.SkipStageResponse}
  */
-proto.grpc.service.webservice.ListApplicationsRequest.Options.prototype.setPipedId = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
+proto.grpc.service.webservice.SkipStageResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommandId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional Options options = 1;
- * @return {?proto.grpc.service.webservice.ListApplicationsRequest.Options}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListApplicationsRequest.prototype.getOptions = function() {
-  return /** @type{?proto.grpc.service.webservice.ListApplicationsRequest.Options} */ (
-    jspb.Message.getWrapperField(this, proto.grpc.service.webservice.ListApplicationsRequest.Options, 1));
+proto.grpc.service.webservice.SkipStageResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.SkipStageResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {?proto.grpc.service.webservice.ListApplicationsRequest.Options|undefined} value
- * @return {!proto.grpc.service.webservice.ListApplicationsRequest} returns this
-*/
-proto.grpc.service.webservice.ListApplicationsRequest.prototype.setOptions = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.SkipStageResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.SkipStageResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCommandId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.grpc.service.webservice.ListApplicationsRequest} returns this
+ * optional string command_id = 1;
+ * @return {string}
  */
-proto.grpc.service.webservice.ListApplicationsRequest.prototype.clearOptions = function() {
-  return this.setOptions(undefined);
+proto.grpc.service.webservice.SkipStageResponse.prototype.getCommandId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.SkipStageResponse} returns this
  */
-proto.grpc.service.webservice.ListApplicationsRequest.prototype.hasOptions = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.grpc.service.webservice.SkipStageResponse.prototype.setCommandId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.grpc.service.webservice.ListApplicationsResponse.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -8011,8 +13111,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ListApplicationsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListApplicationsResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.RetryStageRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.RetryStageRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8021,14 +13121,14 @@ proto.grpc.service.webservice.ListApplicationsResponse.prototype.toObject = func
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListApplicationsResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.RetryStageRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListApplicationsResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.RetryStageRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    applicationsList: jspb.Message.toObjectList(msg.getApplicationsList(),
-    pkg_model_application_pb.Application.toObject, includeInstance)
+    deploymentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    stageId: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -8042,23 +13142,23 @@ proto.grpc.service.webservice.ListApplicationsResponse.toObject = function(inclu
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListApplicationsResponse}
+ * @return {!proto.grpc.service.webservice.RetryStageRequest}
  */
-proto.grpc.service.webservice.ListApplicationsResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.RetryStageRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListApplicationsResponse;
-  return proto.grpc.service.webservice.ListApplicationsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.RetryStageRequest;
+  return proto.grpc.service.webservice.RetryStageRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListApplicationsResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.RetryStageRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListApplicationsResponse}
+ * @return {!proto.grpc.service.webservice.RetryStageRequest}
  */
-proto.grpc.service.webservice.ListApplicationsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.RetryStageRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8066,9 +13166,12 @@ proto.grpc.service.webservice.ListApplicationsResponse.deserializeBinaryFromRead
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new pkg_model_application_pb.Application;
-      reader.readMessage(value,pkg_model_application_pb.Application.deserializeBinaryFromReader);
-      msg.addApplications(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDeploymentId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStageId(value);
       break;
     default:
       reader.skipField();
@@ -8083,9 +13186,9 @@ proto.grpc.service.webservice.ListApplicationsResponse.deserializeBinaryFromRead
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListApplicationsResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.RetryStageRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListApplicationsResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.RetryStageRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8093,58 +13196,62 @@ proto.grpc.service.webservice.ListApplicationsResponse.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListApplicationsResponse} message
+ * @param {!proto.grpc.service.webservice.RetryStageRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListApplicationsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.RetryStageRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getApplicationsList();
+  f = message.getDeploymentId();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      pkg_model_application_pb.Application.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getStageId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
     );
   }
 };
 
 
 /**
- * repeated model.Application applications = 1;
- * @return {!Array<!proto.model.Application>}
+ * optional string deployment_id = 1;
+ * @return {string}
  */
-proto.grpc.service.webservice.ListApplicationsResponse.prototype.getApplicationsList = function() {
-  return /** @type{!Array<!proto.model.Application>} */ (
-    jspb.Message.getRepeatedWrapperField(this, pkg_model_application_pb.Application, 1));
+proto.grpc.service.webservice.RetryStageRequest.prototype.getDeploymentId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<!proto.model.Application>} value
- * @return {!proto.grpc.service.webservice.ListApplicationsResponse} returns this
-*/
-proto.grpc.service.webservice.ListApplicationsResponse.prototype.setApplicationsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.RetryStageRequest} returns this
+ */
+proto.grpc.service.webservice.RetryStageRequest.prototype.setDeploymentId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.model.Application=} opt_value
- * @param {number=} opt_index
- * @return {!proto.model.Application}
+ * optional string stage_id = 2;
+ * @return {string}
  */
-proto.grpc.service.webservice.ListApplicationsResponse.prototype.addApplications = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.Application, opt_index);
+proto.grpc.service.webservice.RetryStageRequest.prototype.getStageId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.grpc.service.webservice.ListApplicationsResponse} returns this
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.RetryStageRequest} returns this
  */
-proto.grpc.service.webservice.ListApplicationsResponse.prototype.clearApplicationsList = function() {
-  return this.setApplicationsList([]);
+proto.grpc.service.webservice.RetryStageRequest.prototype.setStageId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -8164,8 +13271,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.SyncApplicationRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.SyncApplicationRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.RetryStageResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.RetryStageResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -8174,14 +13281,13 @@ proto.grpc.service.webservice.SyncApplicationRequest.prototype.toObject = functi
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.SyncApplicationRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.RetryStageResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.SyncApplicationRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.RetryStageResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    applicationId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    syncStrategy: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    commandId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -8195,23 +13301,23 @@ proto.grpc.service.webservice.SyncApplicationRequest.toObject = function(include
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.SyncApplicationRequest}
+ * @return {!proto.grpc.service.webservice.RetryStageResponse}
  */
-proto.grpc.service.webservice.SyncApplicationRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.RetryStageResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.SyncApplicationRequest;
-  return proto.grpc.service.webservice.SyncApplicationRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.RetryStageResponse;
+  return proto.grpc.service.webservice.RetryStageResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.SyncApplicationRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.RetryStageResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.SyncApplicationRequest}
+ * @return {!proto.grpc.service.webservice.RetryStageResponse}
  */
-proto.grpc.service.webservice.SyncApplicationRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.RetryStageResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8220,11 +13326,7 @@ proto.grpc.service.webservice.SyncApplicationRequest.deserializeBinaryFromReader
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setApplicationId(value);
-      break;
-    case 2:
-      var value = /** @type {!proto.model.SyncStrategy} */ (reader.readEnum());
-      msg.setSyncStrategy(value);
+      msg.setCommandId(value);
       break;
     default:
       reader.skipField();
@@ -8239,9 +13341,9 @@ proto.grpc.service.webservice.SyncApplicationRequest.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.SyncApplicationRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.RetryStageResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.SyncApplicationRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.RetryStageResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8249,65 +13351,40 @@ proto.grpc.service.webservice.SyncApplicationRequest.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.SyncApplicationRequest} message
+ * @param {!proto.grpc.service.webservice.RetryStageResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.SyncApplicationRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.RetryStageResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getApplicationId();
+  f = message.getCommandId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getSyncStrategy();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      2,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string application_id = 1;
+ * optional string command_id = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.SyncApplicationRequest.prototype.getApplicationId = function() {
+proto.grpc.service.webservice.RetryStageResponse.prototype.getCommandId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.SyncApplicationRequest} returns this
+ * @return {!proto.grpc.service.webservice.RetryStageResponse} returns this
  */
-proto.grpc.service.webservice.SyncApplicationRequest.prototype.setApplicationId = function(value) {
+proto.grpc.service.webservice.RetryStageResponse.prototype.setCommandId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * optional model.SyncStrategy sync_strategy = 2;
- * @return {!proto.model.SyncStrategy}
- */
-proto.grpc.service.webservice.SyncApplicationRequest.prototype.getSyncStrategy = function() {
-  return /** @type {!proto.model.SyncStrategy} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {!proto.model.SyncStrategy} value
- * @return {!proto.grpc.service.webservice.SyncApplicationRequest} returns this
- */
-proto.grpc.service.webservice.SyncApplicationRequest.prototype.setSyncStrategy = function(value) {
-  return jspb.Message.setProto3EnumField(this, 2, value);
-};
-
-
 
 
 
@@ -8324,8 +13401,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.SyncApplicationResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.SyncApplicationResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ApproveStageRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ApproveStageRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8334,13 +13411,14 @@ proto.grpc.service.webservice.SyncApplicationResponse.prototype.toObject = funct
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.SyncApplicationResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ApproveStageRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.SyncApplicationResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ApproveStageRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    commandId: jspb.Message.getFieldWithDefault(msg, 1, "")
+    deploymentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    stageId: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -8354,23 +13432,23 @@ proto.grpc.service.webservice.SyncApplicationResponse.toObject = function(includ
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.SyncApplicationResponse}
+ * @return {!proto.grpc.service.webservice.ApproveStageRequest}
  */
-proto.grpc.service.webservice.SyncApplicationResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ApproveStageRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.SyncApplicationResponse;
-  return proto.grpc.service.webservice.SyncApplicationResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ApproveStageRequest;
+  return proto.grpc.service.webservice.ApproveStageRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.SyncApplicationResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ApproveStageRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.SyncApplicationResponse}
+ * @return {!proto.grpc.service.webservice.ApproveStageRequest}
  */
-proto.grpc.service.webservice.SyncApplicationResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ApproveStageRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8379,7 +13457,11 @@ proto.grpc.service.webservice.SyncApplicationResponse.deserializeBinaryFromReade
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setCommandId(value);
+      msg.setDeploymentId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStageId(value);
       break;
     default:
       reader.skipField();
@@ -8394,9 +13476,9 @@ proto.grpc.service.webservice.SyncApplicationResponse.deserializeBinaryFromReade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.SyncApplicationResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ApproveStageRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.SyncApplicationResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ApproveStageRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8404,40 +13486,65 @@ proto.grpc.service.webservice.SyncApplicationResponse.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.SyncApplicationResponse} message
+ * @param {!proto.grpc.service.webservice.ApproveStageRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.SyncApplicationResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ApproveStageRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCommandId();
+  f = message.getDeploymentId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getStageId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string command_id = 1;
+ * optional string deployment_id = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.SyncApplicationResponse.prototype.getCommandId = function() {
+proto.grpc.service.webservice.ApproveStageRequest.prototype.getDeploymentId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.SyncApplicationResponse} returns this
+ * @return {!proto.grpc.service.webservice.ApproveStageRequest} returns this
  */
-proto.grpc.service.webservice.SyncApplicationResponse.prototype.setCommandId = function(value) {
+proto.grpc.service.webservice.ApproveStageRequest.prototype.setDeploymentId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional string stage_id = 2;
+ * @return {string}
+ */
+proto.grpc.service.webservice.ApproveStageRequest.prototype.getStageId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.ApproveStageRequest} returns this
+ */
+proto.grpc.service.webservice.ApproveStageRequest.prototype.setStageId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
 
 
 
@@ -8454,8 +13561,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.GetApplicationRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GetApplicationRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ApproveStageResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ApproveStageResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -8464,13 +13571,13 @@ proto.grpc.service.webservice.GetApplicationRequest.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GetApplicationRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ApproveStageResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetApplicationRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ApproveStageResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    applicationId: jspb.Message.getFieldWithDefault(msg, 1, "")
+    commandId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -8484,23 +13591,23 @@ proto.grpc.service.webservice.GetApplicationRequest.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GetApplicationRequest}
+ * @return {!proto.grpc.service.webservice.ApproveStageResponse}
  */
-proto.grpc.service.webservice.GetApplicationRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ApproveStageResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GetApplicationRequest;
-  return proto.grpc.service.webservice.GetApplicationRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ApproveStageResponse;
+  return proto.grpc.service.webservice.ApproveStageResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GetApplicationRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ApproveStageResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GetApplicationRequest}
+ * @return {!proto.grpc.service.webservice.ApproveStageResponse}
  */
-proto.grpc.service.webservice.GetApplicationRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ApproveStageResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8509,7 +13616,7 @@ proto.grpc.service.webservice.GetApplicationRequest.deserializeBinaryFromReader
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setApplicationId(value);
+      msg.setCommandId(value);
       break;
     default:
       reader.skipField();
@@ -8524,9 +13631,9 @@ proto.grpc.service.webservice.GetApplicationRequest.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GetApplicationRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ApproveStageResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GetApplicationRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ApproveStageResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8534,13 +13641,13 @@ proto.grpc.service.webservice.GetApplicationRequest.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GetApplicationRequest} message
+ * @param {!proto.grpc.service.webservice.ApproveStageResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetApplicationRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ApproveStageResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getApplicationId();
+  f = message.getCommandId();
   if (f.length > 0) {
     writer.writeString(
       1,
@@ -8551,19 +13658,19 @@ proto.grpc.service.webservice.GetApplicationRequest.serializeBinaryToWriter = fu
 
 
 /**
- * optional string application_id = 1;
+ * optional string command_id = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.GetApplicationRequest.prototype.getApplicationId = function() {
+proto.grpc.service.webservice.ApproveStageResponse.prototype.getCommandId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.GetApplicationRequest} returns this
+ * @return {!proto.grpc.service.webservice.ApproveStageResponse} returns this
  */
-proto.grpc.service.webservice.GetApplicationRequest.prototype.setApplicationId = function(value) {
+proto.grpc.service.webservice.ApproveStageResponse.prototype.setCommandId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -8584,8 +13691,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.GetApplicationResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GetApplicationResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListDeploymentTracesRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -8594,13 +13701,16 @@ proto.grpc.service.webservice.GetApplicationResponse.prototype.toObject = functi
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GetApplicationResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ListDeploymentTracesRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetApplicationResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ListDeploymentTracesRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    application: (f = msg.getApplication()) && pkg_model_application_pb.Application.toObject(includeInstance, f)
+    options: (f = msg.getOptions()) && proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.toObject(includeInstance, f),
+    pageSize: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    cursor: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    pageMinUpdatedAt: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -8614,23 +13724,23 @@ proto.grpc.service.webservice.GetApplicationResponse.toObject = function(include
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GetApplicationResponse}
+ * @return {!proto.grpc.service.webservice.ListDeploymentTracesRequest}
  */
-proto.grpc.service.webservice.GetApplicationResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ListDeploymentTracesRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GetApplicationResponse;
-  return proto.grpc.service.webservice.GetApplicationResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ListDeploymentTracesRequest;
+  return proto.grpc.service.webservice.ListDeploymentTracesRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GetApplicationResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ListDeploymentTracesRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GetApplicationResponse}
+ * @return {!proto.grpc.service.webservice.ListDeploymentTracesRequest}
  */
-proto.grpc.service.webservice.GetApplicationResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ListDeploymentTracesRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8638,9 +13748,21 @@ proto.grpc.service.webservice.GetApplicationResponse.deserializeBinaryFromReader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new pkg_model_application_pb.Application;
-      reader.readMessage(value,pkg_model_application_pb.Application.deserializeBinaryFromReader);
-      msg.setApplication(value);
+      var value = new proto.grpc.service.webservice.ListDeploymentTracesRequest.Options;
+      reader.readMessage(value,proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.deserializeBinaryFromReader);
+      msg.setOptions(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPageSize(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCursor(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setPageMinUpdatedAt(value);
       break;
     default:
       reader.skipField();
@@ -8655,9 +13777,9 @@ proto.grpc.service.webservice.GetApplicationResponse.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GetApplicationResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GetApplicationResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ListDeploymentTracesRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8665,57 +13787,41 @@ proto.grpc.service.webservice.GetApplicationResponse.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GetApplicationResponse} message
+ * @param {!proto.grpc.service.webservice.ListDeploymentTracesRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetApplicationResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ListDeploymentTracesRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getApplication();
+  f = message.getOptions();
   if (f != null) {
     writer.writeMessage(
       1,
       f,
-      pkg_model_application_pb.Application.serializeBinaryToWriter
+      proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.serializeBinaryToWriter
+    );
+  }
+  f = message.getPageSize();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getCursor();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getPageMinUpdatedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
     );
   }
-};
-
-
-/**
- * optional model.Application application = 1;
- * @return {?proto.model.Application}
- */
-proto.grpc.service.webservice.GetApplicationResponse.prototype.getApplication = function() {
-  return /** @type{?proto.model.Application} */ (
-    jspb.Message.getWrapperField(this, pkg_model_application_pb.Application, 1));
-};
-
-
-/**
- * @param {?proto.model.Application|undefined} value
- * @return {!proto.grpc.service.webservice.GetApplicationResponse} returns this
-*/
-proto.grpc.service.webservice.GetApplicationResponse.prototype.setApplication = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.grpc.service.webservice.GetApplicationResponse} returns this
- */
-proto.grpc.service.webservice.GetApplicationResponse.prototype.clearApplication = function() {
-  return this.setApplication(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.grpc.service.webservice.GetApplicationResponse.prototype.hasApplication = function() {
-  return jspb.Message.getField(this, 1) != null;
 };
 
 
@@ -8735,8 +13841,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.toObject(opt_includeInstance, this);
 };
 
 
@@ -8745,15 +13851,13 @@ proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.prototype.t
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ListDeploymentTracesRequest.Options} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.toObject = function(includeInstance, msg) {
   var f, obj = {
-    pipedId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    data: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    base64Encoding: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+    commitHash: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -8767,23 +13871,23 @@ proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.toObject =
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest}
+ * @return {!proto.grpc.service.webservice.ListDeploymentTracesRequest.Options}
  */
-proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest;
-  return proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ListDeploymentTracesRequest.Options;
+  return proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ListDeploymentTracesRequest.Options} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest}
+ * @return {!proto.grpc.service.webservice.ListDeploymentTracesRequest.Options}
  */
-proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8792,15 +13896,7 @@ proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.deserialize
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPipedId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setData(value);
-      break;
-    case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setBase64Encoding(value);
+      msg.setCommitHash(value);
       break;
     default:
       reader.skipField();
@@ -8815,9 +13911,9 @@ proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.deserialize
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8825,91 +13921,139 @@ proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.prototype.s
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest} message
+ * @param {!proto.grpc.service.webservice.ListDeploymentTracesRequest.Options} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPipedId();
+  f = message.getCommitHash();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getData();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getBase64Encoding();
-  if (f) {
-    writer.writeBool(
-      3,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string piped_id = 1;
- * @return {string}
+ * optional string commit_hash = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.prototype.getCommitHash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.ListDeploymentTracesRequest.Options} returns this
+ */
+proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.prototype.setCommitHash = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional Options options = 1;
+ * @return {?proto.grpc.service.webservice.ListDeploymentTracesRequest.Options}
+ */
+proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.getOptions = function() {
+  return /** @type{?proto.grpc.service.webservice.ListDeploymentTracesRequest.Options} */ (
+    jspb.Message.getWrapperField(this, proto.grpc.service.webservice.ListDeploymentTracesRequest.Options, 1));
+};
+
+
+/**
+ * @param {?proto.grpc.service.webservice.ListDeploymentTracesRequest.Options|undefined} value
+ * @return {!proto.grpc.service.webservice.ListDeploymentTracesRequest} returns this
+*/
+proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.setOptions = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.grpc.service.webservice.ListDeploymentTracesRequest} returns this
  */
-proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.prototype.getPipedId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.clearOptions = function() {
+  return this.setOptions(undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.prototype.setPipedId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.hasOptions = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * optional string data = 2;
+ * optional int32 page_size = 2;
+ * @return {number}
+ */
+proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.getPageSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.grpc.service.webservice.ListDeploymentTracesRequest} returns this
+ */
+proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.setPageSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string cursor = 3;
  * @return {string}
  */
-proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.prototype.getData = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.getCursor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest} returns this
+ * @return {!proto.grpc.service.webservice.ListDeploymentTracesRequest} returns this
  */
-proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.prototype.setData = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.setCursor = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional bool base64_encoding = 3;
- * @return {boolean}
+ * optional int64 page_min_updated_at = 4;
+ * @return {number}
  */
-proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.prototype.getBase64Encoding = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.getPageMinUpdatedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest} returns this
+ * @param {number} value
+ * @return {!proto.grpc.service.webservice.ListDeploymentTracesRequest} returns this
  */
-proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest.prototype.setBase64Encoding = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
+proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.setPageMinUpdatedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.grpc.service.webservice.ListDeploymentTracesResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -8925,8 +14069,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListDeploymentTracesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListDeploymentTracesResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -8935,13 +14079,15 @@ proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.prototype.
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ListDeploymentTracesResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ListDeploymentTracesResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    data: jspb.Message.getFieldWithDefault(msg, 1, "")
+    tracesList: jspb.Message.toObjectList(msg.getTracesList(),
+    proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.toObject, includeInstance),
+    cursor: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -8955,23 +14101,23 @@ proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.toObject =
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse}
+ * @return {!proto.grpc.service.webservice.ListDeploymentTracesResponse}
  */
-proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ListDeploymentTracesResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse;
-  return proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ListDeploymentTracesResponse;
+  return proto.grpc.service.webservice.ListDeploymentTracesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ListDeploymentTracesResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse}
+ * @return {!proto.grpc.service.webservice.ListDeploymentTracesResponse}
  */
-proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ListDeploymentTracesResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8979,8 +14125,13 @@ proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.deserializ
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
+      var value = new proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes;
+      reader.readMessage(value,proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.deserializeBinaryFromReader);
+      msg.addTraces(value);
+      break;
+    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setData(value);
+      msg.setCursor(value);
       break;
     default:
       reader.skipField();
@@ -8995,9 +14146,9 @@ proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.deserializ
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ListDeploymentTracesResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ListDeploymentTracesResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9005,40 +14156,37 @@ proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.prototype.
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse} message
+ * @param {!proto.grpc.service.webservice.ListDeploymentTracesResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ListDeploymentTracesResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getData();
+  f = message.getTracesList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       1,
+      f,
+      proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.serializeBinaryToWriter
+    );
+  }
+  f = message.getCursor();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
       f
     );
   }
 };
 
 
-/**
- * optional string data = 1;
- * @return {string}
- */
-proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.prototype.getData = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
 
 /**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse.prototype.setData = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
+proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.repeatedFields_ = [2];
 
 
 
@@ -9055,8 +14203,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ListUnregisteredApplicationsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListUnregisteredApplicationsRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.toObject(opt_includeInstance, this);
 };
 
 
@@ -9065,13 +14213,15 @@ proto.grpc.service.webservice.ListUnregisteredApplicationsRequest.prototype.toOb
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListUnregisteredApplicationsRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListUnregisteredApplicationsRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    trace: (f = msg.getTrace()) && pkg_model_deployment_trace_pb.DeploymentTrace.toObject(includeInstance, f),
+    deploymentsList: jspb.Message.toObjectList(msg.getDeploymentsList(),
+    pkg_model_deployment_pb.Deployment.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -9085,29 +14235,39 @@ proto.grpc.service.webservice.ListUnregisteredApplicationsRequest.toObject = fun
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListUnregisteredApplicationsRequest}
+ * @return {!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes}
  */
-proto.grpc.service.webservice.ListUnregisteredApplicationsRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListUnregisteredApplicationsRequest;
-  return proto.grpc.service.webservice.ListUnregisteredApplicationsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes;
+  return proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListUnregisteredApplicationsRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListUnregisteredApplicationsRequest}
+ * @return {!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes}
  */
-proto.grpc.service.webservice.ListUnregisteredApplicationsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = new pkg_model_deployment_trace_pb.DeploymentTrace;
+      reader.readMessage(value,pkg_model_deployment_trace_pb.DeploymentTrace.deserializeBinaryFromReader);
+      msg.setTrace(value);
+      break;
+    case 2:
+      var value = new pkg_model_deployment_pb.Deployment;
+      reader.readMessage(value,pkg_model_deployment_pb.Deployment.deserializeBinaryFromReader);
+      msg.addDeployments(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -9121,9 +14281,9 @@ proto.grpc.service.webservice.ListUnregisteredApplicationsRequest.deserializeBin
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListUnregisteredApplicationsRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListUnregisteredApplicationsRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9131,172 +14291,159 @@ proto.grpc.service.webservice.ListUnregisteredApplicationsRequest.prototype.seri
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListUnregisteredApplicationsRequest} message
+ * @param {!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListUnregisteredApplicationsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getTrace();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      pkg_model_deployment_trace_pb.DeploymentTrace.serializeBinaryToWriter
+    );
+  }
+  f = message.getDeploymentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      pkg_model_deployment_pb.Deployment.serializeBinaryToWriter
+    );
+  }
 };
 
 
-
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.repeatedFields_ = [1];
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional model.DeploymentTrace trace = 1;
+ * @return {?proto.model.DeploymentTrace}
  */
-proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.prototype.getTrace = function() {
+  return /** @type{?proto.model.DeploymentTrace} */ (
+    jspb.Message.getWrapperField(this, pkg_model_deployment_trace_pb.DeploymentTrace, 1));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListUnregisteredApplicationsResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    applicationsList: jspb.Message.toObjectList(msg.getApplicationsList(),
-    pkg_model_common_pb.ApplicationInfo.toObject, includeInstance)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+ * @param {?proto.model.DeploymentTrace|undefined} value
+ * @return {!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes} returns this
+*/
+proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.prototype.setTrace = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes} returns this
+ */
+proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.prototype.clearTrace = function() {
+  return this.setTrace(undefined);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListUnregisteredApplicationsResponse}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListUnregisteredApplicationsResponse;
-  return proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.deserializeBinaryFromReader(msg, reader);
+proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.prototype.hasTrace = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListUnregisteredApplicationsResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListUnregisteredApplicationsResponse}
+ * repeated model.Deployment deployments = 2;
+ * @return {!Array<!proto.model.Deployment>}
  */
-proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = new pkg_model_common_pb.ApplicationInfo;
-      reader.readMessage(value,pkg_model_common_pb.ApplicationInfo.deserializeBinaryFromReader);
-      msg.addApplications(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.prototype.getDeploymentsList = function() {
+  return /** @type{!Array<!proto.model.Deployment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, pkg_model_deployment_pb.Deployment, 2));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {!Array<!proto.model.Deployment>} value
+ * @return {!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes} returns this
+*/
+proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.prototype.setDeploymentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.model.Deployment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.Deployment}
  */
-proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.prototype.addDeployments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.model.Deployment, opt_index);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListUnregisteredApplicationsResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes} returns this
  */
-proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getApplicationsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      1,
-      f,
-      pkg_model_common_pb.ApplicationInfo.serializeBinaryToWriter
-    );
-  }
+proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.prototype.clearDeploymentsList = function() {
+  return this.setDeploymentsList([]);
 };
 
 
 /**
- * repeated model.ApplicationInfo applications = 1;
- * @return {!Array<!proto.model.ApplicationInfo>}
+ * repeated DeploymentTraceRes traces = 1;
+ * @return {!Array<!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes>}
  */
-proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.prototype.getApplicationsList = function() {
-  return /** @type{!Array<!proto.model.ApplicationInfo>} */ (
-    jspb.Message.getRepeatedWrapperField(this, pkg_model_common_pb.ApplicationInfo, 1));
+proto.grpc.service.webservice.ListDeploymentTracesResponse.prototype.getTracesList = function() {
+  return /** @type{!Array<!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes, 1));
 };
 
 
 /**
- * @param {!Array<!proto.model.ApplicationInfo>} value
- * @return {!proto.grpc.service.webservice.ListUnregisteredApplicationsResponse} returns this
+ * @param {!Array<!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes>} value
+ * @return {!proto.grpc.service.webservice.ListDeploymentTracesResponse} returns this
 */
-proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.prototype.setApplicationsList = function(value) {
+proto.grpc.service.webservice.ListDeploymentTracesResponse.prototype.setTracesList = function(value) {
   return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.model.ApplicationInfo=} opt_value
+ * @param {!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes=} opt_value
  * @param {number=} opt_index
- * @return {!proto.model.ApplicationInfo}
+ * @return {!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes}
  */
-proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.prototype.addApplications = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.ApplicationInfo, opt_index);
+proto.grpc.service.webservice.ListDeploymentTracesResponse.prototype.addTraces = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.grpc.service.webservice.ListUnregisteredApplicationsResponse} returns this
+ * @return {!proto.grpc.service.webservice.ListDeploymentTracesResponse} returns this
  */
-proto.grpc.service.webservice.ListUnregisteredApplicationsResponse.prototype.clearApplicationsList = function() {
-  return this.setApplicationsList([]);
+proto.grpc.service.webservice.ListDeploymentTracesResponse.prototype.clearTracesList = function() {
+  return this.setTracesList([]);
+};
+
+
+/**
+ * optional string cursor = 2;
+ * @return {string}
+ */
+proto.grpc.service.webservice.ListDeploymentTracesResponse.prototype.getCursor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.ListDeploymentTracesResponse} returns this
+ */
+proto.grpc.service.webservice.ListDeploymentTracesResponse.prototype.setCursor = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -9316,8 +14463,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListDeploymentsRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.GetApplicationLiveStateRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GetApplicationLiveStateRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -9326,16 +14473,13 @@ proto.grpc.service.webservice.ListDeploymentsRequest.prototype.toObject = functi
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListDeploymentsRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.GetApplicationLiveStateRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.GetApplicationLiveStateRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    options: (f = msg.getOptions()) && proto.grpc.service.webservice.ListDeploymentsRequest.Options.toObject(includeInstance, f),
-    pageSize: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    cursor: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    pageMinUpdatedAt: jspb.Message.getFieldWithDefault(msg, 4, 0)
+    applicationId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -9349,23 +14493,23 @@ proto.grpc.service.webservice.ListDeploymentsRequest.toObject = function(include
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListDeploymentsRequest}
+ * @return {!proto.grpc.service.webservice.GetApplicationLiveStateRequest}
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.GetApplicationLiveStateRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListDeploymentsRequest;
-  return proto.grpc.service.webservice.ListDeploymentsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.GetApplicationLiveStateRequest;
+  return proto.grpc.service.webservice.GetApplicationLiveStateRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListDeploymentsRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.GetApplicationLiveStateRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListDeploymentsRequest}
+ * @return {!proto.grpc.service.webservice.GetApplicationLiveStateRequest}
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.GetApplicationLiveStateRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9373,21 +14517,8 @@ proto.grpc.service.webservice.ListDeploymentsRequest.deserializeBinaryFromReader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.grpc.service.webservice.ListDeploymentsRequest.Options;
-      reader.readMessage(value,proto.grpc.service.webservice.ListDeploymentsRequest.Options.deserializeBinaryFromReader);
-      msg.setOptions(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setPageSize(value);
-      break;
-    case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setCursor(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setPageMinUpdatedAt(value);
+      msg.setApplicationId(value);
       break;
     default:
       reader.skipField();
@@ -9402,9 +14533,9 @@ proto.grpc.service.webservice.ListDeploymentsRequest.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.GetApplicationLiveStateRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListDeploymentsRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.GetApplicationLiveStateRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9412,51 +14543,40 @@ proto.grpc.service.webservice.ListDeploymentsRequest.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListDeploymentsRequest} message
+ * @param {!proto.grpc.service.webservice.GetApplicationLiveStateRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.GetApplicationLiveStateRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getOptions();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      proto.grpc.service.webservice.ListDeploymentsRequest.Options.serializeBinaryToWriter
-    );
-  }
-  f = message.getPageSize();
-  if (f !== 0) {
-    writer.writeInt32(
-      2,
-      f
-    );
-  }
-  f = message.getCursor();
+  f = message.getApplicationId();
   if (f.length > 0) {
     writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getPageMinUpdatedAt();
-  if (f !== 0) {
-    writer.writeInt64(
-      4,
+      1,
       f
     );
   }
 };
 
 
+/**
+ * optional string application_id = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.GetApplicationLiveStateRequest.prototype.getApplicationId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.GetApplicationLiveStateRequest} returns this
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.Options.repeatedFields_ = [1,2,3];
+proto.grpc.service.webservice.GetApplicationLiveStateRequest.prototype.setApplicationId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
 
 
 
@@ -9473,8 +14593,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListDeploymentsRequest.Options.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.GetApplicationLiveStateResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GetApplicationLiveStateResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -9483,17 +14603,13 @@ proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.toObject
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.GetApplicationLiveStateResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.Options.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.GetApplicationLiveStateResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    statusesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
-    kindsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
-    applicationIdsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
-    applicationName: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : []
+    snapshot: (f = msg.getSnapshot()) && pkg_model_application_live_state_pb.ApplicationLiveStateSnapshot.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -9507,23 +14623,23 @@ proto.grpc.service.webservice.ListDeploymentsRequest.Options.toObject = function
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options}
+ * @return {!proto.grpc.service.webservice.GetApplicationLiveStateResponse}
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.Options.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.GetApplicationLiveStateResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListDeploymentsRequest.Options;
-  return proto.grpc.service.webservice.ListDeploymentsRequest.Options.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.GetApplicationLiveStateResponse;
+  return proto.grpc.service.webservice.GetApplicationLiveStateResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.GetApplicationLiveStateResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options}
+ * @return {!proto.grpc.service.webservice.GetApplicationLiveStateResponse}
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.Options.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.GetApplicationLiveStateResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9531,30 +14647,9 @@ proto.grpc.service.webservice.ListDeploymentsRequest.Options.deserializeBinaryFr
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var values = /** @type {!Array<!proto.model.DeploymentStatus>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addStatuses(values[i]);
-      }
-      break;
-    case 2:
-      var values = /** @type {!Array<!proto.model.ApplicationKind>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addKinds(values[i]);
-      }
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addApplicationIds(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setApplicationName(value);
-      break;
-    case 6:
-      var value = msg.getLabelsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
+      var value = new pkg_model_application_live_state_pb.ApplicationLiveStateSnapshot;
+      reader.readMessage(value,pkg_model_application_live_state_pb.ApplicationLiveStateSnapshot.deserializeBinaryFromReader);
+      msg.setSnapshot(value);
       break;
     default:
       reader.skipField();
@@ -9569,9 +14664,9 @@ proto.grpc.service.webservice.ListDeploymentsRequest.Options.deserializeBinaryFr
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.GetApplicationLiveStateResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListDeploymentsRequest.Options.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.GetApplicationLiveStateResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9579,298 +14674,313 @@ proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.serialize
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} message
+ * @param {!proto.grpc.service.webservice.GetApplicationLiveStateResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.Options.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.GetApplicationLiveStateResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getStatusesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
+  f = message.getSnapshot();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
-    );
-  }
-  f = message.getKindsList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
-      2,
-      f
-    );
-  }
-  f = message.getApplicationIdsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      3,
-      f
-    );
-  }
-  f = message.getApplicationName();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
+      f,
+      pkg_model_application_live_state_pb.ApplicationLiveStateSnapshot.serializeBinaryToWriter
     );
   }
-  f = message.getLabelsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(6, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
-  }
 };
 
 
 /**
- * repeated model.DeploymentStatus statuses = 1;
- * @return {!Array<!proto.model.DeploymentStatus>}
+ * optional model.ApplicationLiveStateSnapshot snapshot = 1;
+ * @return {?proto.model.ApplicationLiveStateSnapshot}
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.getStatusesList = function() {
-  return /** @type {!Array<!proto.model.DeploymentStatus>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.grpc.service.webservice.GetApplicationLiveStateResponse.prototype.getSnapshot = function() {
+  return /** @type{?proto.model.ApplicationLiveStateSnapshot} */ (
+    jspb.Message.getWrapperField(this, pkg_model_application_live_state_pb.ApplicationLiveStateSnapshot, 1));
 };
 
 
 /**
- * @param {!Array<!proto.model.DeploymentStatus>} value
- * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} returns this
- */
-proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.setStatusesList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+ * @param {?proto.model.ApplicationLiveStateSnapshot|undefined} value
+ * @return {!proto.grpc.service.webservice.GetApplicationLiveStateResponse} returns this
+*/
+proto.grpc.service.webservice.GetApplicationLiveStateResponse.prototype.setSnapshot = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.model.DeploymentStatus} value
- * @param {number=} opt_index
- * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.grpc.service.webservice.GetApplicationLiveStateResponse} returns this
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.addStatuses = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.grpc.service.webservice.GetApplicationLiveStateResponse.prototype.clearSnapshot = function() {
+  return this.setSnapshot(undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.clearStatusesList = function() {
-  return this.setStatusesList([]);
+proto.grpc.service.webservice.GetApplicationLiveStateResponse.prototype.hasSnapshot = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
-/**
- * repeated model.ApplicationKind kinds = 2;
- * @return {!Array<!proto.model.ApplicationKind>}
- */
-proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.getKindsList = function() {
-  return /** @type {!Array<!proto.model.ApplicationKind>} */ (jspb.Message.getRepeatedField(this, 2));
-};
-
 
-/**
- * @param {!Array<!proto.model.ApplicationKind>} value
- * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} returns this
- */
-proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.setKindsList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {!proto.model.ApplicationKind} value
- * @param {number=} opt_index
- * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.addKinds = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+proto.grpc.service.webservice.GetProjectRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GetProjectRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.GetProjectRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.clearKindsList = function() {
-  return this.setKindsList([]);
-};
+proto.grpc.service.webservice.GetProjectRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
 
+  };
 
-/**
- * repeated string application_ids = 3;
- * @return {!Array<string>}
- */
-proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.getApplicationIdsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.GetProjectRequest}
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.setApplicationIdsList = function(value) {
-  return jspb.Message.setField(this, 3, value || []);
+proto.grpc.service.webservice.GetProjectRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.GetProjectRequest;
+  return proto.grpc.service.webservice.GetProjectRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.GetProjectRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.GetProjectRequest}
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.addApplicationIds = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+proto.grpc.service.webservice.GetProjectRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.clearApplicationIdsList = function() {
-  return this.setApplicationIdsList([]);
+proto.grpc.service.webservice.GetProjectRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.GetProjectRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * optional string application_name = 5;
- * @return {string}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.GetProjectRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.getApplicationName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.grpc.service.webservice.GetProjectRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
 };
 
 
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} returns this
- */
-proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.setApplicationName = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
-};
-
 
-/**
- * map<string, string> labels = 6;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
- */
-proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.getLabelsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 6, opt_noLazyCreate,
-      null));
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.grpc.service.webservice.ListDeploymentsRequest.Options} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.Options.prototype.clearLabelsMap = function() {
-  this.getLabelsMap().clear();
-  return this;
+proto.grpc.service.webservice.GetProjectResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GetProjectResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional Options options = 1;
- * @return {?proto.grpc.service.webservice.ListDeploymentsRequest.Options}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.GetProjectResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.prototype.getOptions = function() {
-  return /** @type{?proto.grpc.service.webservice.ListDeploymentsRequest.Options} */ (
-    jspb.Message.getWrapperField(this, proto.grpc.service.webservice.ListDeploymentsRequest.Options, 1));
-};
-
+proto.grpc.service.webservice.GetProjectResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    project: (f = msg.getProject()) && pkg_model_project_pb.Project.toObject(includeInstance, f)
+  };
 
-/**
- * @param {?proto.grpc.service.webservice.ListDeploymentsRequest.Options|undefined} value
- * @return {!proto.grpc.service.webservice.ListDeploymentsRequest} returns this
-*/
-proto.grpc.service.webservice.ListDeploymentsRequest.prototype.setOptions = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.grpc.service.webservice.ListDeploymentsRequest} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.GetProjectResponse}
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.prototype.clearOptions = function() {
-  return this.setOptions(undefined);
+proto.grpc.service.webservice.GetProjectResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.GetProjectResponse;
+  return proto.grpc.service.webservice.GetProjectResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.GetProjectResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.GetProjectResponse}
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.prototype.hasOptions = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.grpc.service.webservice.GetProjectResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new pkg_model_project_pb.Project;
+      reader.readMessage(value,pkg_model_project_pb.Project.deserializeBinaryFromReader);
+      msg.setProject(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional int32 page_size = 2;
- * @return {number}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.prototype.getPageSize = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.grpc.service.webservice.GetProjectResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.GetProjectResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.grpc.service.webservice.ListDeploymentsRequest} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.GetProjectResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.prototype.setPageSize = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.grpc.service.webservice.GetProjectResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getProject();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      pkg_model_project_pb.Project.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional string cursor = 3;
- * @return {string}
+ * optional model.Project project = 1;
+ * @return {?proto.model.Project}
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.prototype.getCursor = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.grpc.service.webservice.GetProjectResponse.prototype.getProject = function() {
+  return /** @type{?proto.model.Project} */ (
+    jspb.Message.getWrapperField(this, pkg_model_project_pb.Project, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.ListDeploymentsRequest} returns this
- */
-proto.grpc.service.webservice.ListDeploymentsRequest.prototype.setCursor = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+ * @param {?proto.model.Project|undefined} value
+ * @return {!proto.grpc.service.webservice.GetProjectResponse} returns this
+*/
+proto.grpc.service.webservice.GetProjectResponse.prototype.setProject = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * optional int64 page_min_updated_at = 4;
- * @return {number}
+ * Clears the message field making it undefined.
+ * @return {!proto.grpc.service.webservice.GetProjectResponse} returns this
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.prototype.getPageMinUpdatedAt = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.grpc.service.webservice.GetProjectResponse.prototype.clearProject = function() {
+  return this.setProject(undefined);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.grpc.service.webservice.ListDeploymentsRequest} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.grpc.service.webservice.ListDeploymentsRequest.prototype.setPageMinUpdatedAt = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
+proto.grpc.service.webservice.GetProjectResponse.prototype.hasProject = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.grpc.service.webservice.ListDeploymentsResponse.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -9886,8 +14996,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ListDeploymentsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListDeploymentsResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -9896,15 +15006,14 @@ proto.grpc.service.webservice.ListDeploymentsResponse.prototype.toObject = funct
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListDeploymentsResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.UpdateProjectStaticAdminRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListDeploymentsResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    deploymentsList: jspb.Message.toObjectList(msg.getDeploymentsList(),
-    pkg_model_deployment_pb.Deployment.toObject, includeInstance),
-    cursor: jspb.Message.getFieldWithDefault(msg, 2, "")
+    username: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    password: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -9918,23 +15027,23 @@ proto.grpc.service.webservice.ListDeploymentsResponse.toObject = function(includ
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListDeploymentsResponse}
+ * @return {!proto.grpc.service.webservice.UpdateProjectStaticAdminRequest}
  */
-proto.grpc.service.webservice.ListDeploymentsResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListDeploymentsResponse;
-  return proto.grpc.service.webservice.ListDeploymentsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.UpdateProjectStaticAdminRequest;
+  return proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListDeploymentsResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.UpdateProjectStaticAdminRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListDeploymentsResponse}
+ * @return {!proto.grpc.service.webservice.UpdateProjectStaticAdminRequest}
  */
-proto.grpc.service.webservice.ListDeploymentsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9942,13 +15051,12 @@ proto.grpc.service.webservice.ListDeploymentsResponse.deserializeBinaryFromReade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new pkg_model_deployment_pb.Deployment;
-      reader.readMessage(value,pkg_model_deployment_pb.Deployment.deserializeBinaryFromReader);
-      msg.addDeployments(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUsername(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setCursor(value);
+      msg.setPassword(value);
       break;
     default:
       reader.skipField();
@@ -9963,9 +15071,9 @@ proto.grpc.service.webservice.ListDeploymentsResponse.deserializeBinaryFromReade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListDeploymentsResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListDeploymentsResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9973,21 +15081,20 @@ proto.grpc.service.webservice.ListDeploymentsResponse.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListDeploymentsResponse} message
+ * @param {!proto.grpc.service.webservice.UpdateProjectStaticAdminRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListDeploymentsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getDeploymentsList();
+  f = message.getUsername();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      pkg_model_deployment_pb.Deployment.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getCursor();
+  f = message.getPassword();
   if (f.length > 0) {
     writer.writeString(
       2,
@@ -9998,57 +15105,37 @@ proto.grpc.service.webservice.ListDeploymentsResponse.serializeBinaryToWriter =
 
 
 /**
- * repeated model.Deployment deployments = 1;
- * @return {!Array<!proto.model.Deployment>}
- */
-proto.grpc.service.webservice.ListDeploymentsResponse.prototype.getDeploymentsList = function() {
-  return /** @type{!Array<!proto.model.Deployment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, pkg_model_deployment_pb.Deployment, 1));
-};
-
-
-/**
- * @param {!Array<!proto.model.Deployment>} value
- * @return {!proto.grpc.service.webservice.ListDeploymentsResponse} returns this
-*/
-proto.grpc.service.webservice.ListDeploymentsResponse.prototype.setDeploymentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
-};
-
-
-/**
- * @param {!proto.model.Deployment=} opt_value
- * @param {number=} opt_index
- * @return {!proto.model.Deployment}
+ * optional string username = 1;
+ * @return {string}
  */
-proto.grpc.service.webservice.ListDeploymentsResponse.prototype.addDeployments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.Deployment, opt_index);
+proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.grpc.service.webservice.ListDeploymentsResponse} returns this
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.UpdateProjectStaticAdminRequest} returns this
  */
-proto.grpc.service.webservice.ListDeploymentsResponse.prototype.clearDeploymentsList = function() {
-  return this.setDeploymentsList([]);
+proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string cursor = 2;
+ * optional string password = 2;
  * @return {string}
  */
-proto.grpc.service.webservice.ListDeploymentsResponse.prototype.getCursor = function() {
+proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.prototype.getPassword = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.ListDeploymentsResponse} returns this
+ * @return {!proto.grpc.service.webservice.UpdateProjectStaticAdminRequest} returns this
  */
-proto.grpc.service.webservice.ListDeploymentsResponse.prototype.setCursor = function(value) {
+proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.prototype.setPassword = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
@@ -10069,8 +15156,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.GetDeploymentRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GetDeploymentRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.UpdateProjectStaticAdminResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.UpdateProjectStaticAdminResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -10079,13 +15166,13 @@ proto.grpc.service.webservice.GetDeploymentRequest.prototype.toObject = function
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GetDeploymentRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.UpdateProjectStaticAdminResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetDeploymentRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.UpdateProjectStaticAdminResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    deploymentId: jspb.Message.getFieldWithDefault(msg, 1, "")
+
   };
 
   if (includeInstance) {
@@ -10099,33 +15186,29 @@ proto.grpc.service.webservice.GetDeploymentRequest.toObject = function(includeIn
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GetDeploymentRequest}
+ * @return {!proto.grpc.service.webservice.UpdateProjectStaticAdminResponse}
  */
-proto.grpc.service.webservice.GetDeploymentRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.UpdateProjectStaticAdminResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GetDeploymentRequest;
-  return proto.grpc.service.webservice.GetDeploymentRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.UpdateProjectStaticAdminResponse;
+  return proto.grpc.service.webservice.UpdateProjectStaticAdminResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GetDeploymentRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.UpdateProjectStaticAdminResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GetDeploymentRequest}
+ * @return {!proto.grpc.service.webservice.UpdateProjectStaticAdminResponse}
  */
-proto.grpc.service.webservice.GetDeploymentRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.UpdateProjectStaticAdminResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDeploymentId(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -10139,9 +15222,9 @@ proto.grpc.service.webservice.GetDeploymentRequest.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GetDeploymentRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.UpdateProjectStaticAdminResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GetDeploymentRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.UpdateProjectStaticAdminResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10149,37 +15232,12 @@ proto.grpc.service.webservice.GetDeploymentRequest.prototype.serializeBinary = f
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GetDeploymentRequest} message
+ * @param {!proto.grpc.service.webservice.UpdateProjectStaticAdminResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetDeploymentRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.UpdateProjectStaticAdminResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getDeploymentId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string deployment_id = 1;
- * @return {string}
- */
-proto.grpc.service.webservice.GetDeploymentRequest.prototype.getDeploymentId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.GetDeploymentRequest} returns this
- */
-proto.grpc.service.webservice.GetDeploymentRequest.prototype.setDeploymentId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -10199,8 +15257,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.GetDeploymentResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GetDeploymentResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -10209,13 +15267,13 @@ proto.grpc.service.webservice.GetDeploymentResponse.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GetDeploymentResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.UpdateProjectSSOConfigRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetDeploymentResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    deployment: (f = msg.getDeployment()) && pkg_model_deployment_pb.Deployment.toObject(includeInstance, f)
+    sso: (f = msg.getSso()) && pkg_model_project_pb.ProjectSSOConfig.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -10229,23 +15287,23 @@ proto.grpc.service.webservice.GetDeploymentResponse.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GetDeploymentResponse}
+ * @return {!proto.grpc.service.webservice.UpdateProjectSSOConfigRequest}
  */
-proto.grpc.service.webservice.GetDeploymentResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GetDeploymentResponse;
-  return proto.grpc.service.webservice.GetDeploymentResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.UpdateProjectSSOConfigRequest;
+  return proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GetDeploymentResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.UpdateProjectSSOConfigRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GetDeploymentResponse}
+ * @return {!proto.grpc.service.webservice.UpdateProjectSSOConfigRequest}
  */
-proto.grpc.service.webservice.GetDeploymentResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10253,9 +15311,9 @@ proto.grpc.service.webservice.GetDeploymentResponse.deserializeBinaryFromReader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new pkg_model_deployment_pb.Deployment;
-      reader.readMessage(value,pkg_model_deployment_pb.Deployment.deserializeBinaryFromReader);
-      msg.setDeployment(value);
+      var value = new pkg_model_project_pb.ProjectSSOConfig;
+      reader.readMessage(value,pkg_model_project_pb.ProjectSSOConfig.deserializeBinaryFromReader);
+      msg.setSso(value);
       break;
     default:
       reader.skipField();
@@ -10270,9 +15328,9 @@ proto.grpc.service.webservice.GetDeploymentResponse.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GetDeploymentResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GetDeploymentResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10280,48 +15338,48 @@ proto.grpc.service.webservice.GetDeploymentResponse.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GetDeploymentResponse} message
+ * @param {!proto.grpc.service.webservice.UpdateProjectSSOConfigRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetDeploymentResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getDeployment();
+  f = message.getSso();
   if (f != null) {
     writer.writeMessage(
       1,
       f,
-      pkg_model_deployment_pb.Deployment.serializeBinaryToWriter
+      pkg_model_project_pb.ProjectSSOConfig.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional model.Deployment deployment = 1;
- * @return {?proto.model.Deployment}
+ * optional model.ProjectSSOConfig sso = 1;
+ * @return {?proto.model.ProjectSSOConfig}
  */
-proto.grpc.service.webservice.GetDeploymentResponse.prototype.getDeployment = function() {
-  return /** @type{?proto.model.Deployment} */ (
-    jspb.Message.getWrapperField(this, pkg_model_deployment_pb.Deployment, 1));
+proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.prototype.getSso = function() {
+  return /** @type{?proto.model.ProjectSSOConfig} */ (
+    jspb.Message.getWrapperField(this, pkg_model_project_pb.ProjectSSOConfig, 1));
 };
 
 
 /**
- * @param {?proto.model.Deployment|undefined} value
- * @return {!proto.grpc.service.webservice.GetDeploymentResponse} returns this
+ * @param {?proto.model.ProjectSSOConfig|undefined} value
+ * @return {!proto.grpc.service.webservice.UpdateProjectSSOConfigRequest} returns this
 */
-proto.grpc.service.webservice.GetDeploymentResponse.prototype.setDeployment = function(value) {
+proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.prototype.setSso = function(value) {
   return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.grpc.service.webservice.GetDeploymentResponse} returns this
+ * @return {!proto.grpc.service.webservice.UpdateProjectSSOConfigRequest} returns this
  */
-proto.grpc.service.webservice.GetDeploymentResponse.prototype.clearDeployment = function() {
-  return this.setDeployment(undefined);
+proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.prototype.clearSso = function() {
+  return this.setSso(undefined);
 };
 
 
@@ -10329,7 +15387,7 @@ proto.grpc.service.webservice.GetDeploymentResponse.prototype.clearDeployment =
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.grpc.service.webservice.GetDeploymentResponse.prototype.hasDeployment = function() {
+proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.prototype.hasSso = function() {
   return jspb.Message.getField(this, 1) != null;
 };
 
@@ -10350,8 +15408,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.GetStageLogRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GetStageLogRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.UpdateProjectSSOConfigResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.UpdateProjectSSOConfigResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -10360,16 +15418,13 @@ proto.grpc.service.webservice.GetStageLogRequest.prototype.toObject = function(o
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GetStageLogRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.UpdateProjectSSOConfigResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetStageLogRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.UpdateProjectSSOConfigResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    deploymentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    stageId: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    retriedCount: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    offsetIndex: jspb.Message.getFieldWithDefault(msg, 4, 0)
+
   };
 
   if (includeInstance) {
@@ -10383,45 +15438,29 @@ proto.grpc.service.webservice.GetStageLogRequest.toObject = function(includeInst
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GetStageLogRequest}
+ * @return {!proto.grpc.service.webservice.UpdateProjectSSOConfigResponse}
  */
-proto.grpc.service.webservice.GetStageLogRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.UpdateProjectSSOConfigResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GetStageLogRequest;
-  return proto.grpc.service.webservice.GetStageLogRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.UpdateProjectSSOConfigResponse;
+  return proto.grpc.service.webservice.UpdateProjectSSOConfigResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GetStageLogRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.UpdateProjectSSOConfigResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GetStageLogRequest}
+ * @return {!proto.grpc.service.webservice.UpdateProjectSSOConfigResponse}
  */
-proto.grpc.service.webservice.GetStageLogRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.UpdateProjectSSOConfigResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDeploymentId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setStageId(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setRetriedCount(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setOffsetIndex(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -10435,133 +15474,26 @@ proto.grpc.service.webservice.GetStageLogRequest.deserializeBinaryFromReader = f
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GetStageLogRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.UpdateProjectSSOConfigResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GetStageLogRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
-};
-
-
-/**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GetStageLogRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.grpc.service.webservice.GetStageLogRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getDeploymentId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getStageId();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getRetriedCount();
-  if (f !== 0) {
-    writer.writeInt32(
-      3,
-      f
-    );
-  }
-  f = message.getOffsetIndex();
-  if (f !== 0) {
-    writer.writeInt64(
-      4,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string deployment_id = 1;
- * @return {string}
- */
-proto.grpc.service.webservice.GetStageLogRequest.prototype.getDeploymentId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.GetStageLogRequest} returns this
- */
-proto.grpc.service.webservice.GetStageLogRequest.prototype.setDeploymentId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional string stage_id = 2;
- * @return {string}
- */
-proto.grpc.service.webservice.GetStageLogRequest.prototype.getStageId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.GetStageLogRequest} returns this
- */
-proto.grpc.service.webservice.GetStageLogRequest.prototype.setStageId = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * optional int32 retried_count = 3;
- * @return {number}
- */
-proto.grpc.service.webservice.GetStageLogRequest.prototype.getRetriedCount = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.grpc.service.webservice.GetStageLogRequest} returns this
- */
-proto.grpc.service.webservice.GetStageLogRequest.prototype.setRetriedCount = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
-};
-
-
-/**
- * optional int64 offset_index = 4;
- * @return {number}
- */
-proto.grpc.service.webservice.GetStageLogRequest.prototype.getOffsetIndex = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+  proto.grpc.service.webservice.UpdateProjectSSOConfigResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.grpc.service.webservice.GetStageLogRequest} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.UpdateProjectSSOConfigResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetStageLogRequest.prototype.setOffsetIndex = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
+proto.grpc.service.webservice.UpdateProjectSSOConfigResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.grpc.service.webservice.GetStageLogResponse.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -10577,8 +15509,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.GetStageLogResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GetStageLogResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -10587,15 +15519,13 @@ proto.grpc.service.webservice.GetStageLogResponse.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GetStageLogResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.UpdateProjectRBACConfigRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetStageLogResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    blocksList: jspb.Message.toObjectList(msg.getBlocksList(),
-    pkg_model_logblock_pb.LogBlock.toObject, includeInstance),
-    completed: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
+    rbac: (f = msg.getRbac()) && pkg_model_project_pb.ProjectRBACConfig.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -10609,23 +15539,23 @@ proto.grpc.service.webservice.GetStageLogResponse.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GetStageLogResponse}
+ * @return {!proto.grpc.service.webservice.UpdateProjectRBACConfigRequest}
  */
-proto.grpc.service.webservice.GetStageLogResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GetStageLogResponse;
-  return proto.grpc.service.webservice.GetStageLogResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.UpdateProjectRBACConfigRequest;
+  return proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GetStageLogResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.UpdateProjectRBACConfigRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GetStageLogResponse}
+ * @return {!proto.grpc.service.webservice.UpdateProjectRBACConfigRequest}
  */
-proto.grpc.service.webservice.GetStageLogResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10633,13 +15563,9 @@ proto.grpc.service.webservice.GetStageLogResponse.deserializeBinaryFromReader =
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new pkg_model_logblock_pb.LogBlock;
-      reader.readMessage(value,pkg_model_logblock_pb.LogBlock.deserializeBinaryFromReader);
-      msg.addBlocks(value);
-      break;
-    case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setCompleted(value);
+      var value = new pkg_model_project_pb.ProjectRBACConfig;
+      reader.readMessage(value,pkg_model_project_pb.ProjectRBACConfig.deserializeBinaryFromReader);
+      msg.setRbac(value);
       break;
     default:
       reader.skipField();
@@ -10654,9 +15580,9 @@ proto.grpc.service.webservice.GetStageLogResponse.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GetStageLogResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GetStageLogResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10664,83 +15590,57 @@ proto.grpc.service.webservice.GetStageLogResponse.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GetStageLogResponse} message
+ * @param {!proto.grpc.service.webservice.UpdateProjectRBACConfigRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetStageLogResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getBlocksList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getRbac();
+  if (f != null) {
+    writer.writeMessage(
       1,
       f,
-      pkg_model_logblock_pb.LogBlock.serializeBinaryToWriter
-    );
-  }
-  f = message.getCompleted();
-  if (f) {
-    writer.writeBool(
-      2,
-      f
+      pkg_model_project_pb.ProjectRBACConfig.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated model.LogBlock blocks = 1;
- * @return {!Array<!proto.model.LogBlock>}
+ * optional model.ProjectRBACConfig rbac = 1;
+ * @return {?proto.model.ProjectRBACConfig}
  */
-proto.grpc.service.webservice.GetStageLogResponse.prototype.getBlocksList = function() {
-  return /** @type{!Array<!proto.model.LogBlock>} */ (
-    jspb.Message.getRepeatedWrapperField(this, pkg_model_logblock_pb.LogBlock, 1));
+proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.prototype.getRbac = function() {
+  return /** @type{?proto.model.ProjectRBACConfig} */ (
+    jspb.Message.getWrapperField(this, pkg_model_project_pb.ProjectRBACConfig, 1));
 };
 
 
 /**
- * @param {!Array<!proto.model.LogBlock>} value
- * @return {!proto.grpc.service.webservice.GetStageLogResponse} returns this
+ * @param {?proto.model.ProjectRBACConfig|undefined} value
+ * @return {!proto.grpc.service.webservice.UpdateProjectRBACConfigRequest} returns this
 */
-proto.grpc.service.webservice.GetStageLogResponse.prototype.setBlocksList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
-};
-
-
-/**
- * @param {!proto.model.LogBlock=} opt_value
- * @param {number=} opt_index
- * @return {!proto.model.LogBlock}
- */
-proto.grpc.service.webservice.GetStageLogResponse.prototype.addBlocks = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.LogBlock, opt_index);
+proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.prototype.setRbac = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.grpc.service.webservice.GetStageLogResponse} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.grpc.service.webservice.UpdateProjectRBACConfigRequest} returns this
  */
-proto.grpc.service.webservice.GetStageLogResponse.prototype.clearBlocksList = function() {
-  return this.setBlocksList([]);
+proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.prototype.clearRbac = function() {
+  return this.setRbac(undefined);
 };
 
 
 /**
- * optional bool completed = 2;
+ * Returns whether this field is set.
  * @return {boolean}
  */
-proto.grpc.service.webservice.GetStageLogResponse.prototype.getCompleted = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.grpc.service.webservice.GetStageLogResponse} returns this
- */
-proto.grpc.service.webservice.GetStageLogResponse.prototype.setCompleted = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
+proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.prototype.hasRbac = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
@@ -10760,8 +15660,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.CancelDeploymentRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.CancelDeploymentRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.UpdateProjectRBACConfigResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.UpdateProjectRBACConfigResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -10770,15 +15670,13 @@ proto.grpc.service.webservice.CancelDeploymentRequest.prototype.toObject = funct
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.CancelDeploymentRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.UpdateProjectRBACConfigResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.CancelDeploymentRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.UpdateProjectRBACConfigResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    deploymentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    forceRollback: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
-    forceNoRollback: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+
   };
 
   if (includeInstance) {
@@ -10792,145 +15690,58 @@ proto.grpc.service.webservice.CancelDeploymentRequest.toObject = function(includ
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.CancelDeploymentRequest}
+ * @return {!proto.grpc.service.webservice.UpdateProjectRBACConfigResponse}
  */
-proto.grpc.service.webservice.CancelDeploymentRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.UpdateProjectRBACConfigResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.CancelDeploymentRequest;
-  return proto.grpc.service.webservice.CancelDeploymentRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.UpdateProjectRBACConfigResponse;
+  return proto.grpc.service.webservice.UpdateProjectRBACConfigResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.CancelDeploymentRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.UpdateProjectRBACConfigResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.CancelDeploymentRequest}
+ * @return {!proto.grpc.service.webservice.UpdateProjectRBACConfigResponse}
  */
-proto.grpc.service.webservice.CancelDeploymentRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.UpdateProjectRBACConfigResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDeploymentId(value);
-      break;
-    case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setForceRollback(value);
-      break;
-    case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setForceNoRollback(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
-};
-
-
-/**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
- */
-proto.grpc.service.webservice.CancelDeploymentRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.CancelDeploymentRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
-};
-
-
-/**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.CancelDeploymentRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.grpc.service.webservice.CancelDeploymentRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getDeploymentId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getForceRollback();
-  if (f) {
-    writer.writeBool(
-      2,
-      f
-    );
-  }
-  f = message.getForceNoRollback();
-  if (f) {
-    writer.writeBool(
-      3,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string deployment_id = 1;
- * @return {string}
- */
-proto.grpc.service.webservice.CancelDeploymentRequest.prototype.getDeploymentId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.CancelDeploymentRequest} returns this
- */
-proto.grpc.service.webservice.CancelDeploymentRequest.prototype.setDeploymentId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional bool force_rollback = 2;
- * @return {boolean}
- */
-proto.grpc.service.webservice.CancelDeploymentRequest.prototype.getForceRollback = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.grpc.service.webservice.CancelDeploymentRequest} returns this
- */
-proto.grpc.service.webservice.CancelDeploymentRequest.prototype.setForceRollback = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional bool force_no_rollback = 3;
- * @return {boolean}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.CancelDeploymentRequest.prototype.getForceNoRollback = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.grpc.service.webservice.UpdateProjectRBACConfigResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.UpdateProjectRBACConfigResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.grpc.service.webservice.CancelDeploymentRequest} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.UpdateProjectRBACConfigResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.CancelDeploymentRequest.prototype.setForceNoRollback = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
+proto.grpc.service.webservice.UpdateProjectRBACConfigResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
 };
 
 
@@ -10950,8 +15761,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.CancelDeploymentResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.CancelDeploymentResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.EnableStaticAdminRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.EnableStaticAdminRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -10960,13 +15771,13 @@ proto.grpc.service.webservice.CancelDeploymentResponse.prototype.toObject = func
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.CancelDeploymentResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.EnableStaticAdminRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.CancelDeploymentResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.EnableStaticAdminRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    commandId: jspb.Message.getFieldWithDefault(msg, 1, "")
+
   };
 
   if (includeInstance) {
@@ -10980,33 +15791,29 @@ proto.grpc.service.webservice.CancelDeploymentResponse.toObject = function(inclu
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.CancelDeploymentResponse}
+ * @return {!proto.grpc.service.webservice.EnableStaticAdminRequest}
  */
-proto.grpc.service.webservice.CancelDeploymentResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.EnableStaticAdminRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.CancelDeploymentResponse;
-  return proto.grpc.service.webservice.CancelDeploymentResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.EnableStaticAdminRequest;
+  return proto.grpc.service.webservice.EnableStaticAdminRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.CancelDeploymentResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.EnableStaticAdminRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.CancelDeploymentResponse}
+ * @return {!proto.grpc.service.webservice.EnableStaticAdminRequest}
  */
-proto.grpc.service.webservice.CancelDeploymentResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.EnableStaticAdminRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setCommandId(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -11020,9 +15827,9 @@ proto.grpc.service.webservice.CancelDeploymentResponse.deserializeBinaryFromRead
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.CancelDeploymentResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.EnableStaticAdminRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.CancelDeploymentResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.EnableStaticAdminRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11030,37 +15837,12 @@ proto.grpc.service.webservice.CancelDeploymentResponse.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.CancelDeploymentResponse} message
+ * @param {!proto.grpc.service.webservice.EnableStaticAdminRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.CancelDeploymentResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.EnableStaticAdminRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCommandId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string command_id = 1;
- * @return {string}
- */
-proto.grpc.service.webservice.CancelDeploymentResponse.prototype.getCommandId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.CancelDeploymentResponse} returns this
- */
-proto.grpc.service.webservice.CancelDeploymentResponse.prototype.setCommandId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -11080,8 +15862,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.SkipStageRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.SkipStageRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.EnableStaticAdminResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.EnableStaticAdminResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -11090,14 +15872,13 @@ proto.grpc.service.webservice.SkipStageRequest.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.SkipStageRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.EnableStaticAdminResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.SkipStageRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.EnableStaticAdminResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    deploymentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    stageId: jspb.Message.getFieldWithDefault(msg, 2, "")
+
   };
 
   if (includeInstance) {
@@ -11111,37 +15892,29 @@ proto.grpc.service.webservice.SkipStageRequest.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.SkipStageRequest}
+ * @return {!proto.grpc.service.webservice.EnableStaticAdminResponse}
  */
-proto.grpc.service.webservice.SkipStageRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.EnableStaticAdminResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.SkipStageRequest;
-  return proto.grpc.service.webservice.SkipStageRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.EnableStaticAdminResponse;
+  return proto.grpc.service.webservice.EnableStaticAdminResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.SkipStageRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.EnableStaticAdminResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.SkipStageRequest}
+ * @return {!proto.grpc.service.webservice.EnableStaticAdminResponse}
  */
-proto.grpc.service.webservice.SkipStageRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.EnableStaticAdminResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDeploymentId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setStageId(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -11155,9 +15928,9 @@ proto.grpc.service.webservice.SkipStageRequest.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.SkipStageRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.EnableStaticAdminResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.SkipStageRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.EnableStaticAdminResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11165,62 +15938,12 @@ proto.grpc.service.webservice.SkipStageRequest.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.SkipStageRequest} message
+ * @param {!proto.grpc.service.webservice.EnableStaticAdminResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.SkipStageRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.EnableStaticAdminResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getDeploymentId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getStageId();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string deployment_id = 1;
- * @return {string}
- */
-proto.grpc.service.webservice.SkipStageRequest.prototype.getDeploymentId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.SkipStageRequest} returns this
- */
-proto.grpc.service.webservice.SkipStageRequest.prototype.setDeploymentId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional string stage_id = 2;
- * @return {string}
- */
-proto.grpc.service.webservice.SkipStageRequest.prototype.getStageId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.SkipStageRequest} returns this
- */
-proto.grpc.service.webservice.SkipStageRequest.prototype.setStageId = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -11240,8 +15963,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.SkipStageResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.SkipStageResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.DisableStaticAdminRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.DisableStaticAdminRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -11250,13 +15973,13 @@ proto.grpc.service.webservice.SkipStageResponse.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.SkipStageResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.DisableStaticAdminRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.SkipStageResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.DisableStaticAdminRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    commandId: jspb.Message.getFieldWithDefault(msg, 1, "")
+
   };
 
   if (includeInstance) {
@@ -11270,33 +15993,29 @@ proto.grpc.service.webservice.SkipStageResponse.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.SkipStageResponse}
+ * @return {!proto.grpc.service.webservice.DisableStaticAdminRequest}
  */
-proto.grpc.service.webservice.SkipStageResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.DisableStaticAdminRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.SkipStageResponse;
-  return proto.grpc.service.webservice.SkipStageResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.DisableStaticAdminRequest;
+  return proto.grpc.service.webservice.DisableStaticAdminRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.SkipStageResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.DisableStaticAdminRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.SkipStageResponse}
+ * @return {!proto.grpc.service.webservice.DisableStaticAdminRequest}
  */
-proto.grpc.service.webservice.SkipStageResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.DisableStaticAdminRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setCommandId(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -11310,9 +16029,9 @@ proto.grpc.service.webservice.SkipStageResponse.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.SkipStageResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.DisableStaticAdminRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.SkipStageResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.DisableStaticAdminRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11320,37 +16039,12 @@ proto.grpc.service.webservice.SkipStageResponse.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.SkipStageResponse} message
+ * @param {!proto.grpc.service.webservice.DisableStaticAdminRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.SkipStageResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.DisableStaticAdminRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCommandId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string command_id = 1;
- * @return {string}
- */
-proto.grpc.service.webservice.SkipStageResponse.prototype.getCommandId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.SkipStageResponse} returns this
- */
-proto.grpc.service.webservice.SkipStageResponse.prototype.setCommandId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -11370,8 +16064,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ApproveStageRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ApproveStageRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.DisableStaticAdminResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.DisableStaticAdminResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -11380,14 +16074,13 @@ proto.grpc.service.webservice.ApproveStageRequest.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ApproveStageRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.DisableStaticAdminResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ApproveStageRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.DisableStaticAdminResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    deploymentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    stageId: jspb.Message.getFieldWithDefault(msg, 2, "")
+
   };
 
   if (includeInstance) {
@@ -11401,37 +16094,29 @@ proto.grpc.service.webservice.ApproveStageRequest.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ApproveStageRequest}
+ * @return {!proto.grpc.service.webservice.DisableStaticAdminResponse}
  */
-proto.grpc.service.webservice.ApproveStageRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.DisableStaticAdminResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ApproveStageRequest;
-  return proto.grpc.service.webservice.ApproveStageRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.DisableStaticAdminResponse;
+  return proto.grpc.service.webservice.DisableStaticAdminResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ApproveStageRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.DisableStaticAdminResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ApproveStageRequest}
+ * @return {!proto.grpc.service.webservice.DisableStaticAdminResponse}
  */
-proto.grpc.service.webservice.ApproveStageRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.DisableStaticAdminResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDeploymentId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setStageId(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -11445,9 +16130,9 @@ proto.grpc.service.webservice.ApproveStageRequest.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ApproveStageRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.DisableStaticAdminResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ApproveStageRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.DisableStaticAdminResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11455,62 +16140,12 @@ proto.grpc.service.webservice.ApproveStageRequest.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ApproveStageRequest} message
+ * @param {!proto.grpc.service.webservice.DisableStaticAdminResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ApproveStageRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.DisableStaticAdminResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getDeploymentId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getStageId();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string deployment_id = 1;
- * @return {string}
- */
-proto.grpc.service.webservice.ApproveStageRequest.prototype.getDeploymentId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.ApproveStageRequest} returns this
- */
-proto.grpc.service.webservice.ApproveStageRequest.prototype.setDeploymentId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional string stage_id = 2;
- * @return {string}
- */
-proto.grpc.service.webservice.ApproveStageRequest.prototype.getStageId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.ApproveStageRequest} returns this
- */
-proto.grpc.service.webservice.ApproveStageRequest.prototype.setStageId = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -11530,8 +16165,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ApproveStageResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ApproveStageResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.GetMeRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GetMeRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -11540,13 +16175,13 @@ proto.grpc.service.webservice.ApproveStageResponse.prototype.toObject = function
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ApproveStageResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.GetMeRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ApproveStageResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.GetMeRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    commandId: jspb.Message.getFieldWithDefault(msg, 1, "")
+
   };
 
   if (includeInstance) {
@@ -11560,33 +16195,29 @@ proto.grpc.service.webservice.ApproveStageResponse.toObject = function(includeIn
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ApproveStageResponse}
+ * @return {!proto.grpc.service.webservice.GetMeRequest}
  */
-proto.grpc.service.webservice.ApproveStageResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.GetMeRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ApproveStageResponse;
-  return proto.grpc.service.webservice.ApproveStageResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.GetMeRequest;
+  return proto.grpc.service.webservice.GetMeRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ApproveStageResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.GetMeRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ApproveStageResponse}
+ * @return {!proto.grpc.service.webservice.GetMeRequest}
  */
-proto.grpc.service.webservice.ApproveStageResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.GetMeRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setCommandId(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -11600,9 +16231,9 @@ proto.grpc.service.webservice.ApproveStageResponse.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ApproveStageResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.GetMeRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ApproveStageResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.GetMeRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11610,37 +16241,12 @@ proto.grpc.service.webservice.ApproveStageResponse.prototype.serializeBinary = f
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ApproveStageResponse} message
+ * @param {!proto.grpc.service.webservice.GetMeRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ApproveStageResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.GetMeRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCommandId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string command_id = 1;
- * @return {string}
- */
-proto.grpc.service.webservice.ApproveStageResponse.prototype.getCommandId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.ApproveStageResponse} returns this
- */
-proto.grpc.service.webservice.ApproveStageResponse.prototype.setCommandId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -11660,8 +16266,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListDeploymentTracesRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.GetMeResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GetMeResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -11670,16 +16276,15 @@ proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.toObject = f
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListDeploymentTracesRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.GetMeResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListDeploymentTracesRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.GetMeResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    options: (f = msg.getOptions()) && proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.toObject(includeInstance, f),
-    pageSize: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    cursor: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    pageMinUpdatedAt: jspb.Message.getFieldWithDefault(msg, 4, 0)
+    subject: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    avatarUrl: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    projectId: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -11693,23 +16298,23 @@ proto.grpc.service.webservice.ListDeploymentTracesRequest.toObject = function(in
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListDeploymentTracesRequest}
+ * @return {!proto.grpc.service.webservice.GetMeResponse}
  */
-proto.grpc.service.webservice.ListDeploymentTracesRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.GetMeResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListDeploymentTracesRequest;
-  return proto.grpc.service.webservice.ListDeploymentTracesRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.GetMeResponse;
+  return proto.grpc.service.webservice.GetMeResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListDeploymentTracesRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.GetMeResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListDeploymentTracesRequest}
+ * @return {!proto.grpc.service.webservice.GetMeResponse}
  */
-proto.grpc.service.webservice.ListDeploymentTracesRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.GetMeResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11717,21 +16322,16 @@ proto.grpc.service.webservice.ListDeploymentTracesRequest.deserializeBinaryFromR
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.grpc.service.webservice.ListDeploymentTracesRequest.Options;
-      reader.readMessage(value,proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.deserializeBinaryFromReader);
-      msg.setOptions(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSubject(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setPageSize(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAvatarUrl(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setCursor(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setPageMinUpdatedAt(value);
+      msg.setProjectId(value);
       break;
     default:
       reader.skipField();
@@ -11746,9 +16346,9 @@ proto.grpc.service.webservice.ListDeploymentTracesRequest.deserializeBinaryFromR
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.GetMeResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListDeploymentTracesRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.GetMeResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11756,44 +16356,97 @@ proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.serializeBin
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListDeploymentTracesRequest} message
+ * @param {!proto.grpc.service.webservice.GetMeResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListDeploymentTracesRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.GetMeResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getOptions();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getSubject();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getPageSize();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getAvatarUrl();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = message.getCursor();
+  f = message.getProjectId();
   if (f.length > 0) {
     writer.writeString(
       3,
       f
     );
   }
-  f = message.getPageMinUpdatedAt();
-  if (f !== 0) {
-    writer.writeInt64(
-      4,
-      f
-    );
-  }
 };
 
 
+/**
+ * optional string subject = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.GetMeResponse.prototype.getSubject = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.GetMeResponse} returns this
+ */
+proto.grpc.service.webservice.GetMeResponse.prototype.setSubject = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string avatar_url = 2;
+ * @return {string}
+ */
+proto.grpc.service.webservice.GetMeResponse.prototype.getAvatarUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.GetMeResponse} returns this
+ */
+proto.grpc.service.webservice.GetMeResponse.prototype.setAvatarUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string project_id = 3;
+ * @return {string}
+ */
+proto.grpc.service.webservice.GetMeResponse.prototype.getProjectId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.GetMeResponse} returns this
+ */
+proto.grpc.service.webservice.GetMeResponse.prototype.setProjectId = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.grpc.service.webservice.AddProjectRBACRoleRequest.repeatedFields_ = [2];
 
 
 
@@ -11810,8 +16463,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.AddProjectRBACRoleRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.AddProjectRBACRoleRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -11820,13 +16473,15 @@ proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.prototype.toOb
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListDeploymentTracesRequest.Options} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.AddProjectRBACRoleRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.AddProjectRBACRoleRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    commitHash: jspb.Message.getFieldWithDefault(msg, 1, "")
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    policiesList: jspb.Message.toObjectList(msg.getPoliciesList(),
+    pkg_model_project_pb.ProjectRBACPolicy.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -11840,23 +16495,23 @@ proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.toObject = fun
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListDeploymentTracesRequest.Options}
+ * @return {!proto.grpc.service.webservice.AddProjectRBACRoleRequest}
  */
-proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.AddProjectRBACRoleRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListDeploymentTracesRequest.Options;
-  return proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.AddProjectRBACRoleRequest;
+  return proto.grpc.service.webservice.AddProjectRBACRoleRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListDeploymentTracesRequest.Options} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.AddProjectRBACRoleRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListDeploymentTracesRequest.Options}
+ * @return {!proto.grpc.service.webservice.AddProjectRBACRoleRequest}
  */
-proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.AddProjectRBACRoleRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11865,7 +16520,12 @@ proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.deserializeBin
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setCommitHash(value);
+      msg.setName(value);
+      break;
+    case 2:
+      var value = new pkg_model_project_pb.ProjectRBACPolicy;
+      reader.readMessage(value,pkg_model_project_pb.ProjectRBACPolicy.deserializeBinaryFromReader);
+      msg.addPolicies(value);
       break;
     default:
       reader.skipField();
@@ -11880,9 +16540,9 @@ proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.deserializeBin
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.AddProjectRBACRoleRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.AddProjectRBACRoleRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11890,139 +16550,87 @@ proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.prototype.seri
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListDeploymentTracesRequest.Options} message
+ * @param {!proto.grpc.service.webservice.AddProjectRBACRoleRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.AddProjectRBACRoleRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCommitHash();
+  f = message.getName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getPoliciesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      pkg_model_project_pb.ProjectRBACPolicy.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional string commit_hash = 1;
+ * optional string name = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.prototype.getCommitHash = function() {
+proto.grpc.service.webservice.AddProjectRBACRoleRequest.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.ListDeploymentTracesRequest.Options} returns this
+ * @return {!proto.grpc.service.webservice.AddProjectRBACRoleRequest} returns this
  */
-proto.grpc.service.webservice.ListDeploymentTracesRequest.Options.prototype.setCommitHash = function(value) {
+proto.grpc.service.webservice.AddProjectRBACRoleRequest.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional Options options = 1;
- * @return {?proto.grpc.service.webservice.ListDeploymentTracesRequest.Options}
+ * repeated model.ProjectRBACPolicy policies = 2;
+ * @return {!Array<!proto.model.ProjectRBACPolicy>}
  */
-proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.getOptions = function() {
-  return /** @type{?proto.grpc.service.webservice.ListDeploymentTracesRequest.Options} */ (
-    jspb.Message.getWrapperField(this, proto.grpc.service.webservice.ListDeploymentTracesRequest.Options, 1));
+proto.grpc.service.webservice.AddProjectRBACRoleRequest.prototype.getPoliciesList = function() {
+  return /** @type{!Array<!proto.model.ProjectRBACPolicy>} */ (
+    jspb.Message.getRepeatedWrapperField(this, pkg_model_project_pb.ProjectRBACPolicy, 2));
 };
 
 
 /**
- * @param {?proto.grpc.service.webservice.ListDeploymentTracesRequest.Options|undefined} value
- * @return {!proto.grpc.service.webservice.ListDeploymentTracesRequest} returns this
+ * @param {!Array<!proto.model.ProjectRBACPolicy>} value
+ * @return {!proto.grpc.service.webservice.AddProjectRBACRoleRequest} returns this
 */
-proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.setOptions = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.grpc.service.webservice.ListDeploymentTracesRequest} returns this
- */
-proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.clearOptions = function() {
-  return this.setOptions(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.hasOptions = function() {
-  return jspb.Message.getField(this, 1) != null;
-};
-
-
-/**
- * optional int32 page_size = 2;
- * @return {number}
- */
-proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.getPageSize = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.grpc.service.webservice.ListDeploymentTracesRequest} returns this
- */
-proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.setPageSize = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional string cursor = 3;
- * @return {string}
- */
-proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.getCursor = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.ListDeploymentTracesRequest} returns this
- */
-proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.setCursor = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.grpc.service.webservice.AddProjectRBACRoleRequest.prototype.setPoliciesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
 };
 
 
 /**
- * optional int64 page_min_updated_at = 4;
- * @return {number}
+ * @param {!proto.model.ProjectRBACPolicy=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.ProjectRBACPolicy}
  */
-proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.getPageMinUpdatedAt = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.grpc.service.webservice.AddProjectRBACRoleRequest.prototype.addPolicies = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.model.ProjectRBACPolicy, opt_index);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.grpc.service.webservice.ListDeploymentTracesRequest} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.AddProjectRBACRoleRequest} returns this
  */
-proto.grpc.service.webservice.ListDeploymentTracesRequest.prototype.setPageMinUpdatedAt = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
+proto.grpc.service.webservice.AddProjectRBACRoleRequest.prototype.clearPoliciesList = function() {
+  return this.setPoliciesList([]);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -12038,8 +16646,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListDeploymentTracesResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.AddProjectRBACRoleResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.AddProjectRBACRoleResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -12048,15 +16656,13 @@ proto.grpc.service.webservice.ListDeploymentTracesResponse.prototype.toObject =
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListDeploymentTracesResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.AddProjectRBACRoleResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.AddProjectRBACRoleResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    tracesList: jspb.Message.toObjectList(msg.getTracesList(),
-    proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.toObject, includeInstance),
-    cursor: jspb.Message.getFieldWithDefault(msg, 2, "")
+
   };
 
   if (includeInstance) {
@@ -12070,38 +16676,29 @@ proto.grpc.service.webservice.ListDeploymentTracesResponse.toObject = function(i
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListDeploymentTracesResponse}
+ * @return {!proto.grpc.service.webservice.AddProjectRBACRoleResponse}
  */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.AddProjectRBACRoleResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListDeploymentTracesResponse;
-  return proto.grpc.service.webservice.ListDeploymentTracesResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.AddProjectRBACRoleResponse;
+  return proto.grpc.service.webservice.AddProjectRBACRoleResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListDeploymentTracesResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.AddProjectRBACRoleResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListDeploymentTracesResponse}
+ * @return {!proto.grpc.service.webservice.AddProjectRBACRoleResponse}
  */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.AddProjectRBACRoleResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = new proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes;
-      reader.readMessage(value,proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.deserializeBinaryFromReader);
-      msg.addTraces(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setCursor(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -12115,9 +16712,9 @@ proto.grpc.service.webservice.ListDeploymentTracesResponse.deserializeBinaryFrom
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.AddProjectRBACRoleResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListDeploymentTracesResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.AddProjectRBACRoleResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -12125,27 +16722,12 @@ proto.grpc.service.webservice.ListDeploymentTracesResponse.prototype.serializeBi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListDeploymentTracesResponse} message
+ * @param {!proto.grpc.service.webservice.AddProjectRBACRoleResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.AddProjectRBACRoleResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTracesList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      1,
-      f,
-      proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.serializeBinaryToWriter
-    );
-  }
-  f = message.getCursor();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
 };
 
 
@@ -12155,7 +16737,7 @@ proto.grpc.service.webservice.ListDeploymentTracesResponse.serializeBinaryToWrit
  * @private {!Array<number>}
  * @const
  */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.repeatedFields_ = [2];
+proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.repeatedFields_ = [2];
 
 
 
@@ -12172,8 +16754,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -12182,15 +16764,15 @@ proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.pr
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.UpdateProjectRBACRoleRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    trace: (f = msg.getTrace()) && pkg_model_deployment_trace_pb.DeploymentTrace.toObject(includeInstance, f),
-    deploymentsList: jspb.Message.toObjectList(msg.getDeploymentsList(),
-    pkg_model_deployment_pb.Deployment.toObject, includeInstance)
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    policiesList: jspb.Message.toObjectList(msg.getPoliciesList(),
+    pkg_model_project_pb.ProjectRBACPolicy.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -12204,23 +16786,23 @@ proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.to
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes}
+ * @return {!proto.grpc.service.webservice.UpdateProjectRBACRoleRequest}
  */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes;
-  return proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.deserializeBinaryFromReader(msg, reader);
+proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.UpdateProjectRBACRoleRequest;
+  return proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.UpdateProjectRBACRoleRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes}
+ * @return {!proto.grpc.service.webservice.UpdateProjectRBACRoleRequest}
  */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -12228,14 +16810,13 @@ proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.de
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new pkg_model_deployment_trace_pb.DeploymentTrace;
-      reader.readMessage(value,pkg_model_deployment_trace_pb.DeploymentTrace.deserializeBinaryFromReader);
-      msg.setTrace(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     case 2:
-      var value = new pkg_model_deployment_pb.Deployment;
-      reader.readMessage(value,pkg_model_deployment_pb.Deployment.deserializeBinaryFromReader);
-      msg.addDeployments(value);
+      var value = new pkg_model_project_pb.ProjectRBACPolicy;
+      reader.readMessage(value,pkg_model_project_pb.ProjectRBACPolicy.deserializeBinaryFromReader);
+      msg.addPolicies(value);
       break;
     default:
       reader.skipField();
@@ -12250,9 +16831,9 @@ proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.de
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -12260,159 +16841,184 @@ proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.pr
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes} message
+ * @param {!proto.grpc.service.webservice.UpdateProjectRBACRoleRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTrace();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      pkg_model_deployment_trace_pb.DeploymentTrace.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getDeploymentsList();
+  f = message.getPoliciesList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
       2,
       f,
-      pkg_model_deployment_pb.Deployment.serializeBinaryToWriter
+      pkg_model_project_pb.ProjectRBACPolicy.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional model.DeploymentTrace trace = 1;
- * @return {?proto.model.DeploymentTrace}
- */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.prototype.getTrace = function() {
-  return /** @type{?proto.model.DeploymentTrace} */ (
-    jspb.Message.getWrapperField(this, pkg_model_deployment_trace_pb.DeploymentTrace, 1));
-};
-
-
-/**
- * @param {?proto.model.DeploymentTrace|undefined} value
- * @return {!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes} returns this
-*/
-proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.prototype.setTrace = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes} returns this
+ * optional string name = 1;
+ * @return {string}
  */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.prototype.clearTrace = function() {
-  return this.setTrace(undefined);
+proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.UpdateProjectRBACRoleRequest} returns this
  */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.prototype.hasTrace = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * repeated model.Deployment deployments = 2;
- * @return {!Array<!proto.model.Deployment>}
+ * repeated model.ProjectRBACPolicy policies = 2;
+ * @return {!Array<!proto.model.ProjectRBACPolicy>}
  */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.prototype.getDeploymentsList = function() {
-  return /** @type{!Array<!proto.model.Deployment>} */ (
-    jspb.Message.getRepeatedWrapperField(this, pkg_model_deployment_pb.Deployment, 2));
+proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.prototype.getPoliciesList = function() {
+  return /** @type{!Array<!proto.model.ProjectRBACPolicy>} */ (
+    jspb.Message.getRepeatedWrapperField(this, pkg_model_project_pb.ProjectRBACPolicy, 2));
 };
 
 
 /**
- * @param {!Array<!proto.model.Deployment>} value
- * @return {!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes} returns this
+ * @param {!Array<!proto.model.ProjectRBACPolicy>} value
+ * @return {!proto.grpc.service.webservice.UpdateProjectRBACRoleRequest} returns this
 */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.prototype.setDeploymentsList = function(value) {
+proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.prototype.setPoliciesList = function(value) {
   return jspb.Message.setRepeatedWrapperField(this, 2, value);
 };
 
 
 /**
- * @param {!proto.model.Deployment=} opt_value
+ * @param {!proto.model.ProjectRBACPolicy=} opt_value
  * @param {number=} opt_index
- * @return {!proto.model.Deployment}
+ * @return {!proto.model.ProjectRBACPolicy}
  */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.prototype.addDeployments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.model.Deployment, opt_index);
+proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.prototype.addPolicies = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.model.ProjectRBACPolicy, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes} returns this
+ * @return {!proto.grpc.service.webservice.UpdateProjectRBACRoleRequest} returns this
  */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes.prototype.clearDeploymentsList = function() {
-  return this.setDeploymentsList([]);
+proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.prototype.clearPoliciesList = function() {
+  return this.setPoliciesList([]);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * repeated DeploymentTraceRes traces = 1;
- * @return {!Array<!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes>}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.prototype.getTracesList = function() {
-  return /** @type{!Array<!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes, 1));
+proto.grpc.service.webservice.UpdateProjectRBACRoleResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.UpdateProjectRBACRoleResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {!Array<!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes>} value
- * @return {!proto.grpc.service.webservice.ListDeploymentTracesResponse} returns this
-*/
-proto.grpc.service.webservice.ListDeploymentTracesResponse.prototype.setTracesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.UpdateProjectRBACRoleResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.UpdateProjectRBACRoleResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes=} opt_value
- * @param {number=} opt_index
- * @return {!proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.UpdateProjectRBACRoleResponse}
  */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.prototype.addTraces = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.grpc.service.webservice.ListDeploymentTracesResponse.DeploymentTraceRes, opt_index);
+proto.grpc.service.webservice.UpdateProjectRBACRoleResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.UpdateProjectRBACRoleResponse;
+  return proto.grpc.service.webservice.UpdateProjectRBACRoleResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.grpc.service.webservice.ListDeploymentTracesResponse} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.UpdateProjectRBACRoleResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.UpdateProjectRBACRoleResponse}
  */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.prototype.clearTracesList = function() {
-  return this.setTracesList([]);
+proto.grpc.service.webservice.UpdateProjectRBACRoleResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional string cursor = 2;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.prototype.getCursor = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.grpc.service.webservice.UpdateProjectRBACRoleResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.UpdateProjectRBACRoleResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.ListDeploymentTracesResponse} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.UpdateProjectRBACRoleResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListDeploymentTracesResponse.prototype.setCursor = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.grpc.service.webservice.UpdateProjectRBACRoleResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
 };
 
 
@@ -12432,8 +17038,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.GetApplicationLiveStateRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GetApplicationLiveStateRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -12442,13 +17048,13 @@ proto.grpc.service.webservice.GetApplicationLiveStateRequest.prototype.toObject
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GetApplicationLiveStateRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.DeleteProjectRBACRoleRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetApplicationLiveStateRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    applicationId: jspb.Message.getFieldWithDefault(msg, 1, "")
+    name: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -12462,23 +17068,23 @@ proto.grpc.service.webservice.GetApplicationLiveStateRequest.toObject = function
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GetApplicationLiveStateRequest}
+ * @return {!proto.grpc.service.webservice.DeleteProjectRBACRoleRequest}
  */
-proto.grpc.service.webservice.GetApplicationLiveStateRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GetApplicationLiveStateRequest;
-  return proto.grpc.service.webservice.GetApplicationLiveStateRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.DeleteProjectRBACRoleRequest;
+  return proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GetApplicationLiveStateRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.DeleteProjectRBACRoleRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GetApplicationLiveStateRequest}
+ * @return {!proto.grpc.service.webservice.DeleteProjectRBACRoleRequest}
  */
-proto.grpc.service.webservice.GetApplicationLiveStateRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -12487,7 +17093,7 @@ proto.grpc.service.webservice.GetApplicationLiveStateRequest.deserializeBinaryFr
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setApplicationId(value);
+      msg.setName(value);
       break;
     default:
       reader.skipField();
@@ -12502,9 +17108,9 @@ proto.grpc.service.webservice.GetApplicationLiveStateRequest.deserializeBinaryFr
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GetApplicationLiveStateRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GetApplicationLiveStateRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -12512,13 +17118,13 @@ proto.grpc.service.webservice.GetApplicationLiveStateRequest.prototype.serialize
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GetApplicationLiveStateRequest} message
+ * @param {!proto.grpc.service.webservice.DeleteProjectRBACRoleRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetApplicationLiveStateRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getApplicationId();
+  f = message.getName();
   if (f.length > 0) {
     writer.writeString(
       1,
@@ -12529,19 +17135,19 @@ proto.grpc.service.webservice.GetApplicationLiveStateRequest.serializeBinaryToWr
 
 
 /**
- * optional string application_id = 1;
+ * optional string name = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.GetApplicationLiveStateRequest.prototype.getApplicationId = function() {
+proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.GetApplicationLiveStateRequest} returns this
+ * @return {!proto.grpc.service.webservice.DeleteProjectRBACRoleRequest} returns this
  */
-proto.grpc.service.webservice.GetApplicationLiveStateRequest.prototype.setApplicationId = function(value) {
+proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -12562,8 +17168,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.GetApplicationLiveStateResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GetApplicationLiveStateResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.DeleteProjectRBACRoleResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.DeleteProjectRBACRoleResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -12572,13 +17178,13 @@ proto.grpc.service.webservice.GetApplicationLiveStateResponse.prototype.toObject
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GetApplicationLiveStateResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.DeleteProjectRBACRoleResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetApplicationLiveStateResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.DeleteProjectRBACRoleResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    snapshot: (f = msg.getSnapshot()) && pkg_model_application_live_state_pb.ApplicationLiveStateSnapshot.toObject(includeInstance, f)
+
   };
 
   if (includeInstance) {
@@ -12592,34 +17198,29 @@ proto.grpc.service.webservice.GetApplicationLiveStateResponse.toObject = functio
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GetApplicationLiveStateResponse}
+ * @return {!proto.grpc.service.webservice.DeleteProjectRBACRoleResponse}
  */
-proto.grpc.service.webservice.GetApplicationLiveStateResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.DeleteProjectRBACRoleResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GetApplicationLiveStateResponse;
-  return proto.grpc.service.webservice.GetApplicationLiveStateResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.DeleteProjectRBACRoleResponse;
+  return proto.grpc.service.webservice.DeleteProjectRBACRoleResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GetApplicationLiveStateResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.DeleteProjectRBACRoleResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GetApplicationLiveStateResponse}
+ * @return {!proto.grpc.service.webservice.DeleteProjectRBACRoleResponse}
  */
-proto.grpc.service.webservice.GetApplicationLiveStateResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.DeleteProjectRBACRoleResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = new pkg_model_application_live_state_pb.ApplicationLiveStateSnapshot;
-      reader.readMessage(value,pkg_model_application_live_state_pb.ApplicationLiveStateSnapshot.deserializeBinaryFromReader);
-      msg.setSnapshot(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -12633,9 +17234,9 @@ proto.grpc.service.webservice.GetApplicationLiveStateResponse.deserializeBinaryF
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GetApplicationLiveStateResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.DeleteProjectRBACRoleResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GetApplicationLiveStateResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.DeleteProjectRBACRoleResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -12643,57 +17244,12 @@ proto.grpc.service.webservice.GetApplicationLiveStateResponse.prototype.serializ
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GetApplicationLiveStateResponse} message
+ * @param {!proto.grpc.service.webservice.DeleteProjectRBACRoleResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetApplicationLiveStateResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.DeleteProjectRBACRoleResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSnapshot();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      pkg_model_application_live_state_pb.ApplicationLiveStateSnapshot.serializeBinaryToWriter
-    );
-  }
-};
-
-
-/**
- * optional model.ApplicationLiveStateSnapshot snapshot = 1;
- * @return {?proto.model.ApplicationLiveStateSnapshot}
- */
-proto.grpc.service.webservice.GetApplicationLiveStateResponse.prototype.getSnapshot = function() {
-  return /** @type{?proto.model.ApplicationLiveStateSnapshot} */ (
-    jspb.Message.getWrapperField(this, pkg_model_application_live_state_pb.ApplicationLiveStateSnapshot, 1));
-};
-
-
-/**
- * @param {?proto.model.ApplicationLiveStateSnapshot|undefined} value
- * @return {!proto.grpc.service.webservice.GetApplicationLiveStateResponse} returns this
-*/
-proto.grpc.service.webservice.GetApplicationLiveStateResponse.prototype.setSnapshot = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.grpc.service.webservice.GetApplicationLiveStateResponse} returns this
- */
-proto.grpc.service.webservice.GetApplicationLiveStateResponse.prototype.clearSnapshot = function() {
-  return this.setSnapshot(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.grpc.service.webservice.GetApplicationLiveStateResponse.prototype.hasSnapshot = function() {
-  return jspb.Message.getField(this, 1) != null;
 };
 
 
@@ -12713,8 +17269,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.GetProjectRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GetProjectRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.AddProjectUserGroupRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.AddProjectUserGroupRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -12723,13 +17279,14 @@ proto.grpc.service.webservice.GetProjectRequest.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GetProjectRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.AddProjectUserGroupRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetProjectRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.AddProjectUserGroupRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    ssoGroup: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    role: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -12743,29 +17300,37 @@ proto.grpc.service.webservice.GetProjectRequest.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GetProjectRequest}
+ * @return {!proto.grpc.service.webservice.AddProjectUserGroupRequest}
  */
-proto.grpc.service.webservice.GetProjectRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.AddProjectUserGroupRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GetProjectRequest;
-  return proto.grpc.service.webservice.GetProjectRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.AddProjectUserGroupRequest;
+  return proto.grpc.service.webservice.AddProjectUserGroupRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GetProjectRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.AddProjectUserGroupRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GetProjectRequest}
+ * @return {!proto.grpc.service.webservice.AddProjectUserGroupRequest}
  */
-proto.grpc.service.webservice.GetProjectRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.AddProjectUserGroupRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSsoGroup(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRole(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -12779,9 +17344,9 @@ proto.grpc.service.webservice.GetProjectRequest.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GetProjectRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.AddProjectUserGroupRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GetProjectRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.AddProjectUserGroupRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -12789,12 +17354,62 @@ proto.grpc.service.webservice.GetProjectRequest.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GetProjectRequest} message
+ * @param {!proto.grpc.service.webservice.AddProjectUserGroupRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetProjectRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.AddProjectUserGroupRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getSsoGroup();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getRole();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string sso_group = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.AddProjectUserGroupRequest.prototype.getSsoGroup = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.AddProjectUserGroupRequest} returns this
+ */
+proto.grpc.service.webservice.AddProjectUserGroupRequest.prototype.setSsoGroup = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string role = 2;
+ * @return {string}
+ */
+proto.grpc.service.webservice.AddProjectUserGroupRequest.prototype.getRole = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.AddProjectUserGroupRequest} returns this
+ */
+proto.grpc.service.webservice.AddProjectUserGroupRequest.prototype.setRole = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -12814,8 +17429,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.GetProjectResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GetProjectResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.AddProjectUserGroupResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.AddProjectUserGroupResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -12824,13 +17439,13 @@ proto.grpc.service.webservice.GetProjectResponse.prototype.toObject = function(o
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GetProjectResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.AddProjectUserGroupResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetProjectResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.AddProjectUserGroupResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    project: (f = msg.getProject()) && pkg_model_project_pb.Project.toObject(includeInstance, f)
+
   };
 
   if (includeInstance) {
@@ -12844,34 +17459,29 @@ proto.grpc.service.webservice.GetProjectResponse.toObject = function(includeInst
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GetProjectResponse}
+ * @return {!proto.grpc.service.webservice.AddProjectUserGroupResponse}
  */
-proto.grpc.service.webservice.GetProjectResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.AddProjectUserGroupResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GetProjectResponse;
-  return proto.grpc.service.webservice.GetProjectResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.AddProjectUserGroupResponse;
+  return proto.grpc.service.webservice.AddProjectUserGroupResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GetProjectResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.AddProjectUserGroupResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GetProjectResponse}
+ * @return {!proto.grpc.service.webservice.AddProjectUserGroupResponse}
  */
-proto.grpc.service.webservice.GetProjectResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.AddProjectUserGroupResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = new pkg_model_project_pb.Project;
-      reader.readMessage(value,pkg_model_project_pb.Project.deserializeBinaryFromReader);
-      msg.setProject(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -12885,9 +17495,9 @@ proto.grpc.service.webservice.GetProjectResponse.deserializeBinaryFromReader = f
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GetProjectResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.AddProjectUserGroupResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GetProjectResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.AddProjectUserGroupResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -12895,57 +17505,12 @@ proto.grpc.service.webservice.GetProjectResponse.prototype.serializeBinary = fun
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GetProjectResponse} message
+ * @param {!proto.grpc.service.webservice.AddProjectUserGroupResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetProjectResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.AddProjectUserGroupResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getProject();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      pkg_model_project_pb.Project.serializeBinaryToWriter
-    );
-  }
-};
-
-
-/**
- * optional model.Project project = 1;
- * @return {?proto.model.Project}
- */
-proto.grpc.service.webservice.GetProjectResponse.prototype.getProject = function() {
-  return /** @type{?proto.model.Project} */ (
-    jspb.Message.getWrapperField(this, pkg_model_project_pb.Project, 1));
-};
-
-
-/**
- * @param {?proto.model.Project|undefined} value
- * @return {!proto.grpc.service.webservice.GetProjectResponse} returns this
-*/
-proto.grpc.service.webservice.GetProjectResponse.prototype.setProject = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.grpc.service.webservice.GetProjectResponse} returns this
- */
-proto.grpc.service.webservice.GetProjectResponse.prototype.clearProject = function() {
-  return this.setProject(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.grpc.service.webservice.GetProjectResponse.prototype.hasProject = function() {
-  return jspb.Message.getField(this, 1) != null;
 };
 
 
@@ -12965,8 +17530,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.DeleteProjectUserGroupRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.DeleteProjectUserGroupRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -12975,14 +17540,13 @@ proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.prototype.toObject
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.UpdateProjectStaticAdminRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.DeleteProjectUserGroupRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.DeleteProjectUserGroupRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    username: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    password: jspb.Message.getFieldWithDefault(msg, 2, "")
+    ssoGroup: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -12996,23 +17560,23 @@ proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.toObject = functio
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.UpdateProjectStaticAdminRequest}
+ * @return {!proto.grpc.service.webservice.DeleteProjectUserGroupRequest}
  */
-proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.DeleteProjectUserGroupRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.UpdateProjectStaticAdminRequest;
-  return proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.DeleteProjectUserGroupRequest;
+  return proto.grpc.service.webservice.DeleteProjectUserGroupRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.UpdateProjectStaticAdminRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.DeleteProjectUserGroupRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.UpdateProjectStaticAdminRequest}
+ * @return {!proto.grpc.service.webservice.DeleteProjectUserGroupRequest}
  */
-proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.DeleteProjectUserGroupRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -13021,11 +17585,7 @@ proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.deserializeBinaryF
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUsername(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPassword(value);
+      msg.setSsoGroup(value);
       break;
     default:
       reader.skipField();
@@ -13040,9 +17600,9 @@ proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.deserializeBinaryF
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.DeleteProjectUserGroupRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.DeleteProjectUserGroupRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -13050,65 +17610,40 @@ proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.prototype.serializ
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.UpdateProjectStaticAdminRequest} message
+ * @param {!proto.grpc.service.webservice.DeleteProjectUserGroupRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.DeleteProjectUserGroupRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUsername();
+  f = message.getSsoGroup();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getPassword();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string username = 1;
+ * optional string sso_group = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.prototype.getUsername = function() {
+proto.grpc.service.webservice.DeleteProjectUserGroupRequest.prototype.getSsoGroup = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.UpdateProjectStaticAdminRequest} returns this
+ * @return {!proto.grpc.service.webservice.DeleteProjectUserGroupRequest} returns this
  */
-proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.prototype.setUsername = function(value) {
+proto.grpc.service.webservice.DeleteProjectUserGroupRequest.prototype.setSsoGroup = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * optional string password = 2;
- * @return {string}
- */
-proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.prototype.getPassword = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.UpdateProjectStaticAdminRequest} returns this
- */
-proto.grpc.service.webservice.UpdateProjectStaticAdminRequest.prototype.setPassword = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
 
 
 
@@ -13125,8 +17660,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.UpdateProjectStaticAdminResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.UpdateProjectStaticAdminResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.DeleteProjectUserGroupResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.DeleteProjectUserGroupResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -13135,11 +17670,11 @@ proto.grpc.service.webservice.UpdateProjectStaticAdminResponse.prototype.toObjec
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.UpdateProjectStaticAdminResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.DeleteProjectUserGroupResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdateProjectStaticAdminResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.DeleteProjectUserGroupResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
 
   };
@@ -13155,23 +17690,23 @@ proto.grpc.service.webservice.UpdateProjectStaticAdminResponse.toObject = functi
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.UpdateProjectStaticAdminResponse}
+ * @return {!proto.grpc.service.webservice.DeleteProjectUserGroupResponse}
  */
-proto.grpc.service.webservice.UpdateProjectStaticAdminResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.DeleteProjectUserGroupResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.UpdateProjectStaticAdminResponse;
-  return proto.grpc.service.webservice.UpdateProjectStaticAdminResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.DeleteProjectUserGroupResponse;
+  return proto.grpc.service.webservice.DeleteProjectUserGroupResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.UpdateProjectStaticAdminResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.DeleteProjectUserGroupResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.UpdateProjectStaticAdminResponse}
+ * @return {!proto.grpc.service.webservice.DeleteProjectUserGroupResponse}
  */
-proto.grpc.service.webservice.UpdateProjectStaticAdminResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.DeleteProjectUserGroupResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -13191,9 +17726,9 @@ proto.grpc.service.webservice.UpdateProjectStaticAdminResponse.deserializeBinary
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.UpdateProjectStaticAdminResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.DeleteProjectUserGroupResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.UpdateProjectStaticAdminResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.DeleteProjectUserGroupResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -13201,11 +17736,11 @@ proto.grpc.service.webservice.UpdateProjectStaticAdminResponse.prototype.seriali
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.UpdateProjectStaticAdminResponse} message
+ * @param {!proto.grpc.service.webservice.DeleteProjectUserGroupResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdateProjectStaticAdminResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.DeleteProjectUserGroupResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
 };
 
@@ -13226,8 +17761,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -13236,13 +17771,13 @@ proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.prototype.toObject =
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.UpdateProjectSSOConfigRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    sso: (f = msg.getSso()) && pkg_model_project_pb.ProjectSSOConfig.toObject(includeInstance, f)
+    window: (f = msg.getWindow()) && pkg_model_project_pb.DeploymentFreezeWindow.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -13256,23 +17791,23 @@ proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.toObject = function(
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.UpdateProjectSSOConfigRequest}
+ * @return {!proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest}
  */
-proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.UpdateProjectSSOConfigRequest;
-  return proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest;
+  return proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.UpdateProjectSSOConfigRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.UpdateProjectSSOConfigRequest}
+ * @return {!proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest}
  */
-proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -13280,9 +17815,9 @@ proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.deserializeBinaryFro
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new pkg_model_project_pb.ProjectSSOConfig;
-      reader.readMessage(value,pkg_model_project_pb.ProjectSSOConfig.deserializeBinaryFromReader);
-      msg.setSso(value);
+      var value = new pkg_model_project_pb.DeploymentFreezeWindow;
+      reader.readMessage(value,pkg_model_project_pb.DeploymentFreezeWindow.deserializeBinaryFromReader);
+      msg.setWindow(value);
       break;
     default:
       reader.skipField();
@@ -13297,9 +17832,9 @@ proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.deserializeBinaryFro
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -13307,48 +17842,48 @@ proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.prototype.serializeB
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.UpdateProjectSSOConfigRequest} message
+ * @param {!proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSso();
+  f = message.getWindow();
   if (f != null) {
     writer.writeMessage(
       1,
       f,
-      pkg_model_project_pb.ProjectSSOConfig.serializeBinaryToWriter
+      pkg_model_project_pb.DeploymentFreezeWindow.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional model.ProjectSSOConfig sso = 1;
- * @return {?proto.model.ProjectSSOConfig}
+ * optional model.DeploymentFreezeWindow window = 1;
+ * @return {?proto.model.DeploymentFreezeWindow}
  */
-proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.prototype.getSso = function() {
-  return /** @type{?proto.model.ProjectSSOConfig} */ (
-    jspb.Message.getWrapperField(this, pkg_model_project_pb.ProjectSSOConfig, 1));
+proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest.prototype.getWindow = function() {
+  return /** @type{?proto.model.DeploymentFreezeWindow} */ (
+    jspb.Message.getWrapperField(this, pkg_model_project_pb.DeploymentFreezeWindow, 1));
 };
 
 
 /**
- * @param {?proto.model.ProjectSSOConfig|undefined} value
- * @return {!proto.grpc.service.webservice.UpdateProjectSSOConfigRequest} returns this
+ * @param {?proto.model.DeploymentFreezeWindow|undefined} value
+ * @return {!proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest} returns this
 */
-proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.prototype.setSso = function(value) {
+proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest.prototype.setWindow = function(value) {
   return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.grpc.service.webservice.UpdateProjectSSOConfigRequest} returns this
+ * @return {!proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest} returns this
  */
-proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.prototype.clearSso = function() {
-  return this.setSso(undefined);
+proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest.prototype.clearWindow = function() {
+  return this.setWindow(undefined);
 };
 
 
@@ -13356,7 +17891,7 @@ proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.prototype.clearSso =
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.grpc.service.webservice.UpdateProjectSSOConfigRequest.prototype.hasSso = function() {
+proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowRequest.prototype.hasWindow = function() {
   return jspb.Message.getField(this, 1) != null;
 };
 
@@ -13377,8 +17912,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.UpdateProjectSSOConfigResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.UpdateProjectSSOConfigResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -13387,11 +17922,11 @@ proto.grpc.service.webservice.UpdateProjectSSOConfigResponse.prototype.toObject
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.UpdateProjectSSOConfigResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdateProjectSSOConfigResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
 
   };
@@ -13407,23 +17942,23 @@ proto.grpc.service.webservice.UpdateProjectSSOConfigResponse.toObject = function
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.UpdateProjectSSOConfigResponse}
+ * @return {!proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse}
  */
-proto.grpc.service.webservice.UpdateProjectSSOConfigResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.UpdateProjectSSOConfigResponse;
-  return proto.grpc.service.webservice.UpdateProjectSSOConfigResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse;
+  return proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.UpdateProjectSSOConfigResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.UpdateProjectSSOConfigResponse}
+ * @return {!proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse}
  */
-proto.grpc.service.webservice.UpdateProjectSSOConfigResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -13443,9 +17978,9 @@ proto.grpc.service.webservice.UpdateProjectSSOConfigResponse.deserializeBinaryFr
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.UpdateProjectSSOConfigResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.UpdateProjectSSOConfigResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -13453,11 +17988,11 @@ proto.grpc.service.webservice.UpdateProjectSSOConfigResponse.prototype.serialize
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.UpdateProjectSSOConfigResponse} message
+ * @param {!proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdateProjectSSOConfigResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.AddProjectDeploymentFreezeWindowResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
 };
 
@@ -13478,8 +18013,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -13488,13 +18023,13 @@ proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.prototype.toObject
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.UpdateProjectRBACConfigRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    rbac: (f = msg.getRbac()) && pkg_model_project_pb.ProjectRBACConfig.toObject(includeInstance, f)
+    name: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -13508,23 +18043,23 @@ proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.toObject = function
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.UpdateProjectRBACConfigRequest}
+ * @return {!proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest}
  */
-proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.UpdateProjectRBACConfigRequest;
-  return proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest;
+  return proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.UpdateProjectRBACConfigRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.UpdateProjectRBACConfigRequest}
+ * @return {!proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest}
  */
-proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -13532,9 +18067,8 @@ proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.deserializeBinaryFr
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new pkg_model_project_pb.ProjectRBACConfig;
-      reader.readMessage(value,pkg_model_project_pb.ProjectRBACConfig.deserializeBinaryFromReader);
-      msg.setRbac(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     default:
       reader.skipField();
@@ -13549,9 +18083,9 @@ proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.deserializeBinaryFr
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -13559,57 +18093,138 @@ proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.prototype.serialize
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.UpdateProjectRBACConfigRequest} message
+ * @param {!proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getRbac();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      pkg_model_project_pb.ProjectRBACConfig.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * optional model.ProjectRBACConfig rbac = 1;
- * @return {?proto.model.ProjectRBACConfig}
+ * optional string name = 1;
+ * @return {string}
  */
-proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.prototype.getRbac = function() {
-  return /** @type{?proto.model.ProjectRBACConfig} */ (
-    jspb.Message.getWrapperField(this, pkg_model_project_pb.ProjectRBACConfig, 1));
+proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {?proto.model.ProjectRBACConfig|undefined} value
- * @return {!proto.grpc.service.webservice.UpdateProjectRBACConfigRequest} returns this
-*/
-proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.prototype.setRbac = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest} returns this
+ */
+proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse}
+ */
+proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse;
+  return proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse}
+ */
+proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.grpc.service.webservice.UpdateProjectRBACConfigRequest} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.prototype.clearRbac = function() {
-  return this.setRbac(undefined);
+proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdateProjectRBACConfigRequest.prototype.hasRbac = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.grpc.service.webservice.DeleteProjectDeploymentFreezeWindowResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
 };
 
 
@@ -13629,8 +18244,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.UpdateProjectRBACConfigResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.UpdateProjectRBACConfigResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListStaticUsersRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListStaticUsersRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -13639,11 +18254,11 @@ proto.grpc.service.webservice.UpdateProjectRBACConfigResponse.prototype.toObject
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.UpdateProjectRBACConfigResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ListStaticUsersRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdateProjectRBACConfigResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ListStaticUsersRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
 
   };
@@ -13659,23 +18274,23 @@ proto.grpc.service.webservice.UpdateProjectRBACConfigResponse.toObject = functio
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.UpdateProjectRBACConfigResponse}
+ * @return {!proto.grpc.service.webservice.ListStaticUsersRequest}
  */
-proto.grpc.service.webservice.UpdateProjectRBACConfigResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ListStaticUsersRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.UpdateProjectRBACConfigResponse;
-  return proto.grpc.service.webservice.UpdateProjectRBACConfigResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ListStaticUsersRequest;
+  return proto.grpc.service.webservice.ListStaticUsersRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.UpdateProjectRBACConfigResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ListStaticUsersRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.UpdateProjectRBACConfigResponse}
+ * @return {!proto.grpc.service.webservice.ListStaticUsersRequest}
  */
-proto.grpc.service.webservice.UpdateProjectRBACConfigResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ListStaticUsersRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -13695,9 +18310,9 @@ proto.grpc.service.webservice.UpdateProjectRBACConfigResponse.deserializeBinaryF
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.UpdateProjectRBACConfigResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ListStaticUsersRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.UpdateProjectRBACConfigResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ListStaticUsersRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -13705,16 +18320,23 @@ proto.grpc.service.webservice.UpdateProjectRBACConfigResponse.prototype.serializ
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.UpdateProjectRBACConfigResponse} message
+ * @param {!proto.grpc.service.webservice.ListStaticUsersRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdateProjectRBACConfigResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ListStaticUsersRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.grpc.service.webservice.ListStaticUsersResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -13730,8 +18352,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.EnableStaticAdminRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.EnableStaticAdminRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListStaticUsersResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListStaticUsersResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -13740,13 +18362,14 @@ proto.grpc.service.webservice.EnableStaticAdminRequest.prototype.toObject = func
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.EnableStaticAdminRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ListStaticUsersResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.EnableStaticAdminRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ListStaticUsersResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    usersList: jspb.Message.toObjectList(msg.getUsersList(),
+    pkg_model_project_pb.ProjectStaticUser.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -13760,29 +18383,34 @@ proto.grpc.service.webservice.EnableStaticAdminRequest.toObject = function(inclu
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.EnableStaticAdminRequest}
+ * @return {!proto.grpc.service.webservice.ListStaticUsersResponse}
  */
-proto.grpc.service.webservice.EnableStaticAdminRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ListStaticUsersResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.EnableStaticAdminRequest;
-  return proto.grpc.service.webservice.EnableStaticAdminRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ListStaticUsersResponse;
+  return proto.grpc.service.webservice.ListStaticUsersResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.EnableStaticAdminRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ListStaticUsersResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.EnableStaticAdminRequest}
+ * @return {!proto.grpc.service.webservice.ListStaticUsersResponse}
  */
-proto.grpc.service.webservice.EnableStaticAdminRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ListStaticUsersResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = new pkg_model_project_pb.ProjectStaticUser;
+      reader.readMessage(value,pkg_model_project_pb.ProjectStaticUser.deserializeBinaryFromReader);
+      msg.addUsers(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -13796,9 +18424,9 @@ proto.grpc.service.webservice.EnableStaticAdminRequest.deserializeBinaryFromRead
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.EnableStaticAdminRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ListStaticUsersResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.EnableStaticAdminRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ListStaticUsersResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -13806,12 +18434,58 @@ proto.grpc.service.webservice.EnableStaticAdminRequest.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.EnableStaticAdminRequest} message
+ * @param {!proto.grpc.service.webservice.ListStaticUsersResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.EnableStaticAdminRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ListStaticUsersResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getUsersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      pkg_model_project_pb.ProjectStaticUser.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated model.ProjectStaticUser users = 1;
+ * @return {!Array<!proto.model.ProjectStaticUser>}
+ */
+proto.grpc.service.webservice.ListStaticUsersResponse.prototype.getUsersList = function() {
+  return /** @type{!Array<!proto.model.ProjectStaticUser>} */ (
+    jspb.Message.getRepeatedWrapperField(this, pkg_model_project_pb.ProjectStaticUser, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.model.ProjectStaticUser>} value
+ * @return {!proto.grpc.service.webservice.ListStaticUsersResponse} returns this
+*/
+proto.grpc.service.webservice.ListStaticUsersResponse.prototype.setUsersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.model.ProjectStaticUser=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.ProjectStaticUser}
+ */
+proto.grpc.service.webservice.ListStaticUsersResponse.prototype.addUsers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.ProjectStaticUser, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.ListStaticUsersResponse} returns this
+ */
+proto.grpc.service.webservice.ListStaticUsersResponse.prototype.clearUsersList = function() {
+  return this.setUsersList([]);
 };
 
 
@@ -13831,8 +18505,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.EnableStaticAdminResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.EnableStaticAdminResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.AddStaticUserRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.AddStaticUserRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -13841,13 +18515,15 @@ proto.grpc.service.webservice.EnableStaticAdminResponse.prototype.toObject = fun
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.EnableStaticAdminResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.AddStaticUserRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.EnableStaticAdminResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.AddStaticUserRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    username: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    password: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    role: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -13861,29 +18537,41 @@ proto.grpc.service.webservice.EnableStaticAdminResponse.toObject = function(incl
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.EnableStaticAdminResponse}
+ * @return {!proto.grpc.service.webservice.AddStaticUserRequest}
  */
-proto.grpc.service.webservice.EnableStaticAdminResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.AddStaticUserRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.EnableStaticAdminResponse;
-  return proto.grpc.service.webservice.EnableStaticAdminResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.AddStaticUserRequest;
+  return proto.grpc.service.webservice.AddStaticUserRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.EnableStaticAdminResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.AddStaticUserRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.EnableStaticAdminResponse}
+ * @return {!proto.grpc.service.webservice.AddStaticUserRequest}
  */
-proto.grpc.service.webservice.EnableStaticAdminResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.AddStaticUserRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUsername(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPassword(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRole(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -13897,9 +18585,9 @@ proto.grpc.service.webservice.EnableStaticAdminResponse.deserializeBinaryFromRea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.EnableStaticAdminResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.AddStaticUserRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.EnableStaticAdminResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.AddStaticUserRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -13907,12 +18595,87 @@ proto.grpc.service.webservice.EnableStaticAdminResponse.prototype.serializeBinar
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.EnableStaticAdminResponse} message
+ * @param {!proto.grpc.service.webservice.AddStaticUserRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.EnableStaticAdminResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.AddStaticUserRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPassword();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getRole();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string username = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.AddStaticUserRequest.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.AddStaticUserRequest} returns this
+ */
+proto.grpc.service.webservice.AddStaticUserRequest.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string password = 2;
+ * @return {string}
+ */
+proto.grpc.service.webservice.AddStaticUserRequest.prototype.getPassword = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.AddStaticUserRequest} returns this
+ */
+proto.grpc.service.webservice.AddStaticUserRequest.prototype.setPassword = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string role = 3;
+ * @return {string}
+ */
+proto.grpc.service.webservice.AddStaticUserRequest.prototype.getRole = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.AddStaticUserRequest} returns this
+ */
+proto.grpc.service.webservice.AddStaticUserRequest.prototype.setRole = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
@@ -13932,8 +18695,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.DisableStaticAdminRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.DisableStaticAdminRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.AddStaticUserResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.AddStaticUserResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -13942,11 +18705,11 @@ proto.grpc.service.webservice.DisableStaticAdminRequest.prototype.toObject = fun
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.DisableStaticAdminRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.AddStaticUserResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DisableStaticAdminRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.AddStaticUserResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
 
   };
@@ -13962,23 +18725,23 @@ proto.grpc.service.webservice.DisableStaticAdminRequest.toObject = function(incl
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.DisableStaticAdminRequest}
+ * @return {!proto.grpc.service.webservice.AddStaticUserResponse}
  */
-proto.grpc.service.webservice.DisableStaticAdminRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.AddStaticUserResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.DisableStaticAdminRequest;
-  return proto.grpc.service.webservice.DisableStaticAdminRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.AddStaticUserResponse;
+  return proto.grpc.service.webservice.AddStaticUserResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.DisableStaticAdminRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.AddStaticUserResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.DisableStaticAdminRequest}
+ * @return {!proto.grpc.service.webservice.AddStaticUserResponse}
  */
-proto.grpc.service.webservice.DisableStaticAdminRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.AddStaticUserResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -13998,9 +18761,9 @@ proto.grpc.service.webservice.DisableStaticAdminRequest.deserializeBinaryFromRea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.DisableStaticAdminRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.AddStaticUserResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.DisableStaticAdminRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.AddStaticUserResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -14008,11 +18771,11 @@ proto.grpc.service.webservice.DisableStaticAdminRequest.prototype.serializeBinar
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.DisableStaticAdminRequest} message
+ * @param {!proto.grpc.service.webservice.AddStaticUserResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DisableStaticAdminRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.AddStaticUserResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
 };
 
@@ -14033,8 +18796,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.DisableStaticAdminResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.DisableStaticAdminResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.DeleteStaticUserRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.DeleteStaticUserRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -14043,13 +18806,13 @@ proto.grpc.service.webservice.DisableStaticAdminResponse.prototype.toObject = fu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.DisableStaticAdminResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.DeleteStaticUserRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DisableStaticAdminResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.DeleteStaticUserRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    username: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -14063,29 +18826,33 @@ proto.grpc.service.webservice.DisableStaticAdminResponse.toObject = function(inc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.DisableStaticAdminResponse}
+ * @return {!proto.grpc.service.webservice.DeleteStaticUserRequest}
  */
-proto.grpc.service.webservice.DisableStaticAdminResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.DeleteStaticUserRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.DisableStaticAdminResponse;
-  return proto.grpc.service.webservice.DisableStaticAdminResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.DeleteStaticUserRequest;
+  return proto.grpc.service.webservice.DeleteStaticUserRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.DisableStaticAdminResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.DeleteStaticUserRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.DisableStaticAdminResponse}
+ * @return {!proto.grpc.service.webservice.DeleteStaticUserRequest}
  */
-proto.grpc.service.webservice.DisableStaticAdminResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.DeleteStaticUserRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUsername(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -14099,9 +18866,9 @@ proto.grpc.service.webservice.DisableStaticAdminResponse.deserializeBinaryFromRe
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.DisableStaticAdminResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.DeleteStaticUserRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.DisableStaticAdminResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.DeleteStaticUserRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -14109,12 +18876,37 @@ proto.grpc.service.webservice.DisableStaticAdminResponse.prototype.serializeBina
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.DisableStaticAdminResponse} message
+ * @param {!proto.grpc.service.webservice.DeleteStaticUserRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DisableStaticAdminResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.DeleteStaticUserRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string username = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.DeleteStaticUserRequest.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.DeleteStaticUserRequest} returns this
+ */
+proto.grpc.service.webservice.DeleteStaticUserRequest.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -14134,8 +18926,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.GetMeRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GetMeRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.DeleteStaticUserResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.DeleteStaticUserResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -14144,11 +18936,11 @@ proto.grpc.service.webservice.GetMeRequest.prototype.toObject = function(opt_inc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GetMeRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.DeleteStaticUserResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetMeRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.DeleteStaticUserResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
 
   };
@@ -14164,23 +18956,23 @@ proto.grpc.service.webservice.GetMeRequest.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GetMeRequest}
+ * @return {!proto.grpc.service.webservice.DeleteStaticUserResponse}
  */
-proto.grpc.service.webservice.GetMeRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.DeleteStaticUserResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GetMeRequest;
-  return proto.grpc.service.webservice.GetMeRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.DeleteStaticUserResponse;
+  return proto.grpc.service.webservice.DeleteStaticUserResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GetMeRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.DeleteStaticUserResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GetMeRequest}
+ * @return {!proto.grpc.service.webservice.DeleteStaticUserResponse}
  */
-proto.grpc.service.webservice.GetMeRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.DeleteStaticUserResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -14200,9 +18992,9 @@ proto.grpc.service.webservice.GetMeRequest.deserializeBinaryFromReader = functio
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GetMeRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.DeleteStaticUserResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GetMeRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.DeleteStaticUserResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -14210,11 +19002,11 @@ proto.grpc.service.webservice.GetMeRequest.prototype.serializeBinary = function(
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GetMeRequest} message
+ * @param {!proto.grpc.service.webservice.DeleteStaticUserResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetMeRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.DeleteStaticUserResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
 };
 
@@ -14235,8 +19027,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.GetMeResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GetMeResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.UpdateStaticUserRoleRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.UpdateStaticUserRoleRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -14245,15 +19037,14 @@ proto.grpc.service.webservice.GetMeResponse.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GetMeResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.UpdateStaticUserRoleRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetMeResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.UpdateStaticUserRoleRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    subject: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    avatarUrl: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    projectId: jspb.Message.getFieldWithDefault(msg, 3, "")
+    username: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    role: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -14267,23 +19058,23 @@ proto.grpc.service.webservice.GetMeResponse.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GetMeResponse}
+ * @return {!proto.grpc.service.webservice.UpdateStaticUserRoleRequest}
  */
-proto.grpc.service.webservice.GetMeResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.UpdateStaticUserRoleRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GetMeResponse;
-  return proto.grpc.service.webservice.GetMeResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.UpdateStaticUserRoleRequest;
+  return proto.grpc.service.webservice.UpdateStaticUserRoleRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GetMeResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.UpdateStaticUserRoleRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GetMeResponse}
+ * @return {!proto.grpc.service.webservice.UpdateStaticUserRoleRequest}
  */
-proto.grpc.service.webservice.GetMeResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.UpdateStaticUserRoleRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -14292,15 +19083,11 @@ proto.grpc.service.webservice.GetMeResponse.deserializeBinaryFromReader = functi
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSubject(value);
+      msg.setUsername(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setAvatarUrl(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setProjectId(value);
+      msg.setRole(value);
       break;
     default:
       reader.skipField();
@@ -14315,9 +19102,9 @@ proto.grpc.service.webservice.GetMeResponse.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GetMeResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.UpdateStaticUserRoleRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GetMeResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.UpdateStaticUserRoleRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -14325,97 +19112,166 @@ proto.grpc.service.webservice.GetMeResponse.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GetMeResponse} message
+ * @param {!proto.grpc.service.webservice.UpdateStaticUserRoleRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetMeResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.UpdateStaticUserRoleRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSubject();
+  f = message.getUsername();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getAvatarUrl();
+  f = message.getRole();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getProjectId();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string subject = 1;
+ * optional string username = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.GetMeResponse.prototype.getSubject = function() {
+proto.grpc.service.webservice.UpdateStaticUserRoleRequest.prototype.getUsername = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.GetMeResponse} returns this
+ * @return {!proto.grpc.service.webservice.UpdateStaticUserRoleRequest} returns this
  */
-proto.grpc.service.webservice.GetMeResponse.prototype.setSubject = function(value) {
+proto.grpc.service.webservice.UpdateStaticUserRoleRequest.prototype.setUsername = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string avatar_url = 2;
+ * optional string role = 2;
  * @return {string}
  */
-proto.grpc.service.webservice.GetMeResponse.prototype.getAvatarUrl = function() {
+proto.grpc.service.webservice.UpdateStaticUserRoleRequest.prototype.getRole = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.GetMeResponse} returns this
+ * @return {!proto.grpc.service.webservice.UpdateStaticUserRoleRequest} returns this
  */
-proto.grpc.service.webservice.GetMeResponse.prototype.setAvatarUrl = function(value) {
+proto.grpc.service.webservice.UpdateStaticUserRoleRequest.prototype.setRole = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional string project_id = 3;
- * @return {string}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.grpc.service.webservice.GetMeResponse.prototype.getProjectId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.grpc.service.webservice.UpdateStaticUserRoleResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.UpdateStaticUserRoleResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.GetMeResponse} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.UpdateStaticUserRoleResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetMeResponse.prototype.setProjectId = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.grpc.service.webservice.UpdateStaticUserRoleResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
+
 
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.UpdateStaticUserRoleResponse}
+ */
+proto.grpc.service.webservice.UpdateStaticUserRoleResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.UpdateStaticUserRoleResponse;
+  return proto.grpc.service.webservice.UpdateStaticUserRoleResponse.deserializeBinaryFromReader(msg, reader);
+};
 
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.UpdateStaticUserRoleResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.UpdateStaticUserRoleResponse}
  */
-proto.grpc.service.webservice.AddProjectRBACRoleRequest.repeatedFields_ = [2];
+proto.grpc.service.webservice.UpdateStaticUserRoleResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.UpdateStaticUserRoleResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.UpdateStaticUserRoleResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.UpdateStaticUserRoleResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.UpdateStaticUserRoleResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
 
 
 
@@ -14432,8 +19288,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.AddProjectRBACRoleRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.AddProjectRBACRoleRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ResetStaticUserPasswordRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ResetStaticUserPasswordRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -14442,15 +19298,15 @@ proto.grpc.service.webservice.AddProjectRBACRoleRequest.prototype.toObject = fun
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.AddProjectRBACRoleRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ResetStaticUserPasswordRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.AddProjectRBACRoleRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ResetStaticUserPasswordRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    policiesList: jspb.Message.toObjectList(msg.getPoliciesList(),
-    pkg_model_project_pb.ProjectRBACPolicy.toObject, includeInstance)
+    username: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    password: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    resetTotp: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -14464,23 +19320,23 @@ proto.grpc.service.webservice.AddProjectRBACRoleRequest.toObject = function(incl
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.AddProjectRBACRoleRequest}
+ * @return {!proto.grpc.service.webservice.ResetStaticUserPasswordRequest}
  */
-proto.grpc.service.webservice.AddProjectRBACRoleRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ResetStaticUserPasswordRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.AddProjectRBACRoleRequest;
-  return proto.grpc.service.webservice.AddProjectRBACRoleRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ResetStaticUserPasswordRequest;
+  return proto.grpc.service.webservice.ResetStaticUserPasswordRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.AddProjectRBACRoleRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ResetStaticUserPasswordRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.AddProjectRBACRoleRequest}
+ * @return {!proto.grpc.service.webservice.ResetStaticUserPasswordRequest}
  */
-proto.grpc.service.webservice.AddProjectRBACRoleRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ResetStaticUserPasswordRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -14489,12 +19345,15 @@ proto.grpc.service.webservice.AddProjectRBACRoleRequest.deserializeBinaryFromRea
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setUsername(value);
       break;
     case 2:
-      var value = new pkg_model_project_pb.ProjectRBACPolicy;
-      reader.readMessage(value,pkg_model_project_pb.ProjectRBACPolicy.deserializeBinaryFromReader);
-      msg.addPolicies(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPassword(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setResetTotp(value);
       break;
     default:
       reader.skipField();
@@ -14509,9 +19368,9 @@ proto.grpc.service.webservice.AddProjectRBACRoleRequest.deserializeBinaryFromRea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.AddProjectRBACRoleRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ResetStaticUserPasswordRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.AddProjectRBACRoleRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ResetStaticUserPasswordRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -14519,83 +19378,87 @@ proto.grpc.service.webservice.AddProjectRBACRoleRequest.prototype.serializeBinar
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.AddProjectRBACRoleRequest} message
+ * @param {!proto.grpc.service.webservice.ResetStaticUserPasswordRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.AddProjectRBACRoleRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ResetStaticUserPasswordRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getUsername();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getPoliciesList();
+  f = message.getPassword();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       2,
-      f,
-      pkg_model_project_pb.ProjectRBACPolicy.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getResetTotp();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
     );
   }
 };
 
 
 /**
- * optional string name = 1;
+ * optional string username = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.AddProjectRBACRoleRequest.prototype.getName = function() {
+proto.grpc.service.webservice.ResetStaticUserPasswordRequest.prototype.getUsername = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.AddProjectRBACRoleRequest} returns this
+ * @return {!proto.grpc.service.webservice.ResetStaticUserPasswordRequest} returns this
  */
-proto.grpc.service.webservice.AddProjectRBACRoleRequest.prototype.setName = function(value) {
+proto.grpc.service.webservice.ResetStaticUserPasswordRequest.prototype.setUsername = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * repeated model.ProjectRBACPolicy policies = 2;
- * @return {!Array<!proto.model.ProjectRBACPolicy>}
+ * optional string password = 2;
+ * @return {string}
  */
-proto.grpc.service.webservice.AddProjectRBACRoleRequest.prototype.getPoliciesList = function() {
-  return /** @type{!Array<!proto.model.ProjectRBACPolicy>} */ (
-    jspb.Message.getRepeatedWrapperField(this, pkg_model_project_pb.ProjectRBACPolicy, 2));
+proto.grpc.service.webservice.ResetStaticUserPasswordRequest.prototype.getPassword = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {!Array<!proto.model.ProjectRBACPolicy>} value
- * @return {!proto.grpc.service.webservice.AddProjectRBACRoleRequest} returns this
-*/
-proto.grpc.service.webservice.AddProjectRBACRoleRequest.prototype.setPoliciesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.ResetStaticUserPasswordRequest} returns this
+ */
+proto.grpc.service.webservice.ResetStaticUserPasswordRequest.prototype.setPassword = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * @param {!proto.model.ProjectRBACPolicy=} opt_value
- * @param {number=} opt_index
- * @return {!proto.model.ProjectRBACPolicy}
+ * optional bool reset_totp = 3;
+ * @return {boolean}
  */
-proto.grpc.service.webservice.AddProjectRBACRoleRequest.prototype.addPolicies = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.model.ProjectRBACPolicy, opt_index);
+proto.grpc.service.webservice.ResetStaticUserPasswordRequest.prototype.getResetTotp = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.grpc.service.webservice.AddProjectRBACRoleRequest} returns this
+ * @param {boolean} value
+ * @return {!proto.grpc.service.webservice.ResetStaticUserPasswordRequest} returns this
  */
-proto.grpc.service.webservice.AddProjectRBACRoleRequest.prototype.clearPoliciesList = function() {
-  return this.setPoliciesList([]);
+proto.grpc.service.webservice.ResetStaticUserPasswordRequest.prototype.setResetTotp = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
 
@@ -14615,8 +19478,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.AddProjectRBACRoleResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.AddProjectRBACRoleResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ResetStaticUserPasswordResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ResetStaticUserPasswordResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -14625,11 +19488,11 @@ proto.grpc.service.webservice.AddProjectRBACRoleResponse.prototype.toObject = fu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.AddProjectRBACRoleResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ResetStaticUserPasswordResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.AddProjectRBACRoleResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ResetStaticUserPasswordResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
 
   };
@@ -14645,23 +19508,23 @@ proto.grpc.service.webservice.AddProjectRBACRoleResponse.toObject = function(inc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.AddProjectRBACRoleResponse}
+ * @return {!proto.grpc.service.webservice.ResetStaticUserPasswordResponse}
  */
-proto.grpc.service.webservice.AddProjectRBACRoleResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ResetStaticUserPasswordResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.AddProjectRBACRoleResponse;
-  return proto.grpc.service.webservice.AddProjectRBACRoleResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ResetStaticUserPasswordResponse;
+  return proto.grpc.service.webservice.ResetStaticUserPasswordResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.AddProjectRBACRoleResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ResetStaticUserPasswordResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.AddProjectRBACRoleResponse}
+ * @return {!proto.grpc.service.webservice.ResetStaticUserPasswordResponse}
  */
-proto.grpc.service.webservice.AddProjectRBACRoleResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ResetStaticUserPasswordResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -14681,9 +19544,9 @@ proto.grpc.service.webservice.AddProjectRBACRoleResponse.deserializeBinaryFromRe
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.AddProjectRBACRoleResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ResetStaticUserPasswordResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.AddProjectRBACRoleResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ResetStaticUserPasswordResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -14691,23 +19554,16 @@ proto.grpc.service.webservice.AddProjectRBACRoleResponse.prototype.serializeBina
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.AddProjectRBACRoleResponse} message
+ * @param {!proto.grpc.service.webservice.ResetStaticUserPasswordResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.AddProjectRBACRoleResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ResetStaticUserPasswordResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.repeatedFields_ = [2];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -14723,8 +19579,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ChangeStaticUserPasswordRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ChangeStaticUserPasswordRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -14733,15 +19589,14 @@ proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.prototype.toObject =
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.UpdateProjectRBACRoleRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ChangeStaticUserPasswordRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ChangeStaticUserPasswordRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    policiesList: jspb.Message.toObjectList(msg.getPoliciesList(),
-    pkg_model_project_pb.ProjectRBACPolicy.toObject, includeInstance)
+    currentPassword: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    newPassword: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -14755,23 +19610,23 @@ proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.toObject = function(i
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.UpdateProjectRBACRoleRequest}
+ * @return {!proto.grpc.service.webservice.ChangeStaticUserPasswordRequest}
  */
-proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ChangeStaticUserPasswordRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.UpdateProjectRBACRoleRequest;
-  return proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ChangeStaticUserPasswordRequest;
+  return proto.grpc.service.webservice.ChangeStaticUserPasswordRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.UpdateProjectRBACRoleRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ChangeStaticUserPasswordRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.UpdateProjectRBACRoleRequest}
+ * @return {!proto.grpc.service.webservice.ChangeStaticUserPasswordRequest}
  */
-proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ChangeStaticUserPasswordRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -14780,12 +19635,11 @@ proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.deserializeBinaryFrom
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setCurrentPassword(value);
       break;
     case 2:
-      var value = new pkg_model_project_pb.ProjectRBACPolicy;
-      reader.readMessage(value,pkg_model_project_pb.ProjectRBACPolicy.deserializeBinaryFromReader);
-      msg.addPolicies(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNewPassword(value);
       break;
     default:
       reader.skipField();
@@ -14800,9 +19654,9 @@ proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.deserializeBinaryFrom
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ChangeStaticUserPasswordRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ChangeStaticUserPasswordRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -14810,83 +19664,62 @@ proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.prototype.serializeBi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.UpdateProjectRBACRoleRequest} message
+ * @param {!proto.grpc.service.webservice.ChangeStaticUserPasswordRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ChangeStaticUserPasswordRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getCurrentPassword();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getPoliciesList();
+  f = message.getNewPassword();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       2,
-      f,
-      pkg_model_project_pb.ProjectRBACPolicy.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * optional string name = 1;
+ * optional string current_password = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.prototype.getName = function() {
+proto.grpc.service.webservice.ChangeStaticUserPasswordRequest.prototype.getCurrentPassword = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.UpdateProjectRBACRoleRequest} returns this
+ * @return {!proto.grpc.service.webservice.ChangeStaticUserPasswordRequest} returns this
  */
-proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.prototype.setName = function(value) {
+proto.grpc.service.webservice.ChangeStaticUserPasswordRequest.prototype.setCurrentPassword = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * repeated model.ProjectRBACPolicy policies = 2;
- * @return {!Array<!proto.model.ProjectRBACPolicy>}
- */
-proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.prototype.getPoliciesList = function() {
-  return /** @type{!Array<!proto.model.ProjectRBACPolicy>} */ (
-    jspb.Message.getRepeatedWrapperField(this, pkg_model_project_pb.ProjectRBACPolicy, 2));
-};
-
-
-/**
- * @param {!Array<!proto.model.ProjectRBACPolicy>} value
- * @return {!proto.grpc.service.webservice.UpdateProjectRBACRoleRequest} returns this
-*/
-proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.prototype.setPoliciesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 2, value);
-};
-
-
-/**
- * @param {!proto.model.ProjectRBACPolicy=} opt_value
- * @param {number=} opt_index
- * @return {!proto.model.ProjectRBACPolicy}
+ * optional string new_password = 2;
+ * @return {string}
  */
-proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.prototype.addPolicies = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.model.ProjectRBACPolicy, opt_index);
+proto.grpc.service.webservice.ChangeStaticUserPasswordRequest.prototype.getNewPassword = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.grpc.service.webservice.UpdateProjectRBACRoleRequest} returns this
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.ChangeStaticUserPasswordRequest} returns this
  */
-proto.grpc.service.webservice.UpdateProjectRBACRoleRequest.prototype.clearPoliciesList = function() {
-  return this.setPoliciesList([]);
+proto.grpc.service.webservice.ChangeStaticUserPasswordRequest.prototype.setNewPassword = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -14906,8 +19739,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.UpdateProjectRBACRoleResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.UpdateProjectRBACRoleResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ChangeStaticUserPasswordResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ChangeStaticUserPasswordResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -14916,11 +19749,11 @@ proto.grpc.service.webservice.UpdateProjectRBACRoleResponse.prototype.toObject =
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.UpdateProjectRBACRoleResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ChangeStaticUserPasswordResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdateProjectRBACRoleResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ChangeStaticUserPasswordResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
 
   };
@@ -14936,23 +19769,23 @@ proto.grpc.service.webservice.UpdateProjectRBACRoleResponse.toObject = function(
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.UpdateProjectRBACRoleResponse}
+ * @return {!proto.grpc.service.webservice.ChangeStaticUserPasswordResponse}
  */
-proto.grpc.service.webservice.UpdateProjectRBACRoleResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ChangeStaticUserPasswordResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.UpdateProjectRBACRoleResponse;
-  return proto.grpc.service.webservice.UpdateProjectRBACRoleResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ChangeStaticUserPasswordResponse;
+  return proto.grpc.service.webservice.ChangeStaticUserPasswordResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.UpdateProjectRBACRoleResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ChangeStaticUserPasswordResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.UpdateProjectRBACRoleResponse}
+ * @return {!proto.grpc.service.webservice.ChangeStaticUserPasswordResponse}
  */
-proto.grpc.service.webservice.UpdateProjectRBACRoleResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ChangeStaticUserPasswordResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -14972,9 +19805,9 @@ proto.grpc.service.webservice.UpdateProjectRBACRoleResponse.deserializeBinaryFro
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.UpdateProjectRBACRoleResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ChangeStaticUserPasswordResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.UpdateProjectRBACRoleResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ChangeStaticUserPasswordResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -14982,11 +19815,11 @@ proto.grpc.service.webservice.UpdateProjectRBACRoleResponse.prototype.serializeB
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.UpdateProjectRBACRoleResponse} message
+ * @param {!proto.grpc.service.webservice.ChangeStaticUserPasswordResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.UpdateProjectRBACRoleResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ChangeStaticUserPasswordResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
 };
 
@@ -15007,8 +19840,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.GenerateStaticUserTOTPSecretRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GenerateStaticUserTOTPSecretRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -15017,13 +19850,13 @@ proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.prototype.toObject =
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.DeleteProjectRBACRoleRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.GenerateStaticUserTOTPSecretRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.GenerateStaticUserTOTPSecretRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, "")
+
   };
 
   if (includeInstance) {
@@ -15037,33 +19870,29 @@ proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.toObject = function(i
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.DeleteProjectRBACRoleRequest}
+ * @return {!proto.grpc.service.webservice.GenerateStaticUserTOTPSecretRequest}
  */
-proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.GenerateStaticUserTOTPSecretRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.DeleteProjectRBACRoleRequest;
-  return proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.GenerateStaticUserTOTPSecretRequest;
+  return proto.grpc.service.webservice.GenerateStaticUserTOTPSecretRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.DeleteProjectRBACRoleRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.GenerateStaticUserTOTPSecretRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.DeleteProjectRBACRoleRequest}
+ * @return {!proto.grpc.service.webservice.GenerateStaticUserTOTPSecretRequest}
  */
-proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.GenerateStaticUserTOTPSecretRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -15077,9 +19906,9 @@ proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.deserializeBinaryFrom
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.GenerateStaticUserTOTPSecretRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.GenerateStaticUserTOTPSecretRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -15087,37 +19916,12 @@ proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.prototype.serializeBi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.DeleteProjectRBACRoleRequest} message
+ * @param {!proto.grpc.service.webservice.GenerateStaticUserTOTPSecretRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.GenerateStaticUserTOTPSecretRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string name = 1;
- * @return {string}
- */
-proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.DeleteProjectRBACRoleRequest} returns this
- */
-proto.grpc.service.webservice.DeleteProjectRBACRoleRequest.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -15137,8 +19941,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.DeleteProjectRBACRoleResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.DeleteProjectRBACRoleResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -15147,13 +19951,14 @@ proto.grpc.service.webservice.DeleteProjectRBACRoleResponse.prototype.toObject =
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.DeleteProjectRBACRoleResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DeleteProjectRBACRoleResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    secret: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    url: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -15167,29 +19972,37 @@ proto.grpc.service.webservice.DeleteProjectRBACRoleResponse.toObject = function(
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.DeleteProjectRBACRoleResponse}
+ * @return {!proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse}
  */
-proto.grpc.service.webservice.DeleteProjectRBACRoleResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.DeleteProjectRBACRoleResponse;
-  return proto.grpc.service.webservice.DeleteProjectRBACRoleResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse;
+  return proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.DeleteProjectRBACRoleResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.DeleteProjectRBACRoleResponse}
+ * @return {!proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse}
  */
-proto.grpc.service.webservice.DeleteProjectRBACRoleResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSecret(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrl(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -15203,9 +20016,9 @@ proto.grpc.service.webservice.DeleteProjectRBACRoleResponse.deserializeBinaryFro
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.DeleteProjectRBACRoleResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.DeleteProjectRBACRoleResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -15213,12 +20026,62 @@ proto.grpc.service.webservice.DeleteProjectRBACRoleResponse.prototype.serializeB
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.DeleteProjectRBACRoleResponse} message
+ * @param {!proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DeleteProjectRBACRoleResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getSecret();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string secret = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse.prototype.getSecret = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse} returns this
+ */
+proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse.prototype.setSecret = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string url = 2;
+ * @return {string}
+ */
+proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse} returns this
+ */
+proto.grpc.service.webservice.GenerateStaticUserTOTPSecretResponse.prototype.setUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -15238,8 +20101,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.AddProjectUserGroupRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.AddProjectUserGroupRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.EnableStaticUserTOTPRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.EnableStaticUserTOTPRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -15248,14 +20111,15 @@ proto.grpc.service.webservice.AddProjectUserGroupRequest.prototype.toObject = fu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.AddProjectUserGroupRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.EnableStaticUserTOTPRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.AddProjectUserGroupRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.EnableStaticUserTOTPRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    ssoGroup: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    role: jspb.Message.getFieldWithDefault(msg, 2, "")
+    password: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    secret: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    passcode: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -15269,23 +20133,23 @@ proto.grpc.service.webservice.AddProjectUserGroupRequest.toObject = function(inc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.AddProjectUserGroupRequest}
+ * @return {!proto.grpc.service.webservice.EnableStaticUserTOTPRequest}
  */
-proto.grpc.service.webservice.AddProjectUserGroupRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.EnableStaticUserTOTPRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.AddProjectUserGroupRequest;
-  return proto.grpc.service.webservice.AddProjectUserGroupRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.EnableStaticUserTOTPRequest;
+  return proto.grpc.service.webservice.EnableStaticUserTOTPRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.AddProjectUserGroupRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.EnableStaticUserTOTPRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.AddProjectUserGroupRequest}
+ * @return {!proto.grpc.service.webservice.EnableStaticUserTOTPRequest}
  */
-proto.grpc.service.webservice.AddProjectUserGroupRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.EnableStaticUserTOTPRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -15294,11 +20158,15 @@ proto.grpc.service.webservice.AddProjectUserGroupRequest.deserializeBinaryFromRe
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSsoGroup(value);
+      msg.setPassword(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setRole(value);
+      msg.setSecret(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPasscode(value);
       break;
     default:
       reader.skipField();
@@ -15313,9 +20181,9 @@ proto.grpc.service.webservice.AddProjectUserGroupRequest.deserializeBinaryFromRe
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.AddProjectUserGroupRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.EnableStaticUserTOTPRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.AddProjectUserGroupRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.EnableStaticUserTOTPRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -15323,65 +20191,90 @@ proto.grpc.service.webservice.AddProjectUserGroupRequest.prototype.serializeBina
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.AddProjectUserGroupRequest} message
+ * @param {!proto.grpc.service.webservice.EnableStaticUserTOTPRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.AddProjectUserGroupRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.EnableStaticUserTOTPRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSsoGroup();
+  f = message.getPassword();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getRole();
+  f = message.getSecret();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
+  f = message.getPasscode();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string sso_group = 1;
+ * optional string password = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.AddProjectUserGroupRequest.prototype.getSsoGroup = function() {
+proto.grpc.service.webservice.EnableStaticUserTOTPRequest.prototype.getPassword = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.AddProjectUserGroupRequest} returns this
+ * @return {!proto.grpc.service.webservice.EnableStaticUserTOTPRequest} returns this
  */
-proto.grpc.service.webservice.AddProjectUserGroupRequest.prototype.setSsoGroup = function(value) {
+proto.grpc.service.webservice.EnableStaticUserTOTPRequest.prototype.setPassword = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string role = 2;
+ * optional string secret = 2;
  * @return {string}
  */
-proto.grpc.service.webservice.AddProjectUserGroupRequest.prototype.getRole = function() {
+proto.grpc.service.webservice.EnableStaticUserTOTPRequest.prototype.getSecret = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.AddProjectUserGroupRequest} returns this
+ * @return {!proto.grpc.service.webservice.EnableStaticUserTOTPRequest} returns this
  */
-proto.grpc.service.webservice.AddProjectUserGroupRequest.prototype.setRole = function(value) {
+proto.grpc.service.webservice.EnableStaticUserTOTPRequest.prototype.setSecret = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+/**
+ * optional string passcode = 3;
+ * @return {string}
+ */
+proto.grpc.service.webservice.EnableStaticUserTOTPRequest.prototype.getPasscode = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.EnableStaticUserTOTPRequest} returns this
+ */
+proto.grpc.service.webservice.EnableStaticUserTOTPRequest.prototype.setPasscode = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
 
 
 
@@ -15398,8 +20291,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.AddProjectUserGroupResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.AddProjectUserGroupResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.EnableStaticUserTOTPResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.EnableStaticUserTOTPResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -15408,11 +20301,11 @@ proto.grpc.service.webservice.AddProjectUserGroupResponse.prototype.toObject = f
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.AddProjectUserGroupResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.EnableStaticUserTOTPResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.AddProjectUserGroupResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.EnableStaticUserTOTPResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
 
   };
@@ -15428,23 +20321,23 @@ proto.grpc.service.webservice.AddProjectUserGroupResponse.toObject = function(in
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.AddProjectUserGroupResponse}
+ * @return {!proto.grpc.service.webservice.EnableStaticUserTOTPResponse}
  */
-proto.grpc.service.webservice.AddProjectUserGroupResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.EnableStaticUserTOTPResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.AddProjectUserGroupResponse;
-  return proto.grpc.service.webservice.AddProjectUserGroupResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.EnableStaticUserTOTPResponse;
+  return proto.grpc.service.webservice.EnableStaticUserTOTPResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.AddProjectUserGroupResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.EnableStaticUserTOTPResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.AddProjectUserGroupResponse}
+ * @return {!proto.grpc.service.webservice.EnableStaticUserTOTPResponse}
  */
-proto.grpc.service.webservice.AddProjectUserGroupResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.EnableStaticUserTOTPResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -15464,9 +20357,9 @@ proto.grpc.service.webservice.AddProjectUserGroupResponse.deserializeBinaryFromR
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.AddProjectUserGroupResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.EnableStaticUserTOTPResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.AddProjectUserGroupResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.EnableStaticUserTOTPResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -15474,11 +20367,11 @@ proto.grpc.service.webservice.AddProjectUserGroupResponse.prototype.serializeBin
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.AddProjectUserGroupResponse} message
+ * @param {!proto.grpc.service.webservice.EnableStaticUserTOTPResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.AddProjectUserGroupResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.EnableStaticUserTOTPResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
 };
 
@@ -15499,8 +20392,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.DeleteProjectUserGroupRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.DeleteProjectUserGroupRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.DisableStaticUserTOTPRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.DisableStaticUserTOTPRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -15509,13 +20402,13 @@ proto.grpc.service.webservice.DeleteProjectUserGroupRequest.prototype.toObject =
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.DeleteProjectUserGroupRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.DisableStaticUserTOTPRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DeleteProjectUserGroupRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.DisableStaticUserTOTPRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    ssoGroup: jspb.Message.getFieldWithDefault(msg, 1, "")
+    password: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -15529,23 +20422,23 @@ proto.grpc.service.webservice.DeleteProjectUserGroupRequest.toObject = function(
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.DeleteProjectUserGroupRequest}
+ * @return {!proto.grpc.service.webservice.DisableStaticUserTOTPRequest}
  */
-proto.grpc.service.webservice.DeleteProjectUserGroupRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.DisableStaticUserTOTPRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.DeleteProjectUserGroupRequest;
-  return proto.grpc.service.webservice.DeleteProjectUserGroupRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.DisableStaticUserTOTPRequest;
+  return proto.grpc.service.webservice.DisableStaticUserTOTPRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.DeleteProjectUserGroupRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.DisableStaticUserTOTPRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.DeleteProjectUserGroupRequest}
+ * @return {!proto.grpc.service.webservice.DisableStaticUserTOTPRequest}
  */
-proto.grpc.service.webservice.DeleteProjectUserGroupRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.DisableStaticUserTOTPRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -15554,7 +20447,7 @@ proto.grpc.service.webservice.DeleteProjectUserGroupRequest.deserializeBinaryFro
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSsoGroup(value);
+      msg.setPassword(value);
       break;
     default:
       reader.skipField();
@@ -15569,9 +20462,9 @@ proto.grpc.service.webservice.DeleteProjectUserGroupRequest.deserializeBinaryFro
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.DeleteProjectUserGroupRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.DisableStaticUserTOTPRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.DeleteProjectUserGroupRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.DisableStaticUserTOTPRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -15579,13 +20472,13 @@ proto.grpc.service.webservice.DeleteProjectUserGroupRequest.prototype.serializeB
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.DeleteProjectUserGroupRequest} message
+ * @param {!proto.grpc.service.webservice.DisableStaticUserTOTPRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DeleteProjectUserGroupRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.DisableStaticUserTOTPRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSsoGroup();
+  f = message.getPassword();
   if (f.length > 0) {
     writer.writeString(
       1,
@@ -15596,19 +20489,19 @@ proto.grpc.service.webservice.DeleteProjectUserGroupRequest.serializeBinaryToWri
 
 
 /**
- * optional string sso_group = 1;
+ * optional string password = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.DeleteProjectUserGroupRequest.prototype.getSsoGroup = function() {
+proto.grpc.service.webservice.DisableStaticUserTOTPRequest.prototype.getPassword = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.DeleteProjectUserGroupRequest} returns this
+ * @return {!proto.grpc.service.webservice.DisableStaticUserTOTPRequest} returns this
  */
-proto.grpc.service.webservice.DeleteProjectUserGroupRequest.prototype.setSsoGroup = function(value) {
+proto.grpc.service.webservice.DisableStaticUserTOTPRequest.prototype.setPassword = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -15629,8 +20522,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.DeleteProjectUserGroupResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.DeleteProjectUserGroupResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.DisableStaticUserTOTPResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.DisableStaticUserTOTPResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -15639,11 +20532,11 @@ proto.grpc.service.webservice.DeleteProjectUserGroupResponse.prototype.toObject
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.DeleteProjectUserGroupResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.DisableStaticUserTOTPResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DeleteProjectUserGroupResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.DisableStaticUserTOTPResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
 
   };
@@ -15659,23 +20552,23 @@ proto.grpc.service.webservice.DeleteProjectUserGroupResponse.toObject = function
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.DeleteProjectUserGroupResponse}
+ * @return {!proto.grpc.service.webservice.DisableStaticUserTOTPResponse}
  */
-proto.grpc.service.webservice.DeleteProjectUserGroupResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.DisableStaticUserTOTPResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.DeleteProjectUserGroupResponse;
-  return proto.grpc.service.webservice.DeleteProjectUserGroupResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.DisableStaticUserTOTPResponse;
+  return proto.grpc.service.webservice.DisableStaticUserTOTPResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.DeleteProjectUserGroupResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.DisableStaticUserTOTPResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.DeleteProjectUserGroupResponse}
+ * @return {!proto.grpc.service.webservice.DisableStaticUserTOTPResponse}
  */
-proto.grpc.service.webservice.DeleteProjectUserGroupResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.DisableStaticUserTOTPResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -15695,9 +20588,9 @@ proto.grpc.service.webservice.DeleteProjectUserGroupResponse.deserializeBinaryFr
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.DeleteProjectUserGroupResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.DisableStaticUserTOTPResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.DeleteProjectUserGroupResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.DisableStaticUserTOTPResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -15705,11 +20598,11 @@ proto.grpc.service.webservice.DeleteProjectUserGroupResponse.prototype.serialize
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.DeleteProjectUserGroupResponse} message
+ * @param {!proto.grpc.service.webservice.DisableStaticUserTOTPResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DeleteProjectUserGroupResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.DisableStaticUserTOTPResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
 };
 
@@ -18642,6 +23535,292 @@ proto.grpc.service.webservice.GetDeploymentChainResponse.prototype.hasDeployment
 
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.CancelDeploymentChainRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.CancelDeploymentChainRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.CancelDeploymentChainRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.CancelDeploymentChainRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    deploymentChainId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.CancelDeploymentChainRequest}
+ */
+proto.grpc.service.webservice.CancelDeploymentChainRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.CancelDeploymentChainRequest;
+  return proto.grpc.service.webservice.CancelDeploymentChainRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.CancelDeploymentChainRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.CancelDeploymentChainRequest}
+ */
+proto.grpc.service.webservice.CancelDeploymentChainRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDeploymentChainId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.CancelDeploymentChainRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.CancelDeploymentChainRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.CancelDeploymentChainRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.CancelDeploymentChainRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDeploymentChainId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string deployment_chain_id = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.CancelDeploymentChainRequest.prototype.getDeploymentChainId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.CancelDeploymentChainRequest} returns this
+ */
+proto.grpc.service.webservice.CancelDeploymentChainRequest.prototype.setDeploymentChainId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.grpc.service.webservice.CancelDeploymentChainResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.CancelDeploymentChainResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.CancelDeploymentChainResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.CancelDeploymentChainResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.CancelDeploymentChainResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    commandIdsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.CancelDeploymentChainResponse}
+ */
+proto.grpc.service.webservice.CancelDeploymentChainResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.CancelDeploymentChainResponse;
+  return proto.grpc.service.webservice.CancelDeploymentChainResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.CancelDeploymentChainResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.CancelDeploymentChainResponse}
+ */
+proto.grpc.service.webservice.CancelDeploymentChainResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addCommandIds(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.CancelDeploymentChainResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.CancelDeploymentChainResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.CancelDeploymentChainResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.CancelDeploymentChainResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCommandIdsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string command_ids = 1;
+ * @return {!Array<string>}
+ */
+proto.grpc.service.webservice.CancelDeploymentChainResponse.prototype.getCommandIdsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.grpc.service.webservice.CancelDeploymentChainResponse} returns this
+ */
+proto.grpc.service.webservice.CancelDeploymentChainResponse.prototype.setCommandIdsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.grpc.service.webservice.CancelDeploymentChainResponse} returns this
+ */
+proto.grpc.service.webservice.CancelDeploymentChainResponse.prototype.addCommandIds = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.CancelDeploymentChainResponse} returns this
+ */
+proto.grpc.service.webservice.CancelDeploymentChainResponse.prototype.clearCommandIdsList = function() {
+  return this.setCommandIdsList([]);
+};
+
+
+
+
+
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
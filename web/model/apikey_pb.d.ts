@@ -59,6 +59,7 @@ export namespace APIKey {
   export enum Role { 
     READ_ONLY = 0,
     READ_WRITE = 1,
+    ADMIN = 2,
   }
 }
 
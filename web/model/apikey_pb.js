@@ -271,7 +271,8 @@ proto.model.APIKey.serializeBinaryToWriter = function(message, writer) {
  */
 proto.model.APIKey.Role = {
   READ_ONLY: 0,
-  READ_WRITE: 1
+  READ_WRITE: 1,
+  ADMIN: 2
 };
 
 /**
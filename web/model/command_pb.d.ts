@@ -78,6 +78,11 @@ export class Command extends jspb.Message {
   hasRestartPiped(): boolean;
   clearRestartPiped(): Command;
 
+  getRetryStage(): Command.RetryStage | undefined;
+  setRetryStage(value?: Command.RetryStage): Command;
+  hasRetryStage(): boolean;
+  clearRetryStage(): Command;
+
   getCreatedAt(): number;
   setCreatedAt(value: number): Command;
 
@@ -113,6 +118,7 @@ export namespace Command {
     chainSyncApplication?: Command.ChainSyncApplication.AsObject,
     skipStage?: Command.SkipStage.AsObject,
     restartPiped?: Command.RestartPiped.AsObject,
+    retryStage?: Command.RetryStage.AsObject,
     createdAt: number,
     updatedAt: number,
   }
@@ -124,6 +130,9 @@ export namespace Command {
     getSyncStrategy(): pkg_model_common_pb.SyncStrategy;
     setSyncStrategy(value: pkg_model_common_pb.SyncStrategy): SyncApplication;
 
+    getTargetCommit(): string;
+    setTargetCommit(value: string): SyncApplication;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): SyncApplication.AsObject;
     static toObject(includeInstance: boolean, msg: SyncApplication): SyncApplication.AsObject;
@@ -136,6 +145,7 @@ export namespace Command {
     export type AsObject = {
       applicationId: string,
       syncStrategy: pkg_model_common_pb.SyncStrategy,
+      targetCommit: string,
     }
   }
 
@@ -325,6 +335,29 @@ export namespace Command {
   }
 
 
+  export class RetryStage extends jspb.Message {
+    getDeploymentId(): string;
+    setDeploymentId(value: string): RetryStage;
+
+    getStageId(): string;
+    setStageId(value: string): RetryStage;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RetryStage.AsObject;
+    static toObject(includeInstance: boolean, msg: RetryStage): RetryStage.AsObject;
+    static serializeBinaryToWriter(message: RetryStage, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RetryStage;
+    static deserializeBinaryFromReader(message: RetryStage, reader: jspb.BinaryReader): RetryStage;
+  }
+
+  export namespace RetryStage {
+    export type AsObject = {
+      deploymentId: string,
+      stageId: string,
+    }
+  }
+
+
   export enum Type { 
     SYNC_APPLICATION = 0,
     UPDATE_APPLICATION_CONFIG = 1,
@@ -334,6 +367,7 @@ export namespace Command {
     CHAIN_SYNC_APPLICATION = 5,
     SKIP_STAGE = 6,
     RESTART_PIPED = 7,
+    RETRY_STAGE = 8,
   }
 }
 
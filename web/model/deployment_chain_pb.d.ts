@@ -131,6 +131,15 @@ export class ChainBlock extends jspb.Message {
   getStatus(): ChainBlockStatus;
   setStatus(value: ChainBlockStatus): ChainBlock;
 
+  getContinueOnFailure(): boolean;
+  setContinueOnFailure(value: boolean): ChainBlock;
+
+  getCancelOnChainFailure(): boolean;
+  setCancelOnChainFailure(value: boolean): ChainBlock;
+
+  getRollbackOnChainFailure(): boolean;
+  setRollbackOnChainFailure(value: boolean): ChainBlock;
+
   getStartedAt(): number;
   setStartedAt(value: number): ChainBlock;
 
@@ -149,6 +158,9 @@ export namespace ChainBlock {
   export type AsObject = {
     nodesList: Array<ChainNode.AsObject>,
     status: ChainBlockStatus,
+    continueOnFailure: boolean,
+    cancelOnChainFailure: boolean,
+    rollbackOnChainFailure: boolean,
     startedAt: number,
     completedAt: number,
   }
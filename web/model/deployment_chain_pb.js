@@ -1071,6 +1071,9 @@ proto.model.ChainBlock.toObject = function(includeInstance, msg) {
     nodesList: jspb.Message.toObjectList(msg.getNodesList(),
     proto.model.ChainNode.toObject, includeInstance),
     status: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    continueOnFailure: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    cancelOnChainFailure: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    rollbackOnChainFailure: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
     startedAt: jspb.Message.getFieldWithDefault(msg, 100, 0),
     completedAt: jspb.Message.getFieldWithDefault(msg, 101, 0)
   };
@@ -1118,6 +1121,18 @@ proto.model.ChainBlock.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {!proto.model.ChainBlockStatus} */ (reader.readEnum());
       msg.setStatus(value);
       break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setContinueOnFailure(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setCancelOnChainFailure(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRollbackOnChainFailure(value);
+      break;
     case 100:
       var value = /** @type {number} */ (reader.readInt64());
       msg.setStartedAt(value);
@@ -1170,6 +1185,27 @@ proto.model.ChainBlock.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getContinueOnFailure();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getCancelOnChainFailure();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getRollbackOnChainFailure();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
   f = message.getStartedAt();
   if (f !== 0) {
     writer.writeInt64(
@@ -1243,6 +1279,60 @@ proto.model.ChainBlock.prototype.setStatus = function(value) {
 };
 
 
+/**
+ * optional bool continue_on_failure = 3;
+ * @return {boolean}
+ */
+proto.model.ChainBlock.prototype.getContinueOnFailure = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.model.ChainBlock} returns this
+ */
+proto.model.ChainBlock.prototype.setContinueOnFailure = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional bool cancel_on_chain_failure = 4;
+ * @return {boolean}
+ */
+proto.model.ChainBlock.prototype.getCancelOnChainFailure = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.model.ChainBlock} returns this
+ */
+proto.model.ChainBlock.prototype.setCancelOnChainFailure = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+/**
+ * optional bool rollback_on_chain_failure = 5;
+ * @return {boolean}
+ */
+proto.model.ChainBlock.prototype.getRollbackOnChainFailure = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.model.ChainBlock} returns this
+ */
+proto.model.ChainBlock.prototype.setRollbackOnChainFailure = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
 /**
  * optional int64 started_at = 100;
  * @return {number}
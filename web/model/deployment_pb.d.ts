@@ -59,6 +59,9 @@ export class Deployment extends jspb.Message {
   getRunningConfigFilename(): string;
   setRunningConfigFilename(value: string): Deployment;
 
+  getRunningArtifactDigest(): string;
+  setRunningArtifactDigest(value: string): Deployment;
+
   getStatus(): DeploymentStatus;
   setStatus(value: DeploymentStatus): Deployment;
 
@@ -122,6 +125,7 @@ export namespace Deployment {
     versionsList: Array<pkg_model_common_pb.ArtifactVersion.AsObject>,
     runningCommitHash: string,
     runningConfigFilename: string,
+    runningArtifactDigest: string,
     status: DeploymentStatus,
     statusReason: string,
     stagesList: Array<PipelineStage.AsObject>,
@@ -174,6 +178,9 @@ export class DeploymentTrigger extends jspb.Message {
   getStrategySummary(): string;
   setStrategySummary(value: string): DeploymentTrigger;
 
+  getArtifactDigest(): string;
+  setArtifactDigest(value: string): DeploymentTrigger;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): DeploymentTrigger.AsObject;
   static toObject(includeInstance: boolean, msg: DeploymentTrigger): DeploymentTrigger.AsObject;
@@ -189,6 +196,7 @@ export namespace DeploymentTrigger {
     timestamp: number,
     syncStrategy: pkg_model_common_pb.SyncStrategy,
     strategySummary: string,
+    artifactDigest: string,
   }
 }
 
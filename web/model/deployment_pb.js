@@ -239,6 +239,7 @@ proto.model.Deployment.toObject = function(includeInstance, msg) {
     pkg_model_common_pb.ArtifactVersion.toObject, includeInstance),
     runningCommitHash: jspb.Message.getFieldWithDefault(msg, 21, ""),
     runningConfigFilename: jspb.Message.getFieldWithDefault(msg, 60, ""),
+    runningArtifactDigest: jspb.Message.getFieldWithDefault(msg, 61, ""),
     status: jspb.Message.getFieldWithDefault(msg, 30, 0),
     statusReason: jspb.Message.getFieldWithDefault(msg, 31, ""),
     stagesList: jspb.Message.toObjectList(msg.getStagesList(),
@@ -358,6 +359,10 @@ proto.model.Deployment.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {string} */ (reader.readString());
       msg.setRunningConfigFilename(value);
       break;
+    case 61:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRunningArtifactDigest(value);
+      break;
     case 30:
       var value = /** @type {!proto.model.DeploymentStatus} */ (reader.readEnum());
       msg.setStatus(value);
@@ -544,6 +549,13 @@ proto.model.Deployment.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getRunningArtifactDigest();
+  if (f.length > 0) {
+    writer.writeString(
+      61,
+      f
+    );
+  }
   f = message.getStatus();
   if (f !== 0.0) {
     writer.writeEnum(
@@ -979,6 +991,24 @@ proto.model.Deployment.prototype.setRunningConfigFilename = function(value) {
 };
 
 
+/**
+ * optional string running_artifact_digest = 61;
+ * @return {string}
+ */
+proto.model.Deployment.prototype.getRunningArtifactDigest = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 61, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.Deployment} returns this
+ */
+proto.model.Deployment.prototype.setRunningArtifactDigest = function(value) {
+  return jspb.Message.setProto3StringField(this, 61, value);
+};
+
+
 /**
  * optional DeploymentStatus status = 30;
  * @return {!proto.model.DeploymentStatus}
@@ -1413,7 +1443,8 @@ proto.model.DeploymentTrigger.toObject = function(includeInstance, msg) {
     commander: jspb.Message.getFieldWithDefault(msg, 2, ""),
     timestamp: jspb.Message.getFieldWithDefault(msg, 3, 0),
     syncStrategy: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    strategySummary: jspb.Message.getFieldWithDefault(msg, 5, "")
+    strategySummary: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    artifactDigest: jspb.Message.getFieldWithDefault(msg, 6, "")
   };
 
   if (includeInstance) {
@@ -1471,6 +1502,10 @@ proto.model.DeploymentTrigger.deserializeBinaryFromReader = function(msg, reader
       var value = /** @type {string} */ (reader.readString());
       msg.setStrategySummary(value);
       break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setArtifactDigest(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1536,6 +1571,13 @@ proto.model.DeploymentTrigger.serializeBinaryToWriter = function(message, writer
       f
     );
   }
+  f = message.getArtifactDigest();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
 };
 
 
@@ -1648,6 +1690,24 @@ proto.model.DeploymentTrigger.prototype.setStrategySummary = function(value) {
 };
 
 
+/**
+ * optional string artifact_digest = 6;
+ * @return {string}
+ */
+proto.model.DeploymentTrigger.prototype.getArtifactDigest = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.DeploymentTrigger} returns this
+ */
+proto.model.DeploymentTrigger.prototype.setArtifactDigest = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
 
 /**
  * List of repeated fields within this message type.
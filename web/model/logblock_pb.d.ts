@@ -13,6 +13,9 @@ export class LogBlock extends jspb.Message {
   getSeverity(): LogSeverity;
   setSeverity(value: LogSeverity): LogBlock;
 
+  getFieldsMap(): jspb.Map<string, string>;
+  clearFieldsMap(): LogBlock;
+
   getCreatedAt(): number;
   setCreatedAt(value: number): LogBlock;
 
@@ -29,6 +32,7 @@ export namespace LogBlock {
     index: number,
     log: string,
     severity: LogSeverity,
+    fieldsMap: Array<[string, string]>,
     createdAt: number,
   }
 }
@@ -37,4 +41,6 @@ export enum LogSeverity {
   INFO = 0,
   SUCCESS = 1,
   ERROR = 2,
+  WARN = 3,
+  DEBUG = 4,
 }
@@ -81,6 +81,7 @@ proto.model.LogBlock.toObject = function(includeInstance, msg) {
     index: jspb.Message.getFieldWithDefault(msg, 1, 0),
     log: jspb.Message.getFieldWithDefault(msg, 2, ""),
     severity: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    fieldsMap: (f = msg.getFieldsMap()) ? f.toObject(includeInstance, undefined) : [],
     createdAt: jspb.Message.getFieldWithDefault(msg, 14, 0)
   };
 
@@ -130,6 +131,12 @@ proto.model.LogBlock.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {!proto.model.LogSeverity} */ (reader.readEnum());
       msg.setSeverity(value);
       break;
+    case 4:
+      var value = msg.getFieldsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
     case 14:
       var value = /** @type {number} */ (reader.readInt64());
       msg.setCreatedAt(value);
@@ -184,6 +191,10 @@ proto.model.LogBlock.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getFieldsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(4, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
   f = message.getCreatedAt();
   if (f !== 0) {
     writer.writeInt64(
@@ -248,6 +259,29 @@ proto.model.LogBlock.prototype.setSeverity = function(value) {
 };
 
 
+/**
+ * map<string, string> fields = 4;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.model.LogBlock.prototype.getFieldsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 4, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.model.LogBlock} returns this
+ */
+proto.model.LogBlock.prototype.clearFieldsMap = function() {
+  this.getFieldsMap().clear();
+  return this;
+};
+
+
 /**
  * optional int64 created_at = 14;
  * @return {number}
@@ -272,7 +306,9 @@ proto.model.LogBlock.prototype.setCreatedAt = function(value) {
 proto.model.LogSeverity = {
   INFO: 0,
   SUCCESS: 1,
-  ERROR: 2
+  ERROR: 2,
+  WARN: 3,
+  DEBUG: 4
 };
 
 goog.object.extend(exports, proto.model);
@@ -101,6 +101,11 @@ export class ApplicationPlanPreviewResult extends jspb.Message {
   clearPluginNamesList(): ApplicationPlanPreviewResult;
   addPluginNames(value: string, index?: number): ApplicationPlanPreviewResult;
 
+  getPolicyViolationsList(): Array<PolicyViolation>;
+  setPolicyViolationsList(value: Array<PolicyViolation>): ApplicationPlanPreviewResult;
+  clearPolicyViolationsList(): ApplicationPlanPreviewResult;
+  addPolicyViolations(value?: PolicyViolation, index?: number): PolicyViolation;
+
   getError(): string;
   setError(value: string): ApplicationPlanPreviewResult;
 
@@ -133,6 +138,7 @@ export namespace ApplicationPlanPreviewResult {
     noChange: boolean,
     pluginPlanResultsList: Array<PluginPlanPreviewResult.AsObject>,
     pluginNamesList: Array<string>,
+    policyViolationsList: Array<PolicyViolation.AsObject>,
     error: string,
     createdAt: number,
   }
@@ -176,3 +182,34 @@ export namespace PluginPlanPreviewResult {
   }
 }
 
+export class PolicyViolation extends jspb.Message {
+  getRule(): string;
+  setRule(value: string): PolicyViolation;
+
+  getMessage(): string;
+  setMessage(value: string): PolicyViolation;
+
+  getAction(): PolicyViolation.Action;
+  setAction(value: PolicyViolation.Action): PolicyViolation;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PolicyViolation.AsObject;
+  static toObject(includeInstance: boolean, msg: PolicyViolation): PolicyViolation.AsObject;
+  static serializeBinaryToWriter(message: PolicyViolation, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PolicyViolation;
+  static deserializeBinaryFromReader(message: PolicyViolation, reader: jspb.BinaryReader): PolicyViolation;
+}
+
+export namespace PolicyViolation {
+  export type AsObject = {
+    rule: string,
+    message: string,
+    action: PolicyViolation.Action,
+  }
+
+  export enum Action { 
+    DENY = 0,
+    REQUIRE_APPROVAL = 1,
+  }
+}
+
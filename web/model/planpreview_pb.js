@@ -28,6 +28,8 @@ goog.object.extend(proto, pkg_model_common_pb);
 goog.exportSymbol('proto.model.ApplicationPlanPreviewResult', null, global);
 goog.exportSymbol('proto.model.PlanPreviewCommandResult', null, global);
 goog.exportSymbol('proto.model.PluginPlanPreviewResult', null, global);
+goog.exportSymbol('proto.model.PolicyViolation', null, global);
+goog.exportSymbol('proto.model.PolicyViolation.Action', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -91,6 +93,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.model.PluginPlanPreviewResult.displayName = 'proto.model.PluginPlanPreviewResult';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.PolicyViolation = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.model.PolicyViolation, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.PolicyViolation.displayName = 'proto.model.PolicyViolation';
+}
 
 /**
  * List of repeated fields within this message type.
@@ -407,7 +430,7 @@ proto.model.PlanPreviewCommandResult.prototype.setPipedName = function(value) {
  * @private {!Array<number>}
  * @const
  */
-proto.model.ApplicationPlanPreviewResult.repeatedFields_ = [34,35];
+proto.model.ApplicationPlanPreviewResult.repeatedFields_ = [34,35,36];
 
 
 
@@ -457,6 +480,8 @@ proto.model.ApplicationPlanPreviewResult.toObject = function(includeInstance, ms
     pluginPlanResultsList: jspb.Message.toObjectList(msg.getPluginPlanResultsList(),
     proto.model.PluginPlanPreviewResult.toObject, includeInstance),
     pluginNamesList: (f = jspb.Message.getRepeatedField(msg, 35)) == null ? undefined : f,
+    policyViolationsList: jspb.Message.toObjectList(msg.getPolicyViolationsList(),
+    proto.model.PolicyViolation.toObject, includeInstance),
     error: jspb.Message.getFieldWithDefault(msg, 40, ""),
     createdAt: jspb.Message.getFieldWithDefault(msg, 90, 0)
   };
@@ -562,6 +587,11 @@ proto.model.ApplicationPlanPreviewResult.deserializeBinaryFromReader = function(
       var value = /** @type {string} */ (reader.readString());
       msg.addPluginNames(value);
       break;
+    case 36:
+      var value = new proto.model.PolicyViolation;
+      reader.readMessage(value,proto.model.PolicyViolation.deserializeBinaryFromReader);
+      msg.addPolicyViolations(value);
+      break;
     case 40:
       var value = /** @type {string} */ (reader.readString());
       msg.setError(value);
@@ -709,6 +739,14 @@ proto.model.ApplicationPlanPreviewResult.serializeBinaryToWriter = function(mess
       f
     );
   }
+  f = message.getPolicyViolationsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      36,
+      f,
+      proto.model.PolicyViolation.serializeBinaryToWriter
+    );
+  }
   f = message.getError();
   if (f.length > 0) {
     writer.writeString(
@@ -1106,6 +1144,44 @@ proto.model.ApplicationPlanPreviewResult.prototype.clearPluginNamesList = functi
 };
 
 
+/**
+ * repeated PolicyViolation policy_violations = 36;
+ * @return {!Array<!proto.model.PolicyViolation>}
+ */
+proto.model.ApplicationPlanPreviewResult.prototype.getPolicyViolationsList = function() {
+  return /** @type{!Array<!proto.model.PolicyViolation>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.PolicyViolation, 36));
+};
+
+
+/**
+ * @param {!Array<!proto.model.PolicyViolation>} value
+ * @return {!proto.model.ApplicationPlanPreviewResult} returns this
+*/
+proto.model.ApplicationPlanPreviewResult.prototype.setPolicyViolationsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 36, value);
+};
+
+
+/**
+ * @param {!proto.model.PolicyViolation=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.PolicyViolation}
+ */
+proto.model.ApplicationPlanPreviewResult.prototype.addPolicyViolations = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 36, opt_value, proto.model.PolicyViolation, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.ApplicationPlanPreviewResult} returns this
+ */
+proto.model.ApplicationPlanPreviewResult.prototype.clearPolicyViolationsList = function() {
+  return this.setPolicyViolationsList([]);
+};
+
+
 /**
  * optional string error = 40;
  * @return {string}
@@ -1440,4 +1516,202 @@ proto.model.PluginPlanPreviewResult.prototype.setDiffLanguage = function(value)
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.PolicyViolation.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.PolicyViolation.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.PolicyViolation} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.PolicyViolation.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    rule: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    message: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    action: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.PolicyViolation}
+ */
+proto.model.PolicyViolation.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.PolicyViolation;
+  return proto.model.PolicyViolation.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.PolicyViolation} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.PolicyViolation}
+ */
+proto.model.PolicyViolation.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRule(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMessage(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.model.PolicyViolation.Action} */ (reader.readEnum());
+      msg.setAction(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.PolicyViolation.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.PolicyViolation.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.PolicyViolation} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.PolicyViolation.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRule();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getAction();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.model.PolicyViolation.Action = {
+  DENY: 0,
+  REQUIRE_APPROVAL: 1
+};
+
+/**
+ * optional string rule = 1;
+ * @return {string}
+ */
+proto.model.PolicyViolation.prototype.getRule = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.PolicyViolation} returns this
+ */
+proto.model.PolicyViolation.prototype.setRule = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string message = 2;
+ * @return {string}
+ */
+proto.model.PolicyViolation.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.PolicyViolation} returns this
+ */
+proto.model.PolicyViolation.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional Action action = 3;
+ * @return {!proto.model.PolicyViolation.Action}
+ */
+proto.model.PolicyViolation.prototype.getAction = function() {
+  return /** @type {!proto.model.PolicyViolation.Action} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {!proto.model.PolicyViolation.Action} value
+ * @return {!proto.model.PolicyViolation} returns this
+ */
+proto.model.PolicyViolation.prototype.setAction = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
 goog.object.extend(exports, proto.model);
@@ -45,6 +45,16 @@ export class Project extends jspb.Message {
   clearUserGroupsList(): Project;
   addUserGroups(value?: ProjectUserGroup, index?: number): ProjectUserGroup;
 
+  getDeploymentFreezeWindowsList(): Array<DeploymentFreezeWindow>;
+  setDeploymentFreezeWindowsList(value: Array<DeploymentFreezeWindow>): Project;
+  clearDeploymentFreezeWindowsList(): Project;
+  addDeploymentFreezeWindows(value?: DeploymentFreezeWindow, index?: number): DeploymentFreezeWindow;
+
+  getStaticUsersList(): Array<ProjectStaticUser>;
+  setStaticUsersList(value: Array<ProjectStaticUser>): Project;
+  clearStaticUsersList(): Project;
+  addStaticUsers(value?: ProjectStaticUser, index?: number): ProjectStaticUser;
+
   getCreatedAt(): number;
   setCreatedAt(value: number): Project;
 
@@ -71,6 +81,8 @@ export namespace Project {
     allowStrayAsViewer: boolean,
     rbacRolesList: Array<ProjectRBACRole.AsObject>,
     userGroupsList: Array<ProjectUserGroup.AsObject>,
+    deploymentFreezeWindowsList: Array<DeploymentFreezeWindow.AsObject>,
+    staticUsersList: Array<ProjectStaticUser.AsObject>,
     createdAt: number,
     updatedAt: number,
   }
@@ -83,6 +95,15 @@ export class ProjectStaticUser extends jspb.Message {
   getPasswordHash(): string;
   setPasswordHash(value: string): ProjectStaticUser;
 
+  getRole(): string;
+  setRole(value: string): ProjectStaticUser;
+
+  getTotpSecret(): string;
+  setTotpSecret(value: string): ProjectStaticUser;
+
+  getTotpEnabled(): boolean;
+  setTotpEnabled(value: boolean): ProjectStaticUser;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): ProjectStaticUser.AsObject;
   static toObject(includeInstance: boolean, msg: ProjectStaticUser): ProjectStaticUser.AsObject;
@@ -95,6 +116,9 @@ export namespace ProjectStaticUser {
   export type AsObject = {
     username: string,
     passwordHash: string,
+    role: string,
+    totpSecret: string,
+    totpEnabled: boolean,
   }
 }
 
@@ -179,6 +203,18 @@ export namespace ProjectSSOConfig {
     getClientSecret(): string;
     setClientSecret(value: string): Google;
 
+    getProxyUrl(): string;
+    setProxyUrl(value: string): Google;
+
+    getHostedDomain(): string;
+    setHostedDomain(value: string): Google;
+
+    getServiceAccountKey(): string;
+    setServiceAccountKey(value: string): Google;
+
+    getAdminEmail(): string;
+    setAdminEmail(value: string): Google;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): Google.AsObject;
     static toObject(includeInstance: boolean, msg: Google): Google.AsObject;
@@ -191,6 +227,10 @@ export namespace ProjectSSOConfig {
     export type AsObject = {
       clientId: string,
       clientSecret: string,
+      proxyUrl: string,
+      hostedDomain: string,
+      serviceAccountKey: string,
+      adminEmail: string,
     }
   }
 
@@ -315,6 +355,52 @@ export namespace ProjectUserGroup {
   }
 }
 
+export class DeploymentFreezeWindow extends jspb.Message {
+  getName(): string;
+  setName(value: string): DeploymentFreezeWindow;
+
+  getDescription(): string;
+  setDescription(value: string): DeploymentFreezeWindow;
+
+  getLabelsMap(): jspb.Map<string, string>;
+  clearLabelsMap(): DeploymentFreezeWindow;
+
+  getTimeZone(): string;
+  setTimeZone(value: string): DeploymentFreezeWindow;
+
+  getStartAt(): number;
+  setStartAt(value: number): DeploymentFreezeWindow;
+
+  getEndAt(): number;
+  setEndAt(value: number): DeploymentFreezeWindow;
+
+  getCron(): string;
+  setCron(value: string): DeploymentFreezeWindow;
+
+  getDuration(): number;
+  setDuration(value: number): DeploymentFreezeWindow;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): DeploymentFreezeWindow.AsObject;
+  static toObject(includeInstance: boolean, msg: DeploymentFreezeWindow): DeploymentFreezeWindow.AsObject;
+  static serializeBinaryToWriter(message: DeploymentFreezeWindow, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): DeploymentFreezeWindow;
+  static deserializeBinaryFromReader(message: DeploymentFreezeWindow, reader: jspb.BinaryReader): DeploymentFreezeWindow;
+}
+
+export namespace DeploymentFreezeWindow {
+  export type AsObject = {
+    name: string,
+    description: string,
+    labelsMap: Array<[string, string]>,
+    timeZone: string,
+    startAt: number,
+    endAt: number,
+    cron: string,
+    duration: number,
+  }
+}
+
 export class ProjectRBACRole extends jspb.Message {
   getName(): string;
   setName(value: string): ProjectRBACRole;
@@ -25,6 +25,7 @@ var global =
 
 var google_protobuf_descriptor_pb = require('google-protobuf/google/protobuf/descriptor_pb.js');
 goog.object.extend(proto, google_protobuf_descriptor_pb);
+goog.exportSymbol('proto.model.DeploymentFreezeWindow', null, global);
 goog.exportSymbol('proto.model.Project', null, global);
 goog.exportSymbol('proto.model.ProjectRBACConfig', null, global);
 goog.exportSymbol('proto.model.ProjectRBACPolicy', null, global);
@@ -207,6 +208,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.model.ProjectUserGroup.displayName = 'proto.model.ProjectUserGroup';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.DeploymentFreezeWindow = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.model.DeploymentFreezeWindow, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.DeploymentFreezeWindow.displayName = 'proto.model.DeploymentFreezeWindow';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -276,7 +298,7 @@ if (goog.DEBUG && !COMPILED) {
  * @private {!Array<number>}
  * @const
  */
-proto.model.Project.repeatedFields_ = [9,10];
+proto.model.Project.repeatedFields_ = [9,10,11,12];
 
 
 
@@ -321,6 +343,10 @@ proto.model.Project.toObject = function(includeInstance, msg) {
     proto.model.ProjectRBACRole.toObject, includeInstance),
     userGroupsList: jspb.Message.toObjectList(msg.getUserGroupsList(),
     proto.model.ProjectUserGroup.toObject, includeInstance),
+    deploymentFreezeWindowsList: jspb.Message.toObjectList(msg.getDeploymentFreezeWindowsList(),
+    proto.model.DeploymentFreezeWindow.toObject, includeInstance),
+    staticUsersList: jspb.Message.toObjectList(msg.getStaticUsersList(),
+    proto.model.ProjectStaticUser.toObject, includeInstance),
     createdAt: jspb.Message.getFieldWithDefault(msg, 14, 0),
     updatedAt: jspb.Message.getFieldWithDefault(msg, 15, 0)
   };
@@ -404,6 +430,16 @@ proto.model.Project.deserializeBinaryFromReader = function(msg, reader) {
       reader.readMessage(value,proto.model.ProjectUserGroup.deserializeBinaryFromReader);
       msg.addUserGroups(value);
       break;
+    case 11:
+      var value = new proto.model.DeploymentFreezeWindow;
+      reader.readMessage(value,proto.model.DeploymentFreezeWindow.deserializeBinaryFromReader);
+      msg.addDeploymentFreezeWindows(value);
+      break;
+    case 12:
+      var value = new proto.model.ProjectStaticUser;
+      reader.readMessage(value,proto.model.ProjectStaticUser.deserializeBinaryFromReader);
+      msg.addStaticUsers(value);
+      break;
     case 14:
       var value = /** @type {number} */ (reader.readInt64());
       msg.setCreatedAt(value);
@@ -516,6 +552,22 @@ proto.model.Project.serializeBinaryToWriter = function(message, writer) {
       proto.model.ProjectUserGroup.serializeBinaryToWriter
     );
   }
+  f = message.getDeploymentFreezeWindowsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      11,
+      f,
+      proto.model.DeploymentFreezeWindow.serializeBinaryToWriter
+    );
+  }
+  f = message.getStaticUsersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      12,
+      f,
+      proto.model.ProjectStaticUser.serializeBinaryToWriter
+    );
+  }
   f = message.getCreatedAt();
   if (f !== 0) {
     writer.writeInt64(
@@ -810,6 +862,82 @@ proto.model.Project.prototype.clearUserGroupsList = function() {
 };
 
 
+/**
+ * repeated DeploymentFreezeWindow deployment_freeze_windows = 11;
+ * @return {!Array<!proto.model.DeploymentFreezeWindow>}
+ */
+proto.model.Project.prototype.getDeploymentFreezeWindowsList = function() {
+  return /** @type{!Array<!proto.model.DeploymentFreezeWindow>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.DeploymentFreezeWindow, 11));
+};
+
+
+/**
+ * @param {!Array<!proto.model.DeploymentFreezeWindow>} value
+ * @return {!proto.model.Project} returns this
+*/
+proto.model.Project.prototype.setDeploymentFreezeWindowsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 11, value);
+};
+
+
+/**
+ * @param {!proto.model.DeploymentFreezeWindow=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.DeploymentFreezeWindow}
+ */
+proto.model.Project.prototype.addDeploymentFreezeWindows = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 11, opt_value, proto.model.DeploymentFreezeWindow, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.Project} returns this
+ */
+proto.model.Project.prototype.clearDeploymentFreezeWindowsList = function() {
+  return this.setDeploymentFreezeWindowsList([]);
+};
+
+
+/**
+ * repeated ProjectStaticUser static_users = 12;
+ * @return {!Array<!proto.model.ProjectStaticUser>}
+ */
+proto.model.Project.prototype.getStaticUsersList = function() {
+  return /** @type{!Array<!proto.model.ProjectStaticUser>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.ProjectStaticUser, 12));
+};
+
+
+/**
+ * @param {!Array<!proto.model.ProjectStaticUser>} value
+ * @return {!proto.model.Project} returns this
+*/
+proto.model.Project.prototype.setStaticUsersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 12, value);
+};
+
+
+/**
+ * @param {!proto.model.ProjectStaticUser=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.ProjectStaticUser}
+ */
+proto.model.Project.prototype.addStaticUsers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.model.ProjectStaticUser, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.Project} returns this
+ */
+proto.model.Project.prototype.clearStaticUsersList = function() {
+  return this.setStaticUsersList([]);
+};
+
+
 /**
  * optional int64 created_at = 14;
  * @return {number}
@@ -879,7 +1007,10 @@ proto.model.ProjectStaticUser.prototype.toObject = function(opt_includeInstance)
 proto.model.ProjectStaticUser.toObject = function(includeInstance, msg) {
   var f, obj = {
     username: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    passwordHash: jspb.Message.getFieldWithDefault(msg, 2, "")
+    passwordHash: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    role: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    totpSecret: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    totpEnabled: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
   };
 
   if (includeInstance) {
@@ -924,6 +1055,18 @@ proto.model.ProjectStaticUser.deserializeBinaryFromReader = function(msg, reader
       var value = /** @type {string} */ (reader.readString());
       msg.setPasswordHash(value);
       break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRole(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTotpSecret(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setTotpEnabled(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -967,6 +1110,27 @@ proto.model.ProjectStaticUser.serializeBinaryToWriter = function(message, writer
       f
     );
   }
+  f = message.getRole();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getTotpSecret();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getTotpEnabled();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
 };
 
 
@@ -1006,6 +1170,60 @@ proto.model.ProjectStaticUser.prototype.setPasswordHash = function(value) {
 };
 
 
+/**
+ * optional string role = 3;
+ * @return {string}
+ */
+proto.model.ProjectStaticUser.prototype.getRole = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.ProjectStaticUser} returns this
+ */
+proto.model.ProjectStaticUser.prototype.setRole = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string totp_secret = 4;
+ * @return {string}
+ */
+proto.model.ProjectStaticUser.prototype.getTotpSecret = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.ProjectStaticUser} returns this
+ */
+proto.model.ProjectStaticUser.prototype.setTotpSecret = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional bool totp_enabled = 5;
+ * @return {boolean}
+ */
+proto.model.ProjectStaticUser.prototype.getTotpEnabled = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.model.ProjectStaticUser} returns this
+ */
+proto.model.ProjectStaticUser.prototype.setTotpEnabled = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
 
 
 
@@ -1464,7 +1682,11 @@ proto.model.ProjectSSOConfig.Google.prototype.toObject = function(opt_includeIns
 proto.model.ProjectSSOConfig.Google.toObject = function(includeInstance, msg) {
   var f, obj = {
     clientId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    clientSecret: jspb.Message.getFieldWithDefault(msg, 2, "")
+    clientSecret: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    proxyUrl: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    hostedDomain: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    serviceAccountKey: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    adminEmail: jspb.Message.getFieldWithDefault(msg, 6, "")
   };
 
   if (includeInstance) {
@@ -1509,6 +1731,22 @@ proto.model.ProjectSSOConfig.Google.deserializeBinaryFromReader = function(msg,
       var value = /** @type {string} */ (reader.readString());
       msg.setClientSecret(value);
       break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProxyUrl(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setHostedDomain(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceAccountKey(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAdminEmail(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1552,6 +1790,34 @@ proto.model.ProjectSSOConfig.Google.serializeBinaryToWriter = function(message,
       f
     );
   }
+  f = message.getProxyUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getHostedDomain();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getServiceAccountKey();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getAdminEmail();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
 };
 
 
@@ -1591,72 +1857,144 @@ proto.model.ProjectSSOConfig.Google.prototype.setClientSecret = function(value)
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * optional string proxy_url = 3;
+ * @return {string}
  */
-proto.model.ProjectSSOConfig.Oidc.repeatedFields_ = [9];
-
+proto.model.ProjectSSOConfig.Google.prototype.getProxyUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {string} value
+ * @return {!proto.model.ProjectSSOConfig.Google} returns this
  */
-proto.model.ProjectSSOConfig.Oidc.prototype.toObject = function(opt_includeInstance) {
-  return proto.model.ProjectSSOConfig.Oidc.toObject(opt_includeInstance, this);
+proto.model.ProjectSSOConfig.Google.prototype.setProxyUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.model.ProjectSSOConfig.Oidc} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional string hosted_domain = 4;
+ * @return {string}
  */
-proto.model.ProjectSSOConfig.Oidc.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    clientId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    clientSecret: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    issuer: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    redirectUri: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    authorizationEndpoint: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    tokenEndpoint: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    userInfoEndpoint: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    proxyUrl: jspb.Message.getFieldWithDefault(msg, 8, ""),
-    scopesList: (f = jspb.Message.getRepeatedField(msg, 9)) == null ? undefined : f,
-    rolesClaimKey: jspb.Message.getFieldWithDefault(msg, 10, ""),
-    usernameClaimKey: jspb.Message.getFieldWithDefault(msg, 11, ""),
-    avatarUrlClaimKey: jspb.Message.getFieldWithDefault(msg, 12, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.model.ProjectSSOConfig.Google.prototype.getHostedDomain = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.model.ProjectSSOConfig.Oidc}
- */
+ * @param {string} value
+ * @return {!proto.model.ProjectSSOConfig.Google} returns this
+ */
+proto.model.ProjectSSOConfig.Google.prototype.setHostedDomain = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string service_account_key = 5;
+ * @return {string}
+ */
+proto.model.ProjectSSOConfig.Google.prototype.getServiceAccountKey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.ProjectSSOConfig.Google} returns this
+ */
+proto.model.ProjectSSOConfig.Google.prototype.setServiceAccountKey = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string admin_email = 6;
+ * @return {string}
+ */
+proto.model.ProjectSSOConfig.Google.prototype.getAdminEmail = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.ProjectSSOConfig.Google} returns this
+ */
+proto.model.ProjectSSOConfig.Google.prototype.setAdminEmail = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.ProjectSSOConfig.Oidc.repeatedFields_ = [9];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.ProjectSSOConfig.Oidc.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.ProjectSSOConfig.Oidc.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.ProjectSSOConfig.Oidc} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.ProjectSSOConfig.Oidc.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    clientId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    clientSecret: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    issuer: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    redirectUri: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    authorizationEndpoint: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    tokenEndpoint: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    userInfoEndpoint: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    proxyUrl: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    scopesList: (f = jspb.Message.getRepeatedField(msg, 9)) == null ? undefined : f,
+    rolesClaimKey: jspb.Message.getFieldWithDefault(msg, 10, ""),
+    usernameClaimKey: jspb.Message.getFieldWithDefault(msg, 11, ""),
+    avatarUrlClaimKey: jspb.Message.getFieldWithDefault(msg, 12, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.ProjectSSOConfig.Oidc}
+ */
 proto.model.ProjectSSOConfig.Oidc.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
   var msg = new proto.model.ProjectSSOConfig.Oidc;
@@ -2575,6 +2913,350 @@ proto.model.ProjectUserGroup.prototype.setRole = function(value) {
 
 
 
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.DeploymentFreezeWindow.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.DeploymentFreezeWindow.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.DeploymentFreezeWindow} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.DeploymentFreezeWindow.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    description: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : [],
+    timeZone: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    startAt: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    endAt: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    cron: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    duration: jspb.Message.getFieldWithDefault(msg, 8, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.DeploymentFreezeWindow}
+ */
+proto.model.DeploymentFreezeWindow.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.DeploymentFreezeWindow;
+  return proto.model.DeploymentFreezeWindow.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.DeploymentFreezeWindow} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.DeploymentFreezeWindow}
+ */
+proto.model.DeploymentFreezeWindow.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDescription(value);
+      break;
+    case 3:
+      var value = msg.getLabelsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTimeZone(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setStartAt(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setEndAt(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCron(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setDuration(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.DeploymentFreezeWindow.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.DeploymentFreezeWindow.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.DeploymentFreezeWindow} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.DeploymentFreezeWindow.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getLabelsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getTimeZone();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getStartAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
+  f = message.getEndAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      6,
+      f
+    );
+  }
+  f = message.getCron();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getDuration();
+  if (f !== 0) {
+    writer.writeInt64(
+      8,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.model.DeploymentFreezeWindow.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.DeploymentFreezeWindow} returns this
+ */
+proto.model.DeploymentFreezeWindow.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string description = 2;
+ * @return {string}
+ */
+proto.model.DeploymentFreezeWindow.prototype.getDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.DeploymentFreezeWindow} returns this
+ */
+proto.model.DeploymentFreezeWindow.prototype.setDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * map<string, string> labels = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.model.DeploymentFreezeWindow.prototype.getLabelsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.model.DeploymentFreezeWindow} returns this
+ */
+proto.model.DeploymentFreezeWindow.prototype.clearLabelsMap = function() {
+  this.getLabelsMap().clear();
+  return this;
+};
+
+
+/**
+ * optional string time_zone = 4;
+ * @return {string}
+ */
+proto.model.DeploymentFreezeWindow.prototype.getTimeZone = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.DeploymentFreezeWindow} returns this
+ */
+proto.model.DeploymentFreezeWindow.prototype.setTimeZone = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional int64 start_at = 5;
+ * @return {number}
+ */
+proto.model.DeploymentFreezeWindow.prototype.getStartAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.DeploymentFreezeWindow} returns this
+ */
+proto.model.DeploymentFreezeWindow.prototype.setStartAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional int64 end_at = 6;
+ * @return {number}
+ */
+proto.model.DeploymentFreezeWindow.prototype.getEndAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.DeploymentFreezeWindow} returns this
+ */
+proto.model.DeploymentFreezeWindow.prototype.setEndAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional string cron = 7;
+ * @return {string}
+ */
+proto.model.DeploymentFreezeWindow.prototype.getCron = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.DeploymentFreezeWindow} returns this
+ */
+proto.model.DeploymentFreezeWindow.prototype.setCron = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional int64 duration = 8;
+ * @return {number}
+ */
+proto.model.DeploymentFreezeWindow.prototype.getDuration = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.DeploymentFreezeWindow} returns this
+ */
+proto.model.DeploymentFreezeWindow.prototype.setDuration = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
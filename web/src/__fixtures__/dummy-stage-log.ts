@@ -7,6 +7,7 @@ export const dummyLogBlock: LogBlock.AsObject = {
   index: 0,
   log: randomWords(8),
   severity: LogSeverity.SUCCESS,
+  fieldsMap: [],
   createdAt: logTimes[0].unix(),
 };
 
@@ -16,12 +17,14 @@ export const dummyLogBlocks: LogBlock.AsObject[] = [
     index: 1,
     log: randomWords(8),
     severity: LogSeverity.INFO,
+    fieldsMap: [],
     createdAt: logTimes[1].unix(),
   },
   {
     index: 2,
     log: randomWords(8),
     severity: LogSeverity.ERROR,
+    fieldsMap: [["host", "db"]],
     createdAt: logTimes[2].unix(),
   },
 ];
@@ -31,6 +34,7 @@ export function createLogBlockFromObject(o: LogBlock.AsObject): LogBlock {
   block.setIndex(o.index);
   block.setLog(o.log);
   block.setSeverity(o.severity);
+  o.fieldsMap.forEach(([key, value]) => block.getFieldsMap().set(key, value));
   block.setCreatedAt(o.createdAt);
   return block;
 }
@@ -14,6 +14,14 @@ const dummyLog = {
       index: 0,
       log: "hello world",
       severity: LogSeverity.SUCCESS,
+      fieldsMap: [],
+    },
+    {
+      createdAt: 0,
+      index: 1,
+      log: "resolved endpoint",
+      severity: LogSeverity.DEBUG,
+      fieldsMap: [["host", "db"] as [string, string]],
     },
   ],
   completed: true,
@@ -66,3 +74,20 @@ it("should clearActiveStage action if click `close log` button", () => {
 
   expect(changeActiveStage).toHaveBeenCalled();
 });
+
+it("should show debug logs only when the verbosity is toggled", () => {
+  render(
+    <LogViewer
+      activeStage={activeStage}
+      stageLog={dummyLog}
+      changeActiveStage={() => {}}
+    />
+  );
+
+  expect(screen.queryByText("resolved endpoint")).not.toBeInTheDocument();
+
+  userEvent.click(screen.getByRole("checkbox", { name: "Show debug logs" }));
+
+  expect(screen.queryByText("resolved endpoint")).toBeInTheDocument();
+  expect(screen.queryByText("host=db")).toBeInTheDocument();
+});
@@ -10,6 +10,8 @@ import {
   DialogTitle,
   Button,
   Box,
+  FormControlLabel,
+  Switch,
 } from "@mui/material";
 import { Close, SkipNext } from "@mui/icons-material";
 import { FC, memo, useCallback, useMemo, useState } from "react";
@@ -46,6 +48,7 @@ export const LogViewer: FC<Props> = memo(function LogViewer({
   const logViewHeight = maxHandlePosY - handlePosY;
   const [isOpenSkipDialog, setOpenSkipDialog] = useState(false);
   const [commandId, setCommandId] = useState<string>();
+  const [showDebug, setShowDebug] = useState(false);
 
   const stageId = activeStage ? activeStage.id : "";
   const { mutate: skipStage, isLoading: isSkipInitLoading } = useSkipStage();
@@ -204,7 +207,26 @@ export const LogViewer: FC<Props> = memo(function LogViewer({
               {activeStage.desc}
             </Typography>
           </Box>
-          <Box sx={{ flex: 1, justifyContent: "flex-end", display: "flex" }}>
+          <Box
+            sx={{
+              flex: 1,
+              justifyContent: "flex-end",
+              alignItems: "center",
+              display: "flex",
+            }}
+          >
+            <FormControlLabel
+              control={
+                <Switch
+                  size="small"
+                  checked={showDebug}
+                  onChange={(e) => setShowDebug(e.target.checked)}
+                />
+              }
+              label={
+                <Typography variant="body2">Show debug logs</Typography>
+              }
+            />
             <IconButton
               aria-label="close log"
               onClick={handleOnClickClose}
@@ -223,6 +245,7 @@ export const LogViewer: FC<Props> = memo(function LogViewer({
           <Log
             loading={isStageRunning(activeStage.status)}
             logs={stageLog.logBlocks}
+            showDebug={showDebug}
           />
         </Box>
       </Box>
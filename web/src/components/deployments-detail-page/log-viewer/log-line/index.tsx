@@ -1,5 +1,5 @@
 import { Box } from "@mui/material";
-import { Error } from "@mui/icons-material";
+import { Error, Warning } from "@mui/icons-material";
 import { FC } from "react";
 import {
   DEFAULT_BACKGROUND_COLOR,
@@ -16,6 +16,7 @@ export interface LogLineProps {
   lineNumber: number;
   body: string;
   severity: LogSeverity;
+  fields: Array<[string, string]>;
   createdAt: number;
 }
 
@@ -25,6 +26,7 @@ export const LogLine: FC<LogLineProps> = ({
   body,
   lineNumber,
   severity,
+  fields,
   createdAt,
 }) => {
   return (
@@ -50,6 +52,16 @@ export const LogLine: FC<LogLineProps> = ({
           }}
         />
       )}
+      {severity === LogSeverity.WARN && (
+        <Warning
+          color="warning"
+          fontSize="small"
+          sx={{
+            position: "absolute",
+            marginLeft: 1,
+          }}
+        />
+      )}
       <Box
         sx={{
           color: TERMINAL_LINE_NUMBER_COLOR,
@@ -74,6 +86,7 @@ export const LogLine: FC<LogLineProps> = ({
           wordBreak: "break-all",
           pr: 2,
           flex: 1,
+          opacity: severity === LogSeverity.DEBUG ? 0.6 : undefined,
         }}
       >
         {parseLog(body).map((cell, i) => (
@@ -90,6 +103,18 @@ export const LogLine: FC<LogLineProps> = ({
             {cell.content.split("\\n").join("\n")}
           </span>
         ))}
+        {fields.map(([key, value]) => (
+          <span
+            key={`log-field-${key}`}
+            style={{
+              color: TERMINAL_LINE_NUMBER_COLOR,
+              marginLeft: "1em",
+              whiteSpace: "pre-wrap",
+            }}
+          >
+            {`${key}=${value}`}
+          </span>
+        ))}
       </Box>
     </Box>
   );
@@ -1,17 +1,31 @@
-import { FC, memo, useEffect, useRef } from "react";
+import { FC, memo, useEffect, useMemo, useRef } from "react";
 import { CircularProgress, Box } from "@mui/material";
 import { LogLine } from "../log-line";
 import { DEFAULT_BACKGROUND_COLOR } from "~/constants/term-colors";
-import { LogBlock } from "~~/model/logblock_pb";
+import { LogBlock, LogSeverity } from "~~/model/logblock_pb";
 
 export interface LogProps {
   logs: LogBlock.AsObject[];
   loading: boolean;
+  showDebug?: boolean;
 }
 
-export const Log: FC<LogProps> = memo(function Log({ logs, loading }) {
+export const Log: FC<LogProps> = memo(function Log({
+  logs,
+  loading,
+  showDebug = false,
+}) {
   const bottomRef = useRef<null | HTMLDivElement>(null);
 
+  // Line numbers are assigned before filtering so that they do not change with the verbosity.
+  const lines = useMemo(
+    () =>
+      logs
+        .map((log, i) => ({ log, lineNumber: i + 1 }))
+        .filter(({ log }) => showDebug || log.severity !== LogSeverity.DEBUG),
+    [logs, showDebug]
+  );
+
   useEffect(() => {
     bottomRef.current?.scrollIntoView({ behavior: "smooth" });
   }, [logs]);
@@ -26,12 +40,13 @@ export const Log: FC<LogProps> = memo(function Log({ logs, loading }) {
         height: "100%",
       })}
     >
-      {logs.map((log, i) => (
+      {lines.map(({ log, lineNumber }) => (
         <LogLine
           key={`log-${log.index}`}
           severity={log.severity}
+          fields={log.fieldsMap}
           body={log.log}
-          lineNumber={i + 1}
+          lineNumber={lineNumber}
           createdAt={log.createdAt}
         />
       ))}
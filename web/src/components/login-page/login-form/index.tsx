@@ -141,6 +141,15 @@ export const LoginForm: FC<LoginFormProps> = memo(function LoginForm({
             size="small"
             required
           />
+          <TextField
+            id="passcode"
+            name="passcode"
+            label="One-time passcode"
+            helperText="Required only when two-factor authentication is enabled"
+            variant="outlined"
+            size="small"
+            inputProps={{ inputMode: "numeric", autoComplete: "one-time-code" }}
+          />
           <Box
             sx={{
               display: "flex",
@@ -83,6 +83,9 @@ export const GenerateAPIKeyDialog: FC<GenerateAPIKeyDialogProps> = ({
               <MenuItem value={APIKey.Role.READ_WRITE}>
                 {API_KEY_ROLE_TEXT[APIKey.Role.READ_WRITE]}
               </MenuItem>
+              <MenuItem value={APIKey.Role.ADMIN}>
+                {API_KEY_ROLE_TEXT[APIKey.Role.ADMIN]}
+              </MenuItem>
             </Select>
           </FormControl>
         </DialogContent>